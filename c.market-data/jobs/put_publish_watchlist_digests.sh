@@ -0,0 +1,9 @@
+#/bin/sh -x
+
+# Publishes the daily digest of each personal watchlist
+echo Calling s.market-data via gRPC. Publishes watchlist digests.
+
+exec grpcurl -plaintext -d \
+	'{}' \
+	swallowtail-s-marketdata:8000 \
+	marketdata.PublishWatchlistDigests
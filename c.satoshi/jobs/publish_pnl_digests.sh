@@ -0,0 +1,9 @@
+#/bin/sh -x
+
+# DMs subscribed users their daily positions & PnL digest.
+echo Calling s.satoshi via gRPC. Publishes PnL Digests.
+
+exec grpcurl -plaintext -d \
+	'{}' \
+	swallowtail-s-satoshi:8000 \
+	satoshi.PublishPnLDigests
@@ -3,3 +3,10 @@ package emojis
 const (
 	SatoshiEmoji = "<:satoshi:886008008491024445>"
 )
+
+const (
+	// Reactions admins use to review a parsed trade strategy.
+	ApproveEmoji = "✅"
+	EditEmoji    = "✏️"
+	RejectEmoji  = "❌"
+)
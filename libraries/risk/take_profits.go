@@ -0,0 +1,37 @@
+package risk
+
+import (
+	"fmt"
+	"math"
+
+	"swallowtail/libraries/gerrors"
+)
+
+const (
+	// takeProfitAllocationsTolerance allows for allocations like 33.3/33.3/33.4, which don't sum to 100 as float32.
+	takeProfitAllocationsTolerance = 0.01
+)
+
+// ValidateTakeProfitAllocations validates the percentages of a position closed by each take profit; every allocation
+// must be positive & together they must close the whole position.
+func ValidateTakeProfitAllocations(allocations []float32) error {
+	var total float64
+	for i, a := range allocations {
+		if a <= 0 || math.IsNaN(float64(a)) {
+			return gerrors.BadParam("invalid_take_profit_allocations.non_positive_allocation", map[string]string{
+				"index":      fmt.Sprintf("%d", i),
+				"allocation": fmt.Sprintf("%f", a),
+			})
+		}
+
+		total += float64(a)
+	}
+
+	if len(allocations) > 0 && math.Abs(total-100) > takeProfitAllocationsTolerance {
+		return gerrors.BadParam("invalid_take_profit_allocations.must_sum_to_100", map[string]string{
+			"total": fmt.Sprintf("%f", total),
+		})
+	}
+
+	return nil
+}
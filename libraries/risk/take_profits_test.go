@@ -0,0 +1,64 @@
+package risk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTakeProfitAllocations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		allocations []float32
+		withErr     bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:        "single",
+			allocations: []float32{100},
+		},
+		{
+			name:        "thirds",
+			allocations: []float32{33.3, 33.3, 33.4},
+		},
+		{
+			name:        "under_100",
+			allocations: []float32{50, 30},
+			withErr:     true,
+		},
+		{
+			name:        "over_100",
+			allocations: []float32{50, 30, 30},
+			withErr:     true,
+		},
+		{
+			name:        "zero_allocation",
+			allocations: []float32{100, 0},
+			withErr:     true,
+		},
+		{
+			name:        "negative_allocation",
+			allocations: []float32{120, -20},
+			withErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateTakeProfitAllocations(tt.allocations)
+			if tt.withErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package window
 
 import (
 	"fmt"
+	"math"
 	"swallowtail/libraries/structures/queues"
 )
 
@@ -43,9 +44,40 @@ func (mw *MovingWindow) Mean() (float32, error) {
 	return total / counter, nil
 }
 
-func (mw *MovingWindow) StdDev(nMeanAwayFromNormal float32) float32 {
-	// TODO
-	return 0.0
+// StdDev returns n population standard deviations of the values in the window.
+func (mw *MovingWindow) StdDev(n float32) float32 {
+	values := mw.Values()
+	if len(values) == 0 {
+		return 0.0
+	}
+
+	var mean float64
+	for _, v := range values {
+		mean += float64(v)
+	}
+	mean /= float64(len(values))
+
+	var variance float64
+	for _, v := range values {
+		variance += math.Pow(float64(v)-mean, 2)
+	}
+	variance /= float64(len(values))
+
+	return n * float32(math.Sqrt(variance))
+}
+
+// Values returns a copy of the values in the window, oldest first.
+func (mw *MovingWindow) Values() []float32 {
+	items := mw.q.GetAsArray()
+
+	values := make([]float32, 0, len(items))
+	for _, item := range items {
+		if v, ok := item.(float32); ok {
+			values = append(values, v)
+		}
+	}
+
+	return values
 }
 
 func (mw *MovingWindow) Len() int {
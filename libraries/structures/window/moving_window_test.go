@@ -1 +1,30 @@
 package window
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMovingWindow(t *testing.T) {
+	t.Parallel()
+
+	mw := NewMovingWindow(4)
+	assert.Equal(t, float32(0), mw.StdDev(1))
+
+	for _, v := range []float32{100, 2, 4, 4, 6} {
+		require.NoError(t, mw.Push(v))
+	}
+
+	// The oldest value is dropped once the window is at capacity.
+	assert.Equal(t, []float32{2, 4, 4, 6}, mw.Values())
+	assert.Equal(t, 4, mw.Len())
+
+	mean, err := mw.Mean()
+	require.NoError(t, err)
+	assert.Equal(t, float32(4), mean)
+
+	assert.InDelta(t, 1.4142, mw.StdDev(1), 1e-4)
+	assert.InDelta(t, 2.8284, mw.StdDev(2), 1e-4)
+}
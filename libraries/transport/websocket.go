@@ -29,16 +29,25 @@ type WsMessage struct {
 }
 
 func NewWebsocket(ctx context.Context, cfg *WsConfig) *Websocket {
+	ws, err := DialWebsocket(ctx, cfg)
+	if err != nil {
+		panic(err)
+	}
+	return ws
+}
+
+// DialWebsocket creates a new websocket; returning an error rather than panicking if it can't connect.
+func DialWebsocket(ctx context.Context, cfg *WsConfig) (*Websocket, error) {
 	c, _, err := websocket.DefaultDialer.DialContext(ctx, cfg.Endpoint, nil)
 	if err != nil {
-		panic(terrors.Augment(err, "Failed to create a new websocket", nil))
+		return nil, terrors.Augment(err, "Failed to create a new websocket", nil)
 	}
 	slog.Info(ctx, fmt.Sprintf("creating ws -> %s", cfg.Endpoint))
 	return &Websocket{
 		cfg:  cfg,
 		conn: c,
 		done: make(chan struct{}, 1),
-	}
+	}, nil
 }
 
 func (ws *Websocket) Send(ctx context.Context, msg *WsMessage, timeout time.Duration) {
@@ -99,7 +108,12 @@ func (ws *Websocket) Receiver(ctx context.Context) (chan *WsMessage, chan error)
 		for {
 			t, msg, err := ws.conn.ReadMessage()
 			if err != nil {
-				errC <- err
+				// The connection can't be read from again once it has failed; so we stop the receiver.
+				select {
+				case errC <- err:
+				default:
+				}
+				return
 			}
 			wsMsg := &WsMessage{
 				Type:    t,
@@ -131,3 +145,11 @@ func (ws *Websocket) Close() {
 	default:
 	}
 }
+
+// StopReceiver stops the receiver & closes the underlying connection.
+func (ws *Websocket) StopReceiver() {
+	ws.Close()
+	if err := ws.conn.Close(); err != nil {
+		slog.Warn(nil, "Failed to close websocket: %v", err)
+	}
+}
@@ -0,0 +1 @@
+ALTER TABLE s_account_accounts ADD COLUMN default_take_profit_allocations DECIMAL[] NOT NULL DEFAULT '{}';
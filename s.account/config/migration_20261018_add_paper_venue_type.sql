@@ -0,0 +1 @@
+ALTER TYPE venue ADD VALUE 'PAPER';
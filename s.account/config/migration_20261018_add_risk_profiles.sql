@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS s_account_risk_profiles (
+	user_id VARCHAR(20) NOT NULL UNIQUE,
+
+	-- zero valued limits are treated as unlimited.
+	max_risk_per_trade DECIMAL NOT NULL DEFAULT 0,
+	max_concurrent_open_strategies INTEGER NOT NULL DEFAULT 0,
+	max_leverage DECIMAL NOT NULL DEFAULT 0,
+	max_notional_per_asset DECIMAL NOT NULL DEFAULT 0,
+	daily_loss_limit DECIMAL NOT NULL DEFAULT 0,
+	min_venue_balance DECIMAL NOT NULL DEFAULT 0,
+
+	-- empty allows all instruments.
+	instrument_allow_list TEXT[] NOT NULL DEFAULT '{}',
+
+	created TIMESTAMP NOT NULL DEFAULT now(),
+	updated TIMESTAMP NOT NULL DEFAULT now(),
+
+	PRIMARY KEY(user_id),
+	CONSTRAINT fk_account
+		FOREIGN KEY(user_id)
+			REFERENCES s_account_accounts(user_id) ON DELETE CASCADE
+);
@@ -7,7 +7,7 @@ BEGIN
 	END IF;
 
 	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'venue') THEN
-		CREATE TYPE venue AS ENUM ('BINANCE', 'FTX', 'DERIBIT', 'BITFINEX');
+		CREATE TYPE venue AS ENUM ('BINANCE', 'FTX', 'DERIBIT', 'BITFINEX', 'PAPER');
 	END IF;
 
 	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'dca_strategy') THEN
@@ -42,6 +42,9 @@ CREATE TABLE IF NOT EXISTS s_account_accounts (
 
 	default_dca_strategy dca_strategy NOT NULL DEFAULT 'LINEAR',
 
+	-- percentages of the position closed by each take profit; empty if the account has no defaults.
+	default_take_profit_allocations DECIMAL[] NOT NULL DEFAULT '{}',
+
 	PRIMARY KEY(user_id)
 );
 
@@ -94,3 +97,26 @@ CREATE TABLE IF NOT EXISTS s_account_internal_venue_accounts (
 
 	UNIQUE(venue_id, subaccount, venue_account_type)
 );
+
+CREATE TABLE IF NOT EXISTS s_account_risk_profiles (
+	user_id VARCHAR(20) NOT NULL UNIQUE,
+
+	-- zero valued limits are treated as unlimited.
+	max_risk_per_trade DECIMAL NOT NULL DEFAULT 0,
+	max_concurrent_open_strategies INTEGER NOT NULL DEFAULT 0,
+	max_leverage DECIMAL NOT NULL DEFAULT 0,
+	max_notional_per_asset DECIMAL NOT NULL DEFAULT 0,
+	daily_loss_limit DECIMAL NOT NULL DEFAULT 0,
+	min_venue_balance DECIMAL NOT NULL DEFAULT 0,
+
+	-- empty allows all instruments.
+	instrument_allow_list TEXT[] NOT NULL DEFAULT '{}',
+
+	created TIMESTAMP NOT NULL DEFAULT now(),
+	updated TIMESTAMP NOT NULL DEFAULT now(),
+
+	PRIMARY KEY(user_id),
+	CONSTRAINT fk_account
+		FOREIGN KEY(user_id)
+			REFERENCES s_account_accounts(user_id) ON DELETE CASCADE
+);
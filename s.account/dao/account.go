@@ -136,8 +136,8 @@ func UpdateAccount(ctx context.Context, mutation *domain.Account) (*domain.Accou
 	var (
 		sql = `
 		UPDATE s_account_accounts
-		SET username=$1, email=$2, phone_number=$3, high_priority_pager=$4, low_priority_pager=$5, is_futures_member=$6, is_admin=$7, updated=$8, primary_venue=$9
-		WHERE user_id=$10`
+		SET username=$1, email=$2, phone_number=$3, high_priority_pager=$4, low_priority_pager=$5, is_futures_member=$6, is_admin=$7, updated=$8, primary_venue=$9, default_take_profit_allocations=$10
+		WHERE user_id=$11`
 	)
 	if mutation.UserID == "" {
 		return nil, terrors.PreconditionFailed("mutation-without-id", "Account mutation requires at least the account ID", nil)
@@ -165,6 +165,7 @@ func UpdateAccount(ctx context.Context, mutation *domain.Account) (*domain.Accou
 		account.IsAdmin,
 		account.Updated,
 		account.PrimaryVenue,
+		account.DefaultTakeProfitAllocations,
 		account.UserID,
 	)); err != nil {
 		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.account/domain"
+)
+
+// ReadRiskProfileByUserID ...
+func ReadRiskProfileByUserID(ctx context.Context, userID string) (*domain.RiskProfile, error) {
+	var (
+		sql = `
+		SELECT * FROM s_account_risk_profiles
+		WHERE user_id=$1
+		`
+		riskProfiles []*domain.RiskProfile
+	)
+
+	if err := db.Select(ctx, &riskProfiles, sql, userID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	switch len(riskProfiles) {
+	case 0:
+		return nil, gerrors.NotFound("risk_profile_not_found", nil)
+	default:
+		return riskProfiles[0], nil
+	}
+}
+
+// UpsertRiskProfile creates the risk profile, or replaces every limit of the users existing risk profile.
+func UpsertRiskProfile(ctx context.Context, riskProfile *domain.RiskProfile) (*domain.RiskProfile, error) {
+	var (
+		sql = `
+		INSERT INTO s_account_risk_profiles
+			(
+				user_id,
+				max_risk_per_trade,
+				max_concurrent_open_strategies,
+				max_leverage,
+				max_notional_per_asset,
+				daily_loss_limit,
+				min_venue_balance,
+				instrument_allow_list,
+				created,
+				updated
+			)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+		ON CONFLICT (user_id) DO UPDATE SET
+			max_risk_per_trade=EXCLUDED.max_risk_per_trade,
+			max_concurrent_open_strategies=EXCLUDED.max_concurrent_open_strategies,
+			max_leverage=EXCLUDED.max_leverage,
+			max_notional_per_asset=EXCLUDED.max_notional_per_asset,
+			daily_loss_limit=EXCLUDED.daily_loss_limit,
+			min_venue_balance=EXCLUDED.min_venue_balance,
+			instrument_allow_list=EXCLUDED.instrument_allow_list,
+			updated=EXCLUDED.updated
+		`
+		rp = riskProfile
+	)
+
+	if rp.InstrumentAllowList == nil {
+		rp.InstrumentAllowList = []string{}
+	}
+
+	if _, err := db.Exec(
+		ctx, sql,
+		rp.UserID, rp.MaxRiskPerTrade, rp.MaxConcurrentOpenStrategies, rp.MaxLeverage, rp.MaxNotionalPerAsset,
+		rp.DailyLossLimit, rp.MinVenueBalance, rp.InstrumentAllowList, time.Now().UTC(),
+	); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return ReadRiskProfileByUserID(ctx, rp.UserID)
+}
@@ -17,4 +17,6 @@ type Account struct {
 	IsAdmin              bool      `db:"is_admin"`
 	IsFuturesMember      bool      `db:"is_futures_member"`
 	DefaultDCAStrategy   string    `db:"default_dca_strategy"`
+	// DefaultTakeProfitAllocations are percentages summing to 100; empty if the account has none.
+	DefaultTakeProfitAllocations []float64 `db:"default_take_profit_allocations"`
 }
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// RiskProfile defines the per user limits enforced by the trade engine; zero valued limits are unlimited.
+type RiskProfile struct {
+	UserID                      string    `db:"user_id"`
+	MaxRiskPerTrade             float64   `db:"max_risk_per_trade"`
+	MaxConcurrentOpenStrategies int64     `db:"max_concurrent_open_strategies"`
+	MaxLeverage                 float64   `db:"max_leverage"`
+	MaxNotionalPerAsset         float64   `db:"max_notional_per_asset"`
+	DailyLossLimit              float64   `db:"daily_loss_limit"`
+	MinVenueBalance             float64   `db:"min_venue_balance"`
+	InstrumentAllowList         []string  `db:"instrument_allow_list"`
+	Created                     time.Time `db:"created"`
+	Updated                     time.Time `db:"updated"`
+}
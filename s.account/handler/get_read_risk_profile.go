@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.account/dao"
+	"swallowtail/s.account/marshaling"
+	accountproto "swallowtail/s.account/proto"
+)
+
+// ReadRiskProfile reads the users risk profile; falling back to the default risk profile if the user has none.
+func (s *AccountService) ReadRiskProfile(
+	ctx context.Context, in *accountproto.ReadRiskProfileRequest,
+) (*accountproto.ReadRiskProfileResponse, error) {
+	switch {
+	case in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	}
+
+	errParams := map[string]string{
+		"user_id":  in.UserId,
+		"actor_id": in.ActorId,
+	}
+
+	riskProfile, err := dao.ReadRiskProfileByUserID(ctx, in.UserId)
+	switch {
+	case gerrors.Is(err, gerrors.ErrNotFound, "risk_profile_not_found"):
+		return &accountproto.ReadRiskProfileResponse{
+			RiskProfile: marshaling.DefaultRiskProfile(in.UserId),
+			IsDefault:   true,
+		}, nil
+	case err != nil:
+		return nil, gerrors.Augment(err, "failed_to_read_risk_profile", errParams)
+	}
+
+	return &accountproto.ReadRiskProfileResponse{
+		RiskProfile: marshaling.RiskProfileDomainToProto(riskProfile),
+	}, nil
+}
@@ -62,7 +62,7 @@ func validateVenueAccountDetailsRequest(in *accountproto.ReadVenueAccountByVenue
 	}
 
 	switch in.Venue {
-	case tradeengineproto.VENUE_BINANCE, tradeengineproto.VENUE_FTX, tradeengineproto.VENUE_BITFINEX, tradeengineproto.VENUE_DERIBIT:
+	case tradeengineproto.VENUE_BINANCE, tradeengineproto.VENUE_FTX, tradeengineproto.VENUE_BITFINEX, tradeengineproto.VENUE_DERIBIT, tradeengineproto.VENUE_PAPER:
 	default:
 		return gerrors.Unimplemented("venue.unimplemented", nil)
 	}
@@ -63,6 +63,11 @@ func validateVenueAccount(venueAccount *accountproto.VenueAccount) error {
 		return gerrors.BadParam("missing_param.venue_account", nil)
 	}
 
+	// Paper venue accounts are simulated by the trade engine; there are no credentials to validate.
+	if venueAccount.Venue == tradeengineproto.VENUE_PAPER {
+		return nil
+	}
+
 	switch {
 	case venueAccount.ApiKey == "":
 		return gerrors.BadParam("missing_param.api_key", nil)
@@ -109,3 +114,29 @@ func validateInternalVenueAccount(internalVenueAccount *accountproto.InternalVen
 
 	return nil
 }
+
+func isValidRiskProfileActorID(actorID string) bool {
+	switch actorID {
+	case accountproto.ActorSystemSatoshi, accountproto.ActorManual:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateRiskProfile(riskProfile *accountproto.RiskProfile) error {
+	switch {
+	case riskProfile.MaxRiskPerTrade < 0,
+		riskProfile.MaxConcurrentOpenStrategies < 0,
+		riskProfile.MaxLeverage < 0,
+		riskProfile.MaxNotionalPerAsset < 0,
+		riskProfile.DailyLossLimit < 0,
+		riskProfile.MinVenueBalance < 0:
+		return gerrors.BadParam("bad_param.risk_profile.limits_cannot_be_negative", nil)
+	case riskProfile.MaxRiskPerTrade > accountproto.DefaultMaxRiskPerTrade:
+		// The trade engine never allows more than this; regardless of the users risk profile.
+		return gerrors.BadParam("bad_param.risk_profile.max_risk_per_trade_too_high", nil)
+	}
+
+	return nil
+}
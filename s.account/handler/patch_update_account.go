@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/risk"
 	"swallowtail/s.account/dao"
 	"swallowtail/s.account/marshaling"
 	accountproto "swallowtail/s.account/proto"
@@ -29,6 +30,10 @@ func (s *AccountService) UpdateAccount(
 		"user_id": in.UserId,
 	}
 
+	if err := risk.ValidateTakeProfitAllocations(in.DefaultTakeProfitAllocations); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_update_account", errParams)
+	}
+
 	// Create mutation in domain.
 	mutation := marshaling.UpdateAccountProtoToDomain(in)
 
@@ -43,6 +43,10 @@ func (s *AccountService) PageAccount(
 		return nil, terrors.Augment(err, "Cannot page user; missing identifier on account", errParams)
 	}
 
+	if in.DryRun {
+		return &accountproto.PageAccountResponse{}, nil
+	}
+
 	if err := pager.Page(ctx, identifier, in.Content); err != nil {
 		return nil, terrors.Augment(err, "Failed to page user", errParams)
 	}
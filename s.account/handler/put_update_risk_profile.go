@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.account/dao"
+	"swallowtail/s.account/marshaling"
+	accountproto "swallowtail/s.account/proto"
+)
+
+// UpdateRiskProfile replaces every limit of the users risk profile; creating it if the user doesn't yet have one.
+func (s *AccountService) UpdateRiskProfile(
+	ctx context.Context, in *accountproto.UpdateRiskProfileRequest,
+) (*accountproto.UpdateRiskProfileResponse, error) {
+	switch {
+	case in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isValidRiskProfileActorID(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_update_risk_profile.unauthorized", map[string]string{
+			"actor_id": in.ActorId,
+		})
+	case in.RiskProfile == nil:
+		return nil, gerrors.BadParam("missing_param.risk_profile", nil)
+	}
+
+	errParams := map[string]string{
+		"user_id":  in.UserId,
+		"actor_id": in.ActorId,
+	}
+
+	if err := validateRiskProfile(in.RiskProfile); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_update_risk_profile", errParams)
+	}
+
+	// Validate that the user first has an account registered.
+	_, err := dao.ReadAccountByUserID(ctx, in.UserId)
+	switch {
+	case gerrors.Is(err, gerrors.ErrNotFound, "account_not_found"):
+		return nil, gerrors.FailedPrecondition("failed_to_update_risk_profile.account_required", errParams)
+	case err != nil:
+		return nil, gerrors.Augment(err, "failed_to_update_risk_profile.dao.read_account", errParams)
+	}
+
+	riskProfile, err := dao.UpsertRiskProfile(ctx, marshaling.RiskProfileProtoToDomain(in.UserId, in.RiskProfile))
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_update_risk_profile", errParams)
+	}
+
+	slog.Info(ctx, "Updated risk profile for %s by %s: %+v", in.UserId, in.ActorId, riskProfile)
+
+	return &accountproto.UpdateRiskProfileResponse{
+		RiskProfile: marshaling.RiskProfileDomainToProto(riskProfile),
+	}, nil
+}
@@ -10,6 +10,8 @@ import (
 	"swallowtail/libraries/gerrors"
 	accountproto "swallowtail/s.account/proto"
 	binanceproto "swallowtail/s.binance/proto"
+	bitfinexproto "swallowtail/s.bitfinex/proto"
+	deribitproto "swallowtail/s.deribit/proto"
 	discordproto "swallowtail/s.discord/proto"
 	ftxproto "swallowtail/s.ftx/proto"
 	tradeengineproto "swallowtail/s.trade-engine/proto"
@@ -52,11 +54,14 @@ func validateVenueCredentials(ctx context.Context, userID string, venueAccount i
 	case tradeengineproto.VENUE_BINANCE:
 		return validateBinanceCredentials(ctx, userID, credentials)
 	case tradeengineproto.VENUE_BITFINEX:
-		return false, "", gerrors.Unimplemented("venue_unimplemented.bitfinex", nil)
+		return validateBitfinexCredentials(ctx, userID, credentials)
 	case tradeengineproto.VENUE_DERIBIT:
-		return false, "", gerrors.Unimplemented("venue_unimplemented.deribit", nil)
+		return validateDeribitCredentials(ctx, userID, credentials)
 	case tradeengineproto.VENUE_FTX:
 		return validateFTXCredentials(ctx, userID, credentials)
+	case tradeengineproto.VENUE_PAPER:
+		// Paper trading is simulated; there's nothing to validate.
+		return true, "", nil
 	default:
 		return false, "", gerrors.FailedPrecondition("failed_to_validate_credentials.invalid_venue_account", errParams)
 	}
@@ -74,6 +79,30 @@ func validateBinanceCredentials(ctx context.Context, userID string, venueCredent
 	return rsp.Success, rsp.Reason, nil
 }
 
+func validateBitfinexCredentials(ctx context.Context, userID string, venueCredentials *tradeengineproto.VenueCredentials) (bool, string, error) {
+	rsp, err := (&bitfinexproto.VerifyBitfinexCredentialsRequest{
+		UserId:      userID,
+		Credentials: venueCredentials,
+	}).SendWithTimeout(ctx, 30*time.Second).Response()
+	if err != nil {
+		return false, "", gerrors.Augment(err, "failed_to_validate_bitfinex_credentials", nil)
+	}
+
+	return rsp.Success, rsp.Reason, nil
+}
+
+func validateDeribitCredentials(ctx context.Context, userID string, venueCredentials *tradeengineproto.VenueCredentials) (bool, string, error) {
+	rsp, err := (&deribitproto.VerifyDeribitCredentialsRequest{
+		UserId:      userID,
+		Credentials: venueCredentials,
+	}).SendWithTimeout(ctx, 30*time.Second).Response()
+	if err != nil {
+		return false, "", gerrors.Augment(err, "failed_to_validate_deribit_credentials", nil)
+	}
+
+	return rsp.Success, rsp.Reason, nil
+}
+
 func validateFTXCredentials(ctx context.Context, userID string, venueCredentials *tradeengineproto.VenueCredentials) (bool, string, error) {
 	if _, err := (&ftxproto.ReadAccountInformationRequest{
 		Credentials: venueCredentials,
@@ -11,15 +11,16 @@ import (
 // AccountDomainToProto marshals an account domain object into the account proto definition.
 func AccountDomainToProto(account *domain.Account) *accountproto.Account {
 	return &accountproto.Account{
-		UserId:             account.UserID,
-		Username:           account.Username,
-		Email:              account.Email,
-		IsFuturesMember:    account.IsFuturesMember,
-		IsAdmin:            account.IsAdmin,
-		Created:            timestamppb.New(account.Created),
-		LastUpdated:        timestamppb.New(account.Updated),
-		PrimaryVenue:       account.PrimaryVenue,
-		DefaultDcaStrategy: account.DefaultDCAStrategy,
+		UserId:                       account.UserID,
+		Username:                     account.Username,
+		Email:                        account.Email,
+		IsFuturesMember:              account.IsFuturesMember,
+		IsAdmin:                      account.IsAdmin,
+		Created:                      timestamppb.New(account.Created),
+		LastUpdated:                  timestamppb.New(account.Updated),
+		PrimaryVenue:                 account.PrimaryVenue,
+		DefaultDcaStrategy:           account.DefaultDCAStrategy,
+		DefaultTakeProfitAllocations: float64sToFloat32s(account.DefaultTakeProfitAllocations),
 	}
 }
 
@@ -34,15 +35,42 @@ func UpdateAccountProtoToDomain(in *accountproto.UpdateAccountRequest) *domain.A
 	}
 
 	return &domain.Account{
-		UserID:             in.UserId,
-		Username:           in.Username,
-		Email:              in.Email,
-		PhoneNumber:        in.PhoneNumber,
-		HighPriorityPager:  in.HighPriorityPager.String(),
-		LowPriorityPager:   in.LowPriorityPager.String(),
-		IsFuturesMember:    in.IsFutures,
-		IsAdmin:            in.IsAdmin,
-		DefaultDCAStrategy: in.DefaultDcaStrategy,
-		PrimaryVenue:       venue,
+		UserID:                       in.UserId,
+		Username:                     in.Username,
+		Email:                        in.Email,
+		PhoneNumber:                  in.PhoneNumber,
+		HighPriorityPager:            in.HighPriorityPager.String(),
+		LowPriorityPager:             in.LowPriorityPager.String(),
+		IsFuturesMember:              in.IsFutures,
+		IsAdmin:                      in.IsAdmin,
+		DefaultDCAStrategy:           in.DefaultDcaStrategy,
+		PrimaryVenue:                 venue,
+		DefaultTakeProfitAllocations: float32sToFloat64s(in.DefaultTakeProfitAllocations),
 	}
 }
+
+func float64sToFloat32s(in []float64) []float32 {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]float32, 0, len(in))
+	for _, f := range in {
+		out = append(out, float32(f))
+	}
+
+	return out
+}
+
+func float32sToFloat64s(in []float32) []float64 {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]float64, 0, len(in))
+	for _, f := range in {
+		out = append(out, float64(f))
+	}
+
+	return out
+}
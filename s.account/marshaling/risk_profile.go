@@ -0,0 +1,56 @@
+package marshaling
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/s.account/domain"
+	accountproto "swallowtail/s.account/proto"
+)
+
+// RiskProfileDomainToProto marshals a risk profile domain object into the account proto definition.
+func RiskProfileDomainToProto(riskProfile *domain.RiskProfile) *accountproto.RiskProfile {
+	return &accountproto.RiskProfile{
+		UserId:                      riskProfile.UserID,
+		MaxRiskPerTrade:             float32(riskProfile.MaxRiskPerTrade),
+		MaxConcurrentOpenStrategies: riskProfile.MaxConcurrentOpenStrategies,
+		MaxLeverage:                 float32(riskProfile.MaxLeverage),
+		MaxNotionalPerAsset:         float32(riskProfile.MaxNotionalPerAsset),
+		DailyLossLimit:              float32(riskProfile.DailyLossLimit),
+		MinVenueBalance:             float32(riskProfile.MinVenueBalance),
+		InstrumentAllowList:         riskProfile.InstrumentAllowList,
+		LastUpdated:                 timestamppb.New(riskProfile.Updated),
+	}
+}
+
+// RiskProfileProtoToDomain marshals a risk profile proto into the domain; allow list entries are normalized to uppercase.
+func RiskProfileProtoToDomain(userID string, riskProfile *accountproto.RiskProfile) *domain.RiskProfile {
+	allowList := make([]string, 0, len(riskProfile.InstrumentAllowList))
+	for _, instrument := range riskProfile.InstrumentAllowList {
+		if instrument = strings.ToUpper(strings.TrimSpace(instrument)); instrument != "" {
+			allowList = append(allowList, instrument)
+		}
+	}
+
+	return &domain.RiskProfile{
+		UserID:                      userID,
+		MaxRiskPerTrade:             float64(riskProfile.MaxRiskPerTrade),
+		MaxConcurrentOpenStrategies: riskProfile.MaxConcurrentOpenStrategies,
+		MaxLeverage:                 float64(riskProfile.MaxLeverage),
+		MaxNotionalPerAsset:         float64(riskProfile.MaxNotionalPerAsset),
+		DailyLossLimit:              float64(riskProfile.DailyLossLimit),
+		MinVenueBalance:             float64(riskProfile.MinVenueBalance),
+		InstrumentAllowList:         allowList,
+	}
+}
+
+// DefaultRiskProfile returns the risk profile applied to users without one of their own.
+func DefaultRiskProfile(userID string) *accountproto.RiskProfile {
+	return &accountproto.RiskProfile{
+		UserId:          userID,
+		MaxRiskPerTrade: accountproto.DefaultMaxRiskPerTrade,
+		MaxLeverage:     accountproto.DefaultMaxLeverage,
+		MinVenueBalance: accountproto.DefaultMinVenueBalance,
+	}
+}
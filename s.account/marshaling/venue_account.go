@@ -207,6 +207,8 @@ func ConvertVenueIDToProto(venueID string) (tradeengineproto.VENUE, error) {
 		return tradeengineproto.VENUE_DERIBIT, nil
 	case tradeengineproto.VENUE_FTX.String():
 		return tradeengineproto.VENUE_FTX, nil
+	case tradeengineproto.VENUE_PAPER.String():
+		return tradeengineproto.VENUE_PAPER, nil
 	default:
 		return 0, gerrors.Unimplemented("unsupported_venue", map[string]string{
 			"venue_id": venueID,
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.26.0
-// 	protoc        v3.19.3
+// 	protoc        v3.19.2
 // source: s.account/proto/account.proto
 
 package accountproto
@@ -186,6 +186,8 @@ type Account struct {
 	LastPaymentTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_payment_timestamp,json=lastPaymentTimestamp,proto3" json:"last_payment_timestamp,omitempty"`
 	PrimaryVenue         string                 `protobuf:"bytes,9,opt,name=primary_venue,json=primaryVenue,proto3" json:"primary_venue,omitempty"`
 	DefaultDcaStrategy   string                 `protobuf:"bytes,10,opt,name=default_dca_strategy,json=defaultDcaStrategy,proto3" json:"default_dca_strategy,omitempty"`
+	// The percentage of the position each take profit closes when a trade strategy doesn't define its own allocations.
+	DefaultTakeProfitAllocations []float32 `protobuf:"fixed32,11,rep,packed,name=default_take_profit_allocations,json=defaultTakeProfitAllocations,proto3" json:"default_take_profit_allocations,omitempty"`
 }
 
 func (x *Account) Reset() {
@@ -290,6 +292,13 @@ func (x *Account) GetDefaultDcaStrategy() string {
 	return ""
 }
 
+func (x *Account) GetDefaultTakeProfitAllocations() []float32 {
+	if x != nil {
+		return x.DefaultTakeProfitAllocations
+	}
+	return nil
+}
+
 type VenueAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -504,6 +513,123 @@ func (x *InternalVenueAccount) GetVenueAccountType() VenueAccountType {
 	return VenueAccountType_TESTING
 }
 
+// RiskProfile defines the limits the trade engine enforces before placing any order on behalf of a user.
+// Limits with a zero value are treated as unlimited; an empty instrument allow list permits all instruments.
+type RiskProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Percentage of the venue balance that can be risked on a single trade strategy.
+	MaxRiskPerTrade             float32 `protobuf:"fixed32,2,opt,name=max_risk_per_trade,json=maxRiskPerTrade,proto3" json:"max_risk_per_trade,omitempty"`
+	MaxConcurrentOpenStrategies int64   `protobuf:"varint,3,opt,name=max_concurrent_open_strategies,json=maxConcurrentOpenStrategies,proto3" json:"max_concurrent_open_strategies,omitempty"`
+	MaxLeverage                 float32 `protobuf:"fixed32,4,opt,name=max_leverage,json=maxLeverage,proto3" json:"max_leverage,omitempty"`
+	// Notional, in the quote asset, across all open trade strategies of a single asset.
+	MaxNotionalPerAsset float32 `protobuf:"fixed32,5,opt,name=max_notional_per_asset,json=maxNotionalPerAsset,proto3" json:"max_notional_per_asset,omitempty"`
+	// Realized loss, in the quote asset, after which no new trade strategies are placed for the rest of the day (UTC).
+	DailyLossLimit  float32 `protobuf:"fixed32,6,opt,name=daily_loss_limit,json=dailyLossLimit,proto3" json:"daily_loss_limit,omitempty"`
+	MinVenueBalance float32 `protobuf:"fixed32,7,opt,name=min_venue_balance,json=minVenueBalance,proto3" json:"min_venue_balance,omitempty"`
+	// Either instruments (BTCUSDT) or assets (BTC).
+	InstrumentAllowList []string               `protobuf:"bytes,8,rep,name=instrument_allow_list,json=instrumentAllowList,proto3" json:"instrument_allow_list,omitempty"`
+	LastUpdated         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *RiskProfile) Reset() {
+	*x = RiskProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_account_proto_account_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RiskProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskProfile) ProtoMessage() {}
+
+func (x *RiskProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_s_account_proto_account_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskProfile.ProtoReflect.Descriptor instead.
+func (*RiskProfile) Descriptor() ([]byte, []int) {
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RiskProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RiskProfile) GetMaxRiskPerTrade() float32 {
+	if x != nil {
+		return x.MaxRiskPerTrade
+	}
+	return 0
+}
+
+func (x *RiskProfile) GetMaxConcurrentOpenStrategies() int64 {
+	if x != nil {
+		return x.MaxConcurrentOpenStrategies
+	}
+	return 0
+}
+
+func (x *RiskProfile) GetMaxLeverage() float32 {
+	if x != nil {
+		return x.MaxLeverage
+	}
+	return 0
+}
+
+func (x *RiskProfile) GetMaxNotionalPerAsset() float32 {
+	if x != nil {
+		return x.MaxNotionalPerAsset
+	}
+	return 0
+}
+
+func (x *RiskProfile) GetDailyLossLimit() float32 {
+	if x != nil {
+		return x.DailyLossLimit
+	}
+	return 0
+}
+
+func (x *RiskProfile) GetMinVenueBalance() float32 {
+	if x != nil {
+		return x.MinVenueBalance
+	}
+	return 0
+}
+
+func (x *RiskProfile) GetInstrumentAllowList() []string {
+	if x != nil {
+		return x.InstrumentAllowList
+	}
+	return nil
+}
+
+func (x *RiskProfile) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
 type ListAccountsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -515,7 +641,7 @@ type ListAccountsRequest struct {
 func (x *ListAccountsRequest) Reset() {
 	*x = ListAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[3]
+		mi := &file_s_account_proto_account_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -528,7 +654,7 @@ func (x *ListAccountsRequest) String() string {
 func (*ListAccountsRequest) ProtoMessage() {}
 
 func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[3]
+	mi := &file_s_account_proto_account_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,7 +667,7 @@ func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
 func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{3}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListAccountsRequest) GetIsFuturesMember() bool {
@@ -562,7 +688,7 @@ type ListAccountsResponse struct {
 func (x *ListAccountsResponse) Reset() {
 	*x = ListAccountsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[4]
+		mi := &file_s_account_proto_account_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -575,7 +701,7 @@ func (x *ListAccountsResponse) String() string {
 func (*ListAccountsResponse) ProtoMessage() {}
 
 func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[4]
+	mi := &file_s_account_proto_account_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,7 +714,7 @@ func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
 func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{4}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListAccountsResponse) GetAccounts() []*Account {
@@ -609,7 +735,7 @@ type ReadAccountRequest struct {
 func (x *ReadAccountRequest) Reset() {
 	*x = ReadAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[5]
+		mi := &file_s_account_proto_account_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -622,7 +748,7 @@ func (x *ReadAccountRequest) String() string {
 func (*ReadAccountRequest) ProtoMessage() {}
 
 func (x *ReadAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[5]
+	mi := &file_s_account_proto_account_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +761,7 @@ func (x *ReadAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAccountRequest.ProtoReflect.Descriptor instead.
 func (*ReadAccountRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{5}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ReadAccountRequest) GetUserId() string {
@@ -656,7 +782,7 @@ type ReadAccountResponse struct {
 func (x *ReadAccountResponse) Reset() {
 	*x = ReadAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[6]
+		mi := &file_s_account_proto_account_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -669,7 +795,7 @@ func (x *ReadAccountResponse) String() string {
 func (*ReadAccountResponse) ProtoMessage() {}
 
 func (x *ReadAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[6]
+	mi := &file_s_account_proto_account_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +808,7 @@ func (x *ReadAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAccountResponse.ProtoReflect.Descriptor instead.
 func (*ReadAccountResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{6}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ReadAccountResponse) GetAccount() *Account {
@@ -708,7 +834,7 @@ type CreateAccountRequest struct {
 func (x *CreateAccountRequest) Reset() {
 	*x = CreateAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[7]
+		mi := &file_s_account_proto_account_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -721,7 +847,7 @@ func (x *CreateAccountRequest) String() string {
 func (*CreateAccountRequest) ProtoMessage() {}
 
 func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[7]
+	mi := &file_s_account_proto_account_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,7 +860,7 @@ func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
 func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{7}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateAccountRequest) GetUserId() string {
@@ -788,7 +914,7 @@ type CreateAccountResponse struct {
 func (x *CreateAccountResponse) Reset() {
 	*x = CreateAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[8]
+		mi := &file_s_account_proto_account_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -801,7 +927,7 @@ func (x *CreateAccountResponse) String() string {
 func (*CreateAccountResponse) ProtoMessage() {}
 
 func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[8]
+	mi := &file_s_account_proto_account_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +940,7 @@ func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
 func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{8}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{9}
 }
 
 type UpdateAccountRequest struct {
@@ -833,12 +959,14 @@ type UpdateAccountRequest struct {
 	ActorId            string      `protobuf:"bytes,11,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 	DefaultDcaStrategy string      `protobuf:"bytes,12,opt,name=default_dca_strategy,json=defaultDcaStrategy,proto3" json:"default_dca_strategy,omitempty"`
 	PrimaryVenue       proto.VENUE `protobuf:"varint,13,opt,name=primary_venue,json=primaryVenue,proto3,enum=VENUE" json:"primary_venue,omitempty"`
+	// Must sum to 100.
+	DefaultTakeProfitAllocations []float32 `protobuf:"fixed32,14,rep,packed,name=default_take_profit_allocations,json=defaultTakeProfitAllocations,proto3" json:"default_take_profit_allocations,omitempty"`
 }
 
 func (x *UpdateAccountRequest) Reset() {
 	*x = UpdateAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[9]
+		mi := &file_s_account_proto_account_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -851,7 +979,7 @@ func (x *UpdateAccountRequest) String() string {
 func (*UpdateAccountRequest) ProtoMessage() {}
 
 func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[9]
+	mi := &file_s_account_proto_account_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +992,7 @@ func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{9}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateAccountRequest) GetUserId() string {
@@ -944,6 +1072,13 @@ func (x *UpdateAccountRequest) GetPrimaryVenue() proto.VENUE {
 	return proto.VENUE_UNREQUIRED
 }
 
+func (x *UpdateAccountRequest) GetDefaultTakeProfitAllocations() []float32 {
+	if x != nil {
+		return x.DefaultTakeProfitAllocations
+	}
+	return nil
+}
+
 type UpdateAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -955,7 +1090,7 @@ type UpdateAccountResponse struct {
 func (x *UpdateAccountResponse) Reset() {
 	*x = UpdateAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[10]
+		mi := &file_s_account_proto_account_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -968,7 +1103,7 @@ func (x *UpdateAccountResponse) String() string {
 func (*UpdateAccountResponse) ProtoMessage() {}
 
 func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[10]
+	mi := &file_s_account_proto_account_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1116,7 @@ func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAccountResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAccountResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{10}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateAccountResponse) GetAccount() *Account {
@@ -999,12 +1134,14 @@ type PageAccountRequest struct {
 	UserId   string        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Content  string        `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Priority PagerPriority `protobuf:"varint,3,opt,name=priority,proto3,enum=PagerPriority" json:"priority,omitempty"`
+	// Validates the account can be paged at the priority, without paging it.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *PageAccountRequest) Reset() {
 	*x = PageAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[11]
+		mi := &file_s_account_proto_account_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1017,7 +1154,7 @@ func (x *PageAccountRequest) String() string {
 func (*PageAccountRequest) ProtoMessage() {}
 
 func (x *PageAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[11]
+	mi := &file_s_account_proto_account_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,7 +1167,7 @@ func (x *PageAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageAccountRequest.ProtoReflect.Descriptor instead.
 func (*PageAccountRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{11}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PageAccountRequest) GetUserId() string {
@@ -1054,6 +1191,13 @@ func (x *PageAccountRequest) GetPriority() PagerPriority {
 	return PagerPriority_HIGH
 }
 
+func (x *PageAccountRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type PageAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1063,7 +1207,7 @@ type PageAccountResponse struct {
 func (x *PageAccountResponse) Reset() {
 	*x = PageAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[12]
+		mi := &file_s_account_proto_account_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1076,7 +1220,7 @@ func (x *PageAccountResponse) String() string {
 func (*PageAccountResponse) ProtoMessage() {}
 
 func (x *PageAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[12]
+	mi := &file_s_account_proto_account_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +1233,7 @@ func (x *PageAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PageAccountResponse.ProtoReflect.Descriptor instead.
 func (*PageAccountResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{12}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{13}
 }
 
 type AddVenueAccountRequest struct {
@@ -1105,7 +1249,7 @@ type AddVenueAccountRequest struct {
 func (x *AddVenueAccountRequest) Reset() {
 	*x = AddVenueAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[13]
+		mi := &file_s_account_proto_account_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1118,7 +1262,7 @@ func (x *AddVenueAccountRequest) String() string {
 func (*AddVenueAccountRequest) ProtoMessage() {}
 
 func (x *AddVenueAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[13]
+	mi := &file_s_account_proto_account_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1275,7 @@ func (x *AddVenueAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddVenueAccountRequest.ProtoReflect.Descriptor instead.
 func (*AddVenueAccountRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{13}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AddVenueAccountRequest) GetVenueAccount() *VenueAccount {
@@ -1168,7 +1312,7 @@ type AddVenueAccountResponse struct {
 func (x *AddVenueAccountResponse) Reset() {
 	*x = AddVenueAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[14]
+		mi := &file_s_account_proto_account_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1181,7 +1325,7 @@ func (x *AddVenueAccountResponse) String() string {
 func (*AddVenueAccountResponse) ProtoMessage() {}
 
 func (x *AddVenueAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[14]
+	mi := &file_s_account_proto_account_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1194,7 +1338,7 @@ func (x *AddVenueAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddVenueAccountResponse.ProtoReflect.Descriptor instead.
 func (*AddVenueAccountResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{14}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AddVenueAccountResponse) GetVenueAccount() *VenueAccount {
@@ -1232,7 +1376,7 @@ type CreateOrUpdateInternalVenueAccountRequest struct {
 func (x *CreateOrUpdateInternalVenueAccountRequest) Reset() {
 	*x = CreateOrUpdateInternalVenueAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[15]
+		mi := &file_s_account_proto_account_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1245,7 +1389,7 @@ func (x *CreateOrUpdateInternalVenueAccountRequest) String() string {
 func (*CreateOrUpdateInternalVenueAccountRequest) ProtoMessage() {}
 
 func (x *CreateOrUpdateInternalVenueAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[15]
+	mi := &file_s_account_proto_account_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +1402,7 @@ func (x *CreateOrUpdateInternalVenueAccountRequest) ProtoReflect() protoreflect.
 
 // Deprecated: Use CreateOrUpdateInternalVenueAccountRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrUpdateInternalVenueAccountRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{15}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateOrUpdateInternalVenueAccountRequest) GetInternalVenueAccount() *InternalVenueAccount {
@@ -1302,7 +1446,7 @@ type CreateOrUpdateInternalVenueAccountResponse struct {
 func (x *CreateOrUpdateInternalVenueAccountResponse) Reset() {
 	*x = CreateOrUpdateInternalVenueAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[16]
+		mi := &file_s_account_proto_account_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1315,7 +1459,7 @@ func (x *CreateOrUpdateInternalVenueAccountResponse) String() string {
 func (*CreateOrUpdateInternalVenueAccountResponse) ProtoMessage() {}
 
 func (x *CreateOrUpdateInternalVenueAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[16]
+	mi := &file_s_account_proto_account_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +1472,7 @@ func (x *CreateOrUpdateInternalVenueAccountResponse) ProtoReflect() protoreflect
 
 // Deprecated: Use CreateOrUpdateInternalVenueAccountResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrUpdateInternalVenueAccountResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{16}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateOrUpdateInternalVenueAccountResponse) GetInternalVenueAccount() *InternalVenueAccount {
@@ -1366,7 +1510,7 @@ type ListVenueAccountsRequest struct {
 func (x *ListVenueAccountsRequest) Reset() {
 	*x = ListVenueAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[17]
+		mi := &file_s_account_proto_account_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1379,7 +1523,7 @@ func (x *ListVenueAccountsRequest) String() string {
 func (*ListVenueAccountsRequest) ProtoMessage() {}
 
 func (x *ListVenueAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[17]
+	mi := &file_s_account_proto_account_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +1536,7 @@ func (x *ListVenueAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVenueAccountsRequest.ProtoReflect.Descriptor instead.
 func (*ListVenueAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{17}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListVenueAccountsRequest) GetUserId() string {
@@ -1434,7 +1578,7 @@ type ListVenueAccountsResponse struct {
 func (x *ListVenueAccountsResponse) Reset() {
 	*x = ListVenueAccountsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[18]
+		mi := &file_s_account_proto_account_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1447,7 +1591,7 @@ func (x *ListVenueAccountsResponse) String() string {
 func (*ListVenueAccountsResponse) ProtoMessage() {}
 
 func (x *ListVenueAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[18]
+	mi := &file_s_account_proto_account_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1460,7 +1604,7 @@ func (x *ListVenueAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVenueAccountsResponse.ProtoReflect.Descriptor instead.
 func (*ListVenueAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{18}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListVenueAccountsResponse) GetVenueAccounts() []*VenueAccount {
@@ -1481,7 +1625,7 @@ type ReadVenueAccountByVenueAccountIDRequest struct {
 func (x *ReadVenueAccountByVenueAccountIDRequest) Reset() {
 	*x = ReadVenueAccountByVenueAccountIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[19]
+		mi := &file_s_account_proto_account_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1494,7 +1638,7 @@ func (x *ReadVenueAccountByVenueAccountIDRequest) String() string {
 func (*ReadVenueAccountByVenueAccountIDRequest) ProtoMessage() {}
 
 func (x *ReadVenueAccountByVenueAccountIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[19]
+	mi := &file_s_account_proto_account_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,7 +1651,7 @@ func (x *ReadVenueAccountByVenueAccountIDRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ReadVenueAccountByVenueAccountIDRequest.ProtoReflect.Descriptor instead.
 func (*ReadVenueAccountByVenueAccountIDRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{19}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReadVenueAccountByVenueAccountIDRequest) GetVenueAccountId() string {
@@ -1528,7 +1672,7 @@ type ReadVenueAccountByVenueAccountIDResponse struct {
 func (x *ReadVenueAccountByVenueAccountIDResponse) Reset() {
 	*x = ReadVenueAccountByVenueAccountIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[20]
+		mi := &file_s_account_proto_account_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1541,7 +1685,7 @@ func (x *ReadVenueAccountByVenueAccountIDResponse) String() string {
 func (*ReadVenueAccountByVenueAccountIDResponse) ProtoMessage() {}
 
 func (x *ReadVenueAccountByVenueAccountIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[20]
+	mi := &file_s_account_proto_account_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1554,7 +1698,7 @@ func (x *ReadVenueAccountByVenueAccountIDResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ReadVenueAccountByVenueAccountIDResponse.ProtoReflect.Descriptor instead.
 func (*ReadVenueAccountByVenueAccountIDResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{20}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ReadVenueAccountByVenueAccountIDResponse) GetVenueAccount() *VenueAccount {
@@ -1576,7 +1720,7 @@ type ReadPrimaryVenueAccountByUserIDRequest struct {
 func (x *ReadPrimaryVenueAccountByUserIDRequest) Reset() {
 	*x = ReadPrimaryVenueAccountByUserIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[21]
+		mi := &file_s_account_proto_account_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1589,7 +1733,7 @@ func (x *ReadPrimaryVenueAccountByUserIDRequest) String() string {
 func (*ReadPrimaryVenueAccountByUserIDRequest) ProtoMessage() {}
 
 func (x *ReadPrimaryVenueAccountByUserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[21]
+	mi := &file_s_account_proto_account_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,7 +1746,7 @@ func (x *ReadPrimaryVenueAccountByUserIDRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use ReadPrimaryVenueAccountByUserIDRequest.ProtoReflect.Descriptor instead.
 func (*ReadPrimaryVenueAccountByUserIDRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{21}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ReadPrimaryVenueAccountByUserIDRequest) GetUserId() string {
@@ -1630,7 +1774,7 @@ type ReadPrimaryVenueAccountByUserIDResponse struct {
 func (x *ReadPrimaryVenueAccountByUserIDResponse) Reset() {
 	*x = ReadPrimaryVenueAccountByUserIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[22]
+		mi := &file_s_account_proto_account_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1643,7 +1787,7 @@ func (x *ReadPrimaryVenueAccountByUserIDResponse) String() string {
 func (*ReadPrimaryVenueAccountByUserIDResponse) ProtoMessage() {}
 
 func (x *ReadPrimaryVenueAccountByUserIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[22]
+	mi := &file_s_account_proto_account_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1656,7 +1800,7 @@ func (x *ReadPrimaryVenueAccountByUserIDResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use ReadPrimaryVenueAccountByUserIDResponse.ProtoReflect.Descriptor instead.
 func (*ReadPrimaryVenueAccountByUserIDResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{22}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ReadPrimaryVenueAccountByUserIDResponse) GetPrimaryVenueAccount() *VenueAccount {
@@ -1682,7 +1826,7 @@ type ReadVenueAccountByVenueAccountDetailsRequest struct {
 func (x *ReadVenueAccountByVenueAccountDetailsRequest) Reset() {
 	*x = ReadVenueAccountByVenueAccountDetailsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[23]
+		mi := &file_s_account_proto_account_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1695,7 +1839,7 @@ func (x *ReadVenueAccountByVenueAccountDetailsRequest) String() string {
 func (*ReadVenueAccountByVenueAccountDetailsRequest) ProtoMessage() {}
 
 func (x *ReadVenueAccountByVenueAccountDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[23]
+	mi := &file_s_account_proto_account_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1708,7 +1852,7 @@ func (x *ReadVenueAccountByVenueAccountDetailsRequest) ProtoReflect() protorefle
 
 // Deprecated: Use ReadVenueAccountByVenueAccountDetailsRequest.ProtoReflect.Descriptor instead.
 func (*ReadVenueAccountByVenueAccountDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{23}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ReadVenueAccountByVenueAccountDetailsRequest) GetVenue() proto.VENUE {
@@ -1764,7 +1908,7 @@ type ReadVenueAccountByVenueAccountDetailsResponse struct {
 func (x *ReadVenueAccountByVenueAccountDetailsResponse) Reset() {
 	*x = ReadVenueAccountByVenueAccountDetailsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[24]
+		mi := &file_s_account_proto_account_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1777,7 +1921,7 @@ func (x *ReadVenueAccountByVenueAccountDetailsResponse) String() string {
 func (*ReadVenueAccountByVenueAccountDetailsResponse) ProtoMessage() {}
 
 func (x *ReadVenueAccountByVenueAccountDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[24]
+	mi := &file_s_account_proto_account_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1790,7 +1934,7 @@ func (x *ReadVenueAccountByVenueAccountDetailsResponse) ProtoReflect() protorefl
 
 // Deprecated: Use ReadVenueAccountByVenueAccountDetailsResponse.ProtoReflect.Descriptor instead.
 func (*ReadVenueAccountByVenueAccountDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{24}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ReadVenueAccountByVenueAccountDetailsResponse) GetVenueAccount() *VenueAccount {
@@ -1814,7 +1958,7 @@ type ReadInternalVenueAccountRequest struct {
 func (x *ReadInternalVenueAccountRequest) Reset() {
 	*x = ReadInternalVenueAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[25]
+		mi := &file_s_account_proto_account_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1827,7 +1971,7 @@ func (x *ReadInternalVenueAccountRequest) String() string {
 func (*ReadInternalVenueAccountRequest) ProtoMessage() {}
 
 func (x *ReadInternalVenueAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[25]
+	mi := &file_s_account_proto_account_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +1984,7 @@ func (x *ReadInternalVenueAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadInternalVenueAccountRequest.ProtoReflect.Descriptor instead.
 func (*ReadInternalVenueAccountRequest) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{25}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ReadInternalVenueAccountRequest) GetVenue() proto.VENUE {
@@ -1882,7 +2026,7 @@ type ReadInternalVenueAccountResponse struct {
 func (x *ReadInternalVenueAccountResponse) Reset() {
 	*x = ReadInternalVenueAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_account_proto_account_proto_msgTypes[26]
+		mi := &file_s_account_proto_account_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1895,7 +2039,7 @@ func (x *ReadInternalVenueAccountResponse) String() string {
 func (*ReadInternalVenueAccountResponse) ProtoMessage() {}
 
 func (x *ReadInternalVenueAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_account_proto_account_proto_msgTypes[26]
+	mi := &file_s_account_proto_account_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,7 +2052,7 @@ func (x *ReadInternalVenueAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadInternalVenueAccountResponse.ProtoReflect.Descriptor instead.
 func (*ReadInternalVenueAccountResponse) Descriptor() ([]byte, []int) {
-	return file_s_account_proto_account_proto_rawDescGZIP(), []int{26}
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ReadInternalVenueAccountResponse) GetInternalVenueAccount() *InternalVenueAccount {
@@ -1918,34 +2062,255 @@ func (x *ReadInternalVenueAccountResponse) GetInternalVenueAccount() *InternalVe
 	return nil
 }
 
-var File_s_account_proto_account_proto protoreflect.FileDescriptor
+type ReadRiskProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_s_account_proto_account_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x26, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x03, 0x0a, 0x07, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65,
-	0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
-	0x69, 0x73, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
-	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x16, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+}
+
+func (x *ReadRiskProfileRequest) Reset() {
+	*x = ReadRiskProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_account_proto_account_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRiskProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRiskProfileRequest) ProtoMessage() {}
+
+func (x *ReadRiskProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_account_proto_account_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRiskProfileRequest.ProtoReflect.Descriptor instead.
+func (*ReadRiskProfileRequest) Descriptor() ([]byte, []int) {
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReadRiskProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReadRiskProfileRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ReadRiskProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RiskProfile *RiskProfile `protobuf:"bytes,1,opt,name=risk_profile,json=riskProfile,proto3" json:"risk_profile,omitempty"`
+	// Set if the user has no risk profile stored; in which case the default profile is returned.
+	IsDefault bool `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+}
+
+func (x *ReadRiskProfileResponse) Reset() {
+	*x = ReadRiskProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_account_proto_account_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRiskProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRiskProfileResponse) ProtoMessage() {}
+
+func (x *ReadRiskProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_account_proto_account_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRiskProfileResponse.ProtoReflect.Descriptor instead.
+func (*ReadRiskProfileResponse) Descriptor() ([]byte, []int) {
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReadRiskProfileResponse) GetRiskProfile() *RiskProfile {
+	if x != nil {
+		return x.RiskProfile
+	}
+	return nil
+}
+
+func (x *ReadRiskProfileResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type UpdateRiskProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string       `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId     string       `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	RiskProfile *RiskProfile `protobuf:"bytes,3,opt,name=risk_profile,json=riskProfile,proto3" json:"risk_profile,omitempty"`
+}
+
+func (x *UpdateRiskProfileRequest) Reset() {
+	*x = UpdateRiskProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_account_proto_account_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRiskProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRiskProfileRequest) ProtoMessage() {}
+
+func (x *UpdateRiskProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_account_proto_account_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRiskProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRiskProfileRequest) Descriptor() ([]byte, []int) {
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateRiskProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateRiskProfileRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *UpdateRiskProfileRequest) GetRiskProfile() *RiskProfile {
+	if x != nil {
+		return x.RiskProfile
+	}
+	return nil
+}
+
+type UpdateRiskProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RiskProfile *RiskProfile `protobuf:"bytes,1,opt,name=risk_profile,json=riskProfile,proto3" json:"risk_profile,omitempty"`
+}
+
+func (x *UpdateRiskProfileResponse) Reset() {
+	*x = UpdateRiskProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_account_proto_account_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRiskProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRiskProfileResponse) ProtoMessage() {}
+
+func (x *UpdateRiskProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_account_proto_account_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRiskProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRiskProfileResponse) Descriptor() ([]byte, []int) {
+	return file_s_account_proto_account_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateRiskProfileResponse) GetRiskProfile() *RiskProfile {
+	if x != nil {
+		return x.RiskProfile
+	}
+	return nil
+}
+
+var File_s_account_proto_account_proto protoreflect.FileDescriptor
+
+var file_s_account_proto_account_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x26, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x04, 0x0a, 0x07, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x69, 0x73, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x16, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x79,
@@ -1955,316 +2320,388 @@ var file_s_account_proto_account_proto_rawDesc = []byte{
 	0x75, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x63,
 	0x61, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x63, 0x61, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x22, 0x9a, 0x02, 0x0a, 0x0c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x05, 0x76, 0x65,
-	0x6e, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x75, 0x62, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x75, 0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x6e,
-	0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x77, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x77, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x69, 0x61,
-	0x73, 0x22, 0xa1, 0x02, 0x0a, 0x14, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65,
-	0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65,
-	0x6e, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55,
-	0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65,
-	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x6e,
-	0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x15, 0x0a,
-	0x06, 0x77, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x77,
-	0x73, 0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a, 0x12, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x10, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11,
-	0x69, 0x73, 0x5f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x46, 0x75, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x24, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x45, 0x0a, 0x1f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x02, 0x52, 0x1c, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x0c,
+	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x05,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45,
+	0x4e, 0x55, 0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70,
+	0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x15, 0x0a, 0x06,
+	0x77, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x77, 0x73,
+	0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xa1, 0x02, 0x0a, 0x14, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75,
+	0x62, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x77, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x77, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a, 0x12, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb9, 0x03, 0x0a,
+	0x0b, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07,
 	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0xfa, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x13, 0x68, 0x69, 0x67, 0x68, 0x5f,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x11, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61,
-	0x67, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x12, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0a, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x6c, 0x6f, 0x77,
-	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x67, 0x65, 0x72, 0x22, 0x17, 0x0a,
-	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x03, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x69, 0x73,
+	0x6b, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x65, 0x72, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x12, 0x43, 0x0a, 0x1e, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61,
+	0x78, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x4e,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x4c, 0x6f, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e,
+	0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x46, 0x75,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a,
-	0x13, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x11, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x67, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x12, 0x6c, 0x6f, 0x77,
-	0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x10, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61,
-	0x67, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1d,
-	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x19, 0x0a,
-	0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x5f, 0x64, 0x63, 0x61, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44,
-	0x63, 0x61, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2b, 0x0a, 0x0d, 0x70, 0x72,
-	0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x22, 0x3b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x22, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x15, 0x0a, 0x13, 0x50, 0x61, 0x67,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x80, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0d, 0x76,
-	0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x0c, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64,
 	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xfa, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x13, 0x68, 0x69,
+	0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x11, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x50, 0x61, 0x67, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x12, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10,
+	0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x67, 0x65, 0x72,
+	0x22, 0x17, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf5, 0x03, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x3a, 0x0a, 0x13, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x11, 0x68, 0x69, 0x67, 0x68, 0x50,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x67, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x12,
+	0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x50, 0x61, 0x67, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x63, 0x61, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x44, 0x63, 0x61, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2b, 0x0a,
+	0x0d, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x0c, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x1f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x02, 0x52, 0x1c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x6b, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x3b, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8c,
+	0x01, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x15, 0x0a,
+	0x13, 0x50, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x56, 0x65, 0x6e, 0x75,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x32, 0x0a, 0x0d, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xd1, 0x01, 0x0a, 0x29, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x14, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x2a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x16, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x14, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65,
+	0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x65, 0x6e,
+	0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x76, 0x65, 0x6e, 0x75, 0x65,
 	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66,
 	0x69, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x18,
-	0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e,
-	0x6c, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a,
-	0x19, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x5f, 0x63,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x17, 0x77, 0x69, 0x74, 0x68, 0x55, 0x6e, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x51, 0x0a, 0x19, 0x4c, 0x69, 0x73,
-	0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x76,
-	0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x27,
-	0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x6e, 0x75, 0x65,
-	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x64, 0x22, 0x5e, 0x0a, 0x28, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
-	0x0d, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x0c, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x5c, 0x0a, 0x26, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xd1, 0x01, 0x0a, 0x29,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x16, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x14, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0xad, 0x01, 0x0a, 0x2a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
+	0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x14, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0xab, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x3a, 0x0a, 0x19, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x73, 0x6b,
+	0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x77, 0x69, 0x74, 0x68, 0x55, 0x6e, 0x6d, 0x61, 0x73, 0x6b,
+	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x51, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0e, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x0d, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x22, 0x53, 0x0a, 0x27, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x28, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e,
+	0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x0d, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x26, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x27, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x15, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x13, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xee, 0x01, 0x0a, 0x2c, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x22, 0x63, 0x0a, 0x2d, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x65, 0x6e,
+	0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x61, 0x64,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e,
+	0x55, 0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x12, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75,
+	0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x20, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x16, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x14, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x52, 0x69,
+	0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x52, 0x69, 0x73, 0x6b,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x0c, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x0b, 0x72, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22,
+	0x7f, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
 	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
 	0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22,
-	0x6c, 0x0a, 0x27, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x65,
-	0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x15, 0x70, 0x72,
-	0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x65, 0x6e, 0x75,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
-	0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xee, 0x01,
-	0x0a, 0x2c, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e,
-	0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
-	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x63,
-	0x0a, 0x2d, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x32, 0x0a, 0x0d, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x1f, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x05,
-	0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x12, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49,
-	0x64, 0x22, 0x6f, 0x0a, 0x20, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x14, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x2a, 0x37, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x4d, 0x53, 0x10, 0x02,
-	0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x2a, 0x22, 0x0a, 0x0d, 0x50,
-	0x61, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04,
-	0x48, 0x49, 0x47, 0x48, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x2a,
-	0x3a, 0x0a, 0x10, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a,
-	0x08, 0x54, 0x52, 0x45, 0x41, 0x53, 0x55, 0x52, 0x59, 0x10, 0x02, 0x32, 0xbc, 0x08, 0x0a, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x13, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x50, 0x61, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x2f, 0x0a, 0x0c, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x0b, 0x72, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x22, 0x4c, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x0c, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x0b, 0x72, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2a, 0x37,
+	0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44,
+	0x49, 0x53, 0x43, 0x4f, 0x52, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x48, 0x4f, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x4d, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x2a, 0x22, 0x0a, 0x0d, 0x50, 0x61, 0x67, 0x65, 0x72,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47, 0x48,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x2a, 0x3a, 0x0a, 0x10, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x54, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x45,
+	0x41, 0x53, 0x55, 0x52, 0x59, 0x10, 0x02, 0x32, 0xd2, 0x09, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x15, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x41, 0x64, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x41, 0x64, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x22, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2a, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x43,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x13, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x0f, 0x41, 0x64, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x17, 0x2e, 0x41, 0x64, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x41, 0x64, 0x64,
+	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x22, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74,
 	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x4c,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x4c,
 	0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x12, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x20, 0x52, 0x65, 0x61,
-	0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56,
-	0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x28, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65,
-	0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x88, 0x01, 0x0a, 0x25, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65,
+	0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x20, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e,
 	0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2d,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x76, 0x0a, 0x1f, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x65,
-	0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x27, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x73, 0x77,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x73, 0x2e, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x28, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65,
+	0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x88, 0x01, 0x0a, 0x25, 0x52, 0x65, 0x61, 0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x56, 0x65,
+	0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x1f, 0x52,
+	0x65, 0x61, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x27,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x65, 0x6e, 0x75,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x65,
+	0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x56, 0x65, 0x6e, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x52, 0x69,
+	0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x19, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x73, 0x6b,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28,
+	0x73, 0x77, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x73, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2280,7 +2717,7 @@ func file_s_account_proto_account_proto_rawDescGZIP() []byte {
 }
 
 var file_s_account_proto_account_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_s_account_proto_account_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_s_account_proto_account_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_s_account_proto_account_proto_goTypes = []interface{}{
 	(PagerType)(0),                                        // 0: PagerType
 	(PagerPriority)(0),                                    // 1: PagerPriority
@@ -2288,90 +2725,103 @@ var file_s_account_proto_account_proto_goTypes = []interface{}{
 	(*Account)(nil),                                       // 3: Account
 	(*VenueAccount)(nil),                                  // 4: VenueAccount
 	(*InternalVenueAccount)(nil),                          // 5: InternalVenueAccount
-	(*ListAccountsRequest)(nil),                           // 6: ListAccountsRequest
-	(*ListAccountsResponse)(nil),                          // 7: ListAccountsResponse
-	(*ReadAccountRequest)(nil),                            // 8: ReadAccountRequest
-	(*ReadAccountResponse)(nil),                           // 9: ReadAccountResponse
-	(*CreateAccountRequest)(nil),                          // 10: CreateAccountRequest
-	(*CreateAccountResponse)(nil),                         // 11: CreateAccountResponse
-	(*UpdateAccountRequest)(nil),                          // 12: UpdateAccountRequest
-	(*UpdateAccountResponse)(nil),                         // 13: UpdateAccountResponse
-	(*PageAccountRequest)(nil),                            // 14: PageAccountRequest
-	(*PageAccountResponse)(nil),                           // 15: PageAccountResponse
-	(*AddVenueAccountRequest)(nil),                        // 16: AddVenueAccountRequest
-	(*AddVenueAccountResponse)(nil),                       // 17: AddVenueAccountResponse
-	(*CreateOrUpdateInternalVenueAccountRequest)(nil),     // 18: CreateOrUpdateInternalVenueAccountRequest
-	(*CreateOrUpdateInternalVenueAccountResponse)(nil),    // 19: CreateOrUpdateInternalVenueAccountResponse
-	(*ListVenueAccountsRequest)(nil),                      // 20: ListVenueAccountsRequest
-	(*ListVenueAccountsResponse)(nil),                     // 21: ListVenueAccountsResponse
-	(*ReadVenueAccountByVenueAccountIDRequest)(nil),       // 22: ReadVenueAccountByVenueAccountIDRequest
-	(*ReadVenueAccountByVenueAccountIDResponse)(nil),      // 23: ReadVenueAccountByVenueAccountIDResponse
-	(*ReadPrimaryVenueAccountByUserIDRequest)(nil),        // 24: ReadPrimaryVenueAccountByUserIDRequest
-	(*ReadPrimaryVenueAccountByUserIDResponse)(nil),       // 25: ReadPrimaryVenueAccountByUserIDResponse
-	(*ReadVenueAccountByVenueAccountDetailsRequest)(nil),  // 26: ReadVenueAccountByVenueAccountDetailsRequest
-	(*ReadVenueAccountByVenueAccountDetailsResponse)(nil), // 27: ReadVenueAccountByVenueAccountDetailsResponse
-	(*ReadInternalVenueAccountRequest)(nil),               // 28: ReadInternalVenueAccountRequest
-	(*ReadInternalVenueAccountResponse)(nil),              // 29: ReadInternalVenueAccountResponse
-	(*timestamppb.Timestamp)(nil),                         // 30: google.protobuf.Timestamp
-	(proto.VENUE)(0),                                      // 31: VENUE
+	(*RiskProfile)(nil),                                   // 6: RiskProfile
+	(*ListAccountsRequest)(nil),                           // 7: ListAccountsRequest
+	(*ListAccountsResponse)(nil),                          // 8: ListAccountsResponse
+	(*ReadAccountRequest)(nil),                            // 9: ReadAccountRequest
+	(*ReadAccountResponse)(nil),                           // 10: ReadAccountResponse
+	(*CreateAccountRequest)(nil),                          // 11: CreateAccountRequest
+	(*CreateAccountResponse)(nil),                         // 12: CreateAccountResponse
+	(*UpdateAccountRequest)(nil),                          // 13: UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),                         // 14: UpdateAccountResponse
+	(*PageAccountRequest)(nil),                            // 15: PageAccountRequest
+	(*PageAccountResponse)(nil),                           // 16: PageAccountResponse
+	(*AddVenueAccountRequest)(nil),                        // 17: AddVenueAccountRequest
+	(*AddVenueAccountResponse)(nil),                       // 18: AddVenueAccountResponse
+	(*CreateOrUpdateInternalVenueAccountRequest)(nil),     // 19: CreateOrUpdateInternalVenueAccountRequest
+	(*CreateOrUpdateInternalVenueAccountResponse)(nil),    // 20: CreateOrUpdateInternalVenueAccountResponse
+	(*ListVenueAccountsRequest)(nil),                      // 21: ListVenueAccountsRequest
+	(*ListVenueAccountsResponse)(nil),                     // 22: ListVenueAccountsResponse
+	(*ReadVenueAccountByVenueAccountIDRequest)(nil),       // 23: ReadVenueAccountByVenueAccountIDRequest
+	(*ReadVenueAccountByVenueAccountIDResponse)(nil),      // 24: ReadVenueAccountByVenueAccountIDResponse
+	(*ReadPrimaryVenueAccountByUserIDRequest)(nil),        // 25: ReadPrimaryVenueAccountByUserIDRequest
+	(*ReadPrimaryVenueAccountByUserIDResponse)(nil),       // 26: ReadPrimaryVenueAccountByUserIDResponse
+	(*ReadVenueAccountByVenueAccountDetailsRequest)(nil),  // 27: ReadVenueAccountByVenueAccountDetailsRequest
+	(*ReadVenueAccountByVenueAccountDetailsResponse)(nil), // 28: ReadVenueAccountByVenueAccountDetailsResponse
+	(*ReadInternalVenueAccountRequest)(nil),               // 29: ReadInternalVenueAccountRequest
+	(*ReadInternalVenueAccountResponse)(nil),              // 30: ReadInternalVenueAccountResponse
+	(*ReadRiskProfileRequest)(nil),                        // 31: ReadRiskProfileRequest
+	(*ReadRiskProfileResponse)(nil),                       // 32: ReadRiskProfileResponse
+	(*UpdateRiskProfileRequest)(nil),                      // 33: UpdateRiskProfileRequest
+	(*UpdateRiskProfileResponse)(nil),                     // 34: UpdateRiskProfileResponse
+	(*timestamppb.Timestamp)(nil),                         // 35: google.protobuf.Timestamp
+	(proto.VENUE)(0),                                      // 36: VENUE
 }
 var file_s_account_proto_account_proto_depIdxs = []int32{
-	30, // 0: Account.created:type_name -> google.protobuf.Timestamp
-	30, // 1: Account.last_updated:type_name -> google.protobuf.Timestamp
-	30, // 2: Account.last_payment_timestamp:type_name -> google.protobuf.Timestamp
-	31, // 3: VenueAccount.venue:type_name -> VENUE
-	31, // 4: InternalVenueAccount.venue:type_name -> VENUE
+	35, // 0: Account.created:type_name -> google.protobuf.Timestamp
+	35, // 1: Account.last_updated:type_name -> google.protobuf.Timestamp
+	35, // 2: Account.last_payment_timestamp:type_name -> google.protobuf.Timestamp
+	36, // 3: VenueAccount.venue:type_name -> VENUE
+	36, // 4: InternalVenueAccount.venue:type_name -> VENUE
 	2,  // 5: InternalVenueAccount.venue_account_type:type_name -> VenueAccountType
-	3,  // 6: ListAccountsResponse.accounts:type_name -> Account
-	3,  // 7: ReadAccountResponse.account:type_name -> Account
-	0,  // 8: CreateAccountRequest.high_priority_pager:type_name -> PagerType
-	0,  // 9: CreateAccountRequest.low_priority_pager:type_name -> PagerType
-	0,  // 10: UpdateAccountRequest.high_priority_pager:type_name -> PagerType
-	0,  // 11: UpdateAccountRequest.low_priority_pager:type_name -> PagerType
-	31, // 12: UpdateAccountRequest.primary_venue:type_name -> VENUE
-	3,  // 13: UpdateAccountResponse.account:type_name -> Account
-	1,  // 14: PageAccountRequest.priority:type_name -> PagerPriority
-	4,  // 15: AddVenueAccountRequest.venue_account:type_name -> VenueAccount
-	4,  // 16: AddVenueAccountResponse.venue_account:type_name -> VenueAccount
-	5,  // 17: CreateOrUpdateInternalVenueAccountRequest.internal_venue_account:type_name -> InternalVenueAccount
-	5,  // 18: CreateOrUpdateInternalVenueAccountResponse.internal_venue_account:type_name -> InternalVenueAccount
-	4,  // 19: ListVenueAccountsResponse.venue_accounts:type_name -> VenueAccount
-	4,  // 20: ReadVenueAccountByVenueAccountIDResponse.venue_account:type_name -> VenueAccount
-	4,  // 21: ReadPrimaryVenueAccountByUserIDResponse.primary_venue_account:type_name -> VenueAccount
-	31, // 22: ReadVenueAccountByVenueAccountDetailsRequest.venue:type_name -> VENUE
-	4,  // 23: ReadVenueAccountByVenueAccountDetailsResponse.venue_account:type_name -> VenueAccount
-	31, // 24: ReadInternalVenueAccountRequest.venue:type_name -> VENUE
-	2,  // 25: ReadInternalVenueAccountRequest.venue_account_type:type_name -> VenueAccountType
-	5,  // 26: ReadInternalVenueAccountResponse.internal_venue_account:type_name -> InternalVenueAccount
-	6,  // 27: account.ListAccounts:input_type -> ListAccountsRequest
-	8,  // 28: account.ReadAccount:input_type -> ReadAccountRequest
-	10, // 29: account.CreateAccount:input_type -> CreateAccountRequest
-	12, // 30: account.UpdateAccount:input_type -> UpdateAccountRequest
-	14, // 31: account.PageAccount:input_type -> PageAccountRequest
-	16, // 32: account.AddVenueAccount:input_type -> AddVenueAccountRequest
-	18, // 33: account.CreateOrUpdateInternalVenueAccount:input_type -> CreateOrUpdateInternalVenueAccountRequest
-	20, // 34: account.ListVenueAccounts:input_type -> ListVenueAccountsRequest
-	22, // 35: account.ReadVenueAccountByVenueAccountID:input_type -> ReadVenueAccountByVenueAccountIDRequest
-	26, // 36: account.ReadVenueAccountByVenueAccountDetails:input_type -> ReadVenueAccountByVenueAccountDetailsRequest
-	24, // 37: account.ReadPrimaryVenueAccountByUserID:input_type -> ReadPrimaryVenueAccountByUserIDRequest
-	28, // 38: account.ReadInternalVenueAccount:input_type -> ReadInternalVenueAccountRequest
-	7,  // 39: account.ListAccounts:output_type -> ListAccountsResponse
-	9,  // 40: account.ReadAccount:output_type -> ReadAccountResponse
-	11, // 41: account.CreateAccount:output_type -> CreateAccountResponse
-	13, // 42: account.UpdateAccount:output_type -> UpdateAccountResponse
-	15, // 43: account.PageAccount:output_type -> PageAccountResponse
-	17, // 44: account.AddVenueAccount:output_type -> AddVenueAccountResponse
-	19, // 45: account.CreateOrUpdateInternalVenueAccount:output_type -> CreateOrUpdateInternalVenueAccountResponse
-	21, // 46: account.ListVenueAccounts:output_type -> ListVenueAccountsResponse
-	23, // 47: account.ReadVenueAccountByVenueAccountID:output_type -> ReadVenueAccountByVenueAccountIDResponse
-	27, // 48: account.ReadVenueAccountByVenueAccountDetails:output_type -> ReadVenueAccountByVenueAccountDetailsResponse
-	25, // 49: account.ReadPrimaryVenueAccountByUserID:output_type -> ReadPrimaryVenueAccountByUserIDResponse
-	29, // 50: account.ReadInternalVenueAccount:output_type -> ReadInternalVenueAccountResponse
-	39, // [39:51] is the sub-list for method output_type
-	27, // [27:39] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	35, // 6: RiskProfile.last_updated:type_name -> google.protobuf.Timestamp
+	3,  // 7: ListAccountsResponse.accounts:type_name -> Account
+	3,  // 8: ReadAccountResponse.account:type_name -> Account
+	0,  // 9: CreateAccountRequest.high_priority_pager:type_name -> PagerType
+	0,  // 10: CreateAccountRequest.low_priority_pager:type_name -> PagerType
+	0,  // 11: UpdateAccountRequest.high_priority_pager:type_name -> PagerType
+	0,  // 12: UpdateAccountRequest.low_priority_pager:type_name -> PagerType
+	36, // 13: UpdateAccountRequest.primary_venue:type_name -> VENUE
+	3,  // 14: UpdateAccountResponse.account:type_name -> Account
+	1,  // 15: PageAccountRequest.priority:type_name -> PagerPriority
+	4,  // 16: AddVenueAccountRequest.venue_account:type_name -> VenueAccount
+	4,  // 17: AddVenueAccountResponse.venue_account:type_name -> VenueAccount
+	5,  // 18: CreateOrUpdateInternalVenueAccountRequest.internal_venue_account:type_name -> InternalVenueAccount
+	5,  // 19: CreateOrUpdateInternalVenueAccountResponse.internal_venue_account:type_name -> InternalVenueAccount
+	4,  // 20: ListVenueAccountsResponse.venue_accounts:type_name -> VenueAccount
+	4,  // 21: ReadVenueAccountByVenueAccountIDResponse.venue_account:type_name -> VenueAccount
+	4,  // 22: ReadPrimaryVenueAccountByUserIDResponse.primary_venue_account:type_name -> VenueAccount
+	36, // 23: ReadVenueAccountByVenueAccountDetailsRequest.venue:type_name -> VENUE
+	4,  // 24: ReadVenueAccountByVenueAccountDetailsResponse.venue_account:type_name -> VenueAccount
+	36, // 25: ReadInternalVenueAccountRequest.venue:type_name -> VENUE
+	2,  // 26: ReadInternalVenueAccountRequest.venue_account_type:type_name -> VenueAccountType
+	5,  // 27: ReadInternalVenueAccountResponse.internal_venue_account:type_name -> InternalVenueAccount
+	6,  // 28: ReadRiskProfileResponse.risk_profile:type_name -> RiskProfile
+	6,  // 29: UpdateRiskProfileRequest.risk_profile:type_name -> RiskProfile
+	6,  // 30: UpdateRiskProfileResponse.risk_profile:type_name -> RiskProfile
+	7,  // 31: account.ListAccounts:input_type -> ListAccountsRequest
+	9,  // 32: account.ReadAccount:input_type -> ReadAccountRequest
+	11, // 33: account.CreateAccount:input_type -> CreateAccountRequest
+	13, // 34: account.UpdateAccount:input_type -> UpdateAccountRequest
+	15, // 35: account.PageAccount:input_type -> PageAccountRequest
+	17, // 36: account.AddVenueAccount:input_type -> AddVenueAccountRequest
+	19, // 37: account.CreateOrUpdateInternalVenueAccount:input_type -> CreateOrUpdateInternalVenueAccountRequest
+	21, // 38: account.ListVenueAccounts:input_type -> ListVenueAccountsRequest
+	23, // 39: account.ReadVenueAccountByVenueAccountID:input_type -> ReadVenueAccountByVenueAccountIDRequest
+	27, // 40: account.ReadVenueAccountByVenueAccountDetails:input_type -> ReadVenueAccountByVenueAccountDetailsRequest
+	25, // 41: account.ReadPrimaryVenueAccountByUserID:input_type -> ReadPrimaryVenueAccountByUserIDRequest
+	29, // 42: account.ReadInternalVenueAccount:input_type -> ReadInternalVenueAccountRequest
+	31, // 43: account.ReadRiskProfile:input_type -> ReadRiskProfileRequest
+	33, // 44: account.UpdateRiskProfile:input_type -> UpdateRiskProfileRequest
+	8,  // 45: account.ListAccounts:output_type -> ListAccountsResponse
+	10, // 46: account.ReadAccount:output_type -> ReadAccountResponse
+	12, // 47: account.CreateAccount:output_type -> CreateAccountResponse
+	14, // 48: account.UpdateAccount:output_type -> UpdateAccountResponse
+	16, // 49: account.PageAccount:output_type -> PageAccountResponse
+	18, // 50: account.AddVenueAccount:output_type -> AddVenueAccountResponse
+	20, // 51: account.CreateOrUpdateInternalVenueAccount:output_type -> CreateOrUpdateInternalVenueAccountResponse
+	22, // 52: account.ListVenueAccounts:output_type -> ListVenueAccountsResponse
+	24, // 53: account.ReadVenueAccountByVenueAccountID:output_type -> ReadVenueAccountByVenueAccountIDResponse
+	28, // 54: account.ReadVenueAccountByVenueAccountDetails:output_type -> ReadVenueAccountByVenueAccountDetailsResponse
+	26, // 55: account.ReadPrimaryVenueAccountByUserID:output_type -> ReadPrimaryVenueAccountByUserIDResponse
+	30, // 56: account.ReadInternalVenueAccount:output_type -> ReadInternalVenueAccountResponse
+	32, // 57: account.ReadRiskProfile:output_type -> ReadRiskProfileResponse
+	34, // 58: account.UpdateRiskProfile:output_type -> UpdateRiskProfileResponse
+	45, // [45:59] is the sub-list for method output_type
+	31, // [31:45] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_s_account_proto_account_proto_init() }
@@ -2417,7 +2867,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsRequest); i {
+			switch v := v.(*RiskProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2429,7 +2879,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsResponse); i {
+			switch v := v.(*ListAccountsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2441,7 +2891,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAccountRequest); i {
+			switch v := v.(*ListAccountsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2453,7 +2903,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAccountResponse); i {
+			switch v := v.(*ReadAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2465,7 +2915,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAccountRequest); i {
+			switch v := v.(*ReadAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2477,7 +2927,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAccountResponse); i {
+			switch v := v.(*CreateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2489,7 +2939,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAccountRequest); i {
+			switch v := v.(*CreateAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2501,7 +2951,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAccountResponse); i {
+			switch v := v.(*UpdateAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2513,7 +2963,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PageAccountRequest); i {
+			switch v := v.(*UpdateAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2525,7 +2975,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PageAccountResponse); i {
+			switch v := v.(*PageAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2537,7 +2987,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddVenueAccountRequest); i {
+			switch v := v.(*PageAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2549,7 +2999,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddVenueAccountResponse); i {
+			switch v := v.(*AddVenueAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2561,7 +3011,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrUpdateInternalVenueAccountRequest); i {
+			switch v := v.(*AddVenueAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2573,7 +3023,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOrUpdateInternalVenueAccountResponse); i {
+			switch v := v.(*CreateOrUpdateInternalVenueAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2585,7 +3035,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVenueAccountsRequest); i {
+			switch v := v.(*CreateOrUpdateInternalVenueAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2597,7 +3047,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVenueAccountsResponse); i {
+			switch v := v.(*ListVenueAccountsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2609,7 +3059,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadVenueAccountByVenueAccountIDRequest); i {
+			switch v := v.(*ListVenueAccountsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2621,7 +3071,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadVenueAccountByVenueAccountIDResponse); i {
+			switch v := v.(*ReadVenueAccountByVenueAccountIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2633,7 +3083,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadPrimaryVenueAccountByUserIDRequest); i {
+			switch v := v.(*ReadVenueAccountByVenueAccountIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2645,7 +3095,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadPrimaryVenueAccountByUserIDResponse); i {
+			switch v := v.(*ReadPrimaryVenueAccountByUserIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2657,7 +3107,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadVenueAccountByVenueAccountDetailsRequest); i {
+			switch v := v.(*ReadPrimaryVenueAccountByUserIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2669,7 +3119,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadVenueAccountByVenueAccountDetailsResponse); i {
+			switch v := v.(*ReadVenueAccountByVenueAccountDetailsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2681,7 +3131,7 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadInternalVenueAccountRequest); i {
+			switch v := v.(*ReadVenueAccountByVenueAccountDetailsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2693,6 +3143,18 @@ func file_s_account_proto_account_proto_init() {
 			}
 		}
 		file_s_account_proto_account_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadInternalVenueAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_account_proto_account_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadInternalVenueAccountResponse); i {
 			case 0:
 				return &v.state
@@ -2704,6 +3166,54 @@ func file_s_account_proto_account_proto_init() {
 				return nil
 			}
 		}
+		file_s_account_proto_account_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRiskProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_account_proto_account_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRiskProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_account_proto_account_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRiskProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_account_proto_account_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRiskProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2711,7 +3221,7 @@ func file_s_account_proto_account_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_s_account_proto_account_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   27,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
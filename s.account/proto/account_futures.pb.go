@@ -640,3 +640,149 @@ func (r *ListVenueAccountsRequest) SendWithTimeout(ctx context.Context, timeout
 		resultc: resultc,
 	}
 }
+
+// --- Read Risk Profile --- //
+
+type ReadRiskProfileFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ReadRiskProfileResponse
+	ctx     context.Context
+}
+
+func (a *ReadRiskProfileFuture) Response() (*ReadRiskProfileResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "read_risk_profile", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ReadRiskProfileRequest) Send(ctx context.Context) *ReadRiskProfileFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ReadRiskProfileRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ReadRiskProfileFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ReadRiskProfileResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-account:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_account_connection_failed", nil)
+		return &ReadRiskProfileFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewAccountClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ReadRiskProfile(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_read_risk_profile", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ReadRiskProfileFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Update Risk Profile --- //
+
+type UpdateRiskProfileFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *UpdateRiskProfileResponse
+	ctx     context.Context
+}
+
+func (a *UpdateRiskProfileFuture) Response() (*UpdateRiskProfileResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "update_risk_profile", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *UpdateRiskProfileRequest) Send(ctx context.Context) *UpdateRiskProfileFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *UpdateRiskProfileRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *UpdateRiskProfileFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *UpdateRiskProfileResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-account:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_account_connection_failed", nil)
+		return &UpdateRiskProfileFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewAccountClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.UpdateRiskProfile(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_update_risk_profile", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &UpdateRiskProfileFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
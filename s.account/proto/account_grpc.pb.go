@@ -32,6 +32,9 @@ type AccountClient interface {
 	ReadVenueAccountByVenueAccountDetails(ctx context.Context, in *ReadVenueAccountByVenueAccountDetailsRequest, opts ...grpc.CallOption) (*ReadVenueAccountByVenueAccountDetailsResponse, error)
 	ReadPrimaryVenueAccountByUserID(ctx context.Context, in *ReadPrimaryVenueAccountByUserIDRequest, opts ...grpc.CallOption) (*ReadPrimaryVenueAccountByUserIDResponse, error)
 	ReadInternalVenueAccount(ctx context.Context, in *ReadInternalVenueAccountRequest, opts ...grpc.CallOption) (*ReadInternalVenueAccountResponse, error)
+	/// --- Risk Profile --- ///
+	ReadRiskProfile(ctx context.Context, in *ReadRiskProfileRequest, opts ...grpc.CallOption) (*ReadRiskProfileResponse, error)
+	UpdateRiskProfile(ctx context.Context, in *UpdateRiskProfileRequest, opts ...grpc.CallOption) (*UpdateRiskProfileResponse, error)
 }
 
 type accountClient struct {
@@ -150,6 +153,24 @@ func (c *accountClient) ReadInternalVenueAccount(ctx context.Context, in *ReadIn
 	return out, nil
 }
 
+func (c *accountClient) ReadRiskProfile(ctx context.Context, in *ReadRiskProfileRequest, opts ...grpc.CallOption) (*ReadRiskProfileResponse, error) {
+	out := new(ReadRiskProfileResponse)
+	err := c.cc.Invoke(ctx, "/account/ReadRiskProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) UpdateRiskProfile(ctx context.Context, in *UpdateRiskProfileRequest, opts ...grpc.CallOption) (*UpdateRiskProfileResponse, error) {
+	out := new(UpdateRiskProfileResponse)
+	err := c.cc.Invoke(ctx, "/account/UpdateRiskProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServer is the server API for Account service.
 // All implementations must embed UnimplementedAccountServer
 // for forward compatibility
@@ -168,6 +189,9 @@ type AccountServer interface {
 	ReadVenueAccountByVenueAccountDetails(context.Context, *ReadVenueAccountByVenueAccountDetailsRequest) (*ReadVenueAccountByVenueAccountDetailsResponse, error)
 	ReadPrimaryVenueAccountByUserID(context.Context, *ReadPrimaryVenueAccountByUserIDRequest) (*ReadPrimaryVenueAccountByUserIDResponse, error)
 	ReadInternalVenueAccount(context.Context, *ReadInternalVenueAccountRequest) (*ReadInternalVenueAccountResponse, error)
+	/// --- Risk Profile --- ///
+	ReadRiskProfile(context.Context, *ReadRiskProfileRequest) (*ReadRiskProfileResponse, error)
+	UpdateRiskProfile(context.Context, *UpdateRiskProfileRequest) (*UpdateRiskProfileResponse, error)
 	mustEmbedUnimplementedAccountServer()
 }
 
@@ -211,6 +235,12 @@ func (UnimplementedAccountServer) ReadPrimaryVenueAccountByUserID(context.Contex
 func (UnimplementedAccountServer) ReadInternalVenueAccount(context.Context, *ReadInternalVenueAccountRequest) (*ReadInternalVenueAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadInternalVenueAccount not implemented")
 }
+func (UnimplementedAccountServer) ReadRiskProfile(context.Context, *ReadRiskProfileRequest) (*ReadRiskProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadRiskProfile not implemented")
+}
+func (UnimplementedAccountServer) UpdateRiskProfile(context.Context, *UpdateRiskProfileRequest) (*UpdateRiskProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRiskProfile not implemented")
+}
 func (UnimplementedAccountServer) mustEmbedUnimplementedAccountServer() {}
 
 // UnsafeAccountServer may be embedded to opt out of forward compatibility for this service.
@@ -440,6 +470,42 @@ func _Account_ReadInternalVenueAccount_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Account_ReadRiskProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRiskProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).ReadRiskProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account/ReadRiskProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).ReadRiskProfile(ctx, req.(*ReadRiskProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_UpdateRiskProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRiskProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).UpdateRiskProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account/UpdateRiskProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).UpdateRiskProfile(ctx, req.(*UpdateRiskProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Account_ServiceDesc is the grpc.ServiceDesc for Account service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -495,6 +561,14 @@ var Account_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadInternalVenueAccount",
 			Handler:    _Account_ReadInternalVenueAccount_Handler,
 		},
+		{
+			MethodName: "ReadRiskProfile",
+			Handler:    _Account_ReadRiskProfile_Handler,
+		},
+		{
+			MethodName: "UpdateRiskProfile",
+			Handler:    _Account_UpdateRiskProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "s.account/proto/account.proto",
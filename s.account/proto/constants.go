@@ -17,3 +17,15 @@ const (
 	// SubAccountUnknown defines the constant used for exchanges that don't support subaccounts.
 	SubAccountUnknown = "UNKNOWN"
 )
+
+const (
+	// Valid actor IDs for updating a users risk profile.
+	ActorSystemSatoshi = "actor-system-satoshi"
+)
+
+const (
+	// Default risk profile; applied to any user without a risk profile of their own.
+	DefaultMaxRiskPerTrade = 50
+	DefaultMaxLeverage     = 10.5 // +0.5 to act as a buffer.
+	DefaultMinVenueBalance = 100
+)
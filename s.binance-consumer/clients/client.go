@@ -4,9 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"swallowtail/libraries/gerrors"
 	"swallowtail/libraries/multiplexing"
 	"swallowtail/libraries/transport"
-	"swallowtail/s.binance/domain"
+	"swallowtail/s.binance-consumer/domain"
 	"sync"
 	"time"
 
@@ -21,6 +22,9 @@ var (
 	heartbeatPeriod  = 3 * time.Minute
 	heartbeatTimeout = 5 * time.Second // Best effort
 
+	// reconnectBackoff is how long we wait before reconnecting after the stream drops.
+	reconnectBackoff = 5 * time.Second
+
 	// Default Pongs
 	heartbeatPong = &domain.BinanceStreamPong{}
 
@@ -51,14 +55,26 @@ type StreamClient struct {
 	done         chan struct{}
 }
 
-func NewStreamingClient(ctx context.Context, instrument string, multiplexers []*multiplexing.Multiplex) *StreamClient {
+// NewStreamingClient connects to the Binance stream of the instrument, i.e `btcusdt@kline_1m`, multiplexing its events
+// onto the multiplexers given.
+func NewStreamingClient(ctx context.Context, instrument string, multiplexers []*multiplexing.Multiplex) (*StreamClient, error) {
 	if ok, err := validateInstrument(instrument); !ok {
-		panic(fmt.Sprintf("Invalid instrument: %s", err.Error()))
+		return nil, gerrors.Augment(err, "invalid_instrument", map[string]string{
+			"instrument": instrument,
+		})
 	}
 
 	option, err := parseOptionFromInstrument(instrument)
 	if err != nil {
-		panic(err.Error())
+		return nil, gerrors.Augment(err, "invalid_instrument", map[string]string{
+			"instrument": instrument,
+		})
+	}
+
+	if _, ok := constructortMapping[option]; !ok {
+		return nil, gerrors.Unimplemented("unsupported_instrument", map[string]string{
+			"instrument": instrument,
+		})
 	}
 
 	endpoint, _ := buildStreamEndpoint(streamURI, instrument)
@@ -69,8 +85,16 @@ func NewStreamingClient(ctx context.Context, instrument string, multiplexers []*
 		Endpoint: endpoint,
 		BufSize:  bufSize,
 	}
+
+	ws, err := transport.DialWebsocket(ctx, cfg)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_connect_to_binance_stream", map[string]string{
+			"instrument": instrument,
+		})
+	}
+
 	c := &StreamClient{
-		ws:           transport.StreamingJSONTransport(ctx, cfg),
+		ws:           ws,
 		wsCfg:        cfg,
 		wsMtx:        sync.Mutex{},
 		multiplexers: multiplexers,
@@ -80,15 +104,12 @@ func NewStreamingClient(ctx context.Context, instrument string, multiplexers []*
 	}
 	errCh := c.Start(ctx, bufSize)
 	c.errCh = errCh
-	return c
+	return c, nil
 }
 
 func (c *StreamClient) Start(ctx context.Context, bufSize int) chan error {
 	// Pull constructor method to build event before marshalling.
-	eventConstructor, ok := constructortMapping[c.option]
-	if !ok {
-		panic(fmt.Sprintf("Cannot handle events for instrument: %s", c.instrument))
-	}
+	eventConstructor := constructortMapping[c.option]
 
 	// Start reciever websocket receiver
 	ch, errCh := c.ws.Receiver(ctx)
@@ -98,40 +119,53 @@ func (c *StreamClient) Start(ctx context.Context, bufSize int) chan error {
 	// < 24 hour ticker, since Binance cancels after 24; thus we must reconnect.
 	t := time.NewTicker(time.Hour * 23)
 
+	stop := func() {
+		t.Stop()
+		for _, m := range c.multiplexers {
+			m.Stop()
+		}
+		c.getWs().StopReceiver()
+	}
+
 	// Multiplex messages from websocket stream to consumers
 	go func() {
 		defer slog.Info(nil, "Client stopped.")
 		for {
 			select {
-			case rmsg := <-ch:
+			case rmsg, ok := <-ch:
+				if !ok {
+					// The receiver stops if the connection fails; so we reconnect.
+					slog.Warn(ctx, "Binance stream dropped; reconnecting: %s", c.instrument)
+					if ch, errCh = c.reconnect(ctx); ch == nil {
+						stop()
+						return
+					}
+					continue
+				}
+
 				e, err := domain.WsMsgToBinanceEvent(rmsg, eventConstructor)
 				if err != nil {
-					errCh <- err
+					slog.Warn(ctx, "Failed to unmarshal binance event: %v", err)
+					continue
 				}
 				eventCh <- e
-			case err := <-errCh:
-				// Just print to console for now before we have a logger DI.
-				slog.Info(ctx, "Message failed: %s", err.Error())
-			case <-c.done:
-				for _, m := range c.multiplexers {
-					m.Stop()
+			case err, ok := <-errCh:
+				if ok {
+					// Just print to console for now before we have a logger DI.
+					slog.Info(ctx, "Message failed: %s", err.Error())
 				}
-				c.ws.StopReceiver()
+			case <-c.done:
+				stop()
 				return
 			case <-ctx.Done():
-				for _, m := range c.multiplexers {
-					m.Stop()
-				}
-				c.ws.StopReceiver()
+				stop()
 				return
 			case <-t.C:
 				slog.Info(ctx, "Reconnecting websocket before binance closes")
-				c.wsMtx.Lock()
-				c.ws = transport.NewWebsocket(ctx, c.wsCfg)
-				// Arbitary wait to allow for reconnection. We probably want a much better way of doing this here.
-				// We also want to pause other goroutines here as well. This is bad practise.
-				time.Sleep(time.Second * 2)
-				c.wsMtx.Unlock()
+				if ch, errCh = c.reconnect(ctx); ch == nil {
+					stop()
+					return
+				}
 			}
 		}
 	}()
@@ -143,6 +177,39 @@ func (c *StreamClient) Start(ctx context.Context, bufSize int) chan error {
 	return errCh
 }
 
+// reconnect replaces the websocket with a new connection, retrying until the context is done; in which case it returns
+// nil channels.
+func (c *StreamClient) reconnect(ctx context.Context) (chan *transport.WsMessage, chan error) {
+	c.getWs().StopReceiver()
+
+	for {
+		ws, err := transport.DialWebsocket(ctx, c.wsCfg)
+		if err == nil {
+			c.wsMtx.Lock()
+			c.ws = ws
+			c.wsMtx.Unlock()
+
+			return ws.Receiver(ctx)
+		}
+
+		slog.Warn(ctx, "Failed to reconnect to binance stream: %s, Error: %v", c.instrument, err)
+
+		select {
+		case <-time.After(reconnectBackoff):
+		case <-ctx.Done():
+			return nil, nil
+		case <-c.done:
+			return nil, nil
+		}
+	}
+}
+
+func (c *StreamClient) getWs() transport.StreamingJSONTransport {
+	c.wsMtx.Lock()
+	defer c.wsMtx.Unlock()
+	return c.ws
+}
+
 func (c *StreamClient) Stop() {
 	slog.Info(nil, "Stopping client...")
 	c.done <- struct{}{}
@@ -159,8 +226,8 @@ func (c *StreamClient) heartbeat(ctx context.Context) {
 		select {
 		case <-t.C:
 			slog.Info(ctx, "Sending heartbeat pong")
-			c.ws.Send(
-				nil,
+			c.getWs().Send(
+				ctx,
 				&transport.WsMessage{
 					Type:    websocket.PongMessage,
 					Raw:     []byte("--heartbeat--"),
@@ -58,6 +58,7 @@ type BinanceKlineEventData struct {
 	LastTradeID     int    `json:"L"`
 	OpenPrice       string `json:"o"`
 	ClosePrice      string `json:"c"`
+	HighPrice       string `json:"h"`
 	LowPrice        string `json:"l"`
 	BaseAssetVolume string `json:"v"`
 	NumberOfTrade   int    `json:"n"`
@@ -49,6 +49,12 @@ type BinanceClient interface {
 	// ExecutePerpetualFuturesOrder attempts to execute a spot trade on Binance.
 	ExecutePerpetualFuturesOrder(ctx context.Context, req *ExecutePerpetualFuturesOrderRequest, credentials *Credentials) (*ExecutePerpetualFuturesOrderResponse, error)
 
+	// ReadPerpetualFuturesOrder reads the current state of a perpetual futures order on Binance.
+	ReadPerpetualFuturesOrder(ctx context.Context, req *ReadPerpetualFuturesOrderRequest, credentials *Credentials) (*ReadPerpetualFuturesOrderResponse, error)
+
+	// CancelPerpetualFuturesOrder cancels a resting perpetual futures order on Binance.
+	CancelPerpetualFuturesOrder(ctx context.Context, req *CancelPerpetualFuturesOrderRequest, credentials *Credentials) (*CancelPerpetualFuturesOrderResponse, error)
+
 	// Ping serves as a healthcheck to the Binance API.
 	Ping(context.Context) error
 
@@ -69,6 +75,18 @@ type BinanceClient interface {
 
 	// GetStatus returns the statistics round the exchange server time & latency.
 	GetStatus(context.Context) (*GetStatusResponse, error)
+
+	// ListKlines returns the historical klines (candlesticks) for a perpetual futures symbol.
+	ListKlines(context.Context, *ListKlinesRequest) (*ListKlinesResponse, error)
+
+	// ReadPerpetualFuturesAccountInformation reads the margin & PnL of the users perpetual futures account.
+	ReadPerpetualFuturesAccountInformation(context.Context, *Credentials) (*ReadPerpetualFuturesAccountInformationResponse, error)
+
+	// ListPerpetualFuturesPositionRisk lists the positions of the users perpetual futures account; for every symbol.
+	ListPerpetualFuturesPositionRisk(context.Context, *Credentials) (*ListPerpetualFuturesPositionRiskResponse, error)
+
+	// ListPerpetualFuturesIncome lists the income history of the users perpetual futures account.
+	ListPerpetualFuturesIncome(context.Context, *ListPerpetualFuturesIncomeRequest, *Credentials) (*ListPerpetualFuturesIncomeResponse, error)
 }
 
 // Init initializes the default binance client for this service.
@@ -142,6 +160,20 @@ func ExecutePerpetualFuturesOrder(ctx context.Context, req *ExecutePerpetualFutu
 	return client.ExecutePerpetualFuturesOrder(ctx, req, credentials)
 }
 
+// ReadPerpetualFuturesOrder ...
+func ReadPerpetualFuturesOrder(ctx context.Context, req *ReadPerpetualFuturesOrderRequest, credentials *Credentials) (*ReadPerpetualFuturesOrderResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Read perpetual futures order on binance")
+	defer span.Finish()
+	return client.ReadPerpetualFuturesOrder(ctx, req, credentials)
+}
+
+// CancelPerpetualFuturesOrder ...
+func CancelPerpetualFuturesOrder(ctx context.Context, req *CancelPerpetualFuturesOrderRequest, credentials *Credentials) (*CancelPerpetualFuturesOrderResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Cancel perpetual futures order on binance")
+	defer span.Finish()
+	return client.CancelPerpetualFuturesOrder(ctx, req, credentials)
+}
+
 // ExecuteSpotOrder ...
 func ExecuteSpotOrder(ctx context.Context, req *ExecuteSpotOrderRequest, credentials *Credentials) (*ExecuteSpotOrderResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "Execute spot order on binance")
@@ -193,3 +225,31 @@ func GetFundingRate(ctx context.Context, req *GetFundingRateRequest) (*GetFundin
 	defer span.Finish()
 	return client.GetFundingRate(ctx, req)
 }
+
+// ListKlines ...
+func ListKlines(ctx context.Context, req *ListKlinesRequest) (*ListKlinesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "List Binance futures klines")
+	defer span.Finish()
+	return client.ListKlines(ctx, req)
+}
+
+// ReadPerpetualFuturesAccountInformation ...
+func ReadPerpetualFuturesAccountInformation(ctx context.Context, credentials *Credentials) (*ReadPerpetualFuturesAccountInformationResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Read binance perpetual futures account information")
+	defer span.Finish()
+	return client.ReadPerpetualFuturesAccountInformation(ctx, credentials)
+}
+
+// ListPerpetualFuturesPositionRisk ...
+func ListPerpetualFuturesPositionRisk(ctx context.Context, credentials *Credentials) (*ListPerpetualFuturesPositionRiskResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "List binance perpetual futures positions")
+	defer span.Finish()
+	return client.ListPerpetualFuturesPositionRisk(ctx, credentials)
+}
+
+// ListPerpetualFuturesIncome ...
+func ListPerpetualFuturesIncome(ctx context.Context, req *ListPerpetualFuturesIncomeRequest, credentials *Credentials) (*ListPerpetualFuturesIncomeResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "List binance perpetual futures income")
+	defer span.Finish()
+	return client.ListPerpetualFuturesIncome(ctx, req, credentials)
+}
@@ -72,6 +72,36 @@ func (c *binanceClient) ExecutePerpetualFuturesOrder(ctx context.Context, req *E
 	return rspBody, nil
 }
 
+func (c *binanceClient) ReadPerpetualFuturesOrder(ctx context.Context, req *ReadPerpetualFuturesOrderRequest, credentials *Credentials) (*ReadPerpetualFuturesOrderResponse, error) {
+	url := fmt.Sprintf("%s/%s", binanceFuturesURL, "order")
+	rspBody := &ReadPerpetualFuturesOrderResponse{}
+
+	qs := fmt.Sprintf("symbol=%s&orderId=%s", req.Symbol, req.OrderID)
+
+	if err := c.doWithSignature(ctx, http.MethodGet, url, qs, nil, rspBody, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_perpetual_futures_order.client", map[string]string{
+			"query_string": qs,
+		})
+	}
+
+	return rspBody, nil
+}
+
+func (c *binanceClient) CancelPerpetualFuturesOrder(ctx context.Context, req *CancelPerpetualFuturesOrderRequest, credentials *Credentials) (*CancelPerpetualFuturesOrderResponse, error) {
+	url := fmt.Sprintf("%s/%s", binanceFuturesURL, "order")
+	rspBody := &CancelPerpetualFuturesOrderResponse{}
+
+	qs := fmt.Sprintf("symbol=%s&orderId=%s", req.Symbol, req.OrderID)
+
+	if err := c.doWithSignature(ctx, http.MethodDelete, url, qs, nil, rspBody, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_perpetual_futures_order.client", map[string]string{
+			"query_string": qs,
+		})
+	}
+
+	return rspBody, nil
+}
+
 func (c *binanceClient) ExecuteSpotOrder(ctx context.Context, req *ExecuteSpotOrderRequest, credentials *Credentials) (*ExecuteSpotOrderResponse, error) {
 	return nil, gerrors.Unimplemented("unimplemented.execute_spot_trade", nil)
 }
@@ -150,3 +180,62 @@ func (c *binanceClient) GetFundingRate(ctx context.Context, req *GetFundingRateR
 
 	return rspBody, nil
 }
+
+func (c *binanceClient) ListKlines(ctx context.Context, req *ListKlinesRequest) (*ListKlinesResponse, error) {
+	endpoint := fmt.Sprintf("%s/klines?symbol=%s&interval=%s", binanceFuturesURL, req.Symbol, req.Interval)
+
+	if req.StartTime != 0 {
+		endpoint = fmt.Sprintf("%s&startTime=%d", endpoint, req.StartTime)
+	}
+	if req.EndTime != 0 {
+		endpoint = fmt.Sprintf("%s&endTime=%d", endpoint, req.EndTime)
+	}
+
+	if req.Limit != 0 {
+		endpoint = fmt.Sprintf("%s&limit=%d", endpoint, req.Limit)
+	}
+
+	rspBody := &ListKlinesResponse{}
+	if err := c.http.Do(ctx, http.MethodGet, endpoint, nil, rspBody); err != nil {
+		return nil, gerrors.Augment(err, "client_request_failed.list_klines", nil)
+	}
+
+	return rspBody, nil
+}
+
+func (c *binanceClient) ReadPerpetualFuturesAccountInformation(ctx context.Context, credentials *Credentials) (*ReadPerpetualFuturesAccountInformationResponse, error) {
+	url := fmt.Sprintf("%s/%s", binanceFuturesURLV2, "account")
+	rspBody := &ReadPerpetualFuturesAccountInformationResponse{}
+
+	if err := c.doWithSignature(ctx, http.MethodGet, url, "", nil, rspBody, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_perpetual_futures_account_information.client", nil)
+	}
+
+	return rspBody, nil
+}
+
+func (c *binanceClient) ListPerpetualFuturesPositionRisk(ctx context.Context, credentials *Credentials) (*ListPerpetualFuturesPositionRiskResponse, error) {
+	url := fmt.Sprintf("%s/%s", binanceFuturesURLV2, "positionRisk")
+	rspBody := &ListPerpetualFuturesPositionRiskResponse{}
+
+	if err := c.doWithSignature(ctx, http.MethodGet, url, "", nil, rspBody, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_perpetual_futures_position_risk.client", nil)
+	}
+
+	return rspBody, nil
+}
+
+func (c *binanceClient) ListPerpetualFuturesIncome(ctx context.Context, req *ListPerpetualFuturesIncomeRequest, credentials *Credentials) (*ListPerpetualFuturesIncomeResponse, error) {
+	url := fmt.Sprintf("%s/%s", binanceFuturesURL, "income")
+	rspBody := &ListPerpetualFuturesIncomeResponse{}
+
+	qs := fmt.Sprintf("incomeType=%s&startTime=%d&limit=%d", req.IncomeType, req.StartTime, req.Limit)
+
+	if err := c.doWithSignature(ctx, http.MethodGet, url, qs, nil, rspBody, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_perpetual_futures_income.client", map[string]string{
+			"query_string": qs,
+		})
+	}
+
+	return rspBody, nil
+}
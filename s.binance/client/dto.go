@@ -1,6 +1,12 @@
 package client
 
-import "time"
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+)
 
 // Credentials ...
 type Credentials struct {
@@ -115,6 +121,40 @@ type ExecutePerpetualFuturesOrderResponse struct {
 	ExecutionTimestamp int `json:"updateTime"`
 }
 
+// ReadPerpetualFuturesOrderRequest ...
+// https://binance-docs.github.io/apidocs/futures/en/#query-order-user_data
+type ReadPerpetualFuturesOrderRequest struct {
+	Symbol  string `json:"symbol"`
+	OrderID string `json:"orderId"`
+}
+
+// ReadPerpetualFuturesOrderResponse ...
+type ReadPerpetualFuturesOrderResponse struct {
+	OrderID          int    `json:"orderId"`
+	Symbol           string `json:"symbol"`
+	Status           string `json:"status"`
+	OriginalQuantity string `json:"origQty"`
+	ExecutedQuantity string `json:"executedQty"`
+	AveragePrice     string `json:"avgPrice"`
+	OrderType        string `json:"type"`
+	UpdateTime       int64  `json:"updateTime"`
+}
+
+// CancelPerpetualFuturesOrderRequest ...
+// https://binance-docs.github.io/apidocs/futures/en/#cancel-order-trade
+type CancelPerpetualFuturesOrderRequest struct {
+	Symbol  string `json:"symbol"`
+	OrderID string `json:"orderId"`
+}
+
+// CancelPerpetualFuturesOrderResponse ...
+type CancelPerpetualFuturesOrderResponse struct {
+	OrderID    int    `json:"orderId"`
+	Symbol     string `json:"symbol"`
+	Status     string `json:"status"`
+	UpdateTime int64  `json:"updateTime"`
+}
+
 // VerifyCredentialsRequest ...
 type VerifyCredentialsRequest struct {
 	Credentials *Credentials
@@ -191,3 +231,131 @@ type FundingRateInfo struct {
 
 // GetFundingRatesResponse ...
 type GetFundingRateResponse []*FundingRateInfo
+
+// ListKlinesRequest ...
+type ListKlinesRequest struct {
+	Symbol    string `json:"symbol"`
+	Interval  string `json:"interval"`
+	StartTime int64  `json:"startTime"`
+	EndTime   int64  `json:"endTime"`
+	Limit     int    `json:"limit"`
+}
+
+// Kline ...
+type Kline struct {
+	OpenTime                 int64
+	OpenPrice                string
+	HighPrice                string
+	LowPrice                 string
+	ClosePrice               string
+	BaseAssetVolume          string
+	CloseTime                int64
+	QuoteAssetVolume         string
+	NumberOfTrades           int64
+	TakerBuyBaseAssetVolume  string
+	TakerBuyQuoteAssetVolume string
+}
+
+// UnmarshalJSON unmarshals a kline; binance represents klines as a positional array rather than an object.
+func (k *Kline) UnmarshalJSON(b []byte) error {
+	var fields []interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	if len(fields) < 11 {
+		return gerrors.FailedPrecondition("failed_to_unmarshal_kline.too_few_fields", map[string]string{
+			"number_of_fields": strconv.Itoa(len(fields)),
+		})
+	}
+
+	var ok bool
+	for _, f := range []struct {
+		dst   *string
+		index int
+	}{
+		{&k.OpenPrice, 1},
+		{&k.HighPrice, 2},
+		{&k.LowPrice, 3},
+		{&k.ClosePrice, 4},
+		{&k.BaseAssetVolume, 5},
+		{&k.QuoteAssetVolume, 7},
+		{&k.TakerBuyBaseAssetVolume, 9},
+		{&k.TakerBuyQuoteAssetVolume, 10},
+	} {
+		if *f.dst, ok = fields[f.index].(string); !ok {
+			return gerrors.FailedPrecondition("failed_to_unmarshal_kline.bad_field", map[string]string{
+				"index": strconv.Itoa(f.index),
+			})
+		}
+	}
+
+	for _, f := range []struct {
+		dst   *int64
+		index int
+	}{
+		{&k.OpenTime, 0},
+		{&k.CloseTime, 6},
+		{&k.NumberOfTrades, 8},
+	} {
+		v, ok := fields[f.index].(float64)
+		if !ok {
+			return gerrors.FailedPrecondition("failed_to_unmarshal_kline.bad_field", map[string]string{
+				"index": strconv.Itoa(f.index),
+			})
+		}
+		*f.dst = int64(v)
+	}
+
+	return nil
+}
+
+// ListKlinesResponse ...
+type ListKlinesResponse []*Kline
+
+// ReadPerpetualFuturesAccountInformationResponse ...
+// https://binance-docs.github.io/apidocs/futures/en/#account-information-v2-user_data
+type ReadPerpetualFuturesAccountInformationResponse struct {
+	TotalMaintenanceMargin string `json:"totalMaintMargin"`
+	TotalMarginBalance     string `json:"totalMarginBalance"`
+	TotalUnrealizedProfit  string `json:"totalUnrealizedProfit"`
+}
+
+// PerpetualFuturesPositionRisk ...
+// https://binance-docs.github.io/apidocs/futures/en/#position-information-v2-user_data
+type PerpetualFuturesPositionRisk struct {
+	Symbol           string `json:"symbol"`
+	PositionAmount   string `json:"positionAmt"`
+	EntryPrice       string `json:"entryPrice"`
+	MarkPrice        string `json:"markPrice"`
+	UnrealizedProfit string `json:"unRealizedProfit"`
+	LiquidationPrice string `json:"liquidationPrice"`
+	Leverage         string `json:"leverage"`
+	MarginType       string `json:"marginType"`
+	Notional         string `json:"notional"`
+}
+
+// ListPerpetualFuturesPositionRiskResponse an array of positions, one for every symbol; identical to the Binance exchange
+// API definition.
+type ListPerpetualFuturesPositionRiskResponse []*PerpetualFuturesPositionRisk
+
+// ListPerpetualFuturesIncomeRequest ...
+// https://binance-docs.github.io/apidocs/futures/en/#get-income-history-user_data
+type ListPerpetualFuturesIncomeRequest struct {
+	IncomeType string `json:"incomeType"`
+	// Start time in milliseconds.
+	StartTime int64 `json:"startTime"`
+	Limit     int   `json:"limit"`
+}
+
+// PerpetualFuturesIncome ...
+type PerpetualFuturesIncome struct {
+	Symbol     string `json:"symbol"`
+	IncomeType string `json:"incomeType"`
+	Income     string `json:"income"`
+	Asset      string `json:"asset"`
+	Time       int64  `json:"time"`
+}
+
+// ListPerpetualFuturesIncomeResponse ...
+type ListPerpetualFuturesIncomeResponse []*PerpetualFuturesIncome
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.binance/client"
+	"swallowtail/s.binance/marshaling"
+	binanceproto "swallowtail/s.binance/proto"
+)
+
+// CancelPerpetualFuturesOrder cancels a resting perpetual futures order on binance.
+func (s *BinanceService) CancelPerpetualFuturesOrder(
+	ctx context.Context, in *binanceproto.CancelPerpetualFuturesOrderRequest,
+) (*binanceproto.CancelPerpetualFuturesOrderResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isValidActor(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_cancel_perpetual_futures_order.unauthorized", nil)
+	case in.Symbol == "":
+		return nil, gerrors.BadParam("missing_param.symbol", nil)
+	case in.ExternalOrderId == "":
+		return nil, gerrors.BadParam("missing_param.external_order_id", nil)
+	}
+
+	if err := isValidCredentials(in.Credentials, false); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_perpetual_futures_order.credentials", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id":          in.ActorId,
+		"symbol":            in.Symbol,
+		"external_order_id": in.ExternalOrderId,
+	}
+
+	rsp, err := client.CancelPerpetualFuturesOrder(ctx, &client.CancelPerpetualFuturesOrderRequest{
+		Symbol:  strings.ToUpper(in.Symbol),
+		OrderID: in.ExternalOrderId,
+	}, marshaling.CredentialsProtoToDTO(in.Credentials))
+	if err != nil {
+		slog.Error(ctx, "Failed to cancel order: Error: %v, Symbol: %s, Order ID: %s", err, in.Symbol, in.ExternalOrderId)
+		return nil, gerrors.Augment(err, "failed_to_cancel_perpetual_futures_order", errParams)
+	}
+
+	protoRsp, err := marshaling.CancelPerpetualFuturesOrderDTOToProto(rsp)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_perpetual_futures_order.marshal_to_proto", errParams)
+	}
+
+	return protoRsp, nil
+}
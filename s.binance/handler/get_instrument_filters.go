@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.binance/exchangeinfo"
+	binanceproto "swallowtail/s.binance/proto"
+)
+
+// GetInstrumentFilters returns the tick & lot size filters binance applies to orders of the given symbol.
+func (s *BinanceService) GetInstrumentFilters(
+	ctx context.Context, in *binanceproto.GetInstrumentFiltersRequest,
+) (*binanceproto.GetInstrumentFiltersResponse, error) {
+	switch {
+	case in.Symbol == "":
+		return nil, gerrors.BadParam("missing_param.symbol", nil)
+	}
+
+	errParams := map[string]string{
+		"symbol": in.Symbol,
+	}
+
+	symbol := strings.ToUpper(in.Symbol)
+
+	tickSize, ok, err := exchangeinfo.GetBaseAssetPricePrecision(symbol)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_get_instrument_filters", errParams)
+	}
+	if !ok {
+		return nil, gerrors.NotFound("failed_to_get_instrument_filters.symbol_not_found", errParams)
+	}
+
+	rsp := &binanceproto.GetInstrumentFiltersResponse{
+		TickSize: float32(tickSize),
+	}
+
+	for _, isMarketOrder := range []bool{false, true} {
+		lotSize, ok, err := exchangeinfo.GetBaseAssetQuantityPrecision(symbol, isMarketOrder)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_get_instrument_filters", errParams)
+		}
+		if !ok {
+			return nil, gerrors.NotFound("failed_to_get_instrument_filters.lot_size_not_found", errParams)
+		}
+
+		minQuantity, _, err := exchangeinfo.GetBaseAssetMinQty(symbol, isMarketOrder)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_get_instrument_filters", errParams)
+		}
+
+		switch {
+		case isMarketOrder:
+			rsp.MarketLotSize, rsp.MarketMinQuantity = float32(lotSize), float32(minQuantity)
+		default:
+			rsp.LotSize, rsp.MinQuantity = float32(lotSize), float32(minQuantity)
+		}
+	}
+
+	return rsp, nil
+}
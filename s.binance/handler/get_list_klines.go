@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.binance/client"
+	binanceproto "swallowtail/s.binance/proto"
+)
+
+const (
+	defaultKlineInterval = "1m"
+)
+
+// ListKlines ...
+func (*BinanceService) ListKlines(
+	ctx context.Context, in *binanceproto.ListKlinesRequest,
+) (*binanceproto.ListKlinesResponse, error) {
+	switch {
+	case in.Symbol == "":
+		return nil, gerrors.BadParam("missing_param.symbol", nil)
+	case in.StartTime != 0 && in.EndTime != 0 && in.EndTime < in.StartTime:
+		return nil, gerrors.BadParam("bad_param.end_time_before_start_time", nil)
+	}
+
+	interval := in.Interval
+	if interval == "" {
+		interval = defaultKlineInterval
+	}
+
+	errParams := map[string]string{
+		"symbol":     in.Symbol,
+		"interval":   interval,
+		"start_time": strconv.Itoa(int(in.StartTime)),
+		"end_time":   strconv.Itoa(int(in.EndTime)),
+	}
+
+	rsp, err := client.ListKlines(ctx, &client.ListKlinesRequest{
+		Symbol:    in.Symbol,
+		Interval:  interval,
+		StartTime: in.StartTime,
+		EndTime:   in.EndTime,
+		Limit:     int(in.Limit),
+	})
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_klines", errParams)
+	}
+
+	protos := make([]*binanceproto.Kline, 0, len(*rsp))
+	for _, k := range *rsp {
+		proto, err := klineDTOToProto(in.Symbol, interval, k)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_list_klines", errParams)
+		}
+
+		protos = append(protos, proto)
+	}
+
+	return &binanceproto.ListKlinesResponse{
+		Klines: protos,
+	}, nil
+}
+
+func klineDTOToProto(symbol, interval string, k *client.Kline) (*binanceproto.Kline, error) {
+	var prices = make([]float64, 0, 6)
+	for _, v := range []string{k.OpenPrice, k.HighPrice, k.LowPrice, k.ClosePrice, k.BaseAssetVolume, k.QuoteAssetVolume} {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_parse_kline", map[string]string{
+				"value": v,
+			})
+		}
+
+		prices = append(prices, f)
+	}
+
+	return &binanceproto.Kline{
+		Symbol:           symbol,
+		Interval:         interval,
+		OpenTime:         k.OpenTime,
+		CloseTime:        k.CloseTime,
+		OpenPrice:        float32(prices[0]),
+		HighPrice:        float32(prices[1]),
+		LowPrice:         float32(prices[2]),
+		ClosePrice:       float32(prices[3]),
+		BaseAssetVolume:  float32(prices[4]),
+		QuoteAssetVolume: float32(prices[5]),
+		NumberOfTrades:   k.NumberOfTrades,
+	}, nil
+}
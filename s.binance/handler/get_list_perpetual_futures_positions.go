@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.binance/client"
+	"swallowtail/s.binance/marshaling"
+	binanceproto "swallowtail/s.binance/proto"
+)
+
+const (
+	defaultRealisedPnLWindow = 24 * time.Hour
+	// binanceMaxIncomeLimit is the most income binance returns in a single request.
+	binanceMaxIncomeLimit = 1000
+)
+
+// ListPerpetualFuturesPositions lists the open positions of the perpetual futures account, along with its margin, its
+// unrealised PnL & its realised PnL since the time given.
+func (s *BinanceService) ListPerpetualFuturesPositions(
+	ctx context.Context, in *binanceproto.ListPerpetualFuturesPositionsRequest,
+) (*binanceproto.ListPerpetualFuturesPositionsResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isValidActor(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_list_perpetual_futures_positions.unauthorized", nil)
+	}
+
+	if err := isValidCredentials(in.Credentials, false); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_perpetual_futures_positions.credentials", nil)
+	}
+
+	realisedPnLSince := time.Now().UTC().Add(-defaultRealisedPnLWindow)
+	if in.RealisedPnlSince != nil {
+		realisedPnLSince = in.RealisedPnlSince.AsTime()
+	}
+
+	errParams := map[string]string{
+		"actor_id":           in.ActorId,
+		"realised_pnl_since": realisedPnLSince.String(),
+	}
+
+	credentials := marshaling.CredentialsProtoToDTO(in.Credentials)
+
+	account, err := client.ReadPerpetualFuturesAccountInformation(ctx, credentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_perpetual_futures_positions.account_information", errParams)
+	}
+
+	positions, err := client.ListPerpetualFuturesPositionRisk(ctx, credentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_perpetual_futures_positions.position_risk", errParams)
+	}
+
+	income, err := client.ListPerpetualFuturesIncome(ctx, &client.ListPerpetualFuturesIncomeRequest{
+		IncomeType: "REALIZED_PNL",
+		StartTime:  realisedPnLSince.UnixMilli(),
+		Limit:      binanceMaxIncomeLimit,
+	}, credentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_perpetual_futures_positions.income", errParams)
+	}
+
+	protoRsp, err := marshaling.PerpetualFuturesPositionsDTOToProto(account, positions, income)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_perpetual_futures_positions.marshal_to_proto", errParams)
+	}
+
+	return protoRsp, nil
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.binance/client"
+	"swallowtail/s.binance/marshaling"
+	binanceproto "swallowtail/s.binance/proto"
+)
+
+// ReadPerpetualFuturesOrder reads the current state of a perpetual futures order from binance.
+func (s *BinanceService) ReadPerpetualFuturesOrder(
+	ctx context.Context, in *binanceproto.ReadPerpetualFuturesOrderRequest,
+) (*binanceproto.ReadPerpetualFuturesOrderResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isValidActor(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_read_perpetual_futures_order.unauthorized", nil)
+	case in.Symbol == "":
+		return nil, gerrors.BadParam("missing_param.symbol", nil)
+	case in.ExternalOrderId == "":
+		return nil, gerrors.BadParam("missing_param.external_order_id", nil)
+	}
+
+	if err := isValidCredentials(in.Credentials, false); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_perpetual_futures_order.credentials", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id":          in.ActorId,
+		"symbol":            in.Symbol,
+		"external_order_id": in.ExternalOrderId,
+	}
+
+	rsp, err := client.ReadPerpetualFuturesOrder(ctx, &client.ReadPerpetualFuturesOrderRequest{
+		Symbol:  strings.ToUpper(in.Symbol),
+		OrderID: in.ExternalOrderId,
+	}, marshaling.CredentialsProtoToDTO(in.Credentials))
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_perpetual_futures_order", errParams)
+	}
+
+	protoRsp, err := marshaling.ReadPerpetualFuturesOrderDTOToProto(rsp)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_perpetual_futures_order.marshal_to_proto", errParams)
+	}
+
+	return protoRsp, nil
+}
@@ -6,6 +6,11 @@ import (
 	"strings"
 )
 
+const (
+	// float32Precision is the relative precision of a float32.
+	float32Precision = 1e-7
+)
+
 // TODO: this is copy from & tested in `s.ftx` - we should centralize this logic & somepoint
 // or use a proper decimal library.
 func roundToPrecisionString(f float64, minIncrement float64) string {
@@ -15,6 +20,12 @@ func roundToPrecisionString(f float64, minIncrement float64) string {
 
 	v := f / minIncrement
 
+	// Values are passed as float32; so a value on an increment can land a fraction below it, i.e `0.7` is `0.69999998`.
+	// Anything within float32 precision of an increment is taken as on it, rather than flooring a whole increment away.
+	if r := math.Round(v); math.Abs(v-r) <= r*float32Precision {
+		v = r
+	}
+
 	var p float64
 	switch {
 	case v < 1.0:
@@ -26,3 +37,12 @@ func roundToPrecisionString(f float64, minIncrement float64) string {
 	// Format float & trim zeros.
 	return strings.TrimRight(strconv.FormatFloat(p, 'f', 6, 64), "0")
 }
+
+// parseOptionalFloat parses a float from a string; treating the empty string as zero.
+func parseOptionalFloat(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(s, 64)
+}
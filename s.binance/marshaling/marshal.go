@@ -105,7 +105,7 @@ func ProtoOrderToExecutePerpetualsFutureOrderRequest(in *tradeengineproto.Order)
 	quantity := roundToPrecisionString(float64(in.Quantity), assetQuantityPrecision)
 
 	// Parse limit & stop price.
-	var limitPrice, stopPrice string
+	var limitPrice, stopPrice, callbackRate string
 	switch in.OrderType {
 	case tradeengineproto.ORDER_TYPE_MARKET:
 		// Do nothing.
@@ -121,6 +121,14 @@ func ProtoOrderToExecutePerpetualsFutureOrderRequest(in *tradeengineproto.Order)
 		stopPrice = roundToPrecisionString(float64(in.StopPrice), assetPricePrecision)
 	case tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET:
 		stopPrice = roundToPrecisionString(float64(in.StopPrice), assetPricePrecision)
+	case tradeengineproto.ORDER_TYPE_TRAILING_STOP_MARKET:
+		// Binance only accepts callback rates between 0.1% & 5%, in steps of 0.1%. We never set an activation price; so the
+		// order trails from the latest price as soon as it is placed.
+		if in.TrailingPercentage < 0.1 || in.TrailingPercentage > 5 {
+			errParams["trailing_percentage"] = fmt.Sprintf("%f", in.TrailingPercentage)
+			return nil, gerrors.BadParam("failed_to_marshall_perpetuals_trade.invalid_trailing_percentage", errParams)
+		}
+		callbackRate = strconv.FormatFloat(float64(in.TrailingPercentage), 'f', 1, 64)
 	default:
 		return nil, gerrors.Unimplemented("failed_to_marshall_perpetuals_trade.unimplemented.order_type", nil)
 	}
@@ -162,6 +170,8 @@ func ProtoOrderToExecutePerpetualsFutureOrderRequest(in *tradeengineproto.Order)
 		orderType = "TAKE_PROFIT"
 	case tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET:
 		orderType = "TAKE_PROFIT_MARKET"
+	case tradeengineproto.ORDER_TYPE_TRAILING_STOP_MARKET:
+		orderType = "TRAILING_STOP_MARKET"
 	default:
 		errParams["order_type"] = orderType
 		return nil, gerrors.BadParam("failed_to_marshall_perpetuals_trade.invalid_order_type", errParams)
@@ -211,6 +221,7 @@ func ProtoOrderToExecutePerpetualsFutureOrderRequest(in *tradeengineproto.Order)
 		TimeInForce:      timeInForce,
 		LimitPrice:       limitPrice,
 		StopPrice:        stopPrice,
+		CallbackRate:     callbackRate,
 		Quantity:         quantity,
 		ReduceOnly:       reduceOnly,
 		ClosePosition:    strconv.FormatBool(in.ClosePosition),
@@ -220,6 +231,80 @@ func ProtoOrderToExecutePerpetualsFutureOrderRequest(in *tradeengineproto.Order)
 	}, nil
 }
 
+// ReadPerpetualFuturesOrderDTOToProto ...
+func ReadPerpetualFuturesOrderDTOToProto(in *client.ReadPerpetualFuturesOrderResponse) (*binanceproto.ReadPerpetualFuturesOrderResponse, error) {
+	errParams := map[string]string{
+		"order_id": strconv.Itoa(in.OrderID),
+		"status":   in.Status,
+	}
+
+	status, ok := orderStatusDTOToProto(in.Status)
+	if !ok {
+		return nil, gerrors.FailedPrecondition("failed_to_marshal_perpetual_futures_order.unknown_order_status", errParams)
+	}
+
+	quantity, err := parseOptionalFloat(in.OriginalQuantity)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_parse_float.original_quantity", errParams)
+	}
+
+	executedQuantity, err := parseOptionalFloat(in.ExecutedQuantity)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_parse_float.executed_quantity", errParams)
+	}
+
+	averagePrice, err := parseOptionalFloat(in.AveragePrice)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_parse_float.average_price", errParams)
+	}
+
+	return &binanceproto.ReadPerpetualFuturesOrderResponse{
+		ExternalOrderId:  strconv.Itoa(in.OrderID),
+		Status:           status,
+		Quantity:         float32(quantity),
+		ExecutedQuantity: float32(executedQuantity),
+		AverageFillPrice: float32(averagePrice),
+		LastUpdated:      in.UpdateTime,
+	}, nil
+}
+
+// CancelPerpetualFuturesOrderDTOToProto ...
+func CancelPerpetualFuturesOrderDTOToProto(in *client.CancelPerpetualFuturesOrderResponse) (*binanceproto.CancelPerpetualFuturesOrderResponse, error) {
+	status, ok := orderStatusDTOToProto(in.Status)
+	if !ok {
+		return nil, gerrors.FailedPrecondition("failed_to_marshal_cancelled_perpetual_futures_order.unknown_order_status", map[string]string{
+			"order_id": strconv.Itoa(in.OrderID),
+			"status":   in.Status,
+		})
+	}
+
+	return &binanceproto.CancelPerpetualFuturesOrderResponse{
+		ExternalOrderId: strconv.Itoa(in.OrderID),
+		Status:          status,
+	}, nil
+}
+
+// orderStatusDTOToProto converts a binance order status to our own.
+// https://binance-docs.github.io/apidocs/futures/en/#public-endpoints-info
+func orderStatusDTOToProto(status string) (tradeengineproto.ORDER_STATUS, bool) {
+	switch strings.ToUpper(status) {
+	case "NEW":
+		return tradeengineproto.ORDER_STATUS_NEW_ORDER, true
+	case "PARTIALLY_FILLED":
+		return tradeengineproto.ORDER_STATUS_PARTIALLY_FILLED_ORDER, true
+	case "FILLED":
+		return tradeengineproto.ORDER_STATUS_FILLED_ORDER, true
+	case "CANCELED":
+		return tradeengineproto.ORDER_STATUS_CANCELLED_ORDER, true
+	case "REJECTED":
+		return tradeengineproto.ORDER_STATUS_REJECTED, true
+	case "EXPIRED":
+		return tradeengineproto.ORDER_STATUS_EXPIRED, true
+	default:
+		return 0, false
+	}
+}
+
 // ProtoOrderToExecuteSpotOrderRequest ...
 func ProtoOrderToExecuteSpotOrderRequest(order *tradeengineproto.Order) (*client.ExecuteSpotOrderRequest, error) {
 	return nil, nil
@@ -250,3 +335,92 @@ func isSuccess(rsp *client.VerifyCredentialsResponse) (bool, string) {
 
 	return rsp.EnableReading && rsp.EnableFutures && rsp.EnableSpotAndMarginTrading, strings.Join(reasons, ",")
 }
+
+// PerpetualFuturesPositionsDTOToProto marshals the open positions of the account, along with its margin & the realised
+// PnL of the income given.
+func PerpetualFuturesPositionsDTOToProto(
+	account *client.ReadPerpetualFuturesAccountInformationResponse,
+	positions *client.ListPerpetualFuturesPositionRiskResponse,
+	income *client.ListPerpetualFuturesIncomeResponse,
+) (*binanceproto.ListPerpetualFuturesPositionsResponse, error) {
+	maintenanceMargin, err := parseOptionalFloat(account.TotalMaintenanceMargin)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_parse_float.total_maintenance_margin", nil)
+	}
+
+	marginBalance, err := parseOptionalFloat(account.TotalMarginBalance)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_parse_float.total_margin_balance", nil)
+	}
+
+	unrealisedPnL, err := parseOptionalFloat(account.TotalUnrealizedProfit)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_parse_float.total_unrealized_profit", nil)
+	}
+
+	var marginRatio float64
+	if marginBalance > 0 {
+		marginRatio = maintenanceMargin / marginBalance
+	}
+
+	var realisedPnL float64
+	for _, i := range *income {
+		v, err := parseOptionalFloat(i.Income)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_parse_float.income", map[string]string{
+				"symbol": i.Symbol,
+			})
+		}
+
+		realisedPnL += v
+	}
+
+	protoPositions := []*binanceproto.PerpetualFuturesPosition{}
+	for _, p := range *positions {
+		errParams := map[string]string{
+			"symbol": p.Symbol,
+		}
+
+		quantity, err := parseOptionalFloat(p.PositionAmount)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_parse_float.position_amount", errParams)
+		}
+
+		// Binance returns a position for every symbol; most of which are flat.
+		if quantity == 0 {
+			continue
+		}
+
+		var fields [5]float64
+		for i, f := range []string{p.EntryPrice, p.MarkPrice, p.UnrealizedProfit, p.LiquidationPrice, p.Notional} {
+			if fields[i], err = parseOptionalFloat(f); err != nil {
+				return nil, gerrors.Augment(err, "failed_to_parse_float.position", errParams)
+			}
+		}
+
+		leverage, err := strconv.ParseInt(p.Leverage, 10, 64)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_parse_int.leverage", errParams)
+		}
+
+		protoPositions = append(protoPositions, &binanceproto.PerpetualFuturesPosition{
+			Symbol:           p.Symbol,
+			Quantity:         float32(quantity),
+			EntryPrice:       float32(fields[0]),
+			MarkPrice:        float32(fields[1]),
+			UnrealisedPnl:    float32(fields[2]),
+			LiquidationPrice: float32(fields[3]),
+			Leverage:         leverage,
+			MarginType:       strings.ToLower(p.MarginType),
+			Notional:         float32(fields[4]),
+		})
+	}
+
+	return &binanceproto.ListPerpetualFuturesPositionsResponse{
+		Positions:     protoPositions,
+		MarginBalance: float32(marginBalance),
+		UnrealisedPnl: float32(unrealisedPnL),
+		MarginRatio:   float32(marginRatio),
+		RealisedPnl:   float32(realisedPnL),
+	}, nil
+}
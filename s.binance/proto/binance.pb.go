@@ -1,18 +1,17 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
-// 	protoc        v3.17.3
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.2
 // source: s.binance/proto/binance.proto
 
 package binanceproto
 
 import (
-	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
-	proto1 "swallowtail/s.trade-engine/proto"
+	proto "swallowtail/s.trade-engine/proto"
 	sync "sync"
 )
 
@@ -23,10 +22,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type AssetPair struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -196,10 +191,10 @@ type ExecuteNewFuturesPerpetualOrderRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Order              *proto1.Order            `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	Timestamp          *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Credentials        *proto1.VenueCredentials `protobuf:"bytes,3,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	IsAccountHedgeMode bool                     `protobuf:"varint,4,opt,name=is_account_hedge_mode,json=isAccountHedgeMode,proto3" json:"is_account_hedge_mode,omitempty"`
+	Order              *proto.Order            `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Timestamp          *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Credentials        *proto.VenueCredentials `protobuf:"bytes,3,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	IsAccountHedgeMode bool                    `protobuf:"varint,4,opt,name=is_account_hedge_mode,json=isAccountHedgeMode,proto3" json:"is_account_hedge_mode,omitempty"`
 }
 
 func (x *ExecuteNewFuturesPerpetualOrderRequest) Reset() {
@@ -234,7 +229,7 @@ func (*ExecuteNewFuturesPerpetualOrderRequest) Descriptor() ([]byte, []int) {
 	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ExecuteNewFuturesPerpetualOrderRequest) GetOrder() *proto1.Order {
+func (x *ExecuteNewFuturesPerpetualOrderRequest) GetOrder() *proto.Order {
 	if x != nil {
 		return x.Order
 	}
@@ -248,7 +243,7 @@ func (x *ExecuteNewFuturesPerpetualOrderRequest) GetTimestamp() *timestamppb.Tim
 	return nil
 }
 
-func (x *ExecuteNewFuturesPerpetualOrderRequest) GetCredentials() *proto1.VenueCredentials {
+func (x *ExecuteNewFuturesPerpetualOrderRequest) GetCredentials() *proto.VenueCredentials {
 	if x != nil {
 		return x.Credentials
 	}
@@ -267,7 +262,7 @@ type ExecuteNewFuturesPerpetualOrderResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Order *proto1.Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order *proto.Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 }
 
 func (x *ExecuteNewFuturesPerpetualOrderResponse) Reset() {
@@ -302,7 +297,7 @@ func (*ExecuteNewFuturesPerpetualOrderResponse) Descriptor() ([]byte, []int) {
 	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ExecuteNewFuturesPerpetualOrderResponse) GetOrder() *proto1.Order {
+func (x *ExecuteNewFuturesPerpetualOrderResponse) GetOrder() *proto.Order {
 	if x != nil {
 		return x.Order
 	}
@@ -314,9 +309,9 @@ type ExecuteNewSpotOrderRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Order       *proto1.Order            `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	Timestamp   *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Credentials *proto1.VenueCredentials `protobuf:"bytes,3,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Order       *proto.Order            `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Timestamp   *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Credentials *proto.VenueCredentials `protobuf:"bytes,3,opt,name=credentials,proto3" json:"credentials,omitempty"`
 }
 
 func (x *ExecuteNewSpotOrderRequest) Reset() {
@@ -351,7 +346,7 @@ func (*ExecuteNewSpotOrderRequest) Descriptor() ([]byte, []int) {
 	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ExecuteNewSpotOrderRequest) GetOrder() *proto1.Order {
+func (x *ExecuteNewSpotOrderRequest) GetOrder() *proto.Order {
 	if x != nil {
 		return x.Order
 	}
@@ -365,7 +360,7 @@ func (x *ExecuteNewSpotOrderRequest) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *ExecuteNewSpotOrderRequest) GetCredentials() *proto1.VenueCredentials {
+func (x *ExecuteNewSpotOrderRequest) GetCredentials() *proto.VenueCredentials {
 	if x != nil {
 		return x.Credentials
 	}
@@ -377,7 +372,7 @@ type ExecuteNewSpotOrderResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Order *proto1.Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Order *proto.Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 }
 
 func (x *ExecuteNewSpotOrderResponse) Reset() {
@@ -412,7 +407,7 @@ func (*ExecuteNewSpotOrderResponse) Descriptor() ([]byte, []int) {
 	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ExecuteNewSpotOrderResponse) GetOrder() *proto1.Order {
+func (x *ExecuteNewSpotOrderResponse) GetOrder() *proto.Order {
 	if x != nil {
 		return x.Order
 	}
@@ -424,8 +419,8 @@ type ReadPerpetualFuturesAccountRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActorId     string                   `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	Credentials *proto1.VenueCredentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	ActorId     string                  `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Credentials *proto.VenueCredentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
 }
 
 func (x *ReadPerpetualFuturesAccountRequest) Reset() {
@@ -467,7 +462,7 @@ func (x *ReadPerpetualFuturesAccountRequest) GetActorId() string {
 	return ""
 }
 
-func (x *ReadPerpetualFuturesAccountRequest) GetCredentials() *proto1.VenueCredentials {
+func (x *ReadPerpetualFuturesAccountRequest) GetCredentials() *proto.VenueCredentials {
 	if x != nil {
 		return x.Credentials
 	}
@@ -545,6 +540,291 @@ func (x *ReadPerpetualFuturesAccountResponse) GetLastUpdated() *timestamppb.Time
 	return nil
 }
 
+type ReadPerpetualFuturesOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId         string                  `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Credentials     *proto.VenueCredentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Symbol          string                  `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ExternalOrderId string                  `protobuf:"bytes,4,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+}
+
+func (x *ReadPerpetualFuturesOrderRequest) Reset() {
+	*x = ReadPerpetualFuturesOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPerpetualFuturesOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPerpetualFuturesOrderRequest) ProtoMessage() {}
+
+func (x *ReadPerpetualFuturesOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPerpetualFuturesOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReadPerpetualFuturesOrderRequest) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReadPerpetualFuturesOrderRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ReadPerpetualFuturesOrderRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *ReadPerpetualFuturesOrderRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ReadPerpetualFuturesOrderRequest) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+type ReadPerpetualFuturesOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalOrderId  string             `protobuf:"bytes,1,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+	Status           proto.ORDER_STATUS `protobuf:"varint,2,opt,name=status,proto3,enum=ORDER_STATUS" json:"status,omitempty"`
+	Quantity         float32            `protobuf:"fixed32,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExecutedQuantity float32            `protobuf:"fixed32,4,opt,name=executed_quantity,json=executedQuantity,proto3" json:"executed_quantity,omitempty"`
+	AverageFillPrice float32            `protobuf:"fixed32,5,opt,name=average_fill_price,json=averageFillPrice,proto3" json:"average_fill_price,omitempty"`
+	// Last updated time in milliseconds.
+	LastUpdated int64 `protobuf:"varint,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *ReadPerpetualFuturesOrderResponse) Reset() {
+	*x = ReadPerpetualFuturesOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPerpetualFuturesOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPerpetualFuturesOrderResponse) ProtoMessage() {}
+
+func (x *ReadPerpetualFuturesOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPerpetualFuturesOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReadPerpetualFuturesOrderResponse) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReadPerpetualFuturesOrderResponse) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+func (x *ReadPerpetualFuturesOrderResponse) GetStatus() proto.ORDER_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return proto.ORDER_STATUS_PENDING_NEW_ORDER
+}
+
+func (x *ReadPerpetualFuturesOrderResponse) GetQuantity() float32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReadPerpetualFuturesOrderResponse) GetExecutedQuantity() float32 {
+	if x != nil {
+		return x.ExecutedQuantity
+	}
+	return 0
+}
+
+func (x *ReadPerpetualFuturesOrderResponse) GetAverageFillPrice() float32 {
+	if x != nil {
+		return x.AverageFillPrice
+	}
+	return 0
+}
+
+func (x *ReadPerpetualFuturesOrderResponse) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+type CancelPerpetualFuturesOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId         string                  `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Credentials     *proto.VenueCredentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Symbol          string                  `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ExternalOrderId string                  `protobuf:"bytes,4,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+}
+
+func (x *CancelPerpetualFuturesOrderRequest) Reset() {
+	*x = CancelPerpetualFuturesOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPerpetualFuturesOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPerpetualFuturesOrderRequest) ProtoMessage() {}
+
+func (x *CancelPerpetualFuturesOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPerpetualFuturesOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelPerpetualFuturesOrderRequest) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelPerpetualFuturesOrderRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CancelPerpetualFuturesOrderRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *CancelPerpetualFuturesOrderRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CancelPerpetualFuturesOrderRequest) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+type CancelPerpetualFuturesOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalOrderId string             `protobuf:"bytes,1,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+	Status          proto.ORDER_STATUS `protobuf:"varint,2,opt,name=status,proto3,enum=ORDER_STATUS" json:"status,omitempty"`
+}
+
+func (x *CancelPerpetualFuturesOrderResponse) Reset() {
+	*x = CancelPerpetualFuturesOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelPerpetualFuturesOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPerpetualFuturesOrderResponse) ProtoMessage() {}
+
+func (x *CancelPerpetualFuturesOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPerpetualFuturesOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelPerpetualFuturesOrderResponse) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelPerpetualFuturesOrderResponse) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+func (x *CancelPerpetualFuturesOrderResponse) GetStatus() proto.ORDER_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return proto.ORDER_STATUS_PENDING_NEW_ORDER
+}
+
 type GetLatestPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -556,7 +836,7 @@ type GetLatestPriceRequest struct {
 func (x *GetLatestPriceRequest) Reset() {
 	*x = GetLatestPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_binance_proto_binance_proto_msgTypes[9]
+		mi := &file_s_binance_proto_binance_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -569,7 +849,7 @@ func (x *GetLatestPriceRequest) String() string {
 func (*GetLatestPriceRequest) ProtoMessage() {}
 
 func (x *GetLatestPriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_binance_proto_binance_proto_msgTypes[9]
+	mi := &file_s_binance_proto_binance_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +862,7 @@ func (x *GetLatestPriceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLatestPriceRequest.ProtoReflect.Descriptor instead.
 func (*GetLatestPriceRequest) Descriptor() ([]byte, []int) {
-	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{9}
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetLatestPriceRequest) GetSymbol() string {
@@ -604,7 +884,7 @@ type GetLatestPriceResponse struct {
 func (x *GetLatestPriceResponse) Reset() {
 	*x = GetLatestPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_binance_proto_binance_proto_msgTypes[10]
+		mi := &file_s_binance_proto_binance_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -617,7 +897,7 @@ func (x *GetLatestPriceResponse) String() string {
 func (*GetLatestPriceResponse) ProtoMessage() {}
 
 func (x *GetLatestPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_binance_proto_binance_proto_msgTypes[10]
+	mi := &file_s_binance_proto_binance_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +910,7 @@ func (x *GetLatestPriceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLatestPriceResponse.ProtoReflect.Descriptor instead.
 func (*GetLatestPriceResponse) Descriptor() ([]byte, []int) {
-	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{10}
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetLatestPriceResponse) GetPrice() float32 {
@@ -661,7 +941,7 @@ type GetFundingRatesRequest struct {
 func (x *GetFundingRatesRequest) Reset() {
 	*x = GetFundingRatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_binance_proto_binance_proto_msgTypes[11]
+		mi := &file_s_binance_proto_binance_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -674,7 +954,7 @@ func (x *GetFundingRatesRequest) String() string {
 func (*GetFundingRatesRequest) ProtoMessage() {}
 
 func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_binance_proto_binance_proto_msgTypes[11]
+	mi := &file_s_binance_proto_binance_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,7 +967,7 @@ func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
 func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
-	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{11}
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetFundingRatesRequest) GetSymbol() string {
@@ -731,7 +1011,7 @@ type FundingRateInfo struct {
 func (x *FundingRateInfo) Reset() {
 	*x = FundingRateInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_binance_proto_binance_proto_msgTypes[12]
+		mi := &file_s_binance_proto_binance_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -744,7 +1024,7 @@ func (x *FundingRateInfo) String() string {
 func (*FundingRateInfo) ProtoMessage() {}
 
 func (x *FundingRateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_s_binance_proto_binance_proto_msgTypes[12]
+	mi := &file_s_binance_proto_binance_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,7 +1037,7 @@ func (x *FundingRateInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FundingRateInfo.ProtoReflect.Descriptor instead.
 func (*FundingRateInfo) Descriptor() ([]byte, []int) {
-	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{12}
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *FundingRateInfo) GetSymbol() string {
@@ -792,7 +1072,7 @@ type GetFundingRatesResponse struct {
 func (x *GetFundingRatesResponse) Reset() {
 	*x = GetFundingRatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_binance_proto_binance_proto_msgTypes[13]
+		mi := &file_s_binance_proto_binance_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -805,7 +1085,7 @@ func (x *GetFundingRatesResponse) String() string {
 func (*GetFundingRatesResponse) ProtoMessage() {}
 
 func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_binance_proto_binance_proto_msgTypes[13]
+	mi := &file_s_binance_proto_binance_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +1098,7 @@ func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
 func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
-	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{13}
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetFundingRatesResponse) GetFundingRates() []*FundingRateInfo {
@@ -833,14 +1113,14 @@ type VerifyCredentialsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Credentials *proto1.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	UserId      string                   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Credentials *proto.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	UserId      string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
 func (x *VerifyCredentialsRequest) Reset() {
 	*x = VerifyCredentialsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_binance_proto_binance_proto_msgTypes[14]
+		mi := &file_s_binance_proto_binance_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -853,7 +1133,7 @@ func (x *VerifyCredentialsRequest) String() string {
 func (*VerifyCredentialsRequest) ProtoMessage() {}
 
 func (x *VerifyCredentialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_binance_proto_binance_proto_msgTypes[14]
+	mi := &file_s_binance_proto_binance_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,10 +1146,10 @@ func (x *VerifyCredentialsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyCredentialsRequest.ProtoReflect.Descriptor instead.
 func (*VerifyCredentialsRequest) Descriptor() ([]byte, []int) {
-	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{14}
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *VerifyCredentialsRequest) GetCredentials() *proto1.VenueCredentials {
+func (x *VerifyCredentialsRequest) GetCredentials() *proto.VenueCredentials {
 	if x != nil {
 		return x.Credentials
 	}
@@ -901,7 +1181,7 @@ type VerifyCredentialsResponse struct {
 func (x *VerifyCredentialsResponse) Reset() {
 	*x = VerifyCredentialsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_binance_proto_binance_proto_msgTypes[15]
+		mi := &file_s_binance_proto_binance_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -914,7 +1194,7 @@ func (x *VerifyCredentialsResponse) String() string {
 func (*VerifyCredentialsResponse) ProtoMessage() {}
 
 func (x *VerifyCredentialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_binance_proto_binance_proto_msgTypes[15]
+	mi := &file_s_binance_proto_binance_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,7 +1207,7 @@ func (x *VerifyCredentialsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyCredentialsResponse.ProtoReflect.Descriptor instead.
 func (*VerifyCredentialsResponse) Descriptor() ([]byte, []int) {
-	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{15}
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *VerifyCredentialsResponse) GetSuccess() bool {
@@ -995,7 +1275,7 @@ type GetStatusRequest struct {
 func (x *GetStatusRequest) Reset() {
 	*x = GetStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_binance_proto_binance_proto_msgTypes[16]
+		mi := &file_s_binance_proto_binance_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1008,7 +1288,7 @@ func (x *GetStatusRequest) String() string {
 func (*GetStatusRequest) ProtoMessage() {}
 
 func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_binance_proto_binance_proto_msgTypes[16]
+	mi := &file_s_binance_proto_binance_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1021,7 +1301,7 @@ func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetStatusRequest) Descriptor() ([]byte, []int) {
-	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{16}
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{20}
 }
 
 type GetStatusResponse struct {
@@ -1040,7 +1320,7 @@ type GetStatusResponse struct {
 func (x *GetStatusResponse) Reset() {
 	*x = GetStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_binance_proto_binance_proto_msgTypes[17]
+		mi := &file_s_binance_proto_binance_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1053,7 +1333,7 @@ func (x *GetStatusResponse) String() string {
 func (*GetStatusResponse) ProtoMessage() {}
 
 func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_binance_proto_binance_proto_msgTypes[17]
+	mi := &file_s_binance_proto_binance_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,7 +1346,7 @@ func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetStatusResponse) Descriptor() ([]byte, []int) {
-	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{17}
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetStatusResponse) GetServerTime() int64 {
@@ -1090,7 +1370,650 @@ func (x *GetStatusResponse) GetAssumedClockDrift() int64 {
 	return 0
 }
 
-var File_s_binance_proto_binance_proto protoreflect.FileDescriptor
+type ListKlinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Binance interval i.e `1m`, `5m`, `1h`; defaults to `1m`.
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Start & end time in milliseconds.
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Limit     int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListKlinesRequest) Reset() {
+	*x = ListKlinesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKlinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKlinesRequest) ProtoMessage() {}
+
+func (x *ListKlinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKlinesRequest.ProtoReflect.Descriptor instead.
+func (*ListKlinesRequest) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListKlinesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ListKlinesRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *ListKlinesRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListKlinesRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListKlinesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Kline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol   string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Open & close time in milliseconds.
+	OpenTime         int64   `protobuf:"varint,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime        int64   `protobuf:"varint,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	OpenPrice        float32 `protobuf:"fixed32,5,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	HighPrice        float32 `protobuf:"fixed32,6,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	LowPrice         float32 `protobuf:"fixed32,7,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	ClosePrice       float32 `protobuf:"fixed32,8,opt,name=close_price,json=closePrice,proto3" json:"close_price,omitempty"`
+	BaseAssetVolume  float32 `protobuf:"fixed32,9,opt,name=base_asset_volume,json=baseAssetVolume,proto3" json:"base_asset_volume,omitempty"`
+	QuoteAssetVolume float32 `protobuf:"fixed32,10,opt,name=quote_asset_volume,json=quoteAssetVolume,proto3" json:"quote_asset_volume,omitempty"`
+	NumberOfTrades   int64   `protobuf:"varint,11,opt,name=number_of_trades,json=numberOfTrades,proto3" json:"number_of_trades,omitempty"`
+}
+
+func (x *Kline) Reset() {
+	*x = Kline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Kline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kline) ProtoMessage() {}
+
+func (x *Kline) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kline.ProtoReflect.Descriptor instead.
+func (*Kline) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Kline) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Kline) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *Kline) GetOpenTime() int64 {
+	if x != nil {
+		return x.OpenTime
+	}
+	return 0
+}
+
+func (x *Kline) GetCloseTime() int64 {
+	if x != nil {
+		return x.CloseTime
+	}
+	return 0
+}
+
+func (x *Kline) GetOpenPrice() float32 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *Kline) GetHighPrice() float32 {
+	if x != nil {
+		return x.HighPrice
+	}
+	return 0
+}
+
+func (x *Kline) GetLowPrice() float32 {
+	if x != nil {
+		return x.LowPrice
+	}
+	return 0
+}
+
+func (x *Kline) GetClosePrice() float32 {
+	if x != nil {
+		return x.ClosePrice
+	}
+	return 0
+}
+
+func (x *Kline) GetBaseAssetVolume() float32 {
+	if x != nil {
+		return x.BaseAssetVolume
+	}
+	return 0
+}
+
+func (x *Kline) GetQuoteAssetVolume() float32 {
+	if x != nil {
+		return x.QuoteAssetVolume
+	}
+	return 0
+}
+
+func (x *Kline) GetNumberOfTrades() int64 {
+	if x != nil {
+		return x.NumberOfTrades
+	}
+	return 0
+}
+
+type ListKlinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Klines []*Kline `protobuf:"bytes,1,rep,name=klines,proto3" json:"klines,omitempty"`
+}
+
+func (x *ListKlinesResponse) Reset() {
+	*x = ListKlinesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKlinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKlinesResponse) ProtoMessage() {}
+
+func (x *ListKlinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKlinesResponse.ProtoReflect.Descriptor instead.
+func (*ListKlinesResponse) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListKlinesResponse) GetKlines() []*Kline {
+	if x != nil {
+		return x.Klines
+	}
+	return nil
+}
+
+type GetInstrumentFiltersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The perpetual futures symbol i.e `BTCUSDT`.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *GetInstrumentFiltersRequest) Reset() {
+	*x = GetInstrumentFiltersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstrumentFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstrumentFiltersRequest) ProtoMessage() {}
+
+func (x *GetInstrumentFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstrumentFiltersRequest.ProtoReflect.Descriptor instead.
+func (*GetInstrumentFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetInstrumentFiltersRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+// The filters binance applies to the prices & quantities of orders of the symbol; market orders have their own lot size.
+type GetInstrumentFiltersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TickSize          float32 `protobuf:"fixed32,1,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	LotSize           float32 `protobuf:"fixed32,2,opt,name=lot_size,json=lotSize,proto3" json:"lot_size,omitempty"`
+	MinQuantity       float32 `protobuf:"fixed32,3,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	MarketLotSize     float32 `protobuf:"fixed32,4,opt,name=market_lot_size,json=marketLotSize,proto3" json:"market_lot_size,omitempty"`
+	MarketMinQuantity float32 `protobuf:"fixed32,5,opt,name=market_min_quantity,json=marketMinQuantity,proto3" json:"market_min_quantity,omitempty"`
+}
+
+func (x *GetInstrumentFiltersResponse) Reset() {
+	*x = GetInstrumentFiltersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstrumentFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstrumentFiltersResponse) ProtoMessage() {}
+
+func (x *GetInstrumentFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstrumentFiltersResponse.ProtoReflect.Descriptor instead.
+func (*GetInstrumentFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetInstrumentFiltersResponse) GetTickSize() float32 {
+	if x != nil {
+		return x.TickSize
+	}
+	return 0
+}
+
+func (x *GetInstrumentFiltersResponse) GetLotSize() float32 {
+	if x != nil {
+		return x.LotSize
+	}
+	return 0
+}
+
+func (x *GetInstrumentFiltersResponse) GetMinQuantity() float32 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *GetInstrumentFiltersResponse) GetMarketLotSize() float32 {
+	if x != nil {
+		return x.MarketLotSize
+	}
+	return 0
+}
+
+func (x *GetInstrumentFiltersResponse) GetMarketMinQuantity() float32 {
+	if x != nil {
+		return x.MarketMinQuantity
+	}
+	return 0
+}
+
+type ListPerpetualFuturesPositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId     string                  `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Credentials *proto.VenueCredentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// Realised PnL is summed from this time onwards; defaults to the last 24 hours.
+	RealisedPnlSince *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=realised_pnl_since,json=realisedPnlSince,proto3" json:"realised_pnl_since,omitempty"`
+}
+
+func (x *ListPerpetualFuturesPositionsRequest) Reset() {
+	*x = ListPerpetualFuturesPositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPerpetualFuturesPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPerpetualFuturesPositionsRequest) ProtoMessage() {}
+
+func (x *ListPerpetualFuturesPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPerpetualFuturesPositionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPerpetualFuturesPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListPerpetualFuturesPositionsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListPerpetualFuturesPositionsRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *ListPerpetualFuturesPositionsRequest) GetRealisedPnlSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RealisedPnlSince
+	}
+	return nil
+}
+
+type PerpetualFuturesPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Negative if short.
+	Quantity      float32 `protobuf:"fixed32,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	EntryPrice    float32 `protobuf:"fixed32,3,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	MarkPrice     float32 `protobuf:"fixed32,4,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	UnrealisedPnl float32 `protobuf:"fixed32,5,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	// Zero if the position can't be liquidated.
+	LiquidationPrice float32 `protobuf:"fixed32,6,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	Leverage         int64   `protobuf:"varint,7,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	// Either `cross` or `isolated`.
+	MarginType string  `protobuf:"bytes,8,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Notional   float32 `protobuf:"fixed32,9,opt,name=notional,proto3" json:"notional,omitempty"`
+}
+
+func (x *PerpetualFuturesPosition) Reset() {
+	*x = PerpetualFuturesPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerpetualFuturesPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerpetualFuturesPosition) ProtoMessage() {}
+
+func (x *PerpetualFuturesPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerpetualFuturesPosition.ProtoReflect.Descriptor instead.
+func (*PerpetualFuturesPosition) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PerpetualFuturesPosition) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PerpetualFuturesPosition) GetQuantity() float32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PerpetualFuturesPosition) GetEntryPrice() float32 {
+	if x != nil {
+		return x.EntryPrice
+	}
+	return 0
+}
+
+func (x *PerpetualFuturesPosition) GetMarkPrice() float32 {
+	if x != nil {
+		return x.MarkPrice
+	}
+	return 0
+}
+
+func (x *PerpetualFuturesPosition) GetUnrealisedPnl() float32 {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return 0
+}
+
+func (x *PerpetualFuturesPosition) GetLiquidationPrice() float32 {
+	if x != nil {
+		return x.LiquidationPrice
+	}
+	return 0
+}
+
+func (x *PerpetualFuturesPosition) GetLeverage() int64 {
+	if x != nil {
+		return x.Leverage
+	}
+	return 0
+}
+
+func (x *PerpetualFuturesPosition) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+func (x *PerpetualFuturesPosition) GetNotional() float32 {
+	if x != nil {
+		return x.Notional
+	}
+	return 0
+}
+
+// The open positions of the account, along with its margin & PnL; all in USDT.
+type ListPerpetualFuturesPositionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Positions     []*PerpetualFuturesPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	MarginBalance float32                     `protobuf:"fixed32,2,opt,name=margin_balance,json=marginBalance,proto3" json:"margin_balance,omitempty"`
+	UnrealisedPnl float32                     `protobuf:"fixed32,3,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	// The maintenance margin as a ratio of the margin balance; the account is liquidated at one.
+	MarginRatio float32 `protobuf:"fixed32,4,opt,name=margin_ratio,json=marginRatio,proto3" json:"margin_ratio,omitempty"`
+	RealisedPnl float32 `protobuf:"fixed32,5,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+}
+
+func (x *ListPerpetualFuturesPositionsResponse) Reset() {
+	*x = ListPerpetualFuturesPositionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_binance_proto_binance_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPerpetualFuturesPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPerpetualFuturesPositionsResponse) ProtoMessage() {}
+
+func (x *ListPerpetualFuturesPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_binance_proto_binance_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPerpetualFuturesPositionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPerpetualFuturesPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_s_binance_proto_binance_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListPerpetualFuturesPositionsResponse) GetPositions() []*PerpetualFuturesPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *ListPerpetualFuturesPositionsResponse) GetMarginBalance() float32 {
+	if x != nil {
+		return x.MarginBalance
+	}
+	return 0
+}
+
+func (x *ListPerpetualFuturesPositionsResponse) GetUnrealisedPnl() float32 {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return 0
+}
+
+func (x *ListPerpetualFuturesPositionsResponse) GetMarginRatio() float32 {
+	if x != nil {
+		return x.MarginRatio
+	}
+	return 0
+}
+
+func (x *ListPerpetualFuturesPositionsResponse) GetRealisedPnl() float32 {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return 0
+}
+
+var File_s_binance_proto_binance_proto protoreflect.FileDescriptor
 
 var file_s_binance_proto_binance_proto_rawDesc = []byte{
 	0x0a, 0x1d, 0x73, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
@@ -1171,118 +2094,296 @@ var file_s_binance_proto_binance_proto_rawDesc = []byte{
 	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x61,
-	0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x4c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x75,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x61, 0x64,
+	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x90, 0x02, 0x0a, 0x21, 0x52, 0x65, 0x61, 0x64, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
+	0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69,
+	0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x22, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e,
+	0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x78,
+	0x0a, 0x23, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61,
+	0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x0f, 0x46, 0x75, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x75, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x0f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
-	0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x18, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0xb9, 0x02, 0x0a, 0x19, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72,
-	0x65, 0x61, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x75,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x77,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21,
-	0x0a, 0x0c, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x70, 0x6f, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x70,
-	0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x70, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x12, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x8d, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12,
-	0x2e, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x61, 0x73,
-	0x73, 0x75, 0x6d, 0x65, 0x64, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x69, 0x66, 0x74, 0x32,
-	0xa0, 0x05, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73,
-	0x12, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50,
-	0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x1f, 0x45, 0x78, 0x65,
+	0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x4c, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x0f, 0x46, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x66, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x18, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0xb9, 0x02, 0x0a, 0x19, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x72, 0x65, 0x61, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x70, 0x6f, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x70, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x70, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x12, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x8d, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x2e, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x61,
+	0x73, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x69, 0x66, 0x74,
+	0x22, 0x97, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xf7, 0x02, 0x0a, 0x05, 0x4b,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x62, 0x61, 0x73,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x6b, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4b, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x06, 0x6b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x22, 0xd1, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69,
+	0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a,
+	0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4c, 0x6f,
+	0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xc0, 0x01, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x48,
+	0x0a, 0x12, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x5f, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64,
+	0x50, 0x6e, 0x6c, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xbb, 0x02, 0x0a, 0x18, 0x50, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x72, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x6d, 0x61, 0x72, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x50, 0x6e, 0x6c,
+	0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6e, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0xf4, 0x01, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70,
+	0x6e, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c,
+	0x69, 0x73, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x32, 0xf4, 0x08,
+	0x0a, 0x07, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x19,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x69,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x6c, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x1f, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x4e, 0x65, 0x77, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x65, 0x72, 0x70,
+	0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x45, 0x78, 0x65,
 	0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x65,
-	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73,
-	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e,
-	0x65, 0x77, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
-	0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x52, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x53,
-	0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x4e, 0x65, 0x77, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e,
-	0x65, 0x77, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1b, 0x52, 0x65,
-	0x61, 0x64, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77,
+	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x53, 0x70, 0x6f,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x4e, 0x65, 0x77, 0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77,
+	0x53, 0x70, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64,
 	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x46,
-	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c,
-	0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x73, 0x12, 0x19, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x73, 0x77, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x74, 0x61, 0x69,
-	0x6c, 0x2f, 0x73, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x3b, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x65,
+	0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x19, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x12,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x19, 0x52, 0x65, 0x61,
+	0x64, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x65, 0x72,
+	0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6a, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75,
+	0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c,
+	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x65, 0x72, 0x70,
+	0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x70, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74,
+	0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74,
+	0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x72, 0x70, 0x65, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x73, 0x77, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x74,
+	0x61, 0x69, 0x6c, 0x2f, 0x73, 0x2e, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x3b, 0x62, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1297,7 +2398,7 @@ func file_s_binance_proto_binance_proto_rawDescGZIP() []byte {
 	return file_s_binance_proto_binance_proto_rawDescData
 }
 
-var file_s_binance_proto_binance_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_s_binance_proto_binance_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_s_binance_proto_binance_proto_goTypes = []interface{}{
 	(*AssetPair)(nil),                               // 0: AssetPair
 	(*ListAllAssetPairsRequest)(nil),                // 1: ListAllAssetPairsRequest
@@ -1308,54 +2409,85 @@ var file_s_binance_proto_binance_proto_goTypes = []interface{}{
 	(*ExecuteNewSpotOrderResponse)(nil),             // 6: ExecuteNewSpotOrderResponse
 	(*ReadPerpetualFuturesAccountRequest)(nil),      // 7: ReadPerpetualFuturesAccountRequest
 	(*ReadPerpetualFuturesAccountResponse)(nil),     // 8: ReadPerpetualFuturesAccountResponse
-	(*GetLatestPriceRequest)(nil),                   // 9: GetLatestPriceRequest
-	(*GetLatestPriceResponse)(nil),                  // 10: GetLatestPriceResponse
-	(*GetFundingRatesRequest)(nil),                  // 11: GetFundingRatesRequest
-	(*FundingRateInfo)(nil),                         // 12: FundingRateInfo
-	(*GetFundingRatesResponse)(nil),                 // 13: GetFundingRatesResponse
-	(*VerifyCredentialsRequest)(nil),                // 14: VerifyCredentialsRequest
-	(*VerifyCredentialsResponse)(nil),               // 15: VerifyCredentialsResponse
-	(*GetStatusRequest)(nil),                        // 16: GetStatusRequest
-	(*GetStatusResponse)(nil),                       // 17: GetStatusResponse
-	(*proto1.Order)(nil),                            // 18: Order
-	(*timestamppb.Timestamp)(nil),                   // 19: google.protobuf.Timestamp
-	(*proto1.VenueCredentials)(nil),                 // 20: VenueCredentials
+	(*ReadPerpetualFuturesOrderRequest)(nil),        // 9: ReadPerpetualFuturesOrderRequest
+	(*ReadPerpetualFuturesOrderResponse)(nil),       // 10: ReadPerpetualFuturesOrderResponse
+	(*CancelPerpetualFuturesOrderRequest)(nil),      // 11: CancelPerpetualFuturesOrderRequest
+	(*CancelPerpetualFuturesOrderResponse)(nil),     // 12: CancelPerpetualFuturesOrderResponse
+	(*GetLatestPriceRequest)(nil),                   // 13: GetLatestPriceRequest
+	(*GetLatestPriceResponse)(nil),                  // 14: GetLatestPriceResponse
+	(*GetFundingRatesRequest)(nil),                  // 15: GetFundingRatesRequest
+	(*FundingRateInfo)(nil),                         // 16: FundingRateInfo
+	(*GetFundingRatesResponse)(nil),                 // 17: GetFundingRatesResponse
+	(*VerifyCredentialsRequest)(nil),                // 18: VerifyCredentialsRequest
+	(*VerifyCredentialsResponse)(nil),               // 19: VerifyCredentialsResponse
+	(*GetStatusRequest)(nil),                        // 20: GetStatusRequest
+	(*GetStatusResponse)(nil),                       // 21: GetStatusResponse
+	(*ListKlinesRequest)(nil),                       // 22: ListKlinesRequest
+	(*Kline)(nil),                                   // 23: Kline
+	(*ListKlinesResponse)(nil),                      // 24: ListKlinesResponse
+	(*GetInstrumentFiltersRequest)(nil),             // 25: GetInstrumentFiltersRequest
+	(*GetInstrumentFiltersResponse)(nil),            // 26: GetInstrumentFiltersResponse
+	(*ListPerpetualFuturesPositionsRequest)(nil),    // 27: ListPerpetualFuturesPositionsRequest
+	(*PerpetualFuturesPosition)(nil),                // 28: PerpetualFuturesPosition
+	(*ListPerpetualFuturesPositionsResponse)(nil),   // 29: ListPerpetualFuturesPositionsResponse
+	(*proto.Order)(nil),                             // 30: Order
+	(*timestamppb.Timestamp)(nil),                   // 31: google.protobuf.Timestamp
+	(*proto.VenueCredentials)(nil),                  // 32: VenueCredentials
+	(proto.ORDER_STATUS)(0),                         // 33: ORDER_STATUS
 }
 var file_s_binance_proto_binance_proto_depIdxs = []int32{
 	0,  // 0: ListAllAssetPairsResponse.asset_pairs:type_name -> AssetPair
-	18, // 1: ExecuteNewFuturesPerpetualOrderRequest.order:type_name -> Order
-	19, // 2: ExecuteNewFuturesPerpetualOrderRequest.timestamp:type_name -> google.protobuf.Timestamp
-	20, // 3: ExecuteNewFuturesPerpetualOrderRequest.credentials:type_name -> VenueCredentials
-	18, // 4: ExecuteNewFuturesPerpetualOrderResponse.order:type_name -> Order
-	18, // 5: ExecuteNewSpotOrderRequest.order:type_name -> Order
-	19, // 6: ExecuteNewSpotOrderRequest.timestamp:type_name -> google.protobuf.Timestamp
-	20, // 7: ExecuteNewSpotOrderRequest.credentials:type_name -> VenueCredentials
-	18, // 8: ExecuteNewSpotOrderResponse.order:type_name -> Order
-	20, // 9: ReadPerpetualFuturesAccountRequest.credentials:type_name -> VenueCredentials
-	19, // 10: ReadPerpetualFuturesAccountResponse.last_updated:type_name -> google.protobuf.Timestamp
-	12, // 11: GetFundingRatesResponse.funding_rates:type_name -> FundingRateInfo
-	20, // 12: VerifyCredentialsRequest.credentials:type_name -> VenueCredentials
-	1,  // 13: binance.ListAllAssetPairs:input_type -> ListAllAssetPairsRequest
-	3,  // 14: binance.ExecuteNewFuturesPerpetualOrder:input_type -> ExecuteNewFuturesPerpetualOrderRequest
-	5,  // 15: binance.ExecuteNewSpotOrder:input_type -> ExecuteNewSpotOrderRequest
-	9,  // 16: binance.GetLatestPrice:input_type -> GetLatestPriceRequest
-	7,  // 17: binance.ReadPerpetualFuturesAccount:input_type -> ReadPerpetualFuturesAccountRequest
-	11, // 18: binance.GetFundingRates:input_type -> GetFundingRatesRequest
-	14, // 19: binance.VerifyCredentials:input_type -> VerifyCredentialsRequest
-	16, // 20: binance.GetStatus:input_type -> GetStatusRequest
-	2,  // 21: binance.ListAllAssetPairs:output_type -> ListAllAssetPairsResponse
-	4,  // 22: binance.ExecuteNewFuturesPerpetualOrder:output_type -> ExecuteNewFuturesPerpetualOrderResponse
-	6,  // 23: binance.ExecuteNewSpotOrder:output_type -> ExecuteNewSpotOrderResponse
-	10, // 24: binance.GetLatestPrice:output_type -> GetLatestPriceResponse
-	8,  // 25: binance.ReadPerpetualFuturesAccount:output_type -> ReadPerpetualFuturesAccountResponse
-	13, // 26: binance.GetFundingRates:output_type -> GetFundingRatesResponse
-	15, // 27: binance.VerifyCredentials:output_type -> VerifyCredentialsResponse
-	17, // 28: binance.GetStatus:output_type -> GetStatusResponse
-	21, // [21:29] is the sub-list for method output_type
-	13, // [13:21] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	30, // 1: ExecuteNewFuturesPerpetualOrderRequest.order:type_name -> Order
+	31, // 2: ExecuteNewFuturesPerpetualOrderRequest.timestamp:type_name -> google.protobuf.Timestamp
+	32, // 3: ExecuteNewFuturesPerpetualOrderRequest.credentials:type_name -> VenueCredentials
+	30, // 4: ExecuteNewFuturesPerpetualOrderResponse.order:type_name -> Order
+	30, // 5: ExecuteNewSpotOrderRequest.order:type_name -> Order
+	31, // 6: ExecuteNewSpotOrderRequest.timestamp:type_name -> google.protobuf.Timestamp
+	32, // 7: ExecuteNewSpotOrderRequest.credentials:type_name -> VenueCredentials
+	30, // 8: ExecuteNewSpotOrderResponse.order:type_name -> Order
+	32, // 9: ReadPerpetualFuturesAccountRequest.credentials:type_name -> VenueCredentials
+	31, // 10: ReadPerpetualFuturesAccountResponse.last_updated:type_name -> google.protobuf.Timestamp
+	32, // 11: ReadPerpetualFuturesOrderRequest.credentials:type_name -> VenueCredentials
+	33, // 12: ReadPerpetualFuturesOrderResponse.status:type_name -> ORDER_STATUS
+	32, // 13: CancelPerpetualFuturesOrderRequest.credentials:type_name -> VenueCredentials
+	33, // 14: CancelPerpetualFuturesOrderResponse.status:type_name -> ORDER_STATUS
+	16, // 15: GetFundingRatesResponse.funding_rates:type_name -> FundingRateInfo
+	32, // 16: VerifyCredentialsRequest.credentials:type_name -> VenueCredentials
+	23, // 17: ListKlinesResponse.klines:type_name -> Kline
+	32, // 18: ListPerpetualFuturesPositionsRequest.credentials:type_name -> VenueCredentials
+	31, // 19: ListPerpetualFuturesPositionsRequest.realised_pnl_since:type_name -> google.protobuf.Timestamp
+	28, // 20: ListPerpetualFuturesPositionsResponse.positions:type_name -> PerpetualFuturesPosition
+	1,  // 21: binance.ListAllAssetPairs:input_type -> ListAllAssetPairsRequest
+	3,  // 22: binance.ExecuteNewFuturesPerpetualOrder:input_type -> ExecuteNewFuturesPerpetualOrderRequest
+	5,  // 23: binance.ExecuteNewSpotOrder:input_type -> ExecuteNewSpotOrderRequest
+	13, // 24: binance.GetLatestPrice:input_type -> GetLatestPriceRequest
+	7,  // 25: binance.ReadPerpetualFuturesAccount:input_type -> ReadPerpetualFuturesAccountRequest
+	15, // 26: binance.GetFundingRates:input_type -> GetFundingRatesRequest
+	18, // 27: binance.VerifyCredentials:input_type -> VerifyCredentialsRequest
+	20, // 28: binance.GetStatus:input_type -> GetStatusRequest
+	22, // 29: binance.ListKlines:input_type -> ListKlinesRequest
+	9,  // 30: binance.ReadPerpetualFuturesOrder:input_type -> ReadPerpetualFuturesOrderRequest
+	11, // 31: binance.CancelPerpetualFuturesOrder:input_type -> CancelPerpetualFuturesOrderRequest
+	25, // 32: binance.GetInstrumentFilters:input_type -> GetInstrumentFiltersRequest
+	27, // 33: binance.ListPerpetualFuturesPositions:input_type -> ListPerpetualFuturesPositionsRequest
+	2,  // 34: binance.ListAllAssetPairs:output_type -> ListAllAssetPairsResponse
+	4,  // 35: binance.ExecuteNewFuturesPerpetualOrder:output_type -> ExecuteNewFuturesPerpetualOrderResponse
+	6,  // 36: binance.ExecuteNewSpotOrder:output_type -> ExecuteNewSpotOrderResponse
+	14, // 37: binance.GetLatestPrice:output_type -> GetLatestPriceResponse
+	8,  // 38: binance.ReadPerpetualFuturesAccount:output_type -> ReadPerpetualFuturesAccountResponse
+	17, // 39: binance.GetFundingRates:output_type -> GetFundingRatesResponse
+	19, // 40: binance.VerifyCredentials:output_type -> VerifyCredentialsResponse
+	21, // 41: binance.GetStatus:output_type -> GetStatusResponse
+	24, // 42: binance.ListKlines:output_type -> ListKlinesResponse
+	10, // 43: binance.ReadPerpetualFuturesOrder:output_type -> ReadPerpetualFuturesOrderResponse
+	12, // 44: binance.CancelPerpetualFuturesOrder:output_type -> CancelPerpetualFuturesOrderResponse
+	26, // 45: binance.GetInstrumentFilters:output_type -> GetInstrumentFiltersResponse
+	29, // 46: binance.ListPerpetualFuturesPositions:output_type -> ListPerpetualFuturesPositionsResponse
+	34, // [34:47] is the sub-list for method output_type
+	21, // [21:34] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_s_binance_proto_binance_proto_init() }
@@ -1473,7 +2605,7 @@ func file_s_binance_proto_binance_proto_init() {
 			}
 		}
 		file_s_binance_proto_binance_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLatestPriceRequest); i {
+			switch v := v.(*ReadPerpetualFuturesOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1485,7 +2617,7 @@ func file_s_binance_proto_binance_proto_init() {
 			}
 		}
 		file_s_binance_proto_binance_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLatestPriceResponse); i {
+			switch v := v.(*ReadPerpetualFuturesOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1497,7 +2629,7 @@ func file_s_binance_proto_binance_proto_init() {
 			}
 		}
 		file_s_binance_proto_binance_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFundingRatesRequest); i {
+			switch v := v.(*CancelPerpetualFuturesOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1509,7 +2641,7 @@ func file_s_binance_proto_binance_proto_init() {
 			}
 		}
 		file_s_binance_proto_binance_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FundingRateInfo); i {
+			switch v := v.(*CancelPerpetualFuturesOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1521,7 +2653,7 @@ func file_s_binance_proto_binance_proto_init() {
 			}
 		}
 		file_s_binance_proto_binance_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFundingRatesResponse); i {
+			switch v := v.(*GetLatestPriceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1533,7 +2665,7 @@ func file_s_binance_proto_binance_proto_init() {
 			}
 		}
 		file_s_binance_proto_binance_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyCredentialsRequest); i {
+			switch v := v.(*GetLatestPriceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1545,7 +2677,7 @@ func file_s_binance_proto_binance_proto_init() {
 			}
 		}
 		file_s_binance_proto_binance_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyCredentialsResponse); i {
+			switch v := v.(*GetFundingRatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1557,7 +2689,7 @@ func file_s_binance_proto_binance_proto_init() {
 			}
 		}
 		file_s_binance_proto_binance_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatusRequest); i {
+			switch v := v.(*FundingRateInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1569,6 +2701,54 @@ func file_s_binance_proto_binance_proto_init() {
 			}
 		}
 		file_s_binance_proto_binance_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFundingRatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCredentialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse); i {
 			case 0:
 				return &v.state
@@ -1580,6 +2760,102 @@ func file_s_binance_proto_binance_proto_init() {
 				return nil
 			}
 		}
+		file_s_binance_proto_binance_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKlinesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Kline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKlinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstrumentFiltersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstrumentFiltersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPerpetualFuturesPositionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerpetualFuturesPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_binance_proto_binance_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPerpetualFuturesPositionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1587,7 +2863,7 @@ func file_s_binance_proto_binance_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_s_binance_proto_binance_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
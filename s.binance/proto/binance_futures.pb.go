@@ -494,3 +494,368 @@ func (r *GetFundingRatesRequest) SendWithTimeout(ctx context.Context, timeout ti
 		resultc: resultc,
 	}
 }
+
+// --- List Klines --- //
+
+type ListKlinesFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListKlinesResponse
+	ctx     context.Context
+}
+
+func (a *ListKlinesFuture) Response() (*ListKlinesResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_klines", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListKlinesRequest) Send(ctx context.Context) *ListKlinesFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListKlinesRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListKlinesFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListKlinesResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-binance:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_binance_connection_failed", nil)
+		return &ListKlinesFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewBinanceClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListKlines(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_klines", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListKlinesFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Read Perpetual Futures Order --- //
+
+type ReadPerpetualFuturesOrderFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ReadPerpetualFuturesOrderResponse
+	ctx     context.Context
+}
+
+func (a *ReadPerpetualFuturesOrderFuture) Response() (*ReadPerpetualFuturesOrderResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "read_perpetual_futures_order", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ReadPerpetualFuturesOrderRequest) Send(ctx context.Context) *ReadPerpetualFuturesOrderFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ReadPerpetualFuturesOrderRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ReadPerpetualFuturesOrderFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ReadPerpetualFuturesOrderResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-binance:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_binance_connection_failed", nil)
+		return &ReadPerpetualFuturesOrderFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewBinanceClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ReadPerpetualFuturesOrder(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_read_perpetual_futures_order", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ReadPerpetualFuturesOrderFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Cancel Perpetual Futures Order --- //
+
+type CancelPerpetualFuturesOrderFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *CancelPerpetualFuturesOrderResponse
+	ctx     context.Context
+}
+
+func (a *CancelPerpetualFuturesOrderFuture) Response() (*CancelPerpetualFuturesOrderResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "cancel_perpetual_futures_order", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *CancelPerpetualFuturesOrderRequest) Send(ctx context.Context) *CancelPerpetualFuturesOrderFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *CancelPerpetualFuturesOrderRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *CancelPerpetualFuturesOrderFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *CancelPerpetualFuturesOrderResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-binance:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_binance_connection_failed", nil)
+		return &CancelPerpetualFuturesOrderFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewBinanceClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.CancelPerpetualFuturesOrder(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_cancel_perpetual_futures_order", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &CancelPerpetualFuturesOrderFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Get Instrument Filters --- //
+
+type GetInstrumentFiltersFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *GetInstrumentFiltersResponse
+	ctx     context.Context
+}
+
+func (a *GetInstrumentFiltersFuture) Response() (*GetInstrumentFiltersResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "get_instrument_filters", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *GetInstrumentFiltersRequest) Send(ctx context.Context) *GetInstrumentFiltersFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *GetInstrumentFiltersRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *GetInstrumentFiltersFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *GetInstrumentFiltersResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-binance:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_binance_connection_failed", nil)
+		return &GetInstrumentFiltersFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewBinanceClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.GetInstrumentFilters(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_get_instrument_filters", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &GetInstrumentFiltersFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- List Perpetual Futures Positions --- //
+
+type ListPerpetualFuturesPositionsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListPerpetualFuturesPositionsResponse
+	ctx     context.Context
+}
+
+func (a *ListPerpetualFuturesPositionsFuture) Response() (*ListPerpetualFuturesPositionsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_perpetual_futures_positions", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListPerpetualFuturesPositionsRequest) Send(ctx context.Context) *ListPerpetualFuturesPositionsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListPerpetualFuturesPositionsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListPerpetualFuturesPositionsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListPerpetualFuturesPositionsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-binance:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_binance_connection_failed", nil)
+		return &ListPerpetualFuturesPositionsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewBinanceClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListPerpetualFuturesPositions(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_perpetual_futures_positions", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListPerpetualFuturesPositionsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
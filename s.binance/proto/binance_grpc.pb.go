@@ -11,7 +11,8 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
 
 // BinanceClient is the client API for Binance service.
 //
@@ -25,6 +26,11 @@ type BinanceClient interface {
 	GetFundingRates(ctx context.Context, in *GetFundingRatesRequest, opts ...grpc.CallOption) (*GetFundingRatesResponse, error)
 	VerifyCredentials(ctx context.Context, in *VerifyCredentialsRequest, opts ...grpc.CallOption) (*VerifyCredentialsResponse, error)
 	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	ListKlines(ctx context.Context, in *ListKlinesRequest, opts ...grpc.CallOption) (*ListKlinesResponse, error)
+	ReadPerpetualFuturesOrder(ctx context.Context, in *ReadPerpetualFuturesOrderRequest, opts ...grpc.CallOption) (*ReadPerpetualFuturesOrderResponse, error)
+	CancelPerpetualFuturesOrder(ctx context.Context, in *CancelPerpetualFuturesOrderRequest, opts ...grpc.CallOption) (*CancelPerpetualFuturesOrderResponse, error)
+	GetInstrumentFilters(ctx context.Context, in *GetInstrumentFiltersRequest, opts ...grpc.CallOption) (*GetInstrumentFiltersResponse, error)
+	ListPerpetualFuturesPositions(ctx context.Context, in *ListPerpetualFuturesPositionsRequest, opts ...grpc.CallOption) (*ListPerpetualFuturesPositionsResponse, error)
 }
 
 type binanceClient struct {
@@ -107,6 +113,51 @@ func (c *binanceClient) GetStatus(ctx context.Context, in *GetStatusRequest, opt
 	return out, nil
 }
 
+func (c *binanceClient) ListKlines(ctx context.Context, in *ListKlinesRequest, opts ...grpc.CallOption) (*ListKlinesResponse, error) {
+	out := new(ListKlinesResponse)
+	err := c.cc.Invoke(ctx, "/binance/ListKlines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binanceClient) ReadPerpetualFuturesOrder(ctx context.Context, in *ReadPerpetualFuturesOrderRequest, opts ...grpc.CallOption) (*ReadPerpetualFuturesOrderResponse, error) {
+	out := new(ReadPerpetualFuturesOrderResponse)
+	err := c.cc.Invoke(ctx, "/binance/ReadPerpetualFuturesOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binanceClient) CancelPerpetualFuturesOrder(ctx context.Context, in *CancelPerpetualFuturesOrderRequest, opts ...grpc.CallOption) (*CancelPerpetualFuturesOrderResponse, error) {
+	out := new(CancelPerpetualFuturesOrderResponse)
+	err := c.cc.Invoke(ctx, "/binance/CancelPerpetualFuturesOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binanceClient) GetInstrumentFilters(ctx context.Context, in *GetInstrumentFiltersRequest, opts ...grpc.CallOption) (*GetInstrumentFiltersResponse, error) {
+	out := new(GetInstrumentFiltersResponse)
+	err := c.cc.Invoke(ctx, "/binance/GetInstrumentFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binanceClient) ListPerpetualFuturesPositions(ctx context.Context, in *ListPerpetualFuturesPositionsRequest, opts ...grpc.CallOption) (*ListPerpetualFuturesPositionsResponse, error) {
+	out := new(ListPerpetualFuturesPositionsResponse)
+	err := c.cc.Invoke(ctx, "/binance/ListPerpetualFuturesPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BinanceServer is the server API for Binance service.
 // All implementations must embed UnimplementedBinanceServer
 // for forward compatibility
@@ -119,6 +170,11 @@ type BinanceServer interface {
 	GetFundingRates(context.Context, *GetFundingRatesRequest) (*GetFundingRatesResponse, error)
 	VerifyCredentials(context.Context, *VerifyCredentialsRequest) (*VerifyCredentialsResponse, error)
 	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
+	ListKlines(context.Context, *ListKlinesRequest) (*ListKlinesResponse, error)
+	ReadPerpetualFuturesOrder(context.Context, *ReadPerpetualFuturesOrderRequest) (*ReadPerpetualFuturesOrderResponse, error)
+	CancelPerpetualFuturesOrder(context.Context, *CancelPerpetualFuturesOrderRequest) (*CancelPerpetualFuturesOrderResponse, error)
+	GetInstrumentFilters(context.Context, *GetInstrumentFiltersRequest) (*GetInstrumentFiltersResponse, error)
+	ListPerpetualFuturesPositions(context.Context, *ListPerpetualFuturesPositionsRequest) (*ListPerpetualFuturesPositionsResponse, error)
 	mustEmbedUnimplementedBinanceServer()
 }
 
@@ -126,34 +182,56 @@ type BinanceServer interface {
 type UnimplementedBinanceServer struct {
 }
 
-func (*UnimplementedBinanceServer) ListAllAssetPairs(context.Context, *ListAllAssetPairsRequest) (*ListAllAssetPairsResponse, error) {
+func (UnimplementedBinanceServer) ListAllAssetPairs(context.Context, *ListAllAssetPairsRequest) (*ListAllAssetPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllAssetPairs not implemented")
 }
-func (*UnimplementedBinanceServer) ExecuteNewFuturesPerpetualOrder(context.Context, *ExecuteNewFuturesPerpetualOrderRequest) (*ExecuteNewFuturesPerpetualOrderResponse, error) {
+func (UnimplementedBinanceServer) ExecuteNewFuturesPerpetualOrder(context.Context, *ExecuteNewFuturesPerpetualOrderRequest) (*ExecuteNewFuturesPerpetualOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteNewFuturesPerpetualOrder not implemented")
 }
-func (*UnimplementedBinanceServer) ExecuteNewSpotOrder(context.Context, *ExecuteNewSpotOrderRequest) (*ExecuteNewSpotOrderResponse, error) {
+func (UnimplementedBinanceServer) ExecuteNewSpotOrder(context.Context, *ExecuteNewSpotOrderRequest) (*ExecuteNewSpotOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteNewSpotOrder not implemented")
 }
-func (*UnimplementedBinanceServer) GetLatestPrice(context.Context, *GetLatestPriceRequest) (*GetLatestPriceResponse, error) {
+func (UnimplementedBinanceServer) GetLatestPrice(context.Context, *GetLatestPriceRequest) (*GetLatestPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLatestPrice not implemented")
 }
-func (*UnimplementedBinanceServer) ReadPerpetualFuturesAccount(context.Context, *ReadPerpetualFuturesAccountRequest) (*ReadPerpetualFuturesAccountResponse, error) {
+func (UnimplementedBinanceServer) ReadPerpetualFuturesAccount(context.Context, *ReadPerpetualFuturesAccountRequest) (*ReadPerpetualFuturesAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadPerpetualFuturesAccount not implemented")
 }
-func (*UnimplementedBinanceServer) GetFundingRates(context.Context, *GetFundingRatesRequest) (*GetFundingRatesResponse, error) {
+func (UnimplementedBinanceServer) GetFundingRates(context.Context, *GetFundingRatesRequest) (*GetFundingRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFundingRates not implemented")
 }
-func (*UnimplementedBinanceServer) VerifyCredentials(context.Context, *VerifyCredentialsRequest) (*VerifyCredentialsResponse, error) {
+func (UnimplementedBinanceServer) VerifyCredentials(context.Context, *VerifyCredentialsRequest) (*VerifyCredentialsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredentials not implemented")
 }
-func (*UnimplementedBinanceServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error) {
+func (UnimplementedBinanceServer) GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
 }
-func (*UnimplementedBinanceServer) mustEmbedUnimplementedBinanceServer() {}
+func (UnimplementedBinanceServer) ListKlines(context.Context, *ListKlinesRequest) (*ListKlinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKlines not implemented")
+}
+func (UnimplementedBinanceServer) ReadPerpetualFuturesOrder(context.Context, *ReadPerpetualFuturesOrderRequest) (*ReadPerpetualFuturesOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPerpetualFuturesOrder not implemented")
+}
+func (UnimplementedBinanceServer) CancelPerpetualFuturesOrder(context.Context, *CancelPerpetualFuturesOrderRequest) (*CancelPerpetualFuturesOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPerpetualFuturesOrder not implemented")
+}
+func (UnimplementedBinanceServer) GetInstrumentFilters(context.Context, *GetInstrumentFiltersRequest) (*GetInstrumentFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstrumentFilters not implemented")
+}
+func (UnimplementedBinanceServer) ListPerpetualFuturesPositions(context.Context, *ListPerpetualFuturesPositionsRequest) (*ListPerpetualFuturesPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPerpetualFuturesPositions not implemented")
+}
+func (UnimplementedBinanceServer) mustEmbedUnimplementedBinanceServer() {}
+
+// UnsafeBinanceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BinanceServer will
+// result in compilation errors.
+type UnsafeBinanceServer interface {
+	mustEmbedUnimplementedBinanceServer()
+}
 
-func RegisterBinanceServer(s *grpc.Server, srv BinanceServer) {
-	s.RegisterService(&_Binance_serviceDesc, srv)
+func RegisterBinanceServer(s grpc.ServiceRegistrar, srv BinanceServer) {
+	s.RegisterService(&Binance_ServiceDesc, srv)
 }
 
 func _Binance_ListAllAssetPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -300,7 +378,100 @@ func _Binance_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Binance_serviceDesc = grpc.ServiceDesc{
+func _Binance_ListKlines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKlinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinanceServer).ListKlines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance/ListKlines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinanceServer).ListKlines(ctx, req.(*ListKlinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binance_ReadPerpetualFuturesOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPerpetualFuturesOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinanceServer).ReadPerpetualFuturesOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance/ReadPerpetualFuturesOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinanceServer).ReadPerpetualFuturesOrder(ctx, req.(*ReadPerpetualFuturesOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binance_CancelPerpetualFuturesOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPerpetualFuturesOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinanceServer).CancelPerpetualFuturesOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance/CancelPerpetualFuturesOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinanceServer).CancelPerpetualFuturesOrder(ctx, req.(*CancelPerpetualFuturesOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binance_GetInstrumentFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstrumentFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinanceServer).GetInstrumentFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance/GetInstrumentFilters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinanceServer).GetInstrumentFilters(ctx, req.(*GetInstrumentFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Binance_ListPerpetualFuturesPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPerpetualFuturesPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinanceServer).ListPerpetualFuturesPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/binance/ListPerpetualFuturesPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinanceServer).ListPerpetualFuturesPositions(ctx, req.(*ListPerpetualFuturesPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Binance_ServiceDesc is the grpc.ServiceDesc for Binance service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Binance_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "binance",
 	HandlerType: (*BinanceServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -336,6 +507,26 @@ var _Binance_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatus",
 			Handler:    _Binance_GetStatus_Handler,
 		},
+		{
+			MethodName: "ListKlines",
+			Handler:    _Binance_ListKlines_Handler,
+		},
+		{
+			MethodName: "ReadPerpetualFuturesOrder",
+			Handler:    _Binance_ReadPerpetualFuturesOrder_Handler,
+		},
+		{
+			MethodName: "CancelPerpetualFuturesOrder",
+			Handler:    _Binance_CancelPerpetualFuturesOrder_Handler,
+		},
+		{
+			MethodName: "GetInstrumentFilters",
+			Handler:    _Binance_GetInstrumentFilters_Handler,
+		},
+		{
+			MethodName: "ListPerpetualFuturesPositions",
+			Handler:    _Binance_ListPerpetualFuturesPositions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "s.binance/proto/binance.proto",
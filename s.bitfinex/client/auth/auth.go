@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"swallowtail/libraries/gerrors"
+)
+
+// SignRequest signs some signature payload with the given secret as required for Bitfinex.
+// https://docs.bitfinex.com/docs/rest-auth
+func SignRequest(path, nonce string, req interface{}, credentials *Credentials) (string, error) {
+	var rawReq []byte
+	if req != nil {
+		var err error
+
+		rawReq, err = json.Marshal(req)
+		if err != nil {
+			return "", gerrors.Augment(err, "failed_to_sign_request.marshal_request_to_bytes", nil)
+		}
+	}
+
+	signaturePayload := fmt.Sprintf("/api/%s%s%s", path, nonce, rawReq)
+
+	h := hmac.New(sha512.New384, []byte(credentials.SecretKey))
+	if _, err := h.Write([]byte(signaturePayload)); err != nil {
+		return "", gerrors.Augment(err, "failed_to_sign_with_hmac_sha384", nil)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Credentials holds the credentials required for Bitfinex.
+type Credentials struct {
+	APIKey    string
+	SecretKey string
+}
+
+// AsHeaders converts the credentials struct into the headers required to verify the user.
+// It uses the request path, body & the nonce to sign the request.
+func (c *Credentials) AsHeaders(signature, nonce string) map[string]string {
+	if c == nil {
+		return map[string]string{}
+	}
+
+	return map[string]string{
+		"Content-Type":  "application/json",
+		"bfx-apikey":    c.APIKey,
+		"bfx-signature": signature,
+		"bfx-nonce":     nonce,
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"swallowtail/libraries/gerrors"
 	"swallowtail/libraries/transport"
+	"swallowtail/s.bitfinex/client/auth"
 	"swallowtail/s.bitfinex/dto"
 )
 
@@ -21,12 +22,17 @@ type BitfinexClient interface {
 	Ping(ctx context.Context) error
 	GetStatus(ctx context.Context, req *dto.GetStatusRequest) (*dto.GetStatusResponse, error)
 	GetFundingRates(ctx context.Context, req *dto.GetFundingRatesRequest) (*dto.GetFundingRatesResponse, error)
+	SubmitOrder(ctx context.Context, req *dto.SubmitOrderRequest, credentials *auth.Credentials) (*dto.SubmitOrderResponse, error)
+	CancelOrder(ctx context.Context, req *dto.CancelOrderRequest, credentials *auth.Credentials) (*dto.CancelOrderResponse, error)
+	ListWallets(ctx context.Context, req *dto.ListWalletsRequest, credentials *auth.Credentials) (*dto.ListWalletsResponse, error)
+	VerifyCredentials(ctx context.Context, req *dto.VerifyCredentialsRequest, credentials *auth.Credentials) (*dto.VerifyCredentialsResponse, error)
 }
 
 // Init initializes the default bitfinex client.
 func Init(ctx context.Context) error {
 	cli := &bitfinexClient{
-		http: transport.NewHTTPClient(10*time.Second, &bitfinexRateLimiter{}),
+		http:                  transport.NewHTTPClient(10*time.Second, &bitfinexRateLimiter{}),
+		authenticatedHostname: bitfinexAuthenticatedURL,
 	}
 
 	if err := cli.Ping(ctx); err != nil {
@@ -63,3 +69,31 @@ func GetFundingRates(ctx context.Context, req *dto.GetFundingRatesRequest) (*dto
 	defer span.Finish()
 	return client.GetFundingRates(ctx, req)
 }
+
+// SubmitOrder ...
+func SubmitOrder(ctx context.Context, req *dto.SubmitOrderRequest, credentials *auth.Credentials) (*dto.SubmitOrderResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Submit bitfinex order")
+	defer span.Finish()
+	return client.SubmitOrder(ctx, req, credentials)
+}
+
+// CancelOrder ...
+func CancelOrder(ctx context.Context, req *dto.CancelOrderRequest, credentials *auth.Credentials) (*dto.CancelOrderResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Cancel bitfinex order")
+	defer span.Finish()
+	return client.CancelOrder(ctx, req, credentials)
+}
+
+// ListWallets ...
+func ListWallets(ctx context.Context, req *dto.ListWalletsRequest, credentials *auth.Credentials) (*dto.ListWalletsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "List bitfinex wallets")
+	defer span.Finish()
+	return client.ListWallets(ctx, req, credentials)
+}
+
+// VerifyCredentials ...
+func VerifyCredentials(ctx context.Context, req *dto.VerifyCredentialsRequest, credentials *auth.Credentials) (*dto.VerifyCredentialsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Verify bitfinex credentials")
+	defer span.Finish()
+	return client.VerifyCredentials(ctx, req, credentials)
+}
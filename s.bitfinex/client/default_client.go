@@ -4,19 +4,24 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"swallowtail/libraries/gerrors"
 	"swallowtail/libraries/transport"
+	"swallowtail/s.bitfinex/client/auth"
 	"swallowtail/s.bitfinex/dto"
 )
 
 const (
-	bitfinexURL        = "https://api-pub.bitfinex.com"
-	bitfinexAPIVersion = "v2"
+	bitfinexURL              = "https://api-pub.bitfinex.com"
+	bitfinexAuthenticatedURL = "https://api.bitfinex.com"
+	bitfinexAPIVersion       = "v2"
 )
 
 type bitfinexClient struct {
 	http transport.HttpClient
+	// authenticatedHostname is the hostname of authenticated endpoints; public endpoints are served from a separate host.
+	authenticatedHostname string
 }
 
 func (b *bitfinexClient) Ping(ctx context.Context) error {
@@ -57,3 +62,82 @@ func (b *bitfinexClient) GetFundingRates(ctx context.Context, req *dto.GetFundin
 		},
 	}, nil
 }
+
+func (b *bitfinexClient) SubmitOrder(ctx context.Context, req *dto.SubmitOrderRequest, credentials *auth.Credentials) (*dto.SubmitOrderResponse, error) {
+	errParams := map[string]string{
+		"symbol": req.Symbol,
+		"type":   req.Type,
+	}
+
+	rsp := dto.NotificationProxyResponse{}
+	if err := b.signBeforeDo(ctx, fmt.Sprintf("%s/auth/w/order/submit", bitfinexAPIVersion), req, &rsp, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_submit_order", errParams)
+	}
+
+	if rsp.Status() != "SUCCESS" {
+		errParams["status"] = rsp.Status()
+		errParams["text"] = rsp.Text()
+		return nil, gerrors.FailedPrecondition("failed_to_submit_order.order_rejected", errParams)
+	}
+
+	orders := rsp.Orders()
+	if len(orders) == 0 {
+		return nil, gerrors.FailedPrecondition("failed_to_submit_order.missing_order_in_response", errParams)
+	}
+
+	return &dto.SubmitOrderResponse{
+		Order: orders[0],
+	}, nil
+}
+
+func (b *bitfinexClient) CancelOrder(ctx context.Context, req *dto.CancelOrderRequest, credentials *auth.Credentials) (*dto.CancelOrderResponse, error) {
+	errParams := map[string]string{
+		"order_id": strconv.FormatInt(req.ID, 10),
+	}
+
+	rsp := dto.NotificationProxyResponse{}
+	if err := b.signBeforeDo(ctx, fmt.Sprintf("%s/auth/w/order/cancel", bitfinexAPIVersion), req, &rsp, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_order", errParams)
+	}
+
+	if rsp.Status() != "SUCCESS" {
+		errParams["status"] = rsp.Status()
+		errParams["text"] = rsp.Text()
+		return nil, gerrors.FailedPrecondition("failed_to_cancel_order.cancel_rejected", errParams)
+	}
+
+	return &dto.CancelOrderResponse{}, nil
+}
+
+func (b *bitfinexClient) ListWallets(ctx context.Context, req *dto.ListWalletsRequest, credentials *auth.Credentials) (*dto.ListWalletsResponse, error) {
+	rsp := dto.ListWalletsProxyResponse{}
+	if err := b.signBeforeDo(ctx, fmt.Sprintf("%s/auth/r/wallets", bitfinexAPIVersion), req, &rsp, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_wallets", nil)
+	}
+
+	return &dto.ListWalletsResponse{
+		Wallets: rsp.Wallets(),
+	}, nil
+}
+
+func (b *bitfinexClient) VerifyCredentials(ctx context.Context, req *dto.VerifyCredentialsRequest, credentials *auth.Credentials) (*dto.VerifyCredentialsResponse, error) {
+	rsp := dto.ListPermissionsProxyResponse{}
+	if err := b.signBeforeDo(ctx, fmt.Sprintf("%s/auth/r/permissions", bitfinexAPIVersion), req, &rsp, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_verify_credentials", nil)
+	}
+
+	permissions := rsp.Permissions()
+
+	var verified = &dto.VerifyCredentialsResponse{}
+	if p, ok := permissions["orders"]; ok {
+		verified.OrdersEnabled = p.Read && p.Write
+	}
+	if p, ok := permissions["wallets"]; ok {
+		verified.WalletsEnabled = p.Read
+	}
+	if p, ok := permissions["withdraw"]; ok {
+		verified.WithdrawEnabled = p.Write
+	}
+
+	return verified, nil
+}
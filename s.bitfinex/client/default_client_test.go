@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/transport"
+	"swallowtail/s.bitfinex/client/auth"
+	"swallowtail/s.bitfinex/dto"
+)
+
+const (
+	testAPIKey    = "api-key"
+	testSecretKey = "secret-key"
+)
+
+// newFakeBitfinexServer returns a fake of the authenticated Bitfinex api; every request must be correctly signed.
+func newFakeBitfinexServer(t *testing.T, handlers map[string]func(body []byte) (int, interface{})) *bitfinexClient {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		h := hmac.New(sha512.New384, []byte(testSecretKey))
+		h.Write([]byte(fmt.Sprintf("/api%s%s%s", r.URL.Path, r.Header.Get("bfx-nonce"), body)))
+
+		switch {
+		case r.Method != http.MethodPost:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		case r.Header.Get("bfx-apikey") != testAPIKey, r.Header.Get("bfx-signature") != hex.EncodeToString(h.Sum(nil)):
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode([]interface{}{"error", 10100, "apikey: invalid"})
+			return
+		}
+
+		handler, ok := handlers[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		statusCode, rsp := handler(body)
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(rsp)
+	}))
+	t.Cleanup(srv.Close)
+
+	return &bitfinexClient{
+		http:                  transport.NewHTTPClient(5*time.Second, &bitfinexRateLimiter{}),
+		authenticatedHostname: srv.URL,
+	}
+}
+
+func testCredentials() *auth.Credentials {
+	return &auth.Credentials{
+		APIKey:    testAPIKey,
+		SecretKey: testSecretKey,
+	}
+}
+
+func TestSubmitOrder(t *testing.T) {
+	t.Parallel()
+
+	var received *dto.SubmitOrderRequest
+	cli := newFakeBitfinexServer(t, map[string]func(body []byte) (int, interface{}){
+		"/v2/auth/w/order/submit": func(body []byte) (int, interface{}) {
+			received = &dto.SubmitOrderRequest{}
+			require.NoError(t, json.Unmarshal(body, received))
+
+			if received.Symbol == "tFAKE:UST" {
+				return http.StatusOK, []interface{}{1678000000000, "on-req", nil, nil, []interface{}{}, nil, "ERROR", "symbol: invalid"}
+			}
+
+			return http.StatusOK, []interface{}{
+				1678000000000, "on-req", nil, nil,
+				[]interface{}{
+					[]interface{}{int64(123456789), nil, 1, received.Symbol, int64(1678000000123)},
+				},
+				nil, "SUCCESS", "Submitting 1 orders.",
+			}
+		},
+	})
+
+	ctx := context.Background()
+
+	rsp, err := cli.SubmitOrder(ctx, &dto.SubmitOrderRequest{
+		Type:   "LIMIT",
+		Symbol: "tBTCF0:USTF0",
+		Amount: "-0.5",
+		Price:  "21000",
+		Flags:  1024,
+	}, testCredentials())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(123456789), rsp.Order.ID)
+	assert.Equal(t, "tBTCF0:USTF0", rsp.Order.Symbol)
+	assert.Equal(t, int64(1678000000123), rsp.Order.CreatedAt.UnixMilli())
+	assert.Equal(t, "-0.5", received.Amount)
+	assert.Equal(t, 1024, received.Flags)
+
+	// Rejected orders are returned as a notification with an error status.
+	_, err = cli.SubmitOrder(ctx, &dto.SubmitOrderRequest{
+		Type:   "EXCHANGE MARKET",
+		Symbol: "tFAKE:UST",
+		Amount: "1",
+	}, testCredentials())
+	require.Error(t, err)
+	assert.True(t, gerrors.Is(err, gerrors.ErrFailedPrecondition, "failed_to_submit_order.order_rejected"))
+
+	// Invalid credentials are rejected by the venue.
+	_, err = cli.SubmitOrder(ctx, &dto.SubmitOrderRequest{
+		Type:   "MARKET",
+		Symbol: "tBTCF0:USTF0",
+		Amount: "1",
+	}, &auth.Credentials{APIKey: testAPIKey, SecretKey: "wrong"})
+	require.Error(t, err)
+}
+
+func TestListWallets(t *testing.T) {
+	t.Parallel()
+
+	cli := newFakeBitfinexServer(t, map[string]func(body []byte) (int, interface{}){
+		"/v2/auth/r/wallets": func(body []byte) (int, interface{}) {
+			return http.StatusOK, []interface{}{
+				[]interface{}{"exchange", "UST", 1000.5, 0, 900.5, "", nil},
+				[]interface{}{"margin", "USTF0", 250, 0, nil, "", nil},
+			}
+		},
+	})
+
+	rsp, err := cli.ListWallets(context.Background(), &dto.ListWalletsRequest{}, testCredentials())
+	require.NoError(t, err)
+	require.Len(t, rsp.Wallets, 2)
+
+	assert.Equal(t, &dto.Wallet{Type: "exchange", Currency: "UST", Balance: 1000.5, AvailableBalance: 900.5}, rsp.Wallets[0])
+	// A null available balance is treated as zero.
+	assert.Equal(t, &dto.Wallet{Type: "margin", Currency: "USTF0", Balance: 250}, rsp.Wallets[1])
+}
+
+func TestVerifyCredentials(t *testing.T) {
+	t.Parallel()
+
+	cli := newFakeBitfinexServer(t, map[string]func(body []byte) (int, interface{}){
+		"/v2/auth/r/permissions": func(body []byte) (int, interface{}) {
+			return http.StatusOK, []interface{}{
+				[]interface{}{"account", 1, 0},
+				[]interface{}{"orders", 1, 1},
+				[]interface{}{"wallets", 1, 0},
+				[]interface{}{"withdraw", 0, 0},
+			}
+		},
+	})
+
+	rsp, err := cli.VerifyCredentials(context.Background(), &dto.VerifyCredentialsRequest{}, testCredentials())
+	require.NoError(t, err)
+
+	assert.Equal(t, &dto.VerifyCredentialsResponse{
+		OrdersEnabled:   true,
+		WalletsEnabled:  true,
+		WithdrawEnabled: false,
+	}, rsp)
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.bitfinex/client/auth"
+)
+
+// signBeforeDo signs & sends a request to an authenticated endpoint; all authenticated endpoints on Bitfinex are POSTs.
+func (b *bitfinexClient) signBeforeDo(ctx context.Context, path string, req, rsp interface{}, credentials *auth.Credentials) error {
+	// Bitfinex requires the nonce to be strictly increasing per api key; microseconds give us headroom over the
+	// millisecond nonces used by most clients.
+	nonce := strconv.FormatInt(time.Now().UnixNano()/1_000, 10)
+
+	signature, err := auth.SignRequest(path, nonce, req, credentials)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_send_request.failed_to_sign_request", nil)
+	}
+
+	url := fmt.Sprintf("%s/%s", b.authenticatedHostname, path)
+
+	return b.http.DoWithEphemeralHeaders(
+		ctx,
+		http.MethodPost,
+		url,
+		req,
+		rsp,
+		credentials.AsHeaders(signature, nonce),
+	)
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // GetStatusRequest ...
 type GetStatusRequest struct {
 }
@@ -52,3 +54,173 @@ type GetFundingRatesResponse struct {
 	Symbol       string             `json:"symbol"`
 	FundingRates []*FundingRateInfo `json:"funding_rates"`
 }
+
+// SubmitOrderRequest ...
+// https://docs.bitfinex.com/reference/rest-auth-submit-order
+type SubmitOrderRequest struct {
+	Type   string `json:"type"`
+	Symbol string `json:"symbol"`
+	// Amount is signed; positive for buys & negative for sells.
+	Amount string `json:"amount"`
+	Price  string `json:"price,omitempty"`
+	// PriceAuxLimit is the limit price of stop limit orders.
+	PriceAuxLimit string `json:"price_aux_limit,omitempty"`
+	Flags         int    `json:"flags,omitempty"`
+}
+
+// NotificationProxyResponse is the notification returned by Bitfinex on write endpoints:
+// [MTS, TYPE, MESSAGE_ID, null, DATA, CODE, STATUS, TEXT]
+type NotificationProxyResponse []interface{}
+
+// Status ...
+func (p NotificationProxyResponse) Status() string {
+	if len(p) < 7 {
+		return ""
+	}
+
+	s, _ := p[6].(string)
+	return s
+}
+
+// Text ...
+func (p NotificationProxyResponse) Text() string {
+	if len(p) < 8 {
+		return ""
+	}
+
+	s, _ := p[7].(string)
+	return s
+}
+
+// Orders returns the orders in the notification data; each order is [ID, GID, CID, SYMBOL, MTS_CREATE, ...].
+func (p NotificationProxyResponse) Orders() []*SubmittedOrder {
+	if len(p) < 5 {
+		return nil
+	}
+
+	data, ok := p[4].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var orders []*SubmittedOrder
+	for _, d := range data {
+		o, ok := d.([]interface{})
+		if !ok || len(o) < 5 {
+			continue
+		}
+
+		id, _ := o[0].(float64)
+		symbol, _ := o[3].(string)
+		created, _ := o[4].(float64)
+
+		orders = append(orders, &SubmittedOrder{
+			ID:        int64(id),
+			Symbol:    symbol,
+			CreatedAt: time.UnixMilli(int64(created)).UTC(),
+		})
+	}
+
+	return orders
+}
+
+// SubmittedOrder ...
+type SubmittedOrder struct {
+	ID        int64
+	Symbol    string
+	CreatedAt time.Time
+}
+
+// SubmitOrderResponse ...
+type SubmitOrderResponse struct {
+	Order *SubmittedOrder
+}
+
+// CancelOrderRequest ...
+type CancelOrderRequest struct {
+	ID int64 `json:"id"`
+}
+
+// CancelOrderResponse ...
+type CancelOrderResponse struct{}
+
+// ListWalletsRequest ...
+type ListWalletsRequest struct{}
+
+// ListWalletsProxyResponse is a list of wallets: [WALLET_TYPE, CURRENCY, BALANCE, UNSETTLED_INTEREST, AVAILABLE_BALANCE, ...]
+type ListWalletsProxyResponse [][]interface{}
+
+// Wallets ...
+func (p ListWalletsProxyResponse) Wallets() []*Wallet {
+	wallets := make([]*Wallet, 0, len(p))
+	for _, w := range p {
+		if len(w) < 5 {
+			continue
+		}
+
+		walletType, _ := w[0].(string)
+		currency, _ := w[1].(string)
+		balance, _ := w[2].(float64)
+		// The available balance is null if it hasn't been calculated recently.
+		availableBalance, _ := w[4].(float64)
+
+		wallets = append(wallets, &Wallet{
+			Type:             walletType,
+			Currency:         currency,
+			Balance:          balance,
+			AvailableBalance: availableBalance,
+		})
+	}
+
+	return wallets
+}
+
+// Wallet ...
+type Wallet struct {
+	// Type is one of `exchange`, `margin` or `funding`.
+	Type             string
+	Currency         string
+	Balance          float64
+	AvailableBalance float64
+}
+
+// ListWalletsResponse ...
+type ListWalletsResponse struct {
+	Wallets []*Wallet
+}
+
+// VerifyCredentialsRequest ...
+type VerifyCredentialsRequest struct{}
+
+// ListPermissionsProxyResponse is a list of permissions of the api key: [SCOPE, READ, WRITE]
+type ListPermissionsProxyResponse [][3]interface{}
+
+// Permissions ...
+func (p ListPermissionsProxyResponse) Permissions() map[string]*Permission {
+	permissions := make(map[string]*Permission, len(p))
+	for _, perm := range p {
+		scope, _ := perm[0].(string)
+		read, _ := perm[1].(float64)
+		write, _ := perm[2].(float64)
+
+		permissions[scope] = &Permission{
+			Read:  read == 1,
+			Write: write == 1,
+		}
+	}
+
+	return permissions
+}
+
+// Permission ...
+type Permission struct {
+	Read  bool
+	Write bool
+}
+
+// VerifyCredentialsResponse ...
+type VerifyCredentialsResponse struct {
+	OrdersEnabled   bool
+	WalletsEnabled  bool
+	WithdrawEnabled bool
+}
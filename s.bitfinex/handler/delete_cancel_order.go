@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.bitfinex/client"
+	"swallowtail/s.bitfinex/dto"
+	"swallowtail/s.bitfinex/marshaling"
+	bitfinexproto "swallowtail/s.bitfinex/proto"
+)
+
+// CancelBitfinexOrder cancels a resting order on Bitfinex.
+func (s *BitfinexService) CancelBitfinexOrder(
+	ctx context.Context, in *bitfinexproto.CancelBitfinexOrderRequest,
+) (*bitfinexproto.CancelBitfinexOrderResponse, error) {
+	// Validate request.
+	switch {
+	case in.ExternalOrderId == "":
+		return nil, gerrors.BadParam("missing_param.external_order_id", nil)
+	}
+
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Unauthenticated("unauthorized.invalid_credentials", map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	errParams := map[string]string{
+		"external_order_id": in.ExternalOrderId,
+	}
+
+	orderID, err := strconv.ParseInt(in.ExternalOrderId, 10, 64)
+	if err != nil {
+		return nil, gerrors.BadParam("bad_param.external_order_id", errParams)
+	}
+
+	// Marshal credentials.
+	dtoCredentials := marshaling.VenueCredentialsProtoToBitfinexCredentials(in.Credentials)
+
+	if _, err := client.CancelOrder(ctx, &dto.CancelOrderRequest{
+		ID: orderID,
+	}, dtoCredentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_order", errParams)
+	}
+
+	return &bitfinexproto.CancelBitfinexOrderResponse{
+		ExternalOrderId: in.ExternalOrderId,
+	}, nil
+}
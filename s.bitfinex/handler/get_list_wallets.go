@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.bitfinex/client"
+	"swallowtail/s.bitfinex/dto"
+	"swallowtail/s.bitfinex/marshaling"
+	bitfinexproto "swallowtail/s.bitfinex/proto"
+)
+
+// ListBitfinexWallets lists the wallets, and their balances, of the account the credentials belong to.
+func (s *BitfinexService) ListBitfinexWallets(
+	ctx context.Context, in *bitfinexproto.ListBitfinexWalletsRequest,
+) (*bitfinexproto.ListBitfinexWalletsResponse, error) {
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_wallets", nil)
+	}
+
+	// Marshal credentials.
+	dtoCredentials := marshaling.VenueCredentialsProtoToBitfinexCredentials(in.Credentials)
+
+	// List wallets.
+	rsp, err := client.ListWallets(ctx, &dto.ListWalletsRequest{}, dtoCredentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_wallets", nil)
+	}
+
+	return &bitfinexproto.ListBitfinexWalletsResponse{
+		Wallets: marshaling.WalletsDTOToProtos(rsp.Wallets),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"swallowtail/libraries/gerrors"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func validateCredentials(credentials *tradeengineproto.VenueCredentials) error {
+	switch {
+	case credentials == nil:
+		return gerrors.BadParam("missing_param.credentials", nil)
+	case credentials.ApiKey == "":
+		return gerrors.BadParam("missing_param.credentials.api_key", nil)
+	case credentials.SecretKey == "":
+		return gerrors.BadParam("missing_param.credentials.secret_key", nil)
+	default:
+		return nil
+	}
+}
+
+func validateOrder(order *tradeengineproto.Order) error {
+	switch {
+	case order.Venue != tradeengineproto.VENUE_BITFINEX:
+		return gerrors.FailedPrecondition("invalid_venue.expecting_bitfinex", nil)
+	case order.Instrument == "" && order.Asset == "":
+		return gerrors.BadParam("missing_param.instrument_or_asset", nil)
+	case order.ClosePosition && order.Quantity == 0:
+		return gerrors.Unimplemented("unimplemented.close_position", nil)
+	case order.Quantity <= 0:
+		return gerrors.BadParam("missing_param.quantity", nil)
+	}
+
+	switch order.InstrumentType {
+	case tradeengineproto.INSTRUMENT_TYPE_SPOT, tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL:
+	default:
+		return gerrors.Unimplemented("instrument_type", map[string]string{
+			"instrument_type": order.InstrumentType.String(),
+		})
+	}
+
+	switch order.OrderType {
+	case tradeengineproto.ORDER_TYPE_LIMIT, tradeengineproto.ORDER_TYPE_TAKE_PROFIT_LIMIT:
+		switch {
+		case order.LimitPrice <= 0:
+			return gerrors.BadParam("bad_param.limit_price", nil)
+		}
+	case tradeengineproto.ORDER_TYPE_STOP_MARKET, tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET:
+		switch {
+		case order.StopPrice <= 0:
+			return gerrors.BadParam("bad_param.stop_price", nil)
+		}
+	case tradeengineproto.ORDER_TYPE_STOP_LIMIT:
+		switch {
+		case order.LimitPrice <= 0:
+			return gerrors.BadParam("bad_param.limit_price", nil)
+		case order.StopPrice <= 0:
+			return gerrors.BadParam("bad_param.stop_price", nil)
+		}
+	}
+
+	return nil
+}
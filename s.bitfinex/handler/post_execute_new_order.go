@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.bitfinex/client"
+	"swallowtail/s.bitfinex/marshaling"
+	bitfinexproto "swallowtail/s.bitfinex/proto"
+)
+
+// ExecuteNewBitfinexOrder executes a given order on Bitfinex.
+func (s *BitfinexService) ExecuteNewBitfinexOrder(
+	ctx context.Context, in *bitfinexproto.ExecuteNewBitfinexOrderRequest,
+) (*bitfinexproto.ExecuteNewBitfinexOrderResponse, error) {
+	// Validate request.
+	switch {
+	case in.Order == nil:
+		return nil, gerrors.BadParam("missing_param.order", nil)
+	case in.Credentials == nil:
+		return nil, gerrors.BadParam("missing_param.credentials", nil)
+	}
+
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Unauthenticated("unauthorized.invalid_credentials", map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	order := in.Order
+
+	errParams := map[string]string{
+		"actor_id":   order.ActorId,
+		"instrument": order.Instrument,
+		"asset":      order.Asset,
+		"pair":       order.Pair.String(),
+	}
+
+	// Validate order.
+	if err := validateOrder(order); err != nil {
+		slog.Error(ctx, "Failed to execute order invalid: Error: %v, Order: %+v", err, order)
+		return nil, gerrors.Augment(err, "failed_to_execute_order.invalid_order", errParams)
+	}
+
+	// Marshal order to dto.
+	dtoOrder, err := marshaling.OrderProtoToDTO(order)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_order", errParams)
+	}
+
+	// Marshal credentials.
+	dtoCredentials := marshaling.VenueCredentialsProtoToBitfinexCredentials(in.Credentials)
+
+	// Execute order via client.
+	rsp, err := client.SubmitOrder(ctx, dtoOrder, dtoCredentials)
+	if err != nil {
+		slog.Error(ctx, "Failed to execute order: Error: %v, Order: %+v", err, order)
+		return nil, gerrors.Augment(err, "failed_to_execute_order", errParams)
+	}
+
+	// Embelish order with execution metadata.
+	order.ExternalOrderId = strconv.FormatInt(rsp.Order.ID, 10)
+	order.ExecutionTimestamp = rsp.Order.CreatedAt.UnixMilli()
+
+	return &bitfinexproto.ExecuteNewBitfinexOrderResponse{
+		Order: order,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.bitfinex/client"
+	"swallowtail/s.bitfinex/dto"
+	"swallowtail/s.bitfinex/marshaling"
+	bitfinexproto "swallowtail/s.bitfinex/proto"
+)
+
+// VerifyBitfinexCredentials verifies the credentials have the permissions required to trade; & that withdrawals are disabled.
+func (s *BitfinexService) VerifyBitfinexCredentials(
+	ctx context.Context, in *bitfinexproto.VerifyBitfinexCredentialsRequest,
+) (*bitfinexproto.VerifyBitfinexCredentialsResponse, error) {
+	switch {
+	case in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	}
+
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_verify_credentials", nil)
+	}
+
+	errParams := map[string]string{
+		"user_id": in.UserId,
+	}
+
+	// Marshal credentials.
+	dtoCredentials := marshaling.VenueCredentialsProtoToBitfinexCredentials(in.Credentials)
+
+	rsp, err := client.VerifyCredentials(ctx, &dto.VerifyCredentialsRequest{}, dtoCredentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_verify_credentials", errParams)
+	}
+
+	proto := marshaling.VerifyCredentialsDTOToProto(rsp)
+
+	slog.Info(ctx, "%s: verified bitfinex credentials %v", in.UserId, proto.Success)
+
+	return proto, nil
+}
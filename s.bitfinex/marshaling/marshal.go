@@ -1,8 +1,22 @@
 package marshaling
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.bitfinex/client/auth"
 	"swallowtail/s.bitfinex/dto"
 	bitfinexproto "swallowtail/s.bitfinex/proto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	// Order flags; these are summed.
+	// https://docs.bitfinex.com/docs/flag-values
+	flagPostOnly   = 4096
+	flagReduceOnly = 1024
 )
 
 func convertOperative(in int) bool {
@@ -37,3 +51,144 @@ func GetFundingRatesDTOToProto(in *dto.GetFundingRatesResponse) *bitfinexproto.G
 		FundingRates: fundingRates,
 	}
 }
+
+// VenueCredentialsProtoToBitfinexCredentials ...
+func VenueCredentialsProtoToBitfinexCredentials(in *tradeengineproto.VenueCredentials) *auth.Credentials {
+	return &auth.Credentials{
+		APIKey:    in.ApiKey,
+		SecretKey: in.SecretKey,
+	}
+}
+
+// OrderProtoToDTO converts an order to a Bitfinex order. Bitfinex has no take profit order type, so take profits are placed
+// as (reduce only) limit orders at the take profit price; they rest on the book until price reaches them.
+func OrderProtoToDTO(in *tradeengineproto.Order) (*dto.SubmitOrderRequest, error) {
+	symbol, err := symbolFromOrder(in)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_marshal_order", nil)
+	}
+
+	amount := float64(in.Quantity)
+	switch in.TradeSide {
+	case tradeengineproto.TRADE_SIDE_SELL, tradeengineproto.TRADE_SIDE_SHORT:
+		amount = -amount
+	}
+
+	req := &dto.SubmitOrderRequest{
+		Symbol: symbol,
+		Amount: formatFloat(amount),
+	}
+
+	switch in.OrderType {
+	case tradeengineproto.ORDER_TYPE_MARKET:
+		req.Type = "MARKET"
+	case tradeengineproto.ORDER_TYPE_LIMIT:
+		req.Type = "LIMIT"
+		req.Price = formatFloat(float64(in.LimitPrice))
+	case tradeengineproto.ORDER_TYPE_STOP_MARKET:
+		req.Type = "STOP"
+		req.Price = formatFloat(float64(in.StopPrice))
+	case tradeengineproto.ORDER_TYPE_STOP_LIMIT:
+		req.Type = "STOP LIMIT"
+		req.Price = formatFloat(float64(in.StopPrice))
+		req.PriceAuxLimit = formatFloat(float64(in.LimitPrice))
+	case tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET:
+		req.Type = "LIMIT"
+		req.Price = formatFloat(float64(in.StopPrice))
+	case tradeengineproto.ORDER_TYPE_TAKE_PROFIT_LIMIT:
+		req.Type = "LIMIT"
+		req.Price = formatFloat(float64(in.LimitPrice))
+	default:
+		return nil, gerrors.Unimplemented("failed_to_marshal_order.unimplemented_order_type", map[string]string{
+			"order_type": in.OrderType.String(),
+		})
+	}
+
+	// Spot orders are placed against the exchange wallet; the margin wallet otherwise.
+	if in.InstrumentType == tradeengineproto.INSTRUMENT_TYPE_SPOT {
+		req.Type = fmt.Sprintf("EXCHANGE %s", req.Type)
+	}
+
+	if in.ReduceOnly && in.InstrumentType != tradeengineproto.INSTRUMENT_TYPE_SPOT {
+		req.Flags += flagReduceOnly
+	}
+	if in.PostOnly {
+		req.Flags += flagPostOnly
+	}
+
+	return req, nil
+}
+
+// WalletsDTOToProtos ...
+func WalletsDTOToProtos(in []*dto.Wallet) []*bitfinexproto.BitfinexWallet {
+	wallets := make([]*bitfinexproto.BitfinexWallet, 0, len(in))
+	for _, w := range in {
+		wallets = append(wallets, &bitfinexproto.BitfinexWallet{
+			WalletType:       w.Type,
+			Currency:         w.Currency,
+			Balance:          float32(w.Balance),
+			AvailableBalance: float32(w.AvailableBalance),
+		})
+	}
+
+	return wallets
+}
+
+// VerifyCredentialsDTOToProto ...
+func VerifyCredentialsDTOToProto(in *dto.VerifyCredentialsResponse) *bitfinexproto.VerifyBitfinexCredentialsResponse {
+	reasons := []string{}
+
+	if !in.OrdersEnabled {
+		reasons = append(reasons, "Please enable the ability to read & write orders")
+	}
+
+	if !in.WalletsEnabled {
+		reasons = append(reasons, "Please enable the ability to read wallets")
+	}
+
+	if in.WithdrawEnabled {
+		reasons = append(reasons, "You have withdrawals enabled, please turn them off")
+	}
+
+	return &bitfinexproto.VerifyBitfinexCredentialsResponse{
+		Success:         in.OrdersEnabled && in.WalletsEnabled,
+		Reason:          strings.Join(reasons, ","),
+		OrdersEnabled:   in.OrdersEnabled,
+		WalletsEnabled:  in.WalletsEnabled,
+		WithdrawEnabled: in.WithdrawEnabled,
+	}
+}
+
+// symbolFromOrder builds the Bitfinex trading symbol from the order: `tBTCUSD` for spot, `tBTCF0:USTF0` for perpetuals.
+// Currencies with more than three characters are separated with a colon, e.g `tDOGE:USD`.
+func symbolFromOrder(in *tradeengineproto.Order) (string, error) {
+	if in.Asset == "" {
+		return in.Instrument, nil
+	}
+
+	asset, currency := strings.ToUpper(in.Asset), bitfinexproto.BitfinexCurrency(in.Pair)
+
+	switch in.InstrumentType {
+	case tradeengineproto.INSTRUMENT_TYPE_SPOT:
+		if len(asset) > 3 || len(currency) > 3 {
+			return fmt.Sprintf("t%s:%s", asset, currency), nil
+		}
+		return fmt.Sprintf("t%s%s", asset, currency), nil
+	case tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL:
+		// Bitfinex perpetuals are only margined in USDT.
+		if in.Pair != tradeengineproto.TRADE_PAIR_USDT {
+			return "", gerrors.FailedPrecondition("invalid_pair.perpetuals_must_be_usdt_margined", map[string]string{
+				"pair": in.Pair.String(),
+			})
+		}
+		return fmt.Sprintf("t%sF0:%s", asset, bitfinexproto.BitfinexDerivativesCollateralCurrency), nil
+	default:
+		return "", gerrors.Unimplemented("unimplemented_instrument_type", map[string]string{
+			"instrument_type": in.InstrumentType.String(),
+		})
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 32)
+}
@@ -0,0 +1,107 @@
+package marshaling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.bitfinex/dto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func TestOrderProtoToDTO(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		order         *tradeengineproto.Order
+		expectedOrder *dto.SubmitOrderRequest
+	}{
+		{
+			name: "perpetual-reduce-only-stop",
+			order: &tradeengineproto.Order{
+				Asset:          "BTC",
+				Pair:           tradeengineproto.TRADE_PAIR_USDT,
+				InstrumentType: tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+				OrderType:      tradeengineproto.ORDER_TYPE_STOP_MARKET,
+				TradeSide:      tradeengineproto.TRADE_SIDE_SELL,
+				StopPrice:      19500,
+				Quantity:       0.25,
+				ReduceOnly:     true,
+			},
+			expectedOrder: &dto.SubmitOrderRequest{
+				Type:   "STOP",
+				Symbol: "tBTCF0:USTF0",
+				Amount: "-0.25",
+				Price:  "19500",
+				Flags:  flagReduceOnly,
+			},
+		},
+		{
+			name: "perpetual-take-profit-market-as-limit",
+			order: &tradeengineproto.Order{
+				Asset:          "ETH",
+				Pair:           tradeengineproto.TRADE_PAIR_USDT,
+				InstrumentType: tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+				OrderType:      tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET,
+				TradeSide:      tradeengineproto.TRADE_SIDE_SHORT,
+				StopPrice:      1800,
+				Quantity:       2,
+				ReduceOnly:     true,
+			},
+			expectedOrder: &dto.SubmitOrderRequest{
+				Type:   "LIMIT",
+				Symbol: "tETHF0:USTF0",
+				Amount: "-2",
+				Price:  "1800",
+				Flags:  flagReduceOnly,
+			},
+		},
+		{
+			name: "spot-long-asset-name",
+			order: &tradeengineproto.Order{
+				Asset:          "DOGE",
+				Pair:           tradeengineproto.TRADE_PAIR_USD,
+				InstrumentType: tradeengineproto.INSTRUMENT_TYPE_SPOT,
+				OrderType:      tradeengineproto.ORDER_TYPE_STOP_LIMIT,
+				TradeSide:      tradeengineproto.TRADE_SIDE_BUY,
+				StopPrice:      0.5,
+				LimitPrice:     0.55,
+				Quantity:       100,
+				ReduceOnly:     true,
+			},
+			expectedOrder: &dto.SubmitOrderRequest{
+				Type:          "EXCHANGE STOP LIMIT",
+				Symbol:        "tDOGE:USD",
+				Amount:        "100",
+				Price:         "0.5",
+				PriceAuxLimit: "0.55",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order, err := OrderProtoToDTO(tt.order)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOrder, order)
+		})
+	}
+}
+
+func TestOrderProtoToDTO_PerpetualNotUSDTMargined(t *testing.T) {
+	t.Parallel()
+
+	_, err := OrderProtoToDTO(&tradeengineproto.Order{
+		Asset:          "BTC",
+		Pair:           tradeengineproto.TRADE_PAIR_USD,
+		InstrumentType: tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+		OrderType:      tradeengineproto.ORDER_TYPE_MARKET,
+		Quantity:       1,
+	})
+	require.Error(t, err)
+}
@@ -1,16 +1,16 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
-// 	protoc        v3.17.3
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.2
 // source: s.bitfinex/proto/bitfinex.proto
 
 package bitfinexproto
 
 import (
-	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	proto "swallowtail/s.trade-engine/proto"
 	sync "sync"
 )
 
@@ -21,10 +21,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type GetBitfinexStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -267,49 +263,641 @@ func (x *GetBitfinexFundingRatesResponse) GetFundingRates() []*BitfinexFundingRa
 	return nil
 }
 
+type ExecuteNewBitfinexOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order       *proto.Order            `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Credentials *proto.VenueCredentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *ExecuteNewBitfinexOrderRequest) Reset() {
+	*x = ExecuteNewBitfinexOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteNewBitfinexOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteNewBitfinexOrderRequest) ProtoMessage() {}
+
+func (x *ExecuteNewBitfinexOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteNewBitfinexOrderRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteNewBitfinexOrderRequest) Descriptor() ([]byte, []int) {
+	return file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExecuteNewBitfinexOrderRequest) GetOrder() *proto.Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ExecuteNewBitfinexOrderRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type ExecuteNewBitfinexOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *proto.Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *ExecuteNewBitfinexOrderResponse) Reset() {
+	*x = ExecuteNewBitfinexOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteNewBitfinexOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteNewBitfinexOrderResponse) ProtoMessage() {}
+
+func (x *ExecuteNewBitfinexOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteNewBitfinexOrderResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteNewBitfinexOrderResponse) Descriptor() ([]byte, []int) {
+	return file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExecuteNewBitfinexOrderResponse) GetOrder() *proto.Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type CancelBitfinexOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials     *proto.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	ExternalOrderId string                  `protobuf:"bytes,2,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+}
+
+func (x *CancelBitfinexOrderRequest) Reset() {
+	*x = CancelBitfinexOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBitfinexOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBitfinexOrderRequest) ProtoMessage() {}
+
+func (x *CancelBitfinexOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBitfinexOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelBitfinexOrderRequest) Descriptor() ([]byte, []int) {
+	return file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelBitfinexOrderRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *CancelBitfinexOrderRequest) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+type CancelBitfinexOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalOrderId string `protobuf:"bytes,1,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+}
+
+func (x *CancelBitfinexOrderResponse) Reset() {
+	*x = CancelBitfinexOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBitfinexOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBitfinexOrderResponse) ProtoMessage() {}
+
+func (x *CancelBitfinexOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBitfinexOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelBitfinexOrderResponse) Descriptor() ([]byte, []int) {
+	return file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelBitfinexOrderResponse) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+type ListBitfinexWalletsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials *proto.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *ListBitfinexWalletsRequest) Reset() {
+	*x = ListBitfinexWalletsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBitfinexWalletsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBitfinexWalletsRequest) ProtoMessage() {}
+
+func (x *ListBitfinexWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBitfinexWalletsRequest.ProtoReflect.Descriptor instead.
+func (*ListBitfinexWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListBitfinexWalletsRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type BitfinexWallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of `exchange`, `margin` or `funding`.
+	WalletType       string  `protobuf:"bytes,1,opt,name=wallet_type,json=walletType,proto3" json:"wallet_type,omitempty"`
+	Currency         string  `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance          float32 `protobuf:"fixed32,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	AvailableBalance float32 `protobuf:"fixed32,4,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+}
+
+func (x *BitfinexWallet) Reset() {
+	*x = BitfinexWallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BitfinexWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitfinexWallet) ProtoMessage() {}
+
+func (x *BitfinexWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitfinexWallet.ProtoReflect.Descriptor instead.
+func (*BitfinexWallet) Descriptor() ([]byte, []int) {
+	return file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BitfinexWallet) GetWalletType() string {
+	if x != nil {
+		return x.WalletType
+	}
+	return ""
+}
+
+func (x *BitfinexWallet) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BitfinexWallet) GetBalance() float32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *BitfinexWallet) GetAvailableBalance() float32 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+type ListBitfinexWalletsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wallets []*BitfinexWallet `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+}
+
+func (x *ListBitfinexWalletsResponse) Reset() {
+	*x = ListBitfinexWalletsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBitfinexWalletsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBitfinexWalletsResponse) ProtoMessage() {}
+
+func (x *ListBitfinexWalletsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBitfinexWalletsResponse.ProtoReflect.Descriptor instead.
+func (*ListBitfinexWalletsResponse) Descriptor() ([]byte, []int) {
+	return file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListBitfinexWalletsResponse) GetWallets() []*BitfinexWallet {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+type VerifyBitfinexCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials *proto.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	UserId      string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *VerifyBitfinexCredentialsRequest) Reset() {
+	*x = VerifyBitfinexCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyBitfinexCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBitfinexCredentialsRequest) ProtoMessage() {}
+
+func (x *VerifyBitfinexCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBitfinexCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*VerifyBitfinexCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VerifyBitfinexCredentialsRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *VerifyBitfinexCredentialsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type VerifyBitfinexCredentialsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success         bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Reason          string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	OrdersEnabled   bool   `protobuf:"varint,3,opt,name=orders_enabled,json=ordersEnabled,proto3" json:"orders_enabled,omitempty"`
+	WalletsEnabled  bool   `protobuf:"varint,4,opt,name=wallets_enabled,json=walletsEnabled,proto3" json:"wallets_enabled,omitempty"`
+	WithdrawEnabled bool   `protobuf:"varint,5,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
+}
+
+func (x *VerifyBitfinexCredentialsResponse) Reset() {
+	*x = VerifyBitfinexCredentialsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyBitfinexCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBitfinexCredentialsResponse) ProtoMessage() {}
+
+func (x *VerifyBitfinexCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_bitfinex_proto_bitfinex_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBitfinexCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*VerifyBitfinexCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VerifyBitfinexCredentialsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VerifyBitfinexCredentialsResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VerifyBitfinexCredentialsResponse) GetOrdersEnabled() bool {
+	if x != nil {
+		return x.OrdersEnabled
+	}
+	return false
+}
+
+func (x *VerifyBitfinexCredentialsResponse) GetWalletsEnabled() bool {
+	if x != nil {
+		return x.WalletsEnabled
+	}
+	return false
+}
+
+func (x *VerifyBitfinexCredentialsResponse) GetWithdrawEnabled() bool {
+	if x != nil {
+		return x.WithdrawEnabled
+	}
+	return false
+}
+
 var File_s_bitfinex_proto_bitfinex_proto protoreflect.FileDescriptor
 
 var file_s_bitfinex_proto_bitfinex_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x73, 0x2e, 0x62, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x62, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c,
-	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x22, 0x38, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42,
+	0x6f, 0x1a, 0x26, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66,
+	0x69, 0x6e, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73,
+	0x22, 0x38, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x46,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0x3c, 0x0a, 0x17, 0x42, 0x69,
+	0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x66, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x22, 0x78, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x42,
 	0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61,
-	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x22, 0x3c, 0x0a, 0x17, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x46, 0x75,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a,
-	0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65,
-	0x22, 0x78, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x46,
-	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x3d, 0x0a, 0x0d, 0x66,
-	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x46, 0x75, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x66, 0x75,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x32, 0xb8, 0x01, 0x0a, 0x08, 0x62,
-	0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x12, 0x4c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x69,
-	0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x2e, 0x47,
-	0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74,
-	0x66, 0x69, 0x6e, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66,
-	0x69, 0x6e, 0x65, 0x78, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73,
-	0x12, 0x1f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x46, 0x75,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x46,
-	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x12, 0x5a, 0x10, 0x2e, 0x2f, 0x3b, 0x62, 0x69, 0x74, 0x66,
-	0x69, 0x6e, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x3d, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x42, 0x69, 0x74,
+	0x66, 0x69, 0x6e, 0x65, 0x78, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0x73, 0x0a, 0x1e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77,
+	0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x3f, 0x0a, 0x1f, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x4e, 0x65, 0x77, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x7d, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65,
+	0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x51, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e,
+	0x65, 0x78, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e,
+	0x65, 0x78, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x42,
+	0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x07, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x70, 0x0a, 0x20, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x21, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x32, 0xa6, 0x04, 0x0a, 0x08,
+	0x62, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x12, 0x4c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42,
+	0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69,
+	0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74,
+	0x66, 0x69, 0x6e, 0x65, 0x78, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x1f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x46,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78,
+	0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x17, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x4e, 0x65, 0x77, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x1f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x42, 0x69,
+	0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x42,
+	0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64,
+	0x0a, 0x19, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x21, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x69, 0x74, 0x66, 0x69, 0x6e, 0x65, 0x78, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x12, 0x5a, 0x10, 0x2e, 0x2f, 0x3b, 0x62, 0x69, 0x74, 0x66, 0x69,
+	0x6e, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -324,25 +912,51 @@ func file_s_bitfinex_proto_bitfinex_proto_rawDescGZIP() []byte {
 	return file_s_bitfinex_proto_bitfinex_proto_rawDescData
 }
 
-var file_s_bitfinex_proto_bitfinex_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_s_bitfinex_proto_bitfinex_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_s_bitfinex_proto_bitfinex_proto_goTypes = []interface{}{
-	(*GetBitfinexStatusRequest)(nil),        // 0: GetBitfinexStatusRequest
-	(*GetBitfinexStatusResponse)(nil),       // 1: GetBitfinexStatusResponse
-	(*GetBitfinexFundingRatesRequest)(nil),  // 2: GetBitfinexFundingRatesRequest
-	(*BitfinexFundingRateInfo)(nil),         // 3: BitfinexFundingRateInfo
-	(*GetBitfinexFundingRatesResponse)(nil), // 4: GetBitfinexFundingRatesResponse
+	(*GetBitfinexStatusRequest)(nil),          // 0: GetBitfinexStatusRequest
+	(*GetBitfinexStatusResponse)(nil),         // 1: GetBitfinexStatusResponse
+	(*GetBitfinexFundingRatesRequest)(nil),    // 2: GetBitfinexFundingRatesRequest
+	(*BitfinexFundingRateInfo)(nil),           // 3: BitfinexFundingRateInfo
+	(*GetBitfinexFundingRatesResponse)(nil),   // 4: GetBitfinexFundingRatesResponse
+	(*ExecuteNewBitfinexOrderRequest)(nil),    // 5: ExecuteNewBitfinexOrderRequest
+	(*ExecuteNewBitfinexOrderResponse)(nil),   // 6: ExecuteNewBitfinexOrderResponse
+	(*CancelBitfinexOrderRequest)(nil),        // 7: CancelBitfinexOrderRequest
+	(*CancelBitfinexOrderResponse)(nil),       // 8: CancelBitfinexOrderResponse
+	(*ListBitfinexWalletsRequest)(nil),        // 9: ListBitfinexWalletsRequest
+	(*BitfinexWallet)(nil),                    // 10: BitfinexWallet
+	(*ListBitfinexWalletsResponse)(nil),       // 11: ListBitfinexWalletsResponse
+	(*VerifyBitfinexCredentialsRequest)(nil),  // 12: VerifyBitfinexCredentialsRequest
+	(*VerifyBitfinexCredentialsResponse)(nil), // 13: VerifyBitfinexCredentialsResponse
+	(*proto.Order)(nil),                       // 14: Order
+	(*proto.VenueCredentials)(nil),            // 15: VenueCredentials
 }
 var file_s_bitfinex_proto_bitfinex_proto_depIdxs = []int32{
-	3, // 0: GetBitfinexFundingRatesResponse.funding_rates:type_name -> BitfinexFundingRateInfo
-	0, // 1: bitfinex.GetBitfinexStatus:input_type -> GetBitfinexStatusRequest
-	2, // 2: bitfinex.GetBitfinexFundingRates:input_type -> GetBitfinexFundingRatesRequest
-	1, // 3: bitfinex.GetBitfinexStatus:output_type -> GetBitfinexStatusResponse
-	4, // 4: bitfinex.GetBitfinexFundingRates:output_type -> GetBitfinexFundingRatesResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3,  // 0: GetBitfinexFundingRatesResponse.funding_rates:type_name -> BitfinexFundingRateInfo
+	14, // 1: ExecuteNewBitfinexOrderRequest.order:type_name -> Order
+	15, // 2: ExecuteNewBitfinexOrderRequest.credentials:type_name -> VenueCredentials
+	14, // 3: ExecuteNewBitfinexOrderResponse.order:type_name -> Order
+	15, // 4: CancelBitfinexOrderRequest.credentials:type_name -> VenueCredentials
+	15, // 5: ListBitfinexWalletsRequest.credentials:type_name -> VenueCredentials
+	10, // 6: ListBitfinexWalletsResponse.wallets:type_name -> BitfinexWallet
+	15, // 7: VerifyBitfinexCredentialsRequest.credentials:type_name -> VenueCredentials
+	0,  // 8: bitfinex.GetBitfinexStatus:input_type -> GetBitfinexStatusRequest
+	2,  // 9: bitfinex.GetBitfinexFundingRates:input_type -> GetBitfinexFundingRatesRequest
+	5,  // 10: bitfinex.ExecuteNewBitfinexOrder:input_type -> ExecuteNewBitfinexOrderRequest
+	7,  // 11: bitfinex.CancelBitfinexOrder:input_type -> CancelBitfinexOrderRequest
+	9,  // 12: bitfinex.ListBitfinexWallets:input_type -> ListBitfinexWalletsRequest
+	12, // 13: bitfinex.VerifyBitfinexCredentials:input_type -> VerifyBitfinexCredentialsRequest
+	1,  // 14: bitfinex.GetBitfinexStatus:output_type -> GetBitfinexStatusResponse
+	4,  // 15: bitfinex.GetBitfinexFundingRates:output_type -> GetBitfinexFundingRatesResponse
+	6,  // 16: bitfinex.ExecuteNewBitfinexOrder:output_type -> ExecuteNewBitfinexOrderResponse
+	8,  // 17: bitfinex.CancelBitfinexOrder:output_type -> CancelBitfinexOrderResponse
+	11, // 18: bitfinex.ListBitfinexWallets:output_type -> ListBitfinexWalletsResponse
+	13, // 19: bitfinex.VerifyBitfinexCredentials:output_type -> VerifyBitfinexCredentialsResponse
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_s_bitfinex_proto_bitfinex_proto_init() }
@@ -411,6 +1025,114 @@ func file_s_bitfinex_proto_bitfinex_proto_init() {
 				return nil
 			}
 		}
+		file_s_bitfinex_proto_bitfinex_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteNewBitfinexOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_bitfinex_proto_bitfinex_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteNewBitfinexOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_bitfinex_proto_bitfinex_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBitfinexOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_bitfinex_proto_bitfinex_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBitfinexOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_bitfinex_proto_bitfinex_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBitfinexWalletsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_bitfinex_proto_bitfinex_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BitfinexWallet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_bitfinex_proto_bitfinex_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBitfinexWalletsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_bitfinex_proto_bitfinex_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyBitfinexCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_bitfinex_proto_bitfinex_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyBitfinexCredentialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -418,7 +1140,7 @@ func file_s_bitfinex_proto_bitfinex_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_s_bitfinex_proto_bitfinex_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -81,3 +81,295 @@ func (r *GetBitfinexFundingRatesRequest) SendWithTimeout(ctx context.Context, ti
 		resultc: resultc,
 	}
 }
+
+// --- Execute New Bitfinex Order --- //
+
+type ExecuteNewBitfinexOrderFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ExecuteNewBitfinexOrderResponse
+	ctx     context.Context
+}
+
+func (a *ExecuteNewBitfinexOrderFuture) Response() (*ExecuteNewBitfinexOrderResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "execute_new_bitfinex_order", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ExecuteNewBitfinexOrderRequest) Send(ctx context.Context) *ExecuteNewBitfinexOrderFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ExecuteNewBitfinexOrderRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ExecuteNewBitfinexOrderFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ExecuteNewBitfinexOrderResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-bitfinex:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_bitfinex_connection_failed", nil)
+		return &ExecuteNewBitfinexOrderFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewBitfinexClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ExecuteNewBitfinexOrder(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_execute_new_bitfinex_order", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ExecuteNewBitfinexOrderFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Cancel Bitfinex Order --- //
+
+type CancelBitfinexOrderFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *CancelBitfinexOrderResponse
+	ctx     context.Context
+}
+
+func (a *CancelBitfinexOrderFuture) Response() (*CancelBitfinexOrderResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "cancel_bitfinex_order", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *CancelBitfinexOrderRequest) Send(ctx context.Context) *CancelBitfinexOrderFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *CancelBitfinexOrderRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *CancelBitfinexOrderFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *CancelBitfinexOrderResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-bitfinex:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_bitfinex_connection_failed", nil)
+		return &CancelBitfinexOrderFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewBitfinexClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.CancelBitfinexOrder(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_cancel_bitfinex_order", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &CancelBitfinexOrderFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- List Bitfinex Wallets --- //
+
+type ListBitfinexWalletsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListBitfinexWalletsResponse
+	ctx     context.Context
+}
+
+func (a *ListBitfinexWalletsFuture) Response() (*ListBitfinexWalletsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_bitfinex_wallets", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListBitfinexWalletsRequest) Send(ctx context.Context) *ListBitfinexWalletsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListBitfinexWalletsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListBitfinexWalletsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListBitfinexWalletsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-bitfinex:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_bitfinex_connection_failed", nil)
+		return &ListBitfinexWalletsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewBitfinexClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListBitfinexWallets(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_bitfinex_wallets", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListBitfinexWalletsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Verify Bitfinex Credentials --- //
+
+type VerifyBitfinexCredentialsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *VerifyBitfinexCredentialsResponse
+	ctx     context.Context
+}
+
+func (a *VerifyBitfinexCredentialsFuture) Response() (*VerifyBitfinexCredentialsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "verify_bitfinex_credentials", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *VerifyBitfinexCredentialsRequest) Send(ctx context.Context) *VerifyBitfinexCredentialsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *VerifyBitfinexCredentialsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *VerifyBitfinexCredentialsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *VerifyBitfinexCredentialsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-bitfinex:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_bitfinex_connection_failed", nil)
+		return &VerifyBitfinexCredentialsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewBitfinexClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.VerifyBitfinexCredentials(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_verify_bitfinex_credentials", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &VerifyBitfinexCredentialsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
@@ -11,7 +11,8 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
 
 // BitfinexClient is the client API for Bitfinex service.
 //
@@ -19,6 +20,10 @@ const _ = grpc.SupportPackageIsVersion6
 type BitfinexClient interface {
 	GetBitfinexStatus(ctx context.Context, in *GetBitfinexStatusRequest, opts ...grpc.CallOption) (*GetBitfinexStatusResponse, error)
 	GetBitfinexFundingRates(ctx context.Context, in *GetBitfinexFundingRatesRequest, opts ...grpc.CallOption) (*GetBitfinexFundingRatesResponse, error)
+	ExecuteNewBitfinexOrder(ctx context.Context, in *ExecuteNewBitfinexOrderRequest, opts ...grpc.CallOption) (*ExecuteNewBitfinexOrderResponse, error)
+	CancelBitfinexOrder(ctx context.Context, in *CancelBitfinexOrderRequest, opts ...grpc.CallOption) (*CancelBitfinexOrderResponse, error)
+	ListBitfinexWallets(ctx context.Context, in *ListBitfinexWalletsRequest, opts ...grpc.CallOption) (*ListBitfinexWalletsResponse, error)
+	VerifyBitfinexCredentials(ctx context.Context, in *VerifyBitfinexCredentialsRequest, opts ...grpc.CallOption) (*VerifyBitfinexCredentialsResponse, error)
 }
 
 type bitfinexClient struct {
@@ -47,12 +52,52 @@ func (c *bitfinexClient) GetBitfinexFundingRates(ctx context.Context, in *GetBit
 	return out, nil
 }
 
+func (c *bitfinexClient) ExecuteNewBitfinexOrder(ctx context.Context, in *ExecuteNewBitfinexOrderRequest, opts ...grpc.CallOption) (*ExecuteNewBitfinexOrderResponse, error) {
+	out := new(ExecuteNewBitfinexOrderResponse)
+	err := c.cc.Invoke(ctx, "/bitfinex/ExecuteNewBitfinexOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bitfinexClient) CancelBitfinexOrder(ctx context.Context, in *CancelBitfinexOrderRequest, opts ...grpc.CallOption) (*CancelBitfinexOrderResponse, error) {
+	out := new(CancelBitfinexOrderResponse)
+	err := c.cc.Invoke(ctx, "/bitfinex/CancelBitfinexOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bitfinexClient) ListBitfinexWallets(ctx context.Context, in *ListBitfinexWalletsRequest, opts ...grpc.CallOption) (*ListBitfinexWalletsResponse, error) {
+	out := new(ListBitfinexWalletsResponse)
+	err := c.cc.Invoke(ctx, "/bitfinex/ListBitfinexWallets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bitfinexClient) VerifyBitfinexCredentials(ctx context.Context, in *VerifyBitfinexCredentialsRequest, opts ...grpc.CallOption) (*VerifyBitfinexCredentialsResponse, error) {
+	out := new(VerifyBitfinexCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/bitfinex/VerifyBitfinexCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BitfinexServer is the server API for Bitfinex service.
 // All implementations must embed UnimplementedBitfinexServer
 // for forward compatibility
 type BitfinexServer interface {
 	GetBitfinexStatus(context.Context, *GetBitfinexStatusRequest) (*GetBitfinexStatusResponse, error)
 	GetBitfinexFundingRates(context.Context, *GetBitfinexFundingRatesRequest) (*GetBitfinexFundingRatesResponse, error)
+	ExecuteNewBitfinexOrder(context.Context, *ExecuteNewBitfinexOrderRequest) (*ExecuteNewBitfinexOrderResponse, error)
+	CancelBitfinexOrder(context.Context, *CancelBitfinexOrderRequest) (*CancelBitfinexOrderResponse, error)
+	ListBitfinexWallets(context.Context, *ListBitfinexWalletsRequest) (*ListBitfinexWalletsResponse, error)
+	VerifyBitfinexCredentials(context.Context, *VerifyBitfinexCredentialsRequest) (*VerifyBitfinexCredentialsResponse, error)
 	mustEmbedUnimplementedBitfinexServer()
 }
 
@@ -60,16 +105,35 @@ type BitfinexServer interface {
 type UnimplementedBitfinexServer struct {
 }
 
-func (*UnimplementedBitfinexServer) GetBitfinexStatus(context.Context, *GetBitfinexStatusRequest) (*GetBitfinexStatusResponse, error) {
+func (UnimplementedBitfinexServer) GetBitfinexStatus(context.Context, *GetBitfinexStatusRequest) (*GetBitfinexStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBitfinexStatus not implemented")
 }
-func (*UnimplementedBitfinexServer) GetBitfinexFundingRates(context.Context, *GetBitfinexFundingRatesRequest) (*GetBitfinexFundingRatesResponse, error) {
+func (UnimplementedBitfinexServer) GetBitfinexFundingRates(context.Context, *GetBitfinexFundingRatesRequest) (*GetBitfinexFundingRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBitfinexFundingRates not implemented")
 }
-func (*UnimplementedBitfinexServer) mustEmbedUnimplementedBitfinexServer() {}
+func (UnimplementedBitfinexServer) ExecuteNewBitfinexOrder(context.Context, *ExecuteNewBitfinexOrderRequest) (*ExecuteNewBitfinexOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteNewBitfinexOrder not implemented")
+}
+func (UnimplementedBitfinexServer) CancelBitfinexOrder(context.Context, *CancelBitfinexOrderRequest) (*CancelBitfinexOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBitfinexOrder not implemented")
+}
+func (UnimplementedBitfinexServer) ListBitfinexWallets(context.Context, *ListBitfinexWalletsRequest) (*ListBitfinexWalletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBitfinexWallets not implemented")
+}
+func (UnimplementedBitfinexServer) VerifyBitfinexCredentials(context.Context, *VerifyBitfinexCredentialsRequest) (*VerifyBitfinexCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBitfinexCredentials not implemented")
+}
+func (UnimplementedBitfinexServer) mustEmbedUnimplementedBitfinexServer() {}
+
+// UnsafeBitfinexServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BitfinexServer will
+// result in compilation errors.
+type UnsafeBitfinexServer interface {
+	mustEmbedUnimplementedBitfinexServer()
+}
 
-func RegisterBitfinexServer(s *grpc.Server, srv BitfinexServer) {
-	s.RegisterService(&_Bitfinex_serviceDesc, srv)
+func RegisterBitfinexServer(s grpc.ServiceRegistrar, srv BitfinexServer) {
+	s.RegisterService(&Bitfinex_ServiceDesc, srv)
 }
 
 func _Bitfinex_GetBitfinexStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -108,7 +172,82 @@ func _Bitfinex_GetBitfinexFundingRates_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Bitfinex_serviceDesc = grpc.ServiceDesc{
+func _Bitfinex_ExecuteNewBitfinexOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteNewBitfinexOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitfinexServer).ExecuteNewBitfinexOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitfinex/ExecuteNewBitfinexOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitfinexServer).ExecuteNewBitfinexOrder(ctx, req.(*ExecuteNewBitfinexOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bitfinex_CancelBitfinexOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBitfinexOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitfinexServer).CancelBitfinexOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitfinex/CancelBitfinexOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitfinexServer).CancelBitfinexOrder(ctx, req.(*CancelBitfinexOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bitfinex_ListBitfinexWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBitfinexWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitfinexServer).ListBitfinexWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitfinex/ListBitfinexWallets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitfinexServer).ListBitfinexWallets(ctx, req.(*ListBitfinexWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bitfinex_VerifyBitfinexCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBitfinexCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitfinexServer).VerifyBitfinexCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bitfinex/VerifyBitfinexCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitfinexServer).VerifyBitfinexCredentials(ctx, req.(*VerifyBitfinexCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Bitfinex_ServiceDesc is the grpc.ServiceDesc for Bitfinex service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Bitfinex_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "bitfinex",
 	HandlerType: (*BitfinexServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -120,6 +259,22 @@ var _Bitfinex_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBitfinexFundingRates",
 			Handler:    _Bitfinex_GetBitfinexFundingRates_Handler,
 		},
+		{
+			MethodName: "ExecuteNewBitfinexOrder",
+			Handler:    _Bitfinex_ExecuteNewBitfinexOrder_Handler,
+		},
+		{
+			MethodName: "CancelBitfinexOrder",
+			Handler:    _Bitfinex_CancelBitfinexOrder_Handler,
+		},
+		{
+			MethodName: "ListBitfinexWallets",
+			Handler:    _Bitfinex_ListBitfinexWallets_Handler,
+		},
+		{
+			MethodName: "VerifyBitfinexCredentials",
+			Handler:    _Bitfinex_VerifyBitfinexCredentials_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "s.bitfinex/proto/bitfinex.proto",
@@ -0,0 +1,25 @@
+package bitfinexproto
+
+import tradeengineproto "swallowtail/s.trade-engine/proto"
+
+const (
+	// BitfinexWalletTypeExchange is the wallet spot orders are placed against.
+	BitfinexWalletTypeExchange = "exchange"
+	// BitfinexWalletTypeMargin is the wallet margin & derivatives orders are placed against.
+	BitfinexWalletTypeMargin = "margin"
+
+	// BitfinexDerivativesCollateralCurrency is the currency perpetuals are collateralized with in the margin wallet.
+	BitfinexDerivativesCollateralCurrency = "USTF0"
+)
+
+// BitfinexCurrency converts the pair to the currency code used by Bitfinex.
+func BitfinexCurrency(pair tradeengineproto.TRADE_PAIR) string {
+	switch pair {
+	case tradeengineproto.TRADE_PAIR_USDT:
+		return "UST"
+	case tradeengineproto.TRADE_PAIR_USDC:
+		return "UDC"
+	default:
+		return pair.String()
+	}
+}
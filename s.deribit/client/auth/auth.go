@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// Credentials holds the credentials required for Deribit; an api key on Deribit is a client id & client secret pair.
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// AsHeaders converts the credentials struct into the headers required to verify the user. Deribit accepts HTTP basic
+// authentication of private methods, which saves us managing access tokens per user.
+// https://docs.deribit.com/#authentication
+func (c *Credentials) AsHeaders() map[string]string {
+	if c == nil {
+		return map[string]string{
+			"Content-Type": "application/json",
+		}
+	}
+
+	basic := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.ClientID, c.ClientSecret)))
+
+	return map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": fmt.Sprintf("Basic %s", basic),
+	}
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/monzo/slog"
+	"github.com/opentracing/opentracing-go"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/transport"
+	"swallowtail/s.deribit/client/auth"
+	"swallowtail/s.deribit/dto"
+)
+
+var (
+	client DeribitClient
+)
+
+// DeribitClient defines the interface for the Deribit Exchange.
+type DeribitClient interface {
+	Ping(ctx context.Context) error
+	GetTime(ctx context.Context, req *dto.GetTimeRequest) (*dto.GetTimeResponse, error)
+	ExecuteOrder(ctx context.Context, req *dto.ExecuteOrderRequest, credentials *auth.Credentials) (*dto.ExecuteOrderResponse, error)
+	CancelOrder(ctx context.Context, req *dto.CancelOrderRequest, credentials *auth.Credentials) (*dto.CancelOrderResponse, error)
+	GetInstrument(ctx context.Context, req *dto.GetInstrumentRequest) (*dto.Instrument, error)
+	GetTicker(ctx context.Context, req *dto.GetTickerRequest) (*dto.Ticker, error)
+	GetIndexPrice(ctx context.Context, req *dto.GetIndexPriceRequest) (*dto.IndexPrice, error)
+	GetAccountSummary(ctx context.Context, req *dto.GetAccountSummaryRequest, credentials *auth.Credentials) (*dto.AccountSummary, error)
+	Authenticate(ctx context.Context, credentials *auth.Credentials) (*dto.AuthenticateResponse, error)
+}
+
+// Init initializes the default deribit client.
+func Init(ctx context.Context) error {
+	cli := &deribitClient{
+		http:     transport.NewHTTPClient(10*time.Second, &deribitRateLimiter{}),
+		hostname: deribitURL,
+	}
+
+	if err := cli.Ping(ctx); err != nil {
+		panic(gerrors.Augment(err, "failed_to_establish_connection_to_deribit", nil))
+	}
+
+	slog.Info(ctx, "Established a connection to Deribit", nil)
+
+	client = cli
+	return nil
+}
+
+// GetTime fetches the server time of deribit; we use this as the status of the platform.
+func GetTime(ctx context.Context, req *dto.GetTimeRequest) (*dto.GetTimeResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Get deribit exchange status")
+	defer span.Finish()
+
+	then := time.Now().UTC()
+
+	rsp, err := client.GetTime(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Inject server latency into response.
+	rsp.ServerLatency = int(time.Since(then) / time.Millisecond)
+
+	return rsp, nil
+}
+
+// ExecuteOrder ...
+func ExecuteOrder(ctx context.Context, req *dto.ExecuteOrderRequest, credentials *auth.Credentials) (*dto.ExecuteOrderResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Execute deribit order")
+	defer span.Finish()
+	return client.ExecuteOrder(ctx, req, credentials)
+}
+
+// CancelOrder ...
+func CancelOrder(ctx context.Context, req *dto.CancelOrderRequest, credentials *auth.Credentials) (*dto.CancelOrderResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Cancel deribit order")
+	defer span.Finish()
+	return client.CancelOrder(ctx, req, credentials)
+}
+
+// GetInstrument ...
+func GetInstrument(ctx context.Context, req *dto.GetInstrumentRequest) (*dto.Instrument, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Get deribit instrument")
+	defer span.Finish()
+	return client.GetInstrument(ctx, req)
+}
+
+// GetTicker ...
+func GetTicker(ctx context.Context, req *dto.GetTickerRequest) (*dto.Ticker, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Get deribit ticker")
+	defer span.Finish()
+	return client.GetTicker(ctx, req)
+}
+
+// GetIndexPrice ...
+func GetIndexPrice(ctx context.Context, req *dto.GetIndexPriceRequest) (*dto.IndexPrice, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Get deribit index price")
+	defer span.Finish()
+	return client.GetIndexPrice(ctx, req)
+}
+
+// GetAccountSummary ...
+func GetAccountSummary(ctx context.Context, req *dto.GetAccountSummaryRequest, credentials *auth.Credentials) (*dto.AccountSummary, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Get deribit account summary")
+	defer span.Finish()
+	return client.GetAccountSummary(ctx, req, credentials)
+}
+
+// Authenticate ...
+func Authenticate(ctx context.Context, credentials *auth.Credentials) (*dto.AuthenticateResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Authenticate deribit credentials")
+	defer span.Finish()
+	return client.Authenticate(ctx, credentials)
+}
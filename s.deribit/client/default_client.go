@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/transport"
+	"swallowtail/s.deribit/client/auth"
+	"swallowtail/s.deribit/dto"
+)
+
+const (
+	deribitURL        = "https://www.deribit.com/api"
+	deribitAPIVersion = "v2"
+)
+
+type deribitClient struct {
+	http     transport.HttpClient
+	hostname string
+	// requestID is the id of the last JSON-RPC request; Deribit echoes it back on the response.
+	requestID int64
+}
+
+func (d *deribitClient) Ping(ctx context.Context) error {
+	if _, err := d.GetTime(ctx, &dto.GetTimeRequest{}); err != nil {
+		return gerrors.Augment(err, "failed_to_establish_deribit_connection", nil)
+	}
+
+	return nil
+}
+
+func (d *deribitClient) GetTime(ctx context.Context, req *dto.GetTimeRequest) (*dto.GetTimeResponse, error) {
+	var serverTime int64
+	if err := d.do(ctx, "public/get_time", req, &serverTime, nil); err != nil {
+		return nil, gerrors.Augment(err, "failed_get_time", nil)
+	}
+
+	return &dto.GetTimeResponse{
+		ServerTime: serverTime,
+	}, nil
+}
+
+func (d *deribitClient) ExecuteOrder(ctx context.Context, req *dto.ExecuteOrderRequest, credentials *auth.Credentials) (*dto.ExecuteOrderResponse, error) {
+	errParams := map[string]string{
+		"instrument_name": req.InstrumentName,
+		"type":            req.Type,
+		"side":            req.Side,
+	}
+
+	var method string
+	switch req.Side {
+	case "buy":
+		method = "private/buy"
+	case "sell":
+		method = "private/sell"
+	default:
+		return nil, gerrors.BadParam("bad_param.side", errParams)
+	}
+
+	rsp := &dto.ExecuteOrderResponse{}
+	if err := d.do(ctx, method, req, rsp, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_order", errParams)
+	}
+
+	if rsp.Order == nil {
+		return nil, gerrors.FailedPrecondition("failed_to_execute_order.missing_order_in_response", errParams)
+	}
+
+	return rsp, nil
+}
+
+func (d *deribitClient) CancelOrder(ctx context.Context, req *dto.CancelOrderRequest, credentials *auth.Credentials) (*dto.CancelOrderResponse, error) {
+	order := &dto.Order{}
+	if err := d.do(ctx, "private/cancel", req, order, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_order", map[string]string{
+			"order_id": req.OrderID,
+		})
+	}
+
+	return &dto.CancelOrderResponse{
+		Order: order,
+	}, nil
+}
+
+func (d *deribitClient) GetInstrument(ctx context.Context, req *dto.GetInstrumentRequest) (*dto.Instrument, error) {
+	instrument := &dto.Instrument{}
+	if err := d.do(ctx, "public/get_instrument", req, instrument, nil); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_get_instrument", map[string]string{
+			"instrument_name": req.InstrumentName,
+		})
+	}
+
+	return instrument, nil
+}
+
+func (d *deribitClient) GetTicker(ctx context.Context, req *dto.GetTickerRequest) (*dto.Ticker, error) {
+	ticker := &dto.Ticker{}
+	if err := d.do(ctx, "public/ticker", req, ticker, nil); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_get_ticker", map[string]string{
+			"instrument_name": req.InstrumentName,
+		})
+	}
+
+	return ticker, nil
+}
+
+func (d *deribitClient) GetIndexPrice(ctx context.Context, req *dto.GetIndexPriceRequest) (*dto.IndexPrice, error) {
+	indexPrice := &dto.IndexPrice{}
+	if err := d.do(ctx, "public/get_index_price", req, indexPrice, nil); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_get_index_price", map[string]string{
+			"index_name": req.IndexName,
+		})
+	}
+
+	return indexPrice, nil
+}
+
+func (d *deribitClient) GetAccountSummary(ctx context.Context, req *dto.GetAccountSummaryRequest, credentials *auth.Credentials) (*dto.AccountSummary, error) {
+	summary := &dto.AccountSummary{}
+	if err := d.do(ctx, "private/get_account_summary", req, summary, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_get_account_summary", map[string]string{
+			"currency": req.Currency,
+		})
+	}
+
+	return summary, nil
+}
+
+// Authenticate exchanges the credentials for an access token; we only use this to read the scopes granted to the
+// credentials, since private methods are authenticated with basic auth.
+func (d *deribitClient) Authenticate(ctx context.Context, credentials *auth.Credentials) (*dto.AuthenticateResponse, error) {
+	rsp := &dto.AuthenticateResponse{}
+	if err := d.do(ctx, "public/auth", &dto.AuthenticateRequest{
+		GrantType:    "client_credentials",
+		ClientID:     credentials.ClientID,
+		ClientSecret: credentials.ClientSecret,
+	}, rsp, nil); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_authenticate", nil)
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/transport"
+	"swallowtail/s.deribit/client/auth"
+	"swallowtail/s.deribit/dto"
+)
+
+const (
+	testClientID     = "client-id"
+	testClientSecret = "client-secret"
+)
+
+// newFakeDeribitServer returns a fake of the Deribit JSON-RPC api; private methods must be authenticated.
+func newFakeDeribitServer(t *testing.T, handlers map[string]func(params json.RawMessage) (interface{}, *dto.RPCError)) *deribitClient {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := struct {
+			ID     int64           `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		// The method is both in the path & the body.
+		require.Equal(t, "/v2/"+req.Method, r.URL.Path)
+
+		if strings.HasPrefix(req.Method, "private/") {
+			basic := base64.StdEncoding.EncodeToString([]byte(testClientID + ":" + testClientSecret))
+			if r.Header.Get("Authorization") != "Basic "+basic {
+				w.WriteHeader(http.StatusUnauthorized)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"jsonrpc": "2.0",
+					"id":      req.ID,
+					"error":   &dto.RPCError{Code: 13009, Message: "unauthorized"},
+				})
+				return
+			}
+		}
+
+		handler, ok := handlers[req.Method]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		result, rpcErr := handler(req.Params)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  result,
+			"error":   rpcErr,
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return &deribitClient{
+		http:     transport.NewHTTPClient(5*time.Second, &deribitRateLimiter{}),
+		hostname: srv.URL,
+	}
+}
+
+func testCredentials() *auth.Credentials {
+	return &auth.Credentials{
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+	}
+}
+
+func TestExecuteOrder(t *testing.T) {
+	t.Parallel()
+
+	var received *dto.ExecuteOrderRequest
+	cli := newFakeDeribitServer(t, map[string]func(params json.RawMessage) (interface{}, *dto.RPCError){
+		"private/sell": func(params json.RawMessage) (interface{}, *dto.RPCError) {
+			received = &dto.ExecuteOrderRequest{}
+			require.NoError(t, json.Unmarshal(params, received))
+
+			if received.Amount < 10 {
+				return nil, &dto.RPCError{Code: 10004, Message: "order_not_found"}
+			}
+
+			return &dto.ExecuteOrderResponse{
+				Order: &dto.Order{
+					OrderID:           "ETH-584849853",
+					OrderState:        "untriggered",
+					InstrumentName:    received.InstrumentName,
+					Amount:            received.Amount,
+					CreationTimestamp: 1678000000123,
+				},
+			}, nil
+		},
+	})
+
+	ctx := context.Background()
+
+	rsp, err := cli.ExecuteOrder(ctx, &dto.ExecuteOrderRequest{
+		Side:           "sell",
+		InstrumentName: "ETH-PERPETUAL",
+		Amount:         40,
+		Type:           "stop_market",
+		TriggerPrice:   1500,
+		Trigger:        "mark_price",
+		ReduceOnly:     true,
+	}, testCredentials())
+	require.NoError(t, err)
+
+	assert.Equal(t, "ETH-584849853", rsp.Order.OrderID)
+	assert.Equal(t, int64(1678000000123), rsp.Order.CreationTimestamp)
+	assert.Equal(t, "mark_price", received.Trigger)
+	assert.True(t, received.ReduceOnly)
+
+	// RPC errors are returned as failed preconditions.
+	_, err = cli.ExecuteOrder(ctx, &dto.ExecuteOrderRequest{
+		Side:           "sell",
+		InstrumentName: "ETH-PERPETUAL",
+		Amount:         1,
+		Type:           "market",
+	}, testCredentials())
+	require.Error(t, err)
+	assert.True(t, gerrors.Is(err, gerrors.ErrFailedPrecondition, "failed_to_execute_deribit_request.rpc_error"))
+
+	// Invalid credentials are rejected by the venue.
+	_, err = cli.ExecuteOrder(ctx, &dto.ExecuteOrderRequest{
+		Side:           "sell",
+		InstrumentName: "ETH-PERPETUAL",
+		Amount:         40,
+		Type:           "market",
+	}, &auth.Credentials{ClientID: testClientID, ClientSecret: "wrong"})
+	require.Error(t, err)
+	assert.True(t, gerrors.IsCode(err, gerrors.ErrUnauthenticated))
+
+	// The side determines the method.
+	_, err = cli.ExecuteOrder(ctx, &dto.ExecuteOrderRequest{
+		Side:           "short",
+		InstrumentName: "ETH-PERPETUAL",
+		Amount:         40,
+		Type:           "market",
+	}, testCredentials())
+	require.Error(t, err)
+}
+
+func TestGetAccountSummary(t *testing.T) {
+	t.Parallel()
+
+	cli := newFakeDeribitServer(t, map[string]func(params json.RawMessage) (interface{}, *dto.RPCError){
+		"private/get_account_summary": func(params json.RawMessage) (interface{}, *dto.RPCError) {
+			req := &dto.GetAccountSummaryRequest{}
+			require.NoError(t, json.Unmarshal(params, req))
+
+			return &dto.AccountSummary{
+				Currency:       req.Currency,
+				Balance:        1.5,
+				Equity:         1.45,
+				AvailableFunds: 1.2,
+			}, nil
+		},
+	})
+
+	rsp, err := cli.GetAccountSummary(context.Background(), &dto.GetAccountSummaryRequest{
+		Currency: "BTC",
+	}, testCredentials())
+	require.NoError(t, err)
+
+	assert.Equal(t, &dto.AccountSummary{
+		Currency:       "BTC",
+		Balance:        1.5,
+		Equity:         1.45,
+		AvailableFunds: 1.2,
+	}, rsp)
+}
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	cli := newFakeDeribitServer(t, map[string]func(params json.RawMessage) (interface{}, *dto.RPCError){
+		"public/auth": func(params json.RawMessage) (interface{}, *dto.RPCError) {
+			req := &dto.AuthenticateRequest{}
+			require.NoError(t, json.Unmarshal(params, req))
+
+			if req.GrantType != "client_credentials" || req.ClientID != testClientID || req.ClientSecret != testClientSecret {
+				return nil, &dto.RPCError{Code: 13004, Message: "invalid_credentials"}
+			}
+
+			return &dto.AuthenticateResponse{
+				AccessToken: "token",
+				ExpiresIn:   900,
+				Scope:       "account:read trade:read_write wallet:read",
+			}, nil
+		},
+	})
+
+	ctx := context.Background()
+
+	rsp, err := cli.Authenticate(ctx, testCredentials())
+	require.NoError(t, err)
+	assert.Equal(t, "account:read trade:read_write wallet:read", rsp.Scope)
+
+	_, err = cli.Authenticate(ctx, &auth.Credentials{ClientID: testClientID, ClientSecret: "wrong"})
+	require.Error(t, err)
+}
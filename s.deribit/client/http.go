@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.deribit/client/auth"
+	"swallowtail/s.deribit/dto"
+)
+
+// do sends a JSON-RPC request to Deribit & unmarshals the result into `rsp`. If credentials are given the request is
+// authenticated; they are required for all private methods.
+func (d *deribitClient) do(ctx context.Context, method string, params, rsp interface{}, credentials *auth.Credentials) error {
+	errParams := map[string]string{
+		"method": method,
+	}
+
+	req := &dto.RPCRequest{
+		JSONRPC: "2.0",
+		ID:      atomic.AddInt64(&d.requestID, 1),
+		Method:  method,
+		Params:  params,
+	}
+
+	url := fmt.Sprintf("%s/%s/%s", d.hostname, deribitAPIVersion, method)
+
+	rpcRsp := &dto.RPCResponse{}
+	if err := d.http.DoWithEphemeralHeaders(ctx, http.MethodPost, url, req, rpcRsp, credentials.AsHeaders()); err != nil {
+		return gerrors.Augment(err, "failed_to_execute_deribit_request", errParams)
+	}
+
+	if rpcRsp.Error != nil {
+		errParams["code"] = strconv.Itoa(rpcRsp.Error.Code)
+		errParams["message"] = rpcRsp.Error.Message
+		return gerrors.FailedPrecondition("failed_to_execute_deribit_request.rpc_error", errParams)
+	}
+
+	if err := json.Unmarshal(rpcRsp.Result, rsp); err != nil {
+		return gerrors.Augment(err, "failed_to_execute_deribit_request.unmarshal_result", errParams)
+	}
+
+	return nil
+}
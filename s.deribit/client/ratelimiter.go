@@ -0,0 +1,9 @@
+package client
+
+import "net/http"
+
+// deribitRateLimiter is a noop; Deribit rate limits by credits per account, which at our volume we don't come close to.
+type deribitRateLimiter struct{}
+
+func (d *deribitRateLimiter) RefreshWait(header http.Header, statusCode int) {}
+func (d *deribitRateLimiter) Wait()                                          {}
@@ -0,0 +1,158 @@
+package dto
+
+import "encoding/json"
+
+// RPCRequest is the JSON-RPC envelope of every request to Deribit.
+type RPCRequest struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      int64       `json:"id"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+// RPCResponse is the JSON-RPC envelope of every response from Deribit.
+type RPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      int64           `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *RPCError       `json:"error"`
+}
+
+// RPCError ...
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetTimeRequest ...
+type GetTimeRequest struct{}
+
+// GetTimeResponse ...
+type GetTimeResponse struct {
+	// ServerTime in milliseconds.
+	ServerTime int64
+	// Latency of the server.
+	ServerLatency int
+}
+
+// ExecuteOrderRequest ...
+// https://docs.deribit.com/#private-buy
+type ExecuteOrderRequest struct {
+	// Side is either `buy` or `sell`; it determines the method rather than being a param.
+	Side           string  `json:"-"`
+	InstrumentName string  `json:"instrument_name"`
+	Amount         float64 `json:"amount"`
+	// Type is one of `limit`, `market`, `stop_limit`, `stop_market`, `take_limit` or `take_market`.
+	Type         string  `json:"type"`
+	Price        float64 `json:"price,omitempty"`
+	TriggerPrice float64 `json:"trigger_price,omitempty"`
+	// Trigger is one of `index_price`, `mark_price` or `last_price`; required for trigger orders.
+	Trigger    string `json:"trigger,omitempty"`
+	ReduceOnly bool   `json:"reduce_only,omitempty"`
+	PostOnly   bool   `json:"post_only,omitempty"`
+	Label      string `json:"label,omitempty"`
+}
+
+// ExecuteOrderResponse ...
+type ExecuteOrderResponse struct {
+	Order *Order `json:"order"`
+}
+
+// Order ...
+type Order struct {
+	OrderID        string  `json:"order_id"`
+	OrderState     string  `json:"order_state"`
+	OrderType      string  `json:"order_type"`
+	InstrumentName string  `json:"instrument_name"`
+	Direction      string  `json:"direction"`
+	Amount         float64 `json:"amount"`
+	FilledAmount   float64 `json:"filled_amount"`
+	Price          float64 `json:"price"`
+	// CreationTimestamp in milliseconds.
+	CreationTimestamp int64 `json:"creation_timestamp"`
+}
+
+// CancelOrderRequest ...
+type CancelOrderRequest struct {
+	OrderID string `json:"order_id"`
+}
+
+// CancelOrderResponse ...
+type CancelOrderResponse struct {
+	Order *Order
+}
+
+// GetInstrumentRequest ...
+type GetInstrumentRequest struct {
+	InstrumentName string `json:"instrument_name"`
+}
+
+// Instrument ...
+type Instrument struct {
+	InstrumentName string `json:"instrument_name"`
+	// Kind is one of `future`, `option`, `spot`, `future_combo` or `option_combo`.
+	Kind string `json:"kind"`
+	// InstrumentType is either `reversed` (inverse; amounts are in USD) or `linear` (amounts are in the base currency).
+	InstrumentType     string  `json:"instrument_type"`
+	SettlementPeriod   string  `json:"settlement_period"`
+	BaseCurrency       string  `json:"base_currency"`
+	QuoteCurrency      string  `json:"quote_currency"`
+	SettlementCurrency string  `json:"settlement_currency"`
+	ContractSize       float64 `json:"contract_size"`
+	MinTradeAmount     float64 `json:"min_trade_amount"`
+	TickSize           float64 `json:"tick_size"`
+	IsActive           bool    `json:"is_active"`
+}
+
+// GetTickerRequest ...
+type GetTickerRequest struct {
+	InstrumentName string `json:"instrument_name"`
+}
+
+// Ticker ...
+type Ticker struct {
+	InstrumentName string  `json:"instrument_name"`
+	MarkPrice      float64 `json:"mark_price"`
+	IndexPrice     float64 `json:"index_price"`
+	LastPrice      float64 `json:"last_price"`
+}
+
+// GetIndexPriceRequest ...
+type GetIndexPriceRequest struct {
+	// IndexName e.g `btc_usd`.
+	IndexName string `json:"index_name"`
+}
+
+// IndexPrice ...
+type IndexPrice struct {
+	IndexPrice float64 `json:"index_price"`
+}
+
+// GetAccountSummaryRequest ...
+type GetAccountSummaryRequest struct {
+	Currency string `json:"currency"`
+}
+
+// AccountSummary ...
+type AccountSummary struct {
+	Currency       string  `json:"currency"`
+	Balance        float64 `json:"balance"`
+	Equity         float64 `json:"equity"`
+	AvailableFunds float64 `json:"available_funds"`
+	MarginBalance  float64 `json:"margin_balance"`
+}
+
+// AuthenticateRequest ...
+type AuthenticateRequest struct {
+	GrantType    string `json:"grant_type"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// AuthenticateResponse ...
+type AuthenticateResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	// Scope is a space separated list of the scopes granted to the credentials, e.g `trade:read_write wallet:read`.
+	Scope string `json:"scope"`
+}
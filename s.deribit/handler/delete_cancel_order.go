@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.deribit/client"
+	"swallowtail/s.deribit/dto"
+	"swallowtail/s.deribit/marshaling"
+	deribitproto "swallowtail/s.deribit/proto"
+)
+
+// CancelDeribitOrder cancels a resting order on Deribit; the state & filled amount of the order are returned.
+func (s *DeribitService) CancelDeribitOrder(
+	ctx context.Context, in *deribitproto.CancelDeribitOrderRequest,
+) (*deribitproto.CancelDeribitOrderResponse, error) {
+	// Validate request.
+	switch {
+	case in.ExternalOrderId == "":
+		return nil, gerrors.BadParam("missing_param.external_order_id", nil)
+	}
+
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Unauthenticated("unauthorized.invalid_credentials", map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	errParams := map[string]string{
+		"external_order_id": in.ExternalOrderId,
+	}
+
+	// Marshal credentials.
+	dtoCredentials := marshaling.VenueCredentialsProtoToDeribitCredentials(in.Credentials)
+
+	rsp, err := client.CancelOrder(ctx, &dto.CancelOrderRequest{
+		OrderID: in.ExternalOrderId,
+	}, dtoCredentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_order", errParams)
+	}
+
+	return &deribitproto.CancelDeribitOrderResponse{
+		ExternalOrderId: in.ExternalOrderId,
+		OrderState:      rsp.Order.OrderState,
+		FilledAmount:    float32(rsp.Order.FilledAmount),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.deribit/client"
+	"swallowtail/s.deribit/dto"
+	"swallowtail/s.deribit/marshaling"
+	deribitproto "swallowtail/s.deribit/proto"
+)
+
+// ReadDeribitAccountSummary reads the summary of the account in the given collateral currency.
+func (s *DeribitService) ReadDeribitAccountSummary(
+	ctx context.Context, in *deribitproto.ReadDeribitAccountSummaryRequest,
+) (*deribitproto.ReadDeribitAccountSummaryResponse, error) {
+	// Validate request.
+	switch {
+	case in.Currency == "":
+		return nil, gerrors.BadParam("missing_param.currency", nil)
+	}
+
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_account_summary", nil)
+	}
+
+	currency := strings.ToUpper(in.Currency)
+
+	errParams := map[string]string{
+		"currency": currency,
+	}
+
+	// Marshal credentials.
+	dtoCredentials := marshaling.VenueCredentialsProtoToDeribitCredentials(in.Credentials)
+
+	summary, err := client.GetAccountSummary(ctx, &dto.GetAccountSummaryRequest{
+		Currency: currency,
+	}, dtoCredentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_account_summary", errParams)
+	}
+
+	// Value the available funds in USD; USDC is treated as pegged.
+	var indexPrice = 1.0
+	if currency != "USDC" {
+		rsp, err := client.GetIndexPrice(ctx, &dto.GetIndexPriceRequest{
+			IndexName: fmt.Sprintf("%s_usd", strings.ToLower(currency)),
+		})
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_read_account_summary.index_price", errParams)
+		}
+		indexPrice = rsp.IndexPrice
+	}
+
+	return marshaling.AccountSummaryDTOToProto(summary, indexPrice), nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.deribit/client"
+	"swallowtail/s.deribit/dto"
+	"swallowtail/s.deribit/marshaling"
+	deribitproto "swallowtail/s.deribit/proto"
+)
+
+// GetDeribitStatus ...
+func (s *DeribitService) GetDeribitStatus(
+	ctx context.Context, in *deribitproto.GetDeribitStatusRequest,
+) (*deribitproto.GetDeribitStatusResponse, error) {
+	rsp, err := client.GetTime(ctx, &dto.GetTimeRequest{})
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_get_status", nil)
+	}
+
+	return marshaling.GetTimeDTOToProto(rsp), nil
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.deribit/client"
+	"swallowtail/s.deribit/dto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func validateCredentials(credentials *tradeengineproto.VenueCredentials) error {
+	switch {
+	case credentials == nil:
+		return gerrors.BadParam("missing_param.credentials", nil)
+	case credentials.ApiKey == "":
+		return gerrors.BadParam("missing_param.credentials.api_key", nil)
+	case credentials.SecretKey == "":
+		return gerrors.BadParam("missing_param.credentials.secret_key", nil)
+	default:
+		return nil
+	}
+}
+
+func validateOrder(order *tradeengineproto.Order) error {
+	switch {
+	case order.Venue != tradeengineproto.VENUE_DERIBIT:
+		return gerrors.FailedPrecondition("invalid_venue.expecting_deribit", nil)
+	case order.Instrument == "" && order.Asset == "":
+		return gerrors.BadParam("missing_param.instrument_or_asset", nil)
+	case order.ClosePosition && order.Quantity == 0:
+		return gerrors.Unimplemented("unimplemented.close_position", nil)
+	case order.Quantity <= 0:
+		return gerrors.BadParam("missing_param.quantity", nil)
+	}
+
+	switch order.InstrumentType {
+	case tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL:
+	case tradeengineproto.INSTRUMENT_TYPE_OPTION:
+		// Deribit only supports trigger orders on futures.
+		switch order.OrderType {
+		case tradeengineproto.ORDER_TYPE_LIMIT, tradeengineproto.ORDER_TYPE_MARKET:
+		default:
+			return gerrors.Unimplemented("order_type.unsupported_for_options", map[string]string{
+				"order_type": order.OrderType.String(),
+			})
+		}
+	default:
+		return gerrors.Unimplemented("instrument_type", map[string]string{
+			"instrument_type": order.InstrumentType.String(),
+		})
+	}
+
+	switch order.OrderType {
+	case tradeengineproto.ORDER_TYPE_LIMIT:
+		switch {
+		case order.LimitPrice <= 0:
+			return gerrors.BadParam("bad_param.limit_price", nil)
+		}
+	case tradeengineproto.ORDER_TYPE_STOP_MARKET, tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET:
+		switch {
+		case order.StopPrice <= 0:
+			return gerrors.BadParam("bad_param.stop_price", nil)
+		}
+	case tradeengineproto.ORDER_TYPE_STOP_LIMIT, tradeengineproto.ORDER_TYPE_TAKE_PROFIT_LIMIT:
+		switch {
+		case order.LimitPrice <= 0:
+			return gerrors.BadParam("bad_param.limit_price", nil)
+		case order.StopPrice <= 0:
+			return gerrors.BadParam("bad_param.stop_price", nil)
+		}
+	}
+
+	return nil
+}
+
+// referencePrice returns the price we value the order at; this is only required to convert the quantity of orders on
+// inverse instruments to USD. Market orders are valued at the mark price of the instrument.
+func referencePrice(ctx context.Context, order *tradeengineproto.Order, instrument *dto.Instrument) (float64, error) {
+	switch {
+	case order.OrderType == tradeengineproto.ORDER_TYPE_LIMIT:
+		return float64(order.LimitPrice), nil
+	case order.StopPrice > 0:
+		return float64(order.StopPrice), nil
+	}
+
+	ticker, err := client.GetTicker(ctx, &dto.GetTickerRequest{
+		InstrumentName: instrument.InstrumentName,
+	})
+	if err != nil {
+		return 0, gerrors.Augment(err, "failed_to_read_reference_price", nil)
+	}
+
+	return ticker.MarkPrice, nil
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.deribit/client"
+	"swallowtail/s.deribit/dto"
+	"swallowtail/s.deribit/marshaling"
+	deribitproto "swallowtail/s.deribit/proto"
+)
+
+// ExecuteNewDeribitOrder executes a given order on Deribit.
+func (s *DeribitService) ExecuteNewDeribitOrder(
+	ctx context.Context, in *deribitproto.ExecuteNewDeribitOrderRequest,
+) (*deribitproto.ExecuteNewDeribitOrderResponse, error) {
+	// Validate request.
+	switch {
+	case in.Order == nil:
+		return nil, gerrors.BadParam("missing_param.order", nil)
+	case in.Credentials == nil:
+		return nil, gerrors.BadParam("missing_param.credentials", nil)
+	}
+
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Unauthenticated("unauthorized.invalid_credentials", map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	order := in.Order
+
+	errParams := map[string]string{
+		"actor_id":   order.ActorId,
+		"instrument": order.Instrument,
+		"asset":      order.Asset,
+		"pair":       order.Pair.String(),
+	}
+
+	// Validate order.
+	if err := validateOrder(order); err != nil {
+		slog.Error(ctx, "Failed to execute order invalid: Error: %v, Order: %+v", err, order)
+		return nil, gerrors.Augment(err, "failed_to_execute_order.invalid_order", errParams)
+	}
+
+	instrumentName, err := marshaling.InstrumentNameFromOrder(order)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_order", errParams)
+	}
+	errParams["instrument_name"] = instrumentName
+
+	// Read the instrument; we need its contract specification to size the order.
+	instrument, err := client.GetInstrument(ctx, &dto.GetInstrumentRequest{
+		InstrumentName: instrumentName,
+	})
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_order.read_instrument", errParams)
+	}
+
+	price, err := referencePrice(ctx, order, instrument)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_order", errParams)
+	}
+
+	// Marshal order to dto.
+	dtoOrder, err := marshaling.OrderProtoToDTO(order, instrument, price)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_order", errParams)
+	}
+
+	// Marshal credentials.
+	dtoCredentials := marshaling.VenueCredentialsProtoToDeribitCredentials(in.Credentials)
+
+	// Execute order via client.
+	rsp, err := client.ExecuteOrder(ctx, dtoOrder, dtoCredentials)
+	if err != nil {
+		slog.Error(ctx, "Failed to execute order: Error: %v, Order: %+v", err, order)
+		return nil, gerrors.Augment(err, "failed_to_execute_order", errParams)
+	}
+
+	// Embelish order with execution metadata.
+	order.Instrument = instrumentName
+	order.ExternalOrderId = rsp.Order.OrderID
+	order.ExecutionTimestamp = rsp.Order.CreationTimestamp
+
+	return &deribitproto.ExecuteNewDeribitOrderResponse{
+		Order: order,
+	}, nil
+}
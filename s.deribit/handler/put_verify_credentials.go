@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.deribit/client"
+	"swallowtail/s.deribit/marshaling"
+	deribitproto "swallowtail/s.deribit/proto"
+)
+
+// VerifyDeribitCredentials verifies the credentials have the scopes required to trade; & that withdrawals are disabled.
+func (s *DeribitService) VerifyDeribitCredentials(
+	ctx context.Context, in *deribitproto.VerifyDeribitCredentialsRequest,
+) (*deribitproto.VerifyDeribitCredentialsResponse, error) {
+	switch {
+	case in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	}
+
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_verify_credentials", nil)
+	}
+
+	errParams := map[string]string{
+		"user_id": in.UserId,
+	}
+
+	// Marshal credentials.
+	dtoCredentials := marshaling.VenueCredentialsProtoToDeribitCredentials(in.Credentials)
+
+	rsp, err := client.Authenticate(ctx, dtoCredentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_verify_credentials", errParams)
+	}
+
+	proto := marshaling.VerifyCredentialsDTOToProto(rsp)
+
+	slog.Info(ctx, "%s: verified deribit credentials %v", in.UserId, proto.Success)
+
+	return proto, nil
+}
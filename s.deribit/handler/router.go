@@ -0,0 +1,10 @@
+package handler
+
+import (
+	deribitproto "swallowtail/s.deribit/proto"
+)
+
+// DeribitService ...
+type DeribitService struct {
+	*deribitproto.UnimplementedDeribitServer
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+
+	"swallowtail/libraries/mariana"
+	"swallowtail/s.deribit/client"
+	"swallowtail/s.deribit/handler"
+	deribitproto "swallowtail/s.deribit/proto"
+)
+
+const (
+	svcName = "s.deribit"
+)
+
+func main() {
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Init Deribit client.
+	if err := client.Init(ctx); err != nil {
+		panic(err)
+	}
+
+	// Init Mariana Server
+	srv := mariana.Init(svcName)
+	deribitproto.RegisterDeribitServer(srv.Grpc(), &handler.DeribitService{})
+	srv.Run(ctx)
+}
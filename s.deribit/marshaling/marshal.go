@@ -0,0 +1,203 @@
+package marshaling
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.deribit/client/auth"
+	"swallowtail/s.deribit/dto"
+	deribitproto "swallowtail/s.deribit/proto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	// instrumentTypeReversed is an inverse instrument; amounts are denominated in USD rather than the base currency.
+	instrumentTypeReversed = "reversed"
+)
+
+// GetTimeDTOToProto ...
+func GetTimeDTOToProto(in *dto.GetTimeResponse) *deribitproto.GetDeribitStatusResponse {
+	return &deribitproto.GetDeribitStatusResponse{
+		ServerTime:      in.ServerTime,
+		ServerLatencyMs: int64(in.ServerLatency),
+	}
+}
+
+// VenueCredentialsProtoToDeribitCredentials ...
+func VenueCredentialsProtoToDeribitCredentials(in *tradeengineproto.VenueCredentials) *auth.Credentials {
+	return &auth.Credentials{
+		ClientID:     in.ApiKey,
+		ClientSecret: in.SecretKey,
+	}
+}
+
+// InstrumentNameFromOrder returns the Deribit instrument name of the order. If the order has an instrument we use it as is;
+// otherwise perpetuals are derived from the asset & pair: `BTC-PERPETUAL` for the inverse USD perpetuals & `SOL_USDC-PERPETUAL`
+// for the linear USDC perpetuals. Options must always be given by instrument, e.g `BTC-30JUN23-30000-C`.
+func InstrumentNameFromOrder(in *tradeengineproto.Order) (string, error) {
+	if in.Instrument != "" {
+		return in.Instrument, nil
+	}
+
+	errParams := map[string]string{
+		"asset":           in.Asset,
+		"pair":            in.Pair.String(),
+		"instrument_type": in.InstrumentType.String(),
+	}
+
+	switch in.InstrumentType {
+	case tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL:
+	case tradeengineproto.INSTRUMENT_TYPE_OPTION:
+		return "", gerrors.BadParam("missing_param.instrument.required_for_options", errParams)
+	default:
+		return "", gerrors.Unimplemented("unimplemented_instrument_type", errParams)
+	}
+
+	asset := strings.ToUpper(in.Asset)
+	switch in.Pair {
+	case tradeengineproto.TRADE_PAIR_USD:
+		return fmt.Sprintf("%s-PERPETUAL", asset), nil
+	case tradeengineproto.TRADE_PAIR_USDC:
+		return fmt.Sprintf("%s_USDC-PERPETUAL", asset), nil
+	default:
+		return "", gerrors.FailedPrecondition("invalid_pair.perpetuals_must_be_usd_or_usdc", errParams)
+	}
+}
+
+// OrderProtoToDTO converts an order to a Deribit order. The quantity of the order is always in the base currency; for inverse
+// instruments we convert it to USD at the given price. The amount is rounded down to the minimum trade amount of the instrument.
+func OrderProtoToDTO(in *tradeengineproto.Order, instrument *dto.Instrument, price float64) (*dto.ExecuteOrderRequest, error) {
+	errParams := map[string]string{
+		"instrument_name": instrument.InstrumentName,
+	}
+
+	req := &dto.ExecuteOrderRequest{
+		InstrumentName: instrument.InstrumentName,
+		ReduceOnly:     in.ReduceOnly,
+		PostOnly:       in.PostOnly,
+		Label:          in.OrderId,
+	}
+
+	switch in.TradeSide {
+	case tradeengineproto.TRADE_SIDE_BUY, tradeengineproto.TRADE_SIDE_LONG:
+		req.Side = "buy"
+	default:
+		req.Side = "sell"
+	}
+
+	amount, err := amountFromQuantity(float64(in.Quantity), instrument, price)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_marshal_order", errParams)
+	}
+	req.Amount = amount
+
+	switch in.OrderType {
+	case tradeengineproto.ORDER_TYPE_MARKET:
+		req.Type = "market"
+	case tradeengineproto.ORDER_TYPE_LIMIT:
+		req.Type = "limit"
+		req.Price = float64(in.LimitPrice)
+	case tradeengineproto.ORDER_TYPE_STOP_MARKET:
+		req.Type = "stop_market"
+		req.TriggerPrice = float64(in.StopPrice)
+	case tradeengineproto.ORDER_TYPE_STOP_LIMIT:
+		req.Type = "stop_limit"
+		req.Price = float64(in.LimitPrice)
+		req.TriggerPrice = float64(in.StopPrice)
+	case tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET:
+		req.Type = "take_market"
+		req.TriggerPrice = float64(in.StopPrice)
+	case tradeengineproto.ORDER_TYPE_TAKE_PROFIT_LIMIT:
+		req.Type = "take_limit"
+		req.Price = float64(in.LimitPrice)
+		req.TriggerPrice = float64(in.StopPrice)
+	default:
+		errParams["order_type"] = in.OrderType.String()
+		return nil, gerrors.Unimplemented("failed_to_marshal_order.unimplemented_order_type", errParams)
+	}
+
+	// Trigger orders require the price they trigger against.
+	if req.TriggerPrice > 0 {
+		switch in.WorkingType {
+		case tradeengineproto.WORKING_TYPE_MARK_PRICE:
+			req.Trigger = "mark_price"
+		default:
+			req.Trigger = "last_price"
+		}
+	}
+
+	return req, nil
+}
+
+func amountFromQuantity(quantity float64, instrument *dto.Instrument, price float64) (float64, error) {
+	amount := quantity
+	if instrument.InstrumentType == instrumentTypeReversed {
+		if price <= 0 {
+			return 0, gerrors.FailedPrecondition("invalid_price.required_for_inverse_instrument", nil)
+		}
+		amount = quantity * price
+	}
+
+	if instrument.MinTradeAmount > 0 {
+		// Quantities are float32 on the order; we allow for the loss of precision so we don't round down a whole increment.
+		amount = math.Floor(amount/instrument.MinTradeAmount+1e-6) * instrument.MinTradeAmount
+	}
+
+	if amount <= 0 {
+		return 0, gerrors.FailedPrecondition("invalid_quantity.below_minimum_trade_amount", map[string]string{
+			"min_trade_amount": fmt.Sprintf("%v", instrument.MinTradeAmount),
+		})
+	}
+
+	return amount, nil
+}
+
+// AccountSummaryDTOToProto ...
+func AccountSummaryDTOToProto(in *dto.AccountSummary, indexPrice float64) *deribitproto.ReadDeribitAccountSummaryResponse {
+	return &deribitproto.ReadDeribitAccountSummaryResponse{
+		Currency:          in.Currency,
+		Balance:           float32(in.Balance),
+		Equity:            float32(in.Equity),
+		AvailableFunds:    float32(in.AvailableFunds),
+		AvailableFundsUsd: float32(in.AvailableFunds * indexPrice),
+	}
+}
+
+// VerifyCredentialsDTOToProto converts the scopes granted to the credentials into a verification.
+// https://docs.deribit.com/#access-scope
+func VerifyCredentialsDTOToProto(in *dto.AuthenticateResponse) *deribitproto.VerifyDeribitCredentialsResponse {
+	scopes := map[string]bool{}
+	for _, s := range strings.Fields(in.Scope) {
+		scopes[s] = true
+	}
+
+	var (
+		tradeEnabled       = scopes["trade:read_write"]
+		accountReadEnabled = scopes["account:read"] || scopes["account:read_write"]
+		withdrawEnabled    = scopes["wallet:read_write"]
+	)
+
+	reasons := []string{}
+
+	if !tradeEnabled {
+		reasons = append(reasons, "Please enable the ability to trade")
+	}
+
+	if !accountReadEnabled {
+		reasons = append(reasons, "Please enable the ability to read account")
+	}
+
+	if withdrawEnabled {
+		reasons = append(reasons, "You have withdrawals enabled, please turn them off")
+	}
+
+	return &deribitproto.VerifyDeribitCredentialsResponse{
+		Success:            tradeEnabled && accountReadEnabled,
+		Reason:             strings.Join(reasons, ","),
+		TradeEnabled:       tradeEnabled,
+		AccountReadEnabled: accountReadEnabled,
+		WithdrawEnabled:    withdrawEnabled,
+	}
+}
@@ -0,0 +1,176 @@
+package marshaling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.deribit/dto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func TestOrderProtoToDTO(t *testing.T) {
+	t.Parallel()
+
+	inverse := &dto.Instrument{
+		InstrumentName: "BTC-PERPETUAL",
+		InstrumentType: "reversed",
+		ContractSize:   10,
+		MinTradeAmount: 10,
+	}
+	linear := &dto.Instrument{
+		InstrumentName: "SOL_USDC-PERPETUAL",
+		InstrumentType: "linear",
+		ContractSize:   0.1,
+		MinTradeAmount: 0.1,
+	}
+
+	tests := []struct {
+		name          string
+		order         *tradeengineproto.Order
+		instrument    *dto.Instrument
+		price         float64
+		expectedOrder *dto.ExecuteOrderRequest
+	}{
+		{
+			name: "inverse-market-converted-to-usd",
+			order: &tradeengineproto.Order{
+				OrderId:   "order-id",
+				OrderType: tradeengineproto.ORDER_TYPE_MARKET,
+				TradeSide: tradeengineproto.TRADE_SIDE_LONG,
+				Quantity:  0.0105,
+			},
+			instrument: inverse,
+			price:      20000,
+			expectedOrder: &dto.ExecuteOrderRequest{
+				Side:           "buy",
+				InstrumentName: "BTC-PERPETUAL",
+				Amount:         210,
+				Type:           "market",
+				Label:          "order-id",
+			},
+		},
+		{
+			name: "linear-take-profit-market",
+			order: &tradeengineproto.Order{
+				OrderType:   tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET,
+				TradeSide:   tradeengineproto.TRADE_SIDE_SELL,
+				StopPrice:   25,
+				Quantity:    12.34,
+				ReduceOnly:  true,
+				WorkingType: tradeengineproto.WORKING_TYPE_MARK_PRICE,
+			},
+			instrument: linear,
+			price:      25,
+			expectedOrder: &dto.ExecuteOrderRequest{
+				Side:           "sell",
+				InstrumentName: "SOL_USDC-PERPETUAL",
+				Amount:         12.3,
+				Type:           "take_market",
+				TriggerPrice:   25,
+				Trigger:        "mark_price",
+				ReduceOnly:     true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order, err := OrderProtoToDTO(tt.order, tt.instrument, tt.price)
+			require.NoError(t, err)
+
+			assert.InDelta(t, tt.expectedOrder.Amount, order.Amount, 1e-9)
+			order.Amount = tt.expectedOrder.Amount
+			assert.Equal(t, tt.expectedOrder, order)
+		})
+	}
+}
+
+func TestOrderProtoToDTO_BelowMinimumTradeAmount(t *testing.T) {
+	t.Parallel()
+
+	_, err := OrderProtoToDTO(&tradeengineproto.Order{
+		OrderType: tradeengineproto.ORDER_TYPE_MARKET,
+		Quantity:  0.0001,
+	}, &dto.Instrument{
+		InstrumentName: "BTC-PERPETUAL",
+		InstrumentType: "reversed",
+		MinTradeAmount: 10,
+	}, 20000)
+	require.Error(t, err)
+}
+
+func TestInstrumentNameFromOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		order              *tradeengineproto.Order
+		expectedInstrument string
+		expectErr          bool
+	}{
+		{
+			name: "inverse-perpetual",
+			order: &tradeengineproto.Order{
+				Asset:          "btc",
+				Pair:           tradeengineproto.TRADE_PAIR_USD,
+				InstrumentType: tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+			},
+			expectedInstrument: "BTC-PERPETUAL",
+		},
+		{
+			name: "linear-perpetual",
+			order: &tradeengineproto.Order{
+				Asset:          "SOL",
+				Pair:           tradeengineproto.TRADE_PAIR_USDC,
+				InstrumentType: tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+			},
+			expectedInstrument: "SOL_USDC-PERPETUAL",
+		},
+		{
+			name: "option-by-instrument",
+			order: &tradeengineproto.Order{
+				Instrument:     "BTC-30JUN23-30000-C",
+				InstrumentType: tradeengineproto.INSTRUMENT_TYPE_OPTION,
+			},
+			expectedInstrument: "BTC-30JUN23-30000-C",
+		},
+		{
+			name: "option-without-instrument",
+			order: &tradeengineproto.Order{
+				Asset:          "BTC",
+				InstrumentType: tradeengineproto.INSTRUMENT_TYPE_OPTION,
+			},
+			expectErr: true,
+		},
+		{
+			name: "usdt-perpetual",
+			order: &tradeengineproto.Order{
+				Asset:          "BTC",
+				Pair:           tradeengineproto.TRADE_PAIR_USDT,
+				InstrumentType: tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			instrument, err := InstrumentNameFromOrder(tt.order)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedInstrument, instrument)
+		})
+	}
+}
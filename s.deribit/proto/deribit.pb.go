@@ -0,0 +1,923 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.2
+// source: s.deribit/proto/deribit.proto
+
+package deribitproto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	proto "swallowtail/s.trade-engine/proto"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetDeribitStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDeribitStatusRequest) Reset() {
+	*x = GetDeribitStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeribitStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeribitStatusRequest) ProtoMessage() {}
+
+func (x *GetDeribitStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeribitStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetDeribitStatusRequest) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{0}
+}
+
+type GetDeribitStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerTime      int64 `protobuf:"varint,1,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	ServerLatencyMs int64 `protobuf:"varint,2,opt,name=server_latency_ms,json=serverLatencyMs,proto3" json:"server_latency_ms,omitempty"`
+}
+
+func (x *GetDeribitStatusResponse) Reset() {
+	*x = GetDeribitStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeribitStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeribitStatusResponse) ProtoMessage() {}
+
+func (x *GetDeribitStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeribitStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetDeribitStatusResponse) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDeribitStatusResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+func (x *GetDeribitStatusResponse) GetServerLatencyMs() int64 {
+	if x != nil {
+		return x.ServerLatencyMs
+	}
+	return 0
+}
+
+type ExecuteNewDeribitOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Perpetuals may be given by asset & pair; options must be given by their instrument name, e.g `BTC-30JUN23-30000-C`.
+	Order       *proto.Order            `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Credentials *proto.VenueCredentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *ExecuteNewDeribitOrderRequest) Reset() {
+	*x = ExecuteNewDeribitOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteNewDeribitOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteNewDeribitOrderRequest) ProtoMessage() {}
+
+func (x *ExecuteNewDeribitOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteNewDeribitOrderRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteNewDeribitOrderRequest) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExecuteNewDeribitOrderRequest) GetOrder() *proto.Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ExecuteNewDeribitOrderRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type ExecuteNewDeribitOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *proto.Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *ExecuteNewDeribitOrderResponse) Reset() {
+	*x = ExecuteNewDeribitOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteNewDeribitOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteNewDeribitOrderResponse) ProtoMessage() {}
+
+func (x *ExecuteNewDeribitOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteNewDeribitOrderResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteNewDeribitOrderResponse) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExecuteNewDeribitOrderResponse) GetOrder() *proto.Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type CancelDeribitOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials     *proto.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	ExternalOrderId string                  `protobuf:"bytes,2,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+}
+
+func (x *CancelDeribitOrderRequest) Reset() {
+	*x = CancelDeribitOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDeribitOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDeribitOrderRequest) ProtoMessage() {}
+
+func (x *CancelDeribitOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeribitOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelDeribitOrderRequest) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelDeribitOrderRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *CancelDeribitOrderRequest) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+type CancelDeribitOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalOrderId string  `protobuf:"bytes,1,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+	OrderState      string  `protobuf:"bytes,2,opt,name=order_state,json=orderState,proto3" json:"order_state,omitempty"`
+	FilledAmount    float32 `protobuf:"fixed32,3,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
+}
+
+func (x *CancelDeribitOrderResponse) Reset() {
+	*x = CancelDeribitOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDeribitOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDeribitOrderResponse) ProtoMessage() {}
+
+func (x *CancelDeribitOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeribitOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelDeribitOrderResponse) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelDeribitOrderResponse) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+func (x *CancelDeribitOrderResponse) GetOrderState() string {
+	if x != nil {
+		return x.OrderState
+	}
+	return ""
+}
+
+func (x *CancelDeribitOrderResponse) GetFilledAmount() float32 {
+	if x != nil {
+		return x.FilledAmount
+	}
+	return 0
+}
+
+type ReadDeribitAccountSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials *proto.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// The collateral currency of the account; one of `BTC`, `ETH` or `USDC`.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *ReadDeribitAccountSummaryRequest) Reset() {
+	*x = ReadDeribitAccountSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDeribitAccountSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeribitAccountSummaryRequest) ProtoMessage() {}
+
+func (x *ReadDeribitAccountSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeribitAccountSummaryRequest.ProtoReflect.Descriptor instead.
+func (*ReadDeribitAccountSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReadDeribitAccountSummaryRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *ReadDeribitAccountSummaryRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ReadDeribitAccountSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency       string  `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance        float32 `protobuf:"fixed32,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Equity         float32 `protobuf:"fixed32,3,opt,name=equity,proto3" json:"equity,omitempty"`
+	AvailableFunds float32 `protobuf:"fixed32,4,opt,name=available_funds,json=availableFunds,proto3" json:"available_funds,omitempty"`
+	// The available funds valued in USD at the current index price.
+	AvailableFundsUsd float32 `protobuf:"fixed32,5,opt,name=available_funds_usd,json=availableFundsUsd,proto3" json:"available_funds_usd,omitempty"`
+}
+
+func (x *ReadDeribitAccountSummaryResponse) Reset() {
+	*x = ReadDeribitAccountSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDeribitAccountSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeribitAccountSummaryResponse) ProtoMessage() {}
+
+func (x *ReadDeribitAccountSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeribitAccountSummaryResponse.ProtoReflect.Descriptor instead.
+func (*ReadDeribitAccountSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadDeribitAccountSummaryResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ReadDeribitAccountSummaryResponse) GetBalance() float32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ReadDeribitAccountSummaryResponse) GetEquity() float32 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *ReadDeribitAccountSummaryResponse) GetAvailableFunds() float32 {
+	if x != nil {
+		return x.AvailableFunds
+	}
+	return 0
+}
+
+func (x *ReadDeribitAccountSummaryResponse) GetAvailableFundsUsd() float32 {
+	if x != nil {
+		return x.AvailableFundsUsd
+	}
+	return 0
+}
+
+type VerifyDeribitCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials *proto.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	UserId      string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *VerifyDeribitCredentialsRequest) Reset() {
+	*x = VerifyDeribitCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyDeribitCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDeribitCredentialsRequest) ProtoMessage() {}
+
+func (x *VerifyDeribitCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDeribitCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDeribitCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VerifyDeribitCredentialsRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *VerifyDeribitCredentialsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type VerifyDeribitCredentialsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success            bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Reason             string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	TradeEnabled       bool   `protobuf:"varint,3,opt,name=trade_enabled,json=tradeEnabled,proto3" json:"trade_enabled,omitempty"`
+	AccountReadEnabled bool   `protobuf:"varint,4,opt,name=account_read_enabled,json=accountReadEnabled,proto3" json:"account_read_enabled,omitempty"`
+	WithdrawEnabled    bool   `protobuf:"varint,5,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
+}
+
+func (x *VerifyDeribitCredentialsResponse) Reset() {
+	*x = VerifyDeribitCredentialsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_deribit_proto_deribit_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyDeribitCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDeribitCredentialsResponse) ProtoMessage() {}
+
+func (x *VerifyDeribitCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_deribit_proto_deribit_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDeribitCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDeribitCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_s_deribit_proto_deribit_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VerifyDeribitCredentialsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VerifyDeribitCredentialsResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VerifyDeribitCredentialsResponse) GetTradeEnabled() bool {
+	if x != nil {
+		return x.TradeEnabled
+	}
+	return false
+}
+
+func (x *VerifyDeribitCredentialsResponse) GetAccountReadEnabled() bool {
+	if x != nil {
+		return x.AccountReadEnabled
+	}
+	return false
+}
+
+func (x *VerifyDeribitCredentialsResponse) GetWithdrawEnabled() bool {
+	if x != nil {
+		return x.WithdrawEnabled
+	}
+	return false
+}
+
+var File_s_deribit_proto_deribit_proto protoreflect.FileDescriptor
+
+var file_s_deribit_proto_deribit_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x73, 0x2e, 0x64, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x64, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x26, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x72, 0x69, 0x62, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x22, 0x72, 0x0a, 0x1d, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22,
+	0x3e, 0x0a, 0x1e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x72,
+	0x69, 0x62, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22,
+	0x7c, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x8e, 0x01,
+	0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x73,
+	0x0a, 0x20, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0xca, 0x01, 0x0a, 0x21, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x72, 0x69,
+	0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x75,
+	0x6e, 0x64, 0x73, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x55, 0x73, 0x64,
+	0x22, 0x6f, 0x0a, 0x1f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xd6, 0x01, 0x0a, 0x20, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x72, 0x69,
+	0x62, 0x69, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a,
+	0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x32, 0xcb, 0x03, 0x0a, 0x07, 0x64,
+	0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x65, 0x72,
+	0x69, 0x62, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5b, 0x0a, 0x16, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x44,
+	0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x72,
+	0x69, 0x62, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x18, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44,
+	0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x20, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x72, 0x69, 0x62, 0x69,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x65, 0x72, 0x69,
+	0x62, 0x69, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x73, 0x77, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x73, 0x2e, 0x64, 0x65, 0x72, 0x69, 0x62, 0x69,
+	0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x64, 0x65, 0x72, 0x69, 0x62, 0x69, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_s_deribit_proto_deribit_proto_rawDescOnce sync.Once
+	file_s_deribit_proto_deribit_proto_rawDescData = file_s_deribit_proto_deribit_proto_rawDesc
+)
+
+func file_s_deribit_proto_deribit_proto_rawDescGZIP() []byte {
+	file_s_deribit_proto_deribit_proto_rawDescOnce.Do(func() {
+		file_s_deribit_proto_deribit_proto_rawDescData = protoimpl.X.CompressGZIP(file_s_deribit_proto_deribit_proto_rawDescData)
+	})
+	return file_s_deribit_proto_deribit_proto_rawDescData
+}
+
+var file_s_deribit_proto_deribit_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_s_deribit_proto_deribit_proto_goTypes = []interface{}{
+	(*GetDeribitStatusRequest)(nil),           // 0: GetDeribitStatusRequest
+	(*GetDeribitStatusResponse)(nil),          // 1: GetDeribitStatusResponse
+	(*ExecuteNewDeribitOrderRequest)(nil),     // 2: ExecuteNewDeribitOrderRequest
+	(*ExecuteNewDeribitOrderResponse)(nil),    // 3: ExecuteNewDeribitOrderResponse
+	(*CancelDeribitOrderRequest)(nil),         // 4: CancelDeribitOrderRequest
+	(*CancelDeribitOrderResponse)(nil),        // 5: CancelDeribitOrderResponse
+	(*ReadDeribitAccountSummaryRequest)(nil),  // 6: ReadDeribitAccountSummaryRequest
+	(*ReadDeribitAccountSummaryResponse)(nil), // 7: ReadDeribitAccountSummaryResponse
+	(*VerifyDeribitCredentialsRequest)(nil),   // 8: VerifyDeribitCredentialsRequest
+	(*VerifyDeribitCredentialsResponse)(nil),  // 9: VerifyDeribitCredentialsResponse
+	(*proto.Order)(nil),                       // 10: Order
+	(*proto.VenueCredentials)(nil),            // 11: VenueCredentials
+}
+var file_s_deribit_proto_deribit_proto_depIdxs = []int32{
+	10, // 0: ExecuteNewDeribitOrderRequest.order:type_name -> Order
+	11, // 1: ExecuteNewDeribitOrderRequest.credentials:type_name -> VenueCredentials
+	10, // 2: ExecuteNewDeribitOrderResponse.order:type_name -> Order
+	11, // 3: CancelDeribitOrderRequest.credentials:type_name -> VenueCredentials
+	11, // 4: ReadDeribitAccountSummaryRequest.credentials:type_name -> VenueCredentials
+	11, // 5: VerifyDeribitCredentialsRequest.credentials:type_name -> VenueCredentials
+	0,  // 6: deribit.GetDeribitStatus:input_type -> GetDeribitStatusRequest
+	2,  // 7: deribit.ExecuteNewDeribitOrder:input_type -> ExecuteNewDeribitOrderRequest
+	4,  // 8: deribit.CancelDeribitOrder:input_type -> CancelDeribitOrderRequest
+	6,  // 9: deribit.ReadDeribitAccountSummary:input_type -> ReadDeribitAccountSummaryRequest
+	8,  // 10: deribit.VerifyDeribitCredentials:input_type -> VerifyDeribitCredentialsRequest
+	1,  // 11: deribit.GetDeribitStatus:output_type -> GetDeribitStatusResponse
+	3,  // 12: deribit.ExecuteNewDeribitOrder:output_type -> ExecuteNewDeribitOrderResponse
+	5,  // 13: deribit.CancelDeribitOrder:output_type -> CancelDeribitOrderResponse
+	7,  // 14: deribit.ReadDeribitAccountSummary:output_type -> ReadDeribitAccountSummaryResponse
+	9,  // 15: deribit.VerifyDeribitCredentials:output_type -> VerifyDeribitCredentialsResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_s_deribit_proto_deribit_proto_init() }
+func file_s_deribit_proto_deribit_proto_init() {
+	if File_s_deribit_proto_deribit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_s_deribit_proto_deribit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeribitStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_deribit_proto_deribit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeribitStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_deribit_proto_deribit_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteNewDeribitOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_deribit_proto_deribit_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteNewDeribitOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_deribit_proto_deribit_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeribitOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_deribit_proto_deribit_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeribitOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_deribit_proto_deribit_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDeribitAccountSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_deribit_proto_deribit_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDeribitAccountSummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_deribit_proto_deribit_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDeribitCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_deribit_proto_deribit_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDeribitCredentialsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_s_deribit_proto_deribit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_s_deribit_proto_deribit_proto_goTypes,
+		DependencyIndexes: file_s_deribit_proto_deribit_proto_depIdxs,
+		MessageInfos:      file_s_deribit_proto_deribit_proto_msgTypes,
+	}.Build()
+	File_s_deribit_proto_deribit_proto = out.File
+	file_s_deribit_proto_deribit_proto_rawDesc = nil
+	file_s_deribit_proto_deribit_proto_goTypes = nil
+	file_s_deribit_proto_deribit_proto_depIdxs = nil
+}
@@ -0,0 +1,375 @@
+package deribitproto
+
+import (
+	context "context"
+	"swallowtail/libraries/gerrors"
+	"time"
+
+	"github.com/monzo/slog"
+	grpc "google.golang.org/grpc"
+)
+
+// --- Get Deribit Status --- //
+
+type GetDeribitStatusFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *GetDeribitStatusResponse
+	ctx     context.Context
+}
+
+func (a *GetDeribitStatusFuture) Response() (*GetDeribitStatusResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "get_deribit_status", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *GetDeribitStatusRequest) Send(ctx context.Context) *GetDeribitStatusFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *GetDeribitStatusRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *GetDeribitStatusFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *GetDeribitStatusResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-deribit:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_deribit_connection_failed", nil)
+		return &GetDeribitStatusFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewDeribitClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.GetDeribitStatus(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_get_deribit_status", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &GetDeribitStatusFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Execute New Deribit Order --- //
+
+type ExecuteNewDeribitOrderFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ExecuteNewDeribitOrderResponse
+	ctx     context.Context
+}
+
+func (a *ExecuteNewDeribitOrderFuture) Response() (*ExecuteNewDeribitOrderResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "execute_new_deribit_order", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ExecuteNewDeribitOrderRequest) Send(ctx context.Context) *ExecuteNewDeribitOrderFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ExecuteNewDeribitOrderRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ExecuteNewDeribitOrderFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ExecuteNewDeribitOrderResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-deribit:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_deribit_connection_failed", nil)
+		return &ExecuteNewDeribitOrderFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewDeribitClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ExecuteNewDeribitOrder(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_execute_new_deribit_order", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ExecuteNewDeribitOrderFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Cancel Deribit Order --- //
+
+type CancelDeribitOrderFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *CancelDeribitOrderResponse
+	ctx     context.Context
+}
+
+func (a *CancelDeribitOrderFuture) Response() (*CancelDeribitOrderResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "cancel_deribit_order", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *CancelDeribitOrderRequest) Send(ctx context.Context) *CancelDeribitOrderFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *CancelDeribitOrderRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *CancelDeribitOrderFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *CancelDeribitOrderResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-deribit:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_deribit_connection_failed", nil)
+		return &CancelDeribitOrderFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewDeribitClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.CancelDeribitOrder(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_cancel_deribit_order", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &CancelDeribitOrderFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Read Deribit Account Summary --- //
+
+type ReadDeribitAccountSummaryFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ReadDeribitAccountSummaryResponse
+	ctx     context.Context
+}
+
+func (a *ReadDeribitAccountSummaryFuture) Response() (*ReadDeribitAccountSummaryResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "read_deribit_account_summary", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ReadDeribitAccountSummaryRequest) Send(ctx context.Context) *ReadDeribitAccountSummaryFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ReadDeribitAccountSummaryRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ReadDeribitAccountSummaryFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ReadDeribitAccountSummaryResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-deribit:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_deribit_connection_failed", nil)
+		return &ReadDeribitAccountSummaryFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewDeribitClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ReadDeribitAccountSummary(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_read_deribit_account_summary", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ReadDeribitAccountSummaryFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Verify Deribit Credentials --- //
+
+type VerifyDeribitCredentialsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *VerifyDeribitCredentialsResponse
+	ctx     context.Context
+}
+
+func (a *VerifyDeribitCredentialsFuture) Response() (*VerifyDeribitCredentialsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "verify_deribit_credentials", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *VerifyDeribitCredentialsRequest) Send(ctx context.Context) *VerifyDeribitCredentialsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *VerifyDeribitCredentialsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *VerifyDeribitCredentialsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *VerifyDeribitCredentialsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-deribit:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_deribit_connection_failed", nil)
+		return &VerifyDeribitCredentialsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewDeribitClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.VerifyDeribitCredentials(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_verify_deribit_credentials", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &VerifyDeribitCredentialsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
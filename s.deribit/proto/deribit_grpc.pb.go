@@ -0,0 +1,245 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package deribitproto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DeribitClient is the client API for Deribit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeribitClient interface {
+	GetDeribitStatus(ctx context.Context, in *GetDeribitStatusRequest, opts ...grpc.CallOption) (*GetDeribitStatusResponse, error)
+	ExecuteNewDeribitOrder(ctx context.Context, in *ExecuteNewDeribitOrderRequest, opts ...grpc.CallOption) (*ExecuteNewDeribitOrderResponse, error)
+	CancelDeribitOrder(ctx context.Context, in *CancelDeribitOrderRequest, opts ...grpc.CallOption) (*CancelDeribitOrderResponse, error)
+	ReadDeribitAccountSummary(ctx context.Context, in *ReadDeribitAccountSummaryRequest, opts ...grpc.CallOption) (*ReadDeribitAccountSummaryResponse, error)
+	VerifyDeribitCredentials(ctx context.Context, in *VerifyDeribitCredentialsRequest, opts ...grpc.CallOption) (*VerifyDeribitCredentialsResponse, error)
+}
+
+type deribitClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeribitClient(cc grpc.ClientConnInterface) DeribitClient {
+	return &deribitClient{cc}
+}
+
+func (c *deribitClient) GetDeribitStatus(ctx context.Context, in *GetDeribitStatusRequest, opts ...grpc.CallOption) (*GetDeribitStatusResponse, error) {
+	out := new(GetDeribitStatusResponse)
+	err := c.cc.Invoke(ctx, "/deribit/GetDeribitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deribitClient) ExecuteNewDeribitOrder(ctx context.Context, in *ExecuteNewDeribitOrderRequest, opts ...grpc.CallOption) (*ExecuteNewDeribitOrderResponse, error) {
+	out := new(ExecuteNewDeribitOrderResponse)
+	err := c.cc.Invoke(ctx, "/deribit/ExecuteNewDeribitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deribitClient) CancelDeribitOrder(ctx context.Context, in *CancelDeribitOrderRequest, opts ...grpc.CallOption) (*CancelDeribitOrderResponse, error) {
+	out := new(CancelDeribitOrderResponse)
+	err := c.cc.Invoke(ctx, "/deribit/CancelDeribitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deribitClient) ReadDeribitAccountSummary(ctx context.Context, in *ReadDeribitAccountSummaryRequest, opts ...grpc.CallOption) (*ReadDeribitAccountSummaryResponse, error) {
+	out := new(ReadDeribitAccountSummaryResponse)
+	err := c.cc.Invoke(ctx, "/deribit/ReadDeribitAccountSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deribitClient) VerifyDeribitCredentials(ctx context.Context, in *VerifyDeribitCredentialsRequest, opts ...grpc.CallOption) (*VerifyDeribitCredentialsResponse, error) {
+	out := new(VerifyDeribitCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/deribit/VerifyDeribitCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeribitServer is the server API for Deribit service.
+// All implementations must embed UnimplementedDeribitServer
+// for forward compatibility
+type DeribitServer interface {
+	GetDeribitStatus(context.Context, *GetDeribitStatusRequest) (*GetDeribitStatusResponse, error)
+	ExecuteNewDeribitOrder(context.Context, *ExecuteNewDeribitOrderRequest) (*ExecuteNewDeribitOrderResponse, error)
+	CancelDeribitOrder(context.Context, *CancelDeribitOrderRequest) (*CancelDeribitOrderResponse, error)
+	ReadDeribitAccountSummary(context.Context, *ReadDeribitAccountSummaryRequest) (*ReadDeribitAccountSummaryResponse, error)
+	VerifyDeribitCredentials(context.Context, *VerifyDeribitCredentialsRequest) (*VerifyDeribitCredentialsResponse, error)
+	mustEmbedUnimplementedDeribitServer()
+}
+
+// UnimplementedDeribitServer must be embedded to have forward compatible implementations.
+type UnimplementedDeribitServer struct {
+}
+
+func (UnimplementedDeribitServer) GetDeribitStatus(context.Context, *GetDeribitStatusRequest) (*GetDeribitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeribitStatus not implemented")
+}
+func (UnimplementedDeribitServer) ExecuteNewDeribitOrder(context.Context, *ExecuteNewDeribitOrderRequest) (*ExecuteNewDeribitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteNewDeribitOrder not implemented")
+}
+func (UnimplementedDeribitServer) CancelDeribitOrder(context.Context, *CancelDeribitOrderRequest) (*CancelDeribitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeribitOrder not implemented")
+}
+func (UnimplementedDeribitServer) ReadDeribitAccountSummary(context.Context, *ReadDeribitAccountSummaryRequest) (*ReadDeribitAccountSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeribitAccountSummary not implemented")
+}
+func (UnimplementedDeribitServer) VerifyDeribitCredentials(context.Context, *VerifyDeribitCredentialsRequest) (*VerifyDeribitCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDeribitCredentials not implemented")
+}
+func (UnimplementedDeribitServer) mustEmbedUnimplementedDeribitServer() {}
+
+// UnsafeDeribitServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeribitServer will
+// result in compilation errors.
+type UnsafeDeribitServer interface {
+	mustEmbedUnimplementedDeribitServer()
+}
+
+func RegisterDeribitServer(s grpc.ServiceRegistrar, srv DeribitServer) {
+	s.RegisterService(&Deribit_ServiceDesc, srv)
+}
+
+func _Deribit_GetDeribitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeribitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeribitServer).GetDeribitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deribit/GetDeribitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeribitServer).GetDeribitStatus(ctx, req.(*GetDeribitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deribit_ExecuteNewDeribitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteNewDeribitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeribitServer).ExecuteNewDeribitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deribit/ExecuteNewDeribitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeribitServer).ExecuteNewDeribitOrder(ctx, req.(*ExecuteNewDeribitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deribit_CancelDeribitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeribitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeribitServer).CancelDeribitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deribit/CancelDeribitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeribitServer).CancelDeribitOrder(ctx, req.(*CancelDeribitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deribit_ReadDeribitAccountSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeribitAccountSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeribitServer).ReadDeribitAccountSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deribit/ReadDeribitAccountSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeribitServer).ReadDeribitAccountSummary(ctx, req.(*ReadDeribitAccountSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Deribit_VerifyDeribitCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDeribitCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeribitServer).VerifyDeribitCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deribit/VerifyDeribitCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeribitServer).VerifyDeribitCredentials(ctx, req.(*VerifyDeribitCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Deribit_ServiceDesc is the grpc.ServiceDesc for Deribit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Deribit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "deribit",
+	HandlerType: (*DeribitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDeribitStatus",
+			Handler:    _Deribit_GetDeribitStatus_Handler,
+		},
+		{
+			MethodName: "ExecuteNewDeribitOrder",
+			Handler:    _Deribit_ExecuteNewDeribitOrder_Handler,
+		},
+		{
+			MethodName: "CancelDeribitOrder",
+			Handler:    _Deribit_CancelDeribitOrder_Handler,
+		},
+		{
+			MethodName: "ReadDeribitAccountSummary",
+			Handler:    _Deribit_ReadDeribitAccountSummary_Handler,
+		},
+		{
+			MethodName: "VerifyDeribitCredentials",
+			Handler:    _Deribit_VerifyDeribitCredentials_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "s.deribit/proto/deribit.proto",
+}
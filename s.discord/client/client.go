@@ -44,6 +44,10 @@ type DiscordClient interface {
 	AddHandler(handler func(s *discordgo.Session, m *discordgo.MessageCreate))
 	// AddHandlerGuildMemberAdd ...
 	AddHandlerGuildMemberAdd(handler func(s *discordgo.Session, u *discordgo.GuildMemberAdd))
+	// AddHandlerInteractionCreate adds a handler for slash commands, their autocompletion & message components.
+	AddHandlerInteractionCreate(handler func(s *discordgo.Session, i *discordgo.InteractionCreate))
+	// RegisterApplicationCommands replaces the application (slash) commands of the guild with those passed.
+	RegisterApplicationCommands(ctx context.Context, guildID string, commands []*discordgo.ApplicationCommand) error
 	// ReadRoles ...
 	ReadRoles(ctx context.Context, userID string) ([]*domain.Role, error)
 	// SetRoles set the users roles to the roles passed. It replaces all the roles the user currently has.
@@ -159,6 +159,25 @@ func (d *discordClient) AddHandlerGuildMemberAdd(handler func(s *discordgo.Sessi
 	d.session.AddHandler(handler)
 }
 
+func (d *discordClient) AddHandlerInteractionCreate(handler func(s *discordgo.Session, i *discordgo.InteractionCreate)) {
+	d.session.AddHandler(handler)
+}
+
+func (d *discordClient) RegisterApplicationCommands(ctx context.Context, guildID string, commands []*discordgo.ApplicationCommand) error {
+	app, err := d.session.Application("@me")
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_register_application_commands.read_application", nil)
+	}
+
+	if _, err := d.session.ApplicationCommandBulkOverwrite(app.ID, guildID, commands); err != nil {
+		return gerrors.Augment(err, "failed_to_register_application_commands", map[string]string{
+			"guild_id": guildID,
+		})
+	}
+
+	return nil
+}
+
 func (d *discordClient) Close() {
 	d.session.Close()
 }
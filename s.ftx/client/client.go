@@ -36,6 +36,9 @@ type FTXClient interface {
 	// ExecuteOrder ...
 	ExecuteOrder(ctx context.Context, req *ExecuteOrderRequest, credentials *auth.Credentials) (*ExecuteOrderResponse, error)
 
+	// CancelOrder ...
+	CancelOrder(ctx context.Context, req *CancelOrderRequest, credentials *auth.Credentials) (*CancelOrderResponse, error)
+
 	// ListInstruments ...
 	ListInstruments(ctx context.Context, req *ListInstrumentsRequest, futuresOnly bool) (*ListInstrumentsResponse, error)
 
@@ -47,6 +50,9 @@ type FTXClient interface {
 
 	// ListAccountBalances ...
 	ListAccountBalances(ctx context.Context, credentials *auth.Credentials) (*ListAccountBalancesResponse, error)
+
+	// ListAccountPositions ...
+	ListAccountPositions(ctx context.Context, credentials *auth.Credentials) (*ListAccountPositionsResponse, error)
 }
 
 // Init instantiates the FTX client singleton.
@@ -120,6 +126,13 @@ func ExecuteOrder(ctx context.Context, req *ExecuteOrderRequest, credentials *au
 	return client.ExecuteOrder(ctx, req, credentials)
 }
 
+// CancelOrder ...
+func CancelOrder(ctx context.Context, req *CancelOrderRequest, credentials *auth.Credentials) (*CancelOrderResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Cancel Order")
+	defer span.Finish()
+	return client.CancelOrder(ctx, req, credentials)
+}
+
 // ListInstruments ...
 func ListInstruments(ctx context.Context, req *ListInstrumentsRequest) (*ListInstrumentsResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "List instruments")
@@ -143,3 +156,11 @@ func ListAccountBalances(ctx context.Context, credentials *auth.Credentials) (*L
 
 	return client.ListAccountBalances(ctx, credentials)
 }
+
+// ListAccountPositions ...
+func ListAccountPositions(ctx context.Context, credentials *auth.Credentials) (*ListAccountPositionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "List account positions")
+	defer span.Finish()
+
+	return client.ListAccountPositions(ctx, credentials)
+}
@@ -56,6 +56,24 @@ func (f *ftxClient) ExecuteOrder(ctx context.Context, req *ExecuteOrderRequest,
 	return rsp, nil
 }
 
+func (f *ftxClient) CancelOrder(ctx context.Context, req *CancelOrderRequest, credentials *auth.Credentials) (*CancelOrderResponse, error) {
+	var endpoint = fmt.Sprintf("/api/orders/%s", req.OrderID)
+	if req.IsTriggerOrder {
+		endpoint = fmt.Sprintf("/api/conditional_orders/%s", req.OrderID)
+	}
+
+	rsp := &CancelOrderResponse{}
+	if err := f.signBeforeDo(ctx, http.MethodDelete, endpoint, nil, rsp, nil, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_order", map[string]string{
+			"order_id": req.OrderID,
+		})
+	}
+
+	slog.Info(ctx, "Request [%s] successful: Cancelled order: %s", endpoint, rsp.Result)
+
+	return rsp, nil
+}
+
 func (f *ftxClient) ListAccountDeposits(ctx context.Context, req *ListAccountDepositsRequest, pagination *PaginationFilter) (*ListAccountDepositsResponse, error) {
 	rsp := &ListAccountDepositsResponse{}
 	if err := f.signBeforeDo(ctx, http.MethodGet, "/api/wallet/deposits", req, rsp, pagination, depositAccountCredentials); err != nil {
@@ -123,3 +141,12 @@ func (f *ftxClient) ListAccountBalances(ctx context.Context, credentials *auth.C
 
 	return rsp, nil
 }
+
+func (f *ftxClient) ListAccountPositions(ctx context.Context, credentials *auth.Credentials) (*ListAccountPositionsResponse, error) {
+	rsp := &ListAccountPositionsResponse{}
+	if err := f.signBeforeDo(ctx, http.MethodGet, "/api/positions?showAvgPrice=true", nil, rsp, nil, credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_account_positions", nil)
+	}
+
+	return rsp, nil
+}
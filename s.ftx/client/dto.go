@@ -56,6 +56,18 @@ type ExecuteOrderResponse struct {
 	} `json:"result"`
 }
 
+// CancelOrderRequest ...
+type CancelOrderRequest struct {
+	OrderID        string
+	IsTriggerOrder bool
+}
+
+// CancelOrderResponse ...
+type CancelOrderResponse struct {
+	Success bool   `json:"success"`
+	Result  string `json:"result"`
+}
+
 // GetStatusRequest ...
 type GetStatusRequest struct{}
 
@@ -180,3 +192,21 @@ type ListAccountBalancesResponse struct {
 	Success         bool              `json:"success"`
 	AccountBalances []*AccountBalance `json:"result"`
 }
+
+// AccountPosition ...
+type AccountPosition struct {
+	Future                    string  `json:"future"`
+	NetSize                   float64 `json:"netSize"`
+	EntryPrice                float64 `json:"entryPrice"`
+	EstimatedLiquidationPrice float64 `json:"estimatedLiquidationPrice"`
+	UnrealizedPnL             float64 `json:"unrealizedPnl"`
+	RealizedPnL               float64 `json:"realizedPnl"`
+	Cost                      float64 `json:"cost"`
+	CollateralUsed            float64 `json:"collateralUsed"`
+}
+
+// ListAccountPositionsResponse ...
+type ListAccountPositionsResponse struct {
+	Success   bool               `json:"success"`
+	Positions []*AccountPosition `json:"result"`
+}
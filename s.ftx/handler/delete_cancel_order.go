@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.ftx/client"
+	"swallowtail/s.ftx/marshaling"
+	ftxproto "swallowtail/s.ftx/proto"
+)
+
+// CancelOrder cancels a resting order on FTX.
+func (s *FTXService) CancelOrder(
+	ctx context.Context, in *ftxproto.CancelOrderRequest,
+) (*ftxproto.CancelOrderResponse, error) {
+	switch {
+	case in.ExternalOrderId == "":
+		return nil, gerrors.BadParam("missing_param.external_order_id", nil)
+	case in.Credentials == nil:
+		return nil, gerrors.BadParam("missing_param.credentials", nil)
+	}
+
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Unauthenticated("unauthorized.invalid_credentials", map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	errParams := map[string]string{
+		"external_order_id": in.ExternalOrderId,
+	}
+
+	// Marshal credentials.
+	dtoCredentials := marshaling.VenueCredentialsProtoToFTXCredentials(in.Credentials)
+
+	if _, err := client.CancelOrder(ctx, &client.CancelOrderRequest{
+		OrderID:        in.ExternalOrderId,
+		IsTriggerOrder: in.IsTriggerOrder,
+	}, dtoCredentials); err != nil {
+		slog.Error(ctx, "Failed to cancel order: Error: %v, Order ID: %s", err, in.ExternalOrderId)
+		return nil, gerrors.Augment(err, "failed_to_cancel_order", errParams)
+	}
+
+	return &ftxproto.CancelOrderResponse{
+		ExternalOrderId: in.ExternalOrderId,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.ftx/exchangeinfo"
+	ftxproto "swallowtail/s.ftx/proto"
+)
+
+// GetFTXInstrumentFilters returns the price & size increments FTX applies to orders on the given market.
+func (s *FTXService) GetFTXInstrumentFilters(
+	ctx context.Context, in *ftxproto.GetFTXInstrumentFiltersRequest,
+) (*ftxproto.GetFTXInstrumentFiltersResponse, error) {
+	switch {
+	case in.Market == "":
+		return nil, gerrors.BadParam("missing_param.market", nil)
+	}
+
+	errParams := map[string]string{
+		"market": in.Market,
+	}
+
+	// Exchange info is keyed by market without the separator of spot markets.
+	instrument, ok := exchangeinfo.GetInstrumentBySymbol(strings.ReplaceAll(strings.ToUpper(in.Market), "/", ""))
+	if !ok {
+		return nil, gerrors.NotFound("failed_to_get_ftx_instrument_filters.market_not_found", errParams)
+	}
+
+	return &ftxproto.GetFTXInstrumentFiltersResponse{
+		TickSize:    float32(instrument.MininumTickSize),
+		LotSize:     float32(instrument.MininumQuantity),
+		MinQuantity: float32(instrument.MininumQuantity),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.ftx/client"
+	"swallowtail/s.ftx/marshaling"
+	ftxproto "swallowtail/s.ftx/proto"
+)
+
+// ListAccountPositions lists the open positions of the account.
+func (s *FTXService) ListAccountPositions(
+	ctx context.Context, in *ftxproto.ListAccountPositionsRequest,
+) (*ftxproto.ListAccountPositionsResponse, error) {
+	// Basic validation.
+	switch {
+	case in.Credentials == nil:
+		return nil, gerrors.BadParam("missing_param.credentials", nil)
+	}
+
+	// Validate credentials.
+	if err := validateCredentials(in.Credentials); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_account_positions", nil)
+	}
+
+	errParams := map[string]string{
+		"subaccount": in.GetCredentials().Subaccount,
+	}
+
+	// Marshal credentials to DTO.
+	domainCredentials := marshaling.VenueCredentialsProtoToFTXCredentials(in.GetCredentials())
+
+	// List account positions.
+	rsp, err := client.ListAccountPositions(ctx, domainCredentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_account_positions", errParams)
+	}
+
+	if !rsp.Success {
+		return nil, gerrors.FailedPrecondition("failed_to_list_account_positions.ftx_client_failure", errParams)
+	}
+
+	return &ftxproto.ListAccountPositionsResponse{
+		Positions: marshaling.AccountPositionsDTOToProtos(rsp.Positions),
+	}, nil
+}
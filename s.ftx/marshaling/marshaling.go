@@ -16,6 +16,11 @@ import (
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 )
 
+const (
+	// float32Precision is the relative precision of a float32.
+	float32Precision = 1e-7
+)
+
 // OrderProtoToDTO ...
 func OrderProtoToDTO(order *tradeengineproto.Order) (*client.ExecuteOrderRequest, error) {
 	errParams := map[string]string{
@@ -115,6 +120,21 @@ func OrderProtoToDTO(order *tradeengineproto.Order) (*client.ExecuteOrderRequest
 		if err != nil {
 			return nil, gerrors.Augment(err, "failed_to_marshal_new_order_request", nil)
 		}
+	case tradeengineproto.ORDER_TYPE_TRAILING_STOP_MARKET:
+		// FTX trails by an absolute price rather than a percentage; so the stop price is taken as the reference price the
+		// trailing percentage applies to. Sells trail below the price, so are negative.
+		if order.StopPrice <= 0 || order.TrailingPercentage <= 0 {
+			return nil, gerrors.BadParam("missing_param.trailing_stop_reference_price_or_percentage", errParams)
+		}
+
+		var err error
+		trailValue, err = roundToPrecision(float64(order.StopPrice*order.TrailingPercentage/100), exchangeInstrumentData.MininumTickSize)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_marshal_new_order_request", nil)
+		}
+		if side == "sell" {
+			trailValue = -trailValue
+		}
 	}
 
 	// Parse quantity.
@@ -259,6 +279,30 @@ func AccountBalancesDTOToProto(in *client.AccountBalance) *ftxproto.AccountBalan
 	}
 }
 
+// AccountPositionsDTOToProtos marshals the open positions of the account; FTX lists closed positions too, with a net
+// size of zero.
+func AccountPositionsDTOToProtos(in []*client.AccountPosition) []*ftxproto.AccountPosition {
+	var out = make([]*ftxproto.AccountPosition, 0, len(in))
+	for _, p := range in {
+		if p.NetSize == 0 {
+			continue
+		}
+
+		out = append(out, &ftxproto.AccountPosition{
+			Market:                    p.Future,
+			NetSize:                   float32(p.NetSize),
+			EntryPrice:                float32(p.EntryPrice),
+			EstimatedLiquidationPrice: float32(p.EstimatedLiquidationPrice),
+			UnrealisedPnl:             float32(p.UnrealizedPnL),
+			RealisedPnl:               float32(p.RealizedPnL),
+			Cost:                      float32(p.Cost),
+			CollateralUsed:            float32(p.CollateralUsed),
+		})
+	}
+
+	return out
+}
+
 func roundToPrecision(f float64, minIncrement float64) (float64, error) {
 	if f <= 0.0 {
 		return 0.0, nil
@@ -266,6 +310,12 @@ func roundToPrecision(f float64, minIncrement float64) (float64, error) {
 
 	v := f / minIncrement
 
+	// Values are passed as float32; so a value on an increment can land a fraction below it, i.e `0.7` is `0.69999998`.
+	// Anything within float32 precision of an increment is taken as on it, rather than flooring a whole increment away.
+	if r := math.Round(v); math.Abs(v-r) <= r*float32Precision {
+		v = r
+	}
+
 	var p float64
 	switch {
 	case v < 1.0:
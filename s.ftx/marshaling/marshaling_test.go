@@ -46,6 +46,12 @@ func TestRoundToPrecisionString(t *testing.T) {
 			minIncrement:  0.01,
 			expectedValue: 45623.67,
 		},
+		{
+			name:          "float32_on_increment",
+			input:         float64(float32(0.7)),
+			minIncrement:  0.1,
+			expectedValue: 0.7,
+		},
 		{
 			name:          "negative",
 			input:         -1.37,
@@ -996,6 +996,117 @@ func (x *ExecuteNewOrderResponse) GetOrder() *proto.Order {
 	return nil
 }
 
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials     *proto.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	ExternalOrderId string                  `protobuf:"bytes,2,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+	// Trigger orders (stops, take profits & trailing stops) are cancelled via a separate endpoint on FTX.
+	IsTriggerOrder bool `protobuf:"varint,3,opt,name=is_trigger_order,json=isTriggerOrder,proto3" json:"is_trigger_order,omitempty"`
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelOrderRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *CancelOrderRequest) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetIsTriggerOrder() bool {
+	if x != nil {
+		return x.IsTriggerOrder
+	}
+	return false
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalOrderId string `protobuf:"bytes,1,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelOrderResponse) GetExternalOrderId() string {
+	if x != nil {
+		return x.ExternalOrderId
+	}
+	return ""
+}
+
 type ListFTXInstrumentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1007,7 +1118,7 @@ type ListFTXInstrumentsRequest struct {
 func (x *ListFTXInstrumentsRequest) Reset() {
 	*x = ListFTXInstrumentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_ftx_proto_ftx_proto_msgTypes[12]
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1020,7 +1131,7 @@ func (x *ListFTXInstrumentsRequest) String() string {
 func (*ListFTXInstrumentsRequest) ProtoMessage() {}
 
 func (x *ListFTXInstrumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_ftx_proto_ftx_proto_msgTypes[12]
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1033,7 +1144,7 @@ func (x *ListFTXInstrumentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFTXInstrumentsRequest.ProtoReflect.Descriptor instead.
 func (*ListFTXInstrumentsRequest) Descriptor() ([]byte, []int) {
-	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{12}
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListFTXInstrumentsRequest) GetContractTypes() []FTX_CONTRACT_TYPE {
@@ -1054,7 +1165,7 @@ type ListFTXInstrumentsResponse struct {
 func (x *ListFTXInstrumentsResponse) Reset() {
 	*x = ListFTXInstrumentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_ftx_proto_ftx_proto_msgTypes[13]
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1067,7 +1178,7 @@ func (x *ListFTXInstrumentsResponse) String() string {
 func (*ListFTXInstrumentsResponse) ProtoMessage() {}
 
 func (x *ListFTXInstrumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_ftx_proto_ftx_proto_msgTypes[13]
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,7 +1191,7 @@ func (x *ListFTXInstrumentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFTXInstrumentsResponse.ProtoReflect.Descriptor instead.
 func (*ListFTXInstrumentsResponse) Descriptor() ([]byte, []int) {
-	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{13}
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListFTXInstrumentsResponse) GetInstruments() []*Instrument {
@@ -1109,7 +1220,7 @@ type Instrument struct {
 func (x *Instrument) Reset() {
 	*x = Instrument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_ftx_proto_ftx_proto_msgTypes[14]
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1122,7 +1233,7 @@ func (x *Instrument) String() string {
 func (*Instrument) ProtoMessage() {}
 
 func (x *Instrument) ProtoReflect() protoreflect.Message {
-	mi := &file_s_ftx_proto_ftx_proto_msgTypes[14]
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1246,7 @@ func (x *Instrument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Instrument.ProtoReflect.Descriptor instead.
 func (*Instrument) Descriptor() ([]byte, []int) {
-	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{14}
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Instrument) GetSymbol() string {
@@ -1214,7 +1325,7 @@ type ReadAccountInformationRequest struct {
 func (x *ReadAccountInformationRequest) Reset() {
 	*x = ReadAccountInformationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_ftx_proto_ftx_proto_msgTypes[15]
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1227,7 +1338,7 @@ func (x *ReadAccountInformationRequest) String() string {
 func (*ReadAccountInformationRequest) ProtoMessage() {}
 
 func (x *ReadAccountInformationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_ftx_proto_ftx_proto_msgTypes[15]
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,7 +1351,7 @@ func (x *ReadAccountInformationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAccountInformationRequest.ProtoReflect.Descriptor instead.
 func (*ReadAccountInformationRequest) Descriptor() ([]byte, []int) {
-	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{15}
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReadAccountInformationRequest) GetCredentials() *proto.VenueCredentials {
@@ -1288,7 +1399,7 @@ type ReadAccountInformationResponse struct {
 func (x *ReadAccountInformationResponse) Reset() {
 	*x = ReadAccountInformationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_ftx_proto_ftx_proto_msgTypes[16]
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1301,7 +1412,7 @@ func (x *ReadAccountInformationResponse) String() string {
 func (*ReadAccountInformationResponse) ProtoMessage() {}
 
 func (x *ReadAccountInformationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_ftx_proto_ftx_proto_msgTypes[16]
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,7 +1425,7 @@ func (x *ReadAccountInformationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAccountInformationResponse.ProtoReflect.Descriptor instead.
 func (*ReadAccountInformationResponse) Descriptor() ([]byte, []int) {
-	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{16}
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ReadAccountInformationResponse) GetBackstopProvider() bool {
@@ -1428,7 +1539,7 @@ type ListAccountBalancesRequest struct {
 func (x *ListAccountBalancesRequest) Reset() {
 	*x = ListAccountBalancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_ftx_proto_ftx_proto_msgTypes[17]
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1441,7 +1552,7 @@ func (x *ListAccountBalancesRequest) String() string {
 func (*ListAccountBalancesRequest) ProtoMessage() {}
 
 func (x *ListAccountBalancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_ftx_proto_ftx_proto_msgTypes[17]
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,7 +1565,7 @@ func (x *ListAccountBalancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccountBalancesRequest.ProtoReflect.Descriptor instead.
 func (*ListAccountBalancesRequest) Descriptor() ([]byte, []int) {
-	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{17}
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListAccountBalancesRequest) GetCredentials() *proto.VenueCredentials {
@@ -1494,7 +1605,7 @@ type AccountBalance struct {
 func (x *AccountBalance) Reset() {
 	*x = AccountBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_ftx_proto_ftx_proto_msgTypes[18]
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1507,7 +1618,7 @@ func (x *AccountBalance) String() string {
 func (*AccountBalance) ProtoMessage() {}
 
 func (x *AccountBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_s_ftx_proto_ftx_proto_msgTypes[18]
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,7 +1631,7 @@ func (x *AccountBalance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
 func (*AccountBalance) Descriptor() ([]byte, []int) {
-	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{18}
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AccountBalance) GetAsset() string {
@@ -1576,7 +1687,7 @@ type ListAccountBalancesResponse struct {
 func (x *ListAccountBalancesResponse) Reset() {
 	*x = ListAccountBalancesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_ftx_proto_ftx_proto_msgTypes[19]
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1589,7 +1700,7 @@ func (x *ListAccountBalancesResponse) String() string {
 func (*ListAccountBalancesResponse) ProtoMessage() {}
 
 func (x *ListAccountBalancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_ftx_proto_ftx_proto_msgTypes[19]
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,7 +1713,7 @@ func (x *ListAccountBalancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccountBalancesResponse.ProtoReflect.Descriptor instead.
 func (*ListAccountBalancesResponse) Descriptor() ([]byte, []int) {
-	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{19}
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListAccountBalancesResponse) GetAccountBalances() []*AccountBalance {
@@ -1612,6 +1723,333 @@ func (x *ListAccountBalancesResponse) GetAccountBalances() []*AccountBalance {
 	return nil
 }
 
+type GetFTXInstrumentFiltersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The FTX market i.e `BTC-PERP` or `BTC/USDT`.
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *GetFTXInstrumentFiltersRequest) Reset() {
+	*x = GetFTXInstrumentFiltersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFTXInstrumentFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFTXInstrumentFiltersRequest) ProtoMessage() {}
+
+func (x *GetFTXInstrumentFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFTXInstrumentFiltersRequest.ProtoReflect.Descriptor instead.
+func (*GetFTXInstrumentFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetFTXInstrumentFiltersRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+// The increments FTX accepts for the prices & sizes of orders on the market; the minimum size is a single increment.
+type GetFTXInstrumentFiltersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TickSize    float32 `protobuf:"fixed32,1,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	LotSize     float32 `protobuf:"fixed32,2,opt,name=lot_size,json=lotSize,proto3" json:"lot_size,omitempty"`
+	MinQuantity float32 `protobuf:"fixed32,3,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+}
+
+func (x *GetFTXInstrumentFiltersResponse) Reset() {
+	*x = GetFTXInstrumentFiltersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFTXInstrumentFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFTXInstrumentFiltersResponse) ProtoMessage() {}
+
+func (x *GetFTXInstrumentFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFTXInstrumentFiltersResponse.ProtoReflect.Descriptor instead.
+func (*GetFTXInstrumentFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetFTXInstrumentFiltersResponse) GetTickSize() float32 {
+	if x != nil {
+		return x.TickSize
+	}
+	return 0
+}
+
+func (x *GetFTXInstrumentFiltersResponse) GetLotSize() float32 {
+	if x != nil {
+		return x.LotSize
+	}
+	return 0
+}
+
+func (x *GetFTXInstrumentFiltersResponse) GetMinQuantity() float32 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+type ListAccountPositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials    *proto.VenueCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	ActorId        string                  `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	RequestContext string                  `protobuf:"bytes,3,opt,name=request_context,json=requestContext,proto3" json:"request_context,omitempty"`
+}
+
+func (x *ListAccountPositionsRequest) Reset() {
+	*x = ListAccountPositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAccountPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountPositionsRequest) ProtoMessage() {}
+
+func (x *ListAccountPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountPositionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAccountPositionsRequest) GetCredentials() *proto.VenueCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *ListAccountPositionsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAccountPositionsRequest) GetRequestContext() string {
+	if x != nil {
+		return x.RequestContext
+	}
+	return ""
+}
+
+type AccountPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The FTX market i.e `BTC-PERP`.
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Negative if short.
+	NetSize                   float32 `protobuf:"fixed32,2,opt,name=net_size,json=netSize,proto3" json:"net_size,omitempty"`
+	EntryPrice                float32 `protobuf:"fixed32,3,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	EstimatedLiquidationPrice float32 `protobuf:"fixed32,4,opt,name=estimated_liquidation_price,json=estimatedLiquidationPrice,proto3" json:"estimated_liquidation_price,omitempty"`
+	UnrealisedPnl             float32 `protobuf:"fixed32,5,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	RealisedPnl               float32 `protobuf:"fixed32,6,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	Cost                      float32 `protobuf:"fixed32,7,opt,name=cost,proto3" json:"cost,omitempty"`
+	CollateralUsed            float32 `protobuf:"fixed32,8,opt,name=collateral_used,json=collateralUsed,proto3" json:"collateral_used,omitempty"`
+}
+
+func (x *AccountPosition) Reset() {
+	*x = AccountPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountPosition) ProtoMessage() {}
+
+func (x *AccountPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountPosition.ProtoReflect.Descriptor instead.
+func (*AccountPosition) Descriptor() ([]byte, []int) {
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AccountPosition) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *AccountPosition) GetNetSize() float32 {
+	if x != nil {
+		return x.NetSize
+	}
+	return 0
+}
+
+func (x *AccountPosition) GetEntryPrice() float32 {
+	if x != nil {
+		return x.EntryPrice
+	}
+	return 0
+}
+
+func (x *AccountPosition) GetEstimatedLiquidationPrice() float32 {
+	if x != nil {
+		return x.EstimatedLiquidationPrice
+	}
+	return 0
+}
+
+func (x *AccountPosition) GetUnrealisedPnl() float32 {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return 0
+}
+
+func (x *AccountPosition) GetRealisedPnl() float32 {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return 0
+}
+
+func (x *AccountPosition) GetCost() float32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *AccountPosition) GetCollateralUsed() float32 {
+	if x != nil {
+		return x.CollateralUsed
+	}
+	return 0
+}
+
+type ListAccountPositionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Positions []*AccountPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+}
+
+func (x *ListAccountPositionsResponse) Reset() {
+	*x = ListAccountPositionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_ftx_proto_ftx_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAccountPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountPositionsResponse) ProtoMessage() {}
+
+func (x *ListAccountPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_ftx_proto_ftx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountPositionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_s_ftx_proto_ftx_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListAccountPositionsResponse) GetPositions() []*AccountPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
 var File_s_ftx_proto_ftx_proto protoreflect.FileDescriptor
 
 var file_s_ftx_proto_ftx_proto_rawDesc = []byte{
@@ -1731,173 +2169,248 @@ var file_s_ftx_proto_ftx_proto_rawDesc = []byte{
 	0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x22, 0x56, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x39, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f,
-	0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x1a, 0x4c, 0x69,
+	0x65, 0x72, 0x22, 0x9f, 0x01, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73,
+	0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x54, 0x58, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x46,
+	0x54, 0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22,
+	0x4b, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb2, 0x02, 0x0a,
+	0x0a, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x54, 0x69,
+	0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79,
+	0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72,
+	0x6c, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61,
+	0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0x98, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xd9, 0x04, 0x0a,
+	0x1e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x73,
+	0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x66,
+	0x72, 0x65, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x61, 0x6c, 0x4d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x1c,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x1c, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x46, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x46, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c,
+	0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56,
+	0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0xe3, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x6f, 0x74,
+	0x5f, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x73,
+	0x70, 0x6f, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x73, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x75, 0x73, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x0a, 0x18,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75,
+	0x74, 0x5f, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x22, 0x59, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x22, 0x38, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x7c, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x6f, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6c,
+	0x6f, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d, 0x69,
+	0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x96, 0x01, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x1b, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x19, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x50, 0x6e,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65,
+	0x64, 0x50, 0x6e, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x55, 0x73, 0x65,
+	0x64, 0x22, 0x4e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2a, 0x2f, 0x0a, 0x08, 0x46, 0x54, 0x58, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x12, 0x10, 0x0a,
+	0x0c, 0x46, 0x54, 0x58, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x10, 0x00, 0x12,
+	0x11, 0x0a, 0x0d, 0x46, 0x54, 0x58, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c,
+	0x10, 0x01, 0x2a, 0x9f, 0x01, 0x0a, 0x0e, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41,
+	0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x54,
+	0x58, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f,
+	0x50, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54,
+	0x4f, 0x50, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41, 0x44,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x49, 0x54, 0x10, 0x04, 0x2a, 0x8a, 0x01, 0x0a, 0x11, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x54,
+	0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x50, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x54, 0x55,
+	0x52, 0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x52, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x50, 0x45, 0x54,
+	0x55, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x10,
+	0x03, 0x32, 0xa6, 0x06, 0x0a, 0x03, 0x66, 0x74, 0x78, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x46, 0x54, 0x58, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x54, 0x58, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46,
+	0x54, 0x58, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x54, 0x58, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x17, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x54, 0x58,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x4c, 0x69,
 	0x73, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x0a, 0x49, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
-	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x54, 0x69, 0x63, 0x6b, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x71, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x6d, 0x69, 0x6e,
-	0x69, 0x6d, 0x75, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67,
-	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x71,
-	0x75, 0x6f, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x98, 0x01, 0x0a,
-	0x1d, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
-	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x61, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x27,
-	0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xd9, 0x04, 0x0a, 0x1e, 0x52, 0x65, 0x61, 0x64,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x61,
-	0x63, 0x6b, 0x73, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x74, 0x6f, 0x70, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
-	0x65, 0x72, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x63,
-	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0e, 0x66, 0x72, 0x65, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12,
-	0x38, 0x0a, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x76,
-	0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x65, 0x76,
-	0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x1c, 0x6d, 0x61, 0x69, 0x6e, 0x74,
-	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1c, 0x6d,
-	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x6d,
-	0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x67, 0x69,
-	0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0e, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2e, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x46, 0x72, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x6f, 0x70, 0x65,
-	0x6e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x0e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x11, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x6f, 0x74, 0x5f, 0x62, 0x6f, 0x72, 0x72,
-	0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x73, 0x70, 0x6f, 0x74, 0x42, 0x6f,
-	0x72, 0x72, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73,
-	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x75,
-	0x73, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6f, 0x72,
-	0x72, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x61, 0x76, 0x61, 0x69, 0x6c,
-	0x61, 0x62, 0x6c, 0x65, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f,
-	0x77, 0x22, 0x59, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2a, 0x2f, 0x0a, 0x08,
-	0x46, 0x54, 0x58, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x54, 0x58, 0x5f,
-	0x53, 0x49, 0x44, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x54,
-	0x58, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x01, 0x2a, 0x9f, 0x01,
-	0x0a, 0x0e, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x12, 0x18, 0x0a, 0x14, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x54,
-	0x58, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52,
-	0x4b, 0x45, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41,
-	0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x02, 0x12, 0x1f,
-	0x0a, 0x1b, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x54, 0x52, 0x41, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x03, 0x12,
-	0x1e, 0x0a, 0x1a, 0x46, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x54, 0x10, 0x04, 0x2a,
-	0x8a, 0x01, 0x0a, 0x11, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f, 0x4e,
-	0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x4f, 0x54, 0x10,
-	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12,
-	0x1f, 0x0a, 0x1b, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x50, 0x45, 0x54, 0x55, 0x41, 0x4c, 0x10, 0x02,
-	0x12, 0x1a, 0x0a, 0x16, 0x46, 0x54, 0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x03, 0x32, 0xb3, 0x04, 0x0a,
-	0x03, 0x66, 0x74, 0x78, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74,
-	0x46, 0x54, 0x58, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x46, 0x75, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x46,
-	0x54, 0x58, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x46, 0x75,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x4e, 0x65,
-	0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x54, 0x58,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5b, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x22, 0x5a, 0x20, 0x73, 0x77, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x74, 0x61, 0x69,
-	0x6c, 0x2f, 0x73, 0x2e, 0x66, 0x74, 0x78, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x66, 0x74,
-	0x78, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x54,
+	0x58, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x54, 0x58, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x22, 0x5a, 0x20, 0x73, 0x77,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x73, 0x2e, 0x66, 0x74, 0x78, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x66, 0x74, 0x78, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1913,71 +2426,87 @@ func file_s_ftx_proto_ftx_proto_rawDescGZIP() []byte {
 }
 
 var file_s_ftx_proto_ftx_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_s_ftx_proto_ftx_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_s_ftx_proto_ftx_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_s_ftx_proto_ftx_proto_goTypes = []interface{}{
-	(FTX_SIDE)(0),                          // 0: FTX_SIDE
-	(FTX_TRADE_TYPE)(0),                    // 1: FTX_TRADE_TYPE
-	(FTX_CONTRACT_TYPE)(0),                 // 2: FTX_CONTRACT_TYPE
-	(*ListAccountDepositsRequest)(nil),     // 3: ListAccountDepositsRequest
-	(*FTXCredentials)(nil),                 // 4: FTXCredentials
-	(*ListAccountDepositsResponse)(nil),    // 5: ListAccountDepositsResponse
-	(*DepositRecord)(nil),                  // 6: DepositRecord
-	(*GetFTXStatusRequest)(nil),            // 7: GetFTXStatusRequest
-	(*GetFTXStatusResponse)(nil),           // 8: GetFTXStatusResponse
-	(*GetFTXFundingRatesRequest)(nil),      // 9: GetFTXFundingRatesRequest
-	(*FTXFundingRatesInfo)(nil),            // 10: FTXFundingRatesInfo
-	(*GetFTXFundingRatesResponse)(nil),     // 11: GetFTXFundingRatesResponse
-	(*FTXOrder)(nil),                       // 12: FTXOrder
-	(*ExecuteNewOrderRequest)(nil),         // 13: ExecuteNewOrderRequest
-	(*ExecuteNewOrderResponse)(nil),        // 14: ExecuteNewOrderResponse
-	(*ListFTXInstrumentsRequest)(nil),      // 15: ListFTXInstrumentsRequest
-	(*ListFTXInstrumentsResponse)(nil),     // 16: ListFTXInstrumentsResponse
-	(*Instrument)(nil),                     // 17: Instrument
-	(*ReadAccountInformationRequest)(nil),  // 18: ReadAccountInformationRequest
-	(*ReadAccountInformationResponse)(nil), // 19: ReadAccountInformationResponse
-	(*ListAccountBalancesRequest)(nil),     // 20: ListAccountBalancesRequest
-	(*AccountBalance)(nil),                 // 21: AccountBalance
-	(*ListAccountBalancesResponse)(nil),    // 22: ListAccountBalancesResponse
-	(*timestamppb.Timestamp)(nil),          // 23: google.protobuf.Timestamp
-	(*proto.Order)(nil),                    // 24: Order
-	(*proto.VenueCredentials)(nil),         // 25: VenueCredentials
+	(FTX_SIDE)(0),                           // 0: FTX_SIDE
+	(FTX_TRADE_TYPE)(0),                     // 1: FTX_TRADE_TYPE
+	(FTX_CONTRACT_TYPE)(0),                  // 2: FTX_CONTRACT_TYPE
+	(*ListAccountDepositsRequest)(nil),      // 3: ListAccountDepositsRequest
+	(*FTXCredentials)(nil),                  // 4: FTXCredentials
+	(*ListAccountDepositsResponse)(nil),     // 5: ListAccountDepositsResponse
+	(*DepositRecord)(nil),                   // 6: DepositRecord
+	(*GetFTXStatusRequest)(nil),             // 7: GetFTXStatusRequest
+	(*GetFTXStatusResponse)(nil),            // 8: GetFTXStatusResponse
+	(*GetFTXFundingRatesRequest)(nil),       // 9: GetFTXFundingRatesRequest
+	(*FTXFundingRatesInfo)(nil),             // 10: FTXFundingRatesInfo
+	(*GetFTXFundingRatesResponse)(nil),      // 11: GetFTXFundingRatesResponse
+	(*FTXOrder)(nil),                        // 12: FTXOrder
+	(*ExecuteNewOrderRequest)(nil),          // 13: ExecuteNewOrderRequest
+	(*ExecuteNewOrderResponse)(nil),         // 14: ExecuteNewOrderResponse
+	(*CancelOrderRequest)(nil),              // 15: CancelOrderRequest
+	(*CancelOrderResponse)(nil),             // 16: CancelOrderResponse
+	(*ListFTXInstrumentsRequest)(nil),       // 17: ListFTXInstrumentsRequest
+	(*ListFTXInstrumentsResponse)(nil),      // 18: ListFTXInstrumentsResponse
+	(*Instrument)(nil),                      // 19: Instrument
+	(*ReadAccountInformationRequest)(nil),   // 20: ReadAccountInformationRequest
+	(*ReadAccountInformationResponse)(nil),  // 21: ReadAccountInformationResponse
+	(*ListAccountBalancesRequest)(nil),      // 22: ListAccountBalancesRequest
+	(*AccountBalance)(nil),                  // 23: AccountBalance
+	(*ListAccountBalancesResponse)(nil),     // 24: ListAccountBalancesResponse
+	(*GetFTXInstrumentFiltersRequest)(nil),  // 25: GetFTXInstrumentFiltersRequest
+	(*GetFTXInstrumentFiltersResponse)(nil), // 26: GetFTXInstrumentFiltersResponse
+	(*ListAccountPositionsRequest)(nil),     // 27: ListAccountPositionsRequest
+	(*AccountPosition)(nil),                 // 28: AccountPosition
+	(*ListAccountPositionsResponse)(nil),    // 29: ListAccountPositionsResponse
+	(*timestamppb.Timestamp)(nil),           // 30: google.protobuf.Timestamp
+	(*proto.Order)(nil),                     // 31: Order
+	(*proto.VenueCredentials)(nil),          // 32: VenueCredentials
 }
 var file_s_ftx_proto_ftx_proto_depIdxs = []int32{
 	6,  // 0: ListAccountDepositsResponse.deposits:type_name -> DepositRecord
-	23, // 1: DepositRecord.confirmed_time:type_name -> google.protobuf.Timestamp
-	23, // 2: DepositRecord.sent_time:type_name -> google.protobuf.Timestamp
-	23, // 3: DepositRecord.time:type_name -> google.protobuf.Timestamp
+	30, // 1: DepositRecord.confirmed_time:type_name -> google.protobuf.Timestamp
+	30, // 2: DepositRecord.sent_time:type_name -> google.protobuf.Timestamp
+	30, // 3: DepositRecord.time:type_name -> google.protobuf.Timestamp
 	10, // 4: GetFTXFundingRatesResponse.funding_rates:type_name -> FTXFundingRatesInfo
 	0,  // 5: FTXOrder.side:type_name -> FTX_SIDE
 	1,  // 6: FTXOrder.type:type_name -> FTX_TRADE_TYPE
-	24, // 7: ExecuteNewOrderRequest.order:type_name -> Order
-	23, // 8: ExecuteNewOrderRequest.timestamp:type_name -> google.protobuf.Timestamp
-	25, // 9: ExecuteNewOrderRequest.credentials:type_name -> VenueCredentials
-	24, // 10: ExecuteNewOrderResponse.order:type_name -> Order
-	2,  // 11: ListFTXInstrumentsRequest.contract_types:type_name -> FTX_CONTRACT_TYPE
-	17, // 12: ListFTXInstrumentsResponse.instruments:type_name -> Instrument
-	25, // 13: ReadAccountInformationRequest.credentials:type_name -> VenueCredentials
-	25, // 14: ListAccountBalancesRequest.credentials:type_name -> VenueCredentials
-	21, // 15: ListAccountBalancesResponse.account_balances:type_name -> AccountBalance
-	7,  // 16: ftx.GetFTXStatus:input_type -> GetFTXStatusRequest
-	9,  // 17: ftx.GetFTXFundingRates:input_type -> GetFTXFundingRatesRequest
-	3,  // 18: ftx.ListAccountDeposits:input_type -> ListAccountDepositsRequest
-	13, // 19: ftx.ExecuteNewOrder:input_type -> ExecuteNewOrderRequest
-	15, // 20: ftx.ListFTXInstruments:input_type -> ListFTXInstrumentsRequest
-	18, // 21: ftx.ReadAccountInformation:input_type -> ReadAccountInformationRequest
-	20, // 22: ftx.ListAccountBalances:input_type -> ListAccountBalancesRequest
-	8,  // 23: ftx.GetFTXStatus:output_type -> GetFTXStatusResponse
-	11, // 24: ftx.GetFTXFundingRates:output_type -> GetFTXFundingRatesResponse
-	5,  // 25: ftx.ListAccountDeposits:output_type -> ListAccountDepositsResponse
-	14, // 26: ftx.ExecuteNewOrder:output_type -> ExecuteNewOrderResponse
-	16, // 27: ftx.ListFTXInstruments:output_type -> ListFTXInstrumentsResponse
-	19, // 28: ftx.ReadAccountInformation:output_type -> ReadAccountInformationResponse
-	22, // 29: ftx.ListAccountBalances:output_type -> ListAccountBalancesResponse
-	23, // [23:30] is the sub-list for method output_type
-	16, // [16:23] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	31, // 7: ExecuteNewOrderRequest.order:type_name -> Order
+	30, // 8: ExecuteNewOrderRequest.timestamp:type_name -> google.protobuf.Timestamp
+	32, // 9: ExecuteNewOrderRequest.credentials:type_name -> VenueCredentials
+	31, // 10: ExecuteNewOrderResponse.order:type_name -> Order
+	32, // 11: CancelOrderRequest.credentials:type_name -> VenueCredentials
+	2,  // 12: ListFTXInstrumentsRequest.contract_types:type_name -> FTX_CONTRACT_TYPE
+	19, // 13: ListFTXInstrumentsResponse.instruments:type_name -> Instrument
+	32, // 14: ReadAccountInformationRequest.credentials:type_name -> VenueCredentials
+	32, // 15: ListAccountBalancesRequest.credentials:type_name -> VenueCredentials
+	23, // 16: ListAccountBalancesResponse.account_balances:type_name -> AccountBalance
+	32, // 17: ListAccountPositionsRequest.credentials:type_name -> VenueCredentials
+	28, // 18: ListAccountPositionsResponse.positions:type_name -> AccountPosition
+	7,  // 19: ftx.GetFTXStatus:input_type -> GetFTXStatusRequest
+	9,  // 20: ftx.GetFTXFundingRates:input_type -> GetFTXFundingRatesRequest
+	3,  // 21: ftx.ListAccountDeposits:input_type -> ListAccountDepositsRequest
+	13, // 22: ftx.ExecuteNewOrder:input_type -> ExecuteNewOrderRequest
+	17, // 23: ftx.ListFTXInstruments:input_type -> ListFTXInstrumentsRequest
+	20, // 24: ftx.ReadAccountInformation:input_type -> ReadAccountInformationRequest
+	22, // 25: ftx.ListAccountBalances:input_type -> ListAccountBalancesRequest
+	15, // 26: ftx.CancelOrder:input_type -> CancelOrderRequest
+	25, // 27: ftx.GetFTXInstrumentFilters:input_type -> GetFTXInstrumentFiltersRequest
+	27, // 28: ftx.ListAccountPositions:input_type -> ListAccountPositionsRequest
+	8,  // 29: ftx.GetFTXStatus:output_type -> GetFTXStatusResponse
+	11, // 30: ftx.GetFTXFundingRates:output_type -> GetFTXFundingRatesResponse
+	5,  // 31: ftx.ListAccountDeposits:output_type -> ListAccountDepositsResponse
+	14, // 32: ftx.ExecuteNewOrder:output_type -> ExecuteNewOrderResponse
+	18, // 33: ftx.ListFTXInstruments:output_type -> ListFTXInstrumentsResponse
+	21, // 34: ftx.ReadAccountInformation:output_type -> ReadAccountInformationResponse
+	24, // 35: ftx.ListAccountBalances:output_type -> ListAccountBalancesResponse
+	16, // 36: ftx.CancelOrder:output_type -> CancelOrderResponse
+	26, // 37: ftx.GetFTXInstrumentFilters:output_type -> GetFTXInstrumentFiltersResponse
+	29, // 38: ftx.ListAccountPositions:output_type -> ListAccountPositionsResponse
+	29, // [29:39] is the sub-list for method output_type
+	19, // [19:29] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_s_ftx_proto_ftx_proto_init() }
@@ -2131,7 +2660,7 @@ func file_s_ftx_proto_ftx_proto_init() {
 			}
 		}
 		file_s_ftx_proto_ftx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFTXInstrumentsRequest); i {
+			switch v := v.(*CancelOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2143,7 +2672,7 @@ func file_s_ftx_proto_ftx_proto_init() {
 			}
 		}
 		file_s_ftx_proto_ftx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFTXInstrumentsResponse); i {
+			switch v := v.(*CancelOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2155,7 +2684,7 @@ func file_s_ftx_proto_ftx_proto_init() {
 			}
 		}
 		file_s_ftx_proto_ftx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Instrument); i {
+			switch v := v.(*ListFTXInstrumentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2167,7 +2696,7 @@ func file_s_ftx_proto_ftx_proto_init() {
 			}
 		}
 		file_s_ftx_proto_ftx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAccountInformationRequest); i {
+			switch v := v.(*ListFTXInstrumentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2179,7 +2708,7 @@ func file_s_ftx_proto_ftx_proto_init() {
 			}
 		}
 		file_s_ftx_proto_ftx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadAccountInformationResponse); i {
+			switch v := v.(*Instrument); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2191,7 +2720,7 @@ func file_s_ftx_proto_ftx_proto_init() {
 			}
 		}
 		file_s_ftx_proto_ftx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountBalancesRequest); i {
+			switch v := v.(*ReadAccountInformationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2203,7 +2732,7 @@ func file_s_ftx_proto_ftx_proto_init() {
 			}
 		}
 		file_s_ftx_proto_ftx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountBalance); i {
+			switch v := v.(*ReadAccountInformationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2215,6 +2744,30 @@ func file_s_ftx_proto_ftx_proto_init() {
 			}
 		}
 		file_s_ftx_proto_ftx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_ftx_proto_ftx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_ftx_proto_ftx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListAccountBalancesResponse); i {
 			case 0:
 				return &v.state
@@ -2226,6 +2779,66 @@ func file_s_ftx_proto_ftx_proto_init() {
 				return nil
 			}
 		}
+		file_s_ftx_proto_ftx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFTXInstrumentFiltersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_ftx_proto_ftx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFTXInstrumentFiltersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_ftx_proto_ftx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountPositionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_ftx_proto_ftx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_ftx_proto_ftx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountPositionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2233,7 +2846,7 @@ func file_s_ftx_proto_ftx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_s_ftx_proto_ftx_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   20,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -518,3 +518,222 @@ func (r *ListAccountBalancesRequest) SendWithTimeout(ctx context.Context, timeou
 		resultc: resultc,
 	}
 }
+
+// --- Cancel Order --- //
+
+type CancelOrderFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *CancelOrderResponse
+	ctx     context.Context
+}
+
+func (a *CancelOrderFuture) Response() (*CancelOrderResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "cancel_order", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *CancelOrderRequest) Send(ctx context.Context) *CancelOrderFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *CancelOrderRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *CancelOrderFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *CancelOrderResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-ftx:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s-ftx_connection_failed", nil)
+		return &CancelOrderFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewFtxClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.CancelOrder(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_cancel_order", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &CancelOrderFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Get FTX Instrument Filters --- //
+
+type GetFTXInstrumentFiltersFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *GetFTXInstrumentFiltersResponse
+	ctx     context.Context
+}
+
+func (a *GetFTXInstrumentFiltersFuture) Response() (*GetFTXInstrumentFiltersResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "get_ftx_instrument_filters", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *GetFTXInstrumentFiltersRequest) Send(ctx context.Context) *GetFTXInstrumentFiltersFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *GetFTXInstrumentFiltersRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *GetFTXInstrumentFiltersFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *GetFTXInstrumentFiltersResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-ftx:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_ftx_connection_failed", nil)
+		return &GetFTXInstrumentFiltersFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewFtxClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.GetFTXInstrumentFilters(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_get_ftx_instrument_filters", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &GetFTXInstrumentFiltersFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- List Account Positions --- //
+
+type ListAccountPositionsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListAccountPositionsResponse
+	ctx     context.Context
+}
+
+func (a *ListAccountPositionsFuture) Response() (*ListAccountPositionsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_account_positions", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListAccountPositionsRequest) Send(ctx context.Context) *ListAccountPositionsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListAccountPositionsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListAccountPositionsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListAccountPositionsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-ftx:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_ftx_connection_failed", nil)
+		return &ListAccountPositionsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewFtxClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListAccountPositions(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_account_positions", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListAccountPositionsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
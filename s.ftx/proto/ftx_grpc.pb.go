@@ -25,6 +25,9 @@ type FtxClient interface {
 	ListFTXInstruments(ctx context.Context, in *ListFTXInstrumentsRequest, opts ...grpc.CallOption) (*ListFTXInstrumentsResponse, error)
 	ReadAccountInformation(ctx context.Context, in *ReadAccountInformationRequest, opts ...grpc.CallOption) (*ReadAccountInformationResponse, error)
 	ListAccountBalances(ctx context.Context, in *ListAccountBalancesRequest, opts ...grpc.CallOption) (*ListAccountBalancesResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	GetFTXInstrumentFilters(ctx context.Context, in *GetFTXInstrumentFiltersRequest, opts ...grpc.CallOption) (*GetFTXInstrumentFiltersResponse, error)
+	ListAccountPositions(ctx context.Context, in *ListAccountPositionsRequest, opts ...grpc.CallOption) (*ListAccountPositionsResponse, error)
 }
 
 type ftxClient struct {
@@ -98,6 +101,33 @@ func (c *ftxClient) ListAccountBalances(ctx context.Context, in *ListAccountBala
 	return out, nil
 }
 
+func (c *ftxClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/ftx/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ftxClient) GetFTXInstrumentFilters(ctx context.Context, in *GetFTXInstrumentFiltersRequest, opts ...grpc.CallOption) (*GetFTXInstrumentFiltersResponse, error) {
+	out := new(GetFTXInstrumentFiltersResponse)
+	err := c.cc.Invoke(ctx, "/ftx/GetFTXInstrumentFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ftxClient) ListAccountPositions(ctx context.Context, in *ListAccountPositionsRequest, opts ...grpc.CallOption) (*ListAccountPositionsResponse, error) {
+	out := new(ListAccountPositionsResponse)
+	err := c.cc.Invoke(ctx, "/ftx/ListAccountPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FtxServer is the server API for Ftx service.
 // All implementations must embed UnimplementedFtxServer
 // for forward compatibility
@@ -109,6 +139,9 @@ type FtxServer interface {
 	ListFTXInstruments(context.Context, *ListFTXInstrumentsRequest) (*ListFTXInstrumentsResponse, error)
 	ReadAccountInformation(context.Context, *ReadAccountInformationRequest) (*ReadAccountInformationResponse, error)
 	ListAccountBalances(context.Context, *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	GetFTXInstrumentFilters(context.Context, *GetFTXInstrumentFiltersRequest) (*GetFTXInstrumentFiltersResponse, error)
+	ListAccountPositions(context.Context, *ListAccountPositionsRequest) (*ListAccountPositionsResponse, error)
 	mustEmbedUnimplementedFtxServer()
 }
 
@@ -137,6 +170,15 @@ func (UnimplementedFtxServer) ReadAccountInformation(context.Context, *ReadAccou
 func (UnimplementedFtxServer) ListAccountBalances(context.Context, *ListAccountBalancesRequest) (*ListAccountBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccountBalances not implemented")
 }
+func (UnimplementedFtxServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedFtxServer) GetFTXInstrumentFilters(context.Context, *GetFTXInstrumentFiltersRequest) (*GetFTXInstrumentFiltersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFTXInstrumentFilters not implemented")
+}
+func (UnimplementedFtxServer) ListAccountPositions(context.Context, *ListAccountPositionsRequest) (*ListAccountPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountPositions not implemented")
+}
 func (UnimplementedFtxServer) mustEmbedUnimplementedFtxServer() {}
 
 // UnsafeFtxServer may be embedded to opt out of forward compatibility for this service.
@@ -276,6 +318,60 @@ func _Ftx_ListAccountBalances_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Ftx_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FtxServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ftx/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FtxServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ftx_GetFTXInstrumentFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFTXInstrumentFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FtxServer).GetFTXInstrumentFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ftx/GetFTXInstrumentFilters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FtxServer).GetFTXInstrumentFilters(ctx, req.(*GetFTXInstrumentFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ftx_ListAccountPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FtxServer).ListAccountPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ftx/ListAccountPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FtxServer).ListAccountPositions(ctx, req.(*ListAccountPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Ftx_ServiceDesc is the grpc.ServiceDesc for Ftx service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -311,6 +407,18 @@ var Ftx_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAccountBalances",
 			Handler:    _Ftx_ListAccountBalances_Handler,
 		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Ftx_CancelOrder_Handler,
+		},
+		{
+			MethodName: "GetFTXInstrumentFilters",
+			Handler:    _Ftx_GetFTXInstrumentFilters_Handler,
+		},
+		{
+			MethodName: "ListAccountPositions",
+			Handler:    _Ftx_ListAccountPositions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "s.ftx/proto/ftx.proto",
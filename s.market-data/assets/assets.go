@@ -1,5 +1,10 @@
 package assets
 
+import (
+	"fmt"
+	"strings"
+)
+
 type AssetVolatiltyRating int
 
 const (
@@ -9,16 +14,47 @@ const (
 	AssetVolatiltyRatingExtreme
 )
 
+// String ...
+func (a AssetVolatiltyRating) String() string {
+	switch a {
+	case AssetVolatiltyRatingExtreme:
+		return "extreme"
+	case AssetVolatiltyRatingHigh:
+		return "high"
+	case AssetVolatiltyRatingMedium:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// ParseVolatilityRating parses the volatility rating from its string; returning false if it isn't a rating.
+func ParseVolatilityRating(s string) (AssetVolatiltyRating, bool) {
+	switch strings.ToLower(s) {
+	case "extreme":
+		return AssetVolatiltyRatingExtreme, true
+	case "high":
+		return AssetVolatiltyRatingHigh, true
+	case "medium":
+		return AssetVolatiltyRatingMedium, true
+	case "low":
+		return AssetVolatiltyRatingLow, true
+	default:
+		return AssetVolatiltyRatingLow, false
+	}
+}
+
+// PercentageTriggerValue is the move, as a fraction of the price, that's considered volatile for assets of the rating.
 func (a AssetVolatiltyRating) PercentageTriggerValue() float64 {
 	switch a {
+	case AssetVolatiltyRatingExtreme:
+		return 0.1
 	case AssetVolatiltyRatingHigh:
 		return 0.05
 	case AssetVolatiltyRatingMedium:
 		return 0.025
-	case AssetVolatiltyRatingLow:
-		return 0.001
 	default:
-		return 0.001
+		return 0.015
 	}
 }
 
@@ -27,263 +63,16 @@ type AssetPair struct {
 	Symbol           string
 	AssetPair        string
 	VolatilityRating AssetVolatiltyRating
-	Grouping         AssetGroup
+	// Grouping is the group the asset is published under i.e `solana`.
+	Grouping string
 }
 
-var (
-	// latestpriceassets are all the assets that we'd like to watch in order to publish
-	// information to at a later point in time.
-	LatestPriceAssets = []*AssetPair{
-		{
-			Symbol:           "btc",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingLow,
-			Grouping:         AssetGroupBitcoin,
-		},
-		{
-			Symbol:           "eth",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupEthereum,
-		},
-		{
-			Symbol:           "eth",
-			AssetPair:        "btc",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupEthereum,
-		},
-		{
-			Symbol:           "sol",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "avax",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupLayerOne,
-		},
-		{
-			Symbol:           "algo",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupLayerOne,
-		},
-		{
-			Symbol:           "cope",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "link",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupOracles,
-		},
-		{
-			Symbol:           "srm",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "ray",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "step",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "dot",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupLayerOne,
-		},
-		{
-			Symbol:           "aave",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupDeFi,
-		},
-		{
-			Symbol:           "atom",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupLayerOne,
-		},
-		{
-			Symbol:           "bnb",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupBSC,
-		},
-		{
-			Symbol:           "cake",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupBSC,
-		},
-		{
-			Symbol:           "ftm",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupLayerOne,
-		},
-		{
-			Symbol:           "rune",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupDeFi,
-		},
-		{
-			Symbol:           "sushi",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupDeFi,
-		},
-		{
-			Symbol:           "uni",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupDeFi,
-		},
-		{
-			Symbol:           "woo",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupDeFi,
-		},
-		{
-			Symbol:           "spell",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupDeFi2,
-		},
-		{
-			Symbol:           "dydx",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupDeFi2,
-		},
-		{
-			Symbol:           "luna",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupLuna,
-		},
-		{
-			Symbol:           "liq",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingExtreme,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "fab",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingExtreme,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "bop",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "sol",
-			AssetPair:        "btc",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "sol",
-			AssetPair:        "eth",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupSolanaEcosystem,
-		},
-		{
-			Symbol:           "axs",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupMetaverse,
-		},
-		{
-			Symbol:           "doge",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingExtreme,
-			Grouping:         AssetGroupDogcoin,
-		},
-		{
-			Symbol:           "samo",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingExtreme,
-			Grouping:         AssetGroupDogcoin,
-		},
-		{
-			Symbol:           "floki",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingExtreme,
-			Grouping:         AssetGroupDogcoin,
-		},
-		{
-			Symbol:           "scrt",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupPrivacy,
-		},
-		{
-			Symbol:           "one",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupLayerOne,
-		},
-		{
-			Symbol:           "frkt",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingExtreme,
-		},
-		{
-			Symbol:           "htr",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupLayerOne,
-		},
-		{
-			Symbol:           "crv",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupDeFi,
-		},
-		{
-			Symbol:           "comp",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingMedium,
-			Grouping:         AssetGroupDeFi,
-		},
-		{
-			Symbol:           "cheems",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingExtreme,
-			Grouping:         AssetGroupDogcoin,
-		},
-		{
-			Symbol:           "mana",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupMetaverse,
-		},
-		{
-			Symbol:           "sand",
-			AssetPair:        "usd",
-			VolatilityRating: AssetVolatiltyRatingHigh,
-			Grouping:         AssetGroupMetaverse,
-		},
+// BinanceSymbol returns the Binance spot symbol of the asset; USD pairs are traded against USDT.
+func (a *AssetPair) BinanceSymbol() string {
+	pair := strings.ToUpper(a.AssetPair)
+	if pair == "USD" {
+		pair = "USDT"
 	}
-)
+
+	return fmt.Sprintf("%s%s", strings.ToUpper(a.Symbol), pair)
+}
@@ -12,108 +12,35 @@ type FundingRateAsset struct {
 	Symbol          string
 	Venue           tradeengineproto.VENUE
 	HumanizedSymbol string
+	// Asset is the underlying asset i.e `BTC`; so funding rates can be compared across venues.
+	Asset string
 }
 
 // FundingRateVenueInfo ...
 type FundingRateVenueInfo struct {
 	HigherBound float64
 	LowerBound  float64
+	// FundingIntervalHours is how often the venue's funding rate is paid.
+	FundingIntervalHours int
 }
 
 var (
 	coeffMu              sync.RWMutex
 	fundingRateVenueData = map[tradeengineproto.VENUE]*FundingRateVenueInfo{
 		tradeengineproto.VENUE_BINANCE: {
-			HigherBound: 0.4,
-			LowerBound:  0.025,
+			HigherBound:          0.4,
+			LowerBound:           0.025,
+			FundingIntervalHours: 8,
 		},
 		tradeengineproto.VENUE_FTX: {
-			HigherBound: 0.01,
-			LowerBound:  0.0,
+			HigherBound:          0.01,
+			LowerBound:           0.0,
+			FundingIntervalHours: 1,
 		},
 		tradeengineproto.VENUE_BITFINEX: {
-			HigherBound: 0.025,
-			LowerBound:  -0.005,
-		},
-	}
-)
-
-var (
-	// FundingRateAssets ...
-	FundingRateAssets = []*FundingRateAsset{
-		{
-			Symbol: "AVAX-PERP",
-			Venue:  tradeengineproto.VENUE_FTX,
-		},
-		{
-			Symbol: "AVAXUSDT",
-			Venue:  tradeengineproto.VENUE_BINANCE,
-		},
-		{
-			Symbol: "BTC-PERP",
-			Venue:  tradeengineproto.VENUE_FTX,
-		},
-		{
-			Symbol: "BTCUSDT",
-			Venue:  tradeengineproto.VENUE_BINANCE,
-		},
-		{
-			Symbol: "ETH-PERP",
-			Venue:  tradeengineproto.VENUE_FTX,
-		},
-		{
-			Symbol: "ETHUSDT",
-			Venue:  tradeengineproto.VENUE_BINANCE,
-		},
-		{
-			Symbol: "LUNA-PERP",
-			Venue:  tradeengineproto.VENUE_FTX,
-		},
-		{
-			Symbol: "LUNAUSDT",
-			Venue:  tradeengineproto.VENUE_BINANCE,
-		},
-		{
-			Symbol: "SOL-PERP",
-			Venue:  tradeengineproto.VENUE_FTX,
-		},
-		{
-			Symbol: "SOLUSDT",
-			Venue:  tradeengineproto.VENUE_BINANCE,
-		},
-		{
-			Symbol: "FTMUSDT",
-			Venue:  tradeengineproto.VENUE_BINANCE,
-		},
-		{
-			Symbol: "FTM-PERP",
-			Venue:  tradeengineproto.VENUE_FTX,
-		},
-		{
-			Symbol: "ATOMUSDT",
-			Venue:  tradeengineproto.VENUE_BINANCE,
-		},
-		{
-			Symbol: "ATOM-PERP",
-			Venue:  tradeengineproto.VENUE_FTX,
-		},
-		{
-			Symbol: "ALGOUSDT",
-			Venue:  tradeengineproto.VENUE_BINANCE,
-		},
-		{
-			Symbol: "ALGO-PERP",
-			Venue:  tradeengineproto.VENUE_FTX,
-		},
-		{
-			Symbol:          "tBTCF0:USTF0",
-			Venue:           tradeengineproto.VENUE_BITFINEX,
-			HumanizedSymbol: "BTCUSD",
-		},
-		{
-			Symbol:          "tETHF0:USTF0",
-			Venue:           tradeengineproto.VENUE_BITFINEX,
-			HumanizedSymbol: "ETHUSD",
+			HigherBound:          0.025,
+			LowerBound:           -0.005,
+			FundingIntervalHours: 8,
 		},
 	}
 )
@@ -128,7 +55,14 @@ func GetFundingRateCoefficientByVenue(venue tradeengineproto.VENUE) *FundingRate
 	}
 
 	return &FundingRateVenueInfo{
-		HigherBound: math.MaxFloat64,
-		LowerBound:  -math.MaxFloat64,
+		HigherBound:          math.MaxFloat64,
+		LowerBound:           -math.MaxFloat64,
+		FundingIntervalHours: 8,
 	}
 }
+
+// AnnualisedFundingRate annualises the funding rate of the venue; so funding rates of venues with different funding
+// intervals can be compared.
+func AnnualisedFundingRate(venue tradeengineproto.VENUE, fundingRate float64) float64 {
+	return fundingRate * 24 * 365 / float64(GetFundingRateCoefficientByVenue(venue).FundingIntervalHours)
+}
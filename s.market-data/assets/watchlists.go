@@ -0,0 +1,132 @@
+package assets
+
+import (
+	"context"
+	"strings"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/dao"
+	marketdataproto "swallowtail/s.market-data/proto"
+	solananftsproto "swallowtail/s.solana-nfts/proto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	// DefaultAssetGroup is the group of assets added to a watchlist without one.
+	DefaultAssetGroup = "other"
+)
+
+var (
+	// listWatchlistAssets is a package variable so watchlists can be faked in tests.
+	listWatchlistAssets = dao.ListWatchlistAssets
+)
+
+// ListLatestPriceAssets lists the assets on the global prices watchlist.
+func ListLatestPriceAssets(ctx context.Context) ([]*AssetPair, error) {
+	return ListPersonalPriceAssets(ctx, "")
+}
+
+// ListPersonalPriceAssets lists the assets on the personal prices watchlist of the user.
+func ListPersonalPriceAssets(ctx context.Context, userID string) ([]*AssetPair, error) {
+	watchlistAssets, err := listWatchlistAssets(ctx, marketdataproto.WatchlistPrices, userID)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_price_assets", map[string]string{
+			"user_id": userID,
+		})
+	}
+
+	assetPairs := make([]*AssetPair, 0, len(watchlistAssets))
+	for _, a := range watchlistAssets {
+		// Stored ratings are validated when added; so unknown ratings fall back to low.
+		rating, _ := ParseVolatilityRating(a.VolatilityRating)
+
+		grouping := a.Grouping
+		if grouping == "" {
+			grouping = DefaultAssetGroup
+		}
+
+		assetPairs = append(assetPairs, &AssetPair{
+			Symbol:           a.Symbol,
+			AssetPair:        a.AssetPair,
+			VolatilityRating: rating,
+			Grouping:         grouping,
+		})
+	}
+
+	return assetPairs, nil
+}
+
+// ListFundingRateAssets lists the perpetual futures on the funding rates watchlist.
+func ListFundingRateAssets(ctx context.Context) ([]*FundingRateAsset, error) {
+	watchlistAssets, err := listWatchlistAssets(ctx, marketdataproto.WatchlistFundingRates, "")
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_funding_rate_assets", nil)
+	}
+
+	fundingRateAssets := make([]*FundingRateAsset, 0, len(watchlistAssets))
+	for _, a := range watchlistAssets {
+		venue, ok := ParseFundingRateVenue(a.Venue)
+		if !ok {
+			slog.Warn(ctx, "Skipping funding rate asset with unsupported venue: %s %s", a.Venue, a.Symbol)
+			continue
+		}
+
+		fundingRateAssets = append(fundingRateAssets, &FundingRateAsset{
+			Symbol:          a.Symbol,
+			Venue:           venue,
+			HumanizedSymbol: a.HumanizedName,
+			Asset:           a.Asset,
+		})
+	}
+
+	return fundingRateAssets, nil
+}
+
+// ListSolanaNFTAssets lists the collections on the solana nfts watchlist.
+func ListSolanaNFTAssets(ctx context.Context) ([]*SolanaNFTInfo, error) {
+	watchlistAssets, err := listWatchlistAssets(ctx, marketdataproto.WatchlistSolanaNFTs, "")
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_solana_nft_assets", nil)
+	}
+
+	solanaNFTAssets := make([]*SolanaNFTInfo, 0, len(watchlistAssets))
+	for _, a := range watchlistAssets {
+		vendor, ok := ParseSolanaNFTVendor(a.Venue)
+		if !ok {
+			slog.Warn(ctx, "Skipping solana nft asset with unsupported vendor: %s %s", a.Venue, a.Symbol)
+			continue
+		}
+
+		solanaNFTAssets = append(solanaNFTAssets, &SolanaNFTInfo{
+			CollectionID:          a.Symbol,
+			Vendor:                vendor,
+			HumanizedCollectionID: a.HumanizedName,
+			Emoji:                 a.Emoji,
+		})
+	}
+
+	return solanaNFTAssets, nil
+}
+
+// ParseFundingRateVenue parses a venue we publish the funding rates of i.e `ftx`.
+func ParseFundingRateVenue(s string) (tradeengineproto.VENUE, bool) {
+	venue := tradeengineproto.VENUE(tradeengineproto.VENUE_value[strings.ToUpper(s)])
+
+	coeffMu.RLock()
+	defer coeffMu.RUnlock()
+
+	_, ok := fundingRateVenueData[venue]
+	return venue, ok
+}
+
+// ParseSolanaNFTVendor parses a solana nft vendor i.e `solanart`.
+func ParseSolanaNFTVendor(s string) (solananftsproto.SolanaNFTVendor, bool) {
+	vendor, ok := solananftsproto.SolanaNFTVendor_value[strings.ToUpper(s)]
+	if !ok || vendor == int32(solananftsproto.SolanaNFTVendor_UNKNOWN) {
+		return solananftsproto.SolanaNFTVendor_UNKNOWN, false
+	}
+
+	return solananftsproto.SolanaNFTVendor(vendor), true
+}
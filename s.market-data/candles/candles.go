@@ -0,0 +1,89 @@
+package candles
+
+import (
+	"time"
+
+	"swallowtail/s.market-data/domain"
+)
+
+const (
+	// Interval1m is the interval of the candles we ingest from Binance; all other intervals are built from them.
+	Interval1m = "1m"
+	Interval5m = "5m"
+	Interval1h = "1h"
+	Interval1d = "1d"
+)
+
+var (
+	intervals = map[string]time.Duration{
+		Interval1m: time.Minute,
+		Interval5m: 5 * time.Minute,
+		Interval1h: time.Hour,
+		Interval1d: 24 * time.Hour,
+	}
+
+	// parentIntervals maps an interval to the interval built from it. Each interval is built from the one below it,
+	// so that building a candle never reads more than a day's worth of hourly candles.
+	parentIntervals = map[string]string{
+		Interval1m: Interval5m,
+		Interval5m: Interval1h,
+		Interval1h: Interval1d,
+	}
+)
+
+// IntervalDuration returns the duration of the interval, and false if the interval isn't supported.
+func IntervalDuration(interval string) (time.Duration, bool) {
+	d, ok := intervals[interval]
+	return d, ok
+}
+
+// OpenTime returns the open time of the candle of the interval that the time given falls within. Daily candles open at
+// midnight UTC.
+func OpenTime(t time.Time, interval string) time.Time {
+	return t.UTC().Truncate(intervals[interval])
+}
+
+// Aggregate builds the candle of the interval from its child candles, which must be ordered oldest first & fall within
+// the same candle of the interval. The candle is closed only once every child within its interval has been built &
+// closed; a candle missing any of its children, i.e because they couldn't be backfilled, is never closed.
+func Aggregate(children []*domain.Candle, interval string) *domain.Candle {
+	if len(children) == 0 {
+		return nil
+	}
+
+	var (
+		first    = children[0]
+		last     = children[len(children)-1]
+		d        = intervals[interval]
+		openTime = OpenTime(first.OpenTime, interval)
+	)
+
+	candle := &domain.Candle{
+		Symbol:     first.Symbol,
+		Interval:   interval,
+		OpenTime:   openTime,
+		CloseTime:  openTime.Add(d - time.Millisecond),
+		OpenPrice:  first.OpenPrice,
+		HighPrice:  first.HighPrice,
+		LowPrice:   first.LowPrice,
+		ClosePrice: last.ClosePrice,
+		IsClosed:   len(children) == int(d/intervals[first.Interval]),
+	}
+
+	for _, c := range children {
+		candle.IsClosed = candle.IsClosed && c.IsClosed
+
+		if c.HighPrice > candle.HighPrice {
+			candle.HighPrice = c.HighPrice
+		}
+		if c.LowPrice < candle.LowPrice {
+			candle.LowPrice = c.LowPrice
+		}
+
+		candle.BaseAssetVolume += c.BaseAssetVolume
+		candle.QuoteAssetVolume += c.QuoteAssetVolume
+		candle.NumberOfTrades += c.NumberOfTrades
+	}
+
+	return candle
+}
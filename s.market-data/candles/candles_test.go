@@ -0,0 +1,185 @@
+package candles
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.market-data/domain"
+)
+
+func minuteCandle(openTime time.Time, open, high, low, close float64, isClosed bool) *domain.Candle {
+	return &domain.Candle{
+		Symbol:           "BTCUSDT",
+		Interval:         Interval1m,
+		OpenTime:         openTime,
+		CloseTime:        openTime.Add(time.Minute - time.Millisecond),
+		OpenPrice:        open,
+		HighPrice:        high,
+		LowPrice:         low,
+		ClosePrice:       close,
+		BaseAssetVolume:  1,
+		QuoteAssetVolume: close,
+		NumberOfTrades:   10,
+		IsClosed:         isClosed,
+	}
+}
+
+func TestOpenTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 11, 6, 13, 47, 31, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		interval         string
+		expectedOpenTime time.Time
+	}{
+		{
+			name:             "1m",
+			interval:         Interval1m,
+			expectedOpenTime: time.Date(2021, 11, 6, 13, 47, 0, 0, time.UTC),
+		},
+		{
+			name:             "5m",
+			interval:         Interval5m,
+			expectedOpenTime: time.Date(2021, 11, 6, 13, 45, 0, 0, time.UTC),
+		},
+		{
+			name:             "1h",
+			interval:         Interval1h,
+			expectedOpenTime: time.Date(2021, 11, 6, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "1d_opens_at_midnight_utc",
+			interval:         Interval1d,
+			expectedOpenTime: time.Date(2021, 11, 6, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expectedOpenTime, OpenTime(now, tt.interval))
+		})
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	t.Parallel()
+
+	openTime := time.Date(2021, 11, 6, 13, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		children       []*domain.Candle
+		expectedCandle *domain.Candle
+	}{
+		{
+			name: "complete",
+			children: []*domain.Candle{
+				minuteCandle(openTime, 100, 102, 99, 101, true),
+				minuteCandle(openTime.Add(1*time.Minute), 101, 105, 100, 104, true),
+				minuteCandle(openTime.Add(2*time.Minute), 104, 104, 95, 96, true),
+				minuteCandle(openTime.Add(3*time.Minute), 96, 98, 96, 97, true),
+				minuteCandle(openTime.Add(4*time.Minute), 97, 99, 97, 98, true),
+			},
+			expectedCandle: &domain.Candle{
+				Symbol:           "BTCUSDT",
+				Interval:         Interval5m,
+				OpenTime:         openTime,
+				CloseTime:        openTime.Add(5*time.Minute - time.Millisecond),
+				OpenPrice:        100,
+				HighPrice:        105,
+				LowPrice:         95,
+				ClosePrice:       98,
+				BaseAssetVolume:  5,
+				QuoteAssetVolume: 496,
+				NumberOfTrades:   50,
+				IsClosed:         true,
+			},
+		},
+		{
+			name: "incomplete",
+			children: []*domain.Candle{
+				minuteCandle(openTime, 100, 102, 99, 101, true),
+				minuteCandle(openTime.Add(1*time.Minute), 101, 105, 100, 104, true),
+			},
+			expectedCandle: &domain.Candle{
+				Symbol:           "BTCUSDT",
+				Interval:         Interval5m,
+				OpenTime:         openTime,
+				CloseTime:        openTime.Add(5*time.Minute - time.Millisecond),
+				OpenPrice:        100,
+				HighPrice:        105,
+				LowPrice:         99,
+				ClosePrice:       104,
+				BaseAssetVolume:  2,
+				QuoteAssetVolume: 205,
+				NumberOfTrades:   20,
+				IsClosed:         false,
+			},
+		},
+		{
+			name: "last_child_closed_with_children_missing",
+			children: []*domain.Candle{
+				minuteCandle(openTime, 100, 102, 99, 101, true),
+				minuteCandle(openTime.Add(4*time.Minute), 97, 99, 97, 98, true),
+			},
+			expectedCandle: &domain.Candle{
+				Symbol:           "BTCUSDT",
+				Interval:         Interval5m,
+				OpenTime:         openTime,
+				CloseTime:        openTime.Add(5*time.Minute - time.Millisecond),
+				OpenPrice:        100,
+				HighPrice:        102,
+				LowPrice:         97,
+				ClosePrice:       98,
+				BaseAssetVolume:  2,
+				QuoteAssetVolume: 199,
+				NumberOfTrades:   20,
+				IsClosed:         false,
+			},
+		},
+		{
+			name: "every_child_present_but_not_closed",
+			children: []*domain.Candle{
+				minuteCandle(openTime, 100, 102, 99, 101, true),
+				minuteCandle(openTime.Add(1*time.Minute), 101, 105, 100, 104, true),
+				minuteCandle(openTime.Add(2*time.Minute), 104, 104, 95, 96, true),
+				minuteCandle(openTime.Add(3*time.Minute), 96, 98, 96, 97, true),
+				minuteCandle(openTime.Add(4*time.Minute), 97, 99, 97, 98, false),
+			},
+			expectedCandle: &domain.Candle{
+				Symbol:           "BTCUSDT",
+				Interval:         Interval5m,
+				OpenTime:         openTime,
+				CloseTime:        openTime.Add(5*time.Minute - time.Millisecond),
+				OpenPrice:        100,
+				HighPrice:        105,
+				LowPrice:         95,
+				ClosePrice:       98,
+				BaseAssetVolume:  5,
+				QuoteAssetVolume: 496,
+				NumberOfTrades:   50,
+				IsClosed:         false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			candle := Aggregate(tt.children, Interval5m)
+			require.NotNil(t, candle)
+			assert.Equal(t, tt.expectedCandle, candle)
+		})
+	}
+
+	assert.Nil(t, Aggregate(nil, Interval5m))
+}
@@ -0,0 +1,274 @@
+package candles
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/assets"
+	"swallowtail/s.market-data/dao"
+	"swallowtail/s.market-data/domain"
+)
+
+const (
+	// maxBackfill is the most 1m candles we backfill per page; it's the most klines Binance returns per request.
+	maxBackfill = 1000
+
+	// restartBackoff is how long we wait before restarting the ingestion of a symbol that failed.
+	restartBackoff = time.Minute
+
+	// watchlistRefreshPeriod is how often we check the prices watchlist for new symbols to ingest.
+	watchlistRefreshPeriod = 5 * time.Minute
+)
+
+var (
+	// Fakeable for testing.
+	listKlines         = ListKlinesFromBinance
+	streamClosedKlines = streamClosedKlinesFromBinance
+	upsertCandle       = dao.UpsertCandle
+	listCandles        = dao.ListCandles
+	listLatestCandles  = dao.ListLatestCandles
+	listPriceAssets    = assets.ListLatestPriceAssets
+
+	ingestingMu sync.Mutex
+	ingesting   = map[string]bool{}
+)
+
+// Init starts ingesting the candles of each asset on the prices watchlist. Assets added to the watchlist are picked up
+// within the refresh period; assets removed are ingested until the next restart.
+func Init(ctx context.Context) error {
+	if err := ingestWatchlist(ctx); err != nil {
+		return gerrors.Augment(err, "failed_to_init_candles", nil)
+	}
+
+	go func() {
+		t := time.NewTicker(watchlistRefreshPeriod)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-t.C:
+				if err := ingestWatchlist(ctx); err != nil {
+					slog.Error(ctx, "Failed to refresh candle symbols from the prices watchlist: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// ingestWatchlist starts ingesting the candles of any asset on the prices watchlist we aren't already ingesting.
+func ingestWatchlist(ctx context.Context) error {
+	priceAssets, err := listPriceAssets(ctx)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_ingest_watchlist", nil)
+	}
+
+	ingestingMu.Lock()
+	symbols := []string{}
+	for _, asset := range priceAssets {
+		symbol := asset.BinanceSymbol()
+		if ingesting[symbol] {
+			continue
+		}
+
+		ingesting[symbol] = true
+		symbols = append(symbols, symbol)
+	}
+	ingestingMu.Unlock()
+
+	if len(symbols) == 0 {
+		return nil
+	}
+
+	Ingest(ctx, symbols)
+
+	slog.Info(ctx, "Ingesting candles of %d new symbols: %v", len(symbols), symbols)
+
+	return nil
+}
+
+// Ingest ingests the 1m candles of the Binance symbols given until the context is done, building the 5m, 1h & 1d candles
+// from them as they close. It backfills any candles missed whilst we weren't streaming.
+func Ingest(ctx context.Context, symbols []string) {
+	for _, symbol := range symbols {
+		symbol := symbol
+		go func() {
+			for {
+				if err := ingestSymbol(ctx, symbol); err != nil {
+					slog.Error(ctx, "Failed to ingest candles: %s, Error: %v", symbol, err)
+				}
+
+				select {
+				case <-time.After(restartBackoff):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+}
+
+// ingestSymbol streams the closed 1m candles of the symbol until the stream is closed. Candles of each symbol are
+// ingested in order, by a single goroutine, so that each candle built from them is consistent.
+func ingestSymbol(ctx context.Context, symbol string) error {
+	errParams := map[string]string{
+		"symbol": symbol,
+	}
+
+	// The stream lives only as long as this attempt; otherwise every restart would leak the stream of the last.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Start streaming before we backfill, so that we don't miss any candles in between.
+	stream, err := streamClosedKlines(ctx, symbol)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_ingest_symbol.stream", errParams)
+	}
+
+	latest, err := listLatestCandles(ctx, symbol, Interval1m, time.Now().UTC(), 1)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_ingest_symbol.read_latest_candle", errParams)
+	}
+
+	var lastOpenTime time.Time
+	if len(latest) > 0 {
+		lastOpenTime = latest[0].OpenTime
+	}
+
+	lastOpenTime, err = backfill(ctx, symbol, lastOpenTime, time.Now().UTC())
+	if err != nil {
+		// Best effort; we don't want a Binance outage to stop us streaming.
+		slog.Error(ctx, "Failed to backfill candles: %s, Error: %v", symbol, err)
+	}
+
+	for {
+		select {
+		case candle, ok := <-stream:
+			if !ok {
+				return gerrors.FailedPrecondition("failed_to_ingest_symbol.stream_closed", errParams)
+			}
+
+			switch {
+			case !lastOpenTime.IsZero() && !candle.OpenTime.After(lastOpenTime):
+				// We've already ingested this candle whilst backfilling.
+				continue
+			case !lastOpenTime.IsZero() && candle.OpenTime.Sub(lastOpenTime) > time.Minute:
+				// We've missed candles; most likely whilst the stream was reconnecting.
+				if _, err := backfill(ctx, symbol, lastOpenTime, candle.OpenTime); err != nil {
+					slog.Error(ctx, "Failed to backfill missed candles: %s, Error: %v", symbol, err)
+				}
+			}
+
+			if err := ingestCandle(ctx, candle); err != nil {
+				slog.Error(ctx, "Failed to ingest candle: %s, Error: %v", symbol, err)
+				continue
+			}
+
+			lastOpenTime = candle.OpenTime
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// backfill ingests the closed 1m candles of the symbol opened after the last open time & before the time given, paging
+// through the klines of the gap a page at a time; returning the open time of the last candle ingested. Symbols we've
+// never ingested are backfilled a page's worth.
+func backfill(ctx context.Context, symbol string, lastOpenTime, before time.Time) (time.Time, error) {
+	from := lastOpenTime.Add(time.Minute)
+	if lastOpenTime.IsZero() {
+		from = OpenTime(before, Interval1m).Add(-maxBackfill * time.Minute)
+	}
+
+	var numberOfCandles int
+	for from.Before(before) {
+		klines, err := listKlines(ctx, symbol, from, before, maxBackfill)
+		if err != nil {
+			return lastOpenTime, gerrors.Augment(err, "failed_to_backfill_candles", map[string]string{
+				"symbol": symbol,
+				"from":   from.String(),
+				"before": before.String(),
+			})
+		}
+
+		for _, kline := range klines {
+			if !kline.IsClosed || !kline.OpenTime.Before(before) {
+				continue
+			}
+
+			if err := ingestCandle(ctx, kline); err != nil {
+				return lastOpenTime, gerrors.Augment(err, "failed_to_backfill_candles", map[string]string{
+					"symbol": symbol,
+				})
+			}
+
+			lastOpenTime = kline.OpenTime
+			numberOfCandles++
+		}
+
+		// A partial page means we've caught up.
+		if len(klines) < maxBackfill {
+			break
+		}
+
+		next := klines[len(klines)-1].OpenTime.Add(time.Minute)
+		if !next.After(from) {
+			break
+		}
+		from = next
+	}
+
+	slog.Info(ctx, "Backfilled %d candles: %s", numberOfCandles, symbol)
+
+	return lastOpenTime, nil
+}
+
+// ingestCandle stores the 1m candle, then rebuilds each candle of a larger interval that it falls within from the
+// stored candles of the interval below; rebuilding rather than updating means reingesting a candle is idempotent.
+func ingestCandle(ctx context.Context, candle *domain.Candle) error {
+	if err := upsertCandle(ctx, candle); err != nil {
+		return gerrors.Augment(err, "failed_to_store_candle", map[string]string{
+			"interval": candle.Interval,
+		})
+	}
+
+	child := candle
+	for {
+		parentInterval, ok := parentIntervals[child.Interval]
+		if !ok {
+			return nil
+		}
+
+		var (
+			openTime = OpenTime(child.OpenTime, parentInterval)
+			limit    = int(intervals[parentInterval] / intervals[child.Interval])
+		)
+
+		children, err := listCandles(ctx, child.Symbol, child.Interval, openTime, openTime.Add(intervals[parentInterval]), limit)
+		if err != nil {
+			return gerrors.Augment(err, "failed_to_build_candle.list_children", map[string]string{
+				"interval": parentInterval,
+			})
+		}
+
+		parent := Aggregate(children, parentInterval)
+		if parent == nil {
+			return nil
+		}
+
+		if err := upsertCandle(ctx, parent); err != nil {
+			return gerrors.Augment(err, "failed_to_build_candle", map[string]string{
+				"interval": parentInterval,
+			})
+		}
+
+		child = parent
+	}
+}
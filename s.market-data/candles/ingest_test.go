@@ -0,0 +1,203 @@
+package candles
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.market-data/domain"
+)
+
+type candleKey struct {
+	interval string
+	openTime time.Time
+}
+
+// fakeCandleStore stubs the dao with an in memory store of candles.
+func fakeCandleStore(t *testing.T) map[candleKey]*domain.Candle {
+	store := map[candleKey]*domain.Candle{}
+
+	prevUpsertCandle, prevListCandles := upsertCandle, listCandles
+	t.Cleanup(func() {
+		upsertCandle, listCandles = prevUpsertCandle, prevListCandles
+	})
+
+	upsertCandle = func(_ context.Context, candle *domain.Candle) error {
+		c := *candle
+		store[candleKey{candle.Interval, candle.OpenTime}] = &c
+		return nil
+	}
+	listCandles = func(_ context.Context, _, interval string, from, to time.Time, limit int) ([]*domain.Candle, error) {
+		candles := []*domain.Candle{}
+		for k, c := range store {
+			if k.interval == interval && !k.openTime.Before(from) && k.openTime.Before(to) {
+				candles = append(candles, c)
+			}
+		}
+
+		sort.Slice(candles, func(i, j int) bool {
+			return candles[i].OpenTime.Before(candles[j].OpenTime)
+		})
+		if len(candles) > limit {
+			candles = candles[:limit]
+		}
+
+		return candles, nil
+	}
+
+	return store
+}
+
+func TestIngestCandle_BuildsLargerIntervals(t *testing.T) {
+	store := fakeCandleStore(t)
+
+	var (
+		ctx      = context.Background()
+		openTime = time.Date(2021, 11, 6, 23, 55, 0, 0, time.UTC)
+	)
+
+	for i := 0; i < 5; i++ {
+		price := float64(100 + i)
+		require.NoError(t, ingestCandle(ctx, minuteCandle(openTime.Add(time.Duration(i)*time.Minute), price, price+1, price-1, price, true)))
+	}
+
+	// Reingesting a candle shouldn't change the candles built from it.
+	require.NoError(t, ingestCandle(ctx, minuteCandle(openTime, 100, 101, 99, 100, true)))
+
+	fiveMinute := store[candleKey{Interval5m, openTime}]
+	require.NotNil(t, fiveMinute)
+	assert.True(t, fiveMinute.IsClosed)
+	assert.Equal(t, float64(100), fiveMinute.OpenPrice)
+	assert.Equal(t, float64(105), fiveMinute.HighPrice)
+	assert.Equal(t, float64(99), fiveMinute.LowPrice)
+	assert.Equal(t, float64(104), fiveMinute.ClosePrice)
+	assert.Equal(t, int64(50), fiveMinute.NumberOfTrades)
+
+	// The last 5m candle of the day doesn't close the hourly & daily candles it falls within; the earlier candles of the
+	// day are missing.
+	hourly := store[candleKey{Interval1h, time.Date(2021, 11, 6, 23, 0, 0, 0, time.UTC)}]
+	require.NotNil(t, hourly)
+	assert.False(t, hourly.IsClosed)
+	assert.Equal(t, float64(104), hourly.ClosePrice)
+
+	daily := store[candleKey{Interval1d, time.Date(2021, 11, 6, 0, 0, 0, 0, time.UTC)}]
+	require.NotNil(t, daily)
+	assert.False(t, daily.IsClosed)
+	assert.Equal(t, float64(100), daily.OpenPrice)
+	assert.Equal(t, int64(50), daily.NumberOfTrades)
+
+	assert.Len(t, store, 8)
+}
+
+func TestBackfill(t *testing.T) {
+	store := fakeCandleStore(t)
+
+	var (
+		ctx          = context.Background()
+		lastOpenTime = time.Date(2021, 11, 6, 13, 0, 0, 0, time.UTC)
+		before       = lastOpenTime.Add(4 * time.Minute)
+		listedFrom   time.Time
+	)
+
+	prevListKlines := listKlines
+	t.Cleanup(func() { listKlines = prevListKlines })
+
+	listKlines = func(_ context.Context, _ string, from, to time.Time, _ int) ([]*domain.Candle, error) {
+		listedFrom = from
+		return []*domain.Candle{
+			minuteCandle(from, 100, 101, 99, 100, true),
+			minuteCandle(from.Add(time.Minute), 100, 101, 99, 100, true),
+			minuteCandle(from.Add(2*time.Minute), 100, 101, 99, 100, true),
+			// Still open; so it shouldn't be ingested.
+			minuteCandle(from.Add(3*time.Minute), 100, 101, 99, 100, false),
+		}, nil
+	}
+
+	backfilledTo, err := backfill(ctx, "BTCUSDT", lastOpenTime, before)
+	require.NoError(t, err)
+
+	assert.Equal(t, lastOpenTime.Add(time.Minute), listedFrom)
+	assert.Equal(t, lastOpenTime.Add(3*time.Minute), backfilledTo)
+	assert.Nil(t, store[candleKey{Interval1m, lastOpenTime}])
+	assert.NotNil(t, store[candleKey{Interval1m, lastOpenTime.Add(3 * time.Minute)}])
+	assert.Nil(t, store[candleKey{Interval1m, lastOpenTime.Add(4 * time.Minute)}])
+
+	// Nothing to backfill when we're up to date.
+	listedFrom = time.Time{}
+	backfilledTo, err = backfill(ctx, "BTCUSDT", before.Add(-time.Minute), before)
+	require.NoError(t, err)
+	assert.Equal(t, before.Add(-time.Minute), backfilledTo)
+	assert.True(t, listedFrom.IsZero())
+}
+
+func TestIngestSymbol_CancelsStreamOnReturn(t *testing.T) {
+	prevStream, prevListLatest := streamClosedKlines, listLatestCandles
+	t.Cleanup(func() {
+		streamClosedKlines, listLatestCandles = prevStream, prevListLatest
+	})
+
+	var streamCtx context.Context
+	streamClosedKlines = func(ctx context.Context, _ string) (<-chan *domain.Candle, error) {
+		streamCtx = ctx
+		return make(chan *domain.Candle), nil
+	}
+	listLatestCandles = func(_ context.Context, _, _ string, _ time.Time, _ int) ([]*domain.Candle, error) {
+		return nil, assert.AnError
+	}
+
+	require.Error(t, ingestSymbol(context.Background(), "BTCUSDT"))
+
+	// The stream must be closed before we restart, else every restart leaks another.
+	require.NotNil(t, streamCtx)
+	assert.Error(t, streamCtx.Err())
+}
+
+func TestBackfill_PagesThroughGap(t *testing.T) {
+	store := fakeCandleStore(t)
+
+	var (
+		ctx          = context.Background()
+		lastOpenTime = time.Date(2021, 11, 6, 13, 0, 0, 0, time.UTC)
+		before       = lastOpenTime.Add(2500 * time.Minute)
+		pages        []time.Time
+	)
+
+	prevListKlines := listKlines
+	t.Cleanup(func() { listKlines = prevListKlines })
+
+	listKlines = func(_ context.Context, _ string, from, to time.Time, limit int) ([]*domain.Candle, error) {
+		pages = append(pages, from)
+
+		klines := []*domain.Candle{}
+		for openTime := from; openTime.Before(to) && len(klines) < limit; openTime = openTime.Add(time.Minute) {
+			klines = append(klines, minuteCandle(openTime, 100, 101, 99, 100, true))
+		}
+
+		return klines, nil
+	}
+
+	backfilledTo, err := backfill(ctx, "BTCUSDT", lastOpenTime, before)
+	require.NoError(t, err)
+
+	// The whole gap is backfilled, a page at a time.
+	assert.Equal(t, []time.Time{
+		lastOpenTime.Add(time.Minute),
+		lastOpenTime.Add((maxBackfill + 1) * time.Minute),
+		lastOpenTime.Add((2*maxBackfill + 1) * time.Minute),
+	}, pages)
+	assert.Equal(t, before.Add(-time.Minute), backfilledTo)
+	assert.NotNil(t, store[candleKey{Interval1m, lastOpenTime.Add(time.Minute)}])
+	assert.NotNil(t, store[candleKey{Interval1m, lastOpenTime.Add(maxBackfill * time.Minute)}])
+	assert.NotNil(t, store[candleKey{Interval1m, before.Add(-time.Minute)}])
+
+	// A symbol we've never ingested is only backfilled a page's worth.
+	pages = nil
+	backfilledTo, err = backfill(ctx, "ETHUSDT", time.Time{}, before)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{before.Add(-maxBackfill * time.Minute)}, pages)
+	assert.Equal(t, before.Add(-time.Minute), backfilledTo)
+}
@@ -0,0 +1,155 @@
+package candles
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/multiplexing"
+	"swallowtail/s.binance-consumer/clients"
+	binanceconsumerdomain "swallowtail/s.binance-consumer/domain"
+	binanceproto "swallowtail/s.binance/proto"
+	"swallowtail/s.market-data/domain"
+)
+
+const (
+	streamBufferSize = 16
+)
+
+// ListKlinesFromBinance lists the 1m klines of the symbol opened within [from, to), oldest first.
+func ListKlinesFromBinance(ctx context.Context, symbol string, from, to time.Time, limit int) ([]*domain.Candle, error) {
+	rsp, err := (&binanceproto.ListKlinesRequest{
+		Symbol:    symbol,
+		Interval:  Interval1m,
+		StartTime: from.UnixMilli(),
+		EndTime:   to.Add(-time.Millisecond).UnixMilli(),
+		Limit:     int64(limit),
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_klines_from_binance", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	now := time.Now().UTC()
+	candles := make([]*domain.Candle, 0, len(rsp.GetKlines()))
+	for _, k := range rsp.GetKlines() {
+		closeTime := time.UnixMilli(k.CloseTime).UTC()
+		candles = append(candles, &domain.Candle{
+			Symbol:           symbol,
+			Interval:         Interval1m,
+			OpenTime:         time.UnixMilli(k.OpenTime).UTC(),
+			CloseTime:        closeTime,
+			OpenPrice:        float64(k.OpenPrice),
+			HighPrice:        float64(k.HighPrice),
+			LowPrice:         float64(k.LowPrice),
+			ClosePrice:       float64(k.ClosePrice),
+			BaseAssetVolume:  float64(k.BaseAssetVolume),
+			QuoteAssetVolume: float64(k.QuoteAssetVolume),
+			NumberOfTrades:   k.NumberOfTrades,
+			IsClosed:         closeTime.Before(now),
+		})
+	}
+
+	return candles, nil
+}
+
+// streamClosedKlinesFromBinance streams the 1m klines of the symbol from Binance as they close. The stream is closed
+// once the context is done.
+func streamClosedKlinesFromBinance(ctx context.Context, symbol string) (<-chan *domain.Candle, error) {
+	return streamKlinesFromBinance(ctx, symbol, closedKlineFilter)
+}
+
+// StreamKlinesFromBinance streams every update of the 1m klines of the symbol from Binance, closed or not; so the live
+// price can be followed. The stream is closed once the context is done.
+func StreamKlinesFromBinance(ctx context.Context, symbol string) (<-chan *domain.Candle, error) {
+	return streamKlinesFromBinance(ctx, symbol, klineFilter)
+}
+
+func streamKlinesFromBinance(ctx context.Context, symbol string, filter multiplexing.MuliplexFilter) (<-chan *domain.Candle, error) {
+	consumer := multiplexing.NewMultiplexConsumer(streamBufferSize, filter, map[string]string{
+		"symbol": symbol,
+	})
+
+	instrument := fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), Interval1m)
+	if _, err := clients.NewStreamingClient(ctx, instrument, []*multiplexing.Multiplex{
+		multiplexing.New([]*multiplexing.MultiplexConsumer{consumer}),
+	}); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_stream_klines_from_binance", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	candleCh := make(chan *domain.Candle, streamBufferSize)
+	go func() {
+		defer close(candleCh)
+		for e := range consumer.Ch {
+			select {
+			case candleCh <- e.(*domain.Candle):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return candleCh, nil
+}
+
+// closedKlineFilter filters Binance kline events down to those of closed klines, converting them to candles.
+func closedKlineFilter(e interface{}) (interface{}, bool) {
+	candle, ok := klineFilter(e)
+	if !ok || !candle.(*domain.Candle).IsClosed {
+		return nil, false
+	}
+
+	return candle, true
+}
+
+// klineFilter filters Binance events down to kline events, converting them to candles.
+func klineFilter(e interface{}) (interface{}, bool) {
+	// The stream client passes events as pointers to the event interface.
+	v, ok := reflect.Indirect(reflect.ValueOf(e)).Interface().(*binanceconsumerdomain.BinanceKlineEvent)
+	if !ok || v == nil {
+		return nil, false
+	}
+
+	candle, err := klineToCandle(&v.Data)
+	if err != nil {
+		return nil, false
+	}
+
+	return candle, true
+}
+
+func klineToCandle(k *binanceconsumerdomain.BinanceKlineEventData) (*domain.Candle, error) {
+	prices := make([]float64, 0, 6)
+	for _, s := range []string{k.OpenPrice, k.HighPrice, k.LowPrice, k.ClosePrice, k.BaseAssetVolume, k.QuoteAssetVolume} {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, gerrors.BadParam("bad_param.invalid_kline", map[string]string{
+				"symbol": k.Symbol,
+				"value":  s,
+			})
+		}
+		prices = append(prices, f)
+	}
+
+	return &domain.Candle{
+		Symbol:           k.Symbol,
+		Interval:         k.Interval,
+		OpenTime:         time.UnixMilli(int64(k.KlineStartTime)).UTC(),
+		CloseTime:        time.UnixMilli(int64(k.KlineCloseTime)).UTC(),
+		OpenPrice:        prices[0],
+		HighPrice:        prices[1],
+		LowPrice:         prices[2],
+		ClosePrice:       prices[3],
+		BaseAssetVolume:  prices[4],
+		QuoteAssetVolume: prices[5],
+		NumberOfTrades:   int64(k.NumberOfTrade),
+		IsClosed:         k.IsKlineClosed,
+	}, nil
+}
@@ -0,0 +1,177 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS s_marketdata_candles(
+	symbol VARCHAR(32) NOT NULL,
+	candle_interval VARCHAR(8) NOT NULL,
+	open_time TIMESTAMP NOT NULL,
+	close_time TIMESTAMP NOT NULL,
+	open_price DECIMAL NOT NULL,
+	high_price DECIMAL NOT NULL,
+	low_price DECIMAL NOT NULL,
+	close_price DECIMAL NOT NULL,
+	base_asset_volume DECIMAL NOT NULL,
+	quote_asset_volume DECIMAL NOT NULL,
+	number_of_trades BIGINT NOT NULL,
+	is_closed BOOLEAN NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY (symbol, candle_interval, open_time)
+);
+
+-- Funding rates are percentages; as published.
+CREATE TABLE IF NOT EXISTS s_marketdata_funding_rates(
+	venue VARCHAR(32) NOT NULL,
+	symbol VARCHAR(32) NOT NULL,
+	asset VARCHAR(16) NOT NULL,
+	funding_rate DECIMAL NOT NULL,
+	funding_interval_hours INT NOT NULL,
+	annualised_funding_rate DECIMAL NOT NULL,
+	timestamp TIMESTAMP NOT NULL,
+
+	PRIMARY KEY (venue, symbol, timestamp)
+);
+
+CREATE INDEX IF NOT EXISTS idx_s_marketdata_funding_rates_asset_timestamp
+	ON s_marketdata_funding_rates(asset, timestamp);
+
+-- Watchlists are the assets we publish market data on. Global watchlists have an empty owner; personal watchlists are
+-- owned by a user.
+CREATE TABLE IF NOT EXISTS s_marketdata_watchlist_assets(
+	watchlist VARCHAR(32) NOT NULL,
+	owner_id VARCHAR(64) NOT NULL,
+	-- The asset's symbol, the venue's symbol for funding rates, or the collection id for solana nfts.
+	symbol VARCHAR(64) NOT NULL,
+	asset_pair VARCHAR(16) NOT NULL,
+	-- The venue for funding rates, or the vendor for solana nfts.
+	venue VARCHAR(32) NOT NULL,
+	grouping VARCHAR(32) NOT NULL,
+	volatility_rating VARCHAR(16) NOT NULL,
+	humanized_name VARCHAR(64) NOT NULL,
+	asset VARCHAR(16) NOT NULL,
+	emoji VARCHAR(64) NOT NULL,
+	created_by VARCHAR(64) NOT NULL DEFAULT '',
+	created TIMESTAMP NOT NULL DEFAULT NOW(),
+
+	PRIMARY KEY (watchlist, owner_id, symbol, asset_pair, venue)
+);
+
+-- Records the seeds that have been applied; since the schema is applied on every startup.
+CREATE TABLE IF NOT EXISTS s_marketdata_seeds(
+	name VARCHAR(64) NOT NULL,
+	seeded TIMESTAMP NOT NULL,
+
+	PRIMARY KEY (name)
+);
+
+-- Seeds the global watchlists once; from then on they're managed by `!watchlist`.
+INSERT INTO s_marketdata_watchlist_assets
+	(watchlist, owner_id, symbol, asset_pair, venue, grouping, volatility_rating, humanized_name, asset, emoji)
+SELECT * FROM (VALUES
+	('prices', '', 'btc', 'usd', '', 'bitcoin', 'low', '', '', ''),
+	('prices', '', 'eth', 'usd', '', 'ethereum', 'medium', '', '', ''),
+	('prices', '', 'eth', 'btc', '', 'ethereum', 'medium', '', '', ''),
+	('prices', '', 'sol', 'usd', '', 'solana', 'medium', '', '', ''),
+	('prices', '', 'avax', 'usd', '', 'L1', 'medium', '', '', ''),
+	('prices', '', 'algo', 'usd', '', 'L1', 'high', '', '', ''),
+	('prices', '', 'cope', 'usd', '', 'solana', 'high', '', '', ''),
+	('prices', '', 'link', 'usd', '', 'oracles', 'high', '', '', ''),
+	('prices', '', 'srm', 'usd', '', 'solana', 'high', '', '', ''),
+	('prices', '', 'ray', 'usd', '', 'solana', 'high', '', '', ''),
+	('prices', '', 'step', 'usd', '', 'solana', 'high', '', '', ''),
+	('prices', '', 'dot', 'usd', '', 'L1', 'medium', '', '', ''),
+	('prices', '', 'aave', 'usd', '', 'defi', 'medium', '', '', ''),
+	('prices', '', 'atom', 'usd', '', 'L1', 'medium', '', '', ''),
+	('prices', '', 'bnb', 'usd', '', 'bsc', 'medium', '', '', ''),
+	('prices', '', 'cake', 'usd', '', 'bsc', 'medium', '', '', ''),
+	('prices', '', 'ftm', 'usd', '', 'L1', 'high', '', '', ''),
+	('prices', '', 'rune', 'usd', '', 'defi', 'high', '', '', ''),
+	('prices', '', 'sushi', 'usd', '', 'defi', 'high', '', '', ''),
+	('prices', '', 'uni', 'usd', '', 'defi', 'medium', '', '', ''),
+	('prices', '', 'woo', 'usd', '', 'defi', 'high', '', '', ''),
+	('prices', '', 'spell', 'usd', '', 'defi2.0', 'high', '', '', ''),
+	('prices', '', 'dydx', 'usd', '', 'defi2.0', 'high', '', '', ''),
+	('prices', '', 'luna', 'usd', '', 'luna', 'high', '', '', ''),
+	('prices', '', 'liq', 'usd', '', 'solana', 'extreme', '', '', ''),
+	('prices', '', 'fab', 'usd', '', 'solana', 'extreme', '', '', ''),
+	('prices', '', 'bop', 'usd', '', 'solana', 'high', '', '', ''),
+	('prices', '', 'sol', 'btc', '', 'solana', 'medium', '', '', ''),
+	('prices', '', 'sol', 'eth', '', 'solana', 'medium', '', '', ''),
+	('prices', '', 'axs', 'usd', '', 'metaverse', 'high', '', '', ''),
+	('prices', '', 'doge', 'usd', '', 'dogcoin', 'extreme', '', '', ''),
+	('prices', '', 'samo', 'usd', '', 'dogcoin', 'extreme', '', '', ''),
+	('prices', '', 'floki', 'usd', '', 'dogcoin', 'extreme', '', '', ''),
+	('prices', '', 'scrt', 'usd', '', 'privacy', 'high', '', '', ''),
+	('prices', '', 'one', 'usd', '', 'L1', 'high', '', '', ''),
+	('prices', '', 'frkt', 'usd', '', 'other', 'extreme', '', '', ''),
+	('prices', '', 'htr', 'usd', '', 'L1', 'high', '', '', ''),
+	('prices', '', 'crv', 'usd', '', 'defi', 'medium', '', '', ''),
+	('prices', '', 'comp', 'usd', '', 'defi', 'medium', '', '', ''),
+	('prices', '', 'cheems', 'usd', '', 'dogcoin', 'extreme', '', '', ''),
+	('prices', '', 'mana', 'usd', '', 'metaverse', 'high', '', '', ''),
+	('prices', '', 'sand', 'usd', '', 'metaverse', 'high', '', '', ''),
+	('funding-rates', '', 'AVAX-PERP', '', 'ftx', '', '', '', 'AVAX', ''),
+	('funding-rates', '', 'AVAXUSDT', '', 'binance', '', '', '', 'AVAX', ''),
+	('funding-rates', '', 'BTC-PERP', '', 'ftx', '', '', '', 'BTC', ''),
+	('funding-rates', '', 'BTCUSDT', '', 'binance', '', '', '', 'BTC', ''),
+	('funding-rates', '', 'ETH-PERP', '', 'ftx', '', '', '', 'ETH', ''),
+	('funding-rates', '', 'ETHUSDT', '', 'binance', '', '', '', 'ETH', ''),
+	('funding-rates', '', 'LUNA-PERP', '', 'ftx', '', '', '', 'LUNA', ''),
+	('funding-rates', '', 'LUNAUSDT', '', 'binance', '', '', '', 'LUNA', ''),
+	('funding-rates', '', 'SOL-PERP', '', 'ftx', '', '', '', 'SOL', ''),
+	('funding-rates', '', 'SOLUSDT', '', 'binance', '', '', '', 'SOL', ''),
+	('funding-rates', '', 'FTMUSDT', '', 'binance', '', '', '', 'FTM', ''),
+	('funding-rates', '', 'FTM-PERP', '', 'ftx', '', '', '', 'FTM', ''),
+	('funding-rates', '', 'ATOMUSDT', '', 'binance', '', '', '', 'ATOM', ''),
+	('funding-rates', '', 'ATOM-PERP', '', 'ftx', '', '', '', 'ATOM', ''),
+	('funding-rates', '', 'ALGOUSDT', '', 'binance', '', '', '', 'ALGO', ''),
+	('funding-rates', '', 'ALGO-PERP', '', 'ftx', '', '', '', 'ALGO', ''),
+	('funding-rates', '', 'tBTCF0:USTF0', '', 'bitfinex', '', '', 'BTCUSD', 'BTC', ''),
+	('funding-rates', '', 'tETHF0:USTF0', '', 'bitfinex', '', '', 'ETHUSD', 'ETH', ''),
+	('solana-nfts', '', 'galacticgeckospacegarage', '', 'solanart', '', '', 'Galactic Gecko Space Garage', '', ':lizard:'),
+	('solana-nfts', '', 'galacticgeckospacegaragecrystals', '', 'solanart', '', '', 'Galactic Gecko Space Garage Crystals', '', ':diamond_shape_with_a_dot_inside:'),
+	('solana-nfts', '', 'degenape', '', 'solanart', '', '', 'Degenerate Ape Academy', '', ':monkey:'),
+	('solana-nfts', '', 'gloompunk', '', 'solanart', '', '', 'Gloom Punk', '', ':woman_artist:'),
+	('solana-nfts', '', 'babyapes', '', 'solanart', '', '', 'Baby Apes', '', ':monkey_face:'),
+	('solana-nfts', '', 'solarmy2d', '', 'solanart', '', '', 'Solarmy 2D', '', ':poop:'),
+	('solana-nfts', '', 'solarmy3d', '', 'solanart', '', '', 'Solarmy 3D', '', ':mechanical_arm:'),
+	('solana-nfts', '', 'thugbirdz', '', 'solanart', '', '', 'ThugBirdz', '', ':bird:'),
+	('solana-nfts', '', 'frakt', '', 'solanart', '', '', 'Frakt', '', ':art:'),
+	('solana-nfts', '', 'turtles', '', 'solanart', '', '', 'Turtles', '', ':turtle:'),
+	('solana-nfts', '', 'thetower', '', 'solanart', '', '', 'The Tower DAO', '', ':tokyo_tower:'),
+	('solana-nfts', '', 'shadowysupercoder', '', 'solanart', '', '', 'Shadowy Super Coders', '', ':computer:'),
+	('solana-nfts', '', 'guardians', '', 'solanart', '', '', 'Guardians', '', ':robot:')
+) AS seeds(watchlist, owner_id, symbol, asset_pair, venue, grouping, volatility_rating, humanized_name, asset, emoji)
+WHERE NOT EXISTS (SELECT 1 FROM s_marketdata_seeds WHERE name='watchlists')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO s_marketdata_seeds (name, seeded) VALUES ('watchlists', NOW())
+ON CONFLICT DO NOTHING;
+
+-- Price alerts page a user when the price of a Binance spot symbol crosses a level, or moves by a percentage within a
+-- window. One-shot alerts are kept once triggered, for history; recurring alerts stay active.
+CREATE TABLE IF NOT EXISTS s_marketdata_price_alerts(
+	alert_id uuid DEFAULT uuid_generate_v4(),
+	user_id VARCHAR(64) NOT NULL,
+	symbol VARCHAR(16) NOT NULL,
+	asset_pair VARCHAR(16) NOT NULL,
+	-- One of `above`, `below` or `move`.
+	condition VARCHAR(16) NOT NULL,
+	target_price DECIMAL NOT NULL,
+	move_percentage DECIMAL NOT NULL,
+	window_minutes INT NOT NULL,
+	is_recurring BOOLEAN NOT NULL,
+	is_high_priority BOOLEAN NOT NULL,
+	-- Recurring alerts of a level are disarmed once triggered, until the price crosses back over the level.
+	is_armed BOOLEAN NOT NULL,
+	-- One of `active`, `triggered` or `failed`.
+	status VARCHAR(16) NOT NULL,
+	number_of_triggers INT NOT NULL,
+	last_triggered TIMESTAMP,
+	created_by VARCHAR(64) NOT NULL,
+	created TIMESTAMP NOT NULL,
+
+	PRIMARY KEY (alert_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_s_marketdata_price_alerts_user_id
+	ON s_marketdata_price_alerts(user_id);
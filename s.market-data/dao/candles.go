@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/domain"
+)
+
+// UpsertCandle creates the candle, or updates it if the candle has already been stored.
+func UpsertCandle(ctx context.Context, candle *domain.Candle) error {
+	var (
+		sql = `
+		INSERT INTO s_marketdata_candles
+			(symbol, candle_interval, open_time, close_time, open_price, high_price, low_price, close_price,
+			base_asset_volume, quote_asset_volume, number_of_trades, is_closed, last_updated)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (symbol, candle_interval, open_time) DO UPDATE SET
+			close_time=EXCLUDED.close_time,
+			open_price=EXCLUDED.open_price,
+			high_price=EXCLUDED.high_price,
+			low_price=EXCLUDED.low_price,
+			close_price=EXCLUDED.close_price,
+			base_asset_volume=EXCLUDED.base_asset_volume,
+			quote_asset_volume=EXCLUDED.quote_asset_volume,
+			number_of_trades=EXCLUDED.number_of_trades,
+			is_closed=EXCLUDED.is_closed,
+			last_updated=EXCLUDED.last_updated
+		`
+	)
+
+	c := candle
+	c.LastUpdated = time.Now().UTC()
+
+	if _, err := db.Exec(
+		ctx, sql,
+		c.Symbol, c.Interval, c.OpenTime, c.CloseTime, c.OpenPrice, c.HighPrice, c.LowPrice, c.ClosePrice,
+		c.BaseAssetVolume, c.QuoteAssetVolume, c.NumberOfTrades, c.IsClosed, c.LastUpdated,
+	); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ListCandles lists up to the limit of candles opened within [from, to), oldest first.
+func ListCandles(ctx context.Context, symbol, interval string, from, to time.Time, limit int) ([]*domain.Candle, error) {
+	var (
+		sql = `
+		SELECT * FROM s_marketdata_candles
+		WHERE symbol=$1 AND candle_interval=$2 AND open_time>=$3 AND open_time<$4
+		ORDER BY open_time ASC
+		LIMIT $5
+		`
+		candles []*domain.Candle
+	)
+
+	if err := db.Select(ctx, &candles, sql, symbol, interval, from, to, limit); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return candles, nil
+}
+
+// ListLatestCandles lists up to the limit of the most recent candles opened before the time given, oldest first.
+func ListLatestCandles(ctx context.Context, symbol, interval string, to time.Time, limit int) ([]*domain.Candle, error) {
+	var (
+		sql = `
+		SELECT * FROM (
+			SELECT * FROM s_marketdata_candles
+			WHERE symbol=$1 AND candle_interval=$2 AND open_time<$3
+			ORDER BY open_time DESC
+			LIMIT $4
+		) AS latest
+		ORDER BY open_time ASC
+		`
+		candles []*domain.Candle
+	)
+
+	if err := db.Select(ctx, &candles, sql, symbol, interval, to, limit); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return candles, nil
+}
@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/domain"
+)
+
+// CreateFundingRate stores the funding rate; if the funding rate has already been stored for its timestamp then it
+// is updated.
+func CreateFundingRate(ctx context.Context, fundingRate *domain.FundingRate) error {
+	var (
+		sql = `
+		INSERT INTO s_marketdata_funding_rates
+			(venue, symbol, asset, funding_rate, funding_interval_hours, annualised_funding_rate, timestamp)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (venue, symbol, timestamp) DO UPDATE SET
+			funding_rate=EXCLUDED.funding_rate,
+			annualised_funding_rate=EXCLUDED.annualised_funding_rate
+		`
+	)
+
+	f := fundingRate
+	if _, err := db.Exec(
+		ctx, sql,
+		f.Venue, f.Symbol, f.Asset, f.FundingRate, f.FundingIntervalHours, f.AnnualisedFundingRate, f.Timestamp,
+	); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ListFundingRates lists up to the limit of funding rates of the asset within [from, to), oldest first. If the venue
+// is empty then the funding rates of every venue are listed.
+func ListFundingRates(ctx context.Context, asset, venue string, from, to time.Time, limit int) ([]*domain.FundingRate, error) {
+	var (
+		sql = `
+		SELECT * FROM s_marketdata_funding_rates
+		WHERE asset=$1 AND ($2='' OR venue=$2) AND timestamp>=$3 AND timestamp<$4
+		ORDER BY timestamp ASC, venue ASC
+		LIMIT $5
+		`
+		fundingRates []*domain.FundingRate
+	)
+
+	if err := db.Select(ctx, &fundingRates, sql, asset, venue, from, to, limit); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return fundingRates, nil
+}
+
+// ListLatestFundingRates lists up to the limit of the most recent funding rates of the asset before the time given,
+// oldest first. If the venue is empty then the funding rates of every venue are listed.
+func ListLatestFundingRates(ctx context.Context, asset, venue string, to time.Time, limit int) ([]*domain.FundingRate, error) {
+	var (
+		sql = `
+		SELECT * FROM (
+			SELECT * FROM s_marketdata_funding_rates
+			WHERE asset=$1 AND ($2='' OR venue=$2) AND timestamp<$3
+			ORDER BY timestamp DESC
+			LIMIT $4
+		) AS latest
+		ORDER BY timestamp ASC, venue ASC
+		`
+		fundingRates []*domain.FundingRate
+	)
+
+	if err := db.Select(ctx, &fundingRates, sql, asset, venue, to, limit); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return fundingRates, nil
+}
@@ -0,0 +1,191 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// CreatePriceAlert persists the price alert as active & armed, returning the alert embellished with its alert id.
+func CreatePriceAlert(ctx context.Context, alert *domain.PriceAlert) (*domain.PriceAlert, error) {
+	var (
+		sql = `
+		INSERT INTO s_marketdata_price_alerts
+			(user_id, symbol, asset_pair, condition, target_price, move_percentage, window_minutes, is_recurring,
+			is_high_priority, is_armed, status, number_of_triggers, created_by, created)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING alert_id
+		`
+		alertID string
+	)
+
+	a := alert
+	a.IsArmed = true
+	a.Status = marketdataproto.PriceAlertStatusActive
+	a.NumberOfTriggers = 0
+	a.LastTriggered = nil
+	a.Created = time.Now().UTC()
+
+	if err := db.Get(
+		ctx, &alertID, sql,
+		a.UserID, a.Symbol, a.AssetPair, a.Condition, a.TargetPrice, a.MovePercentage, a.WindowMinutes, a.IsRecurring,
+		a.IsHighPriority, a.IsArmed, a.Status, a.NumberOfTriggers, a.CreatedBy, a.Created,
+	); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	a.AlertID = alertID
+	return a, nil
+}
+
+// ListPriceAlertsByUserID lists the price alerts of the user; active alerts first, then the most recent.
+func ListPriceAlertsByUserID(ctx context.Context, userID string) ([]*domain.PriceAlert, error) {
+	var (
+		sql = `
+		SELECT * FROM s_marketdata_price_alerts
+		WHERE user_id=$1
+		ORDER BY status ASC, created DESC
+		`
+		alerts []*domain.PriceAlert
+	)
+
+	if err := db.Select(ctx, &alerts, sql, userID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return alerts, nil
+}
+
+// ListActivePriceAlerts lists every active price alert.
+func ListActivePriceAlerts(ctx context.Context) ([]*domain.PriceAlert, error) {
+	var (
+		sql = `
+		SELECT * FROM s_marketdata_price_alerts
+		WHERE status=$1
+		`
+		alerts []*domain.PriceAlert
+	)
+
+	if err := db.Select(ctx, &alerts, sql, marketdataproto.PriceAlertStatusActive); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return alerts, nil
+}
+
+// DeletePriceAlert deletes the price alert of the user. Returns false if the user has no such alert; alert ids are
+// compared as text, so a malformed id is simply not found.
+func DeletePriceAlert(ctx context.Context, alertID, userID string) (bool, error) {
+	var (
+		sql = `
+		DELETE FROM s_marketdata_price_alerts
+		WHERE alert_id::TEXT=$1 AND user_id=$2
+		`
+	)
+
+	tag, err := db.Exec(ctx, sql, alertID, userID)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// TriggerPriceAlert claims a trigger of the price alert, if it's still active, armed & not within the window of its
+// last trigger. One-shot alerts are marked as triggered; recurring alerts of a level are disarmed, whereas recurring move
+// alerts stay armed. Returns false if the alert couldn't be claimed; i.e it's been deleted or triggered since we last
+// read it.
+func TriggerPriceAlert(ctx context.Context, alertID string, triggered time.Time) (bool, error) {
+	var (
+		sql = `
+		UPDATE s_marketdata_price_alerts
+		SET
+			status=CASE WHEN is_recurring THEN $3 ELSE $4 END,
+			is_armed=(condition=$5),
+			number_of_triggers=number_of_triggers+1,
+			last_triggered=$2
+		WHERE alert_id=$1 AND status=$3 AND is_armed
+		AND (last_triggered IS NULL OR last_triggered + window_minutes * INTERVAL '1 minute' <= $2)
+		`
+	)
+
+	tag, err := db.Exec(
+		ctx, sql,
+		alertID, triggered, marketdataproto.PriceAlertStatusActive, marketdataproto.PriceAlertStatusTriggered,
+		marketdataproto.PriceAlertConditionMove,
+	)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ArmPriceAlert rearms an active price alert.
+func ArmPriceAlert(ctx context.Context, alertID string) error {
+	var (
+		sql = `
+		UPDATE s_marketdata_price_alerts
+		SET is_armed=TRUE
+		WHERE alert_id=$1 AND status=$2
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, alertID, marketdataproto.PriceAlertStatusActive); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// RevertPriceAlertTrigger reverts a claimed trigger of the price alert that couldn't be paged; the alert is reactivated,
+// rearmed & its last trigger restored to the previous trigger, so it can trigger again. Returns false if the trigger is
+// no longer the alert's last; i.e it's been deleted or triggered again since.
+func RevertPriceAlertTrigger(ctx context.Context, alertID string, triggered time.Time, previouslyTriggered *time.Time) (bool, error) {
+	var (
+		sql = `
+		UPDATE s_marketdata_price_alerts
+		SET
+			status=$3,
+			is_armed=TRUE,
+			number_of_triggers=GREATEST(number_of_triggers-1, 0),
+			last_triggered=$4
+		WHERE alert_id=$1 AND last_triggered=$2
+		`
+	)
+
+	tag, err := db.Exec(ctx, sql, alertID, triggered, marketdataproto.PriceAlertStatusActive, previouslyTriggered)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// FailPriceAlert fails a claimed trigger of the price alert that can't be paged; the alert is marked as failed &
+// disarmed, with its last trigger restored to the previous trigger, since it was never paged. Returns false if the
+// trigger is no longer the alert's last; i.e it's been deleted or triggered again since.
+func FailPriceAlert(ctx context.Context, alertID string, triggered time.Time, previouslyTriggered *time.Time) (bool, error) {
+	var (
+		sql = `
+		UPDATE s_marketdata_price_alerts
+		SET
+			status=$3,
+			is_armed=FALSE,
+			number_of_triggers=GREATEST(number_of_triggers-1, 0),
+			last_triggered=$4
+		WHERE alert_id=$1 AND last_triggered=$2
+		`
+	)
+
+	tag, err := db.Exec(ctx, sql, alertID, triggered, marketdataproto.PriceAlertStatusFailed, previouslyTriggered)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
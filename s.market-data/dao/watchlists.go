@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/domain"
+)
+
+// CreateWatchlistAsset adds the asset to its watchlist; if the asset is already on the watchlist then its attributes
+// are updated.
+func CreateWatchlistAsset(ctx context.Context, asset *domain.WatchlistAsset) error {
+	var (
+		sql = `
+		INSERT INTO s_marketdata_watchlist_assets
+			(watchlist, owner_id, symbol, asset_pair, venue, grouping, volatility_rating, humanized_name, asset, emoji,
+			created_by, created)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (watchlist, owner_id, symbol, asset_pair, venue) DO UPDATE SET
+			grouping=EXCLUDED.grouping,
+			volatility_rating=EXCLUDED.volatility_rating,
+			humanized_name=EXCLUDED.humanized_name,
+			asset=EXCLUDED.asset,
+			emoji=EXCLUDED.emoji
+		`
+	)
+
+	a := asset
+	a.Created = time.Now().UTC()
+
+	if _, err := db.Exec(
+		ctx, sql,
+		a.Watchlist, a.OwnerID, a.Symbol, a.AssetPair, a.Venue, a.Grouping, a.VolatilityRating, a.HumanizedName, a.Asset,
+		a.Emoji, a.CreatedBy, a.Created,
+	); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// DeleteWatchlistAsset removes the asset from the watchlist. Returns false if the asset isn't on the watchlist.
+func DeleteWatchlistAsset(ctx context.Context, watchlist, ownerID, symbol, assetPair, venue string) (bool, error) {
+	var (
+		sql = `
+		DELETE FROM s_marketdata_watchlist_assets
+		WHERE watchlist=$1 AND owner_id=$2 AND symbol=$3 AND asset_pair=$4 AND venue=$5
+		`
+	)
+
+	tag, err := db.Exec(ctx, sql, watchlist, ownerID, symbol, assetPair, venue)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListWatchlistAssets lists the assets on the watchlist of the owner; the global watchlist if the owner id is empty.
+func ListWatchlistAssets(ctx context.Context, watchlist, ownerID string) ([]*domain.WatchlistAsset, error) {
+	var (
+		sql = `
+		SELECT * FROM s_marketdata_watchlist_assets
+		WHERE watchlist=$1 AND owner_id=$2
+		ORDER BY grouping ASC, symbol ASC, asset_pair ASC, venue ASC
+		`
+		assets []*domain.WatchlistAsset
+	)
+
+	if err := db.Select(ctx, &assets, sql, watchlist, ownerID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return assets, nil
+}
+
+// ListWatchlistOwners lists the owners of personal watchlists of the given watchlist.
+func ListWatchlistOwners(ctx context.Context, watchlist string) ([]string, error) {
+	var (
+		sql = `
+		SELECT DISTINCT owner_id FROM s_marketdata_watchlist_assets
+		WHERE watchlist=$1 AND owner_id<>''
+		ORDER BY owner_id ASC
+		`
+		ownerIDs []string
+	)
+
+	if err := db.Select(ctx, &ownerIDs, sql, watchlist); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return ownerIDs, nil
+}
@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// Candle is an OHLCV candle of a Binance spot symbol.
+type Candle struct {
+	Symbol           string    `db:"symbol"`
+	Interval         string    `db:"candle_interval"`
+	OpenTime         time.Time `db:"open_time"`
+	CloseTime        time.Time `db:"close_time"`
+	OpenPrice        float64   `db:"open_price"`
+	HighPrice        float64   `db:"high_price"`
+	LowPrice         float64   `db:"low_price"`
+	ClosePrice       float64   `db:"close_price"`
+	BaseAssetVolume  float64   `db:"base_asset_volume"`
+	QuoteAssetVolume float64   `db:"quote_asset_volume"`
+	NumberOfTrades   int64     `db:"number_of_trades"`
+	IsClosed         bool      `db:"is_closed"`
+	LastUpdated      time.Time `db:"last_updated"`
+}
+
+// FundingRate is the funding rate of a perpetual futures symbol on a venue at a point in time. Funding rates are
+// percentages.
+type FundingRate struct {
+	Venue                 string    `db:"venue"`
+	Symbol                string    `db:"symbol"`
+	Asset                 string    `db:"asset"`
+	FundingRate           float64   `db:"funding_rate"`
+	FundingIntervalHours  int       `db:"funding_interval_hours"`
+	AnnualisedFundingRate float64   `db:"annualised_funding_rate"`
+	Timestamp             time.Time `db:"timestamp"`
+}
+
+// WatchlistAsset is an asset on a watchlist. Global watchlists have an empty owner id; personal watchlists are owned by a
+// user.
+type WatchlistAsset struct {
+	Watchlist        string    `db:"watchlist"`
+	OwnerID          string    `db:"owner_id"`
+	Symbol           string    `db:"symbol"`
+	AssetPair        string    `db:"asset_pair"`
+	Venue            string    `db:"venue"`
+	Grouping         string    `db:"grouping"`
+	VolatilityRating string    `db:"volatility_rating"`
+	HumanizedName    string    `db:"humanized_name"`
+	Asset            string    `db:"asset"`
+	Emoji            string    `db:"emoji"`
+	CreatedBy        string    `db:"created_by"`
+	Created          time.Time `db:"created"`
+}
+
+// PriceAlert pages a user when the price of an asset crosses a level, or moves by a percentage within a window.
+type PriceAlert struct {
+	AlertID          string     `db:"alert_id"`
+	UserID           string     `db:"user_id"`
+	Symbol           string     `db:"symbol"`
+	AssetPair        string     `db:"asset_pair"`
+	Condition        string     `db:"condition"`
+	TargetPrice      float64    `db:"target_price"`
+	MovePercentage   float64    `db:"move_percentage"`
+	WindowMinutes    int        `db:"window_minutes"`
+	IsRecurring      bool       `db:"is_recurring"`
+	IsHighPriority   bool       `db:"is_high_priority"`
+	IsArmed          bool       `db:"is_armed"`
+	Status           string     `db:"status"`
+	NumberOfTriggers int        `db:"number_of_triggers"`
+	LastTriggered    *time.Time `db:"last_triggered"`
+	CreatedBy        string     `db:"created_by"`
+	Created          time.Time  `db:"created"`
+}
@@ -6,8 +6,6 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-
-	"swallowtail/s.market-data/assets"
 )
 
 func TestAssets_Sort(t *testing.T) {
@@ -23,21 +21,21 @@ func TestAssets_Sort(t *testing.T) {
 			unsortedAssets: AssetInfoList{
 				{
 					Symbol: "ETH",
-					Group:  assets.AssetGroupBitcoin.String(),
+					Group:  "bitcoin",
 				},
 				{
 					Symbol: "BTC",
-					Group:  assets.AssetGroupBitcoin.String(),
+					Group:  "bitcoin",
 				},
 			},
 			expectedSortedAssets: AssetInfoList{
 				{
 					Symbol: "BTC",
-					Group:  assets.AssetGroupBitcoin.String(),
+					Group:  "bitcoin",
 				},
 				{
 					Symbol: "ETH",
-					Group:  assets.AssetGroupBitcoin.String(),
+					Group:  "bitcoin",
 				},
 			},
 		},
@@ -46,29 +44,29 @@ func TestAssets_Sort(t *testing.T) {
 			unsortedAssets: AssetInfoList{
 				{
 					Symbol: "CRV",
-					Group:  assets.AssetGroupDeFi.String(),
+					Group:  "defi",
 				},
 				{
 					Symbol: "BTC",
-					Group:  assets.AssetGroupBitcoin.String(),
+					Group:  "bitcoin",
 				},
 				{
 					Symbol: "AAVE",
-					Group:  assets.AssetGroupDeFi.String(),
+					Group:  "defi",
 				},
 			},
 			expectedSortedAssets: AssetInfoList{
 				{
 					Symbol: "BTC",
-					Group:  assets.AssetGroupBitcoin.String(),
+					Group:  "bitcoin",
 				},
 				{
 					Symbol: "AAVE",
-					Group:  assets.AssetGroupDeFi.String(),
+					Group:  "defi",
 				},
 				{
 					Symbol: "CRV",
-					Group:  assets.AssetGroupDeFi.String(),
+					Group:  "defi",
 				},
 			},
 		},
@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.market-data/dao"
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+const (
+	// extremeAnnualisedFundingRate is the annualised funding rate, as a percentage, above which (or below the negative
+	// of which) we alert.
+	extremeAnnualisedFundingRate = 100.0
+
+	// fundingRateSpreadThreshold is the spread, in annualised percentage points, between the funding rates of an asset
+	// on two venues above which we alert of an arbitrage opportunity.
+	fundingRateSpreadThreshold = 30.0
+)
+
+var (
+	// fundingRateAlertsChannelID is the channel funding rate alerts are published to; kept apart from the channel the
+	// funding rates themselves are published to, so alerts aren't lost amongst the tables.
+	fundingRateAlertsChannelID = util.SetEnv("MARKETDATA_FUNDING_RATE_ALERTS_CHANNEL_ID")
+
+	// fundingRateAlertCooldown is how long after alerting on a funding rate we stay quiet; funding rates trend, so
+	// without it the same spread would be alerted every hour.
+	fundingRateAlertCooldown = 4 * time.Hour
+	fundingRateCooldowns     = newAlertCooldowns()
+
+	// storeFundingRate & publishFundingRateAlert are package variables so funding rate alerts can be faked in tests.
+	storeFundingRate        = dao.CreateFundingRate
+	publishFundingRateAlert = publishFundingRateAlertToDiscord
+)
+
+type fundingRateAlertType string
+
+const (
+	fundingRateAlertTypeExtreme fundingRateAlertType = "extreme"
+	fundingRateAlertTypeSpread  fundingRateAlertType = "spread"
+)
+
+// fundingRateAlert is an alert on either an extreme funding rate, or on a spread between the funding rates of an asset
+// on two venues; in which case the high funding rate is the venue to short & the low funding rate the venue to long.
+type fundingRateAlert struct {
+	Type  fundingRateAlertType
+	Asset string
+	High  *FundingRateInfo
+	Low   *FundingRateInfo
+}
+
+func (a *fundingRateAlert) key() string {
+	switch a.Type {
+	case fundingRateAlertTypeExtreme:
+		return fmt.Sprintf("%s-%s-%s", a.Type, a.High.Venue, a.High.Symbol)
+	default:
+		return fmt.Sprintf("%s-%s", a.Type, a.Asset)
+	}
+}
+
+// storeFundingRates stores the funding rates; so their history can be read back with `GetFundingRateHistory`.
+func storeFundingRates(ctx context.Context, fundingRates []*FundingRateInfo, now time.Time) error {
+	var failed int
+	for _, fr := range fundingRates {
+		if err := storeFundingRate(ctx, &domain.FundingRate{
+			Venue:                 strings.ToLower(fr.Venue.String()),
+			Symbol:                fr.Symbol,
+			Asset:                 fr.Asset,
+			FundingRate:           fr.FundingRate,
+			FundingIntervalHours:  fr.FundingIntervalHours,
+			AnnualisedFundingRate: fr.AnnualisedFundingRate,
+			Timestamp:             now,
+		}); err != nil {
+			slog.Error(ctx, "Failed to store funding rate: %v %s: %v", fr.Venue, fr.Symbol, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return gerrors.FailedPrecondition("failed_to_store_funding_rates", map[string]string{
+			"failed": fmt.Sprintf("%d", failed),
+		})
+	}
+
+	return nil
+}
+
+// detectFundingRateAlerts detects extreme funding rates, & the widest spread between the funding rates of each asset
+// across venues.
+func detectFundingRateAlerts(fundingRates []*FundingRateInfo) []*fundingRateAlert {
+	var (
+		alerts  = []*fundingRateAlert{}
+		byAsset = map[string][]*FundingRateInfo{}
+	)
+	for _, fr := range fundingRates {
+		if math.Abs(fr.AnnualisedFundingRate) >= extremeAnnualisedFundingRate {
+			alerts = append(alerts, &fundingRateAlert{
+				Type:  fundingRateAlertTypeExtreme,
+				Asset: fr.Asset,
+				High:  fr,
+			})
+		}
+
+		if fr.Asset != "" {
+			byAsset[fr.Asset] = append(byAsset[fr.Asset], fr)
+		}
+	}
+
+	for asset, frs := range byAsset {
+		if len(frs) < 2 {
+			continue
+		}
+
+		high, low := frs[0], frs[0]
+		for _, fr := range frs[1:] {
+			if fr.AnnualisedFundingRate > high.AnnualisedFundingRate {
+				high = fr
+			}
+			if fr.AnnualisedFundingRate < low.AnnualisedFundingRate {
+				low = fr
+			}
+		}
+
+		if high.AnnualisedFundingRate-low.AnnualisedFundingRate < fundingRateSpreadThreshold {
+			continue
+		}
+
+		alerts = append(alerts, &fundingRateAlert{
+			Type:  fundingRateAlertTypeSpread,
+			Asset: asset,
+			High:  high,
+			Low:   low,
+		})
+	}
+
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].key() < alerts[j].key()
+	})
+
+	return alerts
+}
+
+// checkFundingRates alerts on any extreme funding rates or cross venue spreads that aren't cooling down from a
+// previous alert.
+func checkFundingRates(ctx context.Context, fundingRates []*FundingRateInfo, now time.Time) error {
+	if fundingRateAlertsChannelID == "" {
+		return gerrors.FailedPrecondition("failed_to_check_funding_rates.no_alerts_channel", nil)
+	}
+
+	var failed int
+	for _, alert := range detectFundingRateAlerts(fundingRates) {
+		key := alert.key()
+		if !fundingRateCooldowns.tryAcquire(key, now, fundingRateAlertCooldown) {
+			continue
+		}
+
+		if err := publishFundingRateAlert(ctx, alert, now); err != nil {
+			// Let the next run try again.
+			fundingRateCooldowns.release(key)
+			slog.Error(ctx, "Failed to publish funding rate alert: %s: %v", key, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return gerrors.FailedPrecondition("failed_to_publish_funding_rate_alerts", map[string]string{
+			"failed": fmt.Sprintf("%d", failed),
+		})
+	}
+
+	return nil
+}
+
+func publishFundingRateAlertToDiscord(ctx context.Context, alert *fundingRateAlert, now time.Time) error {
+	// Idempotent on the alert & the cooldown period; so restarts don't alert the same funding rates twice.
+	idempotencyKey := fmt.Sprintf("fundingratealert-%s-%s", alert.key(), now.Truncate(fundingRateAlertCooldown))
+	if _, err := (&discordproto.SendMsgToChannelRequest{
+		Content:        formatFundingRateAlertContent(alert, now),
+		ChannelId:      fundingRateAlertsChannelID,
+		SenderId:       marketdataproto.MarketDataSystemActor,
+		IdempotencyKey: idempotencyKey,
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_publish_msg_to_discord", map[string]string{
+			"idempotency_key": idempotencyKey,
+			"channel_id":      fundingRateAlertsChannelID,
+		})
+	}
+
+	return nil
+}
+
+func formatFundingRateAlertContent(alert *fundingRateAlert, now time.Time) string {
+	switch alert.Type {
+	case fundingRateAlertTypeSpread:
+		header := fmt.Sprintf(":robot:     `Funding Rate Spread: %s`    :scales:", alert.Asset)
+		content := `
+
+ASSET:              %s
+SHORT:              %s %s @ %.4f%% (%.2f%% APR)
+LONG:               %s %s @ %.4f%% (%.2f%% APR)
+SPREAD:             %.2f%% APR
+TRIGGER:            %.2f%% APR
+TIMESTAMP:          %v
+`
+		return fmt.Sprintf("%s```%s```", header, fmt.Sprintf(
+			content,
+			alert.Asset,
+			strings.ToTitle(alert.High.Venue.String()), alert.High.Symbol, alert.High.FundingRate, alert.High.AnnualisedFundingRate,
+			strings.ToTitle(alert.Low.Venue.String()), alert.Low.Symbol, alert.Low.FundingRate, alert.Low.AnnualisedFundingRate,
+			alert.High.AnnualisedFundingRate-alert.Low.AnnualisedFundingRate,
+			fundingRateSpreadThreshold,
+			now,
+		))
+	default:
+		emoji := ":red_circle:"
+		if alert.High.AnnualisedFundingRate < 0 {
+			emoji = ":green_circle:"
+		}
+
+		header := fmt.Sprintf(":robot:     `Extreme Funding Rate: %s`    %s", alert.Asset, emoji)
+		content := `
+
+VENUE:              %s
+SYMBOL:             %s
+FUNDING RATE:       %.4f%% every %dh
+ANNUALISED:         %.2f%% APR
+TRIGGER:            %.2f%% APR
+TIMESTAMP:          %v
+`
+		return fmt.Sprintf("%s```%s```", header, fmt.Sprintf(
+			content,
+			strings.ToTitle(alert.High.Venue.String()),
+			alert.High.Symbol,
+			alert.High.FundingRate, alert.High.FundingIntervalHours,
+			alert.High.AnnualisedFundingRate,
+			extremeAnnualisedFundingRate,
+			now,
+		))
+	}
+}
@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/assets"
+	"swallowtail/s.market-data/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func fundingRateInfo(venue tradeengineproto.VENUE, symbol, asset string, fundingRate float64) *FundingRateInfo {
+	return &FundingRateInfo{
+		Venue:                 venue,
+		Symbol:                symbol,
+		Asset:                 asset,
+		FundingRate:           fundingRate,
+		FundingIntervalHours:  assets.GetFundingRateCoefficientByVenue(venue).FundingIntervalHours,
+		AnnualisedFundingRate: assets.AnnualisedFundingRate(venue, fundingRate),
+	}
+}
+
+func TestAnnualisedFundingRate(t *testing.T) {
+	t.Parallel()
+
+	// 0.01% every 8 hours & 0.00125% every hour are the same annualised rate.
+	assert.InDelta(t, 10.95, assets.AnnualisedFundingRate(tradeengineproto.VENUE_BINANCE, 0.01), 1e-6)
+	assert.InDelta(t, 10.95, assets.AnnualisedFundingRate(tradeengineproto.VENUE_FTX, 0.00125), 1e-6)
+}
+
+func TestDetectFundingRateAlerts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		fundingRates []*FundingRateInfo
+		expectedKeys []string
+	}{
+		{
+			name: "no_alerts",
+			fundingRates: []*FundingRateInfo{
+				fundingRateInfo(tradeengineproto.VENUE_BINANCE, "BTCUSDT", "BTC", 0.01),
+				fundingRateInfo(tradeengineproto.VENUE_FTX, "BTC-PERP", "BTC", 0.001),
+			},
+			expectedKeys: []string{},
+		},
+		{
+			name: "spread_across_venues",
+			fundingRates: []*FundingRateInfo{
+				// ~44% APR against ~-9% APR.
+				fundingRateInfo(tradeengineproto.VENUE_BINANCE, "BTCUSDT", "BTC", 0.04),
+				fundingRateInfo(tradeengineproto.VENUE_FTX, "BTC-PERP", "BTC", -0.001),
+				fundingRateInfo(tradeengineproto.VENUE_BITFINEX, "tBTCF0:USTF0", "BTC", 0.01),
+				fundingRateInfo(tradeengineproto.VENUE_BINANCE, "ETHUSDT", "ETH", 0.01),
+			},
+			expectedKeys: []string{"spread-BTC"},
+		},
+		{
+			name: "extreme_rate",
+			fundingRates: []*FundingRateInfo{
+				// ~-110% APR; a single venue can't have a spread.
+				fundingRateInfo(tradeengineproto.VENUE_FTX, "LUNA-PERP", "LUNA", -0.0125),
+			},
+			expectedKeys: []string{"extreme-FTX-LUNA-PERP"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys := []string{}
+			for _, alert := range detectFundingRateAlerts(tt.fundingRates) {
+				keys = append(keys, alert.key())
+			}
+
+			assert.Equal(t, tt.expectedKeys, keys)
+		})
+	}
+
+	// The venue with the higher funding rate is the venue to short.
+	alerts := detectFundingRateAlerts([]*FundingRateInfo{
+		fundingRateInfo(tradeengineproto.VENUE_FTX, "BTC-PERP", "BTC", -0.001),
+		fundingRateInfo(tradeengineproto.VENUE_BINANCE, "BTCUSDT", "BTC", 0.04),
+	})
+	require.Len(t, alerts, 1)
+	assert.Equal(t, "BTCUSDT", alerts[0].High.Symbol)
+	assert.Equal(t, "BTC-PERP", alerts[0].Low.Symbol)
+}
+
+func TestCheckFundingRates(t *testing.T) {
+	originalPublish, originalCooldowns, originalChannelID := publishFundingRateAlert, fundingRateCooldowns, fundingRateAlertsChannelID
+	t.Cleanup(func() {
+		publishFundingRateAlert, fundingRateCooldowns, fundingRateAlertsChannelID = originalPublish, originalCooldowns, originalChannelID
+	})
+
+	var (
+		alerts  int
+		failing bool
+	)
+	publishFundingRateAlert = func(ctx context.Context, alert *fundingRateAlert, now time.Time) error {
+		if failing {
+			return gerrors.FailedPrecondition("discord_unavailable", nil)
+		}
+		alerts++
+		return nil
+	}
+	fundingRateCooldowns = newAlertCooldowns()
+
+	var (
+		ctx          = context.Background()
+		now          = time.Now().UTC()
+		fundingRates = []*FundingRateInfo{
+			fundingRateInfo(tradeengineproto.VENUE_BINANCE, "BTCUSDT", "BTC", 0.04),
+			fundingRateInfo(tradeengineproto.VENUE_FTX, "BTC-PERP", "BTC", -0.001),
+		}
+	)
+
+	// Without an alerts channel we don't alert at all.
+	fundingRateAlertsChannelID = ""
+	gerrors.AssertIs(t, checkFundingRates(ctx, fundingRates, now), gerrors.ErrFailedPrecondition, "failed_to_check_funding_rates.no_alerts_channel")
+	assert.Equal(t, 0, alerts)
+
+	fundingRateAlertsChannelID = "funding-rate-alerts-channel-id"
+
+	failing = true
+	require.Error(t, checkFundingRates(ctx, fundingRates, now))
+
+	// A failed alert doesn't start the cooldown.
+	failing = false
+	require.NoError(t, checkFundingRates(ctx, fundingRates, now))
+	assert.Equal(t, 1, alerts)
+
+	// The same spread an hour later is still cooling down.
+	require.NoError(t, checkFundingRates(ctx, fundingRates, now.Add(time.Hour)))
+	assert.Equal(t, 1, alerts)
+
+	require.NoError(t, checkFundingRates(ctx, fundingRates, now.Add(fundingRateAlertCooldown)))
+	assert.Equal(t, 2, alerts)
+}
+
+func TestStoreFundingRates(t *testing.T) {
+	originalStore := storeFundingRate
+	t.Cleanup(func() {
+		storeFundingRate = originalStore
+	})
+
+	stored := []*domain.FundingRate{}
+	storeFundingRate = func(ctx context.Context, fundingRate *domain.FundingRate) error {
+		stored = append(stored, fundingRate)
+		return nil
+	}
+
+	now := time.Now().UTC().Truncate(time.Hour)
+	require.NoError(t, storeFundingRates(context.Background(), []*FundingRateInfo{
+		fundingRateInfo(tradeengineproto.VENUE_FTX, "BTC-PERP", "BTC", 0.001),
+	}, now))
+
+	require.Len(t, stored, 1)
+	assert.Equal(t, "ftx", stored[0].Venue)
+	assert.Equal(t, "BTC", stored[0].Asset)
+	assert.Equal(t, 1, stored[0].FundingIntervalHours)
+	assert.InDelta(t, 8.76, stored[0].AnnualisedFundingRate, 1e-6)
+	assert.Equal(t, now, stored[0].Timestamp)
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/dao"
+	"swallowtail/s.market-data/marshaling"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+const (
+	defaultFundingRatesLimit = 500
+	maxFundingRatesLimit     = 2000
+)
+
+// GetFundingRateHistory lists the stored funding rates of an asset; so funding rate trends can be compared across
+// venues.
+func (s *MarketDataService) GetFundingRateHistory(
+	ctx context.Context, in *marketdataproto.GetFundingRateHistoryRequest,
+) (*marketdataproto.GetFundingRateHistoryResponse, error) {
+	venue := strings.ToLower(in.Venue)
+
+	switch {
+	case in.Asset == "":
+		return nil, gerrors.BadParam("missing_param.asset", nil)
+	case venue != "" && !isFundingRateVenue(venue):
+		return nil, gerrors.BadParam("bad_param.invalid_venue", map[string]string{
+			"venue": in.Venue,
+		})
+	case in.Limit < 0 || in.Limit > maxFundingRatesLimit:
+		return nil, gerrors.BadParam("bad_param.invalid_limit", map[string]string{
+			"limit": strconv.Itoa(int(in.Limit)),
+		})
+	}
+
+	limit := defaultFundingRatesLimit
+	if in.Limit > 0 {
+		limit = int(in.Limit)
+	}
+
+	to := time.Now().UTC()
+	if in.To != nil {
+		to = in.To.AsTime()
+	}
+
+	asset := strings.ToUpper(in.Asset)
+
+	errParams := map[string]string{
+		"asset": asset,
+		"venue": venue,
+		"to":    to.String(),
+		"limit": strconv.Itoa(limit),
+	}
+
+	// Without a start time we list the most recent funding rates.
+	if in.From == nil {
+		fundingRates, err := dao.ListLatestFundingRates(ctx, asset, venue, to, limit)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_get_funding_rate_history", errParams)
+		}
+
+		return &marketdataproto.GetFundingRateHistoryResponse{
+			FundingRates: marshaling.FundingRatesDomainToProtos(fundingRates),
+		}, nil
+	}
+
+	from := in.From.AsTime()
+	errParams["from"] = from.String()
+
+	if !from.Before(to) {
+		return nil, gerrors.BadParam("bad_param.from_must_be_before_to", errParams)
+	}
+
+	fundingRates, err := dao.ListFundingRates(ctx, asset, venue, from, to, limit)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_get_funding_rate_history", errParams)
+	}
+
+	return &marketdataproto.GetFundingRateHistoryResponse{
+		FundingRates: marshaling.FundingRatesDomainToProtos(fundingRates),
+	}, nil
+}
+
+func isFundingRateVenue(venue string) bool {
+	for _, v := range venues {
+		if strings.ToLower(v.String()) == venue {
+			return true
+		}
+	}
+
+	return false
+}
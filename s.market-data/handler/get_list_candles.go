@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/candles"
+	"swallowtail/s.market-data/dao"
+	"swallowtail/s.market-data/marshaling"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+const (
+	defaultCandlesLimit = 500
+	maxCandlesLimit     = 1500
+)
+
+// ListCandles lists the stored candles of a Binance symbol, so history can be read without hitting exchange rate limits.
+func (s *MarketDataService) ListCandles(
+	ctx context.Context, in *marketdataproto.ListCandlesRequest,
+) (*marketdataproto.ListCandlesResponse, error) {
+	_, isValidInterval := candles.IntervalDuration(in.Interval)
+
+	switch {
+	case in.Symbol == "":
+		return nil, gerrors.BadParam("missing_param.symbol", nil)
+	case in.Interval == "":
+		return nil, gerrors.BadParam("missing_param.interval", nil)
+	case !isValidInterval:
+		return nil, gerrors.BadParam("bad_param.invalid_interval", map[string]string{
+			"interval": in.Interval,
+		})
+	case in.Limit < 0 || in.Limit > maxCandlesLimit:
+		return nil, gerrors.BadParam("bad_param.invalid_limit", map[string]string{
+			"limit": strconv.Itoa(int(in.Limit)),
+		})
+	}
+
+	limit := defaultCandlesLimit
+	if in.Limit > 0 {
+		limit = int(in.Limit)
+	}
+
+	to := time.Now().UTC()
+	if in.To != nil {
+		to = in.To.AsTime()
+	}
+
+	symbol := strings.ToUpper(in.Symbol)
+
+	errParams := map[string]string{
+		"symbol":   symbol,
+		"interval": in.Interval,
+		"to":       to.String(),
+		"limit":    strconv.Itoa(limit),
+	}
+
+	// Without a start time we list the most recent candles.
+	if in.From == nil {
+		storedCandles, err := dao.ListLatestCandles(ctx, symbol, in.Interval, to, limit)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_list_candles", errParams)
+		}
+
+		return &marketdataproto.ListCandlesResponse{
+			Candles: marshaling.CandlesDomainToProtos(storedCandles),
+		}, nil
+	}
+
+	from := in.From.AsTime()
+	errParams["from"] = from.String()
+
+	if !from.Before(to) {
+		return nil, gerrors.BadParam("bad_param.from_must_be_before_to", errParams)
+	}
+
+	storedCandles, err := dao.ListCandles(ctx, symbol, in.Interval, from, to, limit)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_candles", errParams)
+	}
+
+	return &marketdataproto.ListCandlesResponse{
+		Candles: marshaling.CandlesDomainToProtos(storedCandles),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/dao"
+	"swallowtail/s.market-data/marshaling"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// ListPriceAlerts lists the price alerts of a user; active alerts first, then the most recent.
+func (s *MarketDataService) ListPriceAlerts(
+	ctx context.Context, in *marketdataproto.ListPriceAlertsRequest,
+) (*marketdataproto.ListPriceAlertsResponse, error) {
+	switch {
+	case in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	}
+
+	alerts, err := dao.ListPriceAlertsByUserID(ctx, in.UserId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_price_alerts", map[string]string{
+			"user_id": in.UserId,
+		})
+	}
+
+	return &marketdataproto.ListPriceAlertsResponse{
+		Alerts: marshaling.PriceAlertsDomainToProtos(alerts),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/dao"
+	"swallowtail/s.market-data/marshaling"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// ListWatchlistAssets lists the assets on a watchlist; either a global watchlist, or the personal watchlist of a user.
+func (s *MarketDataService) ListWatchlistAssets(
+	ctx context.Context, in *marketdataproto.ListWatchlistAssetsRequest,
+) (*marketdataproto.ListWatchlistAssetsResponse, error) {
+	switch {
+	case in.Watchlist == "":
+		return nil, gerrors.BadParam("missing_param.watchlist", nil)
+	}
+
+	errParams := map[string]string{
+		"watchlist": in.Watchlist,
+		"owner_id":  in.OwnerId,
+	}
+
+	watchlistAssets, err := dao.ListWatchlistAssets(ctx, strings.ToLower(in.Watchlist), in.OwnerId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_watchlist_assets", errParams)
+	}
+
+	return &marketdataproto.ListWatchlistAssetsResponse{
+		Assets: marshaling.WatchlistAssetsDomainToProtos(watchlistAssets),
+	}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"math/rand"
 	"strings"
 	"time"
+
+	marketdataproto "swallowtail/s.market-data/proto"
 )
 
 func jitter(min, max int) time.Duration {
@@ -25,3 +27,14 @@ func addPadding(howMuch int) string {
 
 	return strings.Repeat(" ", howMuch)
 }
+
+func isActorValid(actorID string) bool {
+	switch actorID {
+	case marketdataproto.MarketDataActorSatoshiSystem,
+		marketdataproto.MarketDataActorSatoshiCommand,
+		marketdataproto.MarketDataActorManual:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/domain"
+	"swallowtail/s.market-data/pricealerts"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+const (
+	// maxActivePriceAlerts is the most active price alerts a user can have; each symbol alerted on is streamed.
+	maxActivePriceAlerts = 25
+
+	maxMovePercentage = 100
+)
+
+// normalizePriceAlert validates the price alert against its condition, normalizing the case of its asset & clearing the
+// fields its condition doesn't use.
+func normalizePriceAlert(alert *domain.PriceAlert) (*domain.PriceAlert, error) {
+	a := *alert
+	a.Symbol = strings.ToLower(a.Symbol)
+	a.AssetPair = strings.ToLower(a.AssetPair)
+	a.Condition = strings.ToLower(a.Condition)
+
+	errParams := map[string]string{
+		"user_id":    a.UserID,
+		"symbol":     a.Symbol,
+		"asset_pair": a.AssetPair,
+		"condition":  a.Condition,
+	}
+
+	switch {
+	case a.UserID == "":
+		return nil, gerrors.BadParam("missing_param.user_id", errParams)
+	case a.Symbol == "":
+		return nil, gerrors.BadParam("missing_param.symbol", errParams)
+	case a.AssetPair == "":
+		return nil, gerrors.BadParam("missing_param.asset_pair", errParams)
+	}
+
+	switch a.Condition {
+	case marketdataproto.PriceAlertConditionAbove, marketdataproto.PriceAlertConditionBelow:
+		if a.TargetPrice <= 0 {
+			errParams["target_price"] = strconv.FormatFloat(a.TargetPrice, 'f', -1, 64)
+			return nil, gerrors.BadParam("bad_param.invalid_target_price", errParams)
+		}
+
+		a.MovePercentage, a.WindowMinutes = 0, 0
+	case marketdataproto.PriceAlertConditionMove:
+		switch {
+		case a.MovePercentage <= 0 || a.MovePercentage > maxMovePercentage:
+			errParams["move_percentage"] = strconv.FormatFloat(a.MovePercentage, 'f', -1, 64)
+			return nil, gerrors.BadParam("bad_param.invalid_move_percentage", errParams)
+		case a.WindowMinutes < 1 || time.Duration(a.WindowMinutes)*time.Minute > pricealerts.MaxWindow:
+			errParams["window_minutes"] = strconv.Itoa(a.WindowMinutes)
+			return nil, gerrors.BadParam("bad_param.invalid_window", errParams)
+		}
+
+		a.TargetPrice = 0
+	default:
+		return nil, gerrors.BadParam("bad_param.invalid_condition", errParams)
+	}
+
+	return &a, nil
+}
+
+// isTargetPriceCrossed returns true if the price has already crossed the target price of the alert; such an alert would
+// trigger straight away.
+func isTargetPriceCrossed(alert *domain.PriceAlert, price float64) bool {
+	switch alert.Condition {
+	case marketdataproto.PriceAlertConditionAbove:
+		return price >= alert.TargetPrice
+	case marketdataproto.PriceAlertConditionBelow:
+		return price <= alert.TargetPrice
+	default:
+		return false
+	}
+}
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+func TestNormalizePriceAlert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		alert         *domain.PriceAlert
+		expectedAlert *domain.PriceAlert
+		expectedError string
+	}{
+		{
+			name: "above",
+			alert: &domain.PriceAlert{
+				UserID:         "user-1",
+				Symbol:         "BTC",
+				AssetPair:      "USDT",
+				Condition:      "Above",
+				TargetPrice:    70000,
+				MovePercentage: 5,
+				WindowMinutes:  60,
+			},
+			expectedAlert: &domain.PriceAlert{
+				UserID:      "user-1",
+				Symbol:      "btc",
+				AssetPair:   "usdt",
+				Condition:   marketdataproto.PriceAlertConditionAbove,
+				TargetPrice: 70000,
+			},
+		},
+		{
+			name: "below_missing_target_price",
+			alert: &domain.PriceAlert{
+				UserID:    "user-1",
+				Symbol:    "btc",
+				AssetPair: "usdt",
+				Condition: marketdataproto.PriceAlertConditionBelow,
+			},
+			expectedError: "bad_param.invalid_target_price",
+		},
+		{
+			name: "move",
+			alert: &domain.PriceAlert{
+				UserID:         "user-1",
+				Symbol:         "sol",
+				AssetPair:      "usd",
+				Condition:      marketdataproto.PriceAlertConditionMove,
+				TargetPrice:    200,
+				MovePercentage: 5,
+				WindowMinutes:  60,
+				IsRecurring:    true,
+			},
+			expectedAlert: &domain.PriceAlert{
+				UserID:         "user-1",
+				Symbol:         "sol",
+				AssetPair:      "usd",
+				Condition:      marketdataproto.PriceAlertConditionMove,
+				MovePercentage: 5,
+				WindowMinutes:  60,
+				IsRecurring:    true,
+			},
+		},
+		{
+			name: "move_window_too_long",
+			alert: &domain.PriceAlert{
+				UserID:         "user-1",
+				Symbol:         "sol",
+				AssetPair:      "usd",
+				Condition:      marketdataproto.PriceAlertConditionMove,
+				MovePercentage: 5,
+				WindowMinutes:  24 * 60,
+			},
+			expectedError: "bad_param.invalid_window",
+		},
+		{
+			name: "move_invalid_percentage",
+			alert: &domain.PriceAlert{
+				UserID:         "user-1",
+				Symbol:         "sol",
+				AssetPair:      "usd",
+				Condition:      marketdataproto.PriceAlertConditionMove,
+				MovePercentage: -5,
+				WindowMinutes:  60,
+			},
+			expectedError: "bad_param.invalid_move_percentage",
+		},
+		{
+			name: "invalid_condition",
+			alert: &domain.PriceAlert{
+				UserID:    "user-1",
+				Symbol:    "sol",
+				AssetPair: "usd",
+				Condition: "crosses",
+			},
+			expectedError: "bad_param.invalid_condition",
+		},
+		{
+			name: "missing_asset_pair",
+			alert: &domain.PriceAlert{
+				UserID:      "user-1",
+				Symbol:      "sol",
+				Condition:   marketdataproto.PriceAlertConditionAbove,
+				TargetPrice: 200,
+			},
+			expectedError: "missing_param.asset_pair",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			alert, err := normalizePriceAlert(tt.alert)
+			if tt.expectedError != "" {
+				gerrors.AssertIs(t, err, gerrors.ErrBadParam, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAlert, alert)
+		})
+	}
+}
+
+func TestCreatePriceAlert(t *testing.T) {
+	originalReadAccount, originalValidatePager, originalList, originalRead, originalCreate := readAccount, validatePager, listUserPriceAlerts, readLatestPrice, createPriceAlert
+	t.Cleanup(func() {
+		readAccount, validatePager, listUserPriceAlerts, readLatestPrice, createPriceAlert = originalReadAccount, originalValidatePager, originalList, originalRead, originalCreate
+	})
+
+	readLatestPrice = func(ctx context.Context, symbol string) (float64, error) {
+		assert.Equal(t, "BTCUSDT", symbol)
+		return 60000, nil
+	}
+	createPriceAlert = func(ctx context.Context, alert *domain.PriceAlert) (*domain.PriceAlert, error) {
+		a := *alert
+		a.AlertID = "alert-1"
+		a.Status = marketdataproto.PriceAlertStatusActive
+		return &a, nil
+	}
+
+	var (
+		hasAccount     bool
+		hasPager       bool
+		existingAlerts []*domain.PriceAlert
+	)
+	readAccount = func(ctx context.Context, userID string) error {
+		if !hasAccount {
+			return gerrors.NotFound("account_not_found", nil)
+		}
+		return nil
+	}
+	validatePager = func(ctx context.Context, userID string, isHighPriority bool) error {
+		if !hasPager {
+			return gerrors.FailedPrecondition("failed_to_get_identifier_from_account.phone_number", nil)
+		}
+		return nil
+	}
+	listUserPriceAlerts = func(ctx context.Context, userID string) ([]*domain.PriceAlert, error) {
+		return existingAlerts, nil
+	}
+
+	var (
+		ctx = context.Background()
+		req = func(condition string, targetPrice float64) *marketdataproto.CreatePriceAlertRequest {
+			return &marketdataproto.CreatePriceAlertRequest{
+				ActorId: marketdataproto.MarketDataActorSatoshiCommand,
+				Alert: &marketdataproto.PriceAlert{
+					UserId:      "user-1",
+					Symbol:      "btc",
+					AssetPair:   "usd",
+					Condition:   condition,
+					TargetPrice: targetPrice,
+				},
+			}
+		}
+	)
+
+	_, err := (&MarketDataService{}).CreatePriceAlert(ctx, req(marketdataproto.PriceAlertConditionAbove, 70000))
+	gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, "failed_to_create_price_alert.account_required")
+
+	hasAccount = true
+
+	_, err = (&MarketDataService{}).CreatePriceAlert(ctx, req(marketdataproto.PriceAlertConditionAbove, 70000))
+	gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, "failed_to_create_price_alert.pager_not_configured")
+
+	hasPager = true
+
+	// An alert that would trigger straight away.
+	_, err = (&MarketDataService{}).CreatePriceAlert(ctx, req(marketdataproto.PriceAlertConditionBelow, 65000))
+	gerrors.AssertIs(t, err, gerrors.ErrBadParam, "bad_param.target_price_already_crossed")
+
+	rsp, err := (&MarketDataService{}).CreatePriceAlert(ctx, req(marketdataproto.PriceAlertConditionBelow, 55000))
+	require.NoError(t, err)
+	assert.Equal(t, "alert-1", rsp.Alert.AlertId)
+	assert.Equal(t, marketdataproto.MarketDataActorSatoshiCommand, rsp.Alert.CreatedBy)
+	assert.Equal(t, float64(60000), rsp.CurrentPrice)
+
+	// Triggered alerts don't count towards the limit.
+	for i := 0; i < maxActivePriceAlerts; i++ {
+		existingAlerts = append(existingAlerts, &domain.PriceAlert{Status: marketdataproto.PriceAlertStatusTriggered})
+	}
+	_, err = (&MarketDataService{}).CreatePriceAlert(ctx, req(marketdataproto.PriceAlertConditionAbove, 70000))
+	require.NoError(t, err)
+
+	for i := 0; i < maxActivePriceAlerts; i++ {
+		existingAlerts = append(existingAlerts, &domain.PriceAlert{Status: marketdataproto.PriceAlertStatusActive})
+	}
+	_, err = (&MarketDataService{}).CreatePriceAlert(ctx, req(marketdataproto.PriceAlertConditionAbove, 70000))
+	gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, "failed_to_create_price_alert.too_many_active_alerts")
+}
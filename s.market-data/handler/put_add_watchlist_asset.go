@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/dao"
+	"swallowtail/s.market-data/marshaling"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// AddWatchlistAsset adds an asset to a watchlist; or updates it if it's already on the watchlist.
+func (s *MarketDataService) AddWatchlistAsset(
+	ctx context.Context, in *marketdataproto.AddWatchlistAssetRequest,
+) (*marketdataproto.AddWatchlistAssetResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case in.Asset == nil:
+		return nil, gerrors.BadParam("missing_param.asset", nil)
+	case in.Asset.Watchlist == "":
+		return nil, gerrors.BadParam("missing_param.watchlist", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_add_watchlist_asset.unauthorized", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id":  in.ActorId,
+		"watchlist": in.Asset.Watchlist,
+		"owner_id":  in.Asset.OwnerId,
+		"symbol":    in.Asset.Symbol,
+	}
+
+	asset, err := normalizeWatchlistAsset(marshaling.WatchlistAssetProtoToDomain(in.Asset))
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_add_watchlist_asset", errParams)
+	}
+
+	if asset.CreatedBy == "" {
+		asset.CreatedBy = in.ActorId
+	}
+
+	if err := dao.CreateWatchlistAsset(ctx, asset); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_add_watchlist_asset", errParams)
+	}
+
+	return &marketdataproto.AddWatchlistAssetResponse{
+		Asset: marshaling.WatchlistAssetDomainToProto(asset),
+	}, nil
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/dao"
+	"swallowtail/s.market-data/marshaling"
+	"swallowtail/s.market-data/pricealerts"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+var (
+	// Fakeable for testing.
+	readAccount         = readAccountFromAccountService
+	validatePager       = validatePagerViaAccountService
+	listUserPriceAlerts = dao.ListPriceAlertsByUserID
+	readLatestPrice     = pricealerts.ReadLatestPrice
+	createPriceAlert    = dao.CreatePriceAlert
+)
+
+// CreatePriceAlert creates a price alert that pages the user once the price of an asset crosses a level, or moves by a
+// percentage within a window.
+func (s *MarketDataService) CreatePriceAlert(
+	ctx context.Context, in *marketdataproto.CreatePriceAlertRequest,
+) (*marketdataproto.CreatePriceAlertResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case in.Alert == nil:
+		return nil, gerrors.BadParam("missing_param.alert", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_create_price_alert.unauthorized", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id":  in.ActorId,
+		"user_id":   in.Alert.UserId,
+		"symbol":    in.Alert.Symbol,
+		"condition": in.Alert.Condition,
+	}
+
+	alert, err := normalizePriceAlert(marshaling.PriceAlertProtoToDomain(in.Alert))
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_create_price_alert", errParams)
+	}
+
+	// Alerts are paged via the user's account; so they must have one.
+	if err := readAccount(ctx, alert.UserID); err != nil {
+		if gerrors.Is(err, gerrors.ErrNotFound) {
+			return nil, gerrors.FailedPrecondition("failed_to_create_price_alert.account_required", errParams)
+		}
+
+		return nil, gerrors.Augment(err, "failed_to_create_price_alert.read_account", errParams)
+	}
+
+	// & their pager must be configured; otherwise we can't page them once the alert triggers.
+	if err := validatePager(ctx, alert.UserID, alert.IsHighPriority); err != nil {
+		if gerrors.IsCode(err, gerrors.ErrUnavailable) || gerrors.IsCode(err, gerrors.ErrRateLimited) {
+			return nil, gerrors.Augment(err, "failed_to_create_price_alert.validate_pager", errParams)
+		}
+
+		errParams["is_high_priority"] = strconv.FormatBool(alert.IsHighPriority)
+		return nil, gerrors.FailedPrecondition("failed_to_create_price_alert.pager_not_configured", errParams)
+	}
+
+	existingAlerts, err := listUserPriceAlerts(ctx, alert.UserID)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_create_price_alert.list_existing_alerts", errParams)
+	}
+
+	var numberOfActiveAlerts int
+	for _, existing := range existingAlerts {
+		if existing.Status == marketdataproto.PriceAlertStatusActive {
+			numberOfActiveAlerts++
+		}
+	}
+
+	if numberOfActiveAlerts >= maxActivePriceAlerts {
+		errParams["number_of_active_alerts"] = strconv.Itoa(numberOfActiveAlerts)
+		return nil, gerrors.FailedPrecondition("failed_to_create_price_alert.too_many_active_alerts", errParams)
+	}
+
+	// Reading the latest price also checks Binance lists the symbol; we can't evaluate alerts of symbols it doesn't.
+	latestPrice, err := readLatestPrice(ctx, pricealerts.BinanceSymbol(alert))
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_create_price_alert.read_latest_price", errParams)
+	}
+
+	if isTargetPriceCrossed(alert, latestPrice) {
+		errParams["latest_price"] = strconv.FormatFloat(latestPrice, 'f', -1, 64)
+		return nil, gerrors.BadParam("bad_param.target_price_already_crossed", errParams)
+	}
+
+	if alert.CreatedBy == "" {
+		alert.CreatedBy = in.ActorId
+	}
+
+	alert, err = createPriceAlert(ctx, alert)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_create_price_alert", errParams)
+	}
+
+	return &marketdataproto.CreatePriceAlertResponse{
+		Alert:        marshaling.PriceAlertDomainToProto(alert),
+		CurrentPrice: latestPrice,
+	}, nil
+}
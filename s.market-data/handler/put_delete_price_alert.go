@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/dao"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// DeletePriceAlert deletes a price alert of a user.
+func (s *MarketDataService) DeletePriceAlert(
+	ctx context.Context, in *marketdataproto.DeletePriceAlertRequest,
+) (*marketdataproto.DeletePriceAlertResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	case in.AlertId == "":
+		return nil, gerrors.BadParam("missing_param.alert_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_delete_price_alert.unauthorized", nil)
+	}
+
+	alertID := strings.ToLower(in.AlertId)
+
+	errParams := map[string]string{
+		"actor_id": in.ActorId,
+		"user_id":  in.UserId,
+		"alert_id": alertID,
+	}
+
+	deleted, err := dao.DeletePriceAlert(ctx, alertID, in.UserId)
+	switch {
+	case err != nil:
+		return nil, gerrors.Augment(err, "failed_to_delete_price_alert", errParams)
+	case !deleted:
+		return nil, gerrors.NotFound("failed_to_delete_price_alert.alert_not_found", errParams)
+	}
+
+	return &marketdataproto.DeletePriceAlertResponse{}, nil
+}
@@ -9,13 +9,15 @@ import (
 
 	"github.com/monzo/slog"
 
+	"swallowtail/libraries/gerrors"
 	discordproto "swallowtail/s.discord/proto"
 	"swallowtail/s.market-data/assets"
 	marketdataproto "swallowtail/s.market-data/proto"
 )
 
 var (
-	athAssets            = assets.LatestPriceAssets
+	// listATHAssets is a package variable so the prices watchlist can be faked in tests.
+	listATHAssets        = assets.ListLatestPriceAssets
 	athTriggerPercentage = 0.025 // 2.5%
 )
 
@@ -25,8 +27,13 @@ func (s *MarketDataService) PublishATHInformation(
 ) (*marketdataproto.PublishATHInformationResponse, error) {
 	slog.Trace(ctx, "Market data publishing ATH information")
 
+	athAssets, err := listATHAssets(ctx)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_publish_ath_information", nil)
+	}
+
 	var wg sync.WaitGroup
-	for _, asset := range latestPriceAssets {
+	for _, asset := range athAssets {
 		asset := asset
 		wg.Add(1)
 
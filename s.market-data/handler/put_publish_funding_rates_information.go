@@ -18,7 +18,8 @@ import (
 )
 
 var (
-	fundingRatesAssets = assets.FundingRateAssets
+	// listFundingRatesAssets is a package variable so the funding rates watchlist can be faked in tests.
+	listFundingRatesAssets = assets.ListFundingRateAssets
 )
 
 var (
@@ -31,18 +32,27 @@ var (
 
 // FundingRateInfo ...
 type FundingRateInfo struct {
-	Venue           tradeengineproto.VENUE
-	Symbol          string
-	HumanizedSymbol string
-	FundingRate     float64
+	Venue                 tradeengineproto.VENUE
+	Symbol                string
+	HumanizedSymbol       string
+	Asset                 string
+	FundingRate           float64
+	FundingIntervalHours  int
+	AnnualisedFundingRate float64
 }
 
-// PublishFundingRatesInformation ...
+// PublishFundingRatesInformation publishes the current funding rates of each venue, stores them for
+// `GetFundingRateHistory` & alerts on any extreme funding rates or cross venue spreads.
 func (s *MarketDataService) PublishFundingRatesInformation(
 	ctx context.Context, in *marketdataproto.PublishFundingRatesInformationRequest,
 ) (*marketdataproto.PublishFundingRatesInformationResponse, error) {
 	slog.Trace(ctx, "Market data publishing funding rates information")
 
+	fundingRatesAssets, err := listFundingRatesAssets(ctx)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_publish_funding_rate_information", nil)
+	}
+
 	var (
 		fundingRates = make([]*FundingRateInfo, 0, len(fundingRatesAssets))
 		wg           sync.WaitGroup
@@ -75,16 +85,29 @@ func (s *MarketDataService) PublishFundingRatesInformation(
 			mu.Lock()
 			defer mu.Unlock()
 			fundingRates = append(fundingRates, &FundingRateInfo{
-				Venue:           asset.Venue,
-				Symbol:          asset.Symbol,
-				HumanizedSymbol: asset.HumanizedSymbol,
-				FundingRate:     fundingRate * 100,
+				Venue:                 asset.Venue,
+				Symbol:                asset.Symbol,
+				HumanizedSymbol:       asset.HumanizedSymbol,
+				Asset:                 asset.Asset,
+				FundingRate:           fundingRate * 100,
+				FundingIntervalHours:  assets.GetFundingRateCoefficientByVenue(asset.Venue).FundingIntervalHours,
+				AnnualisedFundingRate: assets.AnnualisedFundingRate(asset.Venue, fundingRate*100),
 			})
 		}()
 	}
 
 	wg.Wait()
 
+	now := time.Now().UTC().Truncate(time.Hour)
+
+	// Best effort; we still want to publish the funding rates if we fail to store or alert on them.
+	if err := storeFundingRates(ctx, fundingRates, now); err != nil {
+		slog.Error(ctx, "Failed to store funding rates: %v", err)
+	}
+	if err := checkFundingRates(ctx, fundingRates, now); err != nil {
+		slog.Error(ctx, "Failed to check funding rates: %v", err)
+	}
+
 	sort.Slice(fundingRates, func(i, j int) bool {
 		fi, fj := fundingRates[i], fundingRates[j]
 
@@ -132,8 +155,6 @@ func (s *MarketDataService) PublishFundingRatesInformation(
 		}
 	}
 
-	now := time.Now().UTC().Truncate(time.Hour)
-
 	var sb strings.Builder
 	sb.WriteString(fmt.Sprintf(":robot:    `Market Data: Hourly Funding Rates [%v]`    :orangutan:\n", now))
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/monzo/slog"
 
+	"swallowtail/libraries/gerrors"
 	"swallowtail/libraries/util"
 	discordproto "swallowtail/s.discord/proto"
 	"swallowtail/s.market-data/assets"
@@ -18,7 +19,8 @@ import (
 )
 
 var (
-	latestPriceAssets = assets.LatestPriceAssets
+	// listLatestPriceAssets is a package variable so the prices watchlist can be faked in tests.
+	listLatestPriceAssets = assets.ListLatestPriceAssets
 )
 
 // AssetInfo ...
@@ -54,6 +56,11 @@ func (s *MarketDataService) PublishLatestPriceInformation(
 ) (*marketdataproto.PublishLatestPriceInformationResponse, error) {
 	slog.Trace(ctx, "Market data publishing latest prices")
 
+	latestPriceAssets, err := listLatestPriceAssets(ctx)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_publish_latest_price_information", nil)
+	}
+
 	var (
 		assetInfo = make([]*AssetInfo, 0, len(latestPriceAssets))
 		wg        sync.WaitGroup
@@ -80,7 +87,7 @@ func (s *MarketDataService) PublishLatestPriceInformation(
 				AssetPair:                asset.AssetPair,
 				LatestPrice:              latestPrice,
 				PriceChangePercentage24h: change24h,
-				Group:                    asset.Grouping.String(),
+				Group:                    asset.Grouping,
 			})
 		}()
 	}
@@ -2,14 +2,136 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/monzo/slog"
 
 	"swallowtail/libraries/gerrors"
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.market-data/assets"
 	marketdataproto "swallowtail/s.market-data/proto"
 )
 
-// PublishVolatilityInformation ...
+var (
+	// listVolatilityAssets is a package variable so the prices watchlist can be faked in tests.
+	listVolatilityAssets = assets.ListLatestPriceAssets
+	// volatilityAlertCooldown is how long after alerting on an asset we stay quiet; so a single move doesn't trigger an
+	// alert every time it's measured.
+	volatilityAlertCooldown = time.Hour
+	volatilityCooldowns     = newAlertCooldowns()
+
+	// listClosePrices & publishVolatilityAlert are package variables so volatility alerts can be faked in tests.
+	listClosePrices        = listClosePricesFromBinance
+	publishVolatilityAlert = publishVolatilityAlertToDiscord
+)
+
+// PublishVolatilityInformation alerts discord of any asset that has moved by more than the trigger value of its
+// volatility rating within the last 15 minutes.
 func (s *MarketDataService) PublishVolatilityInformation(
 	ctx context.Context, in *marketdataproto.PublishVolatilityInformationRequest,
 ) (*marketdataproto.PublishVolatilityInformationResponse, error) {
-	return nil, gerrors.Unimplemented("publish volatility informationk unimplemented", nil)
+	slog.Trace(ctx, "Market data publishing volatility information")
+
+	volatilityAssets, err := listVolatilityAssets(ctx)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_publish_volatility_information", nil)
+	}
+
+	var wg sync.WaitGroup
+	for _, asset := range volatilityAssets {
+		asset := asset
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := checkVolatility(ctx, asset, time.Now().UTC()); err != nil {
+				slog.Warn(ctx, "Failed to check volatility of %s%s: %v", asset.Symbol, asset.AssetPair, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return &marketdataproto.PublishVolatilityInformationResponse{}, nil
+}
+
+func checkVolatility(ctx context.Context, asset *assets.AssetPair, now time.Time) error {
+	closePrices, err := listClosePrices(ctx, asset.BinanceSymbol(), volatilityWindowSize+1)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_check_volatility", nil)
+	}
+
+	v, err := measureVolatility(closePrices)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_check_volatility", nil)
+	}
+
+	if abs(v.Move) < asset.VolatilityRating.PercentageTriggerValue() {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s%s", asset.Symbol, asset.AssetPair)
+	if !volatilityCooldowns.tryAcquire(key, now, volatilityAlertCooldown) {
+		return nil
+	}
+
+	if err := publishVolatilityAlert(ctx, asset, v, now); err != nil {
+		// Let the next run try again.
+		volatilityCooldowns.release(key)
+		return gerrors.Augment(err, "failed_to_publish_volatility_alert", nil)
+	}
+
+	return nil
+}
+
+func publishVolatilityAlertToDiscord(ctx context.Context, asset *assets.AssetPair, v *volatility, now time.Time) error {
+	// Idempotent on the asset & the cooldown period; so restarts don't alert the same move twice.
+	idempotencyKey := fmt.Sprintf("volatilityinfo-%s-%s-%s", asset.Symbol, asset.AssetPair, now.Truncate(volatilityAlertCooldown))
+	if _, err := (&discordproto.SendMsgToChannelRequest{
+		Content:        formatVolatilityContent(asset, v, now),
+		ChannelId:      discordproto.DiscordSatoshiAlertsChannel,
+		SenderId:       marketdataproto.MarketDataSystemActor,
+		IdempotencyKey: idempotencyKey,
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_publish_msg_to_discord", map[string]string{
+			"idempotency_key": idempotencyKey,
+		})
+	}
+
+	return nil
+}
+
+func formatVolatilityContent(asset *assets.AssetPair, v *volatility, now time.Time) string {
+	emoji := ":chart_with_upwards_trend:"
+	if v.Move < 0 {
+		emoji = ":chart_with_downwards_trend:"
+	}
+
+	header := fmt.Sprintf(":robot:     `Volatility Alert: %s%s`    %s", strings.ToUpper(asset.Symbol), strings.ToUpper(asset.AssetPair), emoji)
+	content := `
+
+ASSET:              %s%s
+LATEST PRICE:       %.4f
+15M MOVE:           %+.2f%%
+1H REALISED VOL:    %.2f%%
+STD DEVS:           %.1f
+TRIGGER:            %.2f%%
+TIMESTAMP:          %v
+`
+	formattedContent := fmt.Sprintf(
+		content,
+		strings.ToUpper(asset.Symbol), strings.ToUpper(asset.AssetPair),
+		v.LatestPrice,
+		v.Move*100,
+		v.RealisedVolatility*100,
+		v.StdDevs,
+		asset.VolatilityRating.PercentageTriggerValue()*100,
+		now.Truncate(time.Minute),
+	)
+
+	return fmt.Sprintf("%s```%s```", header, formattedContent)
 }
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.market-data/assets"
+	"swallowtail/s.market-data/dao"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+var (
+	// Package variables so watchlist digests can be faked in tests.
+	listWatchlistOwners     = dao.ListWatchlistOwners
+	listPersonalPriceAssets = assets.ListPersonalPriceAssets
+	fetchLatestPrice        = fetchLatestPriceFromCoingecko
+	sendWatchlistDigest     = sendWatchlistDigestToDiscord
+)
+
+// PublishWatchlistDigests DMs every user with a personal prices watchlist the latest prices of their watchlist; at most
+// once per day (UTC).
+func (s *MarketDataService) PublishWatchlistDigests(
+	ctx context.Context, in *marketdataproto.PublishWatchlistDigestsRequest,
+) (*marketdataproto.PublishWatchlistDigestsResponse, error) {
+	slog.Trace(ctx, "Market data publishing watchlist digests")
+
+	ownerIDs, err := listWatchlistOwners(ctx, marketdataproto.WatchlistPrices)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_publish_watchlist_digests", nil)
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	var sent, failed int64
+	for _, ownerID := range ownerIDs {
+		if err := publishWatchlistDigest(ctx, ownerID, today); err != nil {
+			slog.Error(ctx, "Failed to publish watchlist digest to user: %s, Error: %v", ownerID, err)
+			failed++
+			continue
+		}
+
+		sent++
+	}
+
+	slog.Info(ctx, "Published watchlist digests: %d sent, %d failed", sent, failed)
+
+	return &marketdataproto.PublishWatchlistDigestsResponse{
+		NumberOfDigestsSent:   sent,
+		NumberOfDigestsFailed: failed,
+	}, nil
+}
+
+func publishWatchlistDigest(ctx context.Context, userID string, today time.Time) error {
+	priceAssets, err := listPersonalPriceAssets(ctx, userID)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_watchlist", nil)
+	}
+
+	var (
+		assetInfo = make([]*AssetInfo, 0, len(priceAssets))
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+	)
+	for _, asset := range priceAssets {
+		asset := asset
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			latestPrice, change24h, err := fetchLatestPrice(ctx, asset.Symbol, asset.AssetPair)
+			if err != nil {
+				slog.Warn(ctx, "Failed to fetch latest price for watchlist digest: %v: %s%s", err, asset.Symbol, asset.AssetPair)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			assetInfo = append(assetInfo, &AssetInfo{
+				Symbol:                   asset.Symbol,
+				AssetPair:                asset.AssetPair,
+				LatestPrice:              latestPrice,
+				PriceChangePercentage24h: change24h,
+				Group:                    asset.Grouping,
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	if len(assetInfo) == 0 {
+		return gerrors.FailedPrecondition("failed_to_fetch_latest_prices_of_watchlist", map[string]string{
+			"number_of_assets": fmt.Sprintf("%d", len(priceAssets)),
+		})
+	}
+
+	sort.Sort(AssetInfoList(assetInfo))
+
+	return sendWatchlistDigest(ctx, userID, formatWatchlistDigest(assetInfo), today)
+}
+
+func sendWatchlistDigestToDiscord(ctx context.Context, userID, digest string, today time.Time) error {
+	header := fmt.Sprintf(":sunrise: <@%s> Here's your daily watchlist digest; reply `!mywatchlist remove <symbol> <asset_pair>` to trim it.", userID)
+
+	idempotencyKey := fmt.Sprintf("watchlistdigest-%s-%s", userID, today.Format("2006-01-02"))
+	if _, err := (&discordproto.SendMsgToPrivateChannelRequest{
+		UserId:         userID,
+		SenderId:       marketdataproto.MarketDataSystemActor,
+		Content:        fmt.Sprintf("%s```%s```", header, digest),
+		IdempotencyKey: idempotencyKey,
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_send_watchlist_digest", map[string]string{
+			"idempotency_key": idempotencyKey,
+		})
+	}
+
+	return nil
+}
+
+// formatWatchlistDigest formats the latest prices of a watchlist; the assets should already be sorted.
+func formatWatchlistDigest(assetInfo []*AssetInfo) string {
+	var indent, priceIndent int
+	for _, asset := range assetInfo {
+		if l := len(asset.Symbol) + len(asset.AssetPair); l > indent {
+			indent = l
+		}
+		if l := len(fmt.Sprintf("%.3f", asset.LatestPrice)); l > priceIndent {
+			priceIndent = l
+		}
+	}
+
+	var sb strings.Builder
+	for _, asset := range assetInfo {
+		pair := fmt.Sprintf("%s%s", strings.ToUpper(asset.Symbol), strings.ToUpper(asset.AssetPair))
+		price := fmt.Sprintf("%.3f", asset.LatestPrice)
+
+		sb.WriteString(
+			fmt.Sprintf(
+				"%s:%s %s%s  24h: %+.2f%%\n",
+				pair,
+				addPadding(indent-len(pair)+1),
+				addPadding(priceIndent-len(price)),
+				price,
+				asset.PriceChangePercentage24h,
+			),
+		)
+	}
+
+	return sb.String()
+}
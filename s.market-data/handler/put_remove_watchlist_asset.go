@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/dao"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// RemoveWatchlistAsset removes an asset from a watchlist.
+func (s *MarketDataService) RemoveWatchlistAsset(
+	ctx context.Context, in *marketdataproto.RemoveWatchlistAssetRequest,
+) (*marketdataproto.RemoveWatchlistAssetResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case in.Watchlist == "":
+		return nil, gerrors.BadParam("missing_param.watchlist", nil)
+	case in.Symbol == "":
+		return nil, gerrors.BadParam("missing_param.symbol", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_remove_watchlist_asset.unauthorized", nil)
+	}
+
+	var (
+		watchlist = strings.ToLower(in.Watchlist)
+		symbol    = in.Symbol
+		assetPair = strings.ToLower(in.AssetPair)
+		venue     = strings.ToLower(in.Venue)
+	)
+
+	// Match the case the asset was added with; see `normalizeWatchlistAsset`.
+	switch watchlist {
+	case marketdataproto.WatchlistPrices, marketdataproto.WatchlistSolanaNFTs:
+		symbol = strings.ToLower(symbol)
+	}
+
+	errParams := map[string]string{
+		"actor_id":   in.ActorId,
+		"watchlist":  watchlist,
+		"owner_id":   in.OwnerId,
+		"symbol":     symbol,
+		"asset_pair": assetPair,
+		"venue":      venue,
+	}
+
+	removed, err := dao.DeleteWatchlistAsset(ctx, watchlist, in.OwnerId, symbol, assetPair, venue)
+	switch {
+	case err != nil:
+		return nil, gerrors.Augment(err, "failed_to_remove_watchlist_asset", errParams)
+	case !removed:
+		return nil, gerrors.NotFound("failed_to_remove_watchlist_asset.not_on_watchlist", errParams)
+	}
+
+	return &marketdataproto.RemoveWatchlistAssetResponse{}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/monzo/slog"
 
 	"swallowtail/libraries/gerrors"
+	accountproto "swallowtail/s.account/proto"
 	binanceproto "swallowtail/s.binance/proto"
 	bitfinexproto "swallowtail/s.bitfinex/proto"
 	coingeckoproto "swallowtail/s.coingecko/proto"
@@ -147,3 +148,58 @@ func getSolanaNFTFloorPrice(ctx context.Context, collectionID string, vendor sol
 
 	return rsp, nil
 }
+
+// listClosePricesFromBinance lists the most recent 1m close prices of the symbol, oldest first.
+func listClosePricesFromBinance(ctx context.Context, symbol string, limit int) ([]float64, error) {
+	rsp, err := (&binanceproto.ListKlinesRequest{
+		Symbol:   symbol,
+		Interval: "1m",
+		Limit:    int64(limit),
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_close_prices_from_binance", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	closePrices := make([]float64, 0, len(rsp.GetKlines()))
+	for _, k := range rsp.GetKlines() {
+		closePrices = append(closePrices, float64(k.ClosePrice))
+	}
+
+	return closePrices, nil
+}
+
+// readAccountFromAccountService checks the user has an account.
+// validatePagerViaAccountService validates the user can be paged with their pager of the given priority, without
+// paging them.
+func validatePagerViaAccountService(ctx context.Context, userID string, isHighPriority bool) error {
+	priority := accountproto.PagerPriority_LOW
+	if isHighPriority {
+		priority = accountproto.PagerPriority_HIGH
+	}
+
+	if _, err := (&accountproto.PageAccountRequest{
+		UserId:   userID,
+		Priority: priority,
+		DryRun:   true,
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_validate_pager", map[string]string{
+			"user_id": userID,
+		})
+	}
+
+	return nil
+}
+
+func readAccountFromAccountService(ctx context.Context, userID string) error {
+	if _, err := (&accountproto.ReadAccountRequest{
+		UserId: userID,
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_read_account", map[string]string{
+			"user_id": userID,
+		})
+	}
+
+	return nil
+}
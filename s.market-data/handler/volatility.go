@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/structures/window"
+)
+
+const (
+	// volatilityWindowSize is the number of 1m candles realised volatility is measured over.
+	volatilityWindowSize = 60
+	// suddenMoveWindowSize is the number of the most recent 1m candles a sudden move is measured over.
+	suddenMoveWindowSize = 15
+)
+
+// volatility of an asset, measured from its most recent 1m close prices.
+type volatility struct {
+	LatestPrice float64
+	// Move is the change in price over the last 15 minutes, as a fraction of the price.
+	Move float64
+	// RealisedVolatility is the standard deviation of the 1m log returns, scaled to the hour.
+	RealisedVolatility float64
+	// StdDevs is how many standard deviations of 15 minute returns the move is.
+	StdDevs float64
+}
+
+// measureVolatility measures the volatility of the close prices given, oldest first.
+func measureVolatility(closePrices []float64) (*volatility, error) {
+	if len(closePrices) <= suddenMoveWindowSize {
+		return nil, gerrors.FailedPrecondition("failed_to_measure_volatility.not_enough_prices", nil)
+	}
+
+	returns := window.NewMovingWindow(volatilityWindowSize)
+	for i := 1; i < len(closePrices); i++ {
+		if closePrices[i-1] <= 0 || closePrices[i] <= 0 {
+			return nil, gerrors.FailedPrecondition("failed_to_measure_volatility.invalid_price", nil)
+		}
+
+		if err := returns.Push(float32(math.Log(closePrices[i] / closePrices[i-1]))); err != nil {
+			return nil, gerrors.Augment(err, "failed_to_measure_volatility", nil)
+		}
+	}
+
+	var (
+		latest = closePrices[len(closePrices)-1]
+		before = closePrices[len(closePrices)-1-suddenMoveWindowSize]
+		stdDev = float64(returns.StdDev(1))
+	)
+
+	v := &volatility{
+		LatestPrice:        latest,
+		Move:               (latest - before) / before,
+		RealisedVolatility: stdDev * math.Sqrt(60),
+	}
+
+	// Returns scale with the square root of time.
+	if stdDev > 0 {
+		v.StdDevs = math.Log(latest/before) / (stdDev * math.Sqrt(suddenMoveWindowSize))
+	}
+
+	return v, nil
+}
+
+// alertCooldowns tracks when each key was last alerted on.
+type alertCooldowns struct {
+	mu        sync.Mutex
+	lastAlert map[string]time.Time
+}
+
+func newAlertCooldowns() *alertCooldowns {
+	return &alertCooldowns{
+		lastAlert: map[string]time.Time{},
+	}
+}
+
+// tryAcquire returns true, & starts the cooldown, if the key isn't cooling down from a previous alert.
+func (a *alertCooldowns) tryAcquire(key string, now time.Time, cooldown time.Duration) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if last, ok := a.lastAlert[key]; ok && now.Sub(last) < cooldown {
+		return false
+	}
+
+	a.lastAlert[key] = now
+	return true
+}
+
+// release ends the cooldown of the key early; i.e. if the alert failed to send.
+func (a *alertCooldowns) release(key string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.lastAlert, key)
+}
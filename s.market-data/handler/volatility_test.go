@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/assets"
+)
+
+// flatThenMove returns an hour of close prices flat at 100, oscillating slightly, then moving by the fraction given
+// over the last 15 minutes.
+func flatThenMove(move float64) []float64 {
+	closePrices := []float64{}
+	for i := 0; i <= volatilityWindowSize-suddenMoveWindowSize; i++ {
+		closePrices = append(closePrices, 100+float64(i%2)*0.05)
+	}
+
+	start := closePrices[len(closePrices)-1]
+	for i := 1; i <= suddenMoveWindowSize; i++ {
+		closePrices = append(closePrices, start*(1+move*float64(i)/suddenMoveWindowSize))
+	}
+
+	return closePrices
+}
+
+func TestMeasureVolatility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		closePrices   []float64
+		expectedMove  float64
+		expectedError bool
+	}{
+		{
+			name:         "sudden_move_up",
+			closePrices:  flatThenMove(0.05),
+			expectedMove: 0.05,
+		},
+		{
+			name:         "sudden_move_down",
+			closePrices:  flatThenMove(-0.03),
+			expectedMove: -0.03,
+		},
+		{
+			name:          "not_enough_prices",
+			closePrices:   []float64{100, 101},
+			expectedError: true,
+		},
+		{
+			name:          "invalid_price",
+			closePrices:   append(flatThenMove(0), 0),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := measureVolatility(tt.closePrices)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.InDelta(t, tt.expectedMove, v.Move, 1e-6)
+			assert.Greater(t, v.RealisedVolatility, 0.0)
+			// A steady trend after an hour of noise is an outsized move.
+			assert.Greater(t, abs(v.StdDevs), 2.0)
+		})
+	}
+}
+
+func TestCheckVolatility(t *testing.T) {
+	originalList, originalPublish, originalCooldowns := listClosePrices, publishVolatilityAlert, volatilityCooldowns
+	t.Cleanup(func() {
+		listClosePrices, publishVolatilityAlert, volatilityCooldowns = originalList, originalPublish, originalCooldowns
+	})
+
+	move := 0.02
+	listClosePrices = func(ctx context.Context, symbol string, limit int) ([]float64, error) {
+		assert.Equal(t, "ETHUSDT", symbol)
+		return flatThenMove(move), nil
+	}
+
+	var (
+		alerts  int
+		failing bool
+	)
+	publishVolatilityAlert = func(ctx context.Context, asset *assets.AssetPair, v *volatility, now time.Time) error {
+		if failing {
+			return gerrors.FailedPrecondition("discord_unavailable", nil)
+		}
+		alerts++
+		return nil
+	}
+	volatilityCooldowns = newAlertCooldowns()
+
+	var (
+		ctx   = context.Background()
+		asset = &assets.AssetPair{Symbol: "eth", AssetPair: "usd", VolatilityRating: assets.AssetVolatiltyRatingMedium}
+		now   = time.Now().UTC()
+	)
+
+	// Below the 2.5% trigger of a medium volatility asset.
+	require.NoError(t, checkVolatility(ctx, asset, now))
+	assert.Equal(t, 0, alerts)
+
+	move = -0.04
+	failing = true
+	require.Error(t, checkVolatility(ctx, asset, now))
+
+	// A failed alert doesn't start the cooldown.
+	failing = false
+	require.NoError(t, checkVolatility(ctx, asset, now))
+	assert.Equal(t, 1, alerts)
+
+	// The same move measured again within the cooldown isn't alerted twice.
+	require.NoError(t, checkVolatility(ctx, asset, now.Add(15*time.Minute)))
+	assert.Equal(t, 1, alerts)
+
+	require.NoError(t, checkVolatility(ctx, asset, now.Add(volatilityAlertCooldown)))
+	assert.Equal(t, 2, alerts)
+}
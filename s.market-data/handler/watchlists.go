@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/assets"
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// normalizeWatchlistAsset validates the asset against its watchlist, normalizing the case of its key & defaulting its
+// attributes; so the same asset can't be added twice in different cases.
+func normalizeWatchlistAsset(asset *domain.WatchlistAsset) (*domain.WatchlistAsset, error) {
+	a := *asset
+	a.Watchlist = strings.ToLower(a.Watchlist)
+	a.Venue = strings.ToLower(a.Venue)
+
+	errParams := map[string]string{
+		"watchlist": a.Watchlist,
+		"owner_id":  a.OwnerID,
+		"symbol":    a.Symbol,
+	}
+
+	if a.Symbol == "" {
+		return nil, gerrors.BadParam("missing_param.symbol", errParams)
+	}
+
+	switch a.Watchlist {
+	case marketdataproto.WatchlistPrices:
+		if a.AssetPair == "" {
+			return nil, gerrors.BadParam("missing_param.asset_pair", errParams)
+		}
+
+		rating := assets.AssetVolatiltyRatingLow
+		if a.VolatilityRating != "" {
+			r, ok := assets.ParseVolatilityRating(a.VolatilityRating)
+			if !ok {
+				errParams["volatility_rating"] = a.VolatilityRating
+				return nil, gerrors.BadParam("bad_param.invalid_volatility_rating", errParams)
+			}
+			rating = r
+		}
+
+		a.Symbol, a.AssetPair, a.Venue = strings.ToLower(a.Symbol), strings.ToLower(a.AssetPair), ""
+		a.VolatilityRating = rating.String()
+		// Groups are published as given, i.e `L1`; so they're kept in their own case.
+		if a.Grouping == "" {
+			a.Grouping = assets.DefaultAssetGroup
+		}
+	case marketdataproto.WatchlistFundingRates:
+		switch {
+		case a.OwnerID != "":
+			return nil, gerrors.BadParam("bad_param.funding_rates_watchlist_cannot_be_personal", errParams)
+		case a.Asset == "":
+			return nil, gerrors.BadParam("missing_param.asset", errParams)
+		}
+
+		if _, ok := assets.ParseFundingRateVenue(a.Venue); !ok {
+			errParams["venue"] = a.Venue
+			return nil, gerrors.BadParam("bad_param.invalid_funding_rate_venue", errParams)
+		}
+
+		// Venue symbols are case sensitive i.e `tBTCF0:USTF0`; so we leave them as they are.
+		a.Asset, a.AssetPair = strings.ToUpper(a.Asset), ""
+	case marketdataproto.WatchlistSolanaNFTs:
+		if a.OwnerID != "" {
+			return nil, gerrors.BadParam("bad_param.solana_nfts_watchlist_cannot_be_personal", errParams)
+		}
+
+		if _, ok := assets.ParseSolanaNFTVendor(a.Venue); !ok {
+			errParams["venue"] = a.Venue
+			return nil, gerrors.BadParam("bad_param.invalid_solana_nft_vendor", errParams)
+		}
+
+		a.Symbol, a.AssetPair = strings.ToLower(a.Symbol), ""
+	default:
+		return nil, gerrors.BadParam("bad_param.invalid_watchlist", errParams)
+	}
+
+	return &a, nil
+}
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/assets"
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+func TestNormalizeWatchlistAsset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		asset         *domain.WatchlistAsset
+		expectedAsset *domain.WatchlistAsset
+		expectedError string
+	}{
+		{
+			name: "prices",
+			asset: &domain.WatchlistAsset{
+				Watchlist:        "Prices",
+				Symbol:           "SOL",
+				AssetPair:        "USDT",
+				Grouping:         "L1",
+				VolatilityRating: "HIGH",
+			},
+			expectedAsset: &domain.WatchlistAsset{
+				Watchlist:        marketdataproto.WatchlistPrices,
+				Symbol:           "sol",
+				AssetPair:        "usdt",
+				Grouping:         "L1",
+				VolatilityRating: "high",
+			},
+		},
+		{
+			name: "prices_defaults",
+			asset: &domain.WatchlistAsset{
+				Watchlist: marketdataproto.WatchlistPrices,
+				OwnerID:   "user-1",
+				Symbol:    "btc",
+				AssetPair: "usd",
+			},
+			expectedAsset: &domain.WatchlistAsset{
+				Watchlist:        marketdataproto.WatchlistPrices,
+				OwnerID:          "user-1",
+				Symbol:           "btc",
+				AssetPair:        "usd",
+				Grouping:         assets.DefaultAssetGroup,
+				VolatilityRating: "low",
+			},
+		},
+		{
+			name: "prices_missing_asset_pair",
+			asset: &domain.WatchlistAsset{
+				Watchlist: marketdataproto.WatchlistPrices,
+				Symbol:    "btc",
+			},
+			expectedError: "missing_param.asset_pair",
+		},
+		{
+			name: "prices_invalid_volatility_rating",
+			asset: &domain.WatchlistAsset{
+				Watchlist:        marketdataproto.WatchlistPrices,
+				Symbol:           "btc",
+				AssetPair:        "usd",
+				VolatilityRating: "wild",
+			},
+			expectedError: "bad_param.invalid_volatility_rating",
+		},
+		{
+			name: "funding_rates",
+			asset: &domain.WatchlistAsset{
+				Watchlist: marketdataproto.WatchlistFundingRates,
+				Symbol:    "tBTCF0:USTF0",
+				Venue:     "Bitfinex",
+				Asset:     "btc",
+			},
+			expectedAsset: &domain.WatchlistAsset{
+				Watchlist: marketdataproto.WatchlistFundingRates,
+				Symbol:    "tBTCF0:USTF0",
+				Venue:     "bitfinex",
+				Asset:     "BTC",
+			},
+		},
+		{
+			name: "funding_rates_invalid_venue",
+			asset: &domain.WatchlistAsset{
+				Watchlist: marketdataproto.WatchlistFundingRates,
+				Symbol:    "BTC-PERPETUAL",
+				Venue:     "deribit",
+				Asset:     "btc",
+			},
+			expectedError: "bad_param.invalid_funding_rate_venue",
+		},
+		{
+			name: "funding_rates_cannot_be_personal",
+			asset: &domain.WatchlistAsset{
+				Watchlist: marketdataproto.WatchlistFundingRates,
+				OwnerID:   "user-1",
+				Symbol:    "BTC-PERP",
+				Venue:     "ftx",
+				Asset:     "btc",
+			},
+			expectedError: "bad_param.funding_rates_watchlist_cannot_be_personal",
+		},
+		{
+			name: "solana_nfts",
+			asset: &domain.WatchlistAsset{
+				Watchlist:     marketdataproto.WatchlistSolanaNFTs,
+				Symbol:        "DegenApe",
+				Venue:         "solanart",
+				HumanizedName: "Degenerate Ape Academy",
+			},
+			expectedAsset: &domain.WatchlistAsset{
+				Watchlist:     marketdataproto.WatchlistSolanaNFTs,
+				Symbol:        "degenape",
+				Venue:         "solanart",
+				HumanizedName: "Degenerate Ape Academy",
+			},
+		},
+		{
+			name: "invalid_watchlist",
+			asset: &domain.WatchlistAsset{
+				Watchlist: "memes",
+				Symbol:    "doge",
+			},
+			expectedError: "bad_param.invalid_watchlist",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			asset, err := normalizeWatchlistAsset(tt.asset)
+			if tt.expectedError != "" {
+				gerrors.AssertIs(t, err, gerrors.ErrBadParam, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedAsset, asset)
+		})
+	}
+}
+
+func TestPublishWatchlistDigests(t *testing.T) {
+	originalOwners, originalAssets, originalFetch, originalSend := listWatchlistOwners, listPersonalPriceAssets, fetchLatestPrice, sendWatchlistDigest
+	t.Cleanup(func() {
+		listWatchlistOwners, listPersonalPriceAssets, fetchLatestPrice, sendWatchlistDigest = originalOwners, originalAssets, originalFetch, originalSend
+	})
+
+	listWatchlistOwners = func(ctx context.Context, watchlist string) ([]string, error) {
+		assert.Equal(t, marketdataproto.WatchlistPrices, watchlist)
+		return []string{"user-1", "user-2"}, nil
+	}
+	listPersonalPriceAssets = func(ctx context.Context, userID string) ([]*assets.AssetPair, error) {
+		if userID == "user-2" {
+			return []*assets.AssetPair{{Symbol: "doge", AssetPair: "usd"}}, nil
+		}
+
+		return []*assets.AssetPair{
+			{Symbol: "sol", AssetPair: "usd", Grouping: "solana"},
+			{Symbol: "btc", AssetPair: "usd", Grouping: "bitcoin"},
+		}, nil
+	}
+	fetchLatestPrice = func(ctx context.Context, symbol, assetPair string) (float64, float64, error) {
+		switch symbol {
+		case "btc":
+			return 60000, 1.5, nil
+		case "sol":
+			return 200.5, -3.25, nil
+		default:
+			return 0, 0, gerrors.FailedPrecondition("coingecko_unavailable", nil)
+		}
+	}
+
+	digests := map[string]string{}
+	sendWatchlistDigest = func(ctx context.Context, userID, digest string, today time.Time) error {
+		digests[userID] = digest
+		return nil
+	}
+
+	rsp, err := (&MarketDataService{}).PublishWatchlistDigests(context.Background(), &marketdataproto.PublishWatchlistDigestsRequest{})
+	require.NoError(t, err)
+
+	// We don't send a digest without any prices.
+	assert.Equal(t, int64(1), rsp.NumberOfDigestsSent)
+	assert.Equal(t, int64(1), rsp.NumberOfDigestsFailed)
+
+	require.Contains(t, digests, "user-1")
+	assert.Equal(t, "BTCUSD:  60000.000  24h: +1.50%\nSOLUSD:    200.500  24h: -3.25%\n", digests["user-1"])
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 
 	"swallowtail/libraries/mariana"
+	"swallowtail/s.market-data/candles"
+	"swallowtail/s.market-data/dao"
 	"swallowtail/s.market-data/handler"
+	"swallowtail/s.market-data/pricealerts"
 	marketdataproto "swallowtail/s.market-data/proto"
 )
 
@@ -17,6 +20,21 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// Init dao.
+	if err := dao.Init(ctx, svcName); err != nil {
+		panic(err)
+	}
+
+	// Init candle ingestion; backfills any candles missed whilst we were down.
+	if err := candles.Init(ctx); err != nil {
+		panic(err)
+	}
+
+	// Init price alerts; evaluated against the live price of their symbols.
+	if err := pricealerts.Init(ctx); err != nil {
+		panic(err)
+	}
+
 	// Init Mariana Server
 	srv := mariana.Init(svcName)
 	marketdataproto.RegisterMarketdataServer(srv.Grpc(), &handler.MarketDataService{})
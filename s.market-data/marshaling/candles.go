@@ -0,0 +1,36 @@
+package marshaling
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// CandleDomainToProto ...
+func CandleDomainToProto(candle *domain.Candle) *marketdataproto.Candle {
+	return &marketdataproto.Candle{
+		Symbol:           candle.Symbol,
+		Interval:         candle.Interval,
+		OpenTime:         timestamppb.New(candle.OpenTime),
+		CloseTime:        timestamppb.New(candle.CloseTime),
+		OpenPrice:        float32(candle.OpenPrice),
+		HighPrice:        float32(candle.HighPrice),
+		LowPrice:         float32(candle.LowPrice),
+		ClosePrice:       float32(candle.ClosePrice),
+		BaseAssetVolume:  float32(candle.BaseAssetVolume),
+		QuoteAssetVolume: float32(candle.QuoteAssetVolume),
+		NumberOfTrades:   candle.NumberOfTrades,
+		IsClosed:         candle.IsClosed,
+	}
+}
+
+// CandlesDomainToProtos ...
+func CandlesDomainToProtos(candles []*domain.Candle) []*marketdataproto.Candle {
+	protos := make([]*marketdataproto.Candle, 0, len(candles))
+	for _, candle := range candles {
+		protos = append(protos, CandleDomainToProto(candle))
+	}
+
+	return protos
+}
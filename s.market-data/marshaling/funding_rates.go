@@ -0,0 +1,31 @@
+package marshaling
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// FundingRateDomainToProto ...
+func FundingRateDomainToProto(fundingRate *domain.FundingRate) *marketdataproto.FundingRate {
+	return &marketdataproto.FundingRate{
+		Venue:                 fundingRate.Venue,
+		Symbol:                fundingRate.Symbol,
+		Asset:                 fundingRate.Asset,
+		FundingRate:           float32(fundingRate.FundingRate),
+		FundingIntervalHours:  int64(fundingRate.FundingIntervalHours),
+		AnnualisedFundingRate: float32(fundingRate.AnnualisedFundingRate),
+		Timestamp:             timestamppb.New(fundingRate.Timestamp),
+	}
+}
+
+// FundingRatesDomainToProtos ...
+func FundingRatesDomainToProtos(fundingRates []*domain.FundingRate) []*marketdataproto.FundingRate {
+	protos := make([]*marketdataproto.FundingRate, 0, len(fundingRates))
+	for _, fundingRate := range fundingRates {
+		protos = append(protos, FundingRateDomainToProto(fundingRate))
+	}
+
+	return protos
+}
@@ -0,0 +1,61 @@
+package marshaling
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// PriceAlertDomainToProto ...
+func PriceAlertDomainToProto(alert *domain.PriceAlert) *marketdataproto.PriceAlert {
+	var lastTriggered *timestamppb.Timestamp
+	if alert.LastTriggered != nil {
+		lastTriggered = timestamppb.New(*alert.LastTriggered)
+	}
+
+	return &marketdataproto.PriceAlert{
+		AlertId:          alert.AlertID,
+		UserId:           alert.UserID,
+		Symbol:           alert.Symbol,
+		AssetPair:        alert.AssetPair,
+		Condition:        alert.Condition,
+		TargetPrice:      alert.TargetPrice,
+		MovePercentage:   alert.MovePercentage,
+		WindowMinutes:    int64(alert.WindowMinutes),
+		IsRecurring:      alert.IsRecurring,
+		IsHighPriority:   alert.IsHighPriority,
+		Status:           alert.Status,
+		NumberOfTriggers: int64(alert.NumberOfTriggers),
+		LastTriggered:    lastTriggered,
+		CreatedBy:        alert.CreatedBy,
+		Created:          timestamppb.New(alert.Created),
+	}
+}
+
+// PriceAlertsDomainToProtos ...
+func PriceAlertsDomainToProtos(alerts []*domain.PriceAlert) []*marketdataproto.PriceAlert {
+	protos := make([]*marketdataproto.PriceAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		protos = append(protos, PriceAlertDomainToProto(alert))
+	}
+
+	return protos
+}
+
+// PriceAlertProtoToDomain ...
+func PriceAlertProtoToDomain(alert *marketdataproto.PriceAlert) *domain.PriceAlert {
+	return &domain.PriceAlert{
+		AlertID:        alert.AlertId,
+		UserID:         alert.UserId,
+		Symbol:         alert.Symbol,
+		AssetPair:      alert.AssetPair,
+		Condition:      alert.Condition,
+		TargetPrice:    alert.TargetPrice,
+		MovePercentage: alert.MovePercentage,
+		WindowMinutes:  int(alert.WindowMinutes),
+		IsRecurring:    alert.IsRecurring,
+		IsHighPriority: alert.IsHighPriority,
+		CreatedBy:      alert.CreatedBy,
+	}
+}
@@ -0,0 +1,53 @@
+package marshaling
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// WatchlistAssetDomainToProto ...
+func WatchlistAssetDomainToProto(asset *domain.WatchlistAsset) *marketdataproto.WatchlistAsset {
+	return &marketdataproto.WatchlistAsset{
+		Watchlist:        asset.Watchlist,
+		OwnerId:          asset.OwnerID,
+		Symbol:           asset.Symbol,
+		AssetPair:        asset.AssetPair,
+		Venue:            asset.Venue,
+		Grouping:         asset.Grouping,
+		VolatilityRating: asset.VolatilityRating,
+		HumanizedName:    asset.HumanizedName,
+		Asset:            asset.Asset,
+		Emoji:            asset.Emoji,
+		CreatedBy:        asset.CreatedBy,
+		Created:          timestamppb.New(asset.Created),
+	}
+}
+
+// WatchlistAssetsDomainToProtos ...
+func WatchlistAssetsDomainToProtos(assets []*domain.WatchlistAsset) []*marketdataproto.WatchlistAsset {
+	protos := make([]*marketdataproto.WatchlistAsset, 0, len(assets))
+	for _, asset := range assets {
+		protos = append(protos, WatchlistAssetDomainToProto(asset))
+	}
+
+	return protos
+}
+
+// WatchlistAssetProtoToDomain ...
+func WatchlistAssetProtoToDomain(asset *marketdataproto.WatchlistAsset) *domain.WatchlistAsset {
+	return &domain.WatchlistAsset{
+		Watchlist:        asset.Watchlist,
+		OwnerID:          asset.OwnerId,
+		Symbol:           asset.Symbol,
+		AssetPair:        asset.AssetPair,
+		Venue:            asset.Venue,
+		Grouping:         asset.Grouping,
+		VolatilityRating: asset.VolatilityRating,
+		HumanizedName:    asset.HumanizedName,
+		Asset:            asset.Asset,
+		Emoji:            asset.Emoji,
+		CreatedBy:        asset.CreatedBy,
+	}
+}
@@ -0,0 +1,101 @@
+package pricealerts
+
+import (
+	"math"
+	"time"
+
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+type outcome int
+
+const (
+	outcomeNone outcome = iota
+	outcomeTrigger
+	outcomeArm
+)
+
+// priceHistory holds the open price of each 1m candle of a symbol over the max window; so move alerts can find the
+// price at the start of their window.
+type priceHistory struct {
+	opens map[time.Time]float64
+}
+
+func newPriceHistory() *priceHistory {
+	return &priceHistory{
+		opens: map[time.Time]float64{},
+	}
+}
+
+// add records the open price of the candle, closed or not, & forgets any candle opened before the max window.
+func (h *priceHistory) add(candle *domain.Candle) {
+	openTime := candle.OpenTime.Truncate(time.Minute)
+	if _, ok := h.opens[openTime]; ok {
+		return
+	}
+
+	h.opens[openTime] = candle.OpenPrice
+
+	earliest := openTime.Add(-MaxWindow)
+	for t := range h.opens {
+		if t.Before(earliest) {
+			delete(h.opens, t)
+		}
+	}
+}
+
+// reference returns the open price of the 1m candle opened at the start of the window; false if we don't have it.
+func (h *priceHistory) reference(window time.Duration, now time.Time) (float64, bool) {
+	price, ok := h.opens[now.Add(-window).Truncate(time.Minute)]
+	if !ok || price <= 0 {
+		return 0, false
+	}
+
+	return price, true
+}
+
+// evaluate determines whether the alert should be triggered or rearmed at the given price.
+func evaluate(alert *domain.PriceAlert, price float64, history *priceHistory, now time.Time) outcome {
+	if alert.Status != marketdataproto.PriceAlertStatusActive {
+		return outcomeNone
+	}
+
+	switch alert.Condition {
+	case marketdataproto.PriceAlertConditionAbove, marketdataproto.PriceAlertConditionBelow:
+		crossed := price >= alert.TargetPrice
+		if alert.Condition == marketdataproto.PriceAlertConditionBelow {
+			crossed = price <= alert.TargetPrice
+		}
+
+		switch {
+		case crossed && alert.IsArmed:
+			return outcomeTrigger
+		case !crossed && !alert.IsArmed:
+			// The price has crossed back over the level since the alert last triggered.
+			return outcomeArm
+		}
+	case marketdataproto.PriceAlertConditionMove:
+		window := time.Duration(alert.WindowMinutes) * time.Minute
+
+		// A move isn't alerted on again until the window has passed; so a single move isn't alerted on every update.
+		if alert.LastTriggered != nil && now.Sub(*alert.LastTriggered) < window {
+			return outcomeNone
+		}
+
+		reference, ok := history.reference(window, now)
+		if !ok {
+			return outcomeNone
+		}
+
+		if math.Abs(movePercentage(reference, price)) >= alert.MovePercentage {
+			return outcomeTrigger
+		}
+	}
+
+	return outcomeNone
+}
+
+func movePercentage(from, to float64) float64 {
+	return (to - from) / from * 100
+}
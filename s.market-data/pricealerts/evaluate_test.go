@@ -0,0 +1,168 @@
+package pricealerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now           = time.Date(2021, 11, 6, 12, 30, 20, 0, time.UTC)
+		lastTriggered = now.Add(-30 * time.Minute)
+		history       = newPriceHistory()
+	)
+
+	// The price an hour ago, at the start of the window.
+	history.add(&domain.Candle{OpenTime: now.Add(-time.Hour).Truncate(time.Minute), OpenPrice: 100})
+
+	tests := []struct {
+		name            string
+		alert           *domain.PriceAlert
+		price           float64
+		expectedOutcome outcome
+	}{
+		{
+			name: "above_crossed",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionAbove, TargetPrice: 110, IsArmed: true,
+			},
+			price:           110,
+			expectedOutcome: outcomeTrigger,
+		},
+		{
+			name: "above_not_crossed",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionAbove, TargetPrice: 110, IsArmed: true,
+			},
+			price:           109.99,
+			expectedOutcome: outcomeNone,
+		},
+		{
+			name: "above_disarmed_still_crossed",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionAbove, TargetPrice: 110, IsRecurring: true,
+			},
+			price:           112,
+			expectedOutcome: outcomeNone,
+		},
+		{
+			name: "above_disarmed_crossed_back",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionAbove, TargetPrice: 110, IsRecurring: true,
+			},
+			price:           108,
+			expectedOutcome: outcomeArm,
+		},
+		{
+			name: "below_crossed",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionBelow, TargetPrice: 90, IsArmed: true,
+			},
+			price:           89,
+			expectedOutcome: outcomeTrigger,
+		},
+		{
+			name: "move_up",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionMove, MovePercentage: 5, WindowMinutes: 60, IsArmed: true,
+			},
+			price:           105,
+			expectedOutcome: outcomeTrigger,
+		},
+		{
+			name: "move_down",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionMove, MovePercentage: 5, WindowMinutes: 60, IsArmed: true,
+			},
+			price:           94,
+			expectedOutcome: outcomeTrigger,
+		},
+		{
+			name: "move_too_small",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionMove, MovePercentage: 5, WindowMinutes: 60, IsArmed: true,
+			},
+			price:           104,
+			expectedOutcome: outcomeNone,
+		},
+		{
+			name: "move_within_window_of_last_trigger",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionMove, MovePercentage: 5, WindowMinutes: 60, IsArmed: true,
+				IsRecurring: true, LastTriggered: &lastTriggered,
+			},
+			price:           110,
+			expectedOutcome: outcomeNone,
+		},
+		{
+			name: "move_without_history",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionMove, MovePercentage: 5, WindowMinutes: 15, IsArmed: true,
+			},
+			price:           110,
+			expectedOutcome: outcomeNone,
+		},
+		{
+			name: "triggered",
+			alert: &domain.PriceAlert{
+				Condition: marketdataproto.PriceAlertConditionAbove, TargetPrice: 110, IsArmed: true,
+				Status: marketdataproto.PriceAlertStatusTriggered,
+			},
+			price:           120,
+			expectedOutcome: outcomeNone,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.alert.Status == "" {
+				tt.alert.Status = marketdataproto.PriceAlertStatusActive
+			}
+
+			assert.Equal(t, tt.expectedOutcome, evaluate(tt.alert, tt.price, history, now))
+		})
+	}
+}
+
+func TestPriceHistory_ForgetsCandlesBeforeMaxWindow(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now     = time.Date(2021, 11, 6, 12, 30, 0, 0, time.UTC)
+		history = newPriceHistory()
+	)
+
+	history.add(&domain.Candle{OpenTime: now.Add(-MaxWindow - time.Minute), OpenPrice: 100})
+	history.add(&domain.Candle{OpenTime: now.Add(-MaxWindow), OpenPrice: 101})
+	history.add(&domain.Candle{OpenTime: now, OpenPrice: 102})
+
+	_, ok := history.reference(MaxWindow+time.Minute, now)
+	assert.False(t, ok)
+
+	price, ok := history.reference(MaxWindow, now)
+	assert.True(t, ok)
+	assert.Equal(t, float64(101), price)
+}
+
+func TestFormatWindow(t *testing.T) {
+	t.Parallel()
+
+	for window, expected := range map[time.Duration]string{
+		15 * time.Minute: "15m",
+		time.Hour:        "1h",
+		70 * time.Minute: "1h10m",
+		12 * time.Hour:   "12h",
+	} {
+		assert.Equal(t, expected, FormatWindow(window))
+	}
+}
@@ -0,0 +1,451 @@
+package pricealerts
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/assets"
+	"swallowtail/s.market-data/candles"
+	"swallowtail/s.market-data/dao"
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+const (
+	// MaxWindow is the longest window of a move alert; we backfill the 1m candles of the max window when we start
+	// watching a symbol, which must be within the most klines Binance returns per request.
+	MaxWindow = 12 * time.Hour
+
+	// refreshPeriod is how often we read the active alerts; new alerts are evaluated within the period.
+	refreshPeriod = 30 * time.Second
+
+	// restartBackoff is how long we wait before restarting the watch of a symbol that failed.
+	restartBackoff = time.Minute
+
+	// pageBackoff is how long we wait before triggering an alert again once its page fails with a retryable error;
+	// doubling with each consecutive failure.
+	pageBackoff = 30 * time.Second
+
+	// maxPageAttempts is the most times we try to page a trigger of an alert before we fail the alert.
+	maxPageAttempts = 5
+)
+
+var (
+	// Fakeable for testing.
+	listActivePriceAlerts = dao.ListActivePriceAlerts
+	triggerPriceAlert     = dao.TriggerPriceAlert
+	armPriceAlert         = dao.ArmPriceAlert
+	revertPriceAlert      = dao.RevertPriceAlertTrigger
+	failPriceAlert        = dao.FailPriceAlert
+	listKlines            = candles.ListKlinesFromBinance
+	streamKlines          = candles.StreamKlinesFromBinance
+	pageUser              = pageUserViaAccount
+	notifyUser            = notifyUserViaDiscord
+
+	mu             sync.Mutex
+	alertsBySymbol = map[string][]*domain.PriceAlert{}
+	watching       = map[string]context.CancelFunc{}
+	pageFailures   = map[string]*pageFailure{}
+)
+
+// pageFailure tracks the consecutive retryable page failures of an alert, in memory; so an alert that can't be paged
+// is backed off, rather than triggered again on every price update.
+type pageFailure struct {
+	attempts   int
+	retryAfter time.Time
+}
+
+// Init starts evaluating the active price alerts against the live price of their symbols; alerts created or deleted
+// are picked up within the refresh period.
+func Init(ctx context.Context) error {
+	if err := refresh(ctx); err != nil {
+		return gerrors.Augment(err, "failed_to_init_price_alerts", nil)
+	}
+
+	go func() {
+		t := time.NewTicker(refreshPeriod)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-t.C:
+				if err := refresh(ctx); err != nil {
+					slog.Error(ctx, "Failed to refresh price alerts: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// BinanceSymbol returns the Binance spot symbol the price alert is evaluated against.
+func BinanceSymbol(alert *domain.PriceAlert) string {
+	return (&assets.AssetPair{
+		Symbol:    alert.Symbol,
+		AssetPair: alert.AssetPair,
+	}).BinanceSymbol()
+}
+
+// refresh reads the active alerts, watching the symbols of any new alerts & no longer watching the symbols without
+// any alerts.
+func refresh(ctx context.Context) error {
+	alerts, err := listActivePriceAlerts(ctx)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_refresh_price_alerts", nil)
+	}
+
+	bySymbol := map[string][]*domain.PriceAlert{}
+	for _, alert := range alerts {
+		symbol := BinanceSymbol(alert)
+		bySymbol[symbol] = append(bySymbol[symbol], alert)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	alertsBySymbol = bySymbol
+
+	for alertID := range pageFailures {
+		if !isAlertActive(bySymbol, alertID) {
+			delete(pageFailures, alertID)
+		}
+	}
+
+	for symbol := range bySymbol {
+		if _, ok := watching[symbol]; ok {
+			continue
+		}
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		watching[symbol] = cancel
+		go watch(watchCtx, symbol)
+	}
+
+	for symbol, cancel := range watching {
+		if _, ok := bySymbol[symbol]; ok {
+			continue
+		}
+
+		cancel()
+		delete(watching, symbol)
+	}
+
+	return nil
+}
+
+// watch evaluates the alerts of the symbol until the context is done; restarting the watch whenever it fails.
+func watch(ctx context.Context, symbol string) {
+	for {
+		if err := watchSymbol(ctx, symbol); err != nil {
+			slog.Error(ctx, "Failed to watch price alerts: %s, Error: %v", symbol, err)
+		}
+
+		select {
+		case <-time.After(restartBackoff):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// watchSymbol streams every kline update of the symbol, evaluating its alerts against each, until the stream is closed.
+func watchSymbol(ctx context.Context, symbol string) error {
+	errParams := map[string]string{
+		"symbol": symbol,
+	}
+
+	// Start streaming before we backfill, so that we don't miss any candles in between.
+	stream, err := streamKlines(ctx, symbol)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_watch_symbol.stream", errParams)
+	}
+
+	history := newPriceHistory()
+
+	now := time.Now().UTC()
+	klines, err := listKlines(ctx, symbol, now.Add(-MaxWindow-time.Minute), now, int(MaxWindow/time.Minute)+1)
+	if err != nil {
+		// Best effort; move alerts are only evaluated once we've seen the start of their window.
+		slog.Error(ctx, "Failed to backfill price history: %s, Error: %v", symbol, err)
+	}
+	for _, kline := range klines {
+		history.add(kline)
+	}
+
+	for {
+		select {
+		case kline, ok := <-stream:
+			if !ok {
+				return gerrors.FailedPrecondition("failed_to_watch_symbol.stream_closed", errParams)
+			}
+
+			history.add(kline)
+			evaluateSymbol(ctx, symbol, kline.ClosePrice, history, time.Now().UTC())
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// evaluateSymbol evaluates the alerts of the symbol at the given price, triggering & rearming them as needed. Alerts are
+// updated in memory straight away, so they aren't evaluated again on the next update before they're persisted.
+func evaluateSymbol(ctx context.Context, symbol string, price float64, history *priceHistory, now time.Time) {
+	var triggered, armed []domain.PriceAlert
+
+	mu.Lock()
+	for _, alert := range alertsBySymbol[symbol] {
+		if failure, ok := pageFailures[alert.AlertID]; ok && now.Before(failure.retryAfter) {
+			continue
+		}
+
+		switch evaluate(alert, price, history, now) {
+		case outcomeTrigger:
+			triggered = append(triggered, *alert)
+
+			alert.NumberOfTriggers++
+			alert.LastTriggered = &now
+			switch {
+			case !alert.IsRecurring:
+				alert.Status = marketdataproto.PriceAlertStatusTriggered
+			case alert.Condition != marketdataproto.PriceAlertConditionMove:
+				alert.IsArmed = false
+			}
+		case outcomeArm:
+			armed = append(armed, *alert)
+			alert.IsArmed = true
+		}
+	}
+	mu.Unlock()
+
+	for _, alert := range triggered {
+		alert := alert
+		if err := triggerAlert(ctx, &alert, price, history, now); err != nil {
+			slog.Error(ctx, "Failed to trigger price alert: %s, Error: %v", alert.AlertID, err)
+		}
+	}
+
+	for _, alert := range armed {
+		if err := armPriceAlert(ctx, alert.AlertID); err != nil {
+			slog.Error(ctx, "Failed to rearm price alert: %s, Error: %v", alert.AlertID, err)
+		}
+	}
+}
+
+// triggerAlert claims the trigger of the alert, then pages its user. An alert is claimed before its user is paged; so a
+// trigger is paged at most once, even if the alert is being evaluated elsewhere. If the page fails with a retryable error
+// the claim is reverted & the alert backed off, so it's triggered & paged again on a later update rather than lost. If
+// the page fails otherwise, or too many times, the alert is failed & its user notified instead.
+func triggerAlert(ctx context.Context, alert *domain.PriceAlert, price float64, history *priceHistory, now time.Time) error {
+	errParams := map[string]string{
+		"alert_id": alert.AlertID,
+		"user_id":  alert.UserID,
+	}
+
+	claimed, err := triggerPriceAlert(ctx, alert.AlertID, now)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_claim_price_alert", errParams)
+	}
+
+	if !claimed {
+		slog.Info(ctx, "Skipping price alert already triggered or deleted: %s", alert.AlertID)
+		return nil
+	}
+
+	err = pageUser(ctx, alert.UserID, formatPage(alert, price, history, now), alert.IsHighPriority)
+	switch {
+	case err == nil:
+		clearPageFailures(alert.AlertID)
+		return nil
+	case isRetryablePageError(err) && recordPageFailure(alert.AlertID, now) < maxPageAttempts:
+		if revertErr := revertTrigger(ctx, alert, now); revertErr != nil {
+			slog.Critical(ctx, "Failed to revert trigger of price alert that wasn't paged: %s, Error: %v", alert.AlertID, revertErr)
+		}
+	default:
+		if failErr := failAlert(ctx, alert, now, err); failErr != nil {
+			slog.Critical(ctx, "Failed to fail price alert that can't be paged: %s, Error: %v", alert.AlertID, failErr)
+		}
+	}
+
+	return gerrors.Augment(err, "failed_to_page_price_alert", errParams)
+}
+
+// isRetryablePageError returns true if the page may succeed if retried; otherwise the user's pager is likely
+// misconfigured & retrying won't help.
+func isRetryablePageError(err error) bool {
+	return gerrors.IsCode(err, gerrors.ErrUnavailable) || gerrors.IsCode(err, gerrors.ErrRateLimited)
+}
+
+// recordPageFailure records a retryable page failure of the alert, backing it off; returning the number of consecutive
+// failures.
+func recordPageFailure(alertID string, now time.Time) int {
+	mu.Lock()
+	defer mu.Unlock()
+
+	failure, ok := pageFailures[alertID]
+	if !ok {
+		failure = &pageFailure{}
+		pageFailures[alertID] = failure
+	}
+
+	failure.attempts++
+	failure.retryAfter = now.Add(pageBackoff << (failure.attempts - 1))
+
+	return failure.attempts
+}
+
+func clearPageFailures(alertID string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	delete(pageFailures, alertID)
+}
+
+// failAlert fails the claimed trigger of the alert, both persisted & in memory, so it's no longer evaluated; then
+// notifies its user, since they can't be paged.
+func failAlert(ctx context.Context, alert *domain.PriceAlert, triggered time.Time, pageErr error) error {
+	clearPageFailures(alert.AlertID)
+
+	failed, err := failPriceAlert(ctx, alert.AlertID, triggered, alert.LastTriggered)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_fail_price_alert", map[string]string{
+			"alert_id": alert.AlertID,
+		})
+	}
+
+	if !failed {
+		slog.Info(ctx, "Skipping failure of price alert deleted or triggered since: %s", alert.AlertID)
+		return nil
+	}
+
+	mu.Lock()
+	for _, a := range alertsBySymbol[BinanceSymbol(alert)] {
+		if a.AlertID != alert.AlertID || a.LastTriggered == nil || !a.LastTriggered.Equal(triggered) {
+			continue
+		}
+
+		a.Status, a.IsArmed, a.NumberOfTriggers, a.LastTriggered = marketdataproto.PriceAlertStatusFailed, false, alert.NumberOfTriggers, alert.LastTriggered
+	}
+	mu.Unlock()
+
+	slog.Warn(ctx, "Failed price alert that can't be paged: %s, UserID: %s, Error: %v", alert.AlertID, alert.UserID, pageErr)
+
+	if err := notifyUser(ctx, alert.UserID, formatFailedAlert(alert), fmt.Sprintf("pricealertfailed-%s-%d", alert.AlertID, triggered.Unix())); err != nil {
+		return gerrors.Augment(err, "failed_to_notify_user_of_failed_price_alert", map[string]string{
+			"alert_id": alert.AlertID,
+		})
+	}
+
+	return nil
+}
+
+func isAlertActive(alertsBySymbol map[string][]*domain.PriceAlert, alertID string) bool {
+	for _, alerts := range alertsBySymbol {
+		for _, alert := range alerts {
+			if alert.AlertID == alertID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// revertTrigger reverts the claimed trigger of the alert, both persisted & in memory; the alert is as it was before it
+// was evaluated, so it's reactivated if one-shot & rearmed if a recurring level.
+func revertTrigger(ctx context.Context, alert *domain.PriceAlert, triggered time.Time) error {
+	reverted, err := revertPriceAlert(ctx, alert.AlertID, triggered, alert.LastTriggered)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_revert_price_alert_trigger", map[string]string{
+			"alert_id": alert.AlertID,
+		})
+	}
+
+	if !reverted {
+		slog.Info(ctx, "Skipping revert of price alert deleted or triggered since: %s", alert.AlertID)
+		return nil
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, a := range alertsBySymbol[BinanceSymbol(alert)] {
+		if a.AlertID != alert.AlertID || a.LastTriggered == nil || !a.LastTriggered.Equal(triggered) {
+			continue
+		}
+
+		a.Status, a.IsArmed, a.NumberOfTriggers, a.LastTriggered = alert.Status, alert.IsArmed, alert.NumberOfTriggers, alert.LastTriggered
+	}
+
+	return nil
+}
+
+// formatPage formats the page of a triggered alert.
+func formatPage(alert *domain.PriceAlert, price float64, history *priceHistory, now time.Time) string {
+	symbol := BinanceSymbol(alert)
+
+	var sb strings.Builder
+	sb.WriteString(":rotating_light: **Price alert** :rotating_light:\n")
+
+	switch alert.Condition {
+	case marketdataproto.PriceAlertConditionMove:
+		window := time.Duration(alert.WindowMinutes) * time.Minute
+		reference, _ := history.reference(window, now)
+		sb.WriteString(fmt.Sprintf(
+			"`%s` has moved %+.2f%% in the last %s: from `%s` to `%s`\n",
+			symbol, movePercentage(reference, price), FormatWindow(window), formatPrice(reference), formatPrice(price),
+		))
+	default:
+		sb.WriteString(fmt.Sprintf(
+			"`%s` is %s `%s`: it's now `%s`\n",
+			symbol, alert.Condition, formatPrice(alert.TargetPrice), formatPrice(price),
+		))
+	}
+
+	switch {
+	case !alert.IsRecurring:
+		sb.WriteString(fmt.Sprintf("Alert `%s` won't trigger again.", alert.AlertID))
+	case alert.Condition == marketdataproto.PriceAlertConditionMove:
+		sb.WriteString(fmt.Sprintf("Alert `%s` is recurring; it can trigger again once the window has passed.", alert.AlertID))
+	default:
+		sb.WriteString(fmt.Sprintf("Alert `%s` is recurring; it can trigger again once the price crosses back over `%s`.", alert.AlertID, formatPrice(alert.TargetPrice)))
+	}
+
+	return sb.String()
+}
+
+// formatFailedAlert formats the notification of an alert that couldn't be paged.
+func formatFailedAlert(alert *domain.PriceAlert) string {
+	pager := "low priority"
+	if alert.IsHighPriority {
+		pager = "high priority"
+	}
+
+	return fmt.Sprintf(
+		":rotating_light: <@%s>, I couldn't page your %s pager when your price alert `%s` on `%s` triggered, so I've failed it. Please ping @ajperkins to check your pager is set up, then create the alert again.",
+		alert.UserID, pager, alert.AlertID, BinanceSymbol(alert),
+	)
+}
+
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', -1, 64)
+}
+
+// FormatWindow formats the window of a move alert without its zero units; i.e `15m`, `1h` or `1h30m`.
+func FormatWindow(window time.Duration) string {
+	s := strings.TrimSuffix(window.String(), "0s")
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+
+	return s
+}
@@ -0,0 +1,322 @@
+package pricealerts
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.market-data/domain"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+type page struct {
+	userID         string
+	content        string
+	isHighPriority bool
+}
+
+// fakeAlertStore stubs the dao, pager & notifier; alerts are claimed in the store as they would be in the dao. Users are
+// notified on the pages returned, with the idempotency key as the content.
+func fakeAlertStore(t *testing.T, alerts ...*domain.PriceAlert) (map[string]*domain.PriceAlert, *[]page) {
+	store := map[string]*domain.PriceAlert{}
+	for _, alert := range alerts {
+		a := *alert
+		store[alert.AlertID] = &a
+	}
+
+	prevList, prevTrigger, prevArm, prevRevert, prevPage, prevAlerts, prevWatching := listActivePriceAlerts, triggerPriceAlert, armPriceAlert, revertPriceAlert, pageUser, alertsBySymbol, watching
+	prevFail, prevNotify, prevPageFailures := failPriceAlert, notifyUser, pageFailures
+	t.Cleanup(func() {
+		listActivePriceAlerts, triggerPriceAlert, armPriceAlert, revertPriceAlert, pageUser, alertsBySymbol, watching = prevList, prevTrigger, prevArm, prevRevert, prevPage, prevAlerts, prevWatching
+		failPriceAlert, notifyUser, pageFailures = prevFail, prevNotify, prevPageFailures
+	})
+
+	pageFailures = map[string]*pageFailure{}
+
+	alertsBySymbol = map[string][]*domain.PriceAlert{}
+	for _, alert := range alerts {
+		symbol := BinanceSymbol(alert)
+		alertsBySymbol[symbol] = append(alertsBySymbol[symbol], alert)
+	}
+
+	triggerPriceAlert = func(_ context.Context, alertID string, triggered time.Time) (bool, error) {
+		a, ok := store[alertID]
+		if !ok || a.Status != marketdataproto.PriceAlertStatusActive || !a.IsArmed {
+			return false, nil
+		}
+
+		if !a.IsRecurring {
+			a.Status = marketdataproto.PriceAlertStatusTriggered
+		}
+		a.IsArmed = a.Condition == marketdataproto.PriceAlertConditionMove
+		a.NumberOfTriggers++
+		a.LastTriggered = &triggered
+		return true, nil
+	}
+	armPriceAlert = func(_ context.Context, alertID string) error {
+		store[alertID].IsArmed = true
+		return nil
+	}
+	revertPriceAlert = func(_ context.Context, alertID string, triggered time.Time, previouslyTriggered *time.Time) (bool, error) {
+		a, ok := store[alertID]
+		if !ok || a.LastTriggered == nil || !a.LastTriggered.Equal(triggered) {
+			return false, nil
+		}
+
+		a.Status = marketdataproto.PriceAlertStatusActive
+		a.IsArmed = true
+		a.NumberOfTriggers--
+		a.LastTriggered = previouslyTriggered
+		return true, nil
+	}
+
+	failPriceAlert = func(_ context.Context, alertID string, triggered time.Time, previouslyTriggered *time.Time) (bool, error) {
+		a, ok := store[alertID]
+		if !ok || a.LastTriggered == nil || !a.LastTriggered.Equal(triggered) {
+			return false, nil
+		}
+
+		a.Status = marketdataproto.PriceAlertStatusFailed
+		a.IsArmed = false
+		a.NumberOfTriggers--
+		a.LastTriggered = previouslyTriggered
+		return true, nil
+	}
+
+	pages := &[]page{}
+	pageUser = func(_ context.Context, userID, content string, isHighPriority bool) error {
+		*pages = append(*pages, page{userID, content, isHighPriority})
+		return nil
+	}
+	notifyUser = func(_ context.Context, userID, content, idempotencyKey string) error {
+		*pages = append(*pages, page{userID: userID, content: idempotencyKey})
+		return nil
+	}
+
+	return store, pages
+}
+
+func TestEvaluateSymbol_OneShot(t *testing.T) {
+	store, pages := fakeAlertStore(t, &domain.PriceAlert{
+		AlertID:     "alert-1",
+		UserID:      "user-1",
+		Symbol:      "btc",
+		AssetPair:   "usd",
+		Condition:   marketdataproto.PriceAlertConditionAbove,
+		TargetPrice: 70000,
+		IsArmed:     true,
+		Status:      marketdataproto.PriceAlertStatusActive,
+	})
+
+	var (
+		ctx     = context.Background()
+		now     = time.Date(2021, 11, 6, 12, 30, 0, 0, time.UTC)
+		history = newPriceHistory()
+	)
+
+	evaluateSymbol(ctx, "BTCUSDT", 69999, history, now)
+	assert.Empty(t, *pages)
+
+	evaluateSymbol(ctx, "BTCUSDT", 70100, history, now)
+	evaluateSymbol(ctx, "BTCUSDT", 69000, history, now.Add(time.Minute))
+	evaluateSymbol(ctx, "BTCUSDT", 70200, history, now.Add(2*time.Minute))
+
+	require.Len(t, *pages, 1)
+	assert.Equal(t, "user-1", (*pages)[0].userID)
+	assert.False(t, (*pages)[0].isHighPriority)
+	assert.Contains(t, (*pages)[0].content, "`BTCUSDT` is above `70000`: it's now `70100`")
+	assert.Contains(t, (*pages)[0].content, "won't trigger again")
+
+	assert.Equal(t, marketdataproto.PriceAlertStatusTriggered, store["alert-1"].Status)
+	assert.Equal(t, 1, store["alert-1"].NumberOfTriggers)
+}
+
+func TestEvaluateSymbol_FailedPageIsRetried(t *testing.T) {
+	store, pages := fakeAlertStore(t, &domain.PriceAlert{
+		AlertID:     "alert-1",
+		UserID:      "user-1",
+		Symbol:      "btc",
+		AssetPair:   "usd",
+		Condition:   marketdataproto.PriceAlertConditionAbove,
+		TargetPrice: 70000,
+		IsArmed:     true,
+		Status:      marketdataproto.PriceAlertStatusActive,
+	})
+
+	page := pageUser
+	pageUser = func(context.Context, string, string, bool) error {
+		return gerrors.New(gerrors.ErrUnavailable, "pager_unavailable", nil)
+	}
+
+	var (
+		ctx     = context.Background()
+		now     = time.Date(2021, 11, 6, 12, 30, 0, 0, time.UTC)
+		history = newPriceHistory()
+	)
+
+	// The page fails, so the trigger is reverted.
+	evaluateSymbol(ctx, "BTCUSDT", 70100, history, now)
+	assert.Empty(t, *pages)
+	assert.Equal(t, marketdataproto.PriceAlertStatusActive, store["alert-1"].Status)
+	assert.True(t, store["alert-1"].IsArmed)
+	assert.Equal(t, 0, store["alert-1"].NumberOfTriggers)
+	assert.Nil(t, store["alert-1"].LastTriggered)
+
+	// & it's backed off, so it's not triggered again until the backoff has passed.
+	evaluateSymbol(ctx, "BTCUSDT", 70150, history, now.Add(10*time.Second))
+	assert.Empty(t, *pages)
+	assert.Equal(t, 0, store["alert-1"].NumberOfTriggers)
+
+	// It's triggered again on the next update after the backoff.
+	pageUser = page
+	evaluateSymbol(ctx, "BTCUSDT", 70200, history, now.Add(time.Minute))
+
+	require.Len(t, *pages, 1)
+	assert.Contains(t, (*pages)[0].content, "it's now `70200`")
+	assert.Equal(t, marketdataproto.PriceAlertStatusTriggered, store["alert-1"].Status)
+	assert.Equal(t, 1, store["alert-1"].NumberOfTriggers)
+	assert.Empty(t, pageFailures)
+}
+
+func TestEvaluateSymbol_FailedPage(t *testing.T) {
+	tests := []struct {
+		name             string
+		pageErr          error
+		expectedAttempts int
+	}{
+		{
+			name:             "retryable_error_attempts_capped",
+			pageErr:          gerrors.New(gerrors.ErrRateLimited, "pager_rate_limited", nil),
+			expectedAttempts: maxPageAttempts,
+		},
+		{
+			name:             "terminal_error_not_retried",
+			pageErr:          gerrors.FailedPrecondition("failed_to_get_identifier_from_account.phone_number", nil),
+			expectedAttempts: 1,
+		},
+		{
+			name:             "unclassified_error_not_retried",
+			pageErr:          errors.New("invalid pager type set to account"),
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			store, pages := fakeAlertStore(t, &domain.PriceAlert{
+				AlertID:     "alert-1",
+				UserID:      "user-1",
+				Symbol:      "btc",
+				AssetPair:   "usd",
+				Condition:   marketdataproto.PriceAlertConditionAbove,
+				TargetPrice: 70000,
+				IsRecurring: true,
+				IsArmed:     true,
+				Status:      marketdataproto.PriceAlertStatusActive,
+			})
+
+			var attempts int
+			pageUser = func(context.Context, string, string, bool) error {
+				attempts++
+				return tt.pageErr
+			}
+
+			var (
+				ctx     = context.Background()
+				now     = time.Date(2021, 11, 6, 12, 30, 0, 0, time.UTC)
+				history = newPriceHistory()
+			)
+
+			// Every update is long after the backoff of any previous failure.
+			for i := 0; i < 2*maxPageAttempts; i++ {
+				evaluateSymbol(ctx, "BTCUSDT", 70100, history, now.Add(time.Duration(i)*time.Hour))
+			}
+
+			assert.Equal(t, tt.expectedAttempts, attempts)
+
+			assert.Equal(t, marketdataproto.PriceAlertStatusFailed, store["alert-1"].Status)
+			assert.False(t, store["alert-1"].IsArmed)
+			assert.Equal(t, 0, store["alert-1"].NumberOfTriggers)
+			assert.Nil(t, store["alert-1"].LastTriggered)
+			assert.Empty(t, pageFailures)
+
+			// The user is notified once the alert is failed.
+			require.Len(t, *pages, 1)
+			assert.Equal(t, "user-1", (*pages)[0].userID)
+			assert.Contains(t, (*pages)[0].content, "pricealertfailed-alert-1")
+		})
+	}
+}
+
+func TestEvaluateSymbol_RecurringLevelRearms(t *testing.T) {
+	store, pages := fakeAlertStore(t, &domain.PriceAlert{
+		AlertID:        "alert-1",
+		UserID:         "user-1",
+		Symbol:         "sol",
+		AssetPair:      "usdt",
+		Condition:      marketdataproto.PriceAlertConditionBelow,
+		TargetPrice:    150,
+		IsRecurring:    true,
+		IsHighPriority: true,
+		IsArmed:        true,
+		Status:         marketdataproto.PriceAlertStatusActive,
+	})
+
+	var (
+		ctx     = context.Background()
+		now     = time.Date(2021, 11, 6, 12, 30, 0, 0, time.UTC)
+		history = newPriceHistory()
+	)
+
+	for i, price := range []float64{149, 148, 151, 149.5} {
+		evaluateSymbol(ctx, "SOLUSDT", price, history, now.Add(time.Duration(i)*time.Minute))
+	}
+
+	// Triggered at 149, rearmed at 151 & triggered again at 149.5.
+	require.Len(t, *pages, 2)
+	assert.True(t, (*pages)[1].isHighPriority)
+	assert.Contains(t, (*pages)[1].content, "once the price crosses back over `150`")
+
+	assert.Equal(t, marketdataproto.PriceAlertStatusActive, store["alert-1"].Status)
+	assert.Equal(t, 2, store["alert-1"].NumberOfTriggers)
+	assert.False(t, store["alert-1"].IsArmed)
+}
+
+func TestEvaluateSymbol_RecurringMove(t *testing.T) {
+	_, pages := fakeAlertStore(t, &domain.PriceAlert{
+		AlertID:        "alert-1",
+		UserID:         "user-1",
+		Symbol:         "eth",
+		AssetPair:      "usdt",
+		Condition:      marketdataproto.PriceAlertConditionMove,
+		MovePercentage: 5,
+		WindowMinutes:  15,
+		IsRecurring:    true,
+		IsArmed:        true,
+		Status:         marketdataproto.PriceAlertStatusActive,
+	})
+
+	var (
+		ctx     = context.Background()
+		now     = time.Date(2021, 11, 6, 12, 30, 0, 0, time.UTC)
+		history = newPriceHistory()
+	)
+
+	for i := 0; i <= 40; i++ {
+		history.add(&domain.Candle{OpenTime: now.Add(time.Duration(i-15) * time.Minute), OpenPrice: 4000})
+	}
+
+	// Moves every minute for 20 minutes are only alerted on once per window.
+	for i := 0; i < 20; i++ {
+		evaluateSymbol(ctx, "ETHUSDT", 4250, history, now.Add(time.Duration(i)*time.Minute))
+	}
+
+	require.Len(t, *pages, 2)
+	assert.Contains(t, (*pages)[0].content, "`ETHUSDT` has moved +6.25% in the last 15m: from `4000` to `4250`")
+}
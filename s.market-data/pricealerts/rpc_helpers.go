@@ -0,0 +1,67 @@
+package pricealerts
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	accountproto "swallowtail/s.account/proto"
+	discordproto "swallowtail/s.discord/proto"
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// pageUserViaAccount pages the user with their configured pager of the given priority.
+func pageUserViaAccount(ctx context.Context, userID, content string, isHighPriority bool) error {
+	priority := accountproto.PagerPriority_LOW
+	if isHighPriority {
+		priority = accountproto.PagerPriority_HIGH
+	}
+
+	if _, err := (&accountproto.PageAccountRequest{
+		UserId:   userID,
+		Content:  content,
+		Priority: priority,
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_page_account", map[string]string{
+			"user_id": userID,
+		})
+	}
+
+	return nil
+}
+
+// notifyUserViaDiscord sends the content to the user as a direct message on Discord.
+func notifyUserViaDiscord(ctx context.Context, userID, content, idempotencyKey string) error {
+	if _, err := (&discordproto.SendMsgToPrivateChannelRequest{
+		UserId:         userID,
+		SenderId:       marketdataproto.MarketDataSystemActor,
+		Content:        content,
+		IdempotencyKey: idempotencyKey,
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_notify_user", map[string]string{
+			"user_id":         userID,
+			"idempotency_key": idempotencyKey,
+		})
+	}
+
+	return nil
+}
+
+// ReadLatestPrice reads the latest price of the Binance spot symbol; the close price of its current 1m kline.
+func ReadLatestPrice(ctx context.Context, symbol string) (float64, error) {
+	now := time.Now().UTC()
+	klines, err := listKlines(ctx, symbol, now.Add(-2*time.Minute), now.Add(time.Minute), 3)
+	if err != nil {
+		return 0, gerrors.Augment(err, "failed_to_read_latest_price", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	if len(klines) == 0 {
+		return 0, gerrors.NotFound("latest_price_not_found", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	return klines[len(klines)-1].ClosePrice, nil
+}
@@ -3,3 +3,37 @@ package marketdataproto
 const (
 	MarketDataSystemActor = "marketdata-system-actor"
 )
+
+const (
+	// Actors that may manage watchlists.
+	MarketDataActorSatoshiSystem  = "actor-satoshi-system"
+	MarketDataActorSatoshiCommand = "actor-satoshi-command"
+	MarketDataActorManual         = "actor-manual"
+)
+
+const (
+	// WatchlistPrices are the assets we publish the latest prices, ATHs, volatility & candles of. Users may also have
+	// their own personal price watchlist for their daily digest.
+	WatchlistPrices = "prices"
+	// WatchlistFundingRates are the perpetual futures we publish the funding rates of.
+	WatchlistFundingRates = "funding-rates"
+	// WatchlistSolanaNFTs are the solana nft collections we publish the floor prices of.
+	WatchlistSolanaNFTs = "solana-nfts"
+)
+
+const (
+	// PriceAlertConditionAbove alerts once the price is at or above the target price.
+	PriceAlertConditionAbove = "above"
+	// PriceAlertConditionBelow alerts once the price is at or below the target price.
+	PriceAlertConditionBelow = "below"
+	// PriceAlertConditionMove alerts once the price has moved by the move percentage, in either direction, within the
+	// window.
+	PriceAlertConditionMove = "move"
+)
+
+const (
+	PriceAlertStatusActive    = "active"
+	PriceAlertStatusTriggered = "triggered"
+	// PriceAlertStatusFailed alerts couldn't be paged; i.e the user's pager isn't configured.
+	PriceAlertStatusFailed = "failed"
+)
@@ -1,15 +1,15 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
-// 	protoc        v3.17.3
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.2
 // source: s.market-data/proto/marketdata.proto
 
 package marketdataproto
 
 import (
-	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,10 +21,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type PublishLatestPriceInformationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -405,199 +401,2054 @@ func (*PublishSolanaNFTPriceInformationResponse) Descriptor() ([]byte, []int) {
 	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{9}
 }
 
-var File_s_market_data_proto_marketdata_proto protoreflect.FileDescriptor
+type ListCandlesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_s_market_data_proto_marketdata_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x73, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x24, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x27,
-	0x0a, 0x25, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x23, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x26,
-	0x0a, 0x24, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x41, 0x54, 0x48, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x41, 0x54, 0x48, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x25, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x28, 0x0a, 0x26, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x27, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x4e, 0x46, 0x54, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x28, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x4e, 0x46, 0x54, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xb7, 0x04, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x70, 0x0a, 0x1d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x25, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x6c,
-	0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x6c, 0x61,
-	0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x58, 0x0a, 0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x54, 0x48, 0x49,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x41, 0x54, 0x48, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x41, 0x54, 0x48, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x1e, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
-	0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61,
-	0x74, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46,
-	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x79, 0x0a, 0x20, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x6f, 0x6c, 0x61, 0x6e,
-	0x61, 0x4e, 0x46, 0x54, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x6f,
-	0x6c, 0x61, 0x6e, 0x61, 0x4e, 0x46, 0x54, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x4e, 0x46,
-	0x54, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x14, 0x5a, 0x12, 0x2e,
-	0x2f, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x64, 0x61, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// The Binance spot symbol i.e `BTCUSDT`.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// One of `1m`, `5m`, `1h` or `1d`.
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Candles opened within [from, to) are listed, oldest first; defaults to the most recent candles up to the limit.
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Defaults to 500; at most 1500.
+	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-var (
-	file_s_market_data_proto_marketdata_proto_rawDescOnce sync.Once
-	file_s_market_data_proto_marketdata_proto_rawDescData = file_s_market_data_proto_marketdata_proto_rawDesc
-)
+func (x *ListCandlesRequest) Reset() {
+	*x = ListCandlesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_s_market_data_proto_marketdata_proto_rawDescGZIP() []byte {
-	file_s_market_data_proto_marketdata_proto_rawDescOnce.Do(func() {
-		file_s_market_data_proto_marketdata_proto_rawDescData = protoimpl.X.CompressGZIP(file_s_market_data_proto_marketdata_proto_rawDescData)
-	})
-	return file_s_market_data_proto_marketdata_proto_rawDescData
+func (x *ListCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_s_market_data_proto_marketdata_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_s_market_data_proto_marketdata_proto_goTypes = []interface{}{
-	(*PublishLatestPriceInformationRequest)(nil),     // 0: PublishLatestPriceInformationRequest
-	(*PublishLatestPriceInformationResponse)(nil),    // 1: PublishLatestPriceInformationResponse
-	(*PublishVolatilityInformationRequest)(nil),      // 2: PublishVolatilityInformationRequest
-	(*PublishVolatilityInformationResponse)(nil),     // 3: PublishVolatilityInformationResponse
-	(*PublishATHInformationRequest)(nil),             // 4: PublishATHInformationRequest
-	(*PublishATHInformationResponse)(nil),            // 5: PublishATHInformationResponse
-	(*PublishFundingRatesInformationRequest)(nil),    // 6: PublishFundingRatesInformationRequest
-	(*PublishFundingRatesInformationResponse)(nil),   // 7: PublishFundingRatesInformationResponse
-	(*PublishSolanaNFTPriceInformationRequest)(nil),  // 8: PublishSolanaNFTPriceInformationRequest
-	(*PublishSolanaNFTPriceInformationResponse)(nil), // 9: PublishSolanaNFTPriceInformationResponse
+func (*ListCandlesRequest) ProtoMessage() {}
+
+func (x *ListCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_s_market_data_proto_marketdata_proto_depIdxs = []int32{
-	0, // 0: marketdata.PublishLatestPriceInformation:input_type -> PublishLatestPriceInformationRequest
-	2, // 1: marketdata.PublishVolatilityInformation:input_type -> PublishVolatilityInformationRequest
-	4, // 2: marketdata.PublishATHInformation:input_type -> PublishATHInformationRequest
-	6, // 3: marketdata.PublishFundingRatesInformation:input_type -> PublishFundingRatesInformationRequest
-	8, // 4: marketdata.PublishSolanaNFTPriceInformation:input_type -> PublishSolanaNFTPriceInformationRequest
-	1, // 5: marketdata.PublishLatestPriceInformation:output_type -> PublishLatestPriceInformationResponse
-	3, // 6: marketdata.PublishVolatilityInformation:output_type -> PublishVolatilityInformationResponse
-	5, // 7: marketdata.PublishATHInformation:output_type -> PublishATHInformationResponse
-	7, // 8: marketdata.PublishFundingRatesInformation:output_type -> PublishFundingRatesInformationResponse
-	9, // 9: marketdata.PublishSolanaNFTPriceInformation:output_type -> PublishSolanaNFTPriceInformationResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use ListCandlesRequest.ProtoReflect.Descriptor instead.
+func (*ListCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{10}
 }
 
-func init() { file_s_market_data_proto_marketdata_proto_init() }
-func file_s_market_data_proto_marketdata_proto_init() {
-	if File_s_market_data_proto_marketdata_proto != nil {
-		return
+func (x *ListCandlesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_s_market_data_proto_marketdata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishLatestPriceInformationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_s_market_data_proto_marketdata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishLatestPriceInformationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_s_market_data_proto_marketdata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishVolatilityInformationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_s_market_data_proto_marketdata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishVolatilityInformationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *ListCandlesRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *ListCandlesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListCandlesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListCandlesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Candle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol           string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Interval         string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	OpenTime         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	OpenPrice        float32                `protobuf:"fixed32,5,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	HighPrice        float32                `protobuf:"fixed32,6,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	LowPrice         float32                `protobuf:"fixed32,7,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	ClosePrice       float32                `protobuf:"fixed32,8,opt,name=close_price,json=closePrice,proto3" json:"close_price,omitempty"`
+	BaseAssetVolume  float32                `protobuf:"fixed32,9,opt,name=base_asset_volume,json=baseAssetVolume,proto3" json:"base_asset_volume,omitempty"`
+	QuoteAssetVolume float32                `protobuf:"fixed32,10,opt,name=quote_asset_volume,json=quoteAssetVolume,proto3" json:"quote_asset_volume,omitempty"`
+	NumberOfTrades   int64                  `protobuf:"varint,11,opt,name=number_of_trades,json=numberOfTrades,proto3" json:"number_of_trades,omitempty"`
+	// False if the candle is still open; i.e. the latest candle of an interval above 1m.
+	IsClosed bool `protobuf:"varint,12,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_s_market_data_proto_marketdata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishATHInformationRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Candle) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Candle) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *Candle) GetOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenTime
+	}
+	return nil
+}
+
+func (x *Candle) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *Candle) GetOpenPrice() float32 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *Candle) GetHighPrice() float32 {
+	if x != nil {
+		return x.HighPrice
+	}
+	return 0
+}
+
+func (x *Candle) GetLowPrice() float32 {
+	if x != nil {
+		return x.LowPrice
+	}
+	return 0
+}
+
+func (x *Candle) GetClosePrice() float32 {
+	if x != nil {
+		return x.ClosePrice
+	}
+	return 0
+}
+
+func (x *Candle) GetBaseAssetVolume() float32 {
+	if x != nil {
+		return x.BaseAssetVolume
+	}
+	return 0
+}
+
+func (x *Candle) GetQuoteAssetVolume() float32 {
+	if x != nil {
+		return x.QuoteAssetVolume
+	}
+	return 0
+}
+
+func (x *Candle) GetNumberOfTrades() int64 {
+	if x != nil {
+		return x.NumberOfTrades
+	}
+	return 0
+}
+
+func (x *Candle) GetIsClosed() bool {
+	if x != nil {
+		return x.IsClosed
+	}
+	return false
+}
+
+type ListCandlesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candles []*Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+}
+
+func (x *ListCandlesResponse) Reset() {
+	*x = ListCandlesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCandlesResponse) ProtoMessage() {}
+
+func (x *ListCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_s_market_data_proto_marketdata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishATHInformationResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCandlesResponse.ProtoReflect.Descriptor instead.
+func (*ListCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListCandlesResponse) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+type GetFundingRateHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The underlying asset i.e `BTC`; lists the funding rates of the asset on every venue.
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Optionally lists the funding rates of a single venue i.e `binance`, `ftx` or `bitfinex`.
+	Venue string `protobuf:"bytes,2,opt,name=venue,proto3" json:"venue,omitempty"`
+	// Funding rates within [from, to) are listed, oldest first; defaults to the most recent funding rates up to the limit.
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Defaults to 500; at most 2000.
+	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetFundingRateHistoryRequest) Reset() {
+	*x = GetFundingRateHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFundingRateHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFundingRateHistoryRequest) ProtoMessage() {}
+
+func (x *GetFundingRateHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_s_market_data_proto_marketdata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishFundingRatesInformationRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFundingRateHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetFundingRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetFundingRateHistoryRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFundingRateHistoryRequest) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *GetFundingRateHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetFundingRateHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetFundingRateHistoryRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FundingRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Venue string `protobuf:"bytes,1,opt,name=venue,proto3" json:"venue,omitempty"`
+	// The venue's symbol i.e `BTC-PERP`.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Asset  string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// The funding rate as a percentage, paid every funding interval.
+	FundingRate          float32 `protobuf:"fixed32,4,opt,name=funding_rate,json=fundingRate,proto3" json:"funding_rate,omitempty"`
+	FundingIntervalHours int64   `protobuf:"varint,5,opt,name=funding_interval_hours,json=fundingIntervalHours,proto3" json:"funding_interval_hours,omitempty"`
+	// The funding rate as an annualised percentage; so rates of venues with different funding intervals can be compared.
+	AnnualisedFundingRate float32                `protobuf:"fixed32,6,opt,name=annualised_funding_rate,json=annualisedFundingRate,proto3" json:"annualised_funding_rate,omitempty"`
+	Timestamp             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *FundingRate) Reset() {
+	*x = FundingRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundingRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingRate) ProtoMessage() {}
+
+func (x *FundingRate) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
+func (*FundingRate) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FundingRate) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *FundingRate) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *FundingRate) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FundingRate) GetFundingRate() float32 {
+	if x != nil {
+		return x.FundingRate
+	}
+	return 0
+}
+
+func (x *FundingRate) GetFundingIntervalHours() int64 {
+	if x != nil {
+		return x.FundingIntervalHours
+	}
+	return 0
+}
+
+func (x *FundingRate) GetAnnualisedFundingRate() float32 {
+	if x != nil {
+		return x.AnnualisedFundingRate
+	}
+	return 0
+}
+
+func (x *FundingRate) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type GetFundingRateHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FundingRates []*FundingRate `protobuf:"bytes,1,rep,name=funding_rates,json=fundingRates,proto3" json:"funding_rates,omitempty"`
+}
+
+func (x *GetFundingRateHistoryResponse) Reset() {
+	*x = GetFundingRateHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFundingRateHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFundingRateHistoryResponse) ProtoMessage() {}
+
+func (x *GetFundingRateHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFundingRateHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetFundingRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetFundingRateHistoryResponse) GetFundingRates() []*FundingRate {
+	if x != nil {
+		return x.FundingRates
+	}
+	return nil
+}
+
+type WatchlistAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of `prices`, `funding-rates` or `solana-nfts`.
+	Watchlist string `protobuf:"bytes,1,opt,name=watchlist,proto3" json:"watchlist,omitempty"`
+	// The user that owns the watchlist; empty for the global watchlists. Only `prices` watchlists can be personal.
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// The asset's symbol i.e `sol`, the venue's symbol for funding rates i.e `SOL-PERP`, or the collection id for solana
+	// nfts.
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The asset pair of prices i.e `usd`.
+	AssetPair string `protobuf:"bytes,4,opt,name=asset_pair,json=assetPair,proto3" json:"asset_pair,omitempty"`
+	// The venue for funding rates i.e `ftx`, or the vendor for solana nfts i.e `solanart`.
+	Venue string `protobuf:"bytes,5,opt,name=venue,proto3" json:"venue,omitempty"`
+	// The group prices are published under i.e `solana`; defaults to `other`.
+	Grouping string `protobuf:"bytes,6,opt,name=grouping,proto3" json:"grouping,omitempty"`
+	// One of `low`, `medium`, `high` or `extreme`; defaults to `low`.
+	VolatilityRating string `protobuf:"bytes,7,opt,name=volatility_rating,json=volatilityRating,proto3" json:"volatility_rating,omitempty"`
+	HumanizedName    string `protobuf:"bytes,8,opt,name=humanized_name,json=humanizedName,proto3" json:"humanized_name,omitempty"`
+	// The underlying asset of funding rates i.e `SOL`.
+	Asset     string                 `protobuf:"bytes,9,opt,name=asset,proto3" json:"asset,omitempty"`
+	Emoji     string                 `protobuf:"bytes,10,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	CreatedBy string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Created   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *WatchlistAsset) Reset() {
+	*x = WatchlistAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchlistAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistAsset) ProtoMessage() {}
+
+func (x *WatchlistAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistAsset.ProtoReflect.Descriptor instead.
+func (*WatchlistAsset) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchlistAsset) GetWatchlist() string {
+	if x != nil {
+		return x.Watchlist
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetAssetPair() string {
+	if x != nil {
+		return x.AssetPair
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetGrouping() string {
+	if x != nil {
+		return x.Grouping
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetVolatilityRating() string {
+	if x != nil {
+		return x.VolatilityRating
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetHumanizedName() string {
+	if x != nil {
+		return x.HumanizedName
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *WatchlistAsset) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+type AddWatchlistAssetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Updates the asset if it's already on the watchlist.
+	Asset *WatchlistAsset `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+}
+
+func (x *AddWatchlistAssetRequest) Reset() {
+	*x = AddWatchlistAssetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddWatchlistAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatchlistAssetRequest) ProtoMessage() {}
+
+func (x *AddWatchlistAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatchlistAssetRequest.ProtoReflect.Descriptor instead.
+func (*AddWatchlistAssetRequest) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddWatchlistAssetRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AddWatchlistAssetRequest) GetAsset() *WatchlistAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type AddWatchlistAssetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset *WatchlistAsset `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+}
+
+func (x *AddWatchlistAssetResponse) Reset() {
+	*x = AddWatchlistAssetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddWatchlistAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWatchlistAssetResponse) ProtoMessage() {}
+
+func (x *AddWatchlistAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWatchlistAssetResponse.ProtoReflect.Descriptor instead.
+func (*AddWatchlistAssetResponse) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddWatchlistAssetResponse) GetAsset() *WatchlistAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type RemoveWatchlistAssetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId   string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Watchlist string `protobuf:"bytes,2,opt,name=watchlist,proto3" json:"watchlist,omitempty"`
+	OwnerId   string `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Symbol    string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	AssetPair string `protobuf:"bytes,5,opt,name=asset_pair,json=assetPair,proto3" json:"asset_pair,omitempty"`
+	Venue     string `protobuf:"bytes,6,opt,name=venue,proto3" json:"venue,omitempty"`
+}
+
+func (x *RemoveWatchlistAssetRequest) Reset() {
+	*x = RemoveWatchlistAssetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWatchlistAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatchlistAssetRequest) ProtoMessage() {}
+
+func (x *RemoveWatchlistAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatchlistAssetRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWatchlistAssetRequest) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveWatchlistAssetRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *RemoveWatchlistAssetRequest) GetWatchlist() string {
+	if x != nil {
+		return x.Watchlist
+	}
+	return ""
+}
+
+func (x *RemoveWatchlistAssetRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RemoveWatchlistAssetRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RemoveWatchlistAssetRequest) GetAssetPair() string {
+	if x != nil {
+		return x.AssetPair
+	}
+	return ""
+}
+
+func (x *RemoveWatchlistAssetRequest) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+type RemoveWatchlistAssetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveWatchlistAssetResponse) Reset() {
+	*x = RemoveWatchlistAssetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWatchlistAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWatchlistAssetResponse) ProtoMessage() {}
+
+func (x *RemoveWatchlistAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWatchlistAssetResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWatchlistAssetResponse) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{20}
+}
+
+type ListWatchlistAssetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Watchlist string `protobuf:"bytes,1,opt,name=watchlist,proto3" json:"watchlist,omitempty"`
+	// Lists the personal watchlist of the user; or the global watchlist if empty.
+	OwnerId string `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+}
+
+func (x *ListWatchlistAssetsRequest) Reset() {
+	*x = ListWatchlistAssetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWatchlistAssetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistAssetsRequest) ProtoMessage() {}
+
+func (x *ListWatchlistAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistAssetsRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchlistAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListWatchlistAssetsRequest) GetWatchlist() string {
+	if x != nil {
+		return x.Watchlist
+	}
+	return ""
+}
+
+func (x *ListWatchlistAssetsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+type ListWatchlistAssetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assets []*WatchlistAsset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+}
+
+func (x *ListWatchlistAssetsResponse) Reset() {
+	*x = ListWatchlistAssetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWatchlistAssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistAssetsResponse) ProtoMessage() {}
+
+func (x *ListWatchlistAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistAssetsResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchlistAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListWatchlistAssetsResponse) GetAssets() []*WatchlistAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type PublishWatchlistDigestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PublishWatchlistDigestsRequest) Reset() {
+	*x = PublishWatchlistDigestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishWatchlistDigestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishWatchlistDigestsRequest) ProtoMessage() {}
+
+func (x *PublishWatchlistDigestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishWatchlistDigestsRequest.ProtoReflect.Descriptor instead.
+func (*PublishWatchlistDigestsRequest) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{23}
+}
+
+type PublishWatchlistDigestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumberOfDigestsSent   int64 `protobuf:"varint,1,opt,name=number_of_digests_sent,json=numberOfDigestsSent,proto3" json:"number_of_digests_sent,omitempty"`
+	NumberOfDigestsFailed int64 `protobuf:"varint,2,opt,name=number_of_digests_failed,json=numberOfDigestsFailed,proto3" json:"number_of_digests_failed,omitempty"`
+}
+
+func (x *PublishWatchlistDigestsResponse) Reset() {
+	*x = PublishWatchlistDigestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishWatchlistDigestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishWatchlistDigestsResponse) ProtoMessage() {}
+
+func (x *PublishWatchlistDigestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishWatchlistDigestsResponse.ProtoReflect.Descriptor instead.
+func (*PublishWatchlistDigestsResponse) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PublishWatchlistDigestsResponse) GetNumberOfDigestsSent() int64 {
+	if x != nil {
+		return x.NumberOfDigestsSent
+	}
+	return 0
+}
+
+func (x *PublishWatchlistDigestsResponse) GetNumberOfDigestsFailed() int64 {
+	if x != nil {
+		return x.NumberOfDigestsFailed
+	}
+	return 0
+}
+
+type PriceAlert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlertId string `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	// The user that's paged; they must have an account.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The asset's symbol i.e `btc`.
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The asset pair i.e `usdt`; USD pairs are priced against USDT.
+	AssetPair string `protobuf:"bytes,4,opt,name=asset_pair,json=assetPair,proto3" json:"asset_pair,omitempty"`
+	// One of `above`, `below` or `move`.
+	Condition string `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	// The price level of `above` & `below` alerts.
+	TargetPrice float64 `protobuf:"fixed64,6,opt,name=target_price,json=targetPrice,proto3" json:"target_price,omitempty"`
+	// The percentage move, in either direction, of `move` alerts.
+	MovePercentage float64 `protobuf:"fixed64,7,opt,name=move_percentage,json=movePercentage,proto3" json:"move_percentage,omitempty"`
+	// The window of `move` alerts, in minutes; at most 12 hours.
+	WindowMinutes int64 `protobuf:"varint,8,opt,name=window_minutes,json=windowMinutes,proto3" json:"window_minutes,omitempty"`
+	// Recurring alerts stay active once triggered; alerts of a level are rearmed once the price crosses back over it, &
+	// move alerts once the window has passed.
+	IsRecurring bool `protobuf:"varint,9,opt,name=is_recurring,json=isRecurring,proto3" json:"is_recurring,omitempty"`
+	// Pages the user's high priority pager, rather than their low priority pager.
+	IsHighPriority bool `protobuf:"varint,10,opt,name=is_high_priority,json=isHighPriority,proto3" json:"is_high_priority,omitempty"`
+	// One of `active`, `triggered` or `failed`.
+	Status           string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	NumberOfTriggers int64                  `protobuf:"varint,12,opt,name=number_of_triggers,json=numberOfTriggers,proto3" json:"number_of_triggers,omitempty"`
+	LastTriggered    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_triggered,json=lastTriggered,proto3" json:"last_triggered,omitempty"`
+	CreatedBy        string                 `protobuf:"bytes,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Created          *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *PriceAlert) Reset() {
+	*x = PriceAlert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAlert) ProtoMessage() {}
+
+func (x *PriceAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAlert.ProtoReflect.Descriptor instead.
+func (*PriceAlert) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PriceAlert) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetAssetPair() string {
+	if x != nil {
+		return x.AssetPair
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetTargetPrice() float64 {
+	if x != nil {
+		return x.TargetPrice
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetMovePercentage() float64 {
+	if x != nil {
+		return x.MovePercentage
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetWindowMinutes() int64 {
+	if x != nil {
+		return x.WindowMinutes
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetIsRecurring() bool {
+	if x != nil {
+		return x.IsRecurring
+	}
+	return false
+}
+
+func (x *PriceAlert) GetIsHighPriority() bool {
+	if x != nil {
+		return x.IsHighPriority
+	}
+	return false
+}
+
+func (x *PriceAlert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetNumberOfTriggers() int64 {
+	if x != nil {
+		return x.NumberOfTriggers
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetLastTriggered() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTriggered
+	}
+	return nil
+}
+
+func (x *PriceAlert) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+type CreatePriceAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId string      `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Alert   *PriceAlert `protobuf:"bytes,2,opt,name=alert,proto3" json:"alert,omitempty"`
+}
+
+func (x *CreatePriceAlertRequest) Reset() {
+	*x = CreatePriceAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceAlertRequest) ProtoMessage() {}
+
+func (x *CreatePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreatePriceAlertRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CreatePriceAlertRequest) GetAlert() *PriceAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+type CreatePriceAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alert        *PriceAlert `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	CurrentPrice float64     `protobuf:"fixed64,2,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+}
+
+func (x *CreatePriceAlertResponse) Reset() {
+	*x = CreatePriceAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePriceAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceAlertResponse) ProtoMessage() {}
+
+func (x *CreatePriceAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreatePriceAlertResponse) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreatePriceAlertResponse) GetAlert() *PriceAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *CreatePriceAlertResponse) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+type ListPriceAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ListPriceAlertsRequest) Reset() {
+	*x = ListPriceAlertsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceAlertsRequest) ProtoMessage() {}
+
+func (x *ListPriceAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListPriceAlertsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListPriceAlertsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alerts []*PriceAlert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+}
+
+func (x *ListPriceAlertsResponse) Reset() {
+	*x = ListPriceAlertsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceAlertsResponse) ProtoMessage() {}
+
+func (x *ListPriceAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListPriceAlertsResponse) GetAlerts() []*PriceAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type DeletePriceAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AlertId string `protobuf:"bytes,3,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+}
+
+func (x *DeletePriceAlertRequest) Reset() {
+	*x = DeletePriceAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceAlertRequest) ProtoMessage() {}
+
+func (x *DeletePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeletePriceAlertRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *DeletePriceAlertRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeletePriceAlertRequest) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+type DeletePriceAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePriceAlertResponse) Reset() {
+	*x = DeletePriceAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_market_data_proto_marketdata_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceAlertResponse) ProtoMessage() {}
+
+func (x *DeletePriceAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_market_data_proto_marketdata_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeletePriceAlertResponse) Descriptor() ([]byte, []int) {
+	return file_s_market_data_proto_marketdata_proto_rawDescGZIP(), []int{31}
+}
+
+var File_s_market_data_proto_marketdata_proto protoreflect.FileDescriptor
+
+var file_s_market_data_proto_marketdata_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x24, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x27, 0x0a, 0x25, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x0a, 0x23, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x26, 0x0a, 0x24, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x41, 0x54, 0x48, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x41, 0x54, 0x48, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x25, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x28, 0x0a, 0x26, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x27, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x4e, 0x46, 0x54, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x28, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x4e, 0x46, 0x54, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xcd, 0x03, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x37,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x22,
+	0x38, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x52, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9c, 0x02, 0x0a, 0x0b, 0x46, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x34, 0x0a, 0x16, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x14, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x69,
+	0x73, 0x65, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x69, 0x73,
+	0x65, 0x64, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x52, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x46, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x66,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x87, 0x03, 0x0a, 0x0e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67,
+	0x12, 0x2b, 0x0a, 0x11, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a,
+	0x0e, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x22, 0x42, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c,
+	0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x50, 0x61,
+	0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x46, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x20, 0x0a, 0x1e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x1f, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x16, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x53, 0x65,
+	0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0xb3, 0x04, 0x0a, 0x0a,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c,
+	0x65, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c,
+	0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x70, 0x61, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73, 0x5f,
+	0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x48, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f,
+	0x66, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x22, 0x57, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x22, 0x62, 0x0a, 0x18, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x31,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x3e, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06,
+	0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74,
+	0x73, 0x22, 0x68, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x84, 0x0a, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x64, 0x61, 0x74, 0x61, 0x12, 0x70, 0x0a, 0x1d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x41, 0x54, 0x48, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1d, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x54, 0x48, 0x49, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x54, 0x48, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x73, 0x0a, 0x1e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x75, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x20, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x4e, 0x46, 0x54, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x53, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x4e, 0x46, 0x54, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x6f,
+	0x6c, 0x61, 0x6e, 0x61, 0x4e, 0x46, 0x54, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x12, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x41,
+	0x64, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c,
+	0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x17, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x6c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69, 0x73, 0x74, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x17,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x14,
+	0x5a, 0x12, 0x2e, 0x2f, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x64, 0x61, 0x74, 0x61, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_s_market_data_proto_marketdata_proto_rawDescOnce sync.Once
+	file_s_market_data_proto_marketdata_proto_rawDescData = file_s_market_data_proto_marketdata_proto_rawDesc
+)
+
+func file_s_market_data_proto_marketdata_proto_rawDescGZIP() []byte {
+	file_s_market_data_proto_marketdata_proto_rawDescOnce.Do(func() {
+		file_s_market_data_proto_marketdata_proto_rawDescData = protoimpl.X.CompressGZIP(file_s_market_data_proto_marketdata_proto_rawDescData)
+	})
+	return file_s_market_data_proto_marketdata_proto_rawDescData
+}
+
+var file_s_market_data_proto_marketdata_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_s_market_data_proto_marketdata_proto_goTypes = []interface{}{
+	(*PublishLatestPriceInformationRequest)(nil),     // 0: PublishLatestPriceInformationRequest
+	(*PublishLatestPriceInformationResponse)(nil),    // 1: PublishLatestPriceInformationResponse
+	(*PublishVolatilityInformationRequest)(nil),      // 2: PublishVolatilityInformationRequest
+	(*PublishVolatilityInformationResponse)(nil),     // 3: PublishVolatilityInformationResponse
+	(*PublishATHInformationRequest)(nil),             // 4: PublishATHInformationRequest
+	(*PublishATHInformationResponse)(nil),            // 5: PublishATHInformationResponse
+	(*PublishFundingRatesInformationRequest)(nil),    // 6: PublishFundingRatesInformationRequest
+	(*PublishFundingRatesInformationResponse)(nil),   // 7: PublishFundingRatesInformationResponse
+	(*PublishSolanaNFTPriceInformationRequest)(nil),  // 8: PublishSolanaNFTPriceInformationRequest
+	(*PublishSolanaNFTPriceInformationResponse)(nil), // 9: PublishSolanaNFTPriceInformationResponse
+	(*ListCandlesRequest)(nil),                       // 10: ListCandlesRequest
+	(*Candle)(nil),                                   // 11: Candle
+	(*ListCandlesResponse)(nil),                      // 12: ListCandlesResponse
+	(*GetFundingRateHistoryRequest)(nil),             // 13: GetFundingRateHistoryRequest
+	(*FundingRate)(nil),                              // 14: FundingRate
+	(*GetFundingRateHistoryResponse)(nil),            // 15: GetFundingRateHistoryResponse
+	(*WatchlistAsset)(nil),                           // 16: WatchlistAsset
+	(*AddWatchlistAssetRequest)(nil),                 // 17: AddWatchlistAssetRequest
+	(*AddWatchlistAssetResponse)(nil),                // 18: AddWatchlistAssetResponse
+	(*RemoveWatchlistAssetRequest)(nil),              // 19: RemoveWatchlistAssetRequest
+	(*RemoveWatchlistAssetResponse)(nil),             // 20: RemoveWatchlistAssetResponse
+	(*ListWatchlistAssetsRequest)(nil),               // 21: ListWatchlistAssetsRequest
+	(*ListWatchlistAssetsResponse)(nil),              // 22: ListWatchlistAssetsResponse
+	(*PublishWatchlistDigestsRequest)(nil),           // 23: PublishWatchlistDigestsRequest
+	(*PublishWatchlistDigestsResponse)(nil),          // 24: PublishWatchlistDigestsResponse
+	(*PriceAlert)(nil),                               // 25: PriceAlert
+	(*CreatePriceAlertRequest)(nil),                  // 26: CreatePriceAlertRequest
+	(*CreatePriceAlertResponse)(nil),                 // 27: CreatePriceAlertResponse
+	(*ListPriceAlertsRequest)(nil),                   // 28: ListPriceAlertsRequest
+	(*ListPriceAlertsResponse)(nil),                  // 29: ListPriceAlertsResponse
+	(*DeletePriceAlertRequest)(nil),                  // 30: DeletePriceAlertRequest
+	(*DeletePriceAlertResponse)(nil),                 // 31: DeletePriceAlertResponse
+	(*timestamppb.Timestamp)(nil),                    // 32: google.protobuf.Timestamp
+}
+var file_s_market_data_proto_marketdata_proto_depIdxs = []int32{
+	32, // 0: ListCandlesRequest.from:type_name -> google.protobuf.Timestamp
+	32, // 1: ListCandlesRequest.to:type_name -> google.protobuf.Timestamp
+	32, // 2: Candle.open_time:type_name -> google.protobuf.Timestamp
+	32, // 3: Candle.close_time:type_name -> google.protobuf.Timestamp
+	11, // 4: ListCandlesResponse.candles:type_name -> Candle
+	32, // 5: GetFundingRateHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	32, // 6: GetFundingRateHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	32, // 7: FundingRate.timestamp:type_name -> google.protobuf.Timestamp
+	14, // 8: GetFundingRateHistoryResponse.funding_rates:type_name -> FundingRate
+	32, // 9: WatchlistAsset.created:type_name -> google.protobuf.Timestamp
+	16, // 10: AddWatchlistAssetRequest.asset:type_name -> WatchlistAsset
+	16, // 11: AddWatchlistAssetResponse.asset:type_name -> WatchlistAsset
+	16, // 12: ListWatchlistAssetsResponse.assets:type_name -> WatchlistAsset
+	32, // 13: PriceAlert.last_triggered:type_name -> google.protobuf.Timestamp
+	32, // 14: PriceAlert.created:type_name -> google.protobuf.Timestamp
+	25, // 15: CreatePriceAlertRequest.alert:type_name -> PriceAlert
+	25, // 16: CreatePriceAlertResponse.alert:type_name -> PriceAlert
+	25, // 17: ListPriceAlertsResponse.alerts:type_name -> PriceAlert
+	0,  // 18: marketdata.PublishLatestPriceInformation:input_type -> PublishLatestPriceInformationRequest
+	2,  // 19: marketdata.PublishVolatilityInformation:input_type -> PublishVolatilityInformationRequest
+	4,  // 20: marketdata.PublishATHInformation:input_type -> PublishATHInformationRequest
+	6,  // 21: marketdata.PublishFundingRatesInformation:input_type -> PublishFundingRatesInformationRequest
+	8,  // 22: marketdata.PublishSolanaNFTPriceInformation:input_type -> PublishSolanaNFTPriceInformationRequest
+	10, // 23: marketdata.ListCandles:input_type -> ListCandlesRequest
+	13, // 24: marketdata.GetFundingRateHistory:input_type -> GetFundingRateHistoryRequest
+	17, // 25: marketdata.AddWatchlistAsset:input_type -> AddWatchlistAssetRequest
+	19, // 26: marketdata.RemoveWatchlistAsset:input_type -> RemoveWatchlistAssetRequest
+	21, // 27: marketdata.ListWatchlistAssets:input_type -> ListWatchlistAssetsRequest
+	23, // 28: marketdata.PublishWatchlistDigests:input_type -> PublishWatchlistDigestsRequest
+	26, // 29: marketdata.CreatePriceAlert:input_type -> CreatePriceAlertRequest
+	28, // 30: marketdata.ListPriceAlerts:input_type -> ListPriceAlertsRequest
+	30, // 31: marketdata.DeletePriceAlert:input_type -> DeletePriceAlertRequest
+	1,  // 32: marketdata.PublishLatestPriceInformation:output_type -> PublishLatestPriceInformationResponse
+	3,  // 33: marketdata.PublishVolatilityInformation:output_type -> PublishVolatilityInformationResponse
+	5,  // 34: marketdata.PublishATHInformation:output_type -> PublishATHInformationResponse
+	7,  // 35: marketdata.PublishFundingRatesInformation:output_type -> PublishFundingRatesInformationResponse
+	9,  // 36: marketdata.PublishSolanaNFTPriceInformation:output_type -> PublishSolanaNFTPriceInformationResponse
+	12, // 37: marketdata.ListCandles:output_type -> ListCandlesResponse
+	15, // 38: marketdata.GetFundingRateHistory:output_type -> GetFundingRateHistoryResponse
+	18, // 39: marketdata.AddWatchlistAsset:output_type -> AddWatchlistAssetResponse
+	20, // 40: marketdata.RemoveWatchlistAsset:output_type -> RemoveWatchlistAssetResponse
+	22, // 41: marketdata.ListWatchlistAssets:output_type -> ListWatchlistAssetsResponse
+	24, // 42: marketdata.PublishWatchlistDigests:output_type -> PublishWatchlistDigestsResponse
+	27, // 43: marketdata.CreatePriceAlert:output_type -> CreatePriceAlertResponse
+	29, // 44: marketdata.ListPriceAlerts:output_type -> ListPriceAlertsResponse
+	31, // 45: marketdata.DeletePriceAlert:output_type -> DeletePriceAlertResponse
+	32, // [32:46] is the sub-list for method output_type
+	18, // [18:32] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_s_market_data_proto_marketdata_proto_init() }
+func file_s_market_data_proto_marketdata_proto_init() {
+	if File_s_market_data_proto_marketdata_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_s_market_data_proto_marketdata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishLatestPriceInformationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishLatestPriceInformationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishVolatilityInformationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishVolatilityInformationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishATHInformationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishATHInformationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishFundingRatesInformationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -644,6 +2495,270 @@ func file_s_market_data_proto_marketdata_proto_init() {
 				return nil
 			}
 		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCandlesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCandlesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFundingRateHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundingRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFundingRateHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchlistAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWatchlistAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddWatchlistAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWatchlistAssetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveWatchlistAssetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWatchlistAssetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWatchlistAssetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishWatchlistDigestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishWatchlistDigestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceAlert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePriceAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePriceAlertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPriceAlertsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPriceAlertsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePriceAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_market_data_proto_marketdata_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePriceAlertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -651,7 +2766,7 @@ func file_s_market_data_proto_marketdata_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_s_market_data_proto_marketdata_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -6,6 +6,8 @@ import (
 
 	"github.com/monzo/slog"
 	grpc "google.golang.org/grpc"
+
+	"swallowtail/libraries/gerrors"
 )
 
 // --- Publish Latest Price Information --- //
@@ -75,3 +77,660 @@ func (r *PublishLatestPriceInformationRequest) SendWithTimeout(ctx context.Conte
 		resultc: resultc,
 	}
 }
+
+// --- List Candles --- //
+
+type ListCandlesFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListCandlesResponse
+	ctx     context.Context
+}
+
+func (a *ListCandlesFuture) Response() (*ListCandlesResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_candles", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListCandlesRequest) Send(ctx context.Context) *ListCandlesFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListCandlesRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListCandlesFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListCandlesResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-marketdata:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_marketdata_connection_failed", nil)
+		return &ListCandlesFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewMarketdataClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListCandles(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_candles", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListCandlesFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Get Funding Rate History --- //
+
+type GetFundingRateHistoryFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *GetFundingRateHistoryResponse
+	ctx     context.Context
+}
+
+func (a *GetFundingRateHistoryFuture) Response() (*GetFundingRateHistoryResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "get_funding_rate_history", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *GetFundingRateHistoryRequest) Send(ctx context.Context) *GetFundingRateHistoryFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *GetFundingRateHistoryRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *GetFundingRateHistoryFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *GetFundingRateHistoryResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-marketdata:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_marketdata_connection_failed", nil)
+		return &GetFundingRateHistoryFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewMarketdataClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.GetFundingRateHistory(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_get_funding_rate_history", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &GetFundingRateHistoryFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Add Watchlist Asset --- //
+
+type AddWatchlistAssetFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *AddWatchlistAssetResponse
+	ctx     context.Context
+}
+
+func (a *AddWatchlistAssetFuture) Response() (*AddWatchlistAssetResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "add_watchlist_asset", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *AddWatchlistAssetRequest) Send(ctx context.Context) *AddWatchlistAssetFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *AddWatchlistAssetRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *AddWatchlistAssetFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *AddWatchlistAssetResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-marketdata:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_marketdata_connection_failed", nil)
+		return &AddWatchlistAssetFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewMarketdataClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.AddWatchlistAsset(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_add_watchlist_asset", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &AddWatchlistAssetFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Remove Watchlist Asset --- //
+
+type RemoveWatchlistAssetFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *RemoveWatchlistAssetResponse
+	ctx     context.Context
+}
+
+func (a *RemoveWatchlistAssetFuture) Response() (*RemoveWatchlistAssetResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "remove_watchlist_asset", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *RemoveWatchlistAssetRequest) Send(ctx context.Context) *RemoveWatchlistAssetFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *RemoveWatchlistAssetRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *RemoveWatchlistAssetFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *RemoveWatchlistAssetResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-marketdata:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_marketdata_connection_failed", nil)
+		return &RemoveWatchlistAssetFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewMarketdataClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.RemoveWatchlistAsset(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_remove_watchlist_asset", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &RemoveWatchlistAssetFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- List Watchlist Assets --- //
+
+type ListWatchlistAssetsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListWatchlistAssetsResponse
+	ctx     context.Context
+}
+
+func (a *ListWatchlistAssetsFuture) Response() (*ListWatchlistAssetsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_watchlist_assets", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListWatchlistAssetsRequest) Send(ctx context.Context) *ListWatchlistAssetsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListWatchlistAssetsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListWatchlistAssetsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListWatchlistAssetsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-marketdata:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_marketdata_connection_failed", nil)
+		return &ListWatchlistAssetsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewMarketdataClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListWatchlistAssets(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_watchlist_assets", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListWatchlistAssetsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Publish Watchlist Digests --- //
+
+type PublishWatchlistDigestsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *PublishWatchlistDigestsResponse
+	ctx     context.Context
+}
+
+func (a *PublishWatchlistDigestsFuture) Response() (*PublishWatchlistDigestsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "publish_watchlist_digests", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *PublishWatchlistDigestsRequest) Send(ctx context.Context) *PublishWatchlistDigestsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *PublishWatchlistDigestsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *PublishWatchlistDigestsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *PublishWatchlistDigestsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-marketdata:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_marketdata_connection_failed", nil)
+		return &PublishWatchlistDigestsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewMarketdataClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.PublishWatchlistDigests(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_publish_watchlist_digests", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &PublishWatchlistDigestsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Create Price Alert --- //
+
+type CreatePriceAlertFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *CreatePriceAlertResponse
+	ctx     context.Context
+}
+
+func (a *CreatePriceAlertFuture) Response() (*CreatePriceAlertResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "create_price_alert", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *CreatePriceAlertRequest) Send(ctx context.Context) *CreatePriceAlertFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *CreatePriceAlertRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *CreatePriceAlertFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *CreatePriceAlertResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-marketdata:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_marketdata_connection_failed", nil)
+		return &CreatePriceAlertFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewMarketdataClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.CreatePriceAlert(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_create_price_alert", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &CreatePriceAlertFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- List Price Alerts --- //
+
+type ListPriceAlertsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListPriceAlertsResponse
+	ctx     context.Context
+}
+
+func (a *ListPriceAlertsFuture) Response() (*ListPriceAlertsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_price_alerts", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListPriceAlertsRequest) Send(ctx context.Context) *ListPriceAlertsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListPriceAlertsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListPriceAlertsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListPriceAlertsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-marketdata:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_marketdata_connection_failed", nil)
+		return &ListPriceAlertsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewMarketdataClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListPriceAlerts(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_price_alerts", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListPriceAlertsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Delete Price Alert --- //
+
+type DeletePriceAlertFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *DeletePriceAlertResponse
+	ctx     context.Context
+}
+
+func (a *DeletePriceAlertFuture) Response() (*DeletePriceAlertResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "delete_price_alert", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *DeletePriceAlertRequest) Send(ctx context.Context) *DeletePriceAlertFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *DeletePriceAlertRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *DeletePriceAlertFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *DeletePriceAlertResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-marketdata:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_marketdata_connection_failed", nil)
+		return &DeletePriceAlertFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewMarketdataClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.DeletePriceAlert(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_delete_price_alert", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &DeletePriceAlertFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
@@ -11,7 +11,8 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
 
 // MarketdataClient is the client API for Marketdata service.
 //
@@ -22,6 +23,15 @@ type MarketdataClient interface {
 	PublishATHInformation(ctx context.Context, in *PublishATHInformationRequest, opts ...grpc.CallOption) (*PublishATHInformationResponse, error)
 	PublishFundingRatesInformation(ctx context.Context, in *PublishFundingRatesInformationRequest, opts ...grpc.CallOption) (*PublishFundingRatesInformationResponse, error)
 	PublishSolanaNFTPriceInformation(ctx context.Context, in *PublishSolanaNFTPriceInformationRequest, opts ...grpc.CallOption) (*PublishSolanaNFTPriceInformationResponse, error)
+	ListCandles(ctx context.Context, in *ListCandlesRequest, opts ...grpc.CallOption) (*ListCandlesResponse, error)
+	GetFundingRateHistory(ctx context.Context, in *GetFundingRateHistoryRequest, opts ...grpc.CallOption) (*GetFundingRateHistoryResponse, error)
+	AddWatchlistAsset(ctx context.Context, in *AddWatchlistAssetRequest, opts ...grpc.CallOption) (*AddWatchlistAssetResponse, error)
+	RemoveWatchlistAsset(ctx context.Context, in *RemoveWatchlistAssetRequest, opts ...grpc.CallOption) (*RemoveWatchlistAssetResponse, error)
+	ListWatchlistAssets(ctx context.Context, in *ListWatchlistAssetsRequest, opts ...grpc.CallOption) (*ListWatchlistAssetsResponse, error)
+	PublishWatchlistDigests(ctx context.Context, in *PublishWatchlistDigestsRequest, opts ...grpc.CallOption) (*PublishWatchlistDigestsResponse, error)
+	CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*CreatePriceAlertResponse, error)
+	ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error)
+	DeletePriceAlert(ctx context.Context, in *DeletePriceAlertRequest, opts ...grpc.CallOption) (*DeletePriceAlertResponse, error)
 }
 
 type marketdataClient struct {
@@ -77,6 +87,87 @@ func (c *marketdataClient) PublishSolanaNFTPriceInformation(ctx context.Context,
 	return out, nil
 }
 
+func (c *marketdataClient) ListCandles(ctx context.Context, in *ListCandlesRequest, opts ...grpc.CallOption) (*ListCandlesResponse, error) {
+	out := new(ListCandlesResponse)
+	err := c.cc.Invoke(ctx, "/marketdata/ListCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketdataClient) GetFundingRateHistory(ctx context.Context, in *GetFundingRateHistoryRequest, opts ...grpc.CallOption) (*GetFundingRateHistoryResponse, error) {
+	out := new(GetFundingRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/marketdata/GetFundingRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketdataClient) AddWatchlistAsset(ctx context.Context, in *AddWatchlistAssetRequest, opts ...grpc.CallOption) (*AddWatchlistAssetResponse, error) {
+	out := new(AddWatchlistAssetResponse)
+	err := c.cc.Invoke(ctx, "/marketdata/AddWatchlistAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketdataClient) RemoveWatchlistAsset(ctx context.Context, in *RemoveWatchlistAssetRequest, opts ...grpc.CallOption) (*RemoveWatchlistAssetResponse, error) {
+	out := new(RemoveWatchlistAssetResponse)
+	err := c.cc.Invoke(ctx, "/marketdata/RemoveWatchlistAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketdataClient) ListWatchlistAssets(ctx context.Context, in *ListWatchlistAssetsRequest, opts ...grpc.CallOption) (*ListWatchlistAssetsResponse, error) {
+	out := new(ListWatchlistAssetsResponse)
+	err := c.cc.Invoke(ctx, "/marketdata/ListWatchlistAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketdataClient) PublishWatchlistDigests(ctx context.Context, in *PublishWatchlistDigestsRequest, opts ...grpc.CallOption) (*PublishWatchlistDigestsResponse, error) {
+	out := new(PublishWatchlistDigestsResponse)
+	err := c.cc.Invoke(ctx, "/marketdata/PublishWatchlistDigests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketdataClient) CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*CreatePriceAlertResponse, error) {
+	out := new(CreatePriceAlertResponse)
+	err := c.cc.Invoke(ctx, "/marketdata/CreatePriceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketdataClient) ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error) {
+	out := new(ListPriceAlertsResponse)
+	err := c.cc.Invoke(ctx, "/marketdata/ListPriceAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketdataClient) DeletePriceAlert(ctx context.Context, in *DeletePriceAlertRequest, opts ...grpc.CallOption) (*DeletePriceAlertResponse, error) {
+	out := new(DeletePriceAlertResponse)
+	err := c.cc.Invoke(ctx, "/marketdata/DeletePriceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MarketdataServer is the server API for Marketdata service.
 // All implementations must embed UnimplementedMarketdataServer
 // for forward compatibility
@@ -86,6 +177,15 @@ type MarketdataServer interface {
 	PublishATHInformation(context.Context, *PublishATHInformationRequest) (*PublishATHInformationResponse, error)
 	PublishFundingRatesInformation(context.Context, *PublishFundingRatesInformationRequest) (*PublishFundingRatesInformationResponse, error)
 	PublishSolanaNFTPriceInformation(context.Context, *PublishSolanaNFTPriceInformationRequest) (*PublishSolanaNFTPriceInformationResponse, error)
+	ListCandles(context.Context, *ListCandlesRequest) (*ListCandlesResponse, error)
+	GetFundingRateHistory(context.Context, *GetFundingRateHistoryRequest) (*GetFundingRateHistoryResponse, error)
+	AddWatchlistAsset(context.Context, *AddWatchlistAssetRequest) (*AddWatchlistAssetResponse, error)
+	RemoveWatchlistAsset(context.Context, *RemoveWatchlistAssetRequest) (*RemoveWatchlistAssetResponse, error)
+	ListWatchlistAssets(context.Context, *ListWatchlistAssetsRequest) (*ListWatchlistAssetsResponse, error)
+	PublishWatchlistDigests(context.Context, *PublishWatchlistDigestsRequest) (*PublishWatchlistDigestsResponse, error)
+	CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*CreatePriceAlertResponse, error)
+	ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error)
+	DeletePriceAlert(context.Context, *DeletePriceAlertRequest) (*DeletePriceAlertResponse, error)
 	mustEmbedUnimplementedMarketdataServer()
 }
 
@@ -93,25 +193,59 @@ type MarketdataServer interface {
 type UnimplementedMarketdataServer struct {
 }
 
-func (*UnimplementedMarketdataServer) PublishLatestPriceInformation(context.Context, *PublishLatestPriceInformationRequest) (*PublishLatestPriceInformationResponse, error) {
+func (UnimplementedMarketdataServer) PublishLatestPriceInformation(context.Context, *PublishLatestPriceInformationRequest) (*PublishLatestPriceInformationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishLatestPriceInformation not implemented")
 }
-func (*UnimplementedMarketdataServer) PublishVolatilityInformation(context.Context, *PublishVolatilityInformationRequest) (*PublishVolatilityInformationResponse, error) {
+func (UnimplementedMarketdataServer) PublishVolatilityInformation(context.Context, *PublishVolatilityInformationRequest) (*PublishVolatilityInformationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishVolatilityInformation not implemented")
 }
-func (*UnimplementedMarketdataServer) PublishATHInformation(context.Context, *PublishATHInformationRequest) (*PublishATHInformationResponse, error) {
+func (UnimplementedMarketdataServer) PublishATHInformation(context.Context, *PublishATHInformationRequest) (*PublishATHInformationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishATHInformation not implemented")
 }
-func (*UnimplementedMarketdataServer) PublishFundingRatesInformation(context.Context, *PublishFundingRatesInformationRequest) (*PublishFundingRatesInformationResponse, error) {
+func (UnimplementedMarketdataServer) PublishFundingRatesInformation(context.Context, *PublishFundingRatesInformationRequest) (*PublishFundingRatesInformationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishFundingRatesInformation not implemented")
 }
-func (*UnimplementedMarketdataServer) PublishSolanaNFTPriceInformation(context.Context, *PublishSolanaNFTPriceInformationRequest) (*PublishSolanaNFTPriceInformationResponse, error) {
+func (UnimplementedMarketdataServer) PublishSolanaNFTPriceInformation(context.Context, *PublishSolanaNFTPriceInformationRequest) (*PublishSolanaNFTPriceInformationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishSolanaNFTPriceInformation not implemented")
 }
-func (*UnimplementedMarketdataServer) mustEmbedUnimplementedMarketdataServer() {}
+func (UnimplementedMarketdataServer) ListCandles(context.Context, *ListCandlesRequest) (*ListCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCandles not implemented")
+}
+func (UnimplementedMarketdataServer) GetFundingRateHistory(context.Context, *GetFundingRateHistoryRequest) (*GetFundingRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingRateHistory not implemented")
+}
+func (UnimplementedMarketdataServer) AddWatchlistAsset(context.Context, *AddWatchlistAssetRequest) (*AddWatchlistAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWatchlistAsset not implemented")
+}
+func (UnimplementedMarketdataServer) RemoveWatchlistAsset(context.Context, *RemoveWatchlistAssetRequest) (*RemoveWatchlistAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWatchlistAsset not implemented")
+}
+func (UnimplementedMarketdataServer) ListWatchlistAssets(context.Context, *ListWatchlistAssetsRequest) (*ListWatchlistAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchlistAssets not implemented")
+}
+func (UnimplementedMarketdataServer) PublishWatchlistDigests(context.Context, *PublishWatchlistDigestsRequest) (*PublishWatchlistDigestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishWatchlistDigests not implemented")
+}
+func (UnimplementedMarketdataServer) CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*CreatePriceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePriceAlert not implemented")
+}
+func (UnimplementedMarketdataServer) ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceAlerts not implemented")
+}
+func (UnimplementedMarketdataServer) DeletePriceAlert(context.Context, *DeletePriceAlertRequest) (*DeletePriceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceAlert not implemented")
+}
+func (UnimplementedMarketdataServer) mustEmbedUnimplementedMarketdataServer() {}
 
-func RegisterMarketdataServer(s *grpc.Server, srv MarketdataServer) {
-	s.RegisterService(&_Marketdata_serviceDesc, srv)
+// UnsafeMarketdataServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MarketdataServer will
+// result in compilation errors.
+type UnsafeMarketdataServer interface {
+	mustEmbedUnimplementedMarketdataServer()
+}
+
+func RegisterMarketdataServer(s grpc.ServiceRegistrar, srv MarketdataServer) {
+	s.RegisterService(&Marketdata_ServiceDesc, srv)
 }
 
 func _Marketdata_PublishLatestPriceInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -204,7 +338,172 @@ func _Marketdata_PublishSolanaNFTPriceInformation_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Marketdata_serviceDesc = grpc.ServiceDesc{
+func _Marketdata_ListCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketdataServer).ListCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/marketdata/ListCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketdataServer).ListCandles(ctx, req.(*ListCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketdata_GetFundingRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketdataServer).GetFundingRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/marketdata/GetFundingRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketdataServer).GetFundingRateHistory(ctx, req.(*GetFundingRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketdata_AddWatchlistAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWatchlistAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketdataServer).AddWatchlistAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/marketdata/AddWatchlistAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketdataServer).AddWatchlistAsset(ctx, req.(*AddWatchlistAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketdata_RemoveWatchlistAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWatchlistAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketdataServer).RemoveWatchlistAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/marketdata/RemoveWatchlistAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketdataServer).RemoveWatchlistAsset(ctx, req.(*RemoveWatchlistAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketdata_ListWatchlistAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchlistAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketdataServer).ListWatchlistAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/marketdata/ListWatchlistAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketdataServer).ListWatchlistAssets(ctx, req.(*ListWatchlistAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketdata_PublishWatchlistDigests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishWatchlistDigestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketdataServer).PublishWatchlistDigests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/marketdata/PublishWatchlistDigests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketdataServer).PublishWatchlistDigests(ctx, req.(*PublishWatchlistDigestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketdata_CreatePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketdataServer).CreatePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/marketdata/CreatePriceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketdataServer).CreatePriceAlert(ctx, req.(*CreatePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketdata_ListPriceAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketdataServer).ListPriceAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/marketdata/ListPriceAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketdataServer).ListPriceAlerts(ctx, req.(*ListPriceAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Marketdata_DeletePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketdataServer).DeletePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/marketdata/DeletePriceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketdataServer).DeletePriceAlert(ctx, req.(*DeletePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Marketdata_ServiceDesc is the grpc.ServiceDesc for Marketdata service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Marketdata_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "marketdata",
 	HandlerType: (*MarketdataServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -228,6 +527,42 @@ var _Marketdata_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PublishSolanaNFTPriceInformation",
 			Handler:    _Marketdata_PublishSolanaNFTPriceInformation_Handler,
 		},
+		{
+			MethodName: "ListCandles",
+			Handler:    _Marketdata_ListCandles_Handler,
+		},
+		{
+			MethodName: "GetFundingRateHistory",
+			Handler:    _Marketdata_GetFundingRateHistory_Handler,
+		},
+		{
+			MethodName: "AddWatchlistAsset",
+			Handler:    _Marketdata_AddWatchlistAsset_Handler,
+		},
+		{
+			MethodName: "RemoveWatchlistAsset",
+			Handler:    _Marketdata_RemoveWatchlistAsset_Handler,
+		},
+		{
+			MethodName: "ListWatchlistAssets",
+			Handler:    _Marketdata_ListWatchlistAssets_Handler,
+		},
+		{
+			MethodName: "PublishWatchlistDigests",
+			Handler:    _Marketdata_PublishWatchlistDigests_Handler,
+		},
+		{
+			MethodName: "CreatePriceAlert",
+			Handler:    _Marketdata_CreatePriceAlert_Handler,
+		},
+		{
+			MethodName: "ListPriceAlerts",
+			Handler:    _Marketdata_ListPriceAlerts_Handler,
+		},
+		{
+			MethodName: "DeletePriceAlert",
+			Handler:    _Marketdata_DeletePriceAlert_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "s.market-data/proto/marketdata.proto",
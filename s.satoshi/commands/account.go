@@ -3,12 +3,15 @@ package commands
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 
 	"swallowtail/libraries/gerrors"
 	"swallowtail/libraries/util"
 	accountproto "swallowtail/s.account/proto"
+	"swallowtail/s.satoshi/formatter"
 )
 
 const (
@@ -47,6 +50,25 @@ func init() {
 				Description:         "Returns everything satoshi stores as your account. You can see if you have an account with this.",
 				Handler:             readAccountHandler,
 			},
+			"risk": {
+				ID:                  "account-risk",
+				IsPrivate:           true,
+				MinimumNumberOfArgs: 0,
+				Usage:               `!account risk`,
+				Description:         "Returns the risk profile the trade engine enforces before placing any of your trades.",
+				Handler:             readRiskProfileHandler,
+				SubCommands: map[string]*Command{
+					"set": {
+						ID:                  "account-risk-set",
+						IsPrivate:           true,
+						IsAdminOnly:         true,
+						MinimumNumberOfArgs: 3,
+						Usage:               `!account risk set <user_id> <max-risk|max-strategies|max-leverage|max-notional|daily-loss|min-balance|instruments> <value>`,
+						Description:         "Sets a single limit of a users risk profile; zero is unlimited. Instruments are comma separated instruments or assets, or `all`.",
+						Handler:             setRiskProfileHandler,
+					},
+				},
+			},
 		},
 	})
 }
@@ -63,8 +85,8 @@ func registerAccountHandler(ctx context.Context, tokens []string, s *discordgo.S
 	}).Send(ctx).Response()
 	switch {
 	case gerrors.Is(err, gerrors.ErrAlreadyExists, "account-already-exists"):
-		s.ChannelMessageSend(
-			m.ChannelID,
+		reply(
+			s, m,
 			":wave: Hi, I've already got an account registered for you.  You're all good!",
 		)
 		return nil
@@ -72,8 +94,8 @@ func registerAccountHandler(ctx context.Context, tokens []string, s *discordgo.S
 		return gerrors.Augment(err, "failed_register_account", nil)
 	}
 
-	s.ChannelMessageSend(
-		m.ChannelID,
+	reply(
+		s, m,
 		fmt.Sprintf(
 			":wave: I have registered your account with email: `%s`.\n\nRef Links:\n`Binance`: %s\n`FTX`: %s",
 			m.Author.Email,
@@ -90,8 +112,8 @@ func readAccountHandler(ctx context.Context, tokens []string, s *discordgo.Sessi
 	}).Send(ctx).Response()
 	switch {
 	case gerrors.Is(err, gerrors.ErrNotFound):
-		s.ChannelMessageSend(
-			m.ChannelID,
+		reply(
+			s, m,
 			":disappointed: Looks like you haven't registered an account with us just yet! Use `!account register help` for help.",
 		)
 		return nil
@@ -116,10 +138,96 @@ Primary Venue:          %v
 	formattedMsg := fmt.Sprintf(tpl, account.Username, account.Email, account.Created.AsTime(), account.LastUpdated.AsTime(), account.IsFuturesMember, account.PrimaryVenue)
 
 	// Best Effort.
-	s.ChannelMessageSend(
-		m.ChannelID,
+	reply(
+		s, m,
 		fmt.Sprintf(":wave: <@%s> Here's your account: %s", m.Author.ID, util.WrapAsCodeBlock(formattedMsg)),
 	)
 
 	return nil
 }
+
+func readRiskProfileHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	rsp, err := (&accountproto.ReadRiskProfileRequest{
+		UserId:  m.Author.ID,
+		ActorId: accountproto.ActorSystemSatoshi,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_read_risk_profile", nil)
+	}
+
+	// Best Effort.
+	reply(
+		s, m,
+		fmt.Sprintf(":wave: <@%s> Here's your risk profile: %s", m.Author.ID, util.WrapAsCodeBlock(formatter.FormatRiskProfile(rsp.GetRiskProfile(), rsp.GetIsDefault()))),
+	)
+
+	return nil
+}
+
+func setRiskProfileHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	userID, limit, value := tokens[0], strings.ToLower(tokens[1]), tokens[2]
+
+	errParams := map[string]string{
+		"user_id": userID,
+		"limit":   limit,
+		"value":   value,
+	}
+
+	rsp, err := (&accountproto.ReadRiskProfileRequest{
+		UserId:  userID,
+		ActorId: accountproto.ActorSystemSatoshi,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_set_risk_profile.read_risk_profile", errParams)
+	}
+
+	riskProfile := rsp.GetRiskProfile()
+
+	if limit == "instruments" {
+		riskProfile.InstrumentAllowList = nil
+		if strings.ToLower(value) != "all" {
+			riskProfile.InstrumentAllowList = strings.Split(value, ",")
+		}
+	} else {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			reply(s, m, fmt.Sprintf(":wave: <@%s>, couldn't parse %s into a number, please check.", m.Author.ID, value))
+			return gerrors.Augment(err, "failed_to_set_risk_profile.invalid_value", errParams)
+		}
+
+		switch limit {
+		case "max-risk":
+			riskProfile.MaxRiskPerTrade = float32(v)
+		case "max-strategies":
+			riskProfile.MaxConcurrentOpenStrategies = int64(v)
+		case "max-leverage":
+			riskProfile.MaxLeverage = float32(v)
+		case "max-notional":
+			riskProfile.MaxNotionalPerAsset = float32(v)
+		case "daily-loss":
+			riskProfile.DailyLossLimit = float32(v)
+		case "min-balance":
+			riskProfile.MinVenueBalance = float32(v)
+		default:
+			reply(s, m, fmt.Sprintf(":wave: <@%s>, I don't recognise the limit: `%s`; see `!account risk set help`.", m.Author.ID, limit))
+			return gerrors.BadParam("failed_to_set_risk_profile.invalid_limit", errParams)
+		}
+	}
+
+	updateRsp, err := (&accountproto.UpdateRiskProfileRequest{
+		UserId:      userID,
+		ActorId:     accountproto.ActorSystemSatoshi,
+		RiskProfile: riskProfile,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_set_risk_profile", errParams)
+	}
+
+	// Best Effort.
+	reply(
+		s, m,
+		fmt.Sprintf(":wave: <@%s> I've updated the risk profile of <@%s>: %s", m.Author.ID, userID, util.WrapAsCodeBlock(formatter.FormatRiskProfile(updateRsp.GetRiskProfile(), false))),
+	)
+
+	return nil
+}
@@ -0,0 +1,293 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	marketdataproto "swallowtail/s.market-data/proto"
+	"swallowtail/s.satoshi/formatter"
+)
+
+const (
+	alertCommandID    = "alert"
+	alertCommandUsage = `!alert <subcommand>`
+
+	alertLevelGuide = "!alert above btc usdt 70000 recurring"
+	alertMoveGuide  = "!alert move eth usdt 5% 1h recurring high"
+)
+
+func init() {
+	levelOptions := func(condition string) []*CommandOption {
+		return []*CommandOption{
+			{
+				Name:        "symbol",
+				Description: "The asset's symbol i.e `btc`",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+			},
+			{
+				Name:        "asset_pair",
+				Description: "The asset pair i.e `usdt`",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+			},
+			{
+				Name:        "price",
+				Description: fmt.Sprintf("The price to be paged at once the price is %s it", condition),
+				Type:        discordgo.ApplicationCommandOptionNumber,
+				Required:    true,
+			},
+			{
+				Name:        "recurring",
+				Description: "Triggers again each time the price crosses back over the level, rather than once",
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+			},
+			{
+				Name:        "high",
+				Description: "Pages your high priority pager, rather than your low priority pager",
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+			},
+		}
+	}
+
+	register(alertCommandID, &Command{
+		ID:                  alertCommandID,
+		IsPrivate:           true,
+		MinimumNumberOfArgs: 1,
+		Usage:               alertCommandUsage,
+		Description:         "Pages you when a price crosses a level, or moves by a percentage within a window.",
+		Handler:             alertHandler,
+		SubCommands: map[string]*Command{
+			marketdataproto.PriceAlertConditionAbove: {
+				ID:                  "alert-above",
+				IsPrivate:           true,
+				MinimumNumberOfArgs: 3,
+				Usage:               `!alert above <symbol> <asset_pair> <price> [recurring] [high]`,
+				Description:         "Pages you once the price is at or above the level.",
+				Guide:               alertLevelGuide,
+				Handler:             alertLevelHandler(marketdataproto.PriceAlertConditionAbove),
+				Options:             levelOptions(marketdataproto.PriceAlertConditionAbove),
+			},
+			marketdataproto.PriceAlertConditionBelow: {
+				ID:                  "alert-below",
+				IsPrivate:           true,
+				MinimumNumberOfArgs: 3,
+				Usage:               `!alert below <symbol> <asset_pair> <price> [recurring] [high]`,
+				Description:         "Pages you once the price is at or below the level.",
+				Guide:               "!alert below btc usdt 50000",
+				Handler:             alertLevelHandler(marketdataproto.PriceAlertConditionBelow),
+				Options:             levelOptions(marketdataproto.PriceAlertConditionBelow),
+			},
+			marketdataproto.PriceAlertConditionMove: {
+				ID:                  "alert-move",
+				IsPrivate:           true,
+				MinimumNumberOfArgs: 4,
+				Usage:               `!alert move <symbol> <asset_pair> <percentage> <window> [recurring] [high]`,
+				Description:         "Pages you once the price moves by the percentage, either way, within the window i.e `15m` or `4h`.",
+				Guide:               alertMoveGuide,
+				Handler:             alertMoveHandler,
+			},
+			"list": {
+				ID:                  "alert-list",
+				IsPrivate:           true,
+				MinimumNumberOfArgs: 0,
+				Usage:               `!alert list`,
+				Description:         "Lists your price alerts.",
+				Handler:             listAlertsHandler,
+			},
+			"delete": {
+				ID:                  "alert-delete",
+				IsPrivate:           true,
+				MinimumNumberOfArgs: 1,
+				Usage:               `!alert delete <alert_id>`,
+				Description:         "Deletes one of your price alerts.",
+				Handler:             deleteAlertHandler,
+			},
+		},
+	})
+}
+
+func alertHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	return gerrors.Unimplemented("parent_command_unimplemented.alert", nil)
+}
+
+func alertLevelHandler(condition string) CommandHandler {
+	return func(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+		args, isRecurring, isHighPriority := parseAlertArgs(tokens)
+		if len(args) != 3 {
+			reply(s, m, formatUsageMsg(m.Author.ID, alertCommandUsage, alertLevelGuide))
+			return gerrors.BadParam("failed_to_create_price_alert.invalid_args", nil)
+		}
+
+		targetPrice, err := parseAlertPrice(args[2])
+		if err != nil {
+			reply(s, m, fmt.Sprintf(":wave: <@%s>, I couldn't parse the price `%s`; it should be a number i.e `70000`.", m.Author.ID, args[2]))
+			return gerrors.Augment(err, "failed_to_create_price_alert", nil)
+		}
+
+		return createPriceAlert(ctx, s, m, &marketdataproto.PriceAlert{
+			UserId:         m.Author.ID,
+			Symbol:         args[0],
+			AssetPair:      args[1],
+			Condition:      condition,
+			TargetPrice:    targetPrice,
+			IsRecurring:    isRecurring,
+			IsHighPriority: isHighPriority,
+			CreatedBy:      m.Author.ID,
+		})
+	}
+}
+
+func alertMoveHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	args, isRecurring, isHighPriority := parseAlertArgs(tokens)
+	if len(args) != 4 {
+		reply(s, m, formatUsageMsg(m.Author.ID, alertCommandUsage, alertMoveGuide))
+		return gerrors.BadParam("failed_to_create_price_alert.invalid_args", nil)
+	}
+
+	movePercentage, err := parseAlertPrice(strings.TrimSuffix(args[2], "%"))
+	if err != nil {
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, I couldn't parse the percentage `%s`; it should be a number i.e `5%%`.", m.Author.ID, args[2]))
+		return gerrors.Augment(err, "failed_to_create_price_alert", nil)
+	}
+
+	window, err := time.ParseDuration(strings.ToLower(args[3]))
+	if err != nil || window < time.Minute || window%time.Minute != 0 {
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, I couldn't parse the window `%s`; it should be in whole minutes or hours i.e `15m`, `4h` or `1h30m`.", m.Author.ID, args[3]))
+		return gerrors.BadParam("failed_to_create_price_alert.invalid_window", map[string]string{
+			"window": args[3],
+		})
+	}
+
+	return createPriceAlert(ctx, s, m, &marketdataproto.PriceAlert{
+		UserId:         m.Author.ID,
+		Symbol:         args[0],
+		AssetPair:      args[1],
+		Condition:      marketdataproto.PriceAlertConditionMove,
+		MovePercentage: movePercentage,
+		WindowMinutes:  int64(window / time.Minute),
+		IsRecurring:    isRecurring,
+		IsHighPriority: isHighPriority,
+		CreatedBy:      m.Author.ID,
+	})
+}
+
+func createPriceAlert(ctx context.Context, s *discordgo.Session, m *discordgo.MessageCreate, alert *marketdataproto.PriceAlert) error {
+	rsp, err := (&marketdataproto.CreatePriceAlertRequest{
+		ActorId: marketdataproto.MarketDataActorSatoshiCommand,
+		Alert:   alert,
+	}).Send(ctx).Response()
+	switch {
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "account_required"):
+		reply(s, m, ":disappointed: Alerts are paged to you via your account; register one first with `!account register help`.")
+		return nil
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "pager_not_configured"):
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, I can't page you with your pager of that priority; please ping @ajperkins to get it set up first.", m.Author.ID))
+		return nil
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "too_many_active_alerts"):
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, you've got too many active alerts; delete some with `!alert delete <alert_id>` first.", m.Author.ID))
+		return nil
+	case gerrors.Is(err, gerrors.ErrBadParam, "target_price_already_crossed"):
+		var latestPrice string
+		if prices, ok := gerrors.CollectDetailByKeyFromError(err, "latest_price"); ok && len(prices) > 0 {
+			latestPrice = prices[0]
+		}
+
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, the price is already %s that; it's `%s` now.", m.Author.ID, alert.Condition, latestPrice))
+		return nil
+	case err != nil:
+		return gerrors.Augment(err, "failed_to_create_price_alert", map[string]string{
+			"symbol":     alert.Symbol,
+			"asset_pair": alert.AssetPair,
+			"condition":  alert.Condition,
+		})
+	}
+
+	// Best Effort.
+	reply(
+		s, m,
+		fmt.Sprintf(
+			":white_check_mark: <@%s> I'll page you %s; it's `%s` now. %s",
+			m.Author.ID, formatter.FormatPriceAlertCondition(rsp.GetAlert()),
+			strconv.FormatFloat(rsp.GetCurrentPrice(), 'f', -1, 64),
+			util.WrapAsCodeBlock(formatter.FormatPriceAlerts([]*marketdataproto.PriceAlert{rsp.GetAlert()})),
+		),
+	)
+
+	return nil
+}
+
+func listAlertsHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	rsp, err := (&marketdataproto.ListPriceAlertsRequest{
+		UserId: m.Author.ID,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_price_alerts", nil)
+	}
+
+	// Best Effort.
+	reply(s, m, fmt.Sprintf(":bell: <@%s> Here are your price alerts: %s", m.Author.ID, util.WrapAsCodeBlock(formatter.FormatPriceAlerts(rsp.GetAlerts()))))
+
+	return nil
+}
+
+func deleteAlertHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	alertID := tokens[0]
+
+	_, err := (&marketdataproto.DeletePriceAlertRequest{
+		ActorId: marketdataproto.MarketDataActorSatoshiCommand,
+		UserId:  m.Author.ID,
+		AlertId: alertID,
+	}).Send(ctx).Response()
+	switch {
+	case gerrors.Is(err, gerrors.ErrNotFound, "alert_not_found"):
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, you don't have an alert `%s`; see `!alert list`.", m.Author.ID, alertID))
+		return nil
+	case err != nil:
+		return gerrors.Augment(err, "failed_to_delete_price_alert", map[string]string{
+			"alert_id": alertID,
+		})
+	}
+
+	// Best Effort.
+	reply(s, m, fmt.Sprintf(":white_check_mark: <@%s> I've deleted alert `%s`.", m.Author.ID, alertID))
+
+	return nil
+}
+
+// parseAlertArgs splits the `recurring` & `high` flags from the positional arguments; flags may be given anywhere, with
+// or without a `--` prefix.
+func parseAlertArgs(tokens []string) (args []string, isRecurring, isHighPriority bool) {
+	args = []string{}
+	for _, token := range tokens {
+		switch strings.ToLower(strings.TrimPrefix(token, "--")) {
+		case "recurring":
+			isRecurring = true
+		case "high":
+			isHighPriority = true
+		default:
+			args = append(args, token)
+		}
+	}
+
+	return args, isRecurring, isHighPriority
+}
+
+// parseAlertPrice parses a price or percentage; allowing for a leading `$` & thousands separators.
+func parseAlertPrice(s string) (float64, error) {
+	f, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimPrefix(s, "$"), ",", ""), 64)
+	if err != nil {
+		return 0, gerrors.BadParam("bad_param.invalid_number", map[string]string{
+			"value": s,
+		})
+	}
+
+	return f, nil
+}
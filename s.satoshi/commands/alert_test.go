@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAlertArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                   string
+		tokens                 []string
+		expectedArgs           []string
+		expectedIsRecurring    bool
+		expectedIsHighPriority bool
+	}{
+		{
+			name:         "args_only",
+			tokens:       []string{"btc", "usdt", "70000"},
+			expectedArgs: []string{"btc", "usdt", "70000"},
+		},
+		{
+			name:                   "flags",
+			tokens:                 []string{"eth", "usdt", "5%", "1h", "recurring", "high"},
+			expectedArgs:           []string{"eth", "usdt", "5%", "1h"},
+			expectedIsRecurring:    true,
+			expectedIsHighPriority: true,
+		},
+		{
+			name:                "prefixed_flags_anywhere",
+			tokens:              []string{"--Recurring", "btc", "usdt", "70000"},
+			expectedArgs:        []string{"btc", "usdt", "70000"},
+			expectedIsRecurring: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args, isRecurring, isHighPriority := parseAlertArgs(tt.tokens)
+			assert.Equal(t, tt.expectedArgs, args)
+			assert.Equal(t, tt.expectedIsRecurring, isRecurring)
+			assert.Equal(t, tt.expectedIsHighPriority, isHighPriority)
+		})
+	}
+}
+
+func TestParseAlertPrice(t *testing.T) {
+	t.Parallel()
+
+	for s, expected := range map[string]float64{
+		"70000":   70000,
+		"$70,000": 70000,
+		"0.00002": 0.00002,
+	} {
+		price, err := parseAlertPrice(s)
+		require.NoError(t, err)
+		assert.Equal(t, expected, price)
+	}
+
+	_, err := parseAlertPrice("seventy")
+	assert.Error(t, err)
+}
@@ -0,0 +1,234 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// argsOptionName is the name of the option commands without typed options take their arguments as.
+	argsOptionName = "args"
+
+	maxApplicationCommandDescriptionLength = 100
+)
+
+// CommandOption is a typed option of a command run as a slash command.
+type CommandOption struct {
+	Name        string
+	Description string
+	Type        discordgo.ApplicationCommandOptionType
+	Required    bool
+	// IsNamed dictates if the option is passed to the handler as `<name> <value>` rather than by its position, such as
+	// `trail <percentage>`. Boolean options are always passed as just their name; & only if true.
+	IsNamed bool
+	// Autocomplete suggests values for the option as the user types, if set.
+	Autocomplete AutocompleteHandler
+}
+
+// AutocompleteHandler returns the choices for an option given what the user has typed so far.
+type AutocompleteHandler func(ctx context.Context, typed string) ([]*discordgo.ApplicationCommandOptionChoice, error)
+
+// ApplicationCommands maps every registered command onto a discord application (slash) command. Subcommands map onto
+// subcommands & their own subcommands onto subcommand groups; discord allows no deeper, so anything below takes its
+// arguments as a string. Discord doesn't allow commands with subcommands to be run themselves.
+func ApplicationCommands() []*discordgo.ApplicationCommand {
+	applicationCommands := []*discordgo.ApplicationCommand{}
+	for _, c := range List() {
+		applicationCommands = append(applicationCommands, &discordgo.ApplicationCommand{
+			Type:        discordgo.ChatApplicationCommand,
+			Name:        c.ID,
+			Description: applicationCommandDescription(c),
+			Options:     applicationCommandOptions(c, true),
+		})
+	}
+
+	return applicationCommands
+}
+
+// applicationCommandOptions returns the options of the command; either its subcommands or its typed options.
+func applicationCommandOptions(c *Command, canNest bool) []*discordgo.ApplicationCommandOption {
+	if len(c.SubCommands) == 0 {
+		return c.applicationCommandOptions()
+	}
+
+	ids := make([]string, 0, len(c.SubCommands))
+	for id := range c.SubCommands {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	options := []*discordgo.ApplicationCommandOption{}
+	for _, id := range ids {
+		sc := c.SubCommands[id]
+
+		option := &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionSubCommand,
+			Name:        id,
+			Description: applicationCommandDescription(sc),
+			Options:     sc.applicationCommandOptions(),
+		}
+
+		if canNest && len(sc.SubCommands) > 0 {
+			option.Type = discordgo.ApplicationCommandOptionSubCommandGroup
+			option.Options = applicationCommandOptions(sc, false)
+		}
+
+		options = append(options, option)
+	}
+
+	return options
+}
+
+// applicationCommandOptions returns the typed options of the command; or a single string option for all its arguments
+// if it has none.
+func (c *Command) applicationCommandOptions() []*discordgo.ApplicationCommandOption {
+	if len(c.Options) == 0 {
+		return []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        argsOptionName,
+				Description: truncate(firstNonEmpty(c.Usage, "Arguments of the command"), maxApplicationCommandDescriptionLength),
+				Required:    c.MinimumNumberOfArgs > 0,
+			},
+		}
+	}
+
+	options := []*discordgo.ApplicationCommandOption{}
+	for _, o := range c.Options {
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Type:         o.Type,
+			Name:         o.Name,
+			Description:  truncate(o.Description, maxApplicationCommandDescriptionLength),
+			Required:     o.Required,
+			Autocomplete: o.Autocomplete != nil,
+		})
+	}
+
+	return options
+}
+
+func (c *Command) option(name string) (*CommandOption, bool) {
+	for _, o := range c.Options {
+		if o.Name == name {
+			return o, true
+		}
+	}
+
+	return nil, false
+}
+
+// resolveApplicationCommand walks down the command tree by the subcommands of the interaction; returning the command to
+// run, the path of subcommands to it & its options.
+func resolveApplicationCommand(c *Command, options []*discordgo.ApplicationCommandInteractionDataOption) (*Command, []string, []*discordgo.ApplicationCommandInteractionDataOption) {
+	path := []string{}
+	for len(options) == 1 {
+		switch options[0].Type {
+		case discordgo.ApplicationCommandOptionSubCommand, discordgo.ApplicationCommandOptionSubCommandGroup:
+		default:
+			return c, path, options
+		}
+
+		sc, ok := c.SubCommands[options[0].Name]
+		if !ok {
+			return c, path, options
+		}
+
+		path = append(path, options[0].Name)
+		c, options = sc, options[0].Options
+	}
+
+	return c, path, options
+}
+
+// optionTokens converts the options of the interaction into the tokens the command handler expects; as if the command
+// was sent as a message.
+func optionTokens(c *Command, options []*discordgo.ApplicationCommandInteractionDataOption) []string {
+	values := map[string]*discordgo.ApplicationCommandInteractionDataOption{}
+	for _, o := range options {
+		values[o.Name] = o
+	}
+
+	if len(c.Options) == 0 {
+		if v, ok := values[argsOptionName]; ok {
+			return strings.Fields(fmt.Sprintf("%v", v.Value))
+		}
+
+		return []string{}
+	}
+
+	tokens := []string{}
+	for _, o := range c.Options {
+		v, ok := values[o.Name]
+		if !ok {
+			continue
+		}
+
+		var token string
+		switch v.Type {
+		case discordgo.ApplicationCommandOptionBoolean:
+			if v.BoolValue() {
+				tokens = append(tokens, o.Name)
+			}
+			continue
+		case discordgo.ApplicationCommandOptionInteger:
+			token = strconv.FormatInt(v.IntValue(), 10)
+		case discordgo.ApplicationCommandOptionNumber:
+			token = strconv.FormatFloat(v.FloatValue(), 'f', -1, 64)
+		default:
+			token = fmt.Sprintf("%v", v.Value)
+		}
+
+		if o.IsNamed {
+			tokens = append(tokens, o.Name)
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+// isPrivate returns true if the command, or any of the subcommands the tokens run, must be run in a private channel.
+func isPrivate(c *Command, tokens []string) bool {
+	private := c.IsPrivate
+	for _, token := range tokens {
+		sc, ok := c.SubCommands[token]
+		if !ok {
+			break
+		}
+
+		c = sc
+		private = private || c.IsPrivate
+	}
+
+	return private
+}
+
+func applicationCommandDescription(c *Command) string {
+	return truncate(firstNonEmpty(c.Description, c.Usage, c.ID), maxApplicationCommandDescriptionLength)
+}
+
+func firstNonEmpty(ss ...string) string {
+	for _, s := range ss {
+		if s != "" {
+			return s
+		}
+	}
+
+	return ""
+}
+
+// truncate shortens the string to at most n characters.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+
+	return string(r[:n-1]) + "…"
+}
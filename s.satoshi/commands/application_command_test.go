@@ -0,0 +1,237 @@
+package commands
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.satoshi/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+var applicationCommandNameRegex = regexp.MustCompile(`^[-_a-z0-9]{1,32}$`)
+
+// TestApplicationCommands checks every registered command maps onto a slash command discord accepts.
+func TestApplicationCommands(t *testing.T) {
+	t.Parallel()
+
+	var validateOptions func(t *testing.T, options []*discordgo.ApplicationCommandOption)
+	validateOptions = func(t *testing.T, options []*discordgo.ApplicationCommandOption) {
+		assert.LessOrEqual(t, len(options), 25)
+
+		var optional bool
+		for _, o := range options {
+			assert.Regexp(t, applicationCommandNameRegex, o.Name)
+			assert.NotEmpty(t, o.Description, o.Name)
+			assert.LessOrEqual(t, len([]rune(o.Description)), maxApplicationCommandDescriptionLength, o.Name)
+
+			switch o.Type {
+			case discordgo.ApplicationCommandOptionSubCommand, discordgo.ApplicationCommandOptionSubCommandGroup:
+				validateOptions(t, o.Options)
+				continue
+			}
+
+			// Required options must come before any optional ones.
+			if optional {
+				assert.False(t, o.Required, o.Name)
+			}
+			optional = optional || !o.Required
+		}
+	}
+
+	applicationCommands := ApplicationCommands()
+	require.Len(t, applicationCommands, len(List()))
+
+	for _, c := range applicationCommands {
+		assert.Regexp(t, applicationCommandNameRegex, c.Name)
+		assert.NotEmpty(t, c.Description, c.Name)
+		assert.LessOrEqual(t, len([]rune(c.Description)), maxApplicationCommandDescriptionLength, c.Name)
+		validateOptions(t, c.Options)
+	}
+}
+
+func TestApplicationCommandOptions(t *testing.T) {
+	t.Parallel()
+
+	c := &Command{
+		ID: "parent",
+		SubCommands: map[string]*Command{
+			"leaf": {
+				ID:                  "parent-leaf",
+				MinimumNumberOfArgs: 1,
+				Usage:               "!parent leaf <arg>",
+			},
+			"group": {
+				ID: "parent-group",
+				SubCommands: map[string]*Command{
+					"nested": {
+						ID: "parent-group-nested",
+						SubCommands: map[string]*Command{
+							"too-deep": {
+								ID: "parent-group-nested-too-deep",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	options := applicationCommandOptions(c, true)
+	require.Len(t, options, 2)
+
+	group, leaf := options[0], options[1]
+
+	assert.Equal(t, discordgo.ApplicationCommandOptionSubCommandGroup, group.Type)
+	require.Len(t, group.Options, 1)
+
+	// Discord allows nothing deeper than a subcommand of a group; so those below take their arguments as a string.
+	nested := group.Options[0]
+	assert.Equal(t, discordgo.ApplicationCommandOptionSubCommand, nested.Type)
+	require.Len(t, nested.Options, 1)
+	assert.Equal(t, argsOptionName, nested.Options[0].Name)
+	assert.False(t, nested.Options[0].Required)
+
+	assert.Equal(t, discordgo.ApplicationCommandOptionSubCommand, leaf.Type)
+	require.Len(t, leaf.Options, 1)
+	assert.Equal(t, argsOptionName, leaf.Options[0].Name)
+	assert.Equal(t, "!parent leaf <arg>", leaf.Options[0].Description)
+	assert.True(t, leaf.Options[0].Required)
+}
+
+func TestResolveApplicationCommandTokens(t *testing.T) {
+	t.Parallel()
+
+	trade, ok := lookup(tradeCommandID)
+	require.True(t, ok)
+
+	account, ok := lookup(accountCommandID)
+	require.True(t, ok)
+
+	tests := []struct {
+		name            string
+		command         *Command
+		options         []*discordgo.ApplicationCommandInteractionDataOption
+		expectedCommand string
+		expectedTokens  []string
+		expectedPrivate bool
+	}{
+		{
+			name:    "typed_options_in_argument_order",
+			command: trade,
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{
+					Name: "execute",
+					Type: discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{Name: "trail", Type: discordgo.ApplicationCommandOptionNumber, Value: 1.5},
+						{Name: "risk", Type: discordgo.ApplicationCommandOptionNumber, Value: float64(2)},
+						{Name: "breakeven", Type: discordgo.ApplicationCommandOptionBoolean, Value: true},
+						{Name: "venue", Type: discordgo.ApplicationCommandOptionString, Value: "paper"},
+						{Name: "trade_id", Type: discordgo.ApplicationCommandOptionString, Value: "trade-strategy-id"},
+					},
+				},
+			},
+			expectedCommand: "trade-execute",
+			expectedTokens:  []string{"execute", "trade-strategy-id", "paper", "2", "breakeven", "trail", "1.5"},
+		},
+		{
+			name:    "false_boolean_option_omitted",
+			command: trade,
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{
+					Name: "execute",
+					Type: discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{Name: "trade_id", Type: discordgo.ApplicationCommandOptionString, Value: "trade-strategy-id"},
+						{Name: "venue", Type: discordgo.ApplicationCommandOptionString, Value: "binance"},
+						{Name: "risk", Type: discordgo.ApplicationCommandOptionNumber, Value: 0.5},
+						{Name: "breakeven", Type: discordgo.ApplicationCommandOptionBoolean, Value: false},
+					},
+				},
+			},
+			expectedCommand: "trade-execute",
+			expectedTokens:  []string{"execute", "trade-strategy-id", "binance", "0.5"},
+		},
+		{
+			name:    "subcommand_group_with_args",
+			command: account,
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{
+					Name: "risk",
+					Type: discordgo.ApplicationCommandOptionSubCommandGroup,
+					Options: []*discordgo.ApplicationCommandInteractionDataOption{
+						{
+							Name: "set",
+							Type: discordgo.ApplicationCommandOptionSubCommand,
+							Options: []*discordgo.ApplicationCommandInteractionDataOption{
+								{Name: argsOptionName, Type: discordgo.ApplicationCommandOptionString, Value: " user-id  max-risk 2 "},
+							},
+						},
+					},
+				},
+			},
+			expectedCommand: "account-risk-set",
+			expectedTokens:  []string{"risk", "set", "user-id", "max-risk", "2"},
+			expectedPrivate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			leaf, path, options := resolveApplicationCommand(tt.command, tt.options)
+			assert.Equal(t, tt.expectedCommand, leaf.ID)
+
+			tokens := append(path, optionTokens(leaf, options)...)
+			assert.Equal(t, tt.expectedTokens, tokens)
+			assert.Equal(t, tt.expectedPrivate, isPrivate(tt.command, tokens))
+		})
+	}
+}
+
+func TestAutocomplete(t *testing.T) {
+	originalPolls, originalVenues := listActiveTradeParticipantPolls, listAvailableVenues
+	t.Cleanup(func() {
+		listActiveTradeParticipantPolls, listAvailableVenues = originalPolls, originalVenues
+	})
+
+	now := time.Now().UTC()
+	listActiveTradeParticipantPolls = func(ctx context.Context) ([]*domain.TradeParticipantPoll, error) {
+		return []*domain.TradeParticipantPoll{
+			{TradeStrategyID: "abc-closed", Deadline: now.Add(-time.Minute)},
+			{TradeStrategyID: "abc-open", Deadline: now.Add(10*time.Minute + 10*time.Second)},
+			{TradeStrategyID: "def-open", Deadline: now.Add(time.Hour)},
+		}, nil
+	}
+	listAvailableVenues = func(ctx context.Context) ([]tradeengineproto.VENUE, error) {
+		return []tradeengineproto.VENUE{tradeengineproto.VENUE_BINANCE, tradeengineproto.VENUE_BITFINEX, tradeengineproto.VENUE_PAPER}, nil
+	}
+
+	tradeStrategyIDs, err := autocompleteTradeStrategyIDs(context.Background(), "ABC")
+	require.NoError(t, err)
+	require.Len(t, tradeStrategyIDs, 1)
+	assert.Equal(t, "abc-open", tradeStrategyIDs[0].Value)
+	assert.Equal(t, "abc-open (closes in 10m0s)", tradeStrategyIDs[0].Name)
+
+	venues, err := autocompleteVenues(context.Background(), "Bi")
+	require.NoError(t, err)
+	require.Len(t, venues, 2)
+	assert.Equal(t, "binance", venues[0].Value)
+	assert.Equal(t, "bitfinex", venues[1].Value)
+}
+
+func TestFormatCommandContent(t *testing.T) {
+	t.Parallel()
+
+	c := &Command{ID: "trade"}
+	assert.Equal(t, "!trade execute trade-strategy-id paper 2", formatCommandContent(c, []string{"execute", "trade-strategy-id", "paper", "2"}))
+	assert.Equal(t, "!trade", formatCommandContent(c, []string{}))
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.satoshi/dao"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// The below are package variables so autocompletion can be faked in tests.
+var (
+	listActiveTradeParticipantPolls = dao.ListActiveTradeParticipantPolls
+	listAvailableVenues             = func(ctx context.Context) ([]tradeengineproto.VENUE, error) {
+		rsp, err := (&tradeengineproto.ListAvailableVenuesRequest{}).Send(ctx).Response()
+		if err != nil {
+			return nil, err
+		}
+
+		return rsp.GetVenues(), nil
+	}
+)
+
+// autocompleteTradeStrategyIDs suggests the trade strategies still open to participants; those closing soonest first.
+func autocompleteTradeStrategyIDs(ctx context.Context, typed string) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	polls, err := listActiveTradeParticipantPolls(ctx)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_autocomplete_trade_strategy_ids", nil)
+	}
+
+	now := time.Now().UTC()
+
+	choices := []*discordgo.ApplicationCommandOptionChoice{}
+	for _, poll := range polls {
+		if !poll.Deadline.After(now) || !strings.HasPrefix(poll.TradeStrategyID, strings.ToLower(typed)) {
+			continue
+		}
+
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  fmt.Sprintf("%s (closes in %s)", poll.TradeStrategyID, poll.Deadline.Sub(now).Round(time.Minute)),
+			Value: poll.TradeStrategyID,
+		})
+	}
+
+	return choices, nil
+}
+
+// autocompleteVenues suggests the venues the trade engine supports.
+func autocompleteVenues(ctx context.Context, typed string) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	venues, err := listAvailableVenues(ctx)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_autocomplete_venues", nil)
+	}
+
+	choices := []*discordgo.ApplicationCommandOptionChoice{}
+	for _, venue := range venues {
+		v := strings.ToLower(venue.String())
+		if !strings.HasPrefix(v, strings.ToLower(typed)) {
+			continue
+		}
+
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  v,
+			Value: v,
+		})
+	}
+
+	return choices, nil
+}
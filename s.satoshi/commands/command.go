@@ -30,6 +30,11 @@ type Command struct {
 	FailureMsg          string
 	Handler             CommandHandler
 	SubCommands         map[string]*Command
+	// Options are the typed options of the command when run as a slash command, in the order of its arguments. Commands
+	// without options take their arguments as a single string option.
+	Options []*CommandOption
+	// RequiresConfirmation dictates if the command must be confirmed with a button before it's executed as a slash command.
+	RequiresConfirmation bool
 }
 
 // CommandHandler ...
@@ -48,20 +53,25 @@ func (c *Command) Exec(s *discordgo.Session, m *discordgo.MessageCreate) {
 
 	slog.Trace(ctx, "Received command: %s with args: %v", c.ID, tokens[1:])
 
-	err := c.exec(ctx, tokens[1:], s, m)
+	c.run(ctx, tokens[1:], s, m)
+}
+
+// run executes the command with the given arguments; paging the user with help if the command isn't implemented.
+func (c *Command) run(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) {
+	err := c.exec(ctx, tokens, s, m)
 	switch {
 	case gerrors.Is(err, gerrors.ErrUnimplemented):
 		// Best effort.
-		s.ChannelMessageSend(m.ChannelID, formatHelpMsg(c, true, false))
+		reply(s, m, formatHelpMsg(c, true, false))
 	case err != nil:
 		slog.Info(ctx, "Parent command %s, failed with error: %v", c.ID, err)
 	}
 }
 
 func (c *Command) exec(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
-	// Check Privacy.
-	if c.IsPrivate && m.GuildID != "" {
-		_, err := s.ChannelMessageSend(m.ChannelID, formatNonPublicMsg(m.Author.ID))
+	// Check Privacy; slash commands can instead reply only to the user.
+	if c.IsPrivate && m.GuildID != "" && !repliesPrivately(m) {
+		_, err := reply(s, m, formatNonPublicMsg(m.Author.ID))
 		return gerrors.Augment(err, "failed_to_page_user.private", nil)
 	}
 
@@ -78,32 +88,32 @@ func (c *Command) exec(ctx context.Context, tokens []string, s *discordgo.Sessio
 
 	// Check if they are indeed an admin member if the command requires so.
 	if c.IsAdminOnly && !admin {
-		_, err := s.ChannelMessageSend(m.ChannelID, formatNonAdminMsg(m.Author.ID))
+		_, err := reply(s, m, formatNonAdminMsg(m.Author.ID))
 		return gerrors.Augment(err, "failed_to_page_user.non_admin", nil)
 	}
 
 	// Check if they are a indeed a futures member if the command requires so.
 	if c.IsFuturesOnly && !futuresMember {
-		_, err := s.ChannelMessageSend(m.ChannelID, formatNonFuturesMsg(m.Author.ID))
+		_, err := reply(s, m, formatNonFuturesMsg(m.Author.ID))
 		return gerrors.Augment(err, "failed_to_page_user.non_futures_member", nil)
 	}
 
 	// Check Usage.
 	if len(tokens) > 0 && strings.ToLower(tokens[0]) == "help" {
-		_, err := s.ChannelMessageSend(m.ChannelID, util.WrapAsCodeBlock(formatHelpMsg(c, futuresMember, admin)))
+		_, err := reply(s, m, util.WrapAsCodeBlock(formatHelpMsg(c, futuresMember, admin)))
 		return gerrors.Augment(err, "failed_to_page_user.help", nil)
 	}
 
 	// Check we have at least the correct number of arguments to execute the command.
 	if len(tokens) < c.MinimumNumberOfArgs {
-		_, err := s.ChannelMessageSend(m.ChannelID, formatUsageMsg(m.Author.ID, c.Usage, c.Guide))
+		_, err := reply(s, m, formatUsageMsg(m.Author.ID, c.Usage, c.Guide))
 		return gerrors.Augment(err, "failed_to_page_user.bad_params", nil)
 	}
 
 	// If we have no args; then we must not have any subcommand; so let's try the parent command default.
 	if len(tokens) == 0 {
 		if err := c.Handler(ctx, tokens, s, m); err != nil {
-			_, err := s.ChannelMessageSend(m.ChannelID, formatFailureMsg(m.Author.ID, c.FailureMsg, err))
+			_, err := reply(s, m, formatFailureMsg(m.Author.ID, c.FailureMsg, err))
 			return gerrors.Augment(err, "failed_to_page_user.command_failure_no_tokens", nil)
 		}
 
@@ -115,7 +125,7 @@ func (c *Command) exec(ctx context.Context, tokens []string, s *discordgo.Sessio
 	subCommand, ok := c.SubCommands[tokens[0]]
 	if !ok {
 		if err := c.Handler(ctx, tokens, s, m); err != nil {
-			_, err := s.ChannelMessageSend(m.ChannelID, formatFailureMsg(m.Author.ID, c.FailureMsg, err))
+			_, err := reply(s, m, formatFailureMsg(m.Author.ID, c.FailureMsg, err))
 			return gerrors.Augment(err, "failed_to_page_user.command_failure", nil)
 		}
 
@@ -35,10 +35,34 @@ func init() {
 				ID:                  "exchange-register",
 				IsPrivate:           true,
 				IsFuturesOnly:       true,
-				MinimumNumberOfArgs: 3,
-				Usage:               `!exchange register binance <api-key> <secret-key> <?subaccount>`,
-				Description:         "Registers a set of API keys (Binance only for now).",
+				MinimumNumberOfArgs: 1,
+				Usage:               `!exchange register <venue> <?api-key> <?secret-key> <?subaccount>`,
+				Description:         "Registers a set of API keys (Binance only for now), or a paper trading account.",
 				Handler:             registerExchangeCommand,
+				Options: []*CommandOption{
+					{
+						Name:         "venue",
+						Description:  "The venue to register; `paper` for a paper trading account.",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: autocompleteVenues,
+					},
+					{
+						Name:        "api-key",
+						Description: "The API key; not required for paper trading accounts.",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+					{
+						Name:        "secret-key",
+						Description: "The secret key; not required for paper trading accounts.",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+					{
+						Name:        "subaccount",
+						Description: "The subaccount; required for FTX only.",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+				},
 			},
 			"list": {
 				ID:                  "exchange-list",
@@ -57,6 +81,15 @@ func init() {
 				Usage:               `!exchange set-primary <exchange>`,
 				Description:         "Sets the primary exchange to use on your account",
 				Handler:             setPrimaryExchangeCommand,
+				Options: []*CommandOption{
+					{
+						Name:         "venue",
+						Description:  "The venue to use as your primary exchange.",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: autocompleteVenues,
+					},
+				},
 			},
 		},
 	})
@@ -70,7 +103,7 @@ func registerExchangeCommand(ctx context.Context, tokens []string, s *discordgo.
 	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(30*time.Second))
 	defer cancel()
 
-	venue, apiKey, secretKey := tokens[0], tokens[1], tokens[2]
+	venue := tokens[0]
 
 	// Parse venue.
 	var venueProto tradeengineproto.VENUE
@@ -83,14 +116,16 @@ func registerExchangeCommand(ctx context.Context, tokens []string, s *discordgo.
 		venueProto = tradeengineproto.VENUE_DERIBIT
 	case tradeengineproto.VENUE_FTX.String():
 		venueProto = tradeengineproto.VENUE_FTX
+	case tradeengineproto.VENUE_PAPER.String():
+		venueProto = tradeengineproto.VENUE_PAPER
 	default:
 		// Bad Exchange type.
-		if _, err := (s.ChannelMessageSend(
-			m.ChannelID,
+		if _, err := reply(
+			s, m,
 			fmt.Sprintf(":wave: Sorry, I don't support that venues\n\nPlease post in #crypto-support to check available venues`%s`", venue),
-		)); err != nil {
-			s.ChannelMessageSend(
-				m.ChannelID,
+		); err != nil {
+			reply(
+				s, m,
 				fmt.Sprintf("Venue: %s, requires a subaccount to be passed: this should match the one created in the exchange itself", venueProto.String()),
 			)
 
@@ -102,6 +137,18 @@ func registerExchangeCommand(ctx context.Context, tokens []string, s *discordgo.
 		return nil
 	}
 
+	// Parse credentials; paper trading accounts are simulated so don't require any.
+	var apiKey, secretKey string
+	switch {
+	case venueProto == tradeengineproto.VENUE_PAPER:
+	case len(tokens) < 3:
+		return gerrors.FailedPrecondition("credentials_required_for_venue", map[string]string{
+			"venue": venueProto.String(),
+		})
+	default:
+		apiKey, secretKey = tokens[1], tokens[2]
+	}
+
 	// Parse subaccount if required by given venue.
 	var subaccount string
 	switch venueProto {
@@ -130,8 +177,8 @@ func registerExchangeCommand(ctx context.Context, tokens []string, s *discordgo.
 	if err != nil {
 		slog.Error(ctx, "Failed to add exchange, error: %v", err)
 
-		if _, derr := s.ChannelMessageSend(
-			m.ChannelID,
+		if _, derr := reply(
+			s, m,
 			fmt.Sprintf(":wave: Sorry, I wasn't able to to add an exchange; please ping @ajperkins to investigate."),
 		); derr != nil {
 			return gerrors.Augment(derr, "failed_to_send_to_discord_failure", nil)
@@ -147,8 +194,8 @@ func registerExchangeCommand(ctx context.Context, tokens []string, s *discordgo.
 			reasons.WriteString(fmt.Sprintf("- %s\n", r))
 		}
 
-		if _, derr := s.ChannelMessageSend(
-			m.ChannelID,
+		if _, derr := reply(
+			s, m,
 			fmt.Sprintf(
 				":wave: Sorry, I wasn't able to to verify your credentials. This is likely due to the following permissisions issues:```%s```",
 				reasons.String(),
@@ -160,8 +207,8 @@ func registerExchangeCommand(ctx context.Context, tokens []string, s *discordgo.
 		return nil
 	}
 
-	_, err = s.ChannelMessageSend(
-		m.ChannelID,
+	_, err = reply(
+		s, m,
 		fmt.Sprintf(":wave: Thanks! I've now added the exchange to your account. \n\n To see all exchanges registered use the command: ```!exchange list```"),
 	)
 
@@ -187,8 +234,8 @@ func listExchangeCommand(ctx context.Context, tokens []string, s *discordgo.Sess
 
 	venueAccounts := rsp.GetVenueAccounts()
 	if venueAccounts == nil {
-		_, err := s.ChannelMessageSend(
-			m.ChannelID,
+		_, err := reply(
+			s, m,
 			fmt.Sprintf(":wave: Sorry, you don't have any exchanges registered I'm afraid."),
 		)
 		return err
@@ -196,8 +243,8 @@ func listExchangeCommand(ctx context.Context, tokens []string, s *discordgo.Sess
 
 	exchangesMsg := formatVenueAccountsToMsg(venueAccounts, m)
 
-	_, err = s.ChannelMessageSend(
-		m.ChannelID,
+	_, err = reply(
+		s, m,
 		fmt.Sprintf(":wave: Here's the exchange details registered to your account, all keys are masked\n\n%s", exchangesMsg),
 	)
 
@@ -213,10 +260,12 @@ func setPrimaryExchangeCommand(ctx context.Context, tokens []string, s *discordg
 		venue = tradeengineproto.VENUE_BINANCE
 	case strings.ToUpper(tradeengineproto.VENUE_FTX.String()):
 		venue = tradeengineproto.VENUE_FTX
+	case strings.ToUpper(tradeengineproto.VENUE_PAPER.String()):
+		venue = tradeengineproto.VENUE_PAPER
 	case strings.ToUpper(tradeengineproto.VENUE_DERIBIT.String()):
-		return gerrors.Unimplemented("venue_unimplemented_for_primary_account.deribit", nil)
+		venue = tradeengineproto.VENUE_DERIBIT
 	case strings.ToUpper(tradeengineproto.VENUE_BITFINEX.String()):
-		return gerrors.Unimplemented("venue_unimplemented_for_primary_account.deribit", nil)
+		venue = tradeengineproto.VENUE_BITFINEX
 	default:
 		// Best effort.
 		rsp, err := (&tradeengineproto.ListAvailableVenuesRequest{}).Send(ctx).Response()
@@ -224,8 +273,8 @@ func setPrimaryExchangeCommand(ctx context.Context, tokens []string, s *discordg
 		case err != nil:
 			slog.Error(ctx, "Failed to retrieve list of available list, unimplemeted venue for set primary exchange command, Error: %v", err)
 		default:
-			s.ChannelMessageSend(
-				m.ChannelID,
+			reply(
+				s, m,
 				fmt.Sprintf(
 					":wave: I was unable to set `%s` as your primary venue, I don't implement that venue just yet.\n\nAvailable Venues: `%s`",
 					venueToken, rsp.GetVenues(),
@@ -260,8 +309,8 @@ func setPrimaryExchangeCommand(ctx context.Context, tokens []string, s *discordg
 		PrimaryVenue: venue,
 		UserId:       m.Author.ID,
 	}).SendWithTimeout(ctx, 10*time.Second).Response(); err != nil {
-		s.ChannelMessageSend(
-			m.ChannelID,
+		reply(
+			s, m,
 			fmt.Sprintf(
 				":wave: I was unable to set your primary exchange on your account to: `%s`, Error: `%v`", venue, err,
 			),
@@ -272,8 +321,8 @@ func setPrimaryExchangeCommand(ctx context.Context, tokens []string, s *discordg
 		})
 	}
 
-	s.ChannelMessageSend(
-		m.ChannelID,
+	reply(
+		s, m,
 		fmt.Sprintf(
 			":wave: I have set your primary exchange on your account to be: `%s`", venue,
 		),
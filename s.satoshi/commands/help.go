@@ -60,8 +60,8 @@ func helpCommand(ctx context.Context, tokens []string, s *discordgo.Session, m *
 
 	// TODO: this breaks if we're over 2000 chars
 	// This temp fix is super awkward; we want to improve it at some point.
-	_, err = s.ChannelMessageSend(
-		m.ChannelID,
+	_, err = reply(
+		s, m,
 		util.WrapAsCodeBlock(sb.String()),
 	)
 	if err != nil {
@@ -73,8 +73,8 @@ func helpCommand(ctx context.Context, tokens []string, s *discordgo.Session, m *
 		sb.WriteString(formatHelpMsg(command, futuresMember, admin))
 	}
 
-	_, err = s.ChannelMessageSend(
-		m.ChannelID,
+	_, err = reply(
+		s, m,
 		util.WrapAsCodeBlock(sb.String()),
 	)
 	if err != nil {
@@ -0,0 +1,343 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+)
+
+const (
+	// The confirm button carries the command it confirms in its custom ID; so it survives a restart.
+	confirmCustomIDPrefix = "satoshi-confirm:"
+	cancelCustomID        = "satoshi-cancel"
+	maxCustomIDLength     = 100
+
+	maxAutocompleteChoices = 25
+	autocompleteTimeout    = 2 * time.Second
+)
+
+var (
+	// interactions are the interactions commands are being run from, by the id of the message synthesized for them.
+	interactions   = map[string]*interactionReply{}
+	interactionsMu sync.RWMutex
+)
+
+// interactionReply replies to the interaction a command is run from.
+type interactionReply struct {
+	interaction *discordgo.Interaction
+	ephemeral   bool
+
+	mu      sync.Mutex
+	replied bool
+}
+
+// followup replies to the interaction; only to the user who ran the command if ephemeral.
+func (r *interactionReply) followup(s *discordgo.Session, content string) (*discordgo.Message, error) {
+	var flags discordgo.MessageFlags
+	if r.ephemeral {
+		flags = discordgo.MessageFlagsEphemeral
+	}
+
+	msg, err := s.FollowupMessageCreate(r.interaction, true, &discordgo.WebhookParams{
+		Content: content,
+		Flags:   flags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.replied = true
+
+	return msg, nil
+}
+
+func (r *interactionReply) hasReplied() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.replied
+}
+
+// reply replies to the user who ran the command; as a followup to the interaction if run as a slash command, otherwise
+// to the channel the command was sent in.
+func reply(s *discordgo.Session, m *discordgo.MessageCreate, content string) (*discordgo.Message, error) {
+	interactionsMu.RLock()
+	r, ok := interactions[m.ID]
+	interactionsMu.RUnlock()
+
+	if !ok {
+		return s.ChannelMessageSend(m.ChannelID, content)
+	}
+
+	return r.followup(s, content)
+}
+
+// repliesPrivately returns true if replies to the command are only seen by the user who ran it.
+func repliesPrivately(m *discordgo.MessageCreate) bool {
+	interactionsMu.RLock()
+	defer interactionsMu.RUnlock()
+
+	r, ok := interactions[m.ID]
+	return ok && r.ephemeral
+}
+
+// HandleInteraction handles satoshi commands run as slash commands, the autocompletion of their options & the buttons
+// confirming them.
+func HandleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	ctx := context.Background()
+
+	var err error
+	switch i.Type {
+	case discordgo.InteractionApplicationCommand:
+		err = execApplicationCommand(ctx, s, i.Interaction)
+	case discordgo.InteractionApplicationCommandAutocomplete:
+		err = autocomplete(ctx, s, i.Interaction)
+	case discordgo.InteractionMessageComponent:
+		err = handleMessageComponent(ctx, s, i.Interaction)
+	default:
+		return
+	}
+
+	if err != nil {
+		slog.Error(ctx, "Failed to handle interaction: %s, Error: %v", i.ID, err)
+	}
+}
+
+func execApplicationCommand(ctx context.Context, s *discordgo.Session, i *discordgo.Interaction) error {
+	data := i.ApplicationCommandData()
+
+	c, ok := lookup(data.Name)
+	if !ok {
+		return gerrors.NotFound("command_not_found", map[string]string{
+			"command_id": data.Name,
+		})
+	}
+
+	leaf, path, options := resolveApplicationCommand(c, data.Options)
+	tokens := append(path, optionTokens(leaf, options)...)
+
+	if leaf.RequiresConfirmation {
+		return requestConfirmation(s, i, c, tokens)
+	}
+
+	ephemeral := isPrivate(c, tokens)
+
+	// We have three seconds to respond; so we defer the reply until the command has run.
+	var flags discordgo.MessageFlags
+	if ephemeral {
+		flags = discordgo.MessageFlagsEphemeral
+	}
+
+	if err := s.InteractionRespond(i, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: flags,
+		},
+	}); err != nil {
+		return gerrors.Augment(err, "failed_to_defer_interaction_response", map[string]string{
+			"command_id": c.ID,
+		})
+	}
+
+	runInteraction(ctx, s, i, c, tokens, ephemeral)
+	return nil
+}
+
+// requestConfirmation asks the user to confirm the command before it's run.
+func requestConfirmation(s *discordgo.Session, i *discordgo.Interaction, c *Command, tokens []string) error {
+	content := formatCommandContent(c, tokens)
+
+	customID := confirmCustomIDPrefix + content
+	if len(customID) > maxCustomIDLength {
+		return s.InteractionRespond(i, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: fmt.Sprintf(":disappointed: Sorry, that command is too long to confirm; please send it as a message instead:\n`%s`", content),
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+	}
+
+	return s.InteractionRespond(i, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf(":warning: Please confirm you want to run:\n`%s`", content),
+			Flags:   discordgo.MessageFlagsEphemeral,
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.Button{
+							Label:    "Confirm",
+							Style:    discordgo.SuccessButton,
+							CustomID: customID,
+						},
+						discordgo.Button{
+							Label:    "Cancel",
+							Style:    discordgo.SecondaryButton,
+							CustomID: cancelCustomID,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func handleMessageComponent(ctx context.Context, s *discordgo.Session, i *discordgo.Interaction) error {
+	customID := i.MessageComponentData().CustomID
+
+	switch {
+	case customID == cancelCustomID:
+		return updateConfirmation(s, i, ":x: Cancelled.")
+	case strings.HasPrefix(customID, confirmCustomIDPrefix):
+	default:
+		return gerrors.BadParam("bad_param.unknown_custom_id", map[string]string{
+			"custom_id": customID,
+		})
+	}
+
+	content := strings.TrimPrefix(customID, confirmCustomIDPrefix)
+	tokens := strings.Fields(content)
+	if len(tokens) == 0 {
+		return gerrors.BadParam("bad_param.empty_confirmation", nil)
+	}
+
+	c, ok := lookup(strings.TrimPrefix(tokens[0], commandIdentifier))
+	if !ok {
+		return gerrors.NotFound("command_not_found", map[string]string{
+			"command_id": tokens[0],
+		})
+	}
+
+	// Removing the buttons stops the command being confirmed twice.
+	if err := updateConfirmation(s, i, fmt.Sprintf(":hourglass: Running `%s`", content)); err != nil {
+		return gerrors.Augment(err, "failed_to_update_confirmation", map[string]string{
+			"command_id": c.ID,
+		})
+	}
+
+	runInteraction(ctx, s, i, c, tokens[1:], isPrivate(c, tokens[1:]))
+	return nil
+}
+
+// updateConfirmation replaces the confirmation message with the content given, removing its buttons.
+func updateConfirmation(s *discordgo.Session, i *discordgo.Interaction, content string) error {
+	return s.InteractionRespond(i, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
+
+// runInteraction runs the command as if it was sent as a message; replying to the interaction instead of the channel.
+func runInteraction(ctx context.Context, s *discordgo.Session, i *discordgo.Interaction, c *Command, tokens []string, ephemeral bool) {
+	m := &discordgo.MessageCreate{
+		Message: &discordgo.Message{
+			ID:        i.ID,
+			ChannelID: i.ChannelID,
+			GuildID:   i.GuildID,
+			Author:    interactionUser(i),
+			Content:   formatCommandContent(c, tokens),
+		},
+	}
+
+	r := &interactionReply{
+		interaction: i,
+		ephemeral:   ephemeral,
+	}
+
+	interactionsMu.Lock()
+	interactions[m.ID] = r
+	interactionsMu.Unlock()
+
+	defer func() {
+		interactionsMu.Lock()
+		delete(interactions, m.ID)
+		interactionsMu.Unlock()
+	}()
+
+	slog.Trace(ctx, "Received slash command: %s with args: %v", c.ID, tokens)
+
+	c.run(ctx, tokens, s, m)
+
+	// The deferred response shows satoshi as thinking until we reply.
+	if !r.hasReplied() {
+		if _, err := r.followup(s, ":white_check_mark: Done."); err != nil {
+			slog.Error(ctx, "Failed to complete interaction: %s, Error: %v", i.ID, err)
+		}
+	}
+}
+
+func autocomplete(ctx context.Context, s *discordgo.Session, i *discordgo.Interaction) error {
+	data := i.ApplicationCommandData()
+
+	c, ok := lookup(data.Name)
+	if !ok {
+		return gerrors.NotFound("command_not_found", map[string]string{
+			"command_id": data.Name,
+		})
+	}
+
+	// Discord only waits three seconds for the choices.
+	ctx, cancel := context.WithTimeout(ctx, autocompleteTimeout)
+	defer cancel()
+
+	leaf, _, options := resolveApplicationCommand(c, data.Options)
+
+	choices := []*discordgo.ApplicationCommandOptionChoice{}
+	for _, o := range options {
+		if !o.Focused {
+			continue
+		}
+
+		option, ok := leaf.option(o.Name)
+		if !ok || option.Autocomplete == nil {
+			continue
+		}
+
+		cs, err := option.Autocomplete(ctx, fmt.Sprintf("%v", o.Value))
+		if err != nil {
+			// Best effort; the user can still type the value themselves.
+			slog.Error(ctx, "Failed to autocomplete option: %s of command: %s, Error: %v", o.Name, leaf.ID, err)
+			break
+		}
+
+		choices = cs
+	}
+
+	if len(choices) > maxAutocompleteChoices {
+		choices = choices[:maxAutocompleteChoices]
+	}
+
+	return s.InteractionRespond(i, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: choices,
+		},
+	})
+}
+
+// interactionUser returns the user who created the interaction; whether in a guild or a private channel.
+func interactionUser(i *discordgo.Interaction) *discordgo.User {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User
+	}
+
+	return i.User
+}
+
+// formatCommandContent formats the command as it would be sent as a message.
+func formatCommandContent(c *Command, tokens []string) string {
+	return strings.TrimSpace(fmt.Sprintf("%s%s %s", commandIdentifier, c.ID, strings.Join(tokens, " ")))
+}
@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	"swallowtail/s.satoshi/formatter"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	killSwitchCommandID = "killswitch"
+	killSwitchUsage     = `!killswitch <subcommand>`
+)
+
+func init() {
+	register(killSwitchCommandID, &Command{
+		ID:                  killSwitchCommandID,
+		IsPrivate:           true,
+		IsAdminOnly:         true,
+		MinimumNumberOfArgs: 1,
+		Usage:               killSwitchUsage,
+		Description:         "Halts or resumes trading either for a single user, or for everyone.",
+		Handler:             killSwitchHandler,
+		SubCommands: map[string]*Command{
+			"trip": {
+				ID:                  "killswitch-trip",
+				IsPrivate:           true,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 3,
+				Usage:               `!killswitch trip <system|user_id> <none|cancel|flatten> <reason>`,
+				Description:         "Halts trading; `cancel` also cancels all resting orders, `flatten` additionally closes out all open positions.",
+				Handler:             tripKillSwitchHandler,
+			},
+			"reset": {
+				ID:                  "killswitch-reset",
+				IsPrivate:           true,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 1,
+				Usage:               `!killswitch reset <system|user_id>`,
+				Description:         "Resumes trading; cancelled orders & closed out positions are not restored.",
+				Handler:             resetKillSwitchHandler,
+			},
+			"list": {
+				ID:                  "killswitch-list",
+				IsPrivate:           true,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 0,
+				Usage:               `!killswitch list`,
+				Description:         "Lists every circuit breaker that has ever been tripped, along with whether it is still tripped.",
+				Handler:             listKillSwitchHandler,
+			},
+		},
+	})
+}
+
+func killSwitchHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	return gerrors.Unimplemented("parent_command_unimplemented.killswitch", nil)
+}
+
+func tripKillSwitchHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	target, mode, reason := tokens[0], strings.ToLower(tokens[1]), strings.Join(tokens[2:], " ")
+	scope, userID := parseKillSwitchTarget(target)
+
+	errParams := map[string]string{
+		"target": target,
+		"mode":   mode,
+	}
+
+	var cancelRestingOrders, flattenPositions bool
+	switch mode {
+	case "none":
+	case "cancel":
+		cancelRestingOrders = true
+	case "flatten":
+		cancelRestingOrders, flattenPositions = true, true
+	default:
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, I don't recognise the mode: `%s`; see `!killswitch trip help`.", m.Author.ID, mode))
+		return gerrors.BadParam("failed_to_trip_kill_switch.invalid_mode", errParams)
+	}
+
+	rsp, err := (&tradeengineproto.TripCircuitBreakerRequest{
+		ActorId:             tradeengineproto.TradeEngineActorSatoshiSystem,
+		Scope:               scope,
+		UserId:              userID,
+		Reason:              reason,
+		CancelRestingOrders: cancelRestingOrders,
+		FlattenPositions:    flattenPositions,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_trip_kill_switch", errParams)
+	}
+
+	msg := formatter.FormatCircuitBreaker(rsp.GetCircuitBreaker())
+	if cancelRestingOrders {
+		msg += formatter.FormatHaltedTrading(rsp)
+	}
+
+	// Best Effort.
+	reply(
+		s, m,
+		fmt.Sprintf(":octagonal_sign: <@%s> I've halted trading: %s", m.Author.ID, util.WrapAsCodeBlock(msg)),
+	)
+
+	return nil
+}
+
+func resetKillSwitchHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	scope, userID := parseKillSwitchTarget(tokens[0])
+
+	rsp, err := (&tradeengineproto.ResetCircuitBreakerRequest{
+		ActorId: tradeengineproto.TradeEngineActorSatoshiSystem,
+		Scope:   scope,
+		UserId:  userID,
+	}).Send(ctx).Response()
+	switch {
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "failed_to_reset_circuit_breaker.never_tripped"):
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, that kill switch has never been tripped.", m.Author.ID))
+		return nil
+	case err != nil:
+		return gerrors.Augment(err, "failed_to_reset_kill_switch", map[string]string{
+			"target": tokens[0],
+		})
+	}
+
+	// Best Effort.
+	reply(
+		s, m,
+		fmt.Sprintf(":white_check_mark: <@%s> I've resumed trading: %s", m.Author.ID, util.WrapAsCodeBlock(formatter.FormatCircuitBreaker(rsp.GetCircuitBreaker()))),
+	)
+
+	return nil
+}
+
+func listKillSwitchHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	rsp, err := (&tradeengineproto.ListCircuitBreakersRequest{
+		ActorId: tradeengineproto.TradeEngineActorSatoshiSystem,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_kill_switches", nil)
+	}
+
+	// Best Effort.
+	reply(
+		s, m,
+		fmt.Sprintf(":wave: <@%s> Here are the kill switches: %s", m.Author.ID, util.WrapAsCodeBlock(formatter.FormatCircuitBreakers(rsp.GetCircuitBreakers()))),
+	)
+
+	return nil
+}
+
+// parseKillSwitchTarget parses the target of a kill switch; either the system as a whole, or a single user id.
+func parseKillSwitchTarget(target string) (tradeengineproto.CIRCUIT_BREAKER_SCOPE, string) {
+	if strings.ToLower(target) == "system" {
+		return tradeengineproto.CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER, ""
+	}
+
+	return tradeengineproto.CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER, target
+}
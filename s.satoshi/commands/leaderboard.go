@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	"swallowtail/s.satoshi/formatter"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	leaderboardCommandID    = "leaderboard"
+	leaderboardCommandUsage = `!leaderboard [<days>|all] [expectancy|winrate]`
+
+	defaultLeaderboardWindowInDays = 30
+	// leaderboardMinNumberOfTrades stops a caller topping the leaderboard off the back of a single lucky call.
+	leaderboardMinNumberOfTrades = 3
+	leaderboardLimit             = 15
+)
+
+func init() {
+	register(leaderboardCommandID, &Command{
+		ID:                  leaderboardCommandID,
+		MinimumNumberOfArgs: 0,
+		Usage:               leaderboardCommandUsage,
+		Description:         "Ranks callers by how their calls played out over the last 30 days, or the given number of days; by expectancy (the average R per trade) or win rate.",
+		Guide:               "!leaderboard 90 winrate",
+		Handler:             leaderboardCommand,
+	})
+}
+
+func leaderboardCommand(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	var (
+		windowInDays = int64(defaultLeaderboardWindowInDays)
+		sort         = tradeengineproto.CALLER_PERFORMANCE_SORT_EXPECTANCY
+	)
+
+	for _, token := range tokens {
+		switch t := strings.ToLower(token); t {
+		case "all":
+			windowInDays = 0
+		case "expectancy":
+			sort = tradeengineproto.CALLER_PERFORMANCE_SORT_EXPECTANCY
+		case "winrate", "win_rate":
+			sort = tradeengineproto.CALLER_PERFORMANCE_SORT_WIN_RATE
+		default:
+			days, err := strconv.ParseInt(strings.TrimSuffix(t, "d"), 10, 64)
+			if err != nil || days <= 0 {
+				reply(s, m, formatUsageMsg(m.Author.ID, leaderboardCommandUsage, "!leaderboard 90 winrate"))
+				return gerrors.BadParam("failed_to_read_leaderboard.invalid_arg", map[string]string{
+					"arg": token,
+				})
+			}
+			windowInDays = days
+		}
+	}
+
+	rsp, err := (&tradeengineproto.ListCallerPerformanceRequest{
+		ActorId:           tradeengineproto.TradeEngineActorSatoshiSystem,
+		WindowInDays:      windowInDays,
+		Sort:              sort,
+		MinNumberOfTrades: leaderboardMinNumberOfTrades,
+		Limit:             leaderboardLimit,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_read_leaderboard", map[string]string{
+			"window_in_days": strconv.FormatInt(windowInDays, 10),
+			"sort":           sort.String(),
+		})
+	}
+
+	// Best Effort.
+	reply(
+		s, m,
+		fmt.Sprintf(
+			":trophy: <@%s> Here's the leaderboard: %s",
+			m.Author.ID,
+			util.WrapAsCodeBlock(formatter.FormatCallerPerformances(rsp.GetCallerPerformances(), windowInDays, sort, leaderboardMinNumberOfTrades)),
+		),
+	)
+
+	return nil
+}
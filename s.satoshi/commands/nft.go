@@ -134,7 +134,7 @@ func priceStatsNFTHandler(ctx context.Context, tokens []string, s *discordgo.Ses
 	emoji := collectionEmoji[collectionID]
 	content := fmt.Sprintf("%s `[%s] Floor: %.2f SOL  Average P90: %.2f SOL  LISTED: %d`", emoji, collectionID, floorPrice, totalPrice/float64(p90Index), len(rsp.VendorStats))
 
-	if _, err := s.ChannelMessageSend(m.ChannelID, content); err != nil {
+	if _, err := reply(s, m, content); err != nil {
 		slog.Error(ctx, "Failed to send soana floor price stats to discord", errParams)
 	}
 
@@ -290,7 +290,7 @@ func listNFTHandler(ctx context.Context, tokens []string, s *discordgo.Session,
 
 	msg := fmt.Sprintf("Available Solana NFT collections: `%v`", strings.Join(cc, " "))
 
-	if _, err := s.ChannelMessageSend(m.ChannelID, msg); err != nil {
+	if _, err := reply(s, m, msg); err != nil {
 		slog.Error(ctx, "Failed to send message to discord: list solana nft colletion: Error %v", err)
 	}
 
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	"swallowtail/s.satoshi/dao"
+	"swallowtail/s.satoshi/formatter"
+)
+
+const (
+	outboxCommandID = "outbox"
+	outboxUsage     = `!outbox <subcommand>`
+
+	defaultDeadLettersLimit = 10
+	maxDeadLettersLimit     = 25
+)
+
+func init() {
+	register(outboxCommandID, &Command{
+		ID:                  outboxCommandID,
+		IsPrivate:           false,
+		IsAdminOnly:         true,
+		MinimumNumberOfArgs: 1,
+		Usage:               outboxUsage,
+		Description:         "Inspects & replays the messages satoshi failed to deliver.",
+		Handler:             outboxHandler,
+		SubCommands: map[string]*Command{
+			"failed": {
+				ID:                  "outbox-failed",
+				IsPrivate:           false,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 0,
+				Usage:               `!outbox failed [limit]`,
+				Description:         "Lists the most recent messages that failed to deliver; 10 by default.",
+				Handler:             outboxFailedHandler,
+			},
+			"replay": {
+				ID:                  "outbox-replay",
+				IsPrivate:           false,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 1,
+				Usage:               `!outbox replay <outbox_id>|all`,
+				Description:         "Replays a message that failed to deliver, or all of them; each is retried afresh.",
+				Handler:             outboxReplayHandler,
+			},
+		},
+	})
+}
+
+func outboxHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	return gerrors.Unimplemented("parent_command_unimplemented.outbox", nil)
+}
+
+func outboxFailedHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	limit := defaultDeadLettersLimit
+	if len(tokens) > 0 {
+		l, err := strconv.Atoi(tokens[0])
+		if err != nil || l <= 0 {
+			return gerrors.BadParam("failed_to_list_dead_letters.invalid_limit", map[string]string{
+				"limit": tokens[0],
+			})
+		}
+		limit = l
+	}
+
+	if limit > maxDeadLettersLimit {
+		limit = maxDeadLettersLimit
+	}
+
+	deadLetters, err := dao.ListDeadLetters(ctx, limit)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_dead_letters", nil)
+	}
+
+	// Best Effort.
+	reply(s, m, util.WrapAsCodeBlock(formatter.FormatDeadLetters(deadLetters)))
+
+	return nil
+}
+
+func outboxReplayHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	// No outbox ids replays every dead letter.
+	var outboxIDs []string
+	if tokens[0] != "all" {
+		outboxIDs = tokens
+	}
+
+	replayed, err := dao.ReplayDeadLetters(ctx, outboxIDs)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_replay_dead_letters", nil)
+	}
+
+	// Best Effort.
+	reply(s, m, fmt.Sprintf(":repeat: <@%s> I've replayed %d failed message(s).", m.Author.ID, replayed))
+
+	return nil
+}
@@ -70,40 +70,40 @@ func registerPaymentHandler(ctx context.Context, tokens []string, s *discordgo.S
 	}).Send(ctx).Response()
 	switch {
 	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "failed_to_register_payment.user_does_not_have_an_account"):
-		_, err := s.ChannelMessageSend(
-			m.ChannelID,
+		_, err := reply(
+			s, m,
 			":disappointed: Hey, you must have an account registered first! You can call `!help` to see how.",
 		)
 		return err
 	case gerrors.Is(err, gerrors.ErrAlreadyExists, "failed_to_register_payment.payment_already_exists"):
-		_, err := s.ChannelMessageSend(
-			m.ChannelID,
+		_, err := reply(
+			s, m,
 			":rocket: Hey, looks like you've already paid for this month! If you don't think that's correct please ping @ajperkins",
 		)
 		return err
 	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "failed_to_register_payment.user_has_already_paid"):
-		_, err := s.ChannelMessageSend(
-			m.ChannelID,
+		_, err := reply(
+			s, m,
 			":rocket: Hey, looks like you've already paid for this month! If you don't think that's correct please ping @ajperkins",
 		)
 		return err
 	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "failed_to_register_payment.transaction_of_correct_amount_does_not_exist_in_deposit_account"):
-		_, err := s.ChannelMessageSend(
-			m.ChannelID,
+		_, err := reply(
+			s, m,
 			":disappointed: Hey, my bad I can't find that transaction id in the deposit account! Please check that the **transaction id** and the **amount** is correct ",
 		)
 		return err
 	case err != nil:
 		slog.Error(ctx, "Failed to process payment: %v", err.Error())
-		_, err := s.ChannelMessageSend(
-			m.ChannelID,
+		_, err := reply(
+			s, m,
 			":disappointed: Hey, apologies! Looks like something broke. Please try again - if this keeps happening please ping @ajperkins to investigate",
 		)
 		return err
 	}
 
-	_, err = s.ChannelMessageSend(
-		m.ChannelID,
+	_, err = reply(
+		s, m,
 		fmt.Sprintf(":wave: Payment successfully registered. Thank you <@%s>! :coin:", m.Author.ID),
 	)
 
@@ -127,8 +127,8 @@ func checkPaymentHandler(ctx context.Context, tokens []string, s *discordgo.Sess
 		msg = fmt.Sprintf("I can't seem to find a payment for the last month I'm afraid, your last was: `%s`.\nPlease ask in support channels if you think this is wrong!", rsp.GetLastPaymentTimestamp().AsTime())
 	}
 
-	if _, err := s.ChannelMessageSend(
-		m.ChannelID,
+	if _, err := reply(
+		s, m,
 		fmt.Sprintf(":wave: <@%s> %s", m.Author.ID, msg),
 	); err != nil {
 		slog.Error(ctx, "Failed to publish up to date message to user via discord: %s", m.Author.Username)
@@ -157,8 +157,8 @@ func listPaymentHandler(ctx context.Context, tokens []string, s *discordgo.Sessi
 		sb.WriteString(fmt.Sprintf("\n[%s] txid: %s amount: %.2f", payment.GetPaymentTimestamp().AsTime(), payment.GetTransactionId(), payment.GetAmountInUsdt()))
 	}
 
-	if _, err := s.ChannelMessageSend(
-		m.ChannelID,
+	if _, err := reply(
+		s, m,
 		fmt.Sprintf(":wave: <@%s> I've found your payments over the last two years :dove:\n```%s```", m.Author.ID, sb.String()),
 	); err != nil {
 		slog.Error(ctx, "Failed to send user [%s] list payments message", m.Author.Username)
@@ -25,7 +25,7 @@ func init() {
 }
 
 func pingCommand(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
-	if _, err := s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(":wave: Hello <@%s>, what can `sat %s` do for you?", m.Author.ID, satoshiproto.SatoshiVersion)); err != nil {
+	if _, err := reply(s, m, fmt.Sprintf(":wave: Hello <@%s>, what can `sat %s` do for you?", m.Author.ID, satoshiproto.SatoshiVersion)); err != nil {
 		return gerrors.Augment(err, "failed_to_ping_discord", nil)
 	}
 
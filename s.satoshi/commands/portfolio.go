@@ -80,7 +80,7 @@ func listPortfolioCommand(ctx context.Context, tokens []string, s *discordgo.Ses
 		UserId: m.Author.ID,
 	}).Send(context.Background()).Response()
 	if err != nil {
-		s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(":disappointed: <@%s>, I failed to list your portfolio sheets: %v", m.Author.ID, err))
+		reply(s, m, fmt.Sprintf(":disappointed: <@%s>, I failed to list your portfolio sheets: %v", m.Author.ID, err))
 		return gerrors.Augment(err, "failed_to_list_sheets", map[string]string{
 			"discord_username": m.Author.Username,
 			"user_id":          m.Author.ID,
@@ -90,7 +90,7 @@ func listPortfolioCommand(ctx context.Context, tokens []string, s *discordgo.Ses
 	sheetsMsg := formatListGooglesheetsMsg(rsp.Sheets)
 
 	// Best effort
-	s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(":wave: <@%s>, here are your sheets:\n\n%s", m.Author.ID, sheetsMsg))
+	reply(s, m, fmt.Sprintf(":wave: <@%s>, here are your sheets:\n\n%s", m.Author.ID, sheetsMsg))
 	return nil
 }
 
@@ -101,7 +101,7 @@ func deletePorfolioCommand(ctx context.Context, tokens []string, s *discordgo.Se
 		GooglesheetId: googlesheetID,
 		UserId:        m.Author.ID,
 	}).Send(context.Background()).Response(); err != nil {
-		s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(
+		reply(s, m, fmt.Sprintf(
 			":wave: <@:%s>, Failed to delete sheet! Please check that the id is correct and the sheet exists", m.Author.ID,
 		))
 		return gerrors.Augment(err, "failed_to_delete_sheet", map[string]string{
@@ -112,7 +112,7 @@ func deletePorfolioCommand(ctx context.Context, tokens []string, s *discordgo.Se
 	}
 
 	// Best effort
-	s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(":wave: <@%s>, I've successfully deleted the sheet %s. This means it will no longer be synced.", m.Author.ID, googlesheetID))
+	reply(s, m, fmt.Sprintf(":wave: <@%s>, I've successfully deleted the sheet %s. This means it will no longer be synced.", m.Author.ID, googlesheetID))
 
 	return nil
 }
@@ -127,7 +127,7 @@ func createPortfolioCommand(ctx context.Context, tokens []string, s *discordgo.S
 		ShouldPagerOnTarget: true,
 	}).SendWithTimeout(context.Background(), 15*time.Second).Response()
 	if err != nil {
-		s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(":wave: <@%s>, I failed to create a portfolio sheet: %v", m.Author.ID, err))
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, I failed to create a portfolio sheet: %v", m.Author.ID, err))
 		return gerrors.Augment(err, "failed_to_create_sheet", map[string]string{
 			"discord_username": m.Author.Username,
 			"user_id":          m.Author.ID,
@@ -135,7 +135,7 @@ func createPortfolioCommand(ctx context.Context, tokens []string, s *discordgo.S
 		})
 	}
 
-	s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(":rocket: <@%s>, Portfolio sheet created, here's the URL: %s", m.Author.ID, rsp.GetURL()))
+	reply(s, m, fmt.Sprintf(":rocket: <@%s>, Portfolio sheet created, here's the URL: %s", m.Author.ID, rsp.GetURL()))
 
 	return nil
 }
@@ -149,7 +149,7 @@ func registerPortfolioCommand(ctx context.Context, tokens []string, s *discordgo
 		Email:     email,
 	}).Send(context.Background()).Response()
 	if err != nil {
-		s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(
+		reply(s, m, fmt.Sprintf(
 			":wave: <@:%s>, Failed to register sheet! Please check that the url is correct", m.Author.ID,
 		))
 		return gerrors.Augment(err, "failed_to_register_sheet", map[string]string{
@@ -160,7 +160,7 @@ func registerPortfolioCommand(ctx context.Context, tokens []string, s *discordgo
 		})
 	}
 
-	s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(
+	reply(s, m, fmt.Sprintf(
 		":rocket: <@:%s>, Googlesheet registered! Please share the sheet with this email to allow satoshi access to sync: %s", m.Author.ID, rsp.GetServiceAccountEmail(),
 	))
 
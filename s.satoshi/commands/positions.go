@@ -0,0 +1,127 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	"swallowtail/s.satoshi/dao"
+	"swallowtail/s.satoshi/formatter"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	positionsCommandID    = "positions"
+	positionsCommandUsage = `!positions`
+
+	pnlCommandID    = "pnl"
+	pnlCommandUsage = `!pnl [digest on|off]`
+)
+
+func init() {
+	register(positionsCommandID, &Command{
+		ID:                  positionsCommandID,
+		IsPrivate:           true,
+		IsFuturesOnly:       true,
+		MinimumNumberOfArgs: 0,
+		Usage:               positionsCommandUsage,
+		Description:         "Lists your open positions, their liquidation price & your margin ratio on each of your exchanges.",
+		Handler:             positionsCommand,
+	})
+
+	register(pnlCommandID, &Command{
+		ID:                  pnlCommandID,
+		IsPrivate:           true,
+		IsFuturesOnly:       true,
+		MinimumNumberOfArgs: 0,
+		Usage:               pnlCommandUsage,
+		Description:         "Shows your unrealised & realised PnL on each of your exchanges; or subscribes you to a daily digest DM.",
+		Guide:               "!pnl digest on",
+		Handler:             pnlCommand,
+		Options: []*CommandOption{
+			{
+				Name:        "digest",
+				Description: "`on` to be sent your positions & PnL daily by DM, `off` to stop",
+				Type:        discordgo.ApplicationCommandOptionString,
+				IsNamed:     true,
+			},
+		},
+	})
+}
+
+func positionsCommand(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	venuePositions, err := listVenuePositions(ctx, m.Author.ID)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_positions", nil)
+	}
+
+	// Best Effort.
+	reply(s, m, fmt.Sprintf(":chart_with_upwards_trend: <@%s> Here are your positions: %s", m.Author.ID, util.WrapAsCodeBlock(formatter.FormatVenuePositions(venuePositions))))
+
+	return nil
+}
+
+func pnlCommand(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	if len(tokens) > 0 {
+		return pnlDigestCommand(ctx, tokens, s, m)
+	}
+
+	venuePositions, err := listVenuePositions(ctx, m.Author.ID)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_read_pnl", nil)
+	}
+
+	// Best Effort.
+	reply(s, m, fmt.Sprintf(":moneybag: <@%s> Here's your PnL: %s", m.Author.ID, util.WrapAsCodeBlock(formatter.FormatPnL(venuePositions))))
+
+	return nil
+}
+
+func pnlDigestCommand(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	if len(tokens) != 2 || strings.ToLower(tokens[0]) != "digest" {
+		reply(s, m, formatUsageMsg(m.Author.ID, pnlCommandUsage, "!pnl digest on"))
+		return gerrors.BadParam("failed_to_update_pnl_digest.invalid_args", nil)
+	}
+
+	errParams := map[string]string{
+		"user_id": m.Author.ID,
+	}
+
+	switch strings.ToLower(tokens[1]) {
+	case "on":
+		if _, err := dao.CreatePnLDigestSubscription(ctx, m.Author.ID); err != nil {
+			return gerrors.Augment(err, "failed_to_subscribe_to_pnl_digest", errParams)
+		}
+
+		reply(s, m, fmt.Sprintf(":white_check_mark: <@%s> You'll be sent your positions & PnL by DM every morning (UTC).", m.Author.ID))
+	case "off":
+		if _, err := dao.DeletePnLDigestSubscription(ctx, m.Author.ID); err != nil {
+			return gerrors.Augment(err, "failed_to_unsubscribe_from_pnl_digest", errParams)
+		}
+
+		reply(s, m, fmt.Sprintf(":white_check_mark: <@%s> You'll no longer be sent your daily PnL digest.", m.Author.ID))
+	default:
+		reply(s, m, formatUsageMsg(m.Author.ID, pnlCommandUsage, "!pnl digest on"))
+		return gerrors.BadParam("failed_to_update_pnl_digest.invalid_arg", map[string]string{
+			"arg": tokens[1],
+		})
+	}
+
+	return nil
+}
+
+func listVenuePositions(ctx context.Context, userID string) ([]*tradeengineproto.VenuePositions, error) {
+	rsp, err := (&tradeengineproto.ListVenuePositionsRequest{
+		ActorId: tradeengineproto.TradeEngineActorSatoshiSystem,
+		UserId:  userID,
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_venue_positions", nil)
+	}
+
+	return rsp.GetVenuePositions(), nil
+}
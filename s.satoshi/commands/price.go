@@ -92,7 +92,7 @@ func priceCommand(ctx context.Context, tokens []string, s *discordgo.Session, m
 
 	if len(cache) == 0 {
 		// Best Effort
-		s.ChannelMessageSend(m.ChannelID, fmt.Sprintf(":wave: <@%s> Sorry, i wasn't able to get price info for any symbols [%s]:disappointed:", m.Author.ID, strings.Join(symbols, ",")))
+		reply(s, m, fmt.Sprintf(":wave: <@%s> Sorry, i wasn't able to get price info for any symbols [%s]:disappointed:", m.Author.ID, strings.Join(symbols, ",")))
 		return nil
 	}
 
@@ -123,7 +123,7 @@ func priceCommand(ctx context.Context, tokens []string, s *discordgo.Session, m
 		sb.WriteString(fmt.Sprintf("%s `[%s] %.3f USDT 24h: %.2f%%  Funding Rate: %.4f%%\n`", emoji, k, v.CurrentPrice, v.PercentagePriceChange_24H, v.FundingRate*100))
 	}
 
-	if _, err := s.ChannelMessageSend(m.ChannelID, sb.String()); err != nil {
+	if _, err := reply(s, m, sb.String()); err != nil {
 		return gerrors.Augment(err, "failed_to_execute_price_command", nil)
 	}
 
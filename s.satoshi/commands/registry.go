@@ -23,6 +23,15 @@ func register(id string, command *Command) {
 	registry[id] = command
 }
 
+// lookup returns the command registered with the given ID.
+func lookup(id string) (*Command, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	c, ok := registry[id]
+	return c, ok
+}
+
 // List lists all commands registered to satoshi.
 func List() []*Command {
 	mu.RLock()
@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/emojis"
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	"swallowtail/s.satoshi/dao"
+	"swallowtail/s.satoshi/domain"
+	"swallowtail/s.satoshi/formatter"
+	"swallowtail/s.satoshi/parser"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	reviewCommandID = "review"
+	reviewUsage     = `!review <subcommand>`
+
+	defaultReviewStatsWindowInDays = 30
+)
+
+func init() {
+	register(reviewCommandID, &Command{
+		ID:                  reviewCommandID,
+		IsPrivate:           false,
+		IsAdminOnly:         true,
+		MinimumNumberOfArgs: 1,
+		Usage:               reviewUsage,
+		Description:         "Reviews trade strategies the parser wasn't confident enough in to create without an admin.",
+		Handler:             reviewHandler,
+		SubCommands: map[string]*Command{
+			"edit": {
+				ID:                  "review-edit",
+				IsPrivate:           false,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 2,
+				Usage:               `!review edit <review_id> <signal>`,
+				Description:         "Replaces a drafted trade strategy with a signal, e.g. `LONG BTC entry 30000-31000 sl 29000 tp 32000/33000`; the draft still needs approving.",
+				Handler:             editReviewHandler,
+			},
+			"stats": {
+				ID:                  "review-stats",
+				IsPrivate:           false,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 0,
+				Usage:               `!review stats [days]`,
+				Description:         "Shows the precision of each parser over the last 30 days, or the given number of days.",
+				Handler:             reviewStatsHandler,
+			},
+		},
+	})
+}
+
+func reviewHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	return gerrors.Unimplemented("parent_command_unimplemented.review", nil)
+}
+
+func editReviewHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	reviewID, signal := tokens[0], strings.Join(tokens[1:], " ")
+
+	errParams := map[string]string{
+		"review_id": reviewID,
+	}
+
+	review, err := dao.ReadParsedTradeReviewByID(ctx, reviewID)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_edit_review", errParams)
+	}
+
+	if review.Status != domain.ParsedTradeReviewStatusPending {
+		errParams["status"] = review.Status
+		return gerrors.FailedPrecondition("failed_to_edit_review.review_not_pending", errParams)
+	}
+
+	drafted := &tradeengineproto.TradeStrategy{}
+	if err := json.Unmarshal(review.TradeStrategy, drafted); err != nil {
+		return gerrors.Augment(err, "failed_to_edit_review.unmarshal", errParams)
+	}
+
+	parsed, err := parser.ParseSignal(ctx, signal, m, drafted.ActorType)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_edit_review.invalid_signal", errParams)
+	}
+
+	// The trade strategy remains that of the mod who posted it; not the admin who corrected it.
+	edited := parsed.TradeStrategy
+	edited.ActorId = drafted.ActorId
+	edited.HumanizedActorName = drafted.HumanizedActorName
+	edited.IdempotencyKey = drafted.IdempotencyKey
+
+	tradeStrategy, err := json.Marshal(edited)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_edit_review.marshal", errParams)
+	}
+
+	review.TradeStrategy = tradeStrategy
+	review.IsEdited = true
+	if err := dao.UpdateParsedTradeReview(ctx, review); err != nil {
+		return gerrors.Augment(err, "failed_to_edit_review", errParams)
+	}
+
+	// Best Effort.
+	reply(
+		s, m,
+		fmt.Sprintf(
+			":pencil2: <@%s> I've updated the draft; react %s to the original draft to approve it.\n%s",
+			m.Author.ID, emojis.ApproveEmoji, formatter.FormatTradeStrategy(review.Source, edited, review.Content),
+		),
+	)
+
+	return nil
+}
+
+func reviewStatsHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	days := defaultReviewStatsWindowInDays
+	if len(tokens) > 0 {
+		d, err := strconv.Atoi(tokens[0])
+		if err != nil || d <= 0 {
+			return gerrors.BadParam("failed_to_read_review_stats.invalid_days", map[string]string{
+				"days": tokens[0],
+			})
+		}
+		days = d
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -days)
+	counts, err := dao.ListParserReviewCounts(ctx, since)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_read_review_stats", nil)
+	}
+
+	// Best Effort.
+	reply(s, m, util.WrapAsCodeBlock(formatter.FormatParserReviewStats(counts, since)))
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"swallowtail/libraries/gerrors"
+	"swallowtail/s.satoshi/formatter"
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 
 	"github.com/bwmarrin/discordgo"
@@ -32,9 +33,43 @@ func init() {
 				IsPrivate:           false,
 				IsFuturesOnly:       true,
 				MinimumNumberOfArgs: 3,
-				Usage:               `!trade execute <trade_id> <risk (%)>`,
+				Usage:               `!trade execute <trade_id> <venue> <risk (%)> [breakeven] [trail <percentage>]`,
 				Handler:             executeTradeStrategyHandler,
 				FailureMsg:          "Please check the guide you have do the command correctly. Run `!trade help` to see it.",
+				Options: []*CommandOption{
+					{
+						Name:         "trade_id",
+						Description:  "The trade to execute.",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: autocompleteTradeStrategyIDs,
+					},
+					{
+						Name:         "venue",
+						Description:  "The venue to execute the trade on; `paper` to simulate it.",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: autocompleteVenues,
+					},
+					{
+						Name:        "risk",
+						Description: "The percentage of your account to risk.",
+						Type:        discordgo.ApplicationCommandOptionNumber,
+						Required:    true,
+					},
+					{
+						Name:        "breakeven",
+						Description: "Move the stop to break even once the first take profit fills.",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+					},
+					{
+						Name:        "trail",
+						Description: "Then trail the stop by the given percentage.",
+						Type:        discordgo.ApplicationCommandOptionNumber,
+						IsNamed:     true,
+					},
+				},
+				RequiresConfirmation: true,
 			},
 		},
 	})
@@ -61,16 +96,60 @@ func executeTradeStrategyHandler(ctx context.Context, tokens []string, s *discor
 		return gerrors.Augment(err, "failed_to_execute_trade.invalid_risk", errParams)
 	}
 
+	// Parse venue; i.e `paper` to simulate the trade without risking funds.
+	venueProto, ok := tradeengineproto.VENUE_value[strings.ToUpper(venue)]
+	if !ok {
+		return gerrors.BadParam("failed_to_execute_trade.invalid_venue", errParams)
+	}
+
+	// Parse the optional position management; `breakeven` moves the stop to break even once the first take profit fills &
+	// `trail <percentage>` then trails the stop by the given percentage.
+	var (
+		moveStopToBreakEven    bool
+		trailingStopPercentage float64
+	)
+	for i := 3; i < len(tokens); i++ {
+		switch strings.ToLower(tokens[i]) {
+		case "breakeven":
+			moveStopToBreakEven = true
+		case "trail":
+			if i+1 >= len(tokens) {
+				return gerrors.BadParam("failed_to_execute_trade.missing_trailing_stop_percentage", errParams)
+			}
+
+			i++
+			errParams["trailing_stop_percentage"] = tokens[i]
+			trailingStopPercentage, err = strconv.ParseFloat(strings.ReplaceAll(tokens[i], "%", ""), 32)
+			if err != nil {
+				return gerrors.Augment(err, "failed_to_execute_trade.invalid_trailing_stop_percentage", errParams)
+			}
+		default:
+			errParams["option"] = tokens[i]
+			return gerrors.BadParam("failed_to_execute_trade.invalid_option", errParams)
+		}
+	}
+
 	if _, err := (&tradeengineproto.ExecuteTradeStrategyForParticipantRequest{
-		ActorId:         tradeengineproto.TradeEngineActorSatoshiSystem,
-		UserId:          m.Author.ID,
-		TradeStrategyId: tradeStrategyID,
-		IsBot:           false,
-		Risk:            float32(risk),
+		ActorId:                tradeengineproto.TradeEngineActorSatoshiSystem,
+		UserId:                 m.Author.ID,
+		TradeStrategyId:        tradeStrategyID,
+		IsBot:                  false,
+		Risk:                   float32(risk),
+		Venue:                  tradeengineproto.VENUE(venueProto),
+		MoveStopToBreakEven:    moveStopToBreakEven,
+		TrailingStopPercentage: float32(trailingStopPercentage),
 	}).Send(ctx).Response(); err != nil {
-		_, err = s.ChannelMessageSend(
-			m.ChannelID,
-			fmt.Sprintf(":wave:<@%s>, very sorry but it seems as though the trade failed! Error: %v", m.Author.ID, err),
+		errMsg := err.Error()
+		switch {
+		case gerrors.Is(err, gerrors.ErrFailedPrecondition, "risk_profile_violation"):
+			errMsg = formatter.FormatRiskProfileViolation(err)
+		case gerrors.Is(err, gerrors.ErrFailedPrecondition, "circuit_breaker_tripped"):
+			errMsg = formatter.FormatCircuitBreakerTripped(err)
+		}
+
+		_, err = reply(
+			s, m,
+			fmt.Sprintf(":wave:<@%s>, very sorry but it seems as though the trade failed! Error: %v", m.Author.ID, errMsg),
 		)
 		if err != nil {
 			slog.Error(ctx, err.Error())
@@ -79,8 +158,8 @@ func executeTradeStrategyHandler(ctx context.Context, tokens []string, s *discor
 		return nil
 	}
 
-	_, err = s.ChannelMessageSend(
-		m.ChannelID,
+	_, err = reply(
+		s, m,
 		fmt.Sprintf(":wave: <@%s> Trade has been successfully executed with %.2f risk :rocket:. Please check manually that everything is in order! :coin:", m.Author.ID, risk),
 	)
 	if err != nil {
@@ -0,0 +1,356 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	marketdataproto "swallowtail/s.market-data/proto"
+	"swallowtail/s.satoshi/formatter"
+)
+
+const (
+	watchlistCommandID    = "watchlist"
+	watchlistCommandUsage = `!watchlist <subcommand>`
+
+	myWatchlistCommandID    = "mywatchlist"
+	myWatchlistCommandUsage = `!mywatchlist <subcommand>`
+)
+
+var (
+	// watchlistFlags are the flags of `!watchlist add` & `!watchlist remove`; flags are passed as `--<flag> <value>`, or
+	// as `<flag> <value>` when run as a slash command.
+	watchlistFlags = []string{"list", "group", "volatility", "venue", "asset", "name", "emoji"}
+
+	watchlists = []string{
+		marketdataproto.WatchlistPrices,
+		marketdataproto.WatchlistFundingRates,
+		marketdataproto.WatchlistSolanaNFTs,
+	}
+)
+
+func init() {
+	watchlistFlagOptions := []*CommandOption{
+		{
+			Name:        "symbol",
+			Description: "The symbol i.e `sol`, the venue's symbol i.e `SOL-PERP` or the nft collection id",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    true,
+		},
+		{
+			Name:        "asset_pair",
+			Description: "The asset pair of prices i.e `usd`",
+			Type:        discordgo.ApplicationCommandOptionString,
+		},
+		{
+			Name:        "list",
+			Description: "`prices` (default), `funding-rates` or `solana-nfts`",
+			Type:        discordgo.ApplicationCommandOptionString,
+			IsNamed:     true,
+		},
+		{
+			Name:        "venue",
+			Description: "The venue of funding rates i.e `ftx`, or the vendor of solana nfts i.e `solanart`",
+			Type:        discordgo.ApplicationCommandOptionString,
+			IsNamed:     true,
+		},
+	}
+
+	register(watchlistCommandID, &Command{
+		ID:                  watchlistCommandID,
+		IsPrivate:           true,
+		IsAdminOnly:         true,
+		MinimumNumberOfArgs: 1,
+		Usage:               watchlistCommandUsage,
+		Description:         "Manages the watchlists of the assets we publish prices, funding rates & nft floor prices of.",
+		Handler:             watchlistHandler,
+		SubCommands: map[string]*Command{
+			"add": {
+				ID:                  "watchlist-add",
+				IsPrivate:           true,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 1,
+				Usage:               `!watchlist add <symbol> [asset_pair] [--list <list>] [--group <group>] [--volatility <low|medium|high|extreme>] [--venue <venue>] [--asset <asset>] [--name <name>] [--emoji <emoji>]`,
+				Description:         "Adds an asset to a watchlist, or updates it if it's already on the watchlist.",
+				Guide:               "!watchlist add sol usdt --group solana --volatility high",
+				Handler:             addWatchlistAssetHandler,
+				Options: append(watchlistFlagOptions,
+					&CommandOption{
+						Name:        "group",
+						Description: "The group prices are published under i.e `solana`",
+						Type:        discordgo.ApplicationCommandOptionString,
+						IsNamed:     true,
+					},
+					&CommandOption{
+						Name:        "volatility",
+						Description: "`low` (default), `medium`, `high` or `extreme`",
+						Type:        discordgo.ApplicationCommandOptionString,
+						IsNamed:     true,
+					},
+					&CommandOption{
+						Name:        "asset",
+						Description: "The underlying asset of funding rates i.e `SOL`",
+						Type:        discordgo.ApplicationCommandOptionString,
+						IsNamed:     true,
+					},
+					&CommandOption{
+						Name:        "name",
+						Description: "A humanized name i.e `Degenerate Ape Academy`",
+						Type:        discordgo.ApplicationCommandOptionString,
+						IsNamed:     true,
+					},
+					&CommandOption{
+						Name:        "emoji",
+						Description: "The emoji of solana nfts i.e `:monkey:`",
+						Type:        discordgo.ApplicationCommandOptionString,
+						IsNamed:     true,
+					},
+				),
+			},
+			"remove": {
+				ID:                  "watchlist-remove",
+				IsPrivate:           true,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 1,
+				Usage:               `!watchlist remove <symbol> [asset_pair] [--list <list>] [--venue <venue>]`,
+				Description:         "Removes an asset from a watchlist.",
+				Guide:               "!watchlist remove BTC-PERP --list funding-rates --venue ftx",
+				Handler:             removeWatchlistAssetHandler,
+				Options:             watchlistFlagOptions,
+			},
+			"list": {
+				ID:                  "watchlist-list",
+				IsPrivate:           true,
+				IsAdminOnly:         true,
+				MinimumNumberOfArgs: 0,
+				Usage:               `!watchlist list [prices|funding-rates|solana-nfts]`,
+				Description:         "Lists the assets on a watchlist; defaults to prices.",
+				Handler:             listWatchlistAssetsHandler,
+			},
+		},
+	})
+
+	register(myWatchlistCommandID, &Command{
+		ID:                  myWatchlistCommandID,
+		MinimumNumberOfArgs: 0,
+		Usage:               myWatchlistCommandUsage,
+		Description:         "Manages your personal watchlist; you're sent the prices of your watchlist by DM every morning (UTC).",
+		Handler:             listMyWatchlistHandler,
+		SubCommands: map[string]*Command{
+			"add": {
+				ID:                  "mywatchlist-add",
+				MinimumNumberOfArgs: 2,
+				Usage:               `!mywatchlist add <symbol> <asset_pair>`,
+				Description:         "Adds an asset to your watchlist.",
+				Guide:               "!mywatchlist add sol usd",
+				Handler:             addMyWatchlistAssetHandler,
+			},
+			"remove": {
+				ID:                  "mywatchlist-remove",
+				MinimumNumberOfArgs: 2,
+				Usage:               `!mywatchlist remove <symbol> <asset_pair>`,
+				Description:         "Removes an asset from your watchlist; you're no longer sent a digest once it's empty.",
+				Handler:             removeMyWatchlistAssetHandler,
+			},
+			"list": {
+				ID:                  "mywatchlist-list",
+				MinimumNumberOfArgs: 0,
+				Usage:               `!mywatchlist list`,
+				Description:         "Lists the assets on your watchlist.",
+				Handler:             listMyWatchlistHandler,
+			},
+		},
+	})
+}
+
+func watchlistHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	return gerrors.Unimplemented("parent_command_unimplemented.watchlist", nil)
+}
+
+func addWatchlistAssetHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	args, flags := parseWatchlistArgs(tokens)
+	if len(args) == 0 {
+		reply(s, m, formatUsageMsg(m.Author.ID, watchlistCommandUsage, "!watchlist add sol usdt --group solana --volatility high"))
+		return gerrors.BadParam("failed_to_add_watchlist_asset.missing_symbol", nil)
+	}
+
+	asset := &marketdataproto.WatchlistAsset{
+		Watchlist:        watchlistOrDefault(flags["list"]),
+		Symbol:           args[0],
+		AssetPair:        safeArg(args, 1),
+		Venue:            flags["venue"],
+		Grouping:         flags["group"],
+		VolatilityRating: flags["volatility"],
+		HumanizedName:    flags["name"],
+		Asset:            flags["asset"],
+		Emoji:            flags["emoji"],
+		CreatedBy:        m.Author.ID,
+	}
+
+	rsp, err := (&marketdataproto.AddWatchlistAssetRequest{
+		ActorId: marketdataproto.MarketDataActorSatoshiCommand,
+		Asset:   asset,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_add_watchlist_asset", map[string]string{
+			"watchlist": asset.Watchlist,
+			"symbol":    asset.Symbol,
+		})
+	}
+
+	// Best Effort.
+	reply(
+		s, m,
+		fmt.Sprintf(":white_check_mark: <@%s> I've added it to the `%s` watchlist: %s", m.Author.ID, asset.Watchlist, util.WrapAsCodeBlock(formatter.FormatWatchlistAssets([]*marketdataproto.WatchlistAsset{rsp.GetAsset()}))),
+	)
+
+	return nil
+}
+
+func removeWatchlistAssetHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	args, flags := parseWatchlistArgs(tokens)
+	if len(args) == 0 {
+		reply(s, m, formatUsageMsg(m.Author.ID, watchlistCommandUsage, "!watchlist remove sol usdt"))
+		return gerrors.BadParam("failed_to_remove_watchlist_asset.missing_symbol", nil)
+	}
+
+	watchlist := watchlistOrDefault(flags["list"])
+	return removeWatchlistAsset(ctx, s, m, watchlist, "", args[0], safeArg(args, 1), flags["venue"])
+}
+
+func listWatchlistAssetsHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	watchlist := watchlistOrDefault(safeArg(tokens, 0))
+	if !contains(watchlist, watchlists) {
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, I don't recognise the watchlist: `%s`; it must be one of %s.", m.Author.ID, watchlist, strings.Join(watchlists, ", ")))
+		return gerrors.BadParam("failed_to_list_watchlist.invalid_watchlist", map[string]string{
+			"watchlist": watchlist,
+		})
+	}
+
+	return listWatchlistAssets(ctx, s, m, watchlist, "")
+}
+
+func addMyWatchlistAssetHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	symbol, assetPair := tokens[0], tokens[1]
+
+	if _, err := (&marketdataproto.AddWatchlistAssetRequest{
+		ActorId: marketdataproto.MarketDataActorSatoshiCommand,
+		Asset: &marketdataproto.WatchlistAsset{
+			Watchlist: marketdataproto.WatchlistPrices,
+			OwnerId:   m.Author.ID,
+			Symbol:    symbol,
+			AssetPair: assetPair,
+			CreatedBy: m.Author.ID,
+		},
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_add_personal_watchlist_asset", map[string]string{
+			"symbol":     symbol,
+			"asset_pair": assetPair,
+		})
+	}
+
+	// Best Effort.
+	reply(s, m, fmt.Sprintf(":white_check_mark: <@%s> I've added `%s%s` to your watchlist; you'll be sent its price by DM every morning (UTC).", m.Author.ID, strings.ToUpper(symbol), strings.ToUpper(assetPair)))
+
+	return nil
+}
+
+func removeMyWatchlistAssetHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	return removeWatchlistAsset(ctx, s, m, marketdataproto.WatchlistPrices, m.Author.ID, tokens[0], tokens[1], "")
+}
+
+func listMyWatchlistHandler(ctx context.Context, tokens []string, s *discordgo.Session, m *discordgo.MessageCreate) error {
+	return listWatchlistAssets(ctx, s, m, marketdataproto.WatchlistPrices, m.Author.ID)
+}
+
+func removeWatchlistAsset(ctx context.Context, s *discordgo.Session, m *discordgo.MessageCreate, watchlist, ownerID, symbol, assetPair, venue string) error {
+	_, err := (&marketdataproto.RemoveWatchlistAssetRequest{
+		ActorId:   marketdataproto.MarketDataActorSatoshiCommand,
+		Watchlist: watchlist,
+		OwnerId:   ownerID,
+		Symbol:    symbol,
+		AssetPair: assetPair,
+		Venue:     venue,
+	}).Send(ctx).Response()
+	switch {
+	case gerrors.Is(err, gerrors.ErrNotFound, "failed_to_remove_watchlist_asset.not_on_watchlist"):
+		reply(s, m, fmt.Sprintf(":wave: <@%s>, `%s` isn't on that watchlist.", m.Author.ID, strings.TrimSpace(fmt.Sprintf("%s %s %s", symbol, assetPair, venue))))
+		return nil
+	case err != nil:
+		return gerrors.Augment(err, "failed_to_remove_watchlist_asset", map[string]string{
+			"watchlist": watchlist,
+			"symbol":    symbol,
+		})
+	}
+
+	// Best Effort.
+	reply(s, m, fmt.Sprintf(":white_check_mark: <@%s> I've removed `%s` from the watchlist.", m.Author.ID, strings.TrimSpace(fmt.Sprintf("%s %s", symbol, assetPair))))
+
+	return nil
+}
+
+func listWatchlistAssets(ctx context.Context, s *discordgo.Session, m *discordgo.MessageCreate, watchlist, ownerID string) error {
+	rsp, err := (&marketdataproto.ListWatchlistAssetsRequest{
+		Watchlist: watchlist,
+		OwnerId:   ownerID,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_watchlist", map[string]string{
+			"watchlist": watchlist,
+		})
+	}
+
+	// Best Effort.
+	reply(s, m, fmt.Sprintf(":eyes: <@%s> Here's the watchlist: %s", m.Author.ID, util.WrapAsCodeBlock(formatter.FormatWatchlistAssets(rsp.GetAssets()))))
+
+	return nil
+}
+
+// parseWatchlistArgs splits the tokens into positional arguments & flags. Flags are passed as `--<flag> <value>`, or as
+// `<flag> <value>` after the first argument; values run until the next flag so they may contain spaces.
+func parseWatchlistArgs(tokens []string) ([]string, map[string]string) {
+	var (
+		args  = []string{}
+		flags = map[string]string{}
+		flag  string
+	)
+	for i, token := range tokens {
+		name := strings.ToLower(strings.TrimPrefix(token, "--"))
+		if (strings.HasPrefix(token, "--") || i > 0) && contains(name, watchlistFlags) {
+			flag = name
+			flags[flag] = ""
+			continue
+		}
+
+		switch {
+		case flag == "":
+			args = append(args, token)
+		case flags[flag] == "":
+			flags[flag] = token
+		default:
+			flags[flag] = fmt.Sprintf("%s %s", flags[flag], token)
+		}
+	}
+
+	return args, flags
+}
+
+func watchlistOrDefault(watchlist string) string {
+	if watchlist == "" {
+		return marketdataproto.WatchlistPrices
+	}
+
+	return strings.ToLower(watchlist)
+}
+
+func safeArg(args []string, index int) string {
+	if index >= len(args) {
+		return ""
+	}
+
+	return args[index]
+}
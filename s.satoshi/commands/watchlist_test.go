@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWatchlistArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		tokens        []string
+		expectedArgs  []string
+		expectedFlags map[string]string
+	}{
+		{
+			name:          "args_only",
+			tokens:        []string{"sol", "usdt"},
+			expectedArgs:  []string{"sol", "usdt"},
+			expectedFlags: map[string]string{},
+		},
+		{
+			name:         "prefixed_flags",
+			tokens:       []string{"sol", "usdt", "--group", "solana", "--volatility", "high"},
+			expectedArgs: []string{"sol", "usdt"},
+			expectedFlags: map[string]string{
+				"group":      "solana",
+				"volatility": "high",
+			},
+		},
+		{
+			name:         "slash_command_flags",
+			tokens:       []string{"BTC-PERP", "list", "funding-rates", "venue", "ftx"},
+			expectedArgs: []string{"BTC-PERP"},
+			expectedFlags: map[string]string{
+				"list":  "funding-rates",
+				"venue": "ftx",
+			},
+		},
+		{
+			name:         "multi_word_value",
+			tokens:       []string{"degenerate_ape_academy", "--list", "solana-nfts", "--name", "Degenerate", "Ape", "Academy", "--emoji", ":monkey:"},
+			expectedArgs: []string{"degenerate_ape_academy"},
+			expectedFlags: map[string]string{
+				"list":  "solana-nfts",
+				"name":  "Degenerate Ape Academy",
+				"emoji": ":monkey:",
+			},
+		},
+		{
+			name:          "symbol_named_like_a_flag",
+			tokens:        []string{"asset", "usd"},
+			expectedArgs:  []string{"asset", "usd"},
+			expectedFlags: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args, flags := parseWatchlistArgs(tt.tokens)
+			assert.Equal(t, tt.expectedArgs, args)
+			assert.Equal(t, tt.expectedFlags, flags)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+DO $$
+BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_satoshi_parsed_trade_review_status') THEN
+		CREATE TYPE s_satoshi_parsed_trade_review_status AS ENUM ('PENDING', 'APPROVED', 'REJECTED', 'EXPIRED');
+	END IF;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS s_satoshi_parsed_trade_reviews(
+	review_id uuid DEFAULT uuid_generate_v4(),
+	parser VARCHAR(64) NOT NULL,
+	confidence DECIMAL NOT NULL,
+	inferred_fields TEXT[] NOT NULL,
+	source VARCHAR(255) NOT NULL,
+	content TEXT NOT NULL,
+	trade_strategy JSONB NOT NULL,
+	status s_satoshi_parsed_trade_review_status NOT NULL,
+	is_edited BOOLEAN NOT NULL DEFAULT FALSE,
+	reviewer_id VARCHAR(64) NOT NULL DEFAULT '',
+	trade_strategy_id VARCHAR(64) NOT NULL DEFAULT '',
+	created TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY (review_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_s_satoshi_parsed_trade_reviews_parser
+	ON s_satoshi_parsed_trade_reviews(parser, status);
+
+DO $$
+BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_satoshi_outbox_message_status') THEN
+		CREATE TYPE s_satoshi_outbox_message_status AS ENUM ('PENDING', 'DELIVERED');
+	END IF;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS s_satoshi_outbox_messages(
+	outbox_id uuid DEFAULT uuid_generate_v4(),
+	idempotency_key VARCHAR(255) NOT NULL,
+	consumer_id VARCHAR(64) NOT NULL,
+	discord_channel_id VARCHAR(64) NOT NULL,
+	message TEXT NOT NULL,
+	attachments JSONB NOT NULL,
+	is_private BOOLEAN NOT NULL,
+	participent_ids TEXT[] NOT NULL,
+	metadata JSONB NOT NULL,
+	status s_satoshi_outbox_message_status NOT NULL,
+	attempts INT NOT NULL DEFAULT 0,
+	last_error TEXT NOT NULL DEFAULT '',
+	next_attempt TIMESTAMP NOT NULL,
+	discord_message_id VARCHAR(64) NOT NULL DEFAULT '',
+	created TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY (outbox_id),
+	UNIQUE (idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_s_satoshi_outbox_messages_due
+	ON s_satoshi_outbox_messages(status, next_attempt);
+
+CREATE TABLE IF NOT EXISTS s_satoshi_outbox_dead_letters(
+	outbox_id uuid NOT NULL,
+	idempotency_key VARCHAR(255) NOT NULL,
+	consumer_id VARCHAR(64) NOT NULL,
+	discord_channel_id VARCHAR(64) NOT NULL,
+	message TEXT NOT NULL,
+	attachments JSONB NOT NULL,
+	is_private BOOLEAN NOT NULL,
+	participent_ids TEXT[] NOT NULL,
+	metadata JSONB NOT NULL,
+	attempts INT NOT NULL,
+	last_error TEXT NOT NULL,
+	created TIMESTAMP NOT NULL,
+	dead_lettered TIMESTAMP NOT NULL,
+
+	PRIMARY KEY (outbox_id)
+);
+
+DO $$
+BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_satoshi_trade_participant_poll_status') THEN
+		CREATE TYPE s_satoshi_trade_participant_poll_status AS ENUM ('ACTIVE', 'CLOSED');
+	END IF;
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_satoshi_trade_participant_reaction_status') THEN
+		CREATE TYPE s_satoshi_trade_participant_reaction_status AS ENUM ('CLAIMED', 'EXECUTED', 'FAILED', 'INTERRUPTED');
+	END IF;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS s_satoshi_trade_participant_polls(
+	poll_id uuid DEFAULT uuid_generate_v4(),
+	trade_strategy_id VARCHAR(64) NOT NULL,
+	channel_id VARCHAR(64) NOT NULL,
+	message_id VARCHAR(64) NOT NULL,
+	deadline TIMESTAMP NOT NULL,
+	status s_satoshi_trade_participant_poll_status NOT NULL,
+	created TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY (poll_id),
+	UNIQUE (message_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_s_satoshi_trade_participant_polls_status
+	ON s_satoshi_trade_participant_polls(status);
+
+-- Mirrors the trade engine's UNIQUE(trade_strategy_id, user_id) on trade strategy participants; a reaction is claimed
+-- before the trade strategy is executed for its user, so it's executed at most once.
+CREATE TABLE IF NOT EXISTS s_satoshi_trade_participant_reactions(
+	trade_strategy_id VARCHAR(64) NOT NULL,
+	user_id VARCHAR(64) NOT NULL,
+	poll_id uuid NOT NULL,
+	reaction_id VARCHAR(64) NOT NULL,
+	risk INT NOT NULL,
+	status s_satoshi_trade_participant_reaction_status NOT NULL,
+	error TEXT NOT NULL DEFAULT '',
+	created TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY (trade_strategy_id, user_id),
+	CONSTRAINT fk_s_satoshi_trade_participant_reactions_poll
+		FOREIGN KEY(poll_id)
+			REFERENCES s_satoshi_trade_participant_polls(poll_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS s_satoshi_pnl_digest_subscriptions(
+	user_id VARCHAR(64) NOT NULL,
+	created TIMESTAMP NOT NULL,
+	last_sent TIMESTAMP,
+
+	PRIMARY KEY (user_id)
+);
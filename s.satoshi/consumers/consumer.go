@@ -8,9 +8,8 @@ import (
 )
 
 // ConsumerMessage the struct definition of a message that satoshi will consume.
-// One caveat is that we are vendor locked with Discord with the name conventions &
-// use of attachments here - whilst practically this won't change, it would be nice to
-// be agnostic.
+// Messages are consumed from any source, i.e discord, twitter, webhooks & telegram; but
+// satoshi only ever posts them to Discord, hence the name conventions & use of attachments here.
 type ConsumerMessage struct {
 	// The message attachments of which to post along with the content.
 	Attachments []*discordgo.MessageAttachment
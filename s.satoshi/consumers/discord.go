@@ -120,23 +120,36 @@ func handleModMessages(
 			}
 
 			// Attempt to parse a trade strategy.
-			tradeStrategy, err := parser.Parse(ctx, discordproto.DiscordMoonModMessagesChannel, pc.Content, mc, tradeengineproto.ACTOR_TYPE_EXTERNAL)
+			parsed, err := parser.Parse(ctx, discordproto.DiscordMoonModMessagesChannel, pc.Content, mc, tradeengineproto.ACTOR_TYPE_EXTERNAL)
 			if err != nil {
 				// No trade strategy can be parsed; so lets continue.
 				slog.Trace(ctx, "Failed to parse trade strategy: %+v, content: %s", err, pc.Content)
 				continue
 			}
 
-			if tradeStrategy == nil {
+			if parsed == nil || parsed.TradeStrategy == nil {
 				continue
 			}
 
+			tradeStrategy := parsed.TradeStrategy
 			now := time.Now().UTC()
 			idempotencyKey := fmt.Sprintf("tradestrategy-%s-%s-%v-%v-%v", tradeStrategy.ActorId, tradeStrategy.Asset, entriesAsString(tradeStrategy.Entries), tradeStrategy.StopLoss, now.Truncate(time.Hour))
 
 			// Sign our trade with our idempotency key.
 			tradeStrategy.IdempotencyKey = idempotencyKey
 
+			// If the parser isn't confident; an admin must approve the trade strategy before we create it.
+			if parsed.RequiresReview() {
+				msg, err := draftParsedTradeStrategy(ctx, c, isActive, parsed, "WWG", pc.Content, m.Attachments)
+				if err != nil {
+					slog.Error(ctx, "Failed to draft trade strategy for review: %v, Error: %v", tradeStrategy, err)
+					continue
+				}
+
+				msgs = append(msgs, msg)
+				continue
+			}
+
 			rsp, err := createTradeStrategy(ctx, tradeStrategy)
 			if err != nil {
 				slog.Error(ctx, "Failed to create trade: %v, Error: %v", tradeStrategy, err)
@@ -169,11 +182,7 @@ func handleModMessages(
 
 		// Lets publish our messages.
 		for _, msg := range msgs {
-			select {
-			case c <- msg:
-			default:
-				slog.Warn(ctx, "Failed to publish satoshi mods msg; blocked channel")
-			}
+			publish(ctx, c, msg)
 		}
 	}
 }
@@ -211,19 +220,32 @@ func handleSwingMessages(
 			})
 
 			// Attempt to parse a trade.
-			tradeStrategy, err := parser.Parse(ctx, discordproto.DiscordMoonSwingGroupChannel, pc.Content, mc, tradeengineproto.ACTOR_TYPE_EXTERNAL)
+			parsed, err := parser.Parse(ctx, discordproto.DiscordMoonSwingGroupChannel, pc.Content, mc, tradeengineproto.ACTOR_TYPE_EXTERNAL)
 			if err != nil {
 				// No trade strategy can be parsed; so lets continue.
 				slog.Trace(ctx, "Failed to parse trade strategy: %+v, content: %s", err, pc.Content)
 				continue
 			}
 
+			tradeStrategy := parsed.TradeStrategy
 			now := time.Now().UTC()
 			idempotencyKey := fmt.Sprintf("tradestrategy-%s-%s-%v-%v-%v", tradeStrategy.ActorId, tradeStrategy.Asset, entriesAsString(tradeStrategy.Entries), tradeStrategy.StopLoss, now.Truncate(time.Minute))
 
 			// Sign our trade strategy with an idempotency key.
 			tradeStrategy.IdempotencyKey = idempotencyKey
 
+			// If the parser isn't confident; an admin must approve the trade strategy before we create it.
+			if parsed.RequiresReview() {
+				msg, err := draftParsedTradeStrategy(ctx, c, isActive, parsed, "SWINGS & SCALPS", pc.Content, m.Attachments)
+				if err != nil {
+					slog.Error(ctx, "Failed to draft trade strategy for review: %v, Error: %v", tradeStrategy, err)
+					continue
+				}
+
+				publish(ctx, c, msg)
+				continue
+			}
+
 			rsp, err := createTradeStrategy(ctx, tradeStrategy)
 			if err != nil {
 				// Best effort for now.
@@ -253,21 +275,12 @@ func handleSwingMessages(
 				},
 			}
 
-			select {
-			case c <- msg:
-			default:
-				slog.Warn(ctx, "Failed to publish satoshi swings msg; blocked channel")
-			}
+			publish(ctx, c, msg)
 		}
 
 		// Lets publish our messages.
 		for _, msg := range msgs {
-			select {
-			case c <- msg:
-			default:
-				slog.Warn(ctx, "Failed to publish satoshi mods msg; blocked channel")
-			}
-
+			publish(ctx, c, msg)
 		}
 	}
 }
@@ -291,19 +304,32 @@ func handleInternalCallsMessages(
 		msgs := []*ConsumerMessage{}
 		for i, pc := range parsedContent {
 			// Attempt to parse a trade.
-			tradeStrategy, err := parser.Parse(ctx, discordproto.DiscordMoonSwingGroupChannel, pc.Content, mc, tradeengineproto.ACTOR_TYPE_INTERNAL)
+			parsed, err := parser.Parse(ctx, discordproto.DiscordMoonSwingGroupChannel, pc.Content, mc, tradeengineproto.ACTOR_TYPE_INTERNAL)
 			if err != nil {
 				// No trade can be parsed; so lets continue.
 				slog.Trace(ctx, "Failed to parse trade: %+v, content: %s", err, pc.Content)
 				return
 			}
 
+			tradeStrategy := parsed.TradeStrategy
 			now := time.Now().UTC()
 			idempotencyKey := fmt.Sprintf("tradestrategy-%s-%s-%v-%v-%v", tradeStrategy.ActorId, tradeStrategy.Asset, entriesAsString(tradeStrategy.Entries), tradeStrategy.StopLoss, now.Truncate(time.Minute))
 
 			// Sign our trade strategy with the timestamp.
 			tradeStrategy.IdempotencyKey = idempotencyKey
 
+			// If the parser isn't confident; an admin must approve the trade strategy before we create it.
+			if parsed.RequiresReview() {
+				msg, err := draftParsedTradeStrategy(ctx, c, isActive, parsed, "SCG INTERNAL CALL", pc.Content, m.Attachments)
+				if err != nil {
+					slog.Error(ctx, "Failed to draft trade strategy for review: %v, Error: %v", tradeStrategy, err)
+					continue
+				}
+
+				msgs = append(msgs, msg)
+				continue
+			}
+
 			rsp, err := createTradeStrategy(ctx, tradeStrategy)
 			if err != nil {
 				// Best effort for now.
@@ -337,11 +363,7 @@ func handleInternalCallsMessages(
 		}
 
 		for _, msg := range msgs {
-			select {
-			case c <- msg:
-			default:
-				slog.Warn(ctx, "Failed to publish satoshi swings msg; blocked channel")
-			}
+			publish(ctx, c, msg)
 		}
 	}
 }
@@ -0,0 +1,163 @@
+package consumers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/gerrors"
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.satoshi/formatter"
+	"swallowtail/s.satoshi/parser"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+var (
+	// The below are package variables so ingestion can be faked in tests.
+	parseSourceMessage        = parser.Parse
+	createSourceTradeStrategy = createTradeStrategy
+)
+
+// sourceMessage is a message consumed from a source outside of discord, e.g a webhook or a telegram channel.
+type sourceMessage struct {
+	// The ID of the consumer that consumed the message.
+	ConsumerID string
+	// The humanized name of the source; shown alongside the trade strategies parsed from it.
+	Source string
+	// The identifier of the parsers the source is mapped to.
+	Parsers string
+	// The ID of the channel within the source the message was posted to.
+	ChannelID string
+	// The ID of the message within the source.
+	MessageID string
+	// The ID & name of whoever posted the message; a caller, a channel or an alert.
+	AuthorID string
+	Author   string
+	// The content of the message itself.
+	Content string
+}
+
+// ingestSourceMessage feeds the message through the parsers its source is mapped to, the same as messages consumed from
+// discord; trade strategies parsed with confidence are created & posted to the mod trades channel, others are drafted
+// for review.
+func ingestSourceMessage(ctx context.Context, c chan *ConsumerMessage, isActive bool, msg *sourceMessage) error {
+	errParams := map[string]string{
+		"consumer_id": msg.ConsumerID,
+		"channel_id":  msg.ChannelID,
+		"message_id":  msg.MessageID,
+	}
+
+	// The parsers only know discord messages; so we dress the message up as one.
+	mc := &discordgo.MessageCreate{
+		Message: &discordgo.Message{
+			ID:        msg.MessageID,
+			ChannelID: msg.ChannelID,
+			Content:   msg.Content,
+			Author: &discordgo.User{
+				ID:       msg.AuthorID,
+				Username: msg.Author,
+			},
+		},
+	}
+
+	parsed, err := parseSourceMessage(ctx, msg.Parsers, msg.Content, mc, tradeengineproto.ACTOR_TYPE_EXTERNAL)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_ingest_source_message.parse", errParams)
+	}
+
+	if parsed == nil || parsed.TradeStrategy == nil {
+		return nil
+	}
+
+	tradeStrategy := parsed.TradeStrategy
+
+	// Sign our trade strategy with an idempotency key; derived from the message itself, so the same message is only ever
+	// created once however many times it's delivered.
+	tradeStrategy.IdempotencyKey = sourceIdempotencyKey(msg)
+
+	// If the parser isn't confident; an admin must approve the trade strategy before we create it.
+	if parsed.RequiresReview() {
+		draft, err := draftParsedTradeStrategy(ctx, c, isActive, parsed, msg.Source, msg.Content, nil)
+		if err != nil {
+			return gerrors.Augment(err, "failed_to_ingest_source_message.draft", errParams)
+		}
+
+		publish(ctx, c, draft)
+		return nil
+	}
+
+	rsp, err := createSourceTradeStrategy(ctx, tradeStrategy)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_ingest_source_message.create_trade_strategy", errParams)
+	}
+
+	tradeStrategy.TradeStrategyId = rsp.TradeStrategyId
+	tradeStrategy.Created = rsp.Created
+
+	publish(ctx, c, &ConsumerMessage{
+		ConsumerID:       msg.ConsumerID,
+		DiscordChannelID: discordproto.DiscordSatoshiModTradesChannel,
+		Message:          formatter.FormatTradeStrategy(msg.Source, tradeStrategy, msg.Content),
+		Created:          time.Now(),
+		IsActive:         isActive,
+		Metadata: map[string]string{
+			"source":            msg.Source,
+			"trade_strategy_id": tradeStrategy.TradeStrategyId,
+		},
+		Poller: func(ctx context.Context, messageID string) error {
+			// Inject the trade ID.
+			return startTradeParticipantsPoller(ctx, messageID, tradeStrategy.TradeStrategyId)
+		},
+	})
+
+	return nil
+}
+
+// sourceIdempotencyKey derives the idempotency key of a trade strategy parsed from the message; messages are unique by
+// their ID within their channel. A message without an ID falls back to its content.
+func sourceIdempotencyKey(msg *sourceMessage) string {
+	messageID := msg.MessageID
+	if messageID == "" {
+		sum := sha256.Sum256([]byte(msg.Content))
+		messageID = hex.EncodeToString(sum[:])
+	}
+
+	return fmt.Sprintf("tradestrategy-%s-%s-%s", msg.ConsumerID, msg.ChannelID, messageID)
+}
+
+// parseSourceParsers parses a mapping of source channels to the identifier of the parsers registered for them, formatted
+// as `<channel>=<parsers>` & comma separated; e.g `tradingview=source-grammar,@calls=source-heuristic`. Channels are
+// lowercased, since webhook sources & telegram usernames are matched regardless of case.
+func parseSourceParsers(mapping string) (map[string]string, error) {
+	sourceParsers := map[string]string{}
+	for _, pair := range strings.Split(mapping, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		splits := strings.SplitN(pair, "=", 2)
+		if len(splits) != 2 || strings.TrimSpace(splits[0]) == "" {
+			return nil, gerrors.BadParam("bad_param.source_parsers", map[string]string{
+				"mapping": pair,
+			})
+		}
+
+		channel, parsers := strings.ToLower(strings.TrimSpace(splits[0])), strings.TrimSpace(splits[1])
+		if !parser.IsRegistered(parsers) {
+			return nil, gerrors.FailedPrecondition("failed_to_parse_source_parsers.parsers_not_registered", map[string]string{
+				"channel": channel,
+				"parsers": parsers,
+			})
+		}
+
+		sourceParsers[channel] = parsers
+	}
+
+	return sourceParsers, nil
+}
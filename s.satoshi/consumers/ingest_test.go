@@ -0,0 +1,152 @@
+package consumers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.satoshi/parser"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// withFakeIngestion fakes the parsers with a fully confident parse of every message, & the trade engine; returning the
+// messages parsed.
+func withFakeIngestion(t *testing.T) *[]*discordgo.MessageCreate {
+	var parsed []*discordgo.MessageCreate
+
+	originalParse, originalCreate := parseSourceMessage, createSourceTradeStrategy
+	t.Cleanup(func() {
+		parseSourceMessage, createSourceTradeStrategy = originalParse, originalCreate
+	})
+
+	parseSourceMessage = func(ctx context.Context, identifier, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*parser.ParsedTradeStrategy, error) {
+		parsed = append(parsed, m)
+		return &parser.ParsedTradeStrategy{
+			TradeStrategy: &tradeengineproto.TradeStrategy{
+				ActorId:   m.Author.ID,
+				Asset:     "BTC",
+				TradeSide: tradeengineproto.TRADE_SIDE_LONG,
+				Entries:   []float32{30000},
+				StopLoss:  29000,
+			},
+			Parser:     "grammar",
+			Confidence: 1,
+		}, nil
+	}
+	createSourceTradeStrategy = func(ctx context.Context, tradeStrategy *tradeengineproto.TradeStrategy) (*tradeengineproto.CreateTradeStrategyResponse, error) {
+		return &tradeengineproto.CreateTradeStrategyResponse{
+			TradeStrategyId: "trade-strategy-id",
+		}, nil
+	}
+
+	return &parsed
+}
+
+func TestIngestSourceMessage(t *testing.T) {
+	parsed := withFakeIngestion(t)
+
+	c := make(chan *ConsumerMessage, 1)
+	err := ingestSourceMessage(context.Background(), c, true, &sourceMessage{
+		ConsumerID: webhookConsumerID,
+		Source:     "TRADINGVIEW",
+		Parsers:    parser.SourceParsersGrammar,
+		ChannelID:  "webhook-tradingview",
+		MessageID:  "alert-id",
+		AuthorID:   "webhook-tradingview-tradingview",
+		Author:     "tradingview",
+		Content:    "LONG BTC entry 30000 sl 29000",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, *parsed, 1)
+	assert.Equal(t, "webhook-tradingview-tradingview", (*parsed)[0].Author.ID)
+	assert.Equal(t, "tradingview", (*parsed)[0].Author.Username)
+
+	require.Len(t, c, 1)
+	msg := <-c
+	assert.Equal(t, webhookConsumerID, msg.ConsumerID)
+	assert.Equal(t, discordproto.DiscordSatoshiModTradesChannel, msg.DiscordChannelID)
+	assert.Equal(t, "trade-strategy-id", msg.Metadata["trade_strategy_id"])
+	assert.NotNil(t, msg.Poller)
+}
+
+func TestSourceIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	msg := func(messageID, content string) *sourceMessage {
+		return &sourceMessage{
+			ConsumerID: webhookConsumerID,
+			ChannelID:  "webhook-tradingview",
+			MessageID:  messageID,
+			Content:    content,
+		}
+	}
+
+	// Derived from the message alone; so redelivering it, at any time, yields the same key.
+	assert.Equal(t, "tradestrategy-webhook-consumer-webhook-tradingview-alert-id", sourceIdempotencyKey(msg("alert-id", "LONG BTC")))
+	assert.Equal(t, sourceIdempotencyKey(msg("", "LONG BTC")), sourceIdempotencyKey(msg("", "LONG BTC")))
+	assert.NotEqual(t, sourceIdempotencyKey(msg("", "LONG BTC")), sourceIdempotencyKey(msg("", "LONG ETH")))
+}
+
+func TestParseSourceParsers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		mapping        string
+		expectedResult map[string]string
+		expectedErr    bool
+	}{
+		{
+			name:           "empty",
+			mapping:        "",
+			expectedResult: map[string]string{},
+		},
+		{
+			name:    "multiple_channels",
+			mapping: " tradingview=source-grammar, @calls=source-heuristic,",
+			expectedResult: map[string]string{
+				"tradingview": parser.SourceParsersGrammar,
+				"@calls":      parser.SourceParsersHeuristic,
+			},
+		},
+		{
+			name:    "channels_lowercased",
+			mapping: "TradingView=source-grammar,@Calls=source-heuristic",
+			expectedResult: map[string]string{
+				"tradingview": parser.SourceParsersGrammar,
+				"@calls":      parser.SourceParsersHeuristic,
+			},
+		},
+		{
+			name:        "missing_parsers",
+			mapping:     "tradingview",
+			expectedErr: true,
+		},
+		{
+			name:        "parsers_not_registered",
+			mapping:     "tradingview=unknown",
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := parseSourceParsers(tt.mapping)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
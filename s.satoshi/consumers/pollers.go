@@ -28,3 +28,23 @@ func startTradeParticipantsPoller(ctx context.Context, messageID, tradeStrategyI
 
 	return nil
 }
+
+// RestorePoller restores the poller of a message from its destination & metadata; pollers are closures, so they don't
+// survive the message being persisted across a restart. Returns nil if the message has no poller.
+func RestorePoller(c chan *ConsumerMessage, msg *ConsumerMessage) func(ctx context.Context, messageID string) error {
+	switch {
+	case msg.DiscordChannelID == discordproto.DiscordSatoshiModTradesChannel && msg.Metadata["trade_strategy_id"] != "":
+		tradeStrategyID := msg.Metadata["trade_strategy_id"]
+		return func(ctx context.Context, messageID string) error {
+			return startTradeParticipantsPoller(ctx, messageID, tradeStrategyID)
+		}
+	case msg.DiscordChannelID == parserReviewChannelID && msg.Metadata["review_id"] != "":
+		reviewID, isActive := msg.Metadata["review_id"], msg.IsActive
+		return func(ctx context.Context, messageID string) error {
+			go pollParsedTradeReview(c, isActive, reviewID, messageID)
+			return nil
+		}
+	default:
+		return nil
+	}
+}
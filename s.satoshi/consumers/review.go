@@ -0,0 +1,326 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/emojis"
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.satoshi/dao"
+	"swallowtail/s.satoshi/domain"
+	"swallowtail/s.satoshi/formatter"
+	"swallowtail/s.satoshi/parser"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	parsedTradeReviewTimeout      = 60 * time.Minute
+	parsedTradeReviewPollInterval = 10 * time.Second
+)
+
+var (
+	// parserReviewChannelID is the admin channel low confidence parses are drafted to for review.
+	parserReviewChannelID = util.SetEnv("SATOSHI_PARSER_REVIEW_CHANNEL_ID")
+
+	// The below are package variables so the review flow can be faked in tests.
+	createParsedTradeReview = dao.CreateParsedTradeReview
+	readParsedTradeReview   = dao.ReadParsedTradeReviewByID
+	updateParsedTradeReview = dao.UpdateParsedTradeReview
+	readMessageReactions    = func(ctx context.Context, channelID, messageID string) ([]*discordproto.Reaction, error) {
+		rsp, err := (&discordproto.ReadMessageReactionsRequest{
+			ChannelId: channelID,
+			MessageId: messageID,
+		}).Send(ctx).Response()
+		if err != nil {
+			return nil, err
+		}
+
+		return rsp.GetReactions(), nil
+	}
+	createReviewedTradeStrategy = createTradeStrategy
+	readUserRoles               = func(ctx context.Context, userID string) ([]*discordproto.Role, error) {
+		rsp, err := (&discordproto.ReadUserRolesRequest{
+			UserId: userID,
+		}).Send(ctx).Response()
+		if err != nil {
+			return nil, err
+		}
+
+		return rsp.GetRoles(), nil
+	}
+)
+
+// draftParsedTradeStrategy persists a trade strategy the parser isn't confident in, returning the draft to post to the
+// review channel. The trade strategy is only created once an admin approves the draft.
+func draftParsedTradeStrategy(
+	ctx context.Context,
+	c chan *ConsumerMessage,
+	isActive bool,
+	parsed *parser.ParsedTradeStrategy,
+	source, content string,
+	attachments []*discordgo.MessageAttachment,
+) (*ConsumerMessage, error) {
+	errParams := map[string]string{
+		"parser": parsed.Parser,
+		"source": source,
+	}
+
+	if parserReviewChannelID == "" {
+		return nil, gerrors.FailedPrecondition("failed_to_draft_parsed_trade_strategy.no_review_channel", errParams)
+	}
+
+	tradeStrategy, err := json.Marshal(parsed.TradeStrategy)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_draft_parsed_trade_strategy.marshal", errParams)
+	}
+
+	review, err := createParsedTradeReview(ctx, &domain.ParsedTradeReview{
+		Parser:         parsed.Parser,
+		Confidence:     parsed.Confidence,
+		InferredFields: parsed.InferredFields,
+		Source:         source,
+		Content:        content,
+		TradeStrategy:  tradeStrategy,
+		Status:         domain.ParsedTradeReviewStatusPending,
+	})
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_draft_parsed_trade_strategy", errParams)
+	}
+
+	return &ConsumerMessage{
+		ConsumerID:       discordConsumerID,
+		DiscordChannelID: parserReviewChannelID,
+		Message:          formatter.FormatParsedTradeReview(review, parsed.TradeStrategy),
+		Created:          time.Now(),
+		Attachments:      attachments,
+		IsActive:         isActive,
+		Metadata: map[string]string{
+			"review_id": review.ReviewID,
+		},
+		Poller: func(ctx context.Context, messageID string) error {
+			go pollParsedTradeReview(c, isActive, review.ReviewID, messageID)
+			return nil
+		},
+	}, nil
+}
+
+// pollParsedTradeReview polls the draft for an admin's reaction until the review times out.
+func pollParsedTradeReview(c chan *ConsumerMessage, isActive bool, reviewID, messageID string) {
+	// The parent context is cancelled once the draft is posted; so we create our own.
+	ctx, cancel := context.WithTimeout(context.Background(), parsedTradeReviewTimeout)
+	defer cancel()
+
+	t := time.NewTicker(parsedTradeReviewPollInterval)
+	defer t.Stop()
+
+	var editRequested bool
+	for {
+		select {
+		case <-t.C:
+			done, err := reviewParsedTrade(ctx, c, isActive, reviewID, messageID, &editRequested)
+			if err != nil {
+				slog.Error(ctx, "Failed to review parsed trade strategy: %s, Error: %v", reviewID, err)
+				continue
+			}
+
+			if done {
+				return
+			}
+		case <-ctx.Done():
+			if err := expireParsedTradeReview(context.Background(), reviewID); err != nil {
+				slog.Error(context.Background(), "Failed to expire parsed trade strategy review: %s, Error: %v", reviewID, err)
+			}
+			return
+		}
+	}
+}
+
+// reviewParsedTrade acts on the admin reactions to the draft; returning true once the review has an outcome. Reactions
+// from anyone without the admin role are ignored, as with the admin only commands.
+func reviewParsedTrade(
+	ctx context.Context,
+	c chan *ConsumerMessage,
+	isActive bool,
+	reviewID, messageID string,
+	editRequested *bool,
+) (bool, error) {
+	reactions, err := readMessageReactions(ctx, parserReviewChannelID, messageID)
+	if err != nil {
+		return false, gerrors.Augment(err, "failed_to_read_parsed_trade_review_reactions", nil)
+	}
+
+	var (
+		approverID, editorID, rejecterID string
+		admins                           = map[string]bool{}
+	)
+	for _, reaction := range reactions {
+		switch reaction.GetReactionId() {
+		case emojis.ApproveEmoji, emojis.EditEmoji, emojis.RejectEmoji:
+		default:
+			continue
+		}
+
+		adminID, err := firstAdmin(ctx, reaction.GetUserIds(), admins)
+		if err != nil {
+			return false, gerrors.Augment(err, "failed_to_read_parsed_trade_reviewer_roles", nil)
+		}
+		if adminID == "" {
+			continue
+		}
+
+		switch reaction.GetReactionId() {
+		case emojis.ApproveEmoji:
+			approverID = adminID
+		case emojis.EditEmoji:
+			editorID = adminID
+		case emojis.RejectEmoji:
+			rejecterID = adminID
+		}
+	}
+
+	review, err := readParsedTradeReview(ctx, reviewID)
+	if err != nil {
+		return false, gerrors.Augment(err, "failed_to_read_parsed_trade_review", nil)
+	}
+
+	switch {
+	case review.Status != domain.ParsedTradeReviewStatusPending:
+		return true, nil
+	case rejecterID != "":
+		// If admins disagree; we err on the side of not trading.
+		review.Status = domain.ParsedTradeReviewStatusRejected
+		review.ReviewerID = rejecterID
+		if err := updateParsedTradeReview(ctx, review); err != nil {
+			return false, gerrors.Augment(err, "failed_to_reject_parsed_trade_review", nil)
+		}
+
+		return true, nil
+	case approverID != "":
+		if err := approveParsedTradeReview(ctx, c, isActive, review, approverID); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	case editorID != "" && !*editRequested:
+		*editRequested = true
+		publish(ctx, c, &ConsumerMessage{
+			ConsumerID:       discordConsumerID,
+			DiscordChannelID: parserReviewChannelID,
+			Message:          formatter.FormatParsedTradeReviewEditRequest(review.ReviewID, editorID),
+			Created:          time.Now(),
+			IsActive:         isActive,
+		})
+	}
+
+	return false, nil
+}
+
+// firstAdmin returns the first of the given users with the admin role; empty if none of them are admins. Roles already read
+// are cached in the given map, keyed by user id.
+func firstAdmin(ctx context.Context, userIDs []string, admins map[string]bool) (string, error) {
+	for _, userID := range userIDs {
+		isAdmin, ok := admins[userID]
+		if !ok {
+			roles, err := readUserRoles(ctx, userID)
+			if err != nil {
+				return "", gerrors.Augment(err, "failed_to_read_user_roles", map[string]string{
+					"user_id": userID,
+				})
+			}
+
+			for _, role := range roles {
+				if role.GetRoleId() == discordproto.DiscordSatoshiAdminRoleID {
+					isAdmin = true
+					break
+				}
+			}
+			admins[userID] = isAdmin
+		}
+
+		if isAdmin {
+			return userID, nil
+		}
+	}
+
+	return "", nil
+}
+
+// approveParsedTradeReview creates the trade strategy of the draft, including any edits, & publishes it to the mod
+// trades channel for participants.
+func approveParsedTradeReview(ctx context.Context, c chan *ConsumerMessage, isActive bool, review *domain.ParsedTradeReview, approverID string) error {
+	errParams := map[string]string{
+		"review_id":   review.ReviewID,
+		"approver_id": approverID,
+	}
+
+	tradeStrategy := &tradeengineproto.TradeStrategy{}
+	if err := json.Unmarshal(review.TradeStrategy, tradeStrategy); err != nil {
+		return gerrors.Augment(err, "failed_to_approve_parsed_trade_review.unmarshal", errParams)
+	}
+
+	rsp, err := createReviewedTradeStrategy(ctx, tradeStrategy)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_approve_parsed_trade_review.create_trade_strategy", errParams)
+	}
+
+	review.Status = domain.ParsedTradeReviewStatusApproved
+	review.ReviewerID = approverID
+	review.TradeStrategyID = rsp.TradeStrategyId
+	if err := updateParsedTradeReview(ctx, review); err != nil {
+		// The trade strategy exists regardless; so we still publish it.
+		slog.Error(ctx, "Failed to record approval of parsed trade strategy review: %s, Error: %v", review.ReviewID, err)
+	}
+
+	tradeStrategy.TradeStrategyId = rsp.TradeStrategyId
+	tradeStrategy.Created = rsp.Created
+
+	publish(ctx, c, &ConsumerMessage{
+		ConsumerID:       discordConsumerID,
+		DiscordChannelID: discordproto.DiscordSatoshiModTradesChannel,
+		Message:          formatter.FormatTradeStrategy(review.Source, tradeStrategy, review.Content),
+		Created:          time.Now(),
+		IsActive:         isActive,
+		Metadata: map[string]string{
+			"review_id":         review.ReviewID,
+			"trade_strategy_id": tradeStrategy.TradeStrategyId,
+		},
+		Poller: func(ctx context.Context, messageID string) error {
+			return startTradeParticipantsPoller(ctx, messageID, tradeStrategy.TradeStrategyId)
+		},
+	})
+
+	return nil
+}
+
+func expireParsedTradeReview(ctx context.Context, reviewID string) error {
+	review, err := readParsedTradeReview(ctx, reviewID)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_expire_parsed_trade_review", nil)
+	}
+
+	if review.Status != domain.ParsedTradeReviewStatusPending {
+		return nil
+	}
+
+	review.Status = domain.ParsedTradeReviewStatusExpired
+	if err := updateParsedTradeReview(ctx, review); err != nil {
+		return gerrors.Augment(err, "failed_to_expire_parsed_trade_review", nil)
+	}
+
+	return nil
+}
+
+func publish(ctx context.Context, c chan<- *ConsumerMessage, msg *ConsumerMessage) {
+	// Satoshi drains the channel into its outbox; so rather than drop the message we wait for room.
+	select {
+	case c <- msg:
+	case <-ctx.Done():
+		slog.Warn(ctx, "Failed to publish satoshi msg; context done: %+v", msg.Metadata)
+	}
+}
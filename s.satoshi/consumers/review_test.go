@@ -0,0 +1,198 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/emojis"
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.satoshi/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// withFakeParsedTradeReview fakes the review store with a single review & the given reactions to its draft; returning
+// the trade strategies created.
+func withFakeParsedTradeReview(t *testing.T, review *domain.ParsedTradeReview, reactions []*discordproto.Reaction) *[]*tradeengineproto.TradeStrategy {
+	var created []*tradeengineproto.TradeStrategy
+
+	originalChannelID, originalRead, originalUpdate, originalReactions, originalCreate, originalRoles :=
+		parserReviewChannelID, readParsedTradeReview, updateParsedTradeReview, readMessageReactions, createReviewedTradeStrategy, readUserRoles
+	t.Cleanup(func() {
+		parserReviewChannelID, readParsedTradeReview, updateParsedTradeReview, readMessageReactions, createReviewedTradeStrategy, readUserRoles =
+			originalChannelID, originalRead, originalUpdate, originalReactions, originalCreate, originalRoles
+	})
+
+	parserReviewChannelID = "review-channel-id"
+	readParsedTradeReview = func(ctx context.Context, reviewID string) (*domain.ParsedTradeReview, error) {
+		return review, nil
+	}
+	updateParsedTradeReview = func(ctx context.Context, r *domain.ParsedTradeReview) error {
+		*review = *r
+		return nil
+	}
+	readMessageReactions = func(ctx context.Context, channelID, messageID string) ([]*discordproto.Reaction, error) {
+		return reactions, nil
+	}
+	readUserRoles = func(ctx context.Context, userID string) ([]*discordproto.Role, error) {
+		if !strings.HasSuffix(userID, "admin-id") {
+			return nil, nil
+		}
+
+		return []*discordproto.Role{
+			{RoleId: discordproto.DiscordSatoshiAdminRoleID, RoleName: discordproto.DiscordSatoshiAdminRole},
+		}, nil
+	}
+	createReviewedTradeStrategy = func(ctx context.Context, tradeStrategy *tradeengineproto.TradeStrategy) (*tradeengineproto.CreateTradeStrategyResponse, error) {
+		created = append(created, tradeStrategy)
+		return &tradeengineproto.CreateTradeStrategyResponse{
+			TradeStrategyId: "trade-strategy-id",
+		}, nil
+	}
+
+	return &created
+}
+
+func testParsedTradeReview(t *testing.T) *domain.ParsedTradeReview {
+	tradeStrategy, err := json.Marshal(&tradeengineproto.TradeStrategy{
+		Asset:     "BTC",
+		TradeSide: tradeengineproto.TRADE_SIDE_LONG,
+		Entries:   []float32{30000},
+		StopLoss:  29000,
+	})
+	require.NoError(t, err)
+
+	return &domain.ParsedTradeReview{
+		ReviewID:      "review-id",
+		Parser:        "dma",
+		Source:        "WWG",
+		TradeStrategy: tradeStrategy,
+		Status:        domain.ParsedTradeReviewStatusPending,
+	}
+}
+
+func TestReviewParsedTrade(t *testing.T) {
+	tests := []struct {
+		name                  string
+		status                string
+		reactions             []*discordproto.Reaction
+		expectedDone          bool
+		expectedStatus        string
+		expectedReviewerID    string
+		expectedChannelIDs    []string
+		expectedTradeStrategy bool
+	}{
+		{
+			name:           "no_reactions",
+			status:         domain.ParsedTradeReviewStatusPending,
+			expectedStatus: domain.ParsedTradeReviewStatusPending,
+		},
+		{
+			name:   "approved",
+			status: domain.ParsedTradeReviewStatusPending,
+			reactions: []*discordproto.Reaction{
+				{ReactionId: emojis.ApproveEmoji, UserIds: []string{"admin-id"}},
+			},
+			expectedDone:          true,
+			expectedStatus:        domain.ParsedTradeReviewStatusApproved,
+			expectedReviewerID:    "admin-id",
+			expectedChannelIDs:    []string{discordproto.DiscordSatoshiModTradesChannel},
+			expectedTradeStrategy: true,
+		},
+		{
+			name:   "rejection_beats_approval",
+			status: domain.ParsedTradeReviewStatusPending,
+			reactions: []*discordproto.Reaction{
+				{ReactionId: emojis.ApproveEmoji, UserIds: []string{"admin-id"}},
+				{ReactionId: emojis.RejectEmoji, UserIds: []string{"other-admin-id"}},
+			},
+			expectedDone:       true,
+			expectedStatus:     domain.ParsedTradeReviewStatusRejected,
+			expectedReviewerID: "other-admin-id",
+		},
+		{
+			name:   "non_admin_reactions_ignored",
+			status: domain.ParsedTradeReviewStatusPending,
+			reactions: []*discordproto.Reaction{
+				{ReactionId: emojis.ApproveEmoji, UserIds: []string{"member-id"}},
+				{ReactionId: emojis.EditEmoji, UserIds: []string{"member-id"}},
+			},
+			expectedStatus: domain.ParsedTradeReviewStatusPending,
+		},
+		{
+			name:   "admin_approval_after_non_admin",
+			status: domain.ParsedTradeReviewStatusPending,
+			reactions: []*discordproto.Reaction{
+				{ReactionId: emojis.RejectEmoji, UserIds: []string{"member-id"}},
+				{ReactionId: emojis.ApproveEmoji, UserIds: []string{"member-id", "admin-id"}},
+			},
+			expectedDone:          true,
+			expectedStatus:        domain.ParsedTradeReviewStatusApproved,
+			expectedReviewerID:    "admin-id",
+			expectedChannelIDs:    []string{discordproto.DiscordSatoshiModTradesChannel},
+			expectedTradeStrategy: true,
+		},
+		{
+			name:   "edit_requested",
+			status: domain.ParsedTradeReviewStatusPending,
+			reactions: []*discordproto.Reaction{
+				{ReactionId: emojis.EditEmoji, UserIds: []string{"admin-id"}},
+			},
+			expectedStatus:     domain.ParsedTradeReviewStatusPending,
+			expectedChannelIDs: []string{"review-channel-id"},
+		},
+		{
+			name:   "already_reviewed",
+			status: domain.ParsedTradeReviewStatusExpired,
+			reactions: []*discordproto.Reaction{
+				{ReactionId: emojis.ApproveEmoji, UserIds: []string{"admin-id"}},
+			},
+			expectedDone:   true,
+			expectedStatus: domain.ParsedTradeReviewStatusExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			review := testParsedTradeReview(t)
+			review.Status = tt.status
+			created := withFakeParsedTradeReview(t, review, tt.reactions)
+
+			c := make(chan *ConsumerMessage, 4)
+
+			// We poll twice; the edit request should only be published once.
+			var editRequested bool
+			done, err := reviewParsedTrade(context.Background(), c, true, review.ReviewID, "message-id", &editRequested)
+			require.NoError(t, err)
+			if !done {
+				done, err = reviewParsedTrade(context.Background(), c, true, review.ReviewID, "message-id", &editRequested)
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.expectedDone, done)
+			assert.Equal(t, tt.expectedStatus, review.Status)
+			assert.Equal(t, tt.expectedReviewerID, review.ReviewerID)
+
+			close(c)
+			var channelIDs []string
+			for msg := range c {
+				channelIDs = append(channelIDs, msg.DiscordChannelID)
+			}
+			assert.Equal(t, tt.expectedChannelIDs, channelIDs)
+
+			if !tt.expectedTradeStrategy {
+				assert.Empty(t, *created)
+				return
+			}
+
+			require.Len(t, *created, 1)
+			assert.Equal(t, float32(29000), (*created)[0].StopLoss)
+			assert.Equal(t, "trade-strategy-id", review.TradeStrategyID)
+		})
+	}
+}
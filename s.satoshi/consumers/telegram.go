@@ -0,0 +1,239 @@
+package consumers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+)
+
+const (
+	telegramConsumerID = "telegram-consumer"
+	telegramUpdatesUrl = "https://api.telegram.org/bot%s/getUpdates"
+
+	// telegramLongPollTimeout is how long telegram holds a request open waiting for updates.
+	telegramLongPollTimeout = 30 * time.Second
+	telegramRetryInterval   = 10 * time.Second
+)
+
+var (
+	telegramBotToken       string
+	telegramChannelParsers string
+
+	// For ease of mocking purposes.
+	fetchTelegramUpdates = getTelegramUpdates
+)
+
+func init() {
+	telegramBotToken = util.SetEnv("SATOSHI_TELEGRAM_BOT_TOKEN")
+	telegramChannelParsers = util.SetEnv("SATOSHI_TELEGRAM_CHANNELS")
+	register(telegramConsumerID, TelegramConsumer{
+		Active: true,
+	})
+}
+
+// TelegramConsumer consumes the posts of the telegram channels & groups the satoshi bot is a member of; only channels
+// mapped to parsers are ingested.
+type TelegramConsumer struct {
+	Active bool
+}
+
+type telegramUpdate struct {
+	UpdateID    int64            `json:"update_id"`
+	Message     *telegramMessage `json:"message"`
+	ChannelPost *telegramMessage `json:"channel_post"`
+}
+
+type telegramMessage struct {
+	MessageID       int64         `json:"message_id"`
+	Chat            *telegramChat `json:"chat"`
+	From            *telegramUser `json:"from"`
+	AuthorSignature string        `json:"author_signature"`
+	Text            string        `json:"text"`
+	Caption         string        `json:"caption"`
+}
+
+type telegramChat struct {
+	ID       int64  `json:"id"`
+	Title    string `json:"title"`
+	Username string `json:"username"`
+}
+
+type telegramUser struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	FirstName string `json:"first_name"`
+}
+
+type telegramUpdatesResponse struct {
+	OK          bool              `json:"ok"`
+	Description string            `json:"description"`
+	Result      []*telegramUpdate `json:"result"`
+}
+
+func (tc TelegramConsumer) Receiver(ctx context.Context, c chan *ConsumerMessage, d chan struct{}, _ bool) {
+	if telegramBotToken == "" {
+		slog.Warn(ctx, "Not starting telegram consumer; no bot token configured")
+		return
+	}
+
+	channelParsers, err := parseSourceParsers(telegramChannelParsers)
+	if err != nil {
+		slog.Error(ctx, "Not starting telegram consumer; invalid channel parsers: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-d:
+			slog.Warn(ctx, "Telegram consumer stop signal received.")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// Telegram keeps unconfirmed updates for a day; the offset confirms every update before it.
+	var offset int64
+	for {
+		updates, err := fetchTelegramUpdates(ctx, telegramBotToken, offset)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			slog.Error(ctx, "Failed to fetch telegram updates: %v", err)
+
+			select {
+			case <-time.After(telegramRetryInterval):
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+
+		for _, update := range updates {
+			if update.UpdateID >= offset {
+				offset = update.UpdateID + 1
+			}
+
+			msg, ok := telegramSourceMessage(update, channelParsers)
+			if !ok {
+				continue
+			}
+
+			if err := ingestSourceMessage(ctx, c, tc.Active, msg); err != nil {
+				slog.Trace(ctx, "Failed to ingest telegram message: %+v, content: %s", err, msg.Content)
+			}
+		}
+	}
+}
+
+func (tc TelegramConsumer) IsActive() bool {
+	return tc.Active
+}
+
+// telegramSourceMessage returns the message of the update to ingest; if it was posted to a channel mapped to parsers.
+// Channels are mapped either by their ID or their public `@username`.
+func telegramSourceMessage(update *telegramUpdate, channelParsers map[string]string) (*sourceMessage, bool) {
+	m := update.ChannelPost
+	if m == nil {
+		m = update.Message
+	}
+
+	if m == nil || m.Chat == nil {
+		return nil, false
+	}
+
+	chatID := strconv.FormatInt(m.Chat.ID, 10)
+	parsers, ok := channelParsers[chatID]
+	if !ok && m.Chat.Username != "" {
+		parsers, ok = channelParsers["@"+strings.ToLower(m.Chat.Username)]
+	}
+	if !ok {
+		return nil, false
+	}
+
+	// Images are posted with their text as the caption.
+	content := m.Text
+	if content == "" {
+		content = m.Caption
+	}
+	if content == "" {
+		return nil, false
+	}
+
+	// Channel posts are made on behalf of the channel; so the caller is the channel unless the post is signed.
+	authorID, author := chatID, m.Chat.Title
+	switch {
+	case m.From != nil:
+		authorID = strconv.FormatInt(m.From.ID, 10)
+		author = m.From.Username
+		if author == "" {
+			author = m.From.FirstName
+		}
+	case m.AuthorSignature != "":
+		author = m.AuthorSignature
+	}
+
+	return &sourceMessage{
+		ConsumerID: telegramConsumerID,
+		Source:     fmt.Sprintf("TELEGRAM %s", m.Chat.Title),
+		Parsers:    parsers,
+		ChannelID:  chatID,
+		MessageID:  strconv.FormatInt(m.MessageID, 10),
+		AuthorID:   fmt.Sprintf("telegram-%s", authorID),
+		Author:     author,
+		Content:    content,
+	}, true
+}
+
+// getTelegramUpdates long polls the telegram bot API for updates from the given offset.
+func getTelegramUpdates(ctx context.Context, token string, offset int64) ([]*telegramUpdate, error) {
+	params := url.Values{}
+	params.Set("offset", strconv.FormatInt(offset, 10))
+	params.Set("timeout", strconv.Itoa(int(telegramLongPollTimeout.Seconds())))
+	params.Set("allowed_updates", `["message","channel_post"]`)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(telegramUpdatesUrl, token)+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_get_telegram_updates.build_request", nil)
+	}
+
+	cli := &http.Client{
+		Timeout: telegramLongPollTimeout + 10*time.Second,
+	}
+
+	rsp, err := cli.Do(req)
+	if err != nil {
+		// The token is part of the URL; so we don't wrap the error as is.
+		return nil, gerrors.FailedPrecondition("failed_to_get_telegram_updates.request", nil)
+	}
+	defer rsp.Body.Close()
+
+	var updates telegramUpdatesResponse
+	if err := json.NewDecoder(rsp.Body).Decode(&updates); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_get_telegram_updates.decode", map[string]string{
+			"status_code": strconv.Itoa(rsp.StatusCode),
+		})
+	}
+
+	if !updates.OK {
+		return nil, gerrors.FailedPrecondition("failed_to_get_telegram_updates.not_ok", map[string]string{
+			"status_code": strconv.Itoa(rsp.StatusCode),
+			"description": updates.Description,
+		})
+	}
+
+	return updates.Result, nil
+}
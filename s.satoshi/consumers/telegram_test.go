@@ -0,0 +1,117 @@
+package consumers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.satoshi/parser"
+)
+
+func TestTelegramSourceMessage(t *testing.T) {
+	t.Parallel()
+
+	channelParsers := map[string]string{
+		"-1001":  parser.SourceParsersHeuristic,
+		"@calls": parser.SourceParsersGrammar,
+	}
+
+	tests := []struct {
+		name             string
+		update           *telegramUpdate
+		expectedIngested bool
+		expectedParsers  string
+		expectedAuthorID string
+		expectedAuthor   string
+		expectedContent  string
+	}{
+		{
+			name: "channel_post_mapped_by_id",
+			update: &telegramUpdate{
+				ChannelPost: &telegramMessage{
+					MessageID: 1,
+					Chat:      &telegramChat{ID: -1001, Title: "Signals"},
+					Text:      "BTC long 30000 sl 29000",
+				},
+			},
+			expectedIngested: true,
+			expectedParsers:  parser.SourceParsersHeuristic,
+			expectedAuthorID: "telegram--1001",
+			expectedAuthor:   "Signals",
+			expectedContent:  "BTC long 30000 sl 29000",
+		},
+		{
+			name: "signed_channel_post_mapped_by_username_with_caption",
+			update: &telegramUpdate{
+				ChannelPost: &telegramMessage{
+					MessageID:       2,
+					Chat:            &telegramChat{ID: -1002, Title: "Calls", Username: "calls"},
+					AuthorSignature: "Eli",
+					Caption:         "LONG BTC entry 30000 sl 29000",
+				},
+			},
+			expectedIngested: true,
+			expectedParsers:  parser.SourceParsersGrammar,
+			expectedAuthorID: "telegram--1002",
+			expectedAuthor:   "Eli",
+			expectedContent:  "LONG BTC entry 30000 sl 29000",
+		},
+		{
+			name: "group_message",
+			update: &telegramUpdate{
+				Message: &telegramMessage{
+					MessageID: 3,
+					Chat:      &telegramChat{ID: -1001, Title: "Signals"},
+					From:      &telegramUser{ID: 42, Username: "eli"},
+					Text:      "BTC long 30000 sl 29000",
+				},
+			},
+			expectedIngested: true,
+			expectedParsers:  parser.SourceParsersHeuristic,
+			expectedAuthorID: "telegram-42",
+			expectedAuthor:   "eli",
+			expectedContent:  "BTC long 30000 sl 29000",
+		},
+		{
+			name: "unmapped_channel",
+			update: &telegramUpdate{
+				ChannelPost: &telegramMessage{
+					Chat: &telegramChat{ID: -1003, Title: "Other"},
+					Text: "BTC long 30000 sl 29000",
+				},
+			},
+		},
+		{
+			name: "without_content",
+			update: &telegramUpdate{
+				ChannelPost: &telegramMessage{
+					Chat: &telegramChat{ID: -1001, Title: "Signals"},
+				},
+			},
+		},
+		{
+			name:   "neither_message_nor_post",
+			update: &telegramUpdate{UpdateID: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			msg, ok := telegramSourceMessage(tt.update, channelParsers)
+			require.Equal(t, tt.expectedIngested, ok)
+			if !ok {
+				return
+			}
+
+			assert.Equal(t, telegramConsumerID, msg.ConsumerID)
+			assert.Equal(t, tt.expectedParsers, msg.Parsers)
+			assert.Equal(t, tt.expectedAuthorID, msg.AuthorID)
+			assert.Equal(t, tt.expectedAuthor, msg.Author)
+			assert.Equal(t, tt.expectedContent, msg.Content)
+		})
+	}
+}
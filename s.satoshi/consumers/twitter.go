@@ -106,12 +106,8 @@ func postTweetToDiscordHandler(ctx context.Context, c chan<- *ConsumerMessage, i
 				"tweet_timestamp": tweet.CreatedAt,
 			},
 		}
-		select {
-		case c <- msg:
-		case <-ctx.Done():
-		default:
-			slog.Warn(ctx, "Dropping twitter msg; satoshi consumer channel blocked: %v", msg)
-		}
+
+		publish(ctx, c, msg)
 	}
 }
 
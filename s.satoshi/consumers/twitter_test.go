@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	discordproto "swallowtail/s.discord/proto"
 
 	"github.com/dghubble/go-twitter/twitter"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 func TestFormatTweetForDiscord(t *testing.T) {
@@ -84,18 +84,30 @@ func TestPostTweetToDiscordHandler(t *testing.T) {
 				ch  = make(chan *ConsumerMessage, 1)
 			)
 			handler := postTweetToDiscordHandler(ctx, ch, tt.isActive)
-			// Post twice; we shouldn't block.
-			handler(tt.tweet)
 			handler(tt.tweet)
 
-			require.Len(t, ch, 1)
-			e := <-ch
-			require.NotNil(t, e)
+			// Post again whilst the channel is full; rather than being dropped, the tweet waits for room.
+			published := make(chan struct{})
+			go func() {
+				handler(tt.tweet)
+				close(published)
+			}()
+
+			for i := 0; i < 2; i++ {
+				var e *ConsumerMessage
+				select {
+				case e = <-ch:
+				case <-time.After(time.Second):
+					t.Fatal("Expected the tweet to be published")
+				}
+
+				assert.Equal(t, e.DiscordChannelID, discordproto.DiscordSatoshiTestingChannel)
+				assert.Equal(t, e.IsActive, tt.isActive)
+				assert.Equal(t, fmt.Sprintf("%s-%s", tt.tweet.User.ScreenName, tt.tweet.CreatedAt), e.IdempotencyKey)
+				assert.Equal(t, tt.expectedMessage, e.Message)
+			}
 
-			assert.Equal(t, e.DiscordChannelID, discordproto.DiscordSatoshiTestingChannel)
-			assert.Equal(t, e.IsActive, tt.isActive)
-			assert.Equal(t, fmt.Sprintf("%s-%s", tt.tweet.User.ScreenName, tt.tweet.CreatedAt), e.IdempotencyKey)
-			assert.Equal(t, tt.expectedMessage, e.Message)
+			<-published
 		})
 	}
 }
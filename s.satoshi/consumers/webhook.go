@@ -0,0 +1,262 @@
+package consumers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+)
+
+const (
+	webhookConsumerID = "webhook-consumer"
+	webhookPathPrefix = "/webhooks/"
+
+	webhookSignatureHeader = "X-Swallowtail-Signature"
+	webhookTimestampHeader = "X-Swallowtail-Timestamp"
+	webhookSignaturePrefix = "sha256="
+
+	// webhookSignatureTolerance is how old a signed payload can be before it's rejected; so captured payloads can't be
+	// replayed later on.
+	webhookSignatureTolerance = 5 * time.Minute
+	webhookMaxPayloadBytes    = 64 * 1024
+	webhookDefaultPort        = "8080"
+)
+
+var (
+	webhookSecret        string
+	webhookPort          string
+	webhookSourceParsers string
+)
+
+func init() {
+	webhookSecret = util.SetEnv("SATOSHI_WEBHOOK_SECRET")
+	webhookPort = util.SetEnv("SATOSHI_WEBHOOK_PORT")
+	webhookSourceParsers = util.SetEnv("SATOSHI_WEBHOOK_SOURCES")
+	register(webhookConsumerID, WebhookConsumer{
+		Active: true,
+	})
+}
+
+// WebhookConsumer consumes HMAC signed payloads posted to `/webhooks/<source>`, e.g from TradingView alerts.
+type WebhookConsumer struct {
+	Active bool
+}
+
+// WebhookPayload is the payload posted to the webhook.
+type WebhookPayload struct {
+	// An optional ID of the alert or message; it must be unique to each payload, since trade strategies are deduplicated by
+	// it. Without one, payloads are deduplicated by their signature.
+	ID string `json:"id"`
+	// The name of whoever is posting; defaults to the source.
+	Author string `json:"author"`
+	// The content to parse; for TradingView alerts this is the alert message.
+	Content string `json:"content"`
+}
+
+func (wc WebhookConsumer) Receiver(ctx context.Context, c chan *ConsumerMessage, d chan struct{}, _ bool) {
+	// Unsigned payloads would let anyone create trade strategies; so we refuse to start without a secret.
+	if webhookSecret == "" {
+		slog.Warn(ctx, "Not starting webhook consumer; no secret configured")
+		return
+	}
+
+	sourceParsers, err := parseSourceParsers(webhookSourceParsers)
+	if err != nil {
+		slog.Error(ctx, "Not starting webhook consumer; invalid source parsers: %v", err)
+		return
+	}
+
+	port := webhookPort
+	if port == "" {
+		port = webhookDefaultPort
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(webhookPathPrefix, handleWebhook(ctx, c, wc.Active, []byte(webhookSecret), sourceParsers))
+
+	srv := &http.Server{
+		Addr:    ":" + port,
+		Handler: mux,
+	}
+
+	go func() {
+		slog.Info(ctx, "Webhook consumer listening on :%s", port)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			slog.Error(ctx, "Webhook consumer stopped: %v", err)
+		}
+	}()
+
+	defer slog.Warn(ctx, "Webhook consumer stop signal received.")
+	defer srv.Close()
+
+	select {
+	case <-d:
+	case <-ctx.Done():
+	}
+}
+
+func (wc WebhookConsumer) IsActive() bool {
+	return wc.Active
+}
+
+func handleWebhook(
+	ctx context.Context, c chan *ConsumerMessage, isActive bool, secret []byte, sourceParsers map[string]string,
+) http.HandlerFunc {
+	replays := newWebhookReplays()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		source := strings.ToLower(strings.TrimPrefix(r.URL.Path, webhookPathPrefix))
+		parsers, ok := sourceParsers[source]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, webhookMaxPayloadBytes))
+		if err != nil {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		now, timestamp := time.Now(), r.Header.Get(webhookTimestampHeader)
+		if err := verifyWebhookSignature(secret, body, timestamp, r.Header.Get(webhookSignatureHeader), now); err != nil {
+			slog.Warn(ctx, "Rejecting webhook payload from %s: %v", source, err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		// The signature is verified; so we key on the one we'd sign ourselves, rather than however the sender encoded it.
+		signature := hex.EncodeToString(signWebhookPayload(secret, body, timestamp))
+		if !replays.claim(signature, now) {
+			slog.Warn(ctx, "Rejecting replayed webhook payload from %s: %s", source, timestamp)
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		var payload WebhookPayload
+		if err := json.Unmarshal(body, &payload); err != nil || strings.TrimSpace(payload.Content) == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		author := payload.Author
+		if author == "" {
+			author = source
+		}
+
+		messageID := payload.ID
+		if messageID == "" {
+			messageID = signature
+		}
+
+		msg := &sourceMessage{
+			ConsumerID: webhookConsumerID,
+			Source:     strings.ToUpper(source),
+			Parsers:    parsers,
+			ChannelID:  fmt.Sprintf("webhook-%s", source),
+			MessageID:  messageID,
+			AuthorID:   fmt.Sprintf("webhook-%s-%s", source, strings.ToLower(author)),
+			Author:     author,
+			Content:    payload.Content,
+		}
+
+		// Parsing calls out to other services; so we acknowledge the payload straight away rather than have the sender
+		// time out.
+		go func() {
+			if err := ingestSourceMessage(ctx, c, isActive, msg); err != nil {
+				slog.Trace(ctx, "Failed to ingest webhook payload: %+v, content: %s", err, msg.Content)
+			}
+		}()
+
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// webhookReplays remembers the signatures of the payloads accepted whilst their timestamp is within tolerance; so a
+// captured payload can't be replayed within the tolerance either. Outside of it the timestamp is rejected regardless.
+type webhookReplays struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+func newWebhookReplays() *webhookReplays {
+	return &webhookReplays{
+		seen: map[string]time.Time{},
+	}
+}
+
+// claim returns true if the signature hasn't been seen within the tolerance window, remembering it until the window has
+// passed; timestamps are accepted either side of now, so a signature is remembered for twice the tolerance.
+func (r *webhookReplays) claim(signature string, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for s, expiry := range r.seen {
+		if !expiry.After(now) {
+			delete(r.seen, s)
+		}
+	}
+
+	if _, ok := r.seen[signature]; ok {
+		return false
+	}
+
+	r.seen[signature] = now.Add(2 * webhookSignatureTolerance)
+	return true
+}
+
+// verifyWebhookSignature verifies the payload was signed with the secret; the signature is the hex encoded HMAC-SHA256 of
+// `<timestamp>.<payload>`, where the timestamp is in unix seconds & must be recent.
+func verifyWebhookSignature(secret, payload []byte, timestamp, signature string, now time.Time) error {
+	if timestamp == "" || signature == "" {
+		return gerrors.Unauthenticated("invalid_webhook_signature.missing", nil)
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return gerrors.Unauthenticated("invalid_webhook_signature.bad_timestamp", nil)
+	}
+
+	if age := now.Sub(time.Unix(ts, 0)); age > webhookSignatureTolerance || age < -webhookSignatureTolerance {
+		return gerrors.Unauthenticated("invalid_webhook_signature.timestamp_outside_tolerance", map[string]string{
+			"timestamp": timestamp,
+		})
+	}
+
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, webhookSignaturePrefix))
+	if err != nil {
+		return gerrors.Unauthenticated("invalid_webhook_signature.bad_encoding", nil)
+	}
+
+	if !hmac.Equal(expected, signWebhookPayload(secret, payload, timestamp)) {
+		return gerrors.Unauthenticated("invalid_webhook_signature.mismatch", nil)
+	}
+
+	return nil
+}
+
+func signWebhookPayload(secret, payload []byte, timestamp string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,237 @@
+package consumers
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.satoshi/parser"
+)
+
+var testWebhookSecret = []byte("test-webhook-secret")
+
+func testWebhookSignature(payload []byte, timestamp string) string {
+	return webhookSignaturePrefix + hex.EncodeToString(signWebhookPayload(testWebhookSecret, payload, timestamp))
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now       = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		timestamp = strconv.FormatInt(now.Unix(), 10)
+		payload   = []byte(`{"content":"LONG BTC entry 30000 sl 29000"}`)
+	)
+
+	tests := []struct {
+		name        string
+		payload     []byte
+		timestamp   string
+		signature   string
+		expectedErr bool
+	}{
+		{
+			name:      "valid",
+			payload:   payload,
+			timestamp: timestamp,
+			signature: testWebhookSignature(payload, timestamp),
+		},
+		{
+			name:      "valid_without_prefix",
+			payload:   payload,
+			timestamp: timestamp,
+			signature: testWebhookSignature(payload, timestamp)[len(webhookSignaturePrefix):],
+		},
+		{
+			name:        "missing_signature",
+			payload:     payload,
+			timestamp:   timestamp,
+			expectedErr: true,
+		},
+		{
+			name:        "tampered_payload",
+			payload:     []byte(`{"content":"LONG BTC entry 30000 sl 1"}`),
+			timestamp:   timestamp,
+			signature:   testWebhookSignature(payload, timestamp),
+			expectedErr: true,
+		},
+		{
+			name:        "tampered_timestamp",
+			payload:     payload,
+			timestamp:   strconv.FormatInt(now.Unix()-1, 10),
+			signature:   testWebhookSignature(payload, timestamp),
+			expectedErr: true,
+		},
+		{
+			name:        "replayed",
+			payload:     payload,
+			timestamp:   strconv.FormatInt(now.Add(-webhookSignatureTolerance-time.Second).Unix(), 10),
+			signature:   testWebhookSignature(payload, strconv.FormatInt(now.Add(-webhookSignatureTolerance-time.Second).Unix(), 10)),
+			expectedErr: true,
+		},
+		{
+			name:        "bad_encoding",
+			payload:     payload,
+			timestamp:   timestamp,
+			signature:   "sha256=not-hex",
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyWebhookSignature(testWebhookSecret, tt.payload, tt.timestamp, tt.signature, now)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestHandleWebhook(t *testing.T) {
+	withFakeIngestion(t)
+
+	var (
+		payload   = []byte(`{"id":"alert-id","content":"LONG BTC entry 30000 sl 29000"}`)
+		timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+	)
+
+	tests := []struct {
+		name               string
+		method             string
+		path               string
+		signature          string
+		expectedStatusCode int
+		expectedPublished  bool
+	}{
+		{
+			name:               "accepted",
+			method:             http.MethodPost,
+			path:               "/webhooks/tradingview",
+			signature:          testWebhookSignature(payload, timestamp),
+			expectedStatusCode: http.StatusAccepted,
+			expectedPublished:  true,
+		},
+		{
+			name:               "unknown_source",
+			method:             http.MethodPost,
+			path:               "/webhooks/unknown",
+			signature:          testWebhookSignature(payload, timestamp),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "bad_signature",
+			method:             http.MethodPost,
+			path:               "/webhooks/tradingview",
+			signature:          testWebhookSignature([]byte("other"), timestamp),
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "not_a_post",
+			method:             http.MethodGet,
+			path:               "/webhooks/tradingview",
+			expectedStatusCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := make(chan *ConsumerMessage, 1)
+			h := handleWebhook(context.Background(), c, true, testWebhookSecret, map[string]string{
+				"tradingview": parser.SourceParsersGrammar,
+			})
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewReader(payload))
+			req.Header.Set(webhookTimestampHeader, timestamp)
+			req.Header.Set(webhookSignatureHeader, tt.signature)
+
+			w := httptest.NewRecorder()
+			h(w, req)
+
+			require.Equal(t, tt.expectedStatusCode, w.Code)
+			if !tt.expectedPublished {
+				return
+			}
+
+			// Payloads are ingested asynchronously.
+			select {
+			case msg := <-c:
+				assert.Equal(t, webhookConsumerID, msg.ConsumerID)
+				assert.Equal(t, "TRADINGVIEW", msg.Metadata["source"])
+			case <-time.After(time.Second):
+				t.Fatal("Expected the webhook payload to be ingested")
+			}
+		})
+	}
+}
+
+func TestHandleWebhook_Replayed(t *testing.T) {
+	parsed := withFakeIngestion(t)
+
+	var (
+		payload   = []byte(`{"content":"LONG BTC entry 30000 sl 29000"}`)
+		timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+		signature = testWebhookSignature(payload, timestamp)
+	)
+
+	c := make(chan *ConsumerMessage, 2)
+	h := handleWebhook(context.Background(), c, true, testWebhookSecret, map[string]string{
+		"tradingview": parser.SourceParsersGrammar,
+	})
+
+	post := func() int {
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/tradingview", bytes.NewReader(payload))
+		req.Header.Set(webhookTimestampHeader, timestamp)
+		req.Header.Set(webhookSignatureHeader, signature)
+
+		w := httptest.NewRecorder()
+		h(w, req)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusAccepted, post())
+
+	// The same signed payload is rejected whilst its timestamp is still within tolerance.
+	require.Equal(t, http.StatusConflict, post())
+
+	select {
+	case <-c:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the webhook payload to be ingested")
+	}
+
+	// Without an ID, the payload is identified by its signature.
+	require.Len(t, *parsed, 1)
+	assert.Equal(t, signature[len(webhookSignaturePrefix):], (*parsed)[0].ID)
+}
+
+func TestWebhookReplays(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now     = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		replays = newWebhookReplays()
+	)
+
+	assert.True(t, replays.claim("signature", now))
+	assert.False(t, replays.claim("signature", now.Add(webhookSignatureTolerance)))
+	assert.True(t, replays.claim("other-signature", now))
+
+	// Once the window has passed, the timestamp of the payload is rejected regardless; so the signature is forgotten.
+	assert.True(t, replays.claim("signature", now.Add(2*webhookSignatureTolerance)))
+}
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/monzo/slog"
+	"github.com/monzo/terrors"
+
+	"swallowtail/libraries/sql"
+	"swallowtail/libraries/sql/mocks"
+)
+
+var (
+	db sql.Database
+	mu sync.Mutex
+)
+
+// Init creates the database connection.
+func Init(ctx context.Context, serviceName string) error {
+	psql, err := sql.NewPostgresSQL(ctx, true, strings.ReplaceAll(serviceName, "-", ""))
+	if err != nil {
+		return terrors.Augment(err, "Failed to initialize dao", map[string]string{
+			"service_name": serviceName,
+		})
+	}
+	db = psql
+	if db == nil {
+		panic("nil db")
+	}
+
+	slog.Debug(ctx, "Dao initialized", map[string]string{
+		"service_name": serviceName,
+	})
+	return nil
+}
+
+// WithMock uses a mock db.
+func WithMock() {
+	if db != nil {
+		panic("Cannot set running db as Mock.")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+
+	db = &mocks.Database{}
+}
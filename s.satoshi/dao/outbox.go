@@ -0,0 +1,248 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.satoshi/domain"
+)
+
+// EnqueueOutboxMessage persists the message to the outbox, due to be delivered straight away. Returns false if a message
+// with the same idempotency key has already been enqueued.
+func EnqueueOutboxMessage(ctx context.Context, message *domain.OutboxMessage) (bool, error) {
+	var (
+		sql = `
+		INSERT INTO s_satoshi_outbox_messages
+			(
+				idempotency_key,
+				consumer_id,
+				discord_channel_id,
+				message,
+				attachments,
+				is_private,
+				participent_ids,
+				metadata,
+				status,
+				next_attempt,
+				created,
+				last_updated
+			)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (idempotency_key) DO NOTHING
+		`
+		m = message
+	)
+
+	now := time.Now().UTC()
+	m.Status = domain.OutboxMessageStatusPending
+	m.NextAttempt, m.LastUpdated = now, now
+	if m.Created.IsZero() {
+		m.Created = now
+	}
+
+	tag, err := db.Exec(
+		ctx, sql,
+		m.IdempotencyKey, m.ConsumerID, m.DiscordChannelID, m.Message, m.Attachments, m.IsPrivate, m.ParticipentIDs,
+		m.Metadata, m.Status, m.NextAttempt, m.Created, m.LastUpdated,
+	)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListDueOutboxMessages lists the pending messages due to be delivered by the given time; oldest first.
+func ListDueOutboxMessages(ctx context.Context, now time.Time, limit int) ([]*domain.OutboxMessage, error) {
+	var (
+		sql = `
+		SELECT * FROM s_satoshi_outbox_messages
+		WHERE status=$1
+		AND next_attempt <= $2
+		ORDER BY created ASC
+		LIMIT $3
+		`
+		messages []*domain.OutboxMessage
+	)
+
+	if err := db.Select(ctx, &messages, sql, domain.OutboxMessageStatusPending, now, limit); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return messages, nil
+}
+
+// MarkOutboxMessageDelivered marks the message as delivered to discord. Delivered messages are kept until pruned, so
+// their idempotency keys still guard against duplicates.
+func MarkOutboxMessageDelivered(ctx context.Context, outboxID, discordMessageID string) error {
+	var (
+		sql = `
+		UPDATE s_satoshi_outbox_messages
+		SET
+			status=$1,
+			discord_message_id=$2,
+			last_updated=$3
+		WHERE outbox_id=$4
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, domain.OutboxMessageStatusDelivered, discordMessageID, time.Now().UTC(), outboxID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// RetryOutboxMessage records a failed attempt to deliver the message, along with when it's next due.
+func RetryOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	var (
+		sql = `
+		UPDATE s_satoshi_outbox_messages
+		SET
+			attempts=$1,
+			last_error=$2,
+			next_attempt=$3,
+			last_updated=$4
+		WHERE outbox_id=$5
+		`
+		m = message
+	)
+
+	m.LastUpdated = time.Now().UTC()
+
+	if _, err := db.Exec(ctx, sql, m.Attempts, m.LastError, m.NextAttempt, m.LastUpdated, m.OutboxID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// DeadLetterOutboxMessage moves the message from the outbox to the dead letters, along with the error of its last
+// attempt.
+func DeadLetterOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	var (
+		sql = `
+		WITH dead AS (
+			DELETE FROM s_satoshi_outbox_messages
+			WHERE outbox_id=$1
+			RETURNING *
+		)
+		INSERT INTO s_satoshi_outbox_dead_letters
+			(
+				outbox_id,
+				idempotency_key,
+				consumer_id,
+				discord_channel_id,
+				message,
+				attachments,
+				is_private,
+				participent_ids,
+				metadata,
+				attempts,
+				last_error,
+				created,
+				dead_lettered
+			)
+		SELECT
+			outbox_id, idempotency_key, consumer_id, discord_channel_id, message, attachments, is_private,
+			participent_ids, metadata, $2, $3, created, $4
+		FROM dead
+		`
+		m = message
+	)
+
+	now := time.Now().UTC()
+	m.DeadLettered = &now
+
+	if _, err := db.Exec(ctx, sql, m.OutboxID, m.Attempts, m.LastError, now); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ListDeadLetters lists the messages that failed to deliver; most recently dead lettered first.
+func ListDeadLetters(ctx context.Context, limit int) ([]*domain.OutboxMessage, error) {
+	var (
+		sql = `
+		SELECT * FROM s_satoshi_outbox_dead_letters
+		ORDER BY dead_lettered DESC
+		LIMIT $1
+		`
+		messages []*domain.OutboxMessage
+	)
+
+	if err := db.Select(ctx, &messages, sql, limit); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return messages, nil
+}
+
+// ReplayDeadLetters moves the dead letters with the given outbox ids back to the outbox, due to be delivered straight
+// away with a fresh set of attempts; or every dead letter if no ids are given. Returns the number of messages replayed.
+// A dead letter whose idempotency key has since been enqueued again is dropped rather than replayed.
+func ReplayDeadLetters(ctx context.Context, outboxIDs []string) (int, error) {
+	var (
+		sql = `
+		WITH replayed AS (
+			DELETE FROM s_satoshi_outbox_dead_letters
+			WHERE CARDINALITY($1::uuid[]) = 0 OR outbox_id = ANY($1::uuid[])
+			RETURNING *
+		)
+		INSERT INTO s_satoshi_outbox_messages
+			(
+				outbox_id,
+				idempotency_key,
+				consumer_id,
+				discord_channel_id,
+				message,
+				attachments,
+				is_private,
+				participent_ids,
+				metadata,
+				status,
+				attempts,
+				last_error,
+				next_attempt,
+				created,
+				last_updated
+			)
+		SELECT
+			outbox_id, idempotency_key, consumer_id, discord_channel_id, message, attachments, is_private,
+			participent_ids, metadata, $2, 0, last_error, $3, created, $3
+		FROM replayed
+		ON CONFLICT (idempotency_key) DO NOTHING
+		`
+	)
+
+	if outboxIDs == nil {
+		outboxIDs = []string{}
+	}
+
+	tag, err := db.Exec(ctx, sql, outboxIDs, domain.OutboxMessageStatusPending, time.Now().UTC())
+	if err != nil {
+		return 0, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// PruneDeliveredOutboxMessages deletes the messages delivered before the given time.
+func PruneDeliveredOutboxMessages(ctx context.Context, before time.Time) error {
+	var (
+		sql = `
+		DELETE FROM s_satoshi_outbox_messages
+		WHERE status=$1
+		AND last_updated < $2
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, domain.OutboxMessageStatusDelivered, before); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.satoshi/domain"
+)
+
+// CreateParsedTradeReview persists the review, returning the review embellished with its review id.
+func CreateParsedTradeReview(ctx context.Context, review *domain.ParsedTradeReview) (*domain.ParsedTradeReview, error) {
+	var (
+		sql = `
+		INSERT INTO s_satoshi_parsed_trade_reviews
+			(parser, confidence, inferred_fields, source, content, trade_strategy, status, created, last_updated)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING review_id
+		`
+		reviewID string
+	)
+
+	now := time.Now().UTC()
+	r := review
+	r.Created = now
+	r.LastUpdated = now
+
+	if err := db.Get(
+		ctx, &reviewID, sql,
+		r.Parser, r.Confidence, r.InferredFields, r.Source, r.Content, r.TradeStrategy, r.Status, r.Created, r.LastUpdated,
+	); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	r.ReviewID = reviewID
+	return r, nil
+}
+
+// ReadParsedTradeReviewByID ...
+func ReadParsedTradeReviewByID(ctx context.Context, reviewID string) (*domain.ParsedTradeReview, error) {
+	var (
+		sql = `
+		SELECT * FROM s_satoshi_parsed_trade_reviews
+		WHERE review_id=$1
+		`
+		reviews []*domain.ParsedTradeReview
+	)
+
+	if err := db.Select(ctx, &reviews, sql, reviewID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	switch len(reviews) {
+	case 0:
+		return nil, gerrors.NotFound("not_found.parsed_trade_review", nil)
+	default:
+		return reviews[0], nil
+	}
+}
+
+// UpdateParsedTradeReview updates the outcome of the review.
+func UpdateParsedTradeReview(ctx context.Context, review *domain.ParsedTradeReview) error {
+	var (
+		sql = `
+		UPDATE s_satoshi_parsed_trade_reviews
+		SET
+			trade_strategy=$1,
+			status=$2,
+			is_edited=$3,
+			reviewer_id=$4,
+			trade_strategy_id=$5,
+			last_updated=$6
+		WHERE review_id=$7
+		`
+	)
+
+	r := review
+	r.LastUpdated = time.Now().UTC()
+
+	if _, err := db.Exec(ctx, sql, r.TradeStrategy, r.Status, r.IsEdited, r.ReviewerID, r.TradeStrategyID, r.LastUpdated, r.ReviewID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ListParserReviewCounts counts the reviews created since the given time by parser & outcome.
+func ListParserReviewCounts(ctx context.Context, since time.Time) ([]*domain.ParserReviewCount, error) {
+	var (
+		sql = `
+		SELECT parser, status, is_edited, COUNT(*) AS count FROM s_satoshi_parsed_trade_reviews
+		WHERE created >= $1
+		GROUP BY parser, status, is_edited
+		ORDER BY parser
+		`
+		counts []*domain.ParserReviewCount
+	)
+
+	if err := db.Select(ctx, &counts, sql, since); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,81 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.satoshi/domain"
+)
+
+// CreatePnLDigestSubscription subscribes the user to the daily PnL digest. Returns false if already subscribed.
+func CreatePnLDigestSubscription(ctx context.Context, userID string) (bool, error) {
+	var (
+		sql = `
+		INSERT INTO s_satoshi_pnl_digest_subscriptions
+			(user_id, created)
+		VALUES
+			($1, $2)
+		ON CONFLICT (user_id) DO NOTHING
+		`
+	)
+
+	tag, err := db.Exec(ctx, sql, userID, time.Now().UTC())
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// DeletePnLDigestSubscription unsubscribes the user from the daily PnL digest. Returns false if not subscribed.
+func DeletePnLDigestSubscription(ctx context.Context, userID string) (bool, error) {
+	var (
+		sql = `
+		DELETE FROM s_satoshi_pnl_digest_subscriptions
+		WHERE user_id=$1
+		`
+	)
+
+	tag, err := db.Exec(ctx, sql, userID)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListPnLDigestSubscriptionsDue lists the subscriptions that haven't been sent a digest since the time given.
+func ListPnLDigestSubscriptionsDue(ctx context.Context, since time.Time) ([]*domain.PnLDigestSubscription, error) {
+	var (
+		sql = `
+		SELECT * FROM s_satoshi_pnl_digest_subscriptions
+		WHERE last_sent IS NULL OR last_sent < $1
+		ORDER BY created ASC
+		`
+		subscriptions []*domain.PnLDigestSubscription
+	)
+
+	if err := db.Select(ctx, &subscriptions, sql, since); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return subscriptions, nil
+}
+
+// MarkPnLDigestSent records the digest as sent to the user.
+func MarkPnLDigestSent(ctx context.Context, userID string, sent time.Time) error {
+	var (
+		sql = `
+		UPDATE s_satoshi_pnl_digest_subscriptions
+		SET last_sent=$1
+		WHERE user_id=$2
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, sent, userID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.satoshi/domain"
+)
+
+// CreateTradeParticipantPoll persists the poll, returning the poll embellished with its poll id. Returns false if the
+// message is already being polled.
+func CreateTradeParticipantPoll(ctx context.Context, poll *domain.TradeParticipantPoll) (*domain.TradeParticipantPoll, bool, error) {
+	var (
+		sql = `
+		INSERT INTO s_satoshi_trade_participant_polls
+			(trade_strategy_id, channel_id, message_id, deadline, status, created, last_updated)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (message_id) DO NOTHING
+		RETURNING poll_id
+		`
+		pollIDs []string
+	)
+
+	now := time.Now().UTC()
+	p := poll
+	p.Status = domain.TradeParticipantPollStatusActive
+	p.Created, p.LastUpdated = now, now
+
+	if err := db.Select(
+		ctx, &pollIDs, sql,
+		p.TradeStrategyID, p.ChannelID, p.MessageID, p.Deadline, p.Status, p.Created, p.LastUpdated,
+	); err != nil {
+		return nil, false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	if len(pollIDs) == 0 {
+		return nil, false, nil
+	}
+
+	p.PollID = pollIDs[0]
+	return p, true, nil
+}
+
+// ListActiveTradeParticipantPolls lists every poll yet to be closed; including those past their deadline.
+func ListActiveTradeParticipantPolls(ctx context.Context) ([]*domain.TradeParticipantPoll, error) {
+	var (
+		sql = `
+		SELECT * FROM s_satoshi_trade_participant_polls
+		WHERE status=$1
+		ORDER BY deadline ASC
+		`
+		polls []*domain.TradeParticipantPoll
+	)
+
+	if err := db.Select(ctx, &polls, sql, domain.TradeParticipantPollStatusActive); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return polls, nil
+}
+
+// CloseTradeParticipantPoll closes the poll to new participants.
+func CloseTradeParticipantPoll(ctx context.Context, pollID string) error {
+	var (
+		sql = `
+		UPDATE s_satoshi_trade_participant_polls
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE poll_id=$3
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, domain.TradeParticipantPollStatusClosed, time.Now().UTC(), pollID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ClaimTradeParticipantReaction claims the reaction of the user to the trade strategy, so it can be executed. Returns
+// false if a reaction of the user to the trade strategy has already been claimed; by any poll.
+func ClaimTradeParticipantReaction(ctx context.Context, reaction *domain.TradeParticipantReaction) (bool, error) {
+	var (
+		sql = `
+		INSERT INTO s_satoshi_trade_participant_reactions
+			(trade_strategy_id, user_id, poll_id, reaction_id, risk, status, created, last_updated)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (trade_strategy_id, user_id) DO NOTHING
+		`
+	)
+
+	now := time.Now().UTC()
+	r := reaction
+	r.Status = domain.TradeParticipantReactionStatusClaimed
+	r.Created, r.LastUpdated = now, now
+
+	tag, err := db.Exec(ctx, sql, r.TradeStrategyID, r.UserID, r.PollID, r.ReactionID, r.Risk, r.Status, r.Created, r.LastUpdated)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// UpdateTradeParticipantReaction updates the outcome of executing the trade strategy for the reaction.
+func UpdateTradeParticipantReaction(ctx context.Context, reaction *domain.TradeParticipantReaction) error {
+	var (
+		sql = `
+		UPDATE s_satoshi_trade_participant_reactions
+		SET
+			status=$1,
+			error=$2,
+			last_updated=$3
+		WHERE trade_strategy_id=$4
+		AND user_id=$5
+		`
+	)
+
+	r := reaction
+	r.LastUpdated = time.Now().UTC()
+
+	if _, err := db.Exec(ctx, sql, r.Status, r.Error, r.LastUpdated, r.TradeStrategyID, r.UserID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// InterruptClaimedTradeParticipantReactions marks the reactions of the poll that were claimed but never executed to
+// completion as interrupted; returning them.
+func InterruptClaimedTradeParticipantReactions(ctx context.Context, pollID string) ([]*domain.TradeParticipantReaction, error) {
+	var (
+		sql = `
+		UPDATE s_satoshi_trade_participant_reactions
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE poll_id=$3
+		AND status=$4
+		RETURNING *
+		`
+		reactions []*domain.TradeParticipantReaction
+	)
+
+	if err := db.Select(
+		ctx, &reactions, sql,
+		domain.TradeParticipantReactionStatusInterrupted, time.Now().UTC(), pollID, domain.TradeParticipantReactionStatusClaimed,
+	); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return reactions, nil
+}
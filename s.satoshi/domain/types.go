@@ -0,0 +1,112 @@
+package domain
+
+import "time"
+
+// ParsedTradeReview is a trade strategy the parser wasn't confident enough in to create without an admin reviewing it.
+type ParsedTradeReview struct {
+	ReviewID       string   `db:"review_id"`
+	Parser         string   `db:"parser"`
+	Confidence     float64  `db:"confidence"`
+	InferredFields []string `db:"inferred_fields"`
+	// Source is the humanized name of the channel the trade strategy was parsed from.
+	Source  string `db:"source"`
+	Content string `db:"content"`
+	// TradeStrategy is the drafted trade strategy marshaled as JSON; replaced if an admin edits it.
+	TradeStrategy   []byte    `db:"trade_strategy"`
+	Status          string    `db:"status"`
+	IsEdited        bool      `db:"is_edited"`
+	ReviewerID      string    `db:"reviewer_id"`
+	TradeStrategyID string    `db:"trade_strategy_id"`
+	Created         time.Time `db:"created"`
+	LastUpdated     time.Time `db:"last_updated"`
+}
+
+// ParserReviewCount is the number of reviews of a parser with the given outcome.
+type ParserReviewCount struct {
+	Parser   string `db:"parser"`
+	Status   string `db:"status"`
+	IsEdited bool   `db:"is_edited"`
+	Count    int    `db:"count"`
+}
+
+const (
+	ParsedTradeReviewStatusPending  = "PENDING"
+	ParsedTradeReviewStatusApproved = "APPROVED"
+	ParsedTradeReviewStatusRejected = "REJECTED"
+	ParsedTradeReviewStatusExpired  = "EXPIRED"
+)
+
+// OutboxMessage is a consumer message persisted until it's delivered to discord. Messages that fail to deliver too many
+// times are moved to the dead letters; the dead lettered time is only set on those.
+type OutboxMessage struct {
+	OutboxID         string `db:"outbox_id"`
+	IdempotencyKey   string `db:"idempotency_key"`
+	ConsumerID       string `db:"consumer_id"`
+	DiscordChannelID string `db:"discord_channel_id"`
+	Message          string `db:"message"`
+	// Attachments & Metadata are marshaled as JSON.
+	Attachments      []byte     `db:"attachments"`
+	IsPrivate        bool       `db:"is_private"`
+	ParticipentIDs   []string   `db:"participent_ids"`
+	Metadata         []byte     `db:"metadata"`
+	Status           string     `db:"status"`
+	Attempts         int        `db:"attempts"`
+	LastError        string     `db:"last_error"`
+	NextAttempt      time.Time  `db:"next_attempt"`
+	DiscordMessageID string     `db:"discord_message_id"`
+	Created          time.Time  `db:"created"`
+	LastUpdated      time.Time  `db:"last_updated"`
+	DeadLettered     *time.Time `db:"dead_lettered"`
+}
+
+const (
+	OutboxMessageStatusPending   = "PENDING"
+	OutboxMessageStatusDelivered = "DELIVERED"
+)
+
+// TradeParticipantPoll is a trade strategy posted to discord, polled for participant reactions until its deadline.
+type TradeParticipantPoll struct {
+	PollID          string    `db:"poll_id"`
+	TradeStrategyID string    `db:"trade_strategy_id"`
+	ChannelID       string    `db:"channel_id"`
+	MessageID       string    `db:"message_id"`
+	Deadline        time.Time `db:"deadline"`
+	Status          string    `db:"status"`
+	Created         time.Time `db:"created"`
+	LastUpdated     time.Time `db:"last_updated"`
+}
+
+const (
+	TradeParticipantPollStatusActive = "ACTIVE"
+	TradeParticipantPollStatusClosed = "CLOSED"
+)
+
+// TradeParticipantReaction is the reaction of a user to a trade participant poll; claimed before the trade strategy is
+// executed for the user.
+type TradeParticipantReaction struct {
+	TradeStrategyID string    `db:"trade_strategy_id"`
+	UserID          string    `db:"user_id"`
+	PollID          string    `db:"poll_id"`
+	ReactionID      string    `db:"reaction_id"`
+	Risk            int       `db:"risk"`
+	Status          string    `db:"status"`
+	Error           string    `db:"error"`
+	Created         time.Time `db:"created"`
+	LastUpdated     time.Time `db:"last_updated"`
+}
+
+const (
+	TradeParticipantReactionStatusClaimed  = "CLAIMED"
+	TradeParticipantReactionStatusExecuted = "EXECUTED"
+	TradeParticipantReactionStatusFailed   = "FAILED"
+	// TradeParticipantReactionStatusInterrupted is a claimed reaction satoshi restarted whilst executing; it's never
+	// retried since the trade strategy may have been executed.
+	TradeParticipantReactionStatusInterrupted = "INTERRUPTED"
+)
+
+// PnLDigestSubscription is a user subscribed to a daily DM of their positions & PnL.
+type PnLDigestSubscription struct {
+	UserID   string     `db:"user_id"`
+	Created  time.Time  `db:"created"`
+	LastSent *time.Time `db:"last_sent"`
+}
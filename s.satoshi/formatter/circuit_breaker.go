@@ -0,0 +1,87 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// FormatCircuitBreakerTripped humanizes a trade engine rejection of a trade strategy whilst a circuit breaker is tripped.
+func FormatCircuitBreakerTripped(err error) string {
+	var reason string
+	if reasons, ok := gerrors.CollectDetailByKeyFromError(err, "reason"); ok && len(reasons) > 0 {
+		reason = reasons[0]
+	}
+
+	switch {
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "circuit_breaker_tripped.system"):
+		return fmt.Sprintf("Trading has been halted for everyone by an admin (%s); no new trade strategies will be placed until it's resumed", reason)
+	default:
+		return fmt.Sprintf("Trading has been halted on your account (%s); no new trade strategies will be placed until an admin resets it", reason)
+	}
+}
+
+// FormatCircuitBreakers humanizes a list of circuit breakers in string format.
+func FormatCircuitBreakers(circuitBreakers []*tradeengineproto.CircuitBreaker) string {
+	if len(circuitBreakers) == 0 {
+		return "No circuit breakers have ever been tripped."
+	}
+
+	var sb strings.Builder
+	for _, cb := range circuitBreakers {
+		sb.WriteString(FormatCircuitBreaker(cb))
+	}
+
+	return sb.String()
+}
+
+// FormatCircuitBreaker humanizes a single circuit breaker in string format.
+func FormatCircuitBreaker(circuitBreaker *tradeengineproto.CircuitBreaker) string {
+	scope := "SYSTEM"
+	if circuitBreaker.Scope == tradeengineproto.CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER {
+		scope = fmt.Sprintf("USER %s", circuitBreaker.UserId)
+	}
+
+	tpl := `
+Scope:          %s
+Tripped:        %v
+Reason:         %s
+Last Tripped:   %v
+Last Updated:   %v
+Actor:          %s
+`
+	return fmt.Sprintf(
+		tpl,
+		scope,
+		circuitBreaker.IsTripped,
+		circuitBreaker.Reason,
+		circuitBreaker.Tripped.AsTime(),
+		circuitBreaker.LastUpdated.AsTime(),
+		circuitBreaker.ActorId,
+	)
+}
+
+// FormatHaltedTrading humanizes the outcome of halting trading when tripping a circuit breaker.
+func FormatHaltedTrading(rsp *tradeengineproto.TripCircuitBreakerResponse) string {
+	tpl := `
+Cancelled Execution Schedules:   %d
+Cancelled Orders:                %d
+Closed Out Orders:               %d
+Failed Orders:                   %d
+`
+	formatted := fmt.Sprintf(
+		tpl,
+		rsp.GetNumberOfCancelledExecutionSchedules(),
+		len(rsp.GetCancelledOrders()),
+		len(rsp.GetClosedOutOrders()),
+		len(rsp.GetFailedOrders()),
+	)
+
+	for _, order := range rsp.GetFailedOrders() {
+		formatted += fmt.Sprintf("FAILED: %s %s [%s] %s\n", order.UserId, order.Instrument, order.ExternalOrderId, order.FailureReason)
+	}
+
+	return formatted
+}
@@ -0,0 +1,68 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// FormatCallerPerformances humanizes the caller leaderboard in string format.
+func FormatCallerPerformances(performances []*tradeengineproto.CallerPerformance, windowInDays int64, sort tradeengineproto.CALLER_PERFORMANCE_SORT, minNumberOfTrades int) string {
+	window := "all time"
+	if windowInDays > 0 {
+		window = fmt.Sprintf("the last %d days", windowInDays)
+	}
+
+	if len(performances) == 0 {
+		return fmt.Sprintf("No callers have %d or more resolved trades over %s.", minNumberOfTrades, window)
+	}
+
+	rankedBy := "expectancy"
+	if sort == tradeengineproto.CALLER_PERFORMANCE_SORT_WIN_RATE {
+		rankedBy = "win rate"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Callers over %s, ranked by %s\n\n", window, rankedBy))
+	sb.WriteString(fmt.Sprintf("%-4s %-24s %6s %8s %10s %8s %10s\n", "#", "CALLER", "TRADES", "WIN RATE", "EXPECTANCY", "TOTAL R", "AVG TIME"))
+	for i, p := range performances {
+		sb.WriteString(fmt.Sprintf(
+			"%-4d %-24s %6d %7.1f%% %9.2fR %7.2fR %10s\n",
+			i+1,
+			truncate(p.HumanizedActorName, 24),
+			p.NumberOfTrades,
+			p.WinRate,
+			p.Expectancy,
+			p.TotalRMultiple,
+			formatMinutes(p.AverageMinutesToOutcome),
+		))
+	}
+	sb.WriteString("\nOnly calls whose entry was hit & have since stopped out, taken their final profit or expired are counted.")
+
+	return sb.String()
+}
+
+// truncate shortens the string to at most n characters.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+
+	return string(r[:n-1]) + "…"
+}
+
+// formatMinutes humanizes a duration in minutes, to the nearest minute.
+func formatMinutes(minutes float32) string {
+	d := time.Duration(minutes) * time.Minute
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%.1fd", d.Hours()/24)
+	case d >= time.Hour:
+		return fmt.Sprintf("%.1fh", d.Hours())
+	default:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+}
@@ -0,0 +1,42 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"swallowtail/s.satoshi/domain"
+)
+
+// FormatDeadLetters humanizes the outbox messages that failed to deliver in string format.
+func FormatDeadLetters(deadLetters []*domain.OutboxMessage) string {
+	if len(deadLetters) == 0 {
+		return "No messages have failed to deliver."
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Messages that failed to deliver, most recent first\n")
+	for _, m := range deadLetters {
+		destination := fmt.Sprintf("channel %s", m.DiscordChannelID)
+		if m.IsPrivate {
+			destination = fmt.Sprintf("participents %s", strings.Join(m.ParticipentIDs, ", "))
+		}
+
+		var deadLettered string
+		if m.DeadLettered != nil {
+			deadLettered = m.DeadLettered.Format("2006-01-02 15:04")
+		}
+
+		sb.WriteString(fmt.Sprintf(
+			"\n%s\nConsumer: %s, to %s\nDead lettered: %s after %d attempts\nError: %s\nMessage: %s\n",
+			m.OutboxID,
+			m.ConsumerID,
+			destination,
+			deadLettered,
+			m.Attempts,
+			truncate(m.LastError, 120),
+			truncate(strings.ReplaceAll(m.Message, "`", "'"), 120),
+		))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,104 @@
+package formatter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"swallowtail/libraries/emojis"
+	"swallowtail/s.satoshi/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// FormatParsedTradeReview humanizes a drafted trade strategy awaiting an admin's review.
+func FormatParsedTradeReview(review *domain.ParsedTradeReview, tradeStrategy *tradeengineproto.TradeStrategy) string {
+	header := fmt.Sprintf(
+		":mag:   `PARSED TRADE STRATEGY REVIEW: %s: confidence %.0f%% (%s parser)`",
+		review.Source, review.Confidence*100, review.Parser,
+	)
+
+	inferred := "none"
+	if len(review.InferredFields) > 0 {
+		inferred = strings.Join(review.InferredFields, ", ")
+	}
+
+	footer := fmt.Sprintf(
+		"Inferred: `%s`\nReact %s to approve, %s to edit or %s to reject. Review ID: `%s`",
+		inferred, emojis.ApproveEmoji, emojis.EditEmoji, emojis.RejectEmoji, review.ReviewID,
+	)
+
+	return fmt.Sprintf("%s\n%s\n%s", header, FormatTradeStrategy(review.Source, tradeStrategy, review.Content), footer)
+}
+
+// FormatParsedTradeReviewEditRequest tells the admin how to correct a drafted trade strategy.
+func FormatParsedTradeReviewEditRequest(reviewID, userID string) string {
+	return fmt.Sprintf(
+		":pencil2: <@%s> reply with `!review edit %s <signal>` to correct the draft, e.g. `!review edit %s LONG BTC entry 30000-31000 sl 29000 tp 32000/33000`; then react %s to approve it.",
+		userID, reviewID, reviewID, emojis.ApproveEmoji,
+	)
+}
+
+// FormatParserReviewStats humanizes the outcomes of parser reviews in string format. The precision of a parser is the
+// percentage of its reviewed drafts approved without any edits.
+func FormatParserReviewStats(counts []*domain.ParserReviewCount, since time.Time) string {
+	if len(counts) == 0 {
+		return fmt.Sprintf("No parsed trade strategies have been reviewed since %s.", since.Format("2006-01-02"))
+	}
+
+	type stats struct {
+		approved, edited, rejected, expired, pending int
+	}
+
+	statsByParser := map[string]*stats{}
+	for _, c := range counts {
+		s, ok := statsByParser[c.Parser]
+		if !ok {
+			s = &stats{}
+			statsByParser[c.Parser] = s
+		}
+
+		switch {
+		case c.Status == domain.ParsedTradeReviewStatusApproved && c.IsEdited:
+			s.edited += c.Count
+		case c.Status == domain.ParsedTradeReviewStatusApproved:
+			s.approved += c.Count
+		case c.Status == domain.ParsedTradeReviewStatusRejected:
+			s.rejected += c.Count
+		case c.Status == domain.ParsedTradeReviewStatusExpired:
+			s.expired += c.Count
+		default:
+			s.pending += c.Count
+		}
+	}
+
+	parsers := make([]string, 0, len(statsByParser))
+	for p := range statsByParser {
+		parsers = append(parsers, p)
+	}
+	sort.Strings(parsers)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Parser reviews since %s\n", since.Format("2006-01-02")))
+	for _, p := range parsers {
+		s := statsByParser[p]
+
+		precision := "n/a"
+		if reviewed := s.approved + s.edited + s.rejected; reviewed > 0 {
+			precision = fmt.Sprintf("%.1f%%", float64(s.approved)/float64(reviewed)*100)
+		}
+
+		tpl := `
+Parser:      %s
+Precision:   %s
+Approved:    %v
+Edited:      %v
+Rejected:    %v
+Expired:     %v
+Pending:     %v
+`
+		sb.WriteString(fmt.Sprintf(tpl, strings.ToUpper(p), precision, s.approved, s.edited, s.rejected, s.expired, s.pending))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,122 @@
+package formatter
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// FormatVenuePositions humanizes the open positions of the user on each of their venues in string format.
+func FormatVenuePositions(venuePositions []*tradeengineproto.VenuePositions) string {
+	if len(venuePositions) == 0 {
+		return "No venue accounts we can read positions from; positions are only read from Binance futures & FTX."
+	}
+
+	var sb strings.Builder
+	for i, vp := range venuePositions {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		sb.WriteString(formatVenueHeader(vp))
+		if vp.Error != "" {
+			continue
+		}
+
+		if len(vp.Positions) == 0 {
+			sb.WriteString("No open positions.\n")
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("%-14s %-5s %10s %10s %10s %10s %10s\n", "INSTRUMENT", "SIDE", "SIZE", "ENTRY", "MARK", "LIQ", "UPNL"))
+		for _, p := range vp.Positions {
+			side := "LONG"
+			if p.Quantity < 0 {
+				side = "SHORT"
+			}
+
+			sb.WriteString(fmt.Sprintf(
+				"%-14s %-5s %10s %10s %10s %10s %10.2f\n",
+				truncate(p.Instrument, 14),
+				side,
+				formatFloat(math.Abs(float64(p.Quantity))),
+				formatFloat(float64(p.EntryPrice)),
+				formatOptionalFloat(p.MarkPrice),
+				formatOptionalFloat(p.LiquidationPrice),
+				p.UnrealisedPnl,
+			))
+		}
+	}
+
+	return sb.String()
+}
+
+// FormatPnL humanizes the unrealised & realised PnL of the user on each of their venues in string format.
+func FormatPnL(venuePositions []*tradeengineproto.VenuePositions) string {
+	if len(venuePositions) == 0 {
+		return "No venue accounts we can read PnL from; PnL is only read from Binance futures & FTX."
+	}
+
+	var (
+		sb                             strings.Builder
+		totalUnrealised, totalRealised float32
+	)
+	for _, vp := range venuePositions {
+		sb.WriteString(formatVenueHeader(vp))
+		if vp.Error != "" {
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("Unrealised PnL: %.2f\n", vp.UnrealisedPnl))
+		totalUnrealised += vp.UnrealisedPnl
+
+		if vp.RealisedPnlUnavailable {
+			sb.WriteString("Realised PnL:   n/a\n")
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("Realised PnL:   %.2f\n", vp.RealisedPnl))
+		totalRealised += vp.RealisedPnl
+	}
+
+	if len(venuePositions) > 1 {
+		sb.WriteString(fmt.Sprintf("\nTOTAL\nUnrealised PnL: %.2f\nRealised PnL:   %.2f\n", totalUnrealised, totalRealised))
+	}
+
+	sb.WriteString("\nRealised PnL is over the last 24 hours; it's n/a for venues that can't report it, i.e FTX, & excluded from the total.")
+
+	return sb.String()
+}
+
+func formatVenueHeader(vp *tradeengineproto.VenuePositions) string {
+	if vp.Error != "" {
+		return fmt.Sprintf("%s: failed to read positions; please check your api keys are still valid.\n", vp.Venue)
+	}
+
+	return fmt.Sprintf("%s (margin ratio %.2f%%)\n", vp.Venue, vp.MarginRatio*100)
+}
+
+// formatOptionalFloat formats the float, or a dash if the venue didn't report it.
+func formatOptionalFloat(f float32) string {
+	if f == 0 {
+		return "-"
+	}
+
+	return formatFloat(float64(f))
+}
+
+// formatFloat formats the float to at most six significant figures.
+func formatFloat(f float64) string {
+	return fmt.Sprintf("%.6g", f)
+}
+
+// FormatPnLDigest humanizes the positions & PnL of the user on each of their venues for the daily digest.
+func FormatPnLDigest(venuePositions []*tradeengineproto.VenuePositions) string {
+	if len(venuePositions) == 0 {
+		return FormatVenuePositions(venuePositions)
+	}
+
+	return fmt.Sprintf("%s\n%s", FormatPnL(venuePositions), FormatVenuePositions(venuePositions))
+}
@@ -0,0 +1,62 @@
+package formatter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// FormatPriceAlerts humanizes a list of price alerts in string format.
+func FormatPriceAlerts(alerts []*marketdataproto.PriceAlert) string {
+	if len(alerts) == 0 {
+		return "No price alerts; create one with `!alert above|below|move`."
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%-36s %-12s %-18s %-9s %-9s %s\n", "ALERT ID", "SYMBOL", "CONDITION", "REPEATS", "STATUS", "TRIGGERS"))
+	for _, a := range alerts {
+		repeats := "once"
+		if a.IsRecurring {
+			repeats = "recurring"
+		}
+
+		sb.WriteString(fmt.Sprintf(
+			"%-36s %-12s %-18s %-9s %-9s %d\n",
+			a.AlertId,
+			truncate(strings.ToUpper(a.Symbol+a.AssetPair), 12),
+			truncate(formatPriceAlertCondition(a), 18),
+			repeats,
+			a.Status,
+			a.NumberOfTriggers,
+		))
+	}
+
+	return sb.String()
+}
+
+// FormatPriceAlertCondition humanizes when a price alert triggers, i.e `when BTCUSDT is above 70000`.
+func FormatPriceAlertCondition(alert *marketdataproto.PriceAlert) string {
+	return fmt.Sprintf("when `%s` is %s", strings.ToUpper(alert.Symbol+alert.AssetPair), formatPriceAlertCondition(alert))
+}
+
+func formatPriceAlertCondition(alert *marketdataproto.PriceAlert) string {
+	switch alert.Condition {
+	case marketdataproto.PriceAlertConditionMove:
+		return fmt.Sprintf("moving %s%% in %s", strconv.FormatFloat(alert.MovePercentage, 'f', -1, 64), formatWindow(alert.WindowMinutes))
+	default:
+		return fmt.Sprintf("%s %s", alert.Condition, strconv.FormatFloat(alert.TargetPrice, 'f', -1, 64))
+	}
+}
+
+// formatWindow formats a window of minutes without its zero units; i.e `15m`, `1h` or `1h30m`.
+func formatWindow(minutes int64) string {
+	s := strings.TrimSuffix((time.Duration(minutes) * time.Minute).String(), "0s")
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+
+	return s
+}
@@ -0,0 +1,76 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"swallowtail/libraries/gerrors"
+	accountproto "swallowtail/s.account/proto"
+)
+
+// FormatRiskProfileViolation humanizes a trade engine rejection of a trade strategy for breaching the users risk profile.
+func FormatRiskProfileViolation(err error) string {
+	var limit, value string
+	if limits, ok := gerrors.CollectDetailByKeyFromError(err, "limit"); ok && len(limits) > 0 {
+		limit = limits[0]
+	}
+	if values, ok := gerrors.CollectDetailByKeyFromError(err, "value"); ok && len(values) > 0 {
+		value = values[0]
+	}
+
+	switch {
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "risk_profile_violation.instrument_not_allowed"):
+		return fmt.Sprintf("Your risk profile doesn't allow trading %s; you can only trade: %s", value, limit)
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "risk_profile_violation.max_risk_per_trade_exceeded"):
+		return fmt.Sprintf("Your risk profile allows at most %s%% risk per trade strategy; you requested %s%%", limit, value)
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "risk_profile_violation.venue_balance_too_small"):
+		return fmt.Sprintf("Sorry, looks like you don't have enough margin in your exchange account to place that trade strategy: your minimum is %s USD, you have %s USD", limit, value)
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "risk_profile_violation.max_leverage_exceeded"):
+		return fmt.Sprintf("Your risk profile allows at most %sx leverage; that trade strategy would need %sx", limit, value)
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "risk_profile_violation.max_notional_per_asset_exceeded"):
+		return fmt.Sprintf("Your risk profile allows at most %s USD notional per asset; that trade strategy would take you to %s USD", limit, value)
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "risk_profile_violation.max_concurrent_open_strategies_exceeded"):
+		return fmt.Sprintf("Your risk profile allows at most %s open trade strategies at once", limit)
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "risk_profile_violation.daily_loss_limit_exceeded"):
+		return fmt.Sprintf("You've hit your daily loss limit of %s USD (lost %s USD today); no new trade strategies will be placed until tomorrow (UTC)", limit, value)
+	default:
+		return "That trade strategy breaches your risk profile; see `!account risk`."
+	}
+}
+
+// FormatRiskProfile humanizes a risk profile in string format; zero valued limits are unlimited.
+func FormatRiskProfile(riskProfile *accountproto.RiskProfile, isDefault bool) string {
+	formatLimit := func(limit float64, unit string) string {
+		if limit == 0 {
+			return "unlimited"
+		}
+		return fmt.Sprintf("%v%s", limit, unit)
+	}
+
+	allowList := "all"
+	if len(riskProfile.InstrumentAllowList) > 0 {
+		allowList = strings.Join(riskProfile.InstrumentAllowList, ", ")
+	}
+
+	tpl := `
+Max Risk Per Trade:            %s
+Max Open Strategies:           %s
+Max Leverage:                  %s
+Max Notional Per Asset:        %s
+Daily Loss Limit:              %s
+Min Venue Balance:             %s
+Allowed Instruments:           %s
+Default Profile:               %v
+`
+	return fmt.Sprintf(
+		tpl,
+		formatLimit(float64(riskProfile.MaxRiskPerTrade), "%"),
+		formatLimit(float64(riskProfile.MaxConcurrentOpenStrategies), ""),
+		formatLimit(float64(riskProfile.MaxLeverage), "x"),
+		formatLimit(float64(riskProfile.MaxNotionalPerAsset), " USD"),
+		formatLimit(float64(riskProfile.DailyLossLimit), " USD"),
+		formatLimit(float64(riskProfile.MinVenueBalance), " USD"),
+		allowList,
+		isDefault,
+	)
+}
@@ -0,0 +1,44 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	marketdataproto "swallowtail/s.market-data/proto"
+)
+
+// FormatWatchlistAssets humanizes the assets on a watchlist in string format.
+func FormatWatchlistAssets(assets []*marketdataproto.WatchlistAsset) string {
+	if len(assets) == 0 {
+		return "No assets on the watchlist."
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%-16s %-10s %-10s %-12s %-10s %s\n", "SYMBOL", "PAIR", "VENUE", "GROUP", "VOLATILITY", "NAME"))
+	for _, a := range assets {
+		name := a.HumanizedName
+		if a.Asset != "" {
+			name = strings.TrimSpace(fmt.Sprintf("%s %s", a.Asset, name))
+		}
+
+		sb.WriteString(fmt.Sprintf(
+			"%-16s %-10s %-10s %-12s %-10s %s\n",
+			truncate(a.Symbol, 16),
+			orDash(strings.ToUpper(a.AssetPair)),
+			orDash(a.Venue),
+			orDash(truncate(a.Grouping, 12)),
+			orDash(a.VolatilityRating),
+			name,
+		))
+	}
+
+	return sb.String()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
@@ -8,6 +8,7 @@ import (
 
 	"swallowtail/libraries/gerrors"
 	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.satoshi/formatter"
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 )
 
@@ -32,8 +33,12 @@ func notifyUserOnFailure(ctx context.Context, userID, tradeStrategyID string, nu
 		errMsg = "Sorry, looks like I've been rate limited. Please try and place the trade manually again in a few seconds time."
 	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "venue_account_found_different_to_primary_venue_account_on_account"):
 		errMsg = "Sorry, looks like you don't have an exchange set up for that venue, please check with the `!exchange list` command."
-	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "venue_balance_too_small"):
-		errMsg = "Sorry, looks like you don't have enough margin in your exchange account to place that trade strategy: default minimum is 100 USD"
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "risk_profile_violation"):
+		errMsg = formatter.FormatRiskProfileViolation(err)
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "circuit_breaker_tripped"):
+		errMsg = formatter.FormatCircuitBreakerTripped(err)
+	case gerrors.Is(err, gerrors.ErrFailedPrecondition, "trade_participant_poll_interrupted"):
+		errMsg = "I restarted whilst placing your trade, so I haven't retried it in case it was placed. Please check on the exchange & place it manually if need be."
 	default:
 		errMsg = "Sorry, I'm not sure what happened there. Please ping @ajperkins for a hand."
 	}
@@ -63,6 +68,46 @@ FAILED ORDER:      %v
 	return nil
 }
 
+// notifyUserOnRollback notifies the user that the execution of their trade strategy failed part way through, along
+// with whether the trade engine managed to roll back the orders it had placed.
+func notifyUserOnRollback(ctx context.Context, userID, tradeStrategyID string, risk int, executionError *tradeengineproto.ExecutionError) error {
+	var header string
+	switch {
+	case executionError.GetParticipantFlat():
+		header = fmt.Sprintf(":warning: <@%s>, Sorry, I failed to execute your trade strategy with %v%% risk; I've rolled back the %d orders I placed, so you have no position :warning:\n", userID, risk, len(executionError.GetRolledBackOrders()))
+	default:
+		header = fmt.Sprintf(":rotating_light: <@%s>, Sorry, I failed to execute your trade strategy with %v%% risk & couldn't roll back the orders I placed. Please manually check on the exchange :rotating_light:\n", userID, risk)
+	}
+
+	content := `
+TRADE STRATEGY ID:  %s
+EXECUTION ERROR:    %v
+ATTEMPTS:           %d
+ROLLED BACK ORDERS: %d
+PARTICIPANT FLAT:   %v
+FAILED ORDER:       %v
+`
+	formattedContent := fmt.Sprintf(
+		content,
+		tradeStrategyID,
+		executionError.GetErrorMessage(),
+		executionError.GetAttempts(),
+		len(executionError.GetRolledBackOrders()),
+		executionError.GetParticipantFlat(),
+		executionError.GetFailedOrder(),
+	)
+
+	if _, err := (&discordproto.SendMsgToPrivateChannelRequest{
+		UserId:         userID,
+		Content:        fmt.Sprintf("%s```%s```", header, formattedContent),
+		IdempotencyKey: fmt.Sprintf("tradestrategyrollback-%s-%s-%s", userID, tradeStrategyID, time.Now().UTC().Truncate(15*time.Minute)),
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_notify_user", nil)
+	}
+
+	return nil
+}
+
 func notifyUserOnSuccess(
 	ctx context.Context,
 	userID, tradeStrategyID, tradeParticipantID, asset, pair string,
@@ -251,9 +296,27 @@ RISK (%%):          %v
 SUCCESSFUL_ORDERS:  %d
 ERROR:              %v
 EXECUTION_ERROR:    %v
+ERROR_CLASS:        %v
+ATTEMPTS:           %d
+ROLLED_BACK_ORDERS: %d
+PARTICIPANT_FLAT:   %v
 FAILED_ORDER:       %+v
 `
-	formattedContent := fmt.Sprintf(content, tradeID, userID, time.Now().UTC().Truncate(time.Second), risk, numberOfSuccessOrders, err, executionError.GetErrorMessage(), executionError.GetFailedOrder())
+	formattedContent := fmt.Sprintf(
+		content,
+		tradeID,
+		userID,
+		time.Now().UTC().Truncate(time.Second),
+		risk,
+		numberOfSuccessOrders,
+		err,
+		executionError.GetErrorMessage(),
+		executionError.GetErrorClass(),
+		executionError.GetAttempts(),
+		len(executionError.GetRolledBackOrders()),
+		executionError.GetParticipantFlat(),
+		executionError.GetFailedOrder(),
+	)
 
 	if _, err := (&discordproto.SendMsgToChannelRequest{
 		ChannelId:      discordproto.DiscordSatoshiTradesPulseChannel,
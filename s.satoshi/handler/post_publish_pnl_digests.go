@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.satoshi/dao"
+	"swallowtail/s.satoshi/domain"
+	"swallowtail/s.satoshi/formatter"
+	satoshiproto "swallowtail/s.satoshi/proto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// PublishPnLDigests DMs every subscribed user their positions & PnL; at most once per day (UTC).
+func (s *SatoshiService) PublishPnLDigests(
+	ctx context.Context, in *satoshiproto.PublishPnLDigestsRequest,
+) (*satoshiproto.PublishPnLDigestsResponse, error) {
+	now := time.Now().UTC()
+	today := now.Truncate(24 * time.Hour)
+
+	subscriptions, err := dao.ListPnLDigestSubscriptionsDue(ctx, today)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_publish_pnl_digests", nil)
+	}
+
+	var sent, failed int64
+	for _, subscription := range subscriptions {
+		if err := publishPnLDigest(ctx, subscription, today); err != nil {
+			slog.Error(ctx, "Failed to publish PnL digest to user: %s, Error: %v", subscription.UserID, err)
+			failed++
+			continue
+		}
+
+		if err := dao.MarkPnLDigestSent(ctx, subscription.UserID, now); err != nil {
+			// Best effort; the idempotency key stops the digest being sent twice today.
+			slog.Error(ctx, "Failed to mark PnL digest sent to user: %s, Error: %v", subscription.UserID, err)
+		}
+
+		sent++
+	}
+
+	slog.Info(ctx, "Published PnL digests: %d sent, %d failed", sent, failed)
+
+	return &satoshiproto.PublishPnLDigestsResponse{
+		NumberOfDigestsSent:   sent,
+		NumberOfDigestsFailed: failed,
+	}, nil
+}
+
+func publishPnLDigest(ctx context.Context, subscription *domain.PnLDigestSubscription, today time.Time) error {
+	rsp, err := (&tradeengineproto.ListVenuePositionsRequest{
+		ActorId: tradeengineproto.TradeEngineActorSatoshiSystem,
+		UserId:  subscription.UserID,
+	}).Send(ctx).Response()
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_venue_positions", nil)
+	}
+
+	header := fmt.Sprintf(":sunrise: <@%s> Here's your daily digest; reply `!pnl digest off` to stop.", subscription.UserID)
+
+	if _, err := (&discordproto.SendMsgToPrivateChannelRequest{
+		UserId:         subscription.UserID,
+		SenderId:       "c.satoshi",
+		Content:        fmt.Sprintf("%s```%s```", header, formatter.FormatPnLDigest(rsp.GetVenuePositions())),
+		IdempotencyKey: fmt.Sprintf("pnldigest-%s-%s", subscription.UserID, today.Format("2006-01-02")),
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_send_pnl_digest", nil)
+	}
+
+	return nil
+}
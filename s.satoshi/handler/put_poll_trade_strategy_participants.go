@@ -7,9 +7,8 @@ import (
 
 	"github.com/monzo/slog"
 
-	"swallowtail/libraries/emojis"
 	"swallowtail/libraries/gerrors"
-	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.satoshi/domain"
 	satoshiproto "swallowtail/s.satoshi/proto"
 )
 
@@ -38,136 +37,27 @@ func (s *SatoshiService) PollTradeStrategyParticipants(
 		"actor_id":           in.ActorId,
 		"timeout_in_seconds": strconv.Itoa(int(in.TimeoutInMinutes)),
 		"trade_strategy_id":  in.TradeStrategyId,
-		"channel_id":         in.TradeStrategyId,
+		"channel_id":         in.ChannelId,
 	}
 
-	// This is horrible code; but we don't yet have the infra in place to do any better.
-	// Ideally this should be asynchronous using some message queue using exactly-once semantics.
-	go func() {
-		deadline := time.Now().UTC().Add(time.Duration(in.TimeoutInMinutes) * time.Minute)
-
-		// We create a new context object; otherwise the parent context would be cancel once the
-		// the response is returned to the callee.
-		newCtx := context.Background()
-		childCtx, cancel := context.WithDeadline(newCtx, deadline)
-		defer cancel()
-
-		tradeCache := map[string]bool{}
-		t := time.NewTicker(10 * time.Second)
-		tPulse := time.NewTicker(5 * time.Minute)
-
-		// Cronitor; notify pulse channel poll has started.
-		if err := notifyPulseChannelStart(childCtx, in.TradeStrategyId, deadline); err != nil {
-			slog.Error(childCtx, err.Error())
-		}
-
-		// Poll for trade participants; ideally this is a candidate to be refactored.
-		for {
-			select {
-			case <-tPulse.C:
-				// Cronitor; notify pulse channel poll of pulse.
-				if err := notifyPulseChannelHeartbeat(childCtx, in.TradeStrategyId, deadline); err != nil {
-					slog.Error(newCtx, err.Error())
-				}
-			case <-t.C:
-				// Poll for new reactions.
-				rsp, err := (&discordproto.ReadMessageReactionsRequest{
-					MessageId: in.MessageId,
-					ChannelId: in.ChannelId,
-				}).Send(childCtx).Response()
-				if err != nil {
-					slog.Trace(childCtx, "poll_trade_participants.failed_to_read_message_reactions", errParams)
-					continue
-				}
-
-				// Execute order for particicpant based on trade strategy reaction.
-				reactions := rsp.GetReactions()
-				for _, reaction := range reactions {
-					if !isValidTradeParticipantReaction(reaction.GetReactionId()) {
-						continue
-					}
-
-					for _, userID := range reaction.UserIds {
-						if _, exists := tradeCache[userID]; exists {
-							continue
-						}
-
-						// We set this to true, even if we fail; we may want to introduce some retry mechanics.
-						// But lets keep it simple for now.
-						tradeCache[userID] = true
-
-						// Calculate risk based on emoji.
-						risk := emojis.SatoshiRiskEmoji(reaction.GetReactionId()).AsRiskPercentage()
-
-						// Execute order.
-						rsp, err := executeTradeStrategyForParticipant(newCtx, userID, in.TradeStrategyId, risk)
-						if err != nil {
-							slog.Error(newCtx, "Failed to execute trade strategy for user: %s; Error: %v", userID, err)
-
-							// Notify parties of failure.
-							if perr := notifyUserOnFailure(newCtx, userID, in.TradeStrategyId, 0, err, nil); perr != nil {
-								slog.Error(newCtx, "Failed to notify user of successful trade strategy: %s, UserID %s, Error: %s", in.TradeStrategyId, userID, perr)
-							}
-
-							if perr := notifyPulseChannelUserTradeFailure(newCtx, userID, in.TradeStrategyId, risk, 0, err, nil); perr != nil {
-								slog.Error(newCtx, "Failed to notify channel of successful trade strategy: TradeID %s, UserID %s, Error: %v", in.TradeStrategyId, userID, perr)
-							}
-
-							continue
-						}
-
-						// Verify we don't have a partial failure.
-						if rsp.GetError() != nil {
-							slog.Error(newCtx, "Partially failed to execute trade strategy for participant: %s; Error: %v", userID, rsp.GetError())
-
-							// Notify pulse channel of partial failure.
-							if perr := notifyPulseChannelUserTradeFailure(newCtx, userID, in.TradeStrategyId, risk, int(rsp.NumberOfExecutedOrders), nil, rsp.GetError()); perr != nil {
-								slog.Error(newCtx, "Failed to notify channel of successful trade strategy: %s, UserID %s, Error: %v", in.TradeStrategyId, userID, perr)
-							}
-						}
-
-						// Notify parties of success.
-						if err := notifyUserOnSuccess(
-							newCtx,
-							userID,
-							in.TradeStrategyId,
-							userID,
-							rsp.Asset,
-							rsp.Pair.String(),
-							rsp.ExecutionStrategy,
-							rsp.Venue,
-							float64(risk),
-							rsp.Timestamp.AsTime(),
-							rsp.SuccessfulOrders,
-							rsp.Error,
-							rsp.InstrumentType,
-							float64(rsp.NotionalSizeIsUsd),
-						); err != nil {
-							slog.Error(newCtx, "Failed to notify user of successful trade strategy: %v TradeParticipantId: %v", in.TradeStrategyId, rsp.TradeParticipantId)
-						}
-
-						// Push to pulse channel.
-						if err := notifyPulseChannelUserTradeSuccess(newCtx, userID, in.TradeStrategyId, rsp.ExecutionStrategy, rsp.Venue, risk, rsp.SuccessfulOrders); err != nil {
-							slog.Error(newCtx, err.Error())
-						}
-					}
-				}
-
-			case <-childCtx.Done():
-				slog.Warn(newCtx, "Closing window for new trade participants for trade strategy: %v", in.TradeStrategyId)
-
-				if err := notifyTradesChannelContextEnded(newCtx, in.TradeStrategyId); err != nil {
-					slog.Error(newCtx, err.Error())
-				}
+	// The poll is persisted so it survives a restart; polling the same message twice is a noop.
+	poll, created, err := createTradeParticipantPoll(ctx, &domain.TradeParticipantPoll{
+		TradeStrategyID: in.TradeStrategyId,
+		ChannelID:       in.ChannelId,
+		MessageID:       in.MessageId,
+		Deadline:        time.Now().UTC().Add(time.Duration(in.TimeoutInMinutes) * time.Minute),
+	})
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_poll_trade_strategy_participants", errParams)
+	}
 
-				if err := notifyPulseChannelEnd(newCtx, in.TradeStrategyId, deadline); err != nil {
-					slog.Error(newCtx, err.Error())
-				}
+	if !created {
+		slog.Info(ctx, "Trade strategy participants already being polled: %s, message: %s", in.TradeStrategyId, in.MessageId)
+		return &satoshiproto.PollTradeStrategyParticipantsResponse{}, nil
+	}
 
-				return
-			}
-		}
-	}()
+	// The poll outlives the request; so it runs with its own context.
+	go pollTradeParticipants(poll)
 
 	return &satoshiproto.PollTradeStrategyParticipantsResponse{}, nil
 }
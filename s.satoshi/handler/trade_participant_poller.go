@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/emojis"
+	"swallowtail/libraries/gerrors"
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.satoshi/dao"
+	"swallowtail/s.satoshi/domain"
+)
+
+const (
+	tradeParticipantPollInterval      = 10 * time.Second
+	tradeParticipantHeartbeatInterval = 5 * time.Minute
+)
+
+// The below are package variables so polling can be faked in tests.
+var (
+	createTradeParticipantPoll                = dao.CreateTradeParticipantPoll
+	listActiveTradeParticipantPolls           = dao.ListActiveTradeParticipantPolls
+	closeTradeParticipantPoll                 = dao.CloseTradeParticipantPoll
+	claimTradeParticipantReaction             = dao.ClaimTradeParticipantReaction
+	updateTradeParticipantReaction            = dao.UpdateTradeParticipantReaction
+	interruptClaimedTradeParticipantReactions = dao.InterruptClaimedTradeParticipantReactions
+	executeTradeStrategyForReaction           = executeAndNotifyTradeParticipant
+	executeTradeStrategy                      = executeTradeStrategyForParticipant
+	notifyParticipantOnSuccess                = notifyUserOnSuccess
+	notifyParticipantOnRollback               = notifyUserOnRollback
+	notifyPulseChannelOnSuccess               = notifyPulseChannelUserTradeSuccess
+	notifyPulseChannelOnFailure               = notifyPulseChannelUserTradeFailure
+)
+
+// ResumeTradeParticipantPolls resumes every poll that was active when satoshi last stopped. Reactions that were claimed
+// but never executed to completion are never retried, since the trade strategy may have been executed regardless; their
+// users are asked to check the exchange instead.
+func ResumeTradeParticipantPolls(ctx context.Context) error {
+	polls, err := listActiveTradeParticipantPolls(ctx)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_resume_trade_participant_polls", nil)
+	}
+
+	for _, poll := range polls {
+		interrupted, err := interruptClaimedTradeParticipantReactions(ctx, poll.PollID)
+		if err != nil {
+			return gerrors.Augment(err, "failed_to_resume_trade_participant_polls", map[string]string{
+				"poll_id": poll.PollID,
+			})
+		}
+
+		for _, reaction := range interrupted {
+			slog.Warn(ctx, "Trade participant reaction interrupted by restart: %s, UserID %s", reaction.TradeStrategyID, reaction.UserID)
+
+			err := gerrors.FailedPrecondition("trade_participant_poll_interrupted", nil)
+			if perr := notifyUserOnFailure(ctx, reaction.UserID, reaction.TradeStrategyID, 0, err, nil); perr != nil {
+				slog.Error(ctx, "Failed to notify user of interrupted trade strategy: %s, UserID %s, Error: %v", reaction.TradeStrategyID, reaction.UserID, perr)
+			}
+		}
+
+		slog.Info(ctx, "Resuming trade participant poll: %s for trade strategy: %s", poll.PollID, poll.TradeStrategyID)
+		go pollTradeParticipants(poll)
+	}
+
+	return nil
+}
+
+// pollTradeParticipants polls the trade strategy message for participant reactions until the poll's deadline, executing
+// the trade strategy for each participant.
+func pollTradeParticipants(poll *domain.TradeParticipantPoll) {
+	// We create a new context object; the poll outlives whatever started it.
+	newCtx := context.Background()
+	childCtx, cancel := context.WithDeadline(newCtx, poll.Deadline)
+	defer cancel()
+
+	t := time.NewTicker(tradeParticipantPollInterval)
+	defer t.Stop()
+
+	tPulse := time.NewTicker(tradeParticipantHeartbeatInterval)
+	defer tPulse.Stop()
+
+	// Cronitor; notify pulse channel poll has started.
+	if err := notifyPulseChannelStart(childCtx, poll.TradeStrategyID, poll.Deadline); err != nil {
+		slog.Error(childCtx, err.Error())
+	}
+
+	// Users whose reaction has been claimed, by this poll or any other; so we don't claim them again each poll.
+	seen := map[string]bool{}
+	for {
+		select {
+		case <-tPulse.C:
+			// Cronitor; notify pulse channel poll of pulse.
+			if err := notifyPulseChannelHeartbeat(childCtx, poll.TradeStrategyID, poll.Deadline); err != nil {
+				slog.Error(newCtx, err.Error())
+			}
+		case <-t.C:
+			// Poll for new reactions.
+			rsp, err := (&discordproto.ReadMessageReactionsRequest{
+				MessageId: poll.MessageID,
+				ChannelId: poll.ChannelID,
+			}).Send(childCtx).Response()
+			if err != nil {
+				slog.Trace(childCtx, "poll_trade_participants.failed_to_read_message_reactions", map[string]string{
+					"poll_id":           poll.PollID,
+					"trade_strategy_id": poll.TradeStrategyID,
+				})
+				continue
+			}
+
+			// Execution outlives the poll's deadline; an order half placed must still complete.
+			processTradeParticipantReactions(newCtx, poll, rsp.GetReactions(), seen)
+		case <-childCtx.Done():
+			slog.Warn(newCtx, "Closing window for new trade participants for trade strategy: %v", poll.TradeStrategyID)
+
+			if err := closeTradeParticipantPoll(newCtx, poll.PollID); err != nil {
+				slog.Error(newCtx, "Failed to close trade participant poll: %s, Error: %v", poll.PollID, err)
+			}
+
+			if err := notifyTradesChannelContextEnded(newCtx, poll.TradeStrategyID); err != nil {
+				slog.Error(newCtx, err.Error())
+			}
+
+			if err := notifyPulseChannelEnd(newCtx, poll.TradeStrategyID, poll.Deadline); err != nil {
+				slog.Error(newCtx, err.Error())
+			}
+
+			return
+		}
+	}
+}
+
+// processTradeParticipantReactions executes the trade strategy for every user with a valid reaction not yet seen. Each
+// reaction is claimed before it's executed; so a trade strategy is executed at most once per user.
+func processTradeParticipantReactions(ctx context.Context, poll *domain.TradeParticipantPoll, reactions []*discordproto.Reaction, seen map[string]bool) {
+	for _, reaction := range reactions {
+		if !isValidTradeParticipantReaction(reaction.GetReactionId()) {
+			continue
+		}
+
+		// Calculate risk based on emoji.
+		risk := emojis.SatoshiRiskEmoji(reaction.GetReactionId()).AsRiskPercentage()
+
+		for _, userID := range reaction.GetUserIds() {
+			if seen[userID] {
+				continue
+			}
+
+			participantReaction := &domain.TradeParticipantReaction{
+				TradeStrategyID: poll.TradeStrategyID,
+				UserID:          userID,
+				PollID:          poll.PollID,
+				ReactionID:      reaction.GetReactionId(),
+				Risk:            risk,
+			}
+
+			claimed, err := claimTradeParticipantReaction(ctx, participantReaction)
+			if err != nil {
+				// We try to claim again next poll.
+				slog.Error(ctx, "Failed to claim trade participant reaction: %s, UserID %s, Error: %v", poll.TradeStrategyID, userID, err)
+				continue
+			}
+
+			seen[userID] = true
+			if !claimed {
+				continue
+			}
+
+			participantReaction.Status, participantReaction.Error = executeTradeStrategyForReaction(ctx, userID, poll.TradeStrategyID, risk)
+			if err := updateTradeParticipantReaction(ctx, participantReaction); err != nil {
+				slog.Error(ctx, "Failed to update trade participant reaction: %s, UserID %s, Error: %v", poll.TradeStrategyID, userID, err)
+			}
+		}
+	}
+}
+
+// executeAndNotifyTradeParticipant executes the trade strategy for the participant, notifying both the participant &
+// the pulse channel of the outcome; returning the status of the reaction along with any error.
+func executeAndNotifyTradeParticipant(ctx context.Context, userID, tradeStrategyID string, risk int) (string, string) {
+	// Execute order.
+	rsp, err := executeTradeStrategy(ctx, userID, tradeStrategyID, risk)
+	if err != nil {
+		slog.Error(ctx, "Failed to execute trade strategy for user: %s; Error: %v", userID, err)
+
+		// Notify parties of failure.
+		if perr := notifyUserOnFailure(ctx, userID, tradeStrategyID, 0, err, nil); perr != nil {
+			slog.Error(ctx, "Failed to notify user of failed trade strategy: %s, UserID %s, Error: %s", tradeStrategyID, userID, perr)
+		}
+
+		if perr := notifyPulseChannelOnFailure(ctx, userID, tradeStrategyID, risk, 0, err, nil); perr != nil {
+			slog.Error(ctx, "Failed to notify channel of failed trade strategy: TradeID %s, UserID %s, Error: %v", tradeStrategyID, userID, perr)
+		}
+
+		return domain.TradeParticipantReactionStatusFailed, err.Error()
+	}
+
+	// Verify the execution didn't fail; if it did the trade engine will have attempted to roll back any orders placed.
+	if rsp.GetError() != nil {
+		slog.Error(ctx, "Failed to execute trade strategy for participant: %s; participant flat: %v, Error: %v", userID, rsp.GetError().GetParticipantFlat(), rsp.GetError())
+
+		// Notify parties of failure.
+		if perr := notifyParticipantOnRollback(ctx, userID, tradeStrategyID, risk, rsp.GetError()); perr != nil {
+			slog.Error(ctx, "Failed to notify user of failed trade strategy: %s, UserID %s, Error: %v", tradeStrategyID, userID, perr)
+		}
+
+		if perr := notifyPulseChannelOnFailure(ctx, userID, tradeStrategyID, risk, int(rsp.NumberOfExecutedOrders), nil, rsp.GetError()); perr != nil {
+			slog.Error(ctx, "Failed to notify channel of failed trade strategy: %s, UserID %s, Error: %v", tradeStrategyID, userID, perr)
+		}
+
+		return domain.TradeParticipantReactionStatusFailed, rsp.GetError().GetErrorMessage()
+	}
+
+	// Notify parties of success.
+	if err := notifyParticipantOnSuccess(
+		ctx,
+		userID,
+		tradeStrategyID,
+		userID,
+		rsp.Asset,
+		rsp.Pair.String(),
+		rsp.ExecutionStrategy,
+		rsp.Venue,
+		float64(risk),
+		rsp.Timestamp.AsTime(),
+		rsp.SuccessfulOrders,
+		rsp.Error,
+		rsp.InstrumentType,
+		float64(rsp.NotionalSizeIsUsd),
+	); err != nil {
+		slog.Error(ctx, "Failed to notify user of successful trade strategy: %v TradeParticipantId: %v", tradeStrategyID, rsp.TradeParticipantId)
+	}
+
+	// Push to pulse channel.
+	if err := notifyPulseChannelOnSuccess(ctx, userID, tradeStrategyID, rsp.ExecutionStrategy, rsp.Venue, risk, rsp.SuccessfulOrders); err != nil {
+		slog.Error(ctx, err.Error())
+	}
+
+	return domain.TradeParticipantReactionStatusExecuted, ""
+}
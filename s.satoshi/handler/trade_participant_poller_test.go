@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/libraries/emojis"
+	discordproto "swallowtail/s.discord/proto"
+	"swallowtail/s.satoshi/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// withFakeReactionClaims fakes the reaction store with the given reactions already claimed, along with the execution
+// of trade strategies; returning the users executed for by risk & the reactions updated.
+func withFakeReactionClaims(t *testing.T, alreadyClaimed map[string]bool, claimErr error) (map[string]int, map[string]*domain.TradeParticipantReaction) {
+	executed := map[string]int{}
+	updated := map[string]*domain.TradeParticipantReaction{}
+
+	originalClaim, originalUpdate, originalExecute := claimTradeParticipantReaction, updateTradeParticipantReaction, executeTradeStrategyForReaction
+	t.Cleanup(func() {
+		claimTradeParticipantReaction, updateTradeParticipantReaction, executeTradeStrategyForReaction = originalClaim, originalUpdate, originalExecute
+	})
+
+	claimTradeParticipantReaction = func(ctx context.Context, reaction *domain.TradeParticipantReaction) (bool, error) {
+		if claimErr != nil {
+			return false, claimErr
+		}
+
+		if alreadyClaimed[reaction.UserID] {
+			return false, nil
+		}
+
+		alreadyClaimed[reaction.UserID] = true
+		return true, nil
+	}
+	updateTradeParticipantReaction = func(ctx context.Context, reaction *domain.TradeParticipantReaction) error {
+		updated[reaction.UserID] = reaction
+		return nil
+	}
+	executeTradeStrategyForReaction = func(ctx context.Context, userID, tradeStrategyID string, risk int) (string, string) {
+		executed[userID] = risk
+		if userID == "failing-user" {
+			return domain.TradeParticipantReactionStatusFailed, "insufficient margin"
+		}
+
+		return domain.TradeParticipantReactionStatusExecuted, ""
+	}
+
+	return executed, updated
+}
+
+func TestProcessTradeParticipantReactions(t *testing.T) {
+	poll := &domain.TradeParticipantPoll{
+		PollID:          "poll-id",
+		TradeStrategyID: "trade-strategy-id",
+	}
+
+	reactions := []*discordproto.Reaction{
+		{
+			ReactionId: string(emojis.EmojiUnicodeTwo),
+			UserIds:    []string{"user", "claimed-before-restart", "failing-user"},
+		},
+		{
+			ReactionId: string(emojis.EmojiUnicodeFive),
+			// A second reaction from the same user is never executed.
+			UserIds: []string{"user"},
+		},
+		{
+			ReactionId: "👍",
+			UserIds:    []string{"not-a-risk-reaction"},
+		},
+	}
+
+	executed, updated := withFakeReactionClaims(t, map[string]bool{"claimed-before-restart": true}, nil)
+	seen := map[string]bool{}
+
+	processTradeParticipantReactions(context.Background(), poll, reactions, seen)
+	// Polling the same reactions again executes nothing more.
+	processTradeParticipantReactions(context.Background(), poll, reactions, seen)
+
+	assert.Equal(t, map[string]int{"user": 2, "failing-user": 2}, executed)
+
+	require.Contains(t, updated, "user")
+	assert.Equal(t, domain.TradeParticipantReactionStatusExecuted, updated["user"].Status)
+	assert.Equal(t, "poll-id", updated["user"].PollID)
+
+	require.Contains(t, updated, "failing-user")
+	assert.Equal(t, domain.TradeParticipantReactionStatusFailed, updated["failing-user"].Status)
+	assert.Equal(t, "insufficient margin", updated["failing-user"].Error)
+
+	assert.True(t, seen["claimed-before-restart"])
+	assert.NotContains(t, seen, "not-a-risk-reaction")
+}
+
+func TestProcessTradeParticipantReactions_ClaimFailure(t *testing.T) {
+	executed, _ := withFakeReactionClaims(t, map[string]bool{}, errors.New("postgres unavailable"))
+	seen := map[string]bool{}
+
+	processTradeParticipantReactions(context.Background(), &domain.TradeParticipantPoll{}, []*discordproto.Reaction{
+		{
+			ReactionId: string(emojis.EmojiUnicodeOne),
+			UserIds:    []string{"user"},
+		},
+	}, seen)
+
+	// Without a claim the trade strategy is never executed; we try to claim again next poll.
+	assert.Empty(t, executed)
+	assert.Empty(t, seen)
+}
+
+// withFakeTradeStrategyExecution fakes the execution of trade strategies with the given response, returning the
+// notifications sent by name.
+func withFakeTradeStrategyExecution(t *testing.T, rsp *tradeengineproto.ExecuteTradeStrategyForParticipantResponse) map[string]int {
+	notified := map[string]int{}
+
+	originalExecute, originalSuccess, originalRollback := executeTradeStrategy, notifyParticipantOnSuccess, notifyParticipantOnRollback
+	originalPulseSuccess, originalPulseFailure := notifyPulseChannelOnSuccess, notifyPulseChannelOnFailure
+	t.Cleanup(func() {
+		executeTradeStrategy, notifyParticipantOnSuccess, notifyParticipantOnRollback = originalExecute, originalSuccess, originalRollback
+		notifyPulseChannelOnSuccess, notifyPulseChannelOnFailure = originalPulseSuccess, originalPulseFailure
+	})
+
+	executeTradeStrategy = func(ctx context.Context, userID, tradeStrategyID string, risk int) (*tradeengineproto.ExecuteTradeStrategyForParticipantResponse, error) {
+		return rsp, nil
+	}
+	notifyParticipantOnSuccess = func(
+		ctx context.Context,
+		userID, tradeStrategyID, tradeParticipantID, asset, pair string,
+		executionStrategy tradeengineproto.EXECUTION_STRATEGY,
+		venue tradeengineproto.VENUE,
+		risk float64,
+		timestamp time.Time,
+		successfulOrders []*tradeengineproto.Order,
+		executionError *tradeengineproto.ExecutionError,
+		instrumentType tradeengineproto.INSTRUMENT_TYPE,
+		notionalSizeInUSD float64,
+	) error {
+		notified["user_success"]++
+		return nil
+	}
+	notifyParticipantOnRollback = func(ctx context.Context, userID, tradeStrategyID string, risk int, executionError *tradeengineproto.ExecutionError) error {
+		notified["user_rollback"]++
+		return nil
+	}
+	notifyPulseChannelOnSuccess = func(ctx context.Context, userID, tradeID string, executionStrategy tradeengineproto.EXECUTION_STRATEGY, venue tradeengineproto.VENUE, risk int, succesfulOrders []*tradeengineproto.Order) error {
+		notified["pulse_success"]++
+		return nil
+	}
+	notifyPulseChannelOnFailure = func(ctx context.Context, userID, tradeID string, risk, numberOfSuccessOrders int, err error, executionError *tradeengineproto.ExecutionError) error {
+		notified["pulse_failure"]++
+		return nil
+	}
+
+	return notified
+}
+
+func TestExecuteAndNotifyTradeParticipant(t *testing.T) {
+	tests := []struct {
+		name           string
+		rsp            *tradeengineproto.ExecuteTradeStrategyForParticipantResponse
+		expectedStatus string
+		expectedError  string
+		expectedNotify map[string]int
+	}{
+		{
+			name: "executed",
+			rsp: &tradeengineproto.ExecuteTradeStrategyForParticipantResponse{
+				NumberOfExecutedOrders: 2,
+				Timestamp:              timestamppb.Now(),
+			},
+			expectedStatus: domain.TradeParticipantReactionStatusExecuted,
+			expectedNotify: map[string]int{"user_success": 1, "pulse_success": 1},
+		},
+		{
+			name: "execution_error_rolled_back",
+			rsp: &tradeengineproto.ExecuteTradeStrategyForParticipantResponse{
+				NumberOfExecutedOrders: 1,
+				Timestamp:              timestamppb.Now(),
+				Error: &tradeengineproto.ExecutionError{
+					ErrorMessage:     "insufficient margin",
+					RolledBackOrders: []*tradeengineproto.Order{{}},
+					ParticipantFlat:  true,
+				},
+			},
+			expectedStatus: domain.TradeParticipantReactionStatusFailed,
+			expectedError:  "insufficient margin",
+			expectedNotify: map[string]int{"user_rollback": 1, "pulse_failure": 1},
+		},
+		{
+			name: "execution_error_not_flat",
+			rsp: &tradeengineproto.ExecuteTradeStrategyForParticipantResponse{
+				NumberOfExecutedOrders: 1,
+				Timestamp:              timestamppb.Now(),
+				Error: &tradeengineproto.ExecutionError{
+					ErrorMessage: "venue unavailable",
+				},
+			},
+			expectedStatus: domain.TradeParticipantReactionStatusFailed,
+			expectedError:  "venue unavailable",
+			expectedNotify: map[string]int{"user_rollback": 1, "pulse_failure": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			notified := withFakeTradeStrategyExecution(t, tt.rsp)
+
+			status, errMsg := executeAndNotifyTradeParticipant(context.Background(), "user", "trade-strategy-id", 2)
+
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedError, errMsg)
+			assert.Equal(t, tt.expectedNotify, notified)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"swallowtail/libraries/mariana"
+	"swallowtail/s.satoshi/dao"
 	"swallowtail/s.satoshi/handler"
 	"swallowtail/s.satoshi/parser"
 	satoshiproto "swallowtail/s.satoshi/proto"
@@ -19,6 +20,11 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// Init dao.
+	if err := dao.Init(ctx, svcName); err != nil {
+		panic(err)
+	}
+
 	// Init parser.
 	if err := parser.Init(ctx); err != nil {
 		panic(err)
@@ -29,6 +35,11 @@ func main() {
 		panic(err)
 	}
 
+	// Resume the trade participant polls active before we last stopped.
+	if err := handler.ResumeTradeParticipantPolls(ctx); err != nil {
+		panic(err)
+	}
+
 	// Init Mariana Server.
 	srv := mariana.Init(svcName)
 	satoshiproto.RegisterSatoshiServer(srv.Grpc(), &handler.SatoshiService{})
@@ -0,0 +1,173 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	// The fields a parser may infer rather than read from a label.
+	InferredFieldTradeSide         = "trade_side"
+	InferredFieldEntries           = "entries"
+	InferredFieldStopLoss          = "stop_loss"
+	InferredFieldTakeProfits       = "take_profits"
+	InferredFieldExecutionStrategy = "execution_strategy"
+
+	// minimumConfidenceWithoutReview is the confidence below which a parsed trade strategy must be reviewed by an admin
+	// before it becomes a trade strategy.
+	minimumConfidenceWithoutReview = 0.8
+)
+
+var (
+	// inferredFieldPenalties is how much each inferred field lowers the confidence of a parse. A mis-parsed stop loss
+	// is the costliest mistake a parser can make; so inferring it alone requires a review.
+	inferredFieldPenalties = map[string]float64{
+		InferredFieldTradeSide:         0.3,
+		InferredFieldEntries:           0.3,
+		InferredFieldStopLoss:          0.5,
+		InferredFieldTakeProfits:       0.2,
+		InferredFieldExecutionStrategy: 0.1,
+	}
+)
+
+// ParsedTradeStrategy is a trade strategy parsed from some content, along with how confident the parser is in it.
+type ParsedTradeStrategy struct {
+	TradeStrategy *tradeengineproto.TradeStrategy
+	// Parser is the name of the parser that parsed the trade strategy.
+	Parser string
+	// Confidence is within [0, 1]; 1 meaning every field was read from a label.
+	Confidence float64
+	// InferredFields are the fields the parser had to infer from the content rather than read from a label.
+	InferredFields []string
+}
+
+// RequiresReview returns true if the parse is too uncertain to create a trade strategy from without an admin reviewing it.
+func (p *ParsedTradeStrategy) RequiresReview() bool {
+	return p.Confidence < minimumConfidenceWithoutReview
+}
+
+func newParsedTradeStrategy(parser string, tradeStrategy *tradeengineproto.TradeStrategy, inferredFields []string) *ParsedTradeStrategy {
+	confidence := 1.0
+	for _, field := range inferredFields {
+		confidence -= inferredFieldPenalties[field]
+	}
+
+	if confidence < 0 {
+		confidence = 0
+	}
+
+	return &ParsedTradeStrategy{
+		TradeStrategy:  tradeStrategy,
+		Parser:         parser,
+		Confidence:     confidence,
+		InferredFields: inferredFields,
+	}
+}
+
+// inferHeuristicFields determines which fields of a heuristic parse were inferred; i.e not read from a label in the
+// content. A value is only taken as labelled if it is the first number following one of the given marks.
+func inferHeuristicFields(
+	content string,
+	sideIsExplicit, executionStrategyIsExplicit bool,
+	numberOfPossibleValues int,
+	entries []float64,
+	stopLoss float64,
+	takeProfits []float64,
+) []string {
+	var inferredFields []string
+	if !sideIsExplicit {
+		inferredFields = append(inferredFields, InferredFieldTradeSide)
+	}
+
+	// If we had to discard any values as out of range; then the entries were picked from what was left.
+	if numberOfPossibleValues > len(entries)+1+len(takeProfits) {
+		inferredFields = append(inferredFields, InferredFieldEntries)
+	}
+
+	if !isLabelledValue(content, possibleStopLossMarks, stopLoss) {
+		inferredFields = append(inferredFields, InferredFieldStopLoss)
+	}
+
+	for _, tp := range takeProfits {
+		if !isLabelledValue(content, possibleTakeProfitMarks, tp) && !followsLabelledValue(content, possibleTakeProfitMarks, tp) {
+			inferredFields = append(inferredFields, InferredFieldTakeProfits)
+			break
+		}
+	}
+
+	if !executionStrategyIsExplicit {
+		inferredFields = append(inferredFields, InferredFieldExecutionStrategy)
+	}
+
+	return inferredFields
+}
+
+// isLabelledValue checks if the value is the first number following any of the marks in the content.
+func isLabelledValue(content string, marks []string, value float64) bool {
+	tokens := strings.Fields(content)
+	for i, token := range tokens {
+		if !isMark(token, marks) {
+			continue
+		}
+
+		for _, next := range tokens[i+1:] {
+			f, ok := parseValueToken(next)
+			if !ok {
+				continue
+			}
+
+			if f == value {
+				return true
+			}
+			break
+		}
+	}
+
+	return false
+}
+
+// followsLabelledValue checks if the value is within the run of numbers directly following any of the marks; e.g the
+// second take profit of `tp 32000 33000`.
+func followsLabelledValue(content string, marks []string, value float64) bool {
+	tokens := strings.Fields(content)
+	for i, token := range tokens {
+		if !isMark(token, marks) {
+			continue
+		}
+
+		for _, next := range tokens[i+1:] {
+			f, ok := parseValueToken(next)
+			if !ok {
+				break
+			}
+
+			if f == value {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isMark(token string, marks []string) bool {
+	t := strings.TrimRight(strings.ToLower(token), ":0123456789")
+	for _, mark := range marks {
+		if t == mark {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseValueToken(token string) (float64, bool) {
+	f, err := strconv.ParseFloat(strings.Trim(token, ":-/"), 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return f, true
+}
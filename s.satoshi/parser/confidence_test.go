@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func TestParsedTradeStrategyConfidence(t *testing.T) {
+	tests := []struct {
+		name                   string
+		parser                 TradeParser
+		content                string
+		currentValue           float64
+		expectedInferredFields []string
+		expectedRequiresReview bool
+	}{
+		{
+			name:         "grammar_signal",
+			parser:       &GrammarParser{},
+			content:      `long btc entry 30000-31000 sl 29000 tp 32000/33000`,
+			currentValue: 31000,
+		},
+		{
+			name:         "heuristic_with_every_field_labelled",
+			parser:       &DCAParser{},
+			content:      `btc long 51000-50000 sl 49000 tp 52000 54000`,
+			currentValue: 50000,
+		},
+		{
+			name:                   "heuristic_without_labels",
+			parser:                 &DMAParser{},
+			content:                `btc 50000 49000 52000`,
+			currentValue:           50000,
+			expectedInferredFields: []string{InferredFieldTradeSide, InferredFieldStopLoss, InferredFieldTakeProfits, InferredFieldExecutionStrategy},
+			expectedRequiresReview: true,
+		},
+		{
+			name:                   "heuristic_stop_loss_not_the_labelled_value",
+			parser:                 &DMAParser{},
+			content:                `btc long 50000 sl 49500 tp 52000 48000`,
+			currentValue:           50000,
+			expectedInferredFields: []string{InferredFieldStopLoss, InferredFieldTakeProfits, InferredFieldExecutionStrategy},
+			expectedRequiresReview: true,
+		},
+		{
+			name:                   "heuristic_discarded_values",
+			parser:                 &DCAParser{},
+			content:                `eth long 2000-1900 sl 1800 tp 2200 with 5 day target 10000`,
+			currentValue:           2000,
+			expectedInferredFields: []string{InferredFieldEntries},
+			expectedRequiresReview: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			withFakeInstruments(t, tt.currentValue)
+
+			parsed, err := tt.parser.Parse(context.Background(), tt.content, &discordgo.MessageCreate{
+				Message: &discordgo.Message{
+					Author: &discordgo.User{},
+				},
+			}, tradeengineproto.ACTOR_TYPE_EXTERNAL)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedInferredFields, parsed.InferredFields)
+			assert.Equal(t, tt.expectedRequiresReview, parsed.RequiresReview())
+			if len(tt.expectedInferredFields) == 0 {
+				assert.Equal(t, 1.0, parsed.Confidence)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"swallowtail/libraries/gerrors"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// CorpusCase is a historical message replayed through the parser; so that any change to the parsers shows exactly which
+// past messages would now parse differently. A corpus is a file of cases, one JSON object per line.
+type CorpusCase struct {
+	// Name uniquely identifies the case within its corpus; for exported messages it's the discord message id.
+	Name string `json:"name"`
+	// ChannelID is the registered channel the message was posted in; it determines the parsers that are used.
+	ChannelID string    `json:"channel_id"`
+	AuthorID  string    `json:"author_id"`
+	Username  string    `json:"username"`
+	Content   string    `json:"content"`
+	Timestamp time.Time `json:"timestamp"`
+	// Price is the price of the asset at the time the message was posted; zero if the parser never asked for one.
+	Price float64 `json:"price"`
+	// ExpectedParser is the name of the parser expected to parse the message; empty if no trade strategy should be
+	// parsed at all.
+	ExpectedParser string `json:"expected_parser,omitempty"`
+	// ExpectedTradeStrategy is the trade strategy expected to be parsed, as protobuf JSON.
+	ExpectedTradeStrategy json.RawMessage `json:"expected_trade_strategy,omitempty"`
+}
+
+// CorpusInstruments are the instruments that were tradeable when a corpus was recorded.
+type CorpusInstruments struct {
+	// Binance are the base assets tradeable on Binance, e.g `btc`.
+	Binance []string `json:"binance"`
+	// FTX are the FTX markets, e.g `btc-perp` or `btc/usdt`.
+	FTX []string `json:"ftx"`
+}
+
+// PriceFetcher fetches the price of the ticker.
+type PriceFetcher func(ctx context.Context, ticker string) (float64, error)
+
+// Expected returns the trade strategy the case is expected to parse to; nil if none.
+func (c *CorpusCase) Expected() (*tradeengineproto.TradeStrategy, error) {
+	if len(c.ExpectedTradeStrategy) == 0 {
+		return nil, nil
+	}
+
+	tradeStrategy := &tradeengineproto.TradeStrategy{}
+	if err := protojson.Unmarshal(c.ExpectedTradeStrategy, tradeStrategy); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_unmarshal_corpus_case_trade_strategy", map[string]string{
+			"name": c.Name,
+		})
+	}
+
+	return tradeStrategy, nil
+}
+
+// SetExpected records the parse as the expected outcome of the case; a nil parse expects no trade strategy at all.
+func (c *CorpusCase) SetExpected(parsed *ParsedTradeStrategy) error {
+	if parsed == nil {
+		c.ExpectedParser, c.ExpectedTradeStrategy = "", nil
+		return nil
+	}
+
+	tradeStrategy, err := protojson.Marshal(parsed.TradeStrategy)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_marshal_corpus_case_trade_strategy", map[string]string{
+			"name": c.Name,
+		})
+	}
+
+	// protojson doesn't guarantee stable output; so we compact it for a stable, single line corpus.
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, tradeStrategy); err != nil {
+		return gerrors.Augment(err, "failed_to_compact_corpus_case_trade_strategy", nil)
+	}
+
+	c.ExpectedParser, c.ExpectedTradeStrategy = parsed.Parser, compacted.Bytes()
+	return nil
+}
+
+// Replay parses the case as it would have been parsed when it was posted; with only the given instruments tradeable &
+// prices from the given fetcher. Replay swaps out the parser's instruments & price fetcher whilst parsing; so it mustn't
+// be called concurrently, nor alongside a live parser.
+func Replay(ctx context.Context, c *CorpusCase, instruments *CorpusInstruments, fetchPrice PriceFetcher) (*ParsedTradeStrategy, error) {
+	originalBinanceInstruments, originalFTXInstruments, originalFetchLatestPrice := binanceInstruments, ftxInstruments, fetchLatestPrice
+	defer func() {
+		binanceInstruments, ftxInstruments, fetchLatestPrice = originalBinanceInstruments, originalFTXInstruments, originalFetchLatestPrice
+	}()
+
+	binanceInstruments, ftxInstruments = map[string]bool{}, map[string]bool{}
+	for _, instrument := range instruments.Binance {
+		binanceInstruments[strings.ToLower(instrument)] = true
+	}
+	for _, instrument := range instruments.FTX {
+		ftxInstruments[strings.ToLower(instrument)] = true
+	}
+	fetchLatestPrice = fetchPrice
+
+	return Parse(ctx, c.ChannelID, c.Content, &discordgo.MessageCreate{
+		Message: &discordgo.Message{
+			ID:        c.Name,
+			ChannelID: c.ChannelID,
+			Content:   c.Content,
+			Author: &discordgo.User{
+				ID:       c.AuthorID,
+				Username: c.Username,
+			},
+		},
+	}, tradeengineproto.ACTOR_TYPE_EXTERNAL)
+}
+
+// ReadCorpus reads every case of the corpus at the given path.
+func ReadCorpus(path string) ([]*CorpusCase, error) {
+	errParams := map[string]string{
+		"path": path,
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_corpus", errParams)
+	}
+	defer f.Close()
+
+	var cases []*CorpusCase
+	scanner := bufio.NewScanner(f)
+	// Messages can be long; so we allow lines of up to 1MB.
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		c := &CorpusCase{}
+		if err := json.Unmarshal(line, c); err != nil {
+			return nil, gerrors.Augment(err, "failed_to_read_corpus.bad_case", errParams)
+		}
+		cases = append(cases, c)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_corpus", errParams)
+	}
+
+	return cases, nil
+}
+
+// WriteCorpusCase writes the case as a single line of a corpus.
+func WriteCorpusCase(w io.Writer, c *CorpusCase) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_write_corpus_case", map[string]string{
+			"name": c.Name,
+		})
+	}
+
+	if _, err := w.Write(append(b, '\n')); err != nil {
+		return gerrors.Augment(err, "failed_to_write_corpus_case", nil)
+	}
+
+	return nil
+}
+
+// ReadCorpusInstruments reads the instruments of a corpus at the given path.
+func ReadCorpusInstruments(path string) (*CorpusInstruments, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_corpus_instruments", map[string]string{
+			"path": path,
+		})
+	}
+
+	instruments := &CorpusInstruments{}
+	if err := json.Unmarshal(b, instruments); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_corpus_instruments.bad_json", map[string]string{
+			"path": path,
+		})
+	}
+
+	return instruments, nil
+}
@@ -0,0 +1,94 @@
+package parser
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	corpusDir = "testdata/corpus"
+)
+
+// TestCorpus replays every historical message of the corpus through the parser; a failure here means a past signal
+// would now parse differently. If the change is intended, correct the expected trade strategy of the case; or delete
+// the case & export it again with `tools/parsercorpus`.
+func TestCorpus(t *testing.T) {
+	instruments, err := ReadCorpusInstruments(filepath.Join(corpusDir, "instruments.json"))
+	require.NoError(t, err)
+
+	paths, err := filepath.Glob(filepath.Join(corpusDir, "*.jsonl"))
+	require.NoError(t, err)
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		cases, err := ReadCorpus(path)
+		require.NoError(t, err)
+
+		for _, c := range cases {
+			c := c
+			t.Run(filepath.Base(path)+"/"+c.Name, func(t *testing.T) {
+				parsed, err := Replay(context.Background(), c, instruments, func(_ context.Context, _ string) (float64, error) {
+					return c.Price, nil
+				})
+
+				if c.ExpectedParser == "" {
+					var got string
+					if parsed != nil {
+						got = protojson.Format(parsed.TradeStrategy)
+					}
+					require.Error(t, err, "expected no trade strategy; got: %s", got)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, c.ExpectedParser, parsed.Parser)
+
+				actual, err := protojson.Marshal(parsed.TradeStrategy)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(c.ExpectedTradeStrategy), string(actual))
+			})
+		}
+	}
+}
+
+func TestCorpusCase_SetExpected(t *testing.T) {
+	withFakeInstruments(t, 31000)
+
+	c := &CorpusCase{
+		Name:      "grammar_round_trip",
+		ChannelID: "unregistered",
+		Content:   "LONG BTC entry 30000 sl 29000 tp 32000",
+	}
+
+	parsed, err := ParseSignal(context.Background(), c.Content, &discordgo.MessageCreate{
+		Message: &discordgo.Message{
+			Author: &discordgo.User{
+				Username: "satoshi",
+			},
+		},
+	}, tradeengineproto.ACTOR_TYPE_EXTERNAL)
+	require.NoError(t, err)
+
+	require.NoError(t, c.SetExpected(parsed))
+	assert.Equal(t, grammarParserName, c.ExpectedParser)
+
+	expected, err := c.Expected()
+	require.NoError(t, err)
+	assert.Equal(t, parsed.TradeStrategy.GetEntries(), expected.GetEntries())
+	assert.Equal(t, parsed.TradeStrategy.GetTradeSide(), expected.GetTradeSide())
+
+	require.NoError(t, c.SetExpected(nil))
+	assert.Empty(t, c.ExpectedParser)
+
+	expected, err = c.Expected()
+	require.NoError(t, err)
+	assert.Nil(t, expected)
+}
@@ -3,6 +3,7 @@ package parser
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -12,6 +13,10 @@ import (
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 )
 
+const (
+	dcaParserName = "dca"
+)
+
 var (
 	possibleStopLossMarks = []string{
 		"sl",
@@ -23,13 +28,16 @@ var (
 		"tp",
 		"target",
 	}
+
+	// takeProfitOrdinal matches the number of a take profit directly after its mark; i.e the `1` of `tp1: 52000`.
+	takeProfitOrdinal = regexp.MustCompile(`^\d\b`)
 )
 
 // DCAParser ...
 type DCAParser struct{}
 
 // Parse parses the content expecting a DCA order; if it cannot parse all neccesary information for a DCA order we fail.
-func (d *DCAParser) Parse(ctx context.Context, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*tradeengineproto.TradeStrategy, error) {
+func (d *DCAParser) Parse(ctx context.Context, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*ParsedTradeStrategy, error) {
 	instrumentType := parseInstrumentType(content)
 
 	ticker, venues := parseTickerAndVenues(content, instrumentType)
@@ -66,26 +74,40 @@ func (d *DCAParser) Parse(ctx context.Context, content string, m *discordgo.Mess
 
 		switch {
 		case takeProfitMark != "":
+			// Each take profit may have its own mark; i.e `tp1: 52000 tp2: 54000`.
 			takeProfitSplits := strings.Split(stopLossSplits[1], takeProfitMark)
 			stopLossContent = takeProfitSplits[0]
-			if len(takeProfitSplits) > 1 {
-				takeProfitContent = strings.ReplaceAll(takeProfitSplits[1], "-", "")
+			for _, tps := range takeProfitSplits[1:] {
+				takeProfitContent += " " + strings.ReplaceAll(takeProfitOrdinal.ReplaceAllString(tps, ""), "-", "")
 			}
 		default:
 			stopLossContent = stopLossSplits[1]
 		}
 	}
 
+	currentPrice, err := fetchLatestPrice(ctx, ticker)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_parse_dca", nil)
+	}
+
 	// Validate this is a DCA order; we do so by checking if we have `dca` in the content or we have at least
-	// two entries in the parsed entry content.
+	// two entries in the parsed entry content. Numbers far from the current price, i.e from a channel name, aren't
+	// entries.
 	switch {
 	case strings.Contains(entriesContent, "dca"):
 	default:
-		entries, err := parseNumbersFromContent(entriesContent)
+		possibleEntries, err := parseNumbersFromContent(entriesContent)
 		if err != nil {
 			return nil, gerrors.Augment(err, "failed_to_parse_dca.failed_to_parse_entries_from_content", nil)
 		}
 
+		var entries []float64
+		for _, entry := range possibleEntries {
+			if withinRange(entry, currentPrice, 50) {
+				entries = append(entries, entry)
+			}
+		}
+
 		if len(entries) < 2 {
 			return nil, gerrors.FailedPrecondition("failed_to_parse_dca.not_enough_entries", map[string]string{
 				"entries": entriesAsString(entries),
@@ -98,12 +120,7 @@ func (d *DCAParser) Parse(ctx context.Context, content string, m *discordgo.Mess
 		return nil, gerrors.Augment(err, "failed_to_parse_dca.failed_to_parse_values_from_content", nil)
 	}
 
-	side, _ := parseSide(content)
-
-	currentPrice, err := fetchLatestPrice(ctx, ticker)
-	if err != nil {
-		return nil, gerrors.Augment(err, "failed_to_parse_dca", nil)
-	}
+	side, sideIsExplicit := parseSide(content)
 
 	switch {
 	case side == tradeengineproto.TRADE_SIDE_LONG:
@@ -127,7 +144,7 @@ func (d *DCAParser) Parse(ctx context.Context, content string, m *discordgo.Mess
 		})
 	}
 
-	executionStrategy, _ := parseExecutionStrategy(content, currentPrice, entries, side)
+	executionStrategy, executionStrategyIsExplicit := parseExecutionStrategy(content, currentPrice, entries, side)
 
 	protoEntries := make([]float32, 0, len(entries))
 	for _, entry := range entries {
@@ -141,7 +158,11 @@ func (d *DCAParser) Parse(ctx context.Context, content string, m *discordgo.Mess
 
 	actor := parseActor(m.Author.Username)
 
-	return &tradeengineproto.TradeStrategy{
+	inferredFields := inferHeuristicFields(
+		content, sideIsExplicit, executionStrategyIsExplicit, len(possibleValues), entries, stopLoss, takeProfits,
+	)
+
+	return newParsedTradeStrategy(dcaParserName, &tradeengineproto.TradeStrategy{
 		ActorId:            m.Author.ID,
 		HumanizedActorName: actor,
 		ActorType:          actorType,
@@ -155,5 +176,5 @@ func (d *DCAParser) Parse(ctx context.Context, content string, m *discordgo.Mess
 		TakeProfits:        protoTakeProfits,
 		CurrentPrice:       float32(currentPrice),
 		TradeableVenues:    venues,
-	}, nil
+	}, inferredFields), nil
 }
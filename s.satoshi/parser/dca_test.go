@@ -196,7 +196,7 @@ bluntz/hfsp [crypto-scalp-trade-ideas-89]: trade idea scalp long btc/usd   scalp
 			switch {
 			case !tt.withErr:
 				require.NoError(t, err)
-				assert.Equal(t, tt.expectedTradeStrategy, trade)
+				assert.Equal(t, tt.expectedTradeStrategy, trade.TradeStrategy)
 			default:
 				require.Error(t, err)
 			}
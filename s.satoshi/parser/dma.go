@@ -11,6 +11,10 @@ import (
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 )
 
+const (
+	dmaParserName = "dma"
+)
+
 var (
 	// TODO: we need proper gRPC service testing.
 	fetchLatestPrice = getLatestPrice
@@ -21,7 +25,7 @@ type DMAParser struct{}
 
 // Parse attempts to parse some content into a `tradeengineproto.Trade`. If it fails it returns a `FailedPrecondition` gerror
 // that details why it was unable to.
-func (d *DMAParser) Parse(ctx context.Context, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*tradeengineproto.TradeStrategy, error) {
+func (d *DMAParser) Parse(ctx context.Context, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*ParsedTradeStrategy, error) {
 	// Parse instrument types.
 	instrumentType := parseInstrumentType(content)
 
@@ -44,7 +48,7 @@ func (d *DMAParser) Parse(ctx context.Context, content string, m *discordgo.Mess
 	}
 
 	// Parse side
-	side, _ := parseSide(content)
+	side, sideIsExplicit := parseSide(content)
 
 	switch {
 	case side == tradeengineproto.TRADE_SIDE_LONG:
@@ -78,7 +82,7 @@ func (d *DMAParser) Parse(ctx context.Context, content string, m *discordgo.Mess
 	}
 
 	// Parse execution strategy.
-	executionStrategy, _ := parseExecutionStrategy(content, currentPrice, entries, side)
+	executionStrategy, executionStrategyIsExplicit := parseExecutionStrategy(content, currentPrice, entries, side)
 
 	// Marshal.
 	protoEntries := make([]float32, 0, len(entries))
@@ -94,7 +98,11 @@ func (d *DMAParser) Parse(ctx context.Context, content string, m *discordgo.Mess
 	// Parse actor.
 	actor := parseActor(m.Author.Username)
 
-	return &tradeengineproto.TradeStrategy{
+	inferredFields := inferHeuristicFields(
+		content, sideIsExplicit, executionStrategyIsExplicit, len(possibleValues), entries, stopLoss, takeProfits,
+	)
+
+	return newParsedTradeStrategy(dmaParserName, &tradeengineproto.TradeStrategy{
 		ActorId:            m.Author.ID,
 		HumanizedActorName: actor,
 		ActorType:          actorType,
@@ -108,5 +116,5 @@ func (d *DMAParser) Parse(ctx context.Context, content string, m *discordgo.Mess
 		TakeProfits:        protoTakeProfits,
 		CurrentPrice:       float32(currentPrice),
 		TradeableVenues:    venues,
-	}, nil
+	}, inferredFields), nil
 }
@@ -354,7 +354,7 @@ func TestDMAParser(t *testing.T) {
 			switch {
 			case !tt.withErr:
 				require.NoError(t, err)
-				assert.Equal(t, tt.expectedTrade, trade)
+				assert.Equal(t, tt.expectedTrade, trade.TradeStrategy)
 			default:
 				require.Error(t, err)
 			}
@@ -0,0 +1,367 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"swallowtail/libraries/gerrors"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// The signal grammar; a message follows it if it starts with a side, followed by the asset & then labelled fields in
+// any order, case insensitive:
+//
+//	signal      = side asset field { field }
+//	side        = "long" | "short"
+//	asset       = ticker [ "usdt" | "/usdt" | "-perp" ]
+//	field       = entry | stop-loss | take-profit | venue | instrument | "limit"
+//	entry       = ( "entry" | "entries" ) price [ "-" price ]
+//	stop-loss   = ( "sl" | "stop" | "stoploss" ) price
+//	take-profit = ( "tp" | "tp1" | "tp2" | ... | "tps" ) price { "/" price }
+//	venue       = "venue" venue-name
+//	instrument  = "perp" | "perps" | "spot"
+//
+// E.g. `LONG BTC entry 30000-31000 sl 29000 tp 32000/33000 venue binance perp`. Labels may be followed by a colon;
+// entry, stop loss & take profit are required, the instrument defaults to perpetuals & the venues default to all those
+// the asset is tradeable on. Unlike the heuristic parsers, every field is taken as labelled; so a signal is either
+// parsed exactly as written or rejected with the offending field.
+
+const (
+	grammarParserName = "grammar"
+)
+
+var (
+	grammarSides = map[string]tradeengineproto.TRADE_SIDE{
+		"long":  tradeengineproto.TRADE_SIDE_LONG,
+		"short": tradeengineproto.TRADE_SIDE_SHORT,
+	}
+
+	// Mods like to space out ranges & take profits; e.g `30000 - 31000` or `32000 / 33000`.
+	spacedPriceRange     = regexp.MustCompile(`(\d)\s*-\s*(\d)`)
+	spacedPriceSeparator = regexp.MustCompile(`(\d)\s*/\s*(\d)`)
+	takeProfitLabel      = regexp.MustCompile(`^tp(s|\d*)$`)
+)
+
+// GrammarParser parses signals following the signal grammar.
+type GrammarParser struct{}
+
+// grammarSignal holds the fields of a signal as written; before any validation against the asset.
+type grammarSignal struct {
+	side        tradeengineproto.TRADE_SIDE
+	asset       string
+	entries     []float64
+	stopLoss    float64
+	takeProfits []float64
+	venue       string
+	instrument  tradeengineproto.INSTRUMENT_TYPE
+}
+
+// Parse parses the content as a signal; any field that is missing, malformed or inconsistent fails the parse. Every
+// field of a signal is labelled; so a successful parse infers nothing beyond the execution strategy.
+func (g *GrammarParser) Parse(ctx context.Context, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*ParsedTradeStrategy, error) {
+	if !isGrammarSignal(content) {
+		return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.not_a_signal", nil)
+	}
+
+	signal, err := parseGrammarSignal(content)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker, venues := parseTickerAndVenues(signal.asset, signal.instrument)
+	if ticker == "" {
+		return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.unknown_asset", map[string]string{
+			"asset":      signal.asset,
+			"instrument": signal.instrument.String(),
+		})
+	}
+
+	if signal.venue != "" {
+		venue, err := grammarVenue(signal.venue, venues)
+		if err != nil {
+			return nil, err
+		}
+		venues = []tradeengineproto.VENUE{venue}
+	}
+
+	currentPrice, err := fetchLatestPrice(ctx, ticker)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_parse_grammar", nil)
+	}
+
+	var inferredFields []string
+	executionStrategy, ok := parseExecutionStrategy(content, currentPrice, signal.entries, signal.side)
+	if !ok {
+		inferredFields = append(inferredFields, InferredFieldExecutionStrategy)
+	}
+
+	protoEntries := make([]float32, 0, len(signal.entries))
+	for _, entry := range signal.entries {
+		protoEntries = append(protoEntries, float32(entry))
+	}
+
+	protoTakeProfits := make([]float32, 0, len(signal.takeProfits))
+	for _, tp := range signal.takeProfits {
+		protoTakeProfits = append(protoTakeProfits, float32(tp))
+	}
+
+	return newParsedTradeStrategy(grammarParserName, &tradeengineproto.TradeStrategy{
+		ActorId:            m.Author.ID,
+		HumanizedActorName: parseActor(m.Author.Username),
+		ActorType:          actorType,
+		ExecutionStrategy:  executionStrategy,
+		InstrumentType:     signal.instrument,
+		TradeSide:          signal.side,
+		Asset:              strings.ToUpper(ticker),
+		Pair:               tradeengineproto.TRADE_PAIR_USDT,
+		Entries:            protoEntries,
+		StopLoss:           float32(signal.stopLoss),
+		TakeProfits:        protoTakeProfits,
+		CurrentPrice:       float32(currentPrice),
+		TradeableVenues:    venues,
+	}, inferredFields), nil
+}
+
+// isGrammarSignal checks if the content claims to follow the signal grammar; i.e it starts with a side & an asset
+// followed by a labelled field.
+func isGrammarSignal(content string) bool {
+	tokens := grammarTokens(content)
+	if len(tokens) < 3 {
+		return false
+	}
+
+	if _, ok := grammarSides[tokens[0]]; !ok {
+		return false
+	}
+
+	return isGrammarLabel(tokens[2])
+}
+
+func isGrammarLabel(token string) bool {
+	switch token {
+	case "entry", "entries", "sl", "stop", "stoploss", "venue", "perp", "perps", "spot", "limit":
+		return true
+	default:
+		return takeProfitLabel.MatchString(token)
+	}
+}
+
+func grammarTokens(content string) []string {
+	c := strings.ToLower(content)
+	c = spacedPriceRange.ReplaceAllString(c, "${1}-${2}")
+	c = spacedPriceSeparator.ReplaceAllString(c, "${1}/${2}")
+
+	var tokens []string
+	for _, token := range strings.Fields(c) {
+		token = strings.TrimSuffix(token, ":")
+		if token == "" {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+func parseGrammarSignal(content string) (*grammarSignal, error) {
+	tokens := grammarTokens(content)
+
+	signal := &grammarSignal{
+		side:       grammarSides[tokens[0]],
+		asset:      tokens[1],
+		instrument: tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+	}
+
+	var hasEntry, hasStopLoss bool
+	for i := 2; i < len(tokens); i++ {
+		label := tokens[i]
+
+		// Consume every price following the label.
+		var prices []string
+		for i+1 < len(tokens) && isPriceToken(tokens[i+1]) {
+			prices = append(prices, tokens[i+1])
+			i++
+		}
+
+		switch {
+		case label == "entry", label == "entries":
+			if hasEntry {
+				return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.duplicate_entry", nil)
+			}
+			hasEntry = true
+
+			entries, err := parseGrammarPrices("entry", prices, "-")
+			if err != nil {
+				return nil, err
+			}
+			if len(entries) > 2 {
+				return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.too_many_entries", map[string]string{
+					"entry": strings.Join(prices, " "),
+				})
+			}
+			signal.entries = entries
+		case label == "sl", label == "stop", label == "stoploss":
+			if hasStopLoss {
+				return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.duplicate_stop_loss", nil)
+			}
+			hasStopLoss = true
+
+			stopLosses, err := parseGrammarPrices("stop_loss", prices, "")
+			if err != nil {
+				return nil, err
+			}
+			if len(stopLosses) > 1 {
+				return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.too_many_stop_losses", map[string]string{
+					"stop_loss": strings.Join(prices, " "),
+				})
+			}
+			signal.stopLoss = stopLosses[0]
+		case takeProfitLabel.MatchString(label):
+			takeProfits, err := parseGrammarPrices("take_profit", prices, "/")
+			if err != nil {
+				return nil, err
+			}
+			signal.takeProfits = append(signal.takeProfits, takeProfits...)
+		case len(prices) > 0:
+			// Only labels that take prices may be followed by them.
+			return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.unexpected_price", map[string]string{
+				"after": label,
+				"price": prices[0],
+			})
+		case label == "venue":
+			if i+1 >= len(tokens) {
+				return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.missing_venue", nil)
+			}
+			i++
+			signal.venue = tokens[i]
+		case label == "perp", label == "perps":
+			signal.instrument = tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL
+		case label == "spot":
+			signal.instrument = tradeengineproto.INSTRUMENT_TYPE_SPOT
+		case label == "limit":
+			// Handled when parsing the execution strategy.
+		default:
+			return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.unexpected_token", map[string]string{
+				"token": label,
+			})
+		}
+	}
+
+	switch {
+	case len(signal.entries) == 0:
+		return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.missing_entry", nil)
+	case !hasStopLoss:
+		return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.missing_stop_loss", nil)
+	case len(signal.takeProfits) == 0:
+		return nil, gerrors.FailedPrecondition("failed_to_parse_grammar.missing_take_profits", nil)
+	}
+
+	if err := validateGrammarSignal(signal); err != nil {
+		return nil, err
+	}
+
+	return signal, nil
+}
+
+// parseGrammarPrices parses the prices of a field; each price token may hold several prices joined by the separator.
+func parseGrammarPrices(field string, tokens []string, separator string) ([]float64, error) {
+	if len(tokens) == 0 {
+		return nil, gerrors.FailedPrecondition(fmt.Sprintf("failed_to_parse_grammar.missing_%s", field), nil)
+	}
+
+	var prices []float64
+	for _, token := range tokens {
+		values := []string{token}
+		if separator != "" {
+			values = strings.Split(token, separator)
+		}
+
+		for _, v := range values {
+			price, err := strconv.ParseFloat(v, 64)
+			if err != nil || price <= 0 {
+				return nil, gerrors.FailedPrecondition(fmt.Sprintf("failed_to_parse_grammar.invalid_%s", field), map[string]string{
+					field: token,
+				})
+			}
+			prices = append(prices, price)
+		}
+	}
+
+	return prices, nil
+}
+
+// validateGrammarSignal checks the stop loss & take profits sit on the correct side of the entries, ordering the entries
+// & take profits as the heuristic parsers do; ascending for longs & descending for shorts.
+func validateGrammarSignal(signal *grammarSignal) error {
+	isLong := signal.side == tradeengineproto.TRADE_SIDE_LONG
+
+	for _, prices := range [][]float64{signal.entries, signal.takeProfits} {
+		sort.Slice(prices, func(i, j int) bool {
+			if isLong {
+				return prices[i] < prices[j]
+			}
+			return prices[i] > prices[j]
+		})
+	}
+
+	var (
+		// The entry closest to the stop loss & furthest from the take profits.
+		worstEntry = signal.entries[0]
+		bestEntry  = signal.entries[len(signal.entries)-1]
+	)
+
+	errParams := map[string]string{
+		"side":      signal.side.String(),
+		"entry":     entriesAsString(signal.entries),
+		"stop_loss": fmt.Sprintf("%v", signal.stopLoss),
+	}
+
+	if (isLong && signal.stopLoss >= worstEntry) || (!isLong && signal.stopLoss <= worstEntry) {
+		return gerrors.FailedPrecondition("failed_to_parse_grammar.stop_loss_on_wrong_side_of_entry", errParams)
+	}
+
+	for _, tp := range signal.takeProfits {
+		if (isLong && tp <= bestEntry) || (!isLong && tp >= bestEntry) {
+			errParams["take_profit"] = fmt.Sprintf("%v", tp)
+			return gerrors.FailedPrecondition("failed_to_parse_grammar.take_profit_on_wrong_side_of_entry", errParams)
+		}
+	}
+
+	return nil
+}
+
+// grammarVenue resolves the named venue; it must be one the asset is tradeable on.
+func grammarVenue(name string, tradeableVenues []tradeengineproto.VENUE) (tradeengineproto.VENUE, error) {
+	errParams := map[string]string{
+		"venue": name,
+	}
+
+	v, ok := tradeengineproto.VENUE_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, gerrors.FailedPrecondition("failed_to_parse_grammar.invalid_venue", errParams)
+	}
+
+	venue := tradeengineproto.VENUE(v)
+	for _, tv := range tradeableVenues {
+		if tv == venue {
+			return venue, nil
+		}
+	}
+
+	return 0, gerrors.FailedPrecondition("failed_to_parse_grammar.asset_not_tradeable_on_venue", errParams)
+}
+
+func isPriceToken(token string) bool {
+	if token == "" {
+		return false
+	}
+
+	c := token[0]
+	return (c >= '0' && c <= '9') || c == '.'
+}
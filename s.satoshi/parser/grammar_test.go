@@ -0,0 +1,207 @@
+package parser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	discordproto "swallowtail/s.discord/proto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func withFakeInstruments(t *testing.T, currentValue float64) {
+	originalBinanceInstruments, originalFTXInstruments, originalFetcher := binanceInstruments, ftxInstruments, fetchLatestPrice
+	t.Cleanup(func() {
+		binanceInstruments, ftxInstruments, fetchLatestPrice = originalBinanceInstruments, originalFTXInstruments, originalFetcher
+	})
+
+	binanceInstruments = map[string]bool{
+		"btc": true,
+		"eth": true,
+	}
+	ftxInstruments = map[string]bool{
+		"btc-perp": true,
+	}
+	fetchLatestPrice = func(_ context.Context, _ string) (float64, error) {
+		return currentValue, nil
+	}
+}
+
+func TestGrammarParser(t *testing.T) {
+	tests := []struct {
+		name                  string
+		content               string
+		currentValue          float64
+		expectedTradeStrategy *tradeengineproto.TradeStrategy
+		expectedErr           string
+	}{
+		{
+			name:         "full_signal",
+			content:      `LONG BTC entry 30000-31000 sl 29000 tp 32000/33000 venue binance perp`,
+			currentValue: 31000,
+			expectedTradeStrategy: &tradeengineproto.TradeStrategy{
+				HumanizedActorName: "ELI ",
+				ActorType:          tradeengineproto.ACTOR_TYPE_EXTERNAL,
+				ExecutionStrategy:  tradeengineproto.EXECUTION_STRATEGY_DCA_FIRST_MARKET_REST_LIMIT,
+				InstrumentType:     tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+				TradeSide:          tradeengineproto.TRADE_SIDE_LONG,
+				Asset:              "BTC",
+				Pair:               tradeengineproto.TRADE_PAIR_USDT,
+				Entries:            []float32{30000, 31000},
+				StopLoss:           29000,
+				TakeProfits:        []float32{32000, 33000},
+				CurrentPrice:       31000,
+				TradeableVenues:    []tradeengineproto.VENUE{tradeengineproto.VENUE_BINANCE},
+			},
+		},
+		{
+			name: "labels_in_any_order_with_colons_and_spacing",
+			content: `short btc
+			tp1: 28000
+			tp2: 27000
+			sl: 31500
+			entry: 30500 - 30000
+			limit`,
+			currentValue: 29500,
+			expectedTradeStrategy: &tradeengineproto.TradeStrategy{
+				HumanizedActorName: "ELI ",
+				ActorType:          tradeengineproto.ACTOR_TYPE_EXTERNAL,
+				ExecutionStrategy:  tradeengineproto.EXECUTION_STRATEGY_DCA_ALL_LIMIT,
+				InstrumentType:     tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+				TradeSide:          tradeengineproto.TRADE_SIDE_SHORT,
+				Asset:              "BTC",
+				Pair:               tradeengineproto.TRADE_PAIR_USDT,
+				Entries:            []float32{30500, 30000},
+				StopLoss:           31500,
+				TakeProfits:        []float32{28000, 27000},
+				CurrentPrice:       29500,
+				TradeableVenues:    []tradeengineproto.VENUE{tradeengineproto.VENUE_BINANCE, tradeengineproto.VENUE_FTX},
+			},
+		},
+		{
+			name:         "single_entry_spot",
+			content:      `long eth entry 2000 stop 1900 tp 2100 spot`,
+			currentValue: 2000,
+			expectedTradeStrategy: &tradeengineproto.TradeStrategy{
+				HumanizedActorName: "ELI ",
+				ActorType:          tradeengineproto.ACTOR_TYPE_EXTERNAL,
+				ExecutionStrategy:  tradeengineproto.EXECUTION_STRATEGY_DMA_MARKET,
+				InstrumentType:     tradeengineproto.INSTRUMENT_TYPE_SPOT,
+				TradeSide:          tradeengineproto.TRADE_SIDE_LONG,
+				Asset:              "ETH",
+				Pair:               tradeengineproto.TRADE_PAIR_USDT,
+				Entries:            []float32{2000},
+				StopLoss:           1900,
+				TakeProfits:        []float32{2100},
+				CurrentPrice:       2000,
+				TradeableVenues:    []tradeengineproto.VENUE{tradeengineproto.VENUE_BINANCE},
+			},
+		},
+		{
+			name:        "not_a_signal",
+			content:     `Hey guys I'm LONG BTC here. ENTRY: 51000-50000 STOP: 49000`,
+			expectedErr: "failed_to_parse_grammar.not_a_signal",
+		},
+		{
+			name:        "missing_stop_loss",
+			content:     `long btc entry 30000 tp 32000`,
+			expectedErr: "failed_to_parse_grammar.missing_stop_loss",
+		},
+		{
+			name:        "stop_loss_without_price",
+			content:     `long btc entry 30000 sl tp 32000`,
+			expectedErr: "failed_to_parse_grammar.missing_stop_loss",
+		},
+		{
+			name:        "invalid_stop_loss",
+			content:     `long btc entry 30000 sl 29000-28000 tp 32000`,
+			expectedErr: "failed_to_parse_grammar.invalid_stop_loss",
+		},
+		{
+			name:        "too_many_stop_losses",
+			content:     `long btc entry 30000 sl 29000 28000 tp 32000`,
+			expectedErr: "failed_to_parse_grammar.too_many_stop_losses",
+		},
+		{
+			name:        "stop_loss_above_long_entry",
+			content:     `long btc entry 30000-31000 sl 30500 tp 32000`,
+			expectedErr: "failed_to_parse_grammar.stop_loss_on_wrong_side_of_entry",
+		},
+		{
+			name:        "take_profit_above_short_entry",
+			content:     `short btc entry 30000 sl 31000 tp 29000/30500`,
+			expectedErr: "failed_to_parse_grammar.take_profit_on_wrong_side_of_entry",
+		},
+		{
+			name:        "too_many_entries",
+			content:     `long btc entry 30000-31000 30500 sl 29000 tp 32000`,
+			expectedErr: "failed_to_parse_grammar.too_many_entries",
+		},
+		{
+			name:        "unknown_asset",
+			content:     `long doge entry 0.1 sl 0.09 tp 0.2`,
+			expectedErr: "failed_to_parse_grammar.unknown_asset",
+		},
+		{
+			name:        "asset_not_tradeable_on_venue",
+			content:     `long eth entry 2000 sl 1900 tp 2100 venue ftx`,
+			expectedErr: "failed_to_parse_grammar.asset_not_tradeable_on_venue",
+		},
+		{
+			name:        "invalid_venue",
+			content:     `long btc entry 30000 sl 29000 tp 32000 venue kraken`,
+			expectedErr: "failed_to_parse_grammar.invalid_venue",
+		},
+		{
+			name:        "unexpected_token",
+			content:     `long btc entry 30000 sl 29000 tp 32000 moon`,
+			expectedErr: "failed_to_parse_grammar.unexpected_token",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			withFakeInstruments(t, tt.currentValue)
+
+			trade, err := (&GrammarParser{}).Parse(context.Background(), cleanContent(tt.content), &discordgo.MessageCreate{
+				Message: &discordgo.Message{
+					Author: &discordgo.User{
+						Username: "Eli [Trades]",
+					},
+				},
+			}, tradeengineproto.ACTOR_TYPE_EXTERNAL)
+
+			if tt.expectedErr != "" {
+				gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTradeStrategy, trade.TradeStrategy)
+			assert.False(t, trade.RequiresReview())
+		})
+	}
+}
+
+func TestParse_GrammarSignalsAreNotParsedHeuristically(t *testing.T) {
+	withFakeInstruments(t, 31000)
+
+	m := &discordgo.MessageCreate{
+		Message: &discordgo.Message{
+			Author: &discordgo.User{},
+		},
+	}
+
+	// The heuristic parsers would happily take the take profit for the stop loss here.
+	_, err := Parse(context.Background(), discordproto.DiscordMoonModMessagesChannel, `long btc entry 30000-31000 sl 32000 tp 29000`, m, tradeengineproto.ACTOR_TYPE_EXTERNAL)
+	gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, "failed_to_parse_grammar.stop_loss_on_wrong_side_of_entry")
+
+	trade, err := Parse(context.Background(), discordproto.DiscordMoonModMessagesChannel, `long btc entry 30000-31000 sl 29000 tp 32000`, m, tradeengineproto.ACTOR_TYPE_EXTERNAL)
+	require.NoError(t, err)
+	assert.Equal(t, float32(29000), trade.TradeStrategy.StopLoss)
+}
@@ -104,11 +104,18 @@ func parseTickerAndVenues(content string, instrumentType tradeengineproto.INSTRU
 			t = strings.ReplaceAll(t, "perp", "")
 			t = strings.ReplaceAll(t, "-", "")
 
-			return parseTickerAndVenues(t, instrumentType)
+			if ticker, venues := parseTickerAndVenues(t, instrumentType); ticker != "" {
+				return ticker, venues
+			}
+			continue
 		case strings.Contains(token, "/"):
-			// Some mods format their trades as `BTC/USDT`.
+			// Some mods format their trades as `BTC/USDT`; others sign them as `bluntz/hfsp`, so we keep looking if
+			// it's not a ticker.
 			s := strings.Split(token, "/")
-			return parseTickerAndVenues(s[0], instrumentType)
+			if ticker, venues := parseTickerAndVenues(s[0], instrumentType); ticker != "" {
+				return ticker, venues
+			}
+			continue
 		}
 
 		// Check if token matches a tradeable instrument across all exchanges.
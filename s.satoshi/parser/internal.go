@@ -6,6 +6,7 @@ import (
 
 func init() {
 	register(discordproto.DiscordSatoshiInternalCallsChannel, []TradeParser{
+		&GrammarParser{},
 		&DCAParser{},
 		&DMAParser{},
 	})
@@ -22,7 +22,7 @@ var (
 
 // TradeParser ...
 type TradeParser interface {
-	Parse(ctx context.Context, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*tradeengineproto.TradeStrategy, error)
+	Parse(ctx context.Context, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*ParsedTradeStrategy, error)
 }
 
 // Init initializes the parser; we do this since we need to pull all the latest assets that are tradable.
@@ -80,8 +80,9 @@ func Init(ctx context.Context) error {
 	return nil
 }
 
-// Parse ...
-func Parse(ctx context.Context, identifier, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*tradeengineproto.TradeStrategy, error) {
+// Parse parses the content with the parsers registered for the identifier, in order; returning the first trade strategy
+// parsed along with the confidence of the parser in it.
+func Parse(ctx context.Context, identifier, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*ParsedTradeStrategy, error) {
 	parsers, ok := getParsersByIdentifier(identifier)
 	if !ok {
 		return nil, gerrors.FailedPrecondition("failed_to_parse.parser_does_not_exist", nil)
@@ -94,6 +95,12 @@ func Parse(ctx context.Context, identifier, content string, m *discordgo.Message
 		trade, err := parser.Parse(ctx, cleanedContent, m, actorType)
 		if err != nil {
 			slog.Error(ctx, "Failed to parse trade: %v %v", err, cleanedContent)
+
+			// Content following the signal grammar is never handed to the heuristic parsers; they may mis-parse it.
+			if _, ok := parser.(*GrammarParser); ok && isGrammarSignal(cleanedContent) {
+				return nil, gerrors.Augment(err, "failed_to_parse_signal", nil)
+			}
+
 			mErr = multierror.Append(mErr, err)
 			continue
 		}
@@ -146,3 +153,9 @@ func cleanContent(content string) string {
 	updates := strings.SplitAfter(c, "---new---")
 	return updates[len(updates)-1]
 }
+
+// ParseSignal parses content following the signal grammar, regardless of the channel it was posted in; e.g when an
+// admin corrects a trade strategy drafted from a mod's message.
+func ParseSignal(ctx context.Context, content string, m *discordgo.MessageCreate, actorType tradeengineproto.ACTOR_TYPE) (*ParsedTradeStrategy, error) {
+	return (&GrammarParser{}).Parse(ctx, cleanContent(content), m, actorType)
+}
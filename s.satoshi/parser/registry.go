@@ -32,3 +32,9 @@ func getParsersByIdentifier(identifier string) ([]TradeParser, bool) {
 	p, ok := registry[identifier]
 	return p, ok
 }
+
+// IsRegistered returns true if parsers are registered for the identifier.
+func IsRegistered(identifier string) bool {
+	_, ok := getParsersByIdentifier(identifier)
+	return ok
+}
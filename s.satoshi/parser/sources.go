@@ -0,0 +1,24 @@
+package parser
+
+const (
+	// SourceParsersGrammar only parses signals following the signal grammar; for sources posting generated signals,
+	// e.g TradingView alerts.
+	SourceParsersGrammar = "source-grammar"
+	// SourceParsersHeuristic parses signals following the signal grammar, falling back to the heuristic parsers; for
+	// sources where callers post by hand.
+	SourceParsersHeuristic = "source-heuristic"
+)
+
+// Sources outside of discord, i.e webhooks & telegram channels, have no identifier known ahead of time; so each is mapped
+// to one of the below by configuration instead.
+func init() {
+	register(SourceParsersGrammar, []TradeParser{
+		&GrammarParser{},
+	})
+
+	register(SourceParsersHeuristic, []TradeParser{
+		&GrammarParser{},
+		&DCAParser{},
+		&DMAParser{},
+	})
+}
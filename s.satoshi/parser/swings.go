@@ -6,6 +6,7 @@ import (
 
 func init() {
 	register(discordproto.DiscordMoonSwingGroupChannel, []TradeParser{
+		&GrammarParser{},
 		&DCAParser{},
 		&DMAParser{},
 	})
@@ -6,6 +6,7 @@ import (
 
 func init() {
 	register(discordproto.DiscordMoonModMessagesChannel, []TradeParser{
+		&GrammarParser{},
 		&DCAParser{},
 		&DMAParser{},
 	})
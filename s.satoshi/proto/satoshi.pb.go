@@ -1,13 +1,12 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
-// 	protoc        v3.17.3
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.2
 // source: s.satoshi/proto/satoshi.proto
 
 package satoshiproto
 
 import (
-	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,10 +20,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type PublishStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -235,6 +230,99 @@ func (*PollTradeStrategyParticipantsResponse) Descriptor() ([]byte, []int) {
 	return file_s_satoshi_proto_satoshi_proto_rawDescGZIP(), []int{3}
 }
 
+type PublishPnLDigestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PublishPnLDigestsRequest) Reset() {
+	*x = PublishPnLDigestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_satoshi_proto_satoshi_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishPnLDigestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPnLDigestsRequest) ProtoMessage() {}
+
+func (x *PublishPnLDigestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_satoshi_proto_satoshi_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPnLDigestsRequest.ProtoReflect.Descriptor instead.
+func (*PublishPnLDigestsRequest) Descriptor() ([]byte, []int) {
+	return file_s_satoshi_proto_satoshi_proto_rawDescGZIP(), []int{4}
+}
+
+type PublishPnLDigestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumberOfDigestsSent   int64 `protobuf:"varint,1,opt,name=number_of_digests_sent,json=numberOfDigestsSent,proto3" json:"number_of_digests_sent,omitempty"`
+	NumberOfDigestsFailed int64 `protobuf:"varint,2,opt,name=number_of_digests_failed,json=numberOfDigestsFailed,proto3" json:"number_of_digests_failed,omitempty"`
+}
+
+func (x *PublishPnLDigestsResponse) Reset() {
+	*x = PublishPnLDigestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_satoshi_proto_satoshi_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishPnLDigestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPnLDigestsResponse) ProtoMessage() {}
+
+func (x *PublishPnLDigestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_satoshi_proto_satoshi_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPnLDigestsResponse.ProtoReflect.Descriptor instead.
+func (*PublishPnLDigestsResponse) Descriptor() ([]byte, []int) {
+	return file_s_satoshi_proto_satoshi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PublishPnLDigestsResponse) GetNumberOfDigestsSent() int64 {
+	if x != nil {
+		return x.NumberOfDigestsSent
+	}
+	return 0
+}
+
+func (x *PublishPnLDigestsResponse) GetNumberOfDigestsFailed() int64 {
+	if x != nil {
+		return x.NumberOfDigestsFailed
+	}
+	return 0
+}
+
 var File_s_satoshi_proto_satoshi_proto protoreflect.FileDescriptor
 
 var file_s_satoshi_proto_satoshi_proto_rawDesc = []byte{
@@ -262,20 +350,36 @@ var file_s_satoshi_proto_satoshi_proto_rawDesc = []byte{
 	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x27, 0x0a, 0x25,
 	0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
 	0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbd, 0x01, 0x0a, 0x07, 0x73, 0x61, 0x74, 0x6f, 0x73, 0x68,
-	0x69, 0x12, 0x40, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x15, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x1d, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
-	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x50, 0x6f,
-	0x6c, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x11, 0x5a, 0x0f, 0x2e, 0x2f, 0x3b, 0x73, 0x61, 0x74, 0x6f,
-	0x73, 0x68, 0x69, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x50, 0x6e, 0x4c, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x89, 0x01, 0x0a, 0x19, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6e, 0x4c,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73,
+	0x53, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f,
+	0x66, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
+	0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x32, 0x8b, 0x02,
+	0x0a, 0x07, 0x73, 0x61, 0x74, 0x6f, 0x73, 0x68, 0x69, 0x12, 0x40, 0x0a, 0x0d, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x1d, 0x50,
+	0x6f, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x50,
+	0x6f, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6e, 0x4c, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x19, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6e, 0x4c, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6e, 0x4c, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x11, 0x5a, 0x0f, 0x2e,
+	0x2f, 0x3b, 0x73, 0x61, 0x74, 0x6f, 0x73, 0x68, 0x69, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -290,20 +394,24 @@ func file_s_satoshi_proto_satoshi_proto_rawDescGZIP() []byte {
 	return file_s_satoshi_proto_satoshi_proto_rawDescData
 }
 
-var file_s_satoshi_proto_satoshi_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_s_satoshi_proto_satoshi_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_s_satoshi_proto_satoshi_proto_goTypes = []interface{}{
 	(*PublishStatusRequest)(nil),                  // 0: PublishStatusRequest
 	(*PublishStatusResponse)(nil),                 // 1: PublishStatusResponse
 	(*PollTradeStrategyParticipantsRequest)(nil),  // 2: PollTradeStrategyParticipantsRequest
 	(*PollTradeStrategyParticipantsResponse)(nil), // 3: PollTradeStrategyParticipantsResponse
+	(*PublishPnLDigestsRequest)(nil),              // 4: PublishPnLDigestsRequest
+	(*PublishPnLDigestsResponse)(nil),             // 5: PublishPnLDigestsResponse
 }
 var file_s_satoshi_proto_satoshi_proto_depIdxs = []int32{
 	0, // 0: satoshi.PublishStatus:input_type -> PublishStatusRequest
 	2, // 1: satoshi.PollTradeStrategyParticipants:input_type -> PollTradeStrategyParticipantsRequest
-	1, // 2: satoshi.PublishStatus:output_type -> PublishStatusResponse
-	3, // 3: satoshi.PollTradeStrategyParticipants:output_type -> PollTradeStrategyParticipantsResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	4, // 2: satoshi.PublishPnLDigests:input_type -> PublishPnLDigestsRequest
+	1, // 3: satoshi.PublishStatus:output_type -> PublishStatusResponse
+	3, // 4: satoshi.PollTradeStrategyParticipants:output_type -> PollTradeStrategyParticipantsResponse
+	5, // 5: satoshi.PublishPnLDigests:output_type -> PublishPnLDigestsResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -363,6 +471,30 @@ func file_s_satoshi_proto_satoshi_proto_init() {
 				return nil
 			}
 		}
+		file_s_satoshi_proto_satoshi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishPnLDigestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_satoshi_proto_satoshi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishPnLDigestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -370,7 +502,7 @@ func file_s_satoshi_proto_satoshi_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_s_satoshi_proto_satoshi_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
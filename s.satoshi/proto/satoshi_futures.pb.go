@@ -76,3 +76,76 @@ func (r *PollTradeStrategyParticipantsRequest) SendWithTimeout(ctx context.Conte
 		resultc: resultc,
 	}
 }
+
+// --- Publish PnL Digests --- //
+
+type PublishPnLDigestsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *PublishPnLDigestsResponse
+	ctx     context.Context
+}
+
+func (a *PublishPnLDigestsFuture) Response() (*PublishPnLDigestsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "publish_pnl_digests", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *PublishPnLDigestsRequest) Send(ctx context.Context) *PublishPnLDigestsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *PublishPnLDigestsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *PublishPnLDigestsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *PublishPnLDigestsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-satoshi:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- gerrors.Augment(err, "swallowtail_s_satoshi_connection_failed", nil)
+		return &PublishPnLDigestsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewSatoshiClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.PublishPnLDigests(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_publish_pnl_digests", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &PublishPnLDigestsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
@@ -11,7 +11,8 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
 
 // SatoshiClient is the client API for Satoshi service.
 //
@@ -19,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion6
 type SatoshiClient interface {
 	PublishStatus(ctx context.Context, in *PublishStatusRequest, opts ...grpc.CallOption) (*PublishStatusResponse, error)
 	PollTradeStrategyParticipants(ctx context.Context, in *PollTradeStrategyParticipantsRequest, opts ...grpc.CallOption) (*PollTradeStrategyParticipantsResponse, error)
+	PublishPnLDigests(ctx context.Context, in *PublishPnLDigestsRequest, opts ...grpc.CallOption) (*PublishPnLDigestsResponse, error)
 }
 
 type satoshiClient struct {
@@ -47,12 +49,22 @@ func (c *satoshiClient) PollTradeStrategyParticipants(ctx context.Context, in *P
 	return out, nil
 }
 
+func (c *satoshiClient) PublishPnLDigests(ctx context.Context, in *PublishPnLDigestsRequest, opts ...grpc.CallOption) (*PublishPnLDigestsResponse, error) {
+	out := new(PublishPnLDigestsResponse)
+	err := c.cc.Invoke(ctx, "/satoshi/PublishPnLDigests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SatoshiServer is the server API for Satoshi service.
 // All implementations must embed UnimplementedSatoshiServer
 // for forward compatibility
 type SatoshiServer interface {
 	PublishStatus(context.Context, *PublishStatusRequest) (*PublishStatusResponse, error)
 	PollTradeStrategyParticipants(context.Context, *PollTradeStrategyParticipantsRequest) (*PollTradeStrategyParticipantsResponse, error)
+	PublishPnLDigests(context.Context, *PublishPnLDigestsRequest) (*PublishPnLDigestsResponse, error)
 	mustEmbedUnimplementedSatoshiServer()
 }
 
@@ -60,16 +72,26 @@ type SatoshiServer interface {
 type UnimplementedSatoshiServer struct {
 }
 
-func (*UnimplementedSatoshiServer) PublishStatus(context.Context, *PublishStatusRequest) (*PublishStatusResponse, error) {
+func (UnimplementedSatoshiServer) PublishStatus(context.Context, *PublishStatusRequest) (*PublishStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishStatus not implemented")
 }
-func (*UnimplementedSatoshiServer) PollTradeStrategyParticipants(context.Context, *PollTradeStrategyParticipantsRequest) (*PollTradeStrategyParticipantsResponse, error) {
+func (UnimplementedSatoshiServer) PollTradeStrategyParticipants(context.Context, *PollTradeStrategyParticipantsRequest) (*PollTradeStrategyParticipantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PollTradeStrategyParticipants not implemented")
 }
-func (*UnimplementedSatoshiServer) mustEmbedUnimplementedSatoshiServer() {}
+func (UnimplementedSatoshiServer) PublishPnLDigests(context.Context, *PublishPnLDigestsRequest) (*PublishPnLDigestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPnLDigests not implemented")
+}
+func (UnimplementedSatoshiServer) mustEmbedUnimplementedSatoshiServer() {}
 
-func RegisterSatoshiServer(s *grpc.Server, srv SatoshiServer) {
-	s.RegisterService(&_Satoshi_serviceDesc, srv)
+// UnsafeSatoshiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SatoshiServer will
+// result in compilation errors.
+type UnsafeSatoshiServer interface {
+	mustEmbedUnimplementedSatoshiServer()
+}
+
+func RegisterSatoshiServer(s grpc.ServiceRegistrar, srv SatoshiServer) {
+	s.RegisterService(&Satoshi_ServiceDesc, srv)
 }
 
 func _Satoshi_PublishStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -108,7 +130,28 @@ func _Satoshi_PollTradeStrategyParticipants_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Satoshi_serviceDesc = grpc.ServiceDesc{
+func _Satoshi_PublishPnLDigests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishPnLDigestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatoshiServer).PublishPnLDigests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satoshi/PublishPnLDigests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatoshiServer).PublishPnLDigests(ctx, req.(*PublishPnLDigestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Satoshi_ServiceDesc is the grpc.ServiceDesc for Satoshi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Satoshi_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "satoshi",
 	HandlerType: (*SatoshiServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -120,6 +163,10 @@ var _Satoshi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PollTradeStrategyParticipants",
 			Handler:    _Satoshi_PollTradeStrategyParticipants_Handler,
 		},
+		{
+			MethodName: "PublishPnLDigests",
+			Handler:    _Satoshi_PublishPnLDigests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "s.satoshi/proto/satoshi.proto",
@@ -0,0 +1,341 @@
+package satoshi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/util"
+	"swallowtail/s.satoshi/consumers"
+	"swallowtail/s.satoshi/dao"
+	"swallowtail/s.satoshi/domain"
+)
+
+const (
+	outboxPollInterval  = time.Second
+	outboxBatchSize     = 25
+	outboxPruneInterval = time.Hour
+
+	// outboxMaxAttempts is the number of attempts to deliver a message before it's dead lettered; with the backoff below
+	// that's roughly four minutes of failures.
+	outboxMaxAttempts = 8
+	outboxBaseBackoff = 2 * time.Second
+	outboxMaxBackoff  = 5 * time.Minute
+
+	// outboxRetention is how long delivered messages are kept; so duplicates are caught for at least as long.
+	outboxRetention = 7 * 24 * time.Hour
+)
+
+// The below are package variables so the outbox can be faked in tests.
+var (
+	enqueueOutboxMessage         = dao.EnqueueOutboxMessage
+	listDueOutboxMessages        = dao.ListDueOutboxMessages
+	markOutboxMessageDelivered   = dao.MarkOutboxMessageDelivered
+	retryOutboxMessage           = dao.RetryOutboxMessage
+	deadLetterOutboxMessage      = dao.DeadLetterOutboxMessage
+	pruneDeliveredOutboxMessages = dao.PruneDeliveredOutboxMessages
+	restorePoller                = consumers.RestorePoller
+)
+
+// enqueue persists the message to the outbox to be delivered at least once; messages with an idempotency key already
+// enqueued are dropped. If the outbox can't be reached we fall back to delivering the message straight away.
+func (s *satoshi) enqueue(ctx context.Context, e *consumers.ConsumerMessage) {
+	m, err := toOutboxMessage(e)
+	if err != nil {
+		slog.Error(ctx, "Failed to convert consumer message for the outbox: %v, Error: %v", e.ConsumerID, err)
+		return
+	}
+
+	// Pollers can't be persisted; so they're held in memory until the message is delivered. The poller of a duplicate
+	// never replaces that of the message already enqueued.
+	var ownsPoller bool
+	if e.Poller != nil {
+		ownsPoller = s.setPoller(m.IdempotencyKey, e.Poller)
+	}
+
+	enqueued, err := enqueueOutboxMessage(ctx, m)
+	switch {
+	case err != nil:
+		slog.Error(ctx, "Failed to enqueue message to the outbox; delivering directly: %v, Error: %v", e.ConsumerID, err)
+		if ownsPoller {
+			s.takePoller(m.IdempotencyKey)
+		}
+		s.deliverDirectly(ctx, e)
+		return
+	case !enqueued:
+		slog.Info(ctx, "Dropping duplicate consumer message: %s, idempotency key: %s", e.ConsumerID, m.IdempotencyKey)
+		if ownsPoller {
+			s.takePoller(m.IdempotencyKey)
+		}
+		return
+	}
+
+	// Wake the dispatcher rather than wait for the next poll.
+	select {
+	case s.outboxNotify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *satoshi) runOutbox(ctx context.Context) {
+	t := time.NewTicker(outboxPollInterval)
+	defer t.Stop()
+
+	p := time.NewTicker(outboxPruneInterval)
+	defer p.Stop()
+
+	for {
+		select {
+		case <-t.C:
+		case <-s.outboxNotify:
+		case <-p.C:
+			if err := pruneDeliveredOutboxMessages(ctx, time.Now().UTC().Add(-outboxRetention)); err != nil {
+				slog.Error(ctx, "Failed to prune delivered outbox messages: %v", err)
+			}
+			continue
+		case <-ctx.Done():
+			return
+		}
+
+		if err := s.deliverDueOutboxMessages(ctx, time.Now().UTC()); err != nil {
+			slog.Error(ctx, "Failed to deliver outbox messages: %v", err)
+		}
+	}
+}
+
+// deliverDueOutboxMessages delivers every message due by now, oldest first; until none are left.
+func (s *satoshi) deliverDueOutboxMessages(ctx context.Context, now time.Time) error {
+	for {
+		messages, err := listDueOutboxMessages(ctx, now, outboxBatchSize)
+		if err != nil {
+			return gerrors.Augment(err, "failed_to_list_due_outbox_messages", nil)
+		}
+
+		for _, m := range messages {
+			if err := s.deliver(ctx, m, now); err != nil {
+				slog.Error(ctx, "Failed to deliver outbox message: %s, Error: %v", m.OutboxID, err)
+			}
+		}
+
+		if len(messages) < outboxBatchSize {
+			return nil
+		}
+	}
+}
+
+// deliver sends the message to discord, then calls its poller. A message is only marked as delivered after it's sent; so
+// if we fail in between it's sent again.
+func (s *satoshi) deliver(ctx context.Context, m *domain.OutboxMessage, now time.Time) error {
+	errParams := map[string]string{
+		"outbox_id":   m.OutboxID,
+		"consumer_id": m.ConsumerID,
+	}
+
+	e, err := fromOutboxMessage(m)
+	if err != nil {
+		// The message can never be sent; there's no point retrying it.
+		m.Attempts, m.LastError = outboxMaxAttempts, err.Error()
+		return s.retryOrDeadLetter(ctx, m, now, errParams)
+	}
+
+	discordMessageID, err := s.send(ctx, e)
+	if err != nil {
+		m.Attempts++
+		m.LastError = err.Error()
+		return s.retryOrDeadLetter(ctx, m, now, errParams)
+	}
+
+	if err := markOutboxMessageDelivered(ctx, m.OutboxID, discordMessageID); err != nil {
+		slog.Error(ctx, "Failed to mark outbox message as delivered; it may be delivered again: %s, Error: %v", m.OutboxID, err)
+	}
+
+	poller := s.takePoller(m.IdempotencyKey)
+	if poller == nil {
+		poller = restorePoller(s.consumerStream, e)
+	}
+
+	if poller == nil || discordMessageID == "" {
+		return nil
+	}
+
+	// Call the poller if one is defined.
+	if err := poller(ctx, discordMessageID); err != nil {
+		return gerrors.Augment(err, "failed_to_call_poller", errParams)
+	}
+
+	slog.Info(ctx, "Called poller for [%v]", discordMessageID)
+	return nil
+}
+
+func (s *satoshi) retryOrDeadLetter(ctx context.Context, m *domain.OutboxMessage, now time.Time, errParams map[string]string) error {
+	if m.Attempts >= outboxMaxAttempts {
+		s.takePoller(m.IdempotencyKey)
+
+		if err := deadLetterOutboxMessage(ctx, m); err != nil {
+			return gerrors.Augment(err, "failed_to_dead_letter_outbox_message", errParams)
+		}
+
+		slog.Warn(ctx, "Dead lettered outbox message: %s after %d attempts, Error: %s", m.OutboxID, m.Attempts, m.LastError)
+		return nil
+	}
+
+	m.NextAttempt = now.Add(outboxBackoff(m.Attempts, s.withJitter))
+	if err := retryOutboxMessage(ctx, m); err != nil {
+		return gerrors.Augment(err, "failed_to_retry_outbox_message", errParams)
+	}
+
+	return nil
+}
+
+// send sends the message to its discord channel, or privately to its participent; returning the id of the message sent
+// to a channel. Errors are returned as is, so the discord error is what's recorded against the message.
+func (s *satoshi) send(ctx context.Context, e *consumers.ConsumerMessage) (string, error) {
+	if e.IsPrivate {
+		// Currently we only have the functionality to send to one participent.
+		if err := s.dc.SendPrivateMessage(ctx, e.Message, e.ParticipentIDs[0]); err != nil {
+			return "", err
+		}
+
+		return "", nil
+	}
+
+	msg, err := s.dc.Send(ctx, e.Message, e.DiscordChannelID)
+	if err != nil {
+		return "", err
+	}
+
+	return msg.ID, nil
+}
+
+// deliverDirectly sends the message without the outbox; it isn't retried if it fails.
+func (s *satoshi) deliverDirectly(ctx context.Context, e *consumers.ConsumerMessage) {
+	discordMessageID, err := s.send(ctx, e)
+	if err != nil {
+		slog.Error(ctx, "Failed to send message via discord: %v, Error: %v", e.Message, err)
+		return
+	}
+
+	if e.Poller == nil || discordMessageID == "" {
+		return
+	}
+
+	if err := e.Poller(ctx, discordMessageID); err != nil {
+		slog.Error(ctx, "Failed to call poller: %v: Error: %v", e.ConsumerID, err)
+	}
+}
+
+// setPoller holds the poller for the message; returning false if one is already held.
+func (s *satoshi) setPoller(idempotencyKey string, poller func(ctx context.Context, messageID string) error) bool {
+	s.pollersMu.Lock()
+	defer s.pollersMu.Unlock()
+
+	if _, ok := s.pollers[idempotencyKey]; ok {
+		return false
+	}
+
+	s.pollers[idempotencyKey] = poller
+	return true
+}
+
+// takePoller removes & returns the poller held for the message; if any.
+func (s *satoshi) takePoller(idempotencyKey string) func(ctx context.Context, messageID string) error {
+	s.pollersMu.Lock()
+	defer s.pollersMu.Unlock()
+
+	poller := s.pollers[idempotencyKey]
+	delete(s.pollers, idempotencyKey)
+	return poller
+}
+
+// outboxBackoff returns how long to wait before the next attempt; doubling with each attempt up to the max. With jitter,
+// the wait is anywhere within the upper half of that; so messages that failed together don't retry together.
+func outboxBackoff(attempts int, withJitter bool) time.Duration {
+	backoff := time.Duration(float64(outboxBaseBackoff) * math.Pow(2, float64(attempts-1)))
+	if backoff > outboxMaxBackoff || backoff <= 0 {
+		backoff = outboxMaxBackoff
+	}
+
+	if withJitter {
+		backoff = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+	}
+
+	return backoff
+}
+
+// outboxIdempotencyKey returns the idempotency key of the message. Messages without one are keyed by their content,
+// destination & the minute they were created; so the same message consumed twice is only delivered once.
+func outboxIdempotencyKey(e *consumers.ConsumerMessage) string {
+	if e.IdempotencyKey != "" {
+		return e.IdempotencyKey
+	}
+
+	return util.Sha256Hash(fmt.Sprintf(
+		"%s-%s-%v-%s-%d", e.ConsumerID, e.DiscordChannelID, e.ParticipentIDs, e.Message, e.Created.Truncate(time.Minute).Unix(),
+	))
+}
+
+func toOutboxMessage(e *consumers.ConsumerMessage) (*domain.OutboxMessage, error) {
+	attachments, err := json.Marshal(e.Attachments)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_marshal_attachments", nil)
+	}
+
+	metadata, err := json.Marshal(e.Metadata)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_marshal_metadata", nil)
+	}
+
+	participentIDs := e.ParticipentIDs
+	if participentIDs == nil {
+		participentIDs = []string{}
+	}
+
+	return &domain.OutboxMessage{
+		IdempotencyKey:   outboxIdempotencyKey(e),
+		ConsumerID:       e.ConsumerID,
+		DiscordChannelID: e.DiscordChannelID,
+		Message:          e.Message,
+		Attachments:      attachments,
+		IsPrivate:        e.IsPrivate,
+		ParticipentIDs:   participentIDs,
+		Metadata:         metadata,
+		Created:          e.Created.UTC(),
+	}, nil
+}
+
+func fromOutboxMessage(m *domain.OutboxMessage) (*consumers.ConsumerMessage, error) {
+	var attachments []*discordgo.MessageAttachment
+	if err := json.Unmarshal(m.Attachments, &attachments); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_unmarshal_attachments", nil)
+	}
+
+	var metadata map[string]string
+	if err := json.Unmarshal(m.Metadata, &metadata); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_unmarshal_metadata", nil)
+	}
+
+	if m.IsPrivate && len(m.ParticipentIDs) == 0 {
+		return nil, gerrors.FailedPrecondition("invalid_outbox_message.private_without_participents", nil)
+	}
+
+	return &consumers.ConsumerMessage{
+		Attachments:      attachments,
+		ConsumerID:       m.ConsumerID,
+		DiscordChannelID: m.DiscordChannelID,
+		Message:          m.Message,
+		IdempotencyKey:   m.IdempotencyKey,
+		IsPrivate:        m.IsPrivate,
+		ParticipentIDs:   m.ParticipentIDs,
+		Metadata:         metadata,
+		Created:          m.Created,
+		// Only active messages are ever enqueued.
+		IsActive: true,
+	}, nil
+}
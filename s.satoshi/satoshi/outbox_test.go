@@ -0,0 +1,241 @@
+package satoshi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	discord "swallowtail/s.discord/client"
+	"swallowtail/s.satoshi/consumers"
+	"swallowtail/s.satoshi/domain"
+)
+
+// fakeDiscordClient sends messages to channels, failing if an error is set.
+type fakeDiscordClient struct {
+	discord.DiscordClient
+	err  error
+	sent []string
+}
+
+func (f *fakeDiscordClient) Send(ctx context.Context, message, channelID string) (*discordgo.Message, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.sent = append(f.sent, message)
+	return &discordgo.Message{ID: "discord-message-id"}, nil
+}
+
+// outboxCalls records the calls made to the faked outbox.
+type outboxCalls struct {
+	enqueued     []*domain.OutboxMessage
+	delivered    []string
+	retried      []*domain.OutboxMessage
+	deadLettered []*domain.OutboxMessage
+}
+
+func withFakeOutbox(t *testing.T, alreadyEnqueued bool) *outboxCalls {
+	calls := &outboxCalls{}
+
+	originalEnqueue, originalDelivered, originalRetry, originalDeadLetter, originalRestore :=
+		enqueueOutboxMessage, markOutboxMessageDelivered, retryOutboxMessage, deadLetterOutboxMessage, restorePoller
+	t.Cleanup(func() {
+		enqueueOutboxMessage, markOutboxMessageDelivered, retryOutboxMessage, deadLetterOutboxMessage, restorePoller =
+			originalEnqueue, originalDelivered, originalRetry, originalDeadLetter, originalRestore
+	})
+
+	enqueueOutboxMessage = func(ctx context.Context, m *domain.OutboxMessage) (bool, error) {
+		calls.enqueued = append(calls.enqueued, m)
+		return !alreadyEnqueued, nil
+	}
+	markOutboxMessageDelivered = func(ctx context.Context, outboxID, discordMessageID string) error {
+		calls.delivered = append(calls.delivered, outboxID)
+		return nil
+	}
+	retryOutboxMessage = func(ctx context.Context, m *domain.OutboxMessage) error {
+		calls.retried = append(calls.retried, m)
+		return nil
+	}
+	deadLetterOutboxMessage = func(ctx context.Context, m *domain.OutboxMessage) error {
+		calls.deadLettered = append(calls.deadLettered, m)
+		return nil
+	}
+	restorePoller = func(c chan *consumers.ConsumerMessage, msg *consumers.ConsumerMessage) func(ctx context.Context, messageID string) error {
+		return nil
+	}
+
+	return calls
+}
+
+func testSatoshi(dc discord.DiscordClient) *satoshi {
+	return &satoshi{
+		dc:             dc,
+		consumerStream: make(chan *consumers.ConsumerMessage, 1),
+		outboxNotify:   make(chan struct{}, 1),
+		pollers:        map[string]func(ctx context.Context, messageID string) error{},
+	}
+}
+
+func testOutboxMessage(t *testing.T, attempts int) *domain.OutboxMessage {
+	metadata, err := json.Marshal(map[string]string{"trade_strategy_id": "trade-strategy-id"})
+	require.NoError(t, err)
+
+	return &domain.OutboxMessage{
+		OutboxID:         "outbox-id",
+		IdempotencyKey:   "idempotency-key",
+		ConsumerID:       "consumer-id",
+		DiscordChannelID: "channel-id",
+		Message:          "message",
+		Attachments:      []byte("null"),
+		ParticipentIDs:   []string{},
+		Metadata:         metadata,
+		Status:           domain.OutboxMessageStatusPending,
+		Attempts:         attempts,
+	}
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, outboxBaseBackoff, outboxBackoff(1, false))
+	assert.Equal(t, 4*outboxBaseBackoff, outboxBackoff(3, false))
+	assert.Equal(t, outboxMaxBackoff, outboxBackoff(outboxMaxAttempts*10, false))
+
+	for i := 0; i < 100; i++ {
+		b := outboxBackoff(3, true)
+		assert.GreaterOrEqual(t, int64(b), int64(2*outboxBaseBackoff))
+		assert.LessOrEqual(t, int64(b), int64(4*outboxBaseBackoff))
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                 string
+		attempts             int
+		sendErr              error
+		expectedDelivered    bool
+		expectedPolled       bool
+		expectedRetried      bool
+		expectedDeadLettered bool
+	}{
+		{
+			name:              "delivered",
+			expectedDelivered: true,
+			expectedPolled:    true,
+		},
+		{
+			name:            "failed_is_retried",
+			attempts:        2,
+			sendErr:         errors.New("discord unavailable"),
+			expectedRetried: true,
+		},
+		{
+			name:                 "failed_too_many_times_is_dead_lettered",
+			attempts:             outboxMaxAttempts - 1,
+			sendErr:              errors.New("discord unavailable"),
+			expectedDeadLettered: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			calls := withFakeOutbox(t, false)
+			s := testSatoshi(&fakeDiscordClient{err: tt.sendErr})
+
+			var polledMessageID string
+			s.setPoller("idempotency-key", func(ctx context.Context, messageID string) error {
+				polledMessageID = messageID
+				return nil
+			})
+
+			m := testOutboxMessage(t, tt.attempts)
+			require.NoError(t, s.deliver(context.Background(), m, now))
+
+			assert.Equal(t, tt.expectedDelivered, len(calls.delivered) == 1)
+			assert.Equal(t, tt.expectedPolled, polledMessageID == "discord-message-id")
+			assert.Equal(t, tt.expectedRetried, len(calls.retried) == 1)
+			assert.Equal(t, tt.expectedDeadLettered, len(calls.deadLettered) == 1)
+
+			if tt.sendErr != nil {
+				assert.Equal(t, tt.attempts+1, m.Attempts)
+				assert.Contains(t, m.LastError, "discord unavailable")
+			}
+
+			if tt.expectedRetried {
+				assert.Equal(t, now.Add(outboxBackoff(m.Attempts, false)), m.NextAttempt)
+				// The poller is held until the message is delivered.
+				assert.NotNil(t, s.takePoller("idempotency-key"))
+			}
+
+			if tt.expectedDeadLettered {
+				assert.Nil(t, s.takePoller("idempotency-key"))
+			}
+		})
+	}
+}
+
+func TestEnqueue(t *testing.T) {
+	tests := []struct {
+		name                  string
+		alreadyEnqueued       bool
+		expectedPollerHeld    bool
+		expectedDispatchWoken bool
+	}{
+		{
+			name:                  "enqueued",
+			expectedPollerHeld:    true,
+			expectedDispatchWoken: true,
+		},
+		{
+			name:            "duplicate_dropped",
+			alreadyEnqueued: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			calls := withFakeOutbox(t, tt.alreadyEnqueued)
+			dc := &fakeDiscordClient{}
+			s := testSatoshi(dc)
+
+			created := time.Date(2026, 10, 1, 0, 0, 30, 0, time.UTC)
+			s.enqueue(context.Background(), &consumers.ConsumerMessage{
+				ConsumerID:       "consumer-id",
+				DiscordChannelID: "channel-id",
+				Message:          "message",
+				Created:          created,
+				IsActive:         true,
+				Metadata:         map[string]string{"key": "value"},
+				Poller: func(ctx context.Context, messageID string) error {
+					return nil
+				},
+			})
+
+			require.Len(t, calls.enqueued, 1)
+			m := calls.enqueued[0]
+
+			// Messages without an idempotency key are keyed by their content, destination & minute.
+			assert.Equal(t, outboxIdempotencyKey(&consumers.ConsumerMessage{
+				ConsumerID:       "consumer-id",
+				DiscordChannelID: "channel-id",
+				Message:          "message",
+				Created:          created.Add(10 * time.Second),
+			}), m.IdempotencyKey)
+			assert.JSONEq(t, `{"key":"value"}`, string(m.Metadata))
+
+			assert.Equal(t, tt.expectedPollerHeld, s.takePoller(m.IdempotencyKey) != nil)
+			assert.Equal(t, tt.expectedDispatchWoken, len(s.outboxNotify) == 1)
+			assert.Empty(t, dc.sent)
+		})
+	}
+}
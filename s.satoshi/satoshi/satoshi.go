@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/monzo/slog"
 
 	"swallowtail/libraries/util"
 	discord "swallowtail/s.discord/client"
+	discordproto "swallowtail/s.discord/proto"
 	"swallowtail/s.satoshi/commands"
 	"swallowtail/s.satoshi/consumers"
 )
 
+// consumerStreamBufferSize only needs to absorb bursts; the stream is drained straight into the outbox.
+const consumerStreamBufferSize = 256
+
 var (
 	satoshiToken = util.SetEnv("DISCORD_API_TOKEN")
 	SatoshiBotID = "satoshi-bot"
@@ -27,12 +32,20 @@ func Init(ctx context.Context) error {
 		dc.AddHandler(command.Exec)
 	}
 
+	// Commands can also be run as slash commands; best effort, since they can always be sent as messages.
+	dc.AddHandlerInteractionCreate(commands.HandleInteraction)
+	if err := dc.RegisterApplicationCommands(ctx, discordproto.DiscordSatoshiGuildID, commands.ApplicationCommands()); err != nil {
+		slog.Error(ctx, "Failed to register slash commands to %s, Error: %v", strings.ToUpper(SatoshiBotID), err)
+	}
+
 	s := &satoshi{
 		dc:             dc,
 		withJitter:     true,
 		consumers:      consumers.Registry(),
-		consumerStream: make(chan *consumers.ConsumerMessage, 32),
+		consumerStream: make(chan *consumers.ConsumerMessage, consumerStreamBufferSize),
 		done:           make(chan struct{}, 1),
+		outboxNotify:   make(chan struct{}, 1),
+		pollers:        map[string]func(ctx context.Context, messageID string) error{},
 	}
 
 	s.run(ctx)
@@ -45,11 +58,17 @@ type satoshi struct {
 	consumers      map[string]consumers.Consumer
 	consumerStream chan *consumers.ConsumerMessage
 	done           chan struct{}
+	outboxNotify   chan struct{}
+
+	// pollers are the pollers of enqueued messages by idempotency key; held until the message is delivered.
+	pollers   map[string]func(ctx context.Context, messageID string) error
+	pollersMu sync.Mutex
 }
 
 func (s *satoshi) run(ctx context.Context) {
 	s.consume(ctx)
 	go s.streamEventHandler(ctx)
+	go s.runOutbox(ctx)
 }
 
 func (s *satoshi) Stop() {
@@ -73,6 +92,7 @@ func (s *satoshi) consume(ctx context.Context) {
 	}
 }
 
+// streamEventHandler enqueues the messages of every consumer to the outbox; from which they're delivered to discord.
 func (s *satoshi) streamEventHandler(ctx context.Context) {
 	for {
 		select {
@@ -81,43 +101,17 @@ func (s *satoshi) streamEventHandler(ctx context.Context) {
 				continue
 			}
 
-			switch {
-			case e.IsPrivate:
-				if len(e.ParticipentIDs) == 0 {
-					slog.Warn(
-						ctx, "Dropping event; cannot send private message with no participants.",
-						map[string]string{
-							"event": fmt.Sprintf("%+v", e),
-						},
-					)
-					continue
-				}
-
-				// Currently we only have the functionality to send to one participent.
-				participent := e.ParticipentIDs[0]
-				if err := s.dc.SendPrivateMessage(ctx, e.Message, participent); err != nil {
-					slog.Error(ctx, "Failed to send message via discord: %v", e.Message)
-				}
-			default:
-				// Send the event message to the appropriate discord channel.
-				m, err := s.dc.Send(ctx, e.Message, e.DiscordChannelID)
-				if err != nil {
-					slog.Error(ctx, "Failed to send message via discord: %v", e.Message)
-					continue
-				}
-
-				if e.Poller == nil {
-					continue
-				}
-
-				// Call the poller if one is defined.
-				if err := e.Poller(ctx, m.ID); err != nil {
-					slog.Error(ctx, "Failed to call poller: %v: Error: %v", e.ConsumerID, err)
-					continue
-				}
-
-				slog.Info(ctx, "Called poller for [%v]", m.ID)
+			if e.IsPrivate && len(e.ParticipentIDs) == 0 {
+				slog.Warn(
+					ctx, "Dropping event; cannot send private message with no participants.",
+					map[string]string{
+						"event": fmt.Sprintf("%+v", e),
+					},
+				)
+				continue
 			}
+
+			s.enqueue(ctx, e)
 		case <-ctx.Done():
 			return
 		case <-s.done:
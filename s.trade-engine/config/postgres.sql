@@ -3,7 +3,7 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 DO $$
 BEGIN
 	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_tradeengine_venue') THEN
-		CREATE TYPE s_tradeengine_venue AS ENUM ('BINANCE', 'FTX', 'DERIBIT', 'BITFINEX');
+		CREATE TYPE s_tradeengine_venue AS ENUM ('BINANCE', 'FTX', 'DERIBIT', 'BITFINEX', 'PAPER');
 	END IF;
 
 	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_tradeengine_actor_type') THEN
@@ -43,6 +43,18 @@ BEGIN
 		CREATE TYPE s_tradeengine_order_type AS ENUM ('LIMIT', 'MARKET', 'STOP_MARKET', 'STOP_LIMIT', 'TAKE_PROFIT_LIMIT', 'TAKE_PROFIT_MARKET', 'TRAILING_STOP_MARKET');
 	END IF;
 
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_tradeengine_circuit_breaker_scope') THEN
+		CREATE TYPE s_tradeengine_circuit_breaker_scope AS ENUM ('SYSTEM_CIRCUIT_BREAKER', 'USER_CIRCUIT_BREAKER');
+	END IF;
+
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_tradeengine_managed_position_status') THEN
+		CREATE TYPE s_tradeengine_managed_position_status AS ENUM ('AWAITING_TAKE_PROFIT', 'TRAILING', 'COMPLETED');
+	END IF;
+
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_tradeengine_trade_outcome_status') THEN
+		CREATE TYPE s_tradeengine_trade_outcome_status AS ENUM ('PENDING_ENTRY', 'ENTERED', 'STOPPED_OUT', 'TAKE_PROFIT', 'EXPIRED');
+	END IF;
+
 	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_tradeengine_time_in_force') THEN
 		CREATE TYPE s_tradeengine_time_in_force AS ENUM (
 			'TIME_IN_FORCE_UNREQUIRED',
@@ -79,9 +91,30 @@ BEGIN
 			'EXPONENTIAL'
 		);
 	END IF;
+
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_tradeengine_execution_schedule_status') THEN
+		CREATE TYPE s_tradeengine_execution_schedule_status AS ENUM (
+			'ACTIVE',
+			'COMPLETE',
+			'CANCELLED',
+			'FAILED'
+		);
+	END IF;
+
+	IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 's_tradeengine_child_order_status') THEN
+		CREATE TYPE s_tradeengine_child_order_status AS ENUM (
+			'PENDING',
+			'EXECUTING',
+			'EXECUTED',
+			'FAILED',
+			'CANCELLED'
+		);
+	END IF;
 END
 $$;
 
+ALTER TYPE s_tradeengine_venue ADD VALUE IF NOT EXISTS 'PAPER';
+
 CREATE TABLE IF NOT EXISTS s_tradeengine_trade_strategies (
 	trade_strategy_id uuid DEFAULT uuid_generate_v4(),
 
@@ -137,3 +170,258 @@ CREATE TABLE IF NOT EXISTS s_tradeengine_trade_strategy_participants (
 	
 	UNIQUE(trade_strategy_id, user_id)
 );
+
+CREATE TABLE IF NOT EXISTS s_tradeengine_orders (
+	order_id uuid DEFAULT uuid_generate_v4(),
+
+	trade_strategy_id uuid NOT NULL,
+	user_id VARCHAR(20) NOT NULL,
+	actor_id VARCHAR(32) NOT NULL,
+
+	venue s_tradeengine_venue NOT NULL,
+	external_order_id VARCHAR(256) NOT NULL DEFAULT '',
+
+	instrument VARCHAR(64) NOT NULL DEFAULT '',
+	instrument_type s_tradeengine_instrument_type NOT NULL,
+	asset VARCHAR(8) NOT NULL,
+	pair VARCHAR(4) NOT NULL,
+
+	order_type s_tradeengine_order_type NOT NULL,
+	trade_side s_tradeengine_trade_side NOT NULL,
+
+	limit_price DECIMAL NOT NULL DEFAULT 0,
+	stop_price DECIMAL NOT NULL DEFAULT 0,
+	quantity DECIMAL NOT NULL,
+	executed_quantity DECIMAL NOT NULL DEFAULT 0,
+	reduce_only BOOLEAN NOT NULL DEFAULT FALSE,
+
+	status s_tradeengine_order_status NOT NULL DEFAULT 'PENDING_NEW_ORDER',
+	failure_reason TEXT NOT NULL DEFAULT '',
+
+	created TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(order_id),
+	CONSTRAINT fk_tradeengine_order_trade_strategy
+		FOREIGN KEY(trade_strategy_id)
+			REFERENCES s_tradeengine_trade_strategies(trade_strategy_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_s_tradeengine_orders_trade_strategy_id_user_id
+	ON s_tradeengine_orders(trade_strategy_id, user_id);
+
+CREATE INDEX IF NOT EXISTS idx_s_tradeengine_orders_status
+	ON s_tradeengine_orders(status);
+
+-- only used by trailing stop orders.
+ALTER TABLE s_tradeengine_orders ADD COLUMN IF NOT EXISTS trailing_percentage DECIMAL NOT NULL DEFAULT 0;
+
+-- Used by the order status poller; orders whose status can't be read are polled less often.
+ALTER TABLE s_tradeengine_orders ADD COLUMN IF NOT EXISTS poll_failures INT NOT NULL DEFAULT 0;
+ALTER TABLE s_tradeengine_orders ADD COLUMN IF NOT EXISTS next_poll TIMESTAMP NOT NULL DEFAULT '1970-01-01';
+
+-- The percentage of the position each take profit closes; empty if the trade strategy leaves it to participants.
+ALTER TABLE s_tradeengine_trade_strategies ADD COLUMN IF NOT EXISTS take_profit_allocations DECIMAL[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS s_tradeengine_execution_schedules (
+	execution_schedule_id uuid DEFAULT uuid_generate_v4(),
+
+	trade_strategy_id uuid NOT NULL,
+	user_id VARCHAR(20) NOT NULL,
+
+	execution_strategy s_tradeengine_execution_strategy NOT NULL,
+	venue s_tradeengine_venue NOT NULL,
+
+	instrument VARCHAR(64) NOT NULL DEFAULT '',
+	instrument_type s_tradeengine_instrument_type NOT NULL,
+	asset VARCHAR(8) NOT NULL,
+	pair VARCHAR(4) NOT NULL,
+	trade_side s_tradeengine_trade_side NOT NULL,
+
+	total_quantity DECIMAL NOT NULL,
+	number_of_child_orders INTEGER NOT NULL,
+
+	status s_tradeengine_execution_schedule_status NOT NULL DEFAULT 'ACTIVE',
+
+	start_timestamp TIMESTAMP NOT NULL,
+	end_timestamp TIMESTAMP NOT NULL,
+	created TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(execution_schedule_id),
+	CONSTRAINT fk_tradeengine_execution_schedule_trade_strategy
+		FOREIGN KEY(trade_strategy_id)
+			REFERENCES s_tradeengine_trade_strategies(trade_strategy_id) ON DELETE CASCADE,
+
+	UNIQUE(trade_strategy_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS s_tradeengine_scheduled_child_orders (
+	child_order_id uuid DEFAULT uuid_generate_v4(),
+
+	execution_schedule_id uuid NOT NULL,
+	sequence_number INTEGER NOT NULL,
+
+	quantity DECIMAL NOT NULL,
+	scheduled_for TIMESTAMP NOT NULL,
+
+	status s_tradeengine_child_order_status NOT NULL DEFAULT 'PENDING',
+	attempts INTEGER NOT NULL DEFAULT 0,
+	external_order_id VARCHAR(256) NOT NULL DEFAULT '',
+	failure_reason TEXT NOT NULL DEFAULT '',
+
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(child_order_id),
+	CONSTRAINT fk_tradeengine_scheduled_child_order_execution_schedule
+		FOREIGN KEY(execution_schedule_id)
+			REFERENCES s_tradeengine_execution_schedules(execution_schedule_id) ON DELETE CASCADE,
+
+	UNIQUE(execution_schedule_id, sequence_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_s_tradeengine_scheduled_child_orders_status_scheduled_for
+	ON s_tradeengine_scheduled_child_orders(status, scheduled_for);
+
+CREATE TABLE IF NOT EXISTS s_tradeengine_paper_orders (
+	external_order_id uuid DEFAULT uuid_generate_v4(),
+
+	user_id VARCHAR(20) NOT NULL,
+
+	instrument VARCHAR(64) NOT NULL,
+	instrument_type s_tradeengine_instrument_type NOT NULL,
+	asset VARCHAR(8) NOT NULL,
+	pair VARCHAR(4) NOT NULL,
+
+	order_type s_tradeengine_order_type NOT NULL,
+	trade_side s_tradeengine_trade_side NOT NULL,
+
+	limit_price DECIMAL NOT NULL DEFAULT 0,
+	stop_price DECIMAL NOT NULL DEFAULT 0,
+	quantity DECIMAL NOT NULL,
+	executed_quantity DECIMAL NOT NULL DEFAULT 0,
+	average_fill_price DECIMAL NOT NULL DEFAULT 0,
+	reduce_only BOOLEAN NOT NULL DEFAULT FALSE,
+
+	status s_tradeengine_order_status NOT NULL,
+
+	created TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(external_order_id)
+);
+
+CREATE TABLE IF NOT EXISTS s_tradeengine_paper_positions (
+	user_id VARCHAR(20) NOT NULL,
+	instrument VARCHAR(64) NOT NULL,
+	instrument_type s_tradeengine_instrument_type NOT NULL,
+
+	-- signed; negative quantities are short positions.
+	quantity DECIMAL NOT NULL DEFAULT 0,
+	average_entry_price DECIMAL NOT NULL DEFAULT 0,
+	realized_pnl DECIMAL NOT NULL DEFAULT 0,
+
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(user_id, instrument, instrument_type)
+);
+
+CREATE TABLE IF NOT EXISTS s_tradeengine_paper_balances (
+	user_id VARCHAR(20) NOT NULL,
+	asset VARCHAR(8) NOT NULL,
+
+	balance DECIMAL NOT NULL DEFAULT 0,
+
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(user_id, asset)
+);
+
+CREATE TABLE IF NOT EXISTS s_tradeengine_circuit_breakers (
+	scope s_tradeengine_circuit_breaker_scope NOT NULL,
+	-- empty for the system circuit breaker.
+	user_id VARCHAR(20) NOT NULL DEFAULT '',
+
+	is_tripped BOOLEAN NOT NULL DEFAULT FALSE,
+	reason TEXT NOT NULL DEFAULT '',
+	actor_id VARCHAR(32) NOT NULL,
+
+	tripped TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(scope, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS s_tradeengine_managed_positions (
+	trade_strategy_id uuid NOT NULL,
+	user_id VARCHAR(20) NOT NULL,
+
+	venue s_tradeengine_venue NOT NULL,
+	instrument VARCHAR(64) NOT NULL,
+	instrument_type s_tradeengine_instrument_type NOT NULL,
+	trade_side s_tradeengine_trade_side NOT NULL,
+	-- used to price market entries, which have no limit price.
+	reference_entry_price DECIMAL NOT NULL,
+
+	move_stop_to_break_even BOOLEAN NOT NULL DEFAULT FALSE,
+	trailing_stop_percentage DECIMAL NOT NULL DEFAULT 0,
+	is_synthetic_trailing_stop BOOLEAN NOT NULL DEFAULT FALSE,
+
+	status s_tradeengine_managed_position_status NOT NULL DEFAULT 'AWAITING_TAKE_PROFIT',
+	break_even_price DECIMAL NOT NULL DEFAULT 0,
+	-- the best price seen since trailing began; the high for longs, the low for shorts.
+	best_price DECIMAL NOT NULL DEFAULT 0,
+
+	created TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(trade_strategy_id, user_id),
+	CONSTRAINT fk_tradeengine_managed_position_trade_strategy
+		FOREIGN KEY(trade_strategy_id)
+			REFERENCES s_tradeengine_trade_strategies(trade_strategy_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_s_tradeengine_managed_positions_status
+	ON s_tradeengine_managed_positions(status);
+
+CREATE TABLE IF NOT EXISTS s_tradeengine_trade_outcomes (
+	trade_strategy_id uuid NOT NULL,
+
+	actor_id VARCHAR(32) NOT NULL,
+	humanized_actor_name VARCHAR(256) NOT NULL,
+	actor_type s_tradeengine_actor_type NOT NULL,
+
+	-- the binance symbol the trade strategy is evaluated against, regardless of where it's traded.
+	symbol VARCHAR(64) NOT NULL,
+	trade_side s_tradeengine_trade_side NOT NULL,
+	entry_price DECIMAL NOT NULL,
+	-- the price when the trade strategy was created; zero if unknown.
+	reference_price DECIMAL NOT NULL DEFAULT 0,
+	stop_loss DECIMAL NOT NULL,
+	take_profits DECIMAL[] NOT NULL,
+	take_profit_allocations DECIMAL[] NOT NULL,
+
+	status s_tradeengine_trade_outcome_status NOT NULL DEFAULT 'PENDING_ENTRY',
+	number_of_take_profits_hit INTEGER NOT NULL DEFAULT 0,
+	r_multiple DECIMAL NOT NULL DEFAULT 0,
+
+	-- the close time of the last candle evaluated.
+	evaluated_until TIMESTAMP NOT NULL,
+	-- when the outcome was last evaluated, successfully or not; outcomes are evaluated least recently attempted first.
+	last_attempted TIMESTAMP NOT NULL DEFAULT '1970-01-01',
+	entered TIMESTAMP,
+	resolved TIMESTAMP,
+	created TIMESTAMP NOT NULL,
+	last_updated TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(trade_strategy_id),
+	CONSTRAINT fk_tradeengine_trade_outcome_trade_strategy
+		FOREIGN KEY(trade_strategy_id)
+			REFERENCES s_tradeengine_trade_strategies(trade_strategy_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_s_tradeengine_trade_outcomes_status
+	ON s_tradeengine_trade_outcomes(status);
+
+CREATE INDEX IF NOT EXISTS idx_s_tradeengine_trade_outcomes_resolved
+	ON s_tradeengine_trade_outcomes(resolved);
@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+)
+
+// ReadCircuitBreaker reads the circuit breaker of the given scope; the user id is empty for the system circuit breaker.
+func ReadCircuitBreaker(ctx context.Context, scope, userID string) (*domain.CircuitBreaker, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_circuit_breakers
+		WHERE scope=$1
+		AND user_id=$2
+		`
+		circuitBreakers []*domain.CircuitBreaker
+	)
+
+	if err := db.Select(ctx, &circuitBreakers, sql, scope, userID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	switch len(circuitBreakers) {
+	case 0:
+		return nil, gerrors.NotFound("not_found.circuit_breaker", nil)
+	default:
+		return circuitBreakers[0], nil
+	}
+}
+
+// UpsertCircuitBreaker creates or replaces the state of the circuit breaker.
+func UpsertCircuitBreaker(ctx context.Context, circuitBreaker *domain.CircuitBreaker) error {
+	var (
+		sql = `
+		INSERT INTO s_tradeengine_circuit_breakers
+			(scope, user_id, is_tripped, reason, actor_id, tripped, last_updated)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (scope, user_id) DO UPDATE SET
+			is_tripped=EXCLUDED.is_tripped,
+			reason=EXCLUDED.reason,
+			actor_id=EXCLUDED.actor_id,
+			tripped=EXCLUDED.tripped,
+			last_updated=EXCLUDED.last_updated
+		`
+		cb = circuitBreaker
+	)
+
+	if _, err := db.Exec(ctx, sql, cb.Scope, cb.UserID, cb.IsTripped, cb.Reason, cb.ActorID, cb.Tripped, cb.LastUpdated); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ListCircuitBreakers lists every circuit breaker; most recently updated first.
+func ListCircuitBreakers(ctx context.Context, onlyTripped bool) ([]*domain.CircuitBreaker, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_circuit_breakers
+		WHERE ($1 = false OR is_tripped=true)
+		ORDER BY last_updated DESC
+		`
+		circuitBreakers []*domain.CircuitBreaker
+	)
+
+	if err := db.Select(ctx, &circuitBreakers, sql, onlyTripped); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return circuitBreakers, nil
+}
@@ -0,0 +1,431 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+)
+
+// CreateExecutionSchedule persists the execution schedule along with all of its child orders in a single transaction;
+// so the scheduler never sees a partial schedule. The schedule is unique per (trade strategy, user) so a participant can
+// only ever have one schedule per strategy.
+func CreateExecutionSchedule(ctx context.Context, schedule *domain.ExecutionSchedule, childOrders []*domain.ScheduledChildOrder) (*domain.ExecutionSchedule, error) {
+	var (
+		scheduleSQL = `
+		INSERT INTO
+			s_tradeengine_execution_schedules(
+				trade_strategy_id,
+				user_id,
+				execution_strategy,
+				venue,
+				instrument,
+				instrument_type,
+				asset,
+				pair,
+				trade_side,
+				total_quantity,
+				number_of_child_orders,
+				status,
+				start_timestamp,
+				end_timestamp,
+				created,
+				last_updated
+			)
+		VALUES
+			(
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+			)
+		RETURNING execution_schedule_id
+		`
+		childOrderSQL = `
+		INSERT INTO
+			s_tradeengine_scheduled_child_orders(
+				execution_schedule_id,
+				sequence_number,
+				quantity,
+				scheduled_for,
+				status,
+				last_updated
+			)
+		VALUES
+			(
+				$1, $2, $3, $4, $5, $6
+			)
+		`
+	)
+
+	now := time.Now().UTC()
+	s := schedule
+	s.Created = now
+	s.LastUpdated = now
+
+	tx, err := db.Transaction(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.Error(ctx, "Failed to rollback execution schedule transaction: %v", err)
+		}
+	}()
+
+	var executionScheduleID string
+	if err := tx.QueryRow(
+		ctx, scheduleSQL,
+		s.TradeStrategyID, s.UserID, s.ExecutionStrategy, s.Venue, s.Instrument, s.InstrumentType, s.Asset, s.Pair, s.TradeSide,
+		s.TotalQuantity, s.NumberOfChildOrders, s.Status, s.Start, s.End, s.Created, s.LastUpdated,
+	).Scan(&executionScheduleID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	for _, co := range childOrders {
+		co.ExecutionScheduleID = executionScheduleID
+		co.LastUpdated = now
+
+		if _, err := tx.Exec(
+			ctx, childOrderSQL,
+			co.ExecutionScheduleID, co.SequenceNumber, co.Quantity, co.ScheduledFor, co.Status, co.LastUpdated,
+		); err != nil {
+			return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	// Read the schedule back out, embellished with its defaults.
+	embellishedSchedule, err := ReadExecutionScheduleByTradeStrategyIDAndUserID(ctx, s.TradeStrategyID, s.UserID)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_created_execution_schedule", nil)
+	}
+
+	return embellishedSchedule, nil
+}
+
+// ReadExecutionScheduleByTradeStrategyIDAndUserID ...
+func ReadExecutionScheduleByTradeStrategyIDAndUserID(ctx context.Context, tradeStrategyID, userID string) (*domain.ExecutionSchedule, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_execution_schedules
+		WHERE trade_strategy_id=$1
+		AND user_id=$2
+		`
+		schedules []*domain.ExecutionSchedule
+	)
+
+	if err := db.Select(ctx, &schedules, sql, tradeStrategyID, userID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	switch len(schedules) {
+	case 0:
+		return nil, gerrors.NotFound("not_found.execution_schedule", nil)
+	case 1:
+		return schedules[0], nil
+	default:
+		slog.Critical(ctx, "Inconsistent data; more than one execution schedule for the same trade strategy & user", map[string]string{
+			"trade_strategy_id": tradeStrategyID,
+			"user_id":           userID,
+		})
+		return schedules[0], nil
+	}
+}
+
+// ReadExecutionScheduleByID ...
+func ReadExecutionScheduleByID(ctx context.Context, executionScheduleID string) (*domain.ExecutionSchedule, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_execution_schedules
+		WHERE execution_schedule_id=$1
+		`
+		schedules []*domain.ExecutionSchedule
+	)
+
+	if err := db.Select(ctx, &schedules, sql, executionScheduleID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	switch len(schedules) {
+	case 0:
+		return nil, gerrors.NotFound("not_found.execution_schedule", nil)
+	default:
+		return schedules[0], nil
+	}
+}
+
+// ListChildOrdersByExecutionScheduleID lists all child orders of a schedule, ordered by sequence number.
+func ListChildOrdersByExecutionScheduleID(ctx context.Context, executionScheduleID string) ([]*domain.ScheduledChildOrder, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_scheduled_child_orders
+		WHERE execution_schedule_id=$1
+		ORDER BY sequence_number ASC
+		`
+		childOrders []*domain.ScheduledChildOrder
+	)
+
+	if err := db.Select(ctx, &childOrders, sql, executionScheduleID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return childOrders, nil
+}
+
+// ListDueChildOrders lists all pending child orders, belonging to active schedules, that are due for execution.
+func ListDueChildOrders(ctx context.Context, now time.Time, limit int) ([]*domain.ScheduledChildOrder, error) {
+	var (
+		sql = `
+		SELECT co.* FROM s_tradeengine_scheduled_child_orders co
+		INNER JOIN s_tradeengine_execution_schedules es
+			ON co.execution_schedule_id = es.execution_schedule_id
+		WHERE co.status=$1
+		AND es.status=$2
+		AND co.scheduled_for <= $3
+		ORDER BY co.scheduled_for ASC
+		LIMIT $4
+		`
+		childOrders []*domain.ScheduledChildOrder
+	)
+
+	if err := db.Select(ctx, &childOrders, sql, domain.ChildOrderStatusPending, domain.ExecutionScheduleStatusActive, now, limit); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return childOrders, nil
+}
+
+// ClaimChildOrder atomically moves a pending child order into the executing state. It returns false if
+// the child order has already been claimed; this guards against executing the same slice twice.
+func ClaimChildOrder(ctx context.Context, childOrderID string) (bool, error) {
+	var (
+		sql = `
+		UPDATE s_tradeengine_scheduled_child_orders
+		SET
+			status=$1,
+			attempts=attempts+1,
+			last_updated=$2
+		WHERE child_order_id=$3
+		AND status=$4
+		`
+	)
+
+	tag, err := db.Exec(ctx, sql, domain.ChildOrderStatusExecuting, time.Now().UTC(), childOrderID, domain.ChildOrderStatusPending)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// DeferChildOrder reschedules a pending child order without claiming it, so no attempt is counted. It returns false if the
+// child order is no longer pending.
+func DeferChildOrder(ctx context.Context, childOrderID string, scheduledFor time.Time) (bool, error) {
+	var (
+		sql = `
+		UPDATE s_tradeengine_scheduled_child_orders
+		SET
+			scheduled_for=$1,
+			last_updated=$2
+		WHERE child_order_id=$3
+		AND status=$4
+		`
+	)
+
+	tag, err := db.Exec(ctx, sql, scheduledFor, time.Now().UTC(), childOrderID, domain.ChildOrderStatusPending)
+	if err != nil {
+		return false, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// UpdateChildOrder updates the mutable state of a child order.
+func UpdateChildOrder(ctx context.Context, childOrder *domain.ScheduledChildOrder) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_scheduled_child_orders
+		SET
+			status=$1,
+			scheduled_for=$2,
+			external_order_id=$3,
+			failure_reason=$4,
+			last_updated=$5
+		WHERE child_order_id=$6
+		`
+	)
+
+	co := childOrder
+	co.LastUpdated = time.Now().UTC()
+
+	if _, err := db.Exec(ctx, sql, co.Status, co.ScheduledFor, co.ExternalOrderID, co.FailureReason, co.LastUpdated, co.ChildOrderID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// FailInterruptedChildOrders marks all child orders left in the executing state as failed; this can only happen
+// if we were interrupted mid execution, in which case we can't know if the order reached the venue or not.
+// Failing is the safer option versus potentially executing twice.
+func FailInterruptedChildOrders(ctx context.Context) (int64, error) {
+	var (
+		sql = `
+		UPDATE s_tradeengine_scheduled_child_orders
+		SET
+			status=$1,
+			failure_reason=$2,
+			last_updated=$3
+		WHERE status=$4
+		`
+	)
+
+	tag, err := db.Exec(ctx, sql, domain.ChildOrderStatusFailed, "interrupted_during_execution", time.Now().UTC(), domain.ChildOrderStatusExecuting)
+	if err != nil {
+		return 0, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// CompleteExecutionScheduleIfDone marks the schedule as complete if there are no outstanding child orders.
+func CompleteExecutionScheduleIfDone(ctx context.Context, executionScheduleID string) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_execution_schedules
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE execution_schedule_id=$3
+		AND status=$4
+		AND NOT EXISTS (
+			SELECT 1 FROM s_tradeengine_scheduled_child_orders
+			WHERE execution_schedule_id=$3
+			AND status IN ($5, $6)
+		)
+		`
+	)
+
+	if _, err := db.Exec(
+		ctx, sql,
+		domain.ExecutionScheduleStatusComplete, time.Now().UTC(), executionScheduleID, domain.ExecutionScheduleStatusActive,
+		domain.ChildOrderStatusPending, domain.ChildOrderStatusExecuting,
+	); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// UpdateExecutionScheduleStatus ...
+func UpdateExecutionScheduleStatus(ctx context.Context, executionScheduleID, status string) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_execution_schedules
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE execution_schedule_id=$3
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, status, time.Now().UTC(), executionScheduleID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// CancelActiveExecutionSchedules cancels all active execution schedules of a trade strategy, along with their pending child
+// orders. If the user id is empty then the schedules of all participants are cancelled. Child orders already executing are
+// left to complete; they can no longer be claimed once cancelled, so nothing new reaches the venue.
+func CancelActiveExecutionSchedules(ctx context.Context, tradeStrategyID, userID string) (int64, error) {
+	var (
+		childOrderSQL = `
+		UPDATE s_tradeengine_scheduled_child_orders
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE status=$3
+		AND execution_schedule_id IN (
+			SELECT execution_schedule_id FROM s_tradeengine_execution_schedules
+			WHERE trade_strategy_id=$4
+			AND ($5 = '' OR user_id=$5)
+			AND status=$6
+		)
+		`
+		scheduleSQL = `
+		UPDATE s_tradeengine_execution_schedules
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE trade_strategy_id=$3
+		AND ($4 = '' OR user_id=$4)
+		AND status=$5
+		`
+	)
+
+	now := time.Now().UTC()
+
+	if _, err := db.Exec(
+		ctx, childOrderSQL,
+		domain.ChildOrderStatusCancelled, now, domain.ChildOrderStatusPending, tradeStrategyID, userID, domain.ExecutionScheduleStatusActive,
+	); err != nil {
+		return 0, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	tag, err := db.Exec(ctx, scheduleSQL, domain.ExecutionScheduleStatusCancelled, now, tradeStrategyID, userID, domain.ExecutionScheduleStatusActive)
+	if err != nil {
+		return 0, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// CancelActiveExecutionSchedulesByUserID cancels every active execution schedule of the user, across all trade strategies,
+// along with their pending child orders. If the user id is empty then the schedules of all users are cancelled.
+func CancelActiveExecutionSchedulesByUserID(ctx context.Context, userID string) (int64, error) {
+	var (
+		childOrderSQL = `
+		UPDATE s_tradeengine_scheduled_child_orders
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE status=$3
+		AND execution_schedule_id IN (
+			SELECT execution_schedule_id FROM s_tradeengine_execution_schedules
+			WHERE ($4 = '' OR user_id=$4)
+			AND status=$5
+		)
+		`
+		scheduleSQL = `
+		UPDATE s_tradeengine_execution_schedules
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE ($3 = '' OR user_id=$3)
+		AND status=$4
+		`
+	)
+
+	now := time.Now().UTC()
+
+	if _, err := db.Exec(
+		ctx, childOrderSQL,
+		domain.ChildOrderStatusCancelled, now, domain.ChildOrderStatusPending, userID, domain.ExecutionScheduleStatusActive,
+	); err != nil {
+		return 0, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	tag, err := db.Exec(ctx, scheduleSQL, domain.ExecutionScheduleStatusCancelled, now, userID, domain.ExecutionScheduleStatusActive)
+	if err != nil {
+		return 0, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,101 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+)
+
+// CreateManagedPosition starts managing the position of a trade strategy participant.
+func CreateManagedPosition(ctx context.Context, managedPosition *domain.ManagedPosition) error {
+	var (
+		sql = `
+		INSERT INTO s_tradeengine_managed_positions
+			(
+				trade_strategy_id,
+				user_id,
+				venue,
+				instrument,
+				instrument_type,
+				trade_side,
+				reference_entry_price,
+				move_stop_to_break_even,
+				trailing_stop_percentage,
+				is_synthetic_trailing_stop,
+				status,
+				break_even_price,
+				best_price,
+				created,
+				last_updated
+			)
+		VALUES
+			(
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+			)
+		`
+		mp = managedPosition
+	)
+
+	now := time.Now().UTC()
+	mp.Created, mp.LastUpdated = now, now
+
+	if _, err := db.Exec(
+		ctx, sql,
+		mp.TradeStrategyID, mp.UserID, mp.Venue, mp.Instrument, mp.InstrumentType, mp.TradeSide, mp.ReferenceEntryPrice,
+		mp.MoveStopToBreakEven, mp.TrailingStopPercentage, mp.IsSyntheticTrailingStop, mp.Status, mp.BreakEvenPrice, mp.BestPrice,
+		mp.Created, mp.LastUpdated,
+	); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// UpdateManagedPosition updates the mutable management state of a position.
+func UpdateManagedPosition(ctx context.Context, managedPosition *domain.ManagedPosition) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_managed_positions
+		SET
+			status=$1,
+			break_even_price=$2,
+			best_price=$3,
+			last_updated=$4
+		WHERE trade_strategy_id=$5
+		AND user_id=$6
+		`
+		mp = managedPosition
+	)
+
+	mp.LastUpdated = time.Now().UTC()
+
+	if _, err := db.Exec(ctx, sql, mp.Status, mp.BreakEvenPrice, mp.BestPrice, mp.LastUpdated, mp.TradeStrategyID, mp.UserID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ListActiveManagedPositions lists every position still being managed; least recently updated first.
+func ListActiveManagedPositions(ctx context.Context, limit int) ([]*domain.ManagedPosition, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_managed_positions
+		WHERE status IN ($1, $2)
+		ORDER BY last_updated ASC
+		LIMIT $3
+		`
+		managedPositions []*domain.ManagedPosition
+	)
+
+	if err := db.Select(
+		ctx, &managedPositions, sql,
+		domain.ManagedPositionStatusAwaitingTakeProfit, domain.ManagedPositionStatusTrailing, limit,
+	); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return managedPositions, nil
+}
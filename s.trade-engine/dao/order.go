@@ -0,0 +1,276 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+)
+
+// CreateOrder persists the order, returning the order embellished with its internal order id.
+func CreateOrder(ctx context.Context, order *domain.Order) (*domain.Order, error) {
+	var (
+		sql = `
+		INSERT INTO
+			s_tradeengine_orders(
+				trade_strategy_id,
+				user_id,
+				actor_id,
+				venue,
+				external_order_id,
+				instrument,
+				instrument_type,
+				asset,
+				pair,
+				order_type,
+				trade_side,
+				limit_price,
+				stop_price,
+				quantity,
+				executed_quantity,
+				reduce_only,
+				status,
+				failure_reason,
+				created,
+				last_updated,
+				trailing_percentage
+			)
+		VALUES
+			(
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21
+			)
+		RETURNING order_id
+		`
+		orderID string
+	)
+
+	now := time.Now().UTC()
+	o := order
+	o.Created = now
+	o.LastUpdated = now
+
+	if err := db.Get(
+		ctx, &orderID, sql,
+		o.TradeStrategyID, o.UserID, o.ActorID, o.Venue, o.ExternalOrderID, o.Instrument, o.InstrumentType, o.Asset, o.Pair,
+		o.OrderType, o.TradeSide, o.LimitPrice, o.StopPrice, o.Quantity, o.ExecutedQuantity, o.ReduceOnly, o.Status, o.FailureReason,
+		o.Created, o.LastUpdated, o.TrailingPercentage,
+	); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	o.OrderID = orderID
+	return o, nil
+}
+
+// UpdateOrder updates the mutable lifecycle state of an order.
+func UpdateOrder(ctx context.Context, order *domain.Order) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_orders
+		SET
+			external_order_id=$1,
+			executed_quantity=$2,
+			status=$3,
+			failure_reason=$4,
+			last_updated=$5
+		WHERE order_id=$6
+		`
+	)
+
+	o := order
+	o.LastUpdated = time.Now().UTC()
+
+	if _, err := db.Exec(ctx, sql, o.ExternalOrderID, o.ExecutedQuantity, o.Status, o.FailureReason, o.LastUpdated, o.OrderID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ReadOrderByOrderID ...
+func ReadOrderByOrderID(ctx context.Context, orderID string) (*domain.Order, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_orders
+		WHERE order_id=$1
+		`
+		orders []*domain.Order
+	)
+
+	if err := db.Select(ctx, &orders, sql, orderID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	switch len(orders) {
+	case 0:
+		return nil, gerrors.NotFound("not_found.order", nil)
+	default:
+		return orders[0], nil
+	}
+}
+
+// ListOrdersByTradeStrategyIDAndUserID lists all orders of a trade strategy participant, oldest first.
+func ListOrdersByTradeStrategyIDAndUserID(ctx context.Context, tradeStrategyID, userID string) ([]*domain.Order, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_orders
+		WHERE trade_strategy_id=$1
+		AND user_id=$2
+		ORDER BY created ASC
+		`
+		orders []*domain.Order
+	)
+
+	if err := db.Select(ctx, &orders, sql, tradeStrategyID, userID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return orders, nil
+}
+
+// ListOpenOrdersByVenueInstruments lists orders resting on any of the given venue & instrument type pairs, each of the
+// form `<venue>:<instrument_type>`, that are due to be polled; least recently polled first.
+func ListOpenOrdersByVenueInstruments(ctx context.Context, venueInstruments []string, limit int) ([]*domain.Order, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_orders
+		WHERE status IN ($1, $2)
+		AND venue::text || ':' || instrument_type::text = ANY($3)
+		AND external_order_id <> ''
+		AND next_poll <= $4
+		ORDER BY next_poll ASC
+		LIMIT $5
+		`
+		orders []*domain.Order
+	)
+
+	if err := db.Select(ctx, &orders, sql, domain.OrderStatusNew, domain.OrderStatusPartiallyFilled, venueInstruments, time.Now().UTC(), limit); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return orders, nil
+}
+
+// RecordOrderPoll records an attempt to poll the status of the order & when it's next due to be polled; consecutive
+// failures are counted, & reset on success.
+func RecordOrderPoll(ctx context.Context, orderID string, succeeded bool, nextPoll time.Time) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_orders
+		SET
+			poll_failures=CASE WHEN $2 THEN 0 ELSE poll_failures+1 END,
+			next_poll=$3
+		WHERE order_id=$1
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, orderID, succeeded, nextPoll.UTC()); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ListOpenOrdersByTradeStrategyID lists all resting orders of a trade strategy across all participants.
+func ListOpenOrdersByTradeStrategyID(ctx context.Context, tradeStrategyID string) ([]*domain.Order, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_orders
+		WHERE trade_strategy_id=$1
+		AND status IN ($2, $3)
+		AND external_order_id <> ''
+		ORDER BY user_id, created ASC
+		`
+		orders []*domain.Order
+	)
+
+	if err := db.Select(ctx, &orders, sql, tradeStrategyID, domain.OrderStatusNew, domain.OrderStatusPartiallyFilled); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return orders, nil
+}
+
+// ListOpenOrdersByTradeStrategyIDAndUserID lists all resting orders of a trade strategy participant.
+func ListOpenOrdersByTradeStrategyIDAndUserID(ctx context.Context, tradeStrategyID, userID string) ([]*domain.Order, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_orders
+		WHERE trade_strategy_id=$1
+		AND user_id=$2
+		AND status IN ($3, $4)
+		AND external_order_id <> ''
+		ORDER BY created ASC
+		`
+		orders []*domain.Order
+	)
+
+	if err := db.Select(ctx, &orders, sql, tradeStrategyID, userID, domain.OrderStatusNew, domain.OrderStatusPartiallyFilled); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return orders, nil
+}
+
+// ListRecentOrderStatusesByUserID lists the statuses of the users most recently created orders; most recent first. Orders
+// the venue didn't accept because it was rate limited or unavailable are skipped, since the venue never decided on them.
+func ListRecentOrderStatusesByUserID(ctx context.Context, userID string, limit int) ([]string, error) {
+	var (
+		sql = `
+		SELECT status FROM s_tradeengine_orders
+		WHERE user_id=$1
+		AND status<>$3
+		ORDER BY created DESC
+		LIMIT $2
+		`
+		statuses []string
+	)
+
+	if err := db.Select(ctx, &statuses, sql, userID, limit, domain.OrderStatusVenueUnavailable); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return statuses, nil
+}
+
+// ListUserIDsWithOpenOrders lists every user with at least one order resting on a venue.
+func ListUserIDsWithOpenOrders(ctx context.Context) ([]string, error) {
+	var (
+		sql = `
+		SELECT DISTINCT user_id FROM s_tradeengine_orders
+		WHERE status IN ($1, $2)
+		`
+		userIDs []string
+	)
+
+	if err := db.Select(ctx, &userIDs, sql, domain.OrderStatusNew, domain.OrderStatusPartiallyFilled); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return userIDs, nil
+}
+
+// ListOrdersOfOpenTradeStrategiesByUserID lists every order, in any status, of the trade strategies the user still has
+// resting orders for; oldest first.
+func ListOrdersOfOpenTradeStrategiesByUserID(ctx context.Context, userID string) ([]*domain.Order, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_orders
+		WHERE user_id=$1
+		AND trade_strategy_id IN (
+			SELECT trade_strategy_id FROM s_tradeengine_orders
+			WHERE user_id=$1
+			AND status IN ($2, $3)
+		)
+		ORDER BY created ASC
+		`
+		orders []*domain.Order
+	)
+
+	if err := db.Select(ctx, &orders, sql, userID, domain.OrderStatusNew, domain.OrderStatusPartiallyFilled); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return orders, nil
+}
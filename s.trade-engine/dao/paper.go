@@ -0,0 +1,290 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+)
+
+// CreatePaperOrder persists the paper order, returning the order embellished with its external order id.
+func CreatePaperOrder(ctx context.Context, order *domain.PaperOrder) (*domain.PaperOrder, error) {
+	var (
+		sql = `
+		INSERT INTO
+			s_tradeengine_paper_orders(
+				user_id,
+				instrument,
+				instrument_type,
+				asset,
+				pair,
+				order_type,
+				trade_side,
+				limit_price,
+				stop_price,
+				quantity,
+				executed_quantity,
+				average_fill_price,
+				reduce_only,
+				status,
+				created,
+				last_updated
+			)
+		VALUES
+			(
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+			)
+		RETURNING external_order_id
+		`
+		externalOrderID string
+	)
+
+	now := time.Now().UTC()
+	o := order
+	o.Created = now
+	o.LastUpdated = now
+
+	if err := db.Get(
+		ctx, &externalOrderID, sql,
+		o.UserID, o.Instrument, o.InstrumentType, o.Asset, o.Pair, o.OrderType, o.TradeSide, o.LimitPrice, o.StopPrice,
+		o.Quantity, o.ExecutedQuantity, o.AverageFillPrice, o.ReduceOnly, o.Status, o.Created, o.LastUpdated,
+	); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	o.ExternalOrderID = externalOrderID
+	return o, nil
+}
+
+// ReadPaperOrderByExternalOrderID ...
+func ReadPaperOrderByExternalOrderID(ctx context.Context, externalOrderID string) (*domain.PaperOrder, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_paper_orders
+		WHERE external_order_id=$1
+		`
+		orders []*domain.PaperOrder
+	)
+
+	if err := db.Select(ctx, &orders, sql, externalOrderID); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	switch len(orders) {
+	case 0:
+		return nil, gerrors.NotFound("not_found.paper_order", nil)
+	default:
+		return orders[0], nil
+	}
+}
+
+// UpdatePaperOrderStatus ...
+func UpdatePaperOrderStatus(ctx context.Context, externalOrderID, status string) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_paper_orders
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE external_order_id=$3
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, status, time.Now().UTC(), externalOrderID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ReadPaperPosition ...
+func ReadPaperPosition(ctx context.Context, userID, instrument, instrumentType string) (*domain.PaperPosition, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_paper_positions
+		WHERE user_id=$1
+		AND instrument=$2
+		AND instrument_type=$3
+		`
+		positions []*domain.PaperPosition
+	)
+
+	if err := db.Select(ctx, &positions, sql, userID, instrument, instrumentType); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	switch len(positions) {
+	case 0:
+		return nil, gerrors.NotFound("not_found.paper_position", nil)
+	default:
+		return positions[0], nil
+	}
+}
+
+// ListOpenPaperPositionsByUserID lists the users paper positions of the given instrument type that aren't flat.
+func ListOpenPaperPositionsByUserID(ctx context.Context, userID, instrumentType string) ([]*domain.PaperPosition, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_paper_positions
+		WHERE user_id=$1
+		AND instrument_type=$2
+		AND quantity <> 0
+		`
+		positions []*domain.PaperPosition
+	)
+
+	if err := db.Select(ctx, &positions, sql, userID, instrumentType); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return positions, nil
+}
+
+// ReadPaperBalance ...
+func ReadPaperBalance(ctx context.Context, userID, asset string) (*domain.PaperBalance, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_paper_balances
+		WHERE user_id=$1
+		AND asset=$2
+		`
+		balances []*domain.PaperBalance
+	)
+
+	if err := db.Select(ctx, &balances, sql, userID, asset); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	switch len(balances) {
+	case 0:
+		return nil, gerrors.NotFound("not_found.paper_balance", nil)
+	default:
+		return balances[0], nil
+	}
+}
+
+// CreatePaperBalanceIfNotExists seeds the users balance of the given asset; existing balances are left untouched.
+func CreatePaperBalanceIfNotExists(ctx context.Context, userID, asset string, balance float64) error {
+	var (
+		sql = `
+		INSERT INTO s_tradeengine_paper_balances(user_id, asset, balance, last_updated)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, asset) DO NOTHING
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, userID, asset, balance, time.Now().UTC()); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// FillPaperOrder persists a fill of a paper order in a single transaction: the order, the resulting position (if any) & the
+// balance deltas per asset. The position is updated optimistically; if it has been modified since it was read, at
+// `previousLastUpdated`, nothing is persisted & an unavailable error is returned so the fill can be retried.
+func FillPaperOrder(
+	ctx context.Context,
+	order *domain.PaperOrder,
+	position *domain.PaperPosition,
+	previousLastUpdated time.Time,
+	balanceDeltas map[string]float64,
+) error {
+	var (
+		orderSQL = `
+		UPDATE s_tradeengine_paper_orders
+		SET
+			executed_quantity=$1,
+			average_fill_price=$2,
+			status=$3,
+			last_updated=$4
+		WHERE external_order_id=$5
+		`
+		createPositionSQL = `
+		INSERT INTO s_tradeengine_paper_positions(user_id, instrument, instrument_type, quantity, average_entry_price, realized_pnl, last_updated)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, instrument, instrument_type) DO NOTHING
+		`
+		updatePositionSQL = `
+		UPDATE s_tradeengine_paper_positions
+		SET
+			quantity=$1,
+			average_entry_price=$2,
+			realized_pnl=$3,
+			last_updated=$4
+		WHERE user_id=$5
+		AND instrument=$6
+		AND instrument_type=$7
+		AND last_updated=$8
+		`
+		balanceSQL = `
+		UPDATE s_tradeengine_paper_balances
+		SET
+			balance=balance + $1,
+			last_updated=$2
+		WHERE user_id=$3
+		AND asset=$4
+		`
+	)
+
+	now := time.Now().UTC()
+
+	tx, err := db.Transaction(ctx, pgx.TxOptions{})
+	if err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && err != pgx.ErrTxClosed {
+			slog.Error(ctx, "Failed to rollback paper fill transaction: %v", err)
+		}
+	}()
+
+	order.LastUpdated = now
+	if _, err := tx.Exec(ctx, orderSQL, order.ExecutedQuantity, order.AverageFillPrice, order.Status, order.LastUpdated, order.ExternalOrderID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	if position != nil {
+		p := position
+		p.LastUpdated = now
+
+		var rowsAffected int64
+		switch {
+		case previousLastUpdated.IsZero():
+			tag, err := tx.Exec(ctx, createPositionSQL, p.UserID, p.Instrument, p.InstrumentType, p.Quantity, p.AverageEntryPrice, p.RealizedPNL, p.LastUpdated)
+			if err != nil {
+				return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+			}
+			rowsAffected = tag.RowsAffected()
+		default:
+			tag, err := tx.Exec(ctx, updatePositionSQL, p.Quantity, p.AverageEntryPrice, p.RealizedPNL, p.LastUpdated, p.UserID, p.Instrument, p.InstrumentType, previousLastUpdated)
+			if err != nil {
+				return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+			}
+			rowsAffected = tag.RowsAffected()
+		}
+
+		if rowsAffected == 0 {
+			return gerrors.New(gerrors.ErrUnavailable, "paper_position_modified_concurrently", map[string]string{
+				"user_id":    p.UserID,
+				"instrument": p.Instrument,
+			})
+		}
+	}
+
+	for asset, delta := range balanceDeltas {
+		if _, err := tx.Exec(ctx, balanceSQL, delta, now, order.UserID, asset); err != nil {
+			return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
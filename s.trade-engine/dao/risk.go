@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+)
+
+// CountOpenTradeStrategiesByUserID counts the trade strategies the user still has resting orders for; a strategy
+// is considered open until its protective orders are either filled or cancelled.
+func CountOpenTradeStrategiesByUserID(ctx context.Context, userID string) (int, error) {
+	var (
+		sql = `
+		SELECT COUNT(DISTINCT trade_strategy_id) FROM s_tradeengine_orders
+		WHERE user_id=$1
+		AND status IN ($2, $3, $4)
+		`
+		count int
+	)
+
+	if err := db.Get(ctx, &count, sql, userID, domain.OrderStatusPendingNew, domain.OrderStatusNew, domain.OrderStatusPartiallyFilled); err != nil {
+		return 0, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return count, nil
+}
+
+// ReadOpenNotionalByUserIDAndAsset sums the notional of every entry order, across all open trade strategies of the user
+// for the given asset, priced at the entry price.
+func ReadOpenNotionalByUserIDAndAsset(ctx context.Context, userID, asset string) (float64, error) {
+	var (
+		sql = `
+		SELECT COALESCE(SUM(quantity * CASE WHEN limit_price > 0 THEN limit_price ELSE stop_price END), 0)
+		FROM s_tradeengine_orders
+		WHERE user_id=$1
+		AND asset=$2
+		AND reduce_only=false
+		AND status IN ($3, $4, $5, $6)
+		AND trade_strategy_id IN (
+			SELECT trade_strategy_id FROM s_tradeengine_orders
+			WHERE user_id=$1
+			AND status IN ($3, $4, $5)
+		)
+		`
+		notional float64
+	)
+
+	if err := db.Get(
+		ctx, &notional, sql, userID, asset,
+		domain.OrderStatusPendingNew, domain.OrderStatusNew, domain.OrderStatusPartiallyFilled, domain.OrderStatusFilled,
+	); err != nil {
+		return 0, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return notional, nil
+}
+
+// ReadRealizedLossByUserIDSince sums the loss of every stop loss filled since the given timestamp; each fill's pnl is signed
+// by the side of the position it closed, a sell stop closing a long & a buy stop closing a short, over the executed quantity.
+// Only losses are summed; a stop filled in profit, i.e one trailed or moved beyond the entry, counts as no loss.
+func ReadRealizedLossByUserIDSince(ctx context.Context, userID string, since time.Time) (float64, error) {
+	var (
+		sql = `
+		SELECT COALESCE(SUM(stops.executed_quantity * GREATEST(0,
+			CASE WHEN stops.trade_side IN ('SELL', 'SHORT')
+				THEN entries.average_entry - stops.stop_price
+				ELSE stops.stop_price - entries.average_entry
+			END
+		)), 0)
+		FROM s_tradeengine_orders stops
+		JOIN (
+			SELECT trade_strategy_id, AVG(limit_price) AS average_entry
+			FROM s_tradeengine_orders
+			WHERE user_id=$1
+			AND reduce_only=false
+			AND limit_price > 0
+			GROUP BY trade_strategy_id
+		) entries ON entries.trade_strategy_id=stops.trade_strategy_id
+		WHERE stops.user_id=$1
+		AND stops.reduce_only=true
+		AND stops.order_type='STOP_MARKET'
+		AND stops.status IN ($2, $3)
+		AND stops.last_updated >= $4
+		`
+		loss float64
+	)
+
+	if err := db.Get(ctx, &loss, sql, userID, domain.OrderStatusPartiallyFilled, domain.OrderStatusFilled, since); err != nil {
+		return 0, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return loss, nil
+}
@@ -0,0 +1,187 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+)
+
+const (
+	// CallerPerformanceOrderByExpectancy ranks callers by their average R-multiple per trade.
+	CallerPerformanceOrderByExpectancy = "expectancy"
+	// CallerPerformanceOrderByWinRate ranks callers by the fraction of their trades closed in profit.
+	CallerPerformanceOrderByWinRate = "win_rate"
+)
+
+// CreateTradeOutcome starts tracking the outcome of a trade strategy; it's a noop if the outcome is already tracked.
+func CreateTradeOutcome(ctx context.Context, outcome *domain.TradeOutcome) error {
+	var (
+		sql = `
+		INSERT INTO s_tradeengine_trade_outcomes
+			(
+				trade_strategy_id,
+				actor_id,
+				humanized_actor_name,
+				actor_type,
+				symbol,
+				trade_side,
+				entry_price,
+				reference_price,
+				stop_loss,
+				take_profits,
+				take_profit_allocations,
+				status,
+				number_of_take_profits_hit,
+				r_multiple,
+				evaluated_until,
+				created,
+				last_updated
+			)
+		VALUES
+			(
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+			)
+		ON CONFLICT (trade_strategy_id) DO NOTHING
+		`
+		o = outcome
+	)
+
+	now := time.Now().UTC()
+	o.Created, o.LastUpdated = now, now
+	if o.EvaluatedUntil.IsZero() {
+		o.EvaluatedUntil = now
+	}
+
+	if _, err := db.Exec(
+		ctx, sql,
+		o.TradeStrategyID, o.ActorID, o.HumanizedActorName, o.ActorType, o.Symbol, o.TradeSide, o.EntryPrice, o.ReferencePrice,
+		o.StopLoss, o.TakeProfits, o.TakeProfitAllocations, o.Status, o.NumberOfTakeProfitsHit, o.RMultiple, o.EvaluatedUntil,
+		o.Created, o.LastUpdated,
+	); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// UpdateTradeOutcome updates the evaluated state of a trade outcome.
+func UpdateTradeOutcome(ctx context.Context, outcome *domain.TradeOutcome) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_trade_outcomes
+		SET
+			status=$1,
+			number_of_take_profits_hit=$2,
+			r_multiple=$3,
+			evaluated_until=$4,
+			entered=$5,
+			resolved=$6,
+			last_updated=$7
+		WHERE trade_strategy_id=$8
+		`
+		o = outcome
+	)
+
+	o.LastUpdated = time.Now().UTC()
+
+	if _, err := db.Exec(
+		ctx, sql,
+		o.Status, o.NumberOfTakeProfitsHit, o.RMultiple, o.EvaluatedUntil, o.Entered, o.Resolved, o.LastUpdated, o.TradeStrategyID,
+	); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// RecordTradeOutcomeAttempt records an attempt to evaluate the trade outcome, successful or not.
+func RecordTradeOutcomeAttempt(ctx context.Context, tradeStrategyID string, attempted time.Time) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_trade_outcomes
+		SET last_attempted=$1
+		WHERE trade_strategy_id=$2
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, attempted, tradeStrategyID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// ListUnresolvedTradeOutcomes lists every trade outcome not yet known; least recently attempted first, so outcomes we
+// repeatedly fail to evaluate don't starve the rest.
+func ListUnresolvedTradeOutcomes(ctx context.Context, limit int) ([]*domain.TradeOutcome, error) {
+	var (
+		sql = `
+		SELECT * FROM s_tradeengine_trade_outcomes
+		WHERE status IN ($1, $2)
+		ORDER BY last_attempted ASC
+		LIMIT $3
+		`
+		outcomes []*domain.TradeOutcome
+	)
+
+	if err := db.Select(
+		ctx, &outcomes, sql,
+		domain.TradeOutcomeStatusPendingEntry, domain.TradeOutcomeStatusEntered, limit,
+	); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return outcomes, nil
+}
+
+// ListCallerPerformance aggregates the trade outcomes resolved since the given time by caller; only outcomes whose entry
+// was hit are counted. Callers with fewer than the minimum number of trades are excluded.
+func ListCallerPerformance(ctx context.Context, since time.Time, minNumberOfTrades, limit int, orderBy string) ([]*domain.CallerPerformance, error) {
+	// Postgres doesn't allow output column aliases within expressions; so we order by the aggregates themselves.
+	const (
+		winRate    = "(COUNT(*) FILTER (WHERE r_multiple > 0))::DECIMAL / COUNT(*)"
+		expectancy = "SUM(r_multiple) / COUNT(*)"
+	)
+
+	var orderByClause string
+	switch orderBy {
+	case CallerPerformanceOrderByWinRate:
+		orderByClause = fmt.Sprintf("%s DESC, %s DESC", winRate, expectancy)
+	case CallerPerformanceOrderByExpectancy:
+		orderByClause = fmt.Sprintf("%s DESC, %s DESC", expectancy, winRate)
+	default:
+		return nil, gerrors.BadParam("bad_param.order_by", map[string]string{
+			"order_by": orderBy,
+		})
+	}
+
+	var (
+		sql = fmt.Sprintf(`
+		SELECT
+			humanized_actor_name,
+			actor_type,
+			COUNT(*) AS number_of_trades,
+			COUNT(*) FILTER (WHERE r_multiple > 0) AS number_of_wins,
+			COALESCE(SUM(r_multiple), 0) AS total_r_multiple,
+			COALESCE(AVG(EXTRACT(EPOCH FROM (resolved - created)) / 60), 0) AS average_minutes_to_outcome
+		FROM s_tradeengine_trade_outcomes
+		WHERE entered IS NOT NULL
+		AND resolved IS NOT NULL
+		AND resolved >= $1
+		GROUP BY humanized_actor_name, actor_type
+		HAVING COUNT(*) >= $2
+		ORDER BY %s
+		LIMIT $3
+		`, orderByClause)
+		performances []*domain.CallerPerformance
+	)
+
+	if err := db.Select(ctx, &performances, sql, since, minNumberOfTrades, limit); err != nil {
+		return nil, gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return performances, nil
+}
@@ -46,6 +46,7 @@ func CreateTradeStrategy(ctx context.Context, trade *domain.TradeStrategy) error
 				entries,
 				stop_loss,
 				take_profits,
+				take_profit_allocations,
 				current_price,
 				status,
 				tradeable_venues,
@@ -54,7 +55,7 @@ func CreateTradeStrategy(ctx context.Context, trade *domain.TradeStrategy) error
 			)
 		VALUES
 			(
-				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
 			)
 		`
 	)
@@ -64,9 +65,13 @@ func CreateTradeStrategy(ctx context.Context, trade *domain.TradeStrategy) error
 	t.Created = now
 	t.LastUpdated = now
 
+	if t.TakeProfitAllocations == nil {
+		t.TakeProfitAllocations = []float64{}
+	}
+
 	if _, err := (db.Exec(
 		ctx, sql,
-		t.ActorID, t.HumanizedActorName, t.ActorType, t.IdempotencyKey, t.ExecutionStrategy, t.InstrumentType, t.TradeSide, trade.Asset, t.Pair, t.Entries, t.StopLoss, t.TakeProfits, t.CurrentPrice, t.Status, t.TradeableVenues,
+		t.ActorID, t.HumanizedActorName, t.ActorType, t.IdempotencyKey, t.ExecutionStrategy, t.InstrumentType, t.TradeSide, trade.Asset, t.Pair, t.Entries, t.StopLoss, t.TakeProfits, t.TakeProfitAllocations, t.CurrentPrice, t.Status, t.TradeableVenues,
 		t.Created, t.LastUpdated,
 	)); err != nil {
 		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
@@ -130,3 +135,41 @@ func ReadTradeStrategyByIdempotencyKey(ctx context.Context, idempotencyKey strin
 		return tradeStrategies[0], nil
 	}
 }
+
+// UpdateTradeStrategyStatus ...
+func UpdateTradeStrategyStatus(ctx context.Context, tradeStrategyID, status string) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_trade_strategies
+		SET
+			status=$1,
+			last_updated=$2
+		WHERE trade_strategy_id=$3
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, status, time.Now().UTC(), tradeStrategyID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
+
+// UpdateTradeStrategyStopLoss ...
+func UpdateTradeStrategyStopLoss(ctx context.Context, tradeStrategyID string, stopLoss float64) error {
+	var (
+		sql = `
+		UPDATE s_tradeengine_trade_strategies
+		SET
+			stop_loss=$1,
+			last_updated=$2
+		WHERE trade_strategy_id=$3
+		`
+	)
+
+	if _, err := db.Exec(ctx, sql, stopLoss, time.Now().UTC(), tradeStrategyID); err != nil {
+		return gerrors.Propagate(err, gerrors.ErrUnknown, nil)
+	}
+
+	return nil
+}
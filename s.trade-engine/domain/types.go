@@ -16,12 +16,14 @@ type TradeStrategy struct {
 	Entries            []float64 `db:"entries"`
 	StopLoss           float64   `db:"stop_loss"`
 	TakeProfits        []float64 `db:"take_profits"`
-	Status             string    `db:"status"`
-	Created            time.Time `db:"created"`
-	LastUpdated        time.Time `db:"last_updated"`
-	TradeSide          string    `db:"trade_side"`
-	CurrentPrice       float64   `db:"current_price"`
-	TradeableVenues    []string  `db:"tradeable_venues"`
+	// TakeProfitAllocations are the percentages of the position closed by each take profit; empty if unset.
+	TakeProfitAllocations []float64 `db:"take_profit_allocations"`
+	Status                string    `db:"status"`
+	Created               time.Time `db:"created"`
+	LastUpdated           time.Time `db:"last_updated"`
+	TradeSide             string    `db:"trade_side"`
+	CurrentPrice          float64   `db:"current_price"`
+	TradeableVenues       []string  `db:"tradeable_venues"`
 }
 
 // TradeStrategyParticipant ...
@@ -37,3 +39,212 @@ type TradeStrategyParticipant struct {
 	Status            string    `db:"status"`
 	ExecutedTimestamp time.Time `db:"executed"`
 }
+
+// Order is a single order placed, or attempted to be placed, on a venue on behalf of a trade strategy participant.
+type Order struct {
+	OrderID          string    `db:"order_id"`
+	TradeStrategyID  string    `db:"trade_strategy_id"`
+	UserID           string    `db:"user_id"`
+	ActorID          string    `db:"actor_id"`
+	Venue            string    `db:"venue"`
+	ExternalOrderID  string    `db:"external_order_id"`
+	Instrument       string    `db:"instrument"`
+	InstrumentType   string    `db:"instrument_type"`
+	Asset            string    `db:"asset"`
+	Pair             string    `db:"pair"`
+	OrderType        string    `db:"order_type"`
+	TradeSide        string    `db:"trade_side"`
+	LimitPrice       float64   `db:"limit_price"`
+	StopPrice        float64   `db:"stop_price"`
+	Quantity         float64   `db:"quantity"`
+	ExecutedQuantity float64   `db:"executed_quantity"`
+	ReduceOnly       bool      `db:"reduce_only"`
+	Status           string    `db:"status"`
+	FailureReason    string    `db:"failure_reason"`
+	Created          time.Time `db:"created"`
+	LastUpdated      time.Time `db:"last_updated"`
+	// Only used by trailing stop orders.
+	TrailingPercentage float64 `db:"trailing_percentage"`
+	// The number of consecutive failures to poll the order's status & when it's next due to be polled.
+	PollFailures int       `db:"poll_failures"`
+	NextPoll     time.Time `db:"next_poll"`
+}
+
+// ExecutionSchedule is the parent record of a scheduled execution strategy (i.e TWAP); it
+// holds everything required to (re)build child orders after a restart.
+type ExecutionSchedule struct {
+	ExecutionScheduleID string    `db:"execution_schedule_id"`
+	TradeStrategyID     string    `db:"trade_strategy_id"`
+	UserID              string    `db:"user_id"`
+	ExecutionStrategy   string    `db:"execution_strategy"`
+	Venue               string    `db:"venue"`
+	Instrument          string    `db:"instrument"`
+	InstrumentType      string    `db:"instrument_type"`
+	Asset               string    `db:"asset"`
+	Pair                string    `db:"pair"`
+	TradeSide           string    `db:"trade_side"`
+	TotalQuantity       float64   `db:"total_quantity"`
+	NumberOfChildOrders int       `db:"number_of_child_orders"`
+	Status              string    `db:"status"`
+	Start               time.Time `db:"start_timestamp"`
+	End                 time.Time `db:"end_timestamp"`
+	Created             time.Time `db:"created"`
+	LastUpdated         time.Time `db:"last_updated"`
+}
+
+// ScheduledChildOrder is a single slice of an execution schedule.
+type ScheduledChildOrder struct {
+	ChildOrderID        string    `db:"child_order_id"`
+	ExecutionScheduleID string    `db:"execution_schedule_id"`
+	SequenceNumber      int       `db:"sequence_number"`
+	Quantity            float64   `db:"quantity"`
+	ScheduledFor        time.Time `db:"scheduled_for"`
+	Status              string    `db:"status"`
+	Attempts            int       `db:"attempts"`
+	ExternalOrderID     string    `db:"external_order_id"`
+	FailureReason       string    `db:"failure_reason"`
+	LastUpdated         time.Time `db:"last_updated"`
+}
+
+// PaperOrder is an order placed on the simulated paper trading venue.
+type PaperOrder struct {
+	ExternalOrderID  string    `db:"external_order_id"`
+	UserID           string    `db:"user_id"`
+	Instrument       string    `db:"instrument"`
+	InstrumentType   string    `db:"instrument_type"`
+	Asset            string    `db:"asset"`
+	Pair             string    `db:"pair"`
+	OrderType        string    `db:"order_type"`
+	TradeSide        string    `db:"trade_side"`
+	LimitPrice       float64   `db:"limit_price"`
+	StopPrice        float64   `db:"stop_price"`
+	Quantity         float64   `db:"quantity"`
+	ExecutedQuantity float64   `db:"executed_quantity"`
+	AverageFillPrice float64   `db:"average_fill_price"`
+	ReduceOnly       bool      `db:"reduce_only"`
+	Status           string    `db:"status"`
+	Created          time.Time `db:"created"`
+	LastUpdated      time.Time `db:"last_updated"`
+}
+
+// PaperPosition is a users simulated position in an instrument; the quantity is signed, negative being short.
+type PaperPosition struct {
+	UserID            string    `db:"user_id"`
+	Instrument        string    `db:"instrument"`
+	InstrumentType    string    `db:"instrument_type"`
+	Quantity          float64   `db:"quantity"`
+	AverageEntryPrice float64   `db:"average_entry_price"`
+	RealizedPNL       float64   `db:"realized_pnl"`
+	LastUpdated       time.Time `db:"last_updated"`
+}
+
+// PaperBalance is a users simulated balance of a single asset.
+type PaperBalance struct {
+	UserID      string    `db:"user_id"`
+	Asset       string    `db:"asset"`
+	Balance     float64   `db:"balance"`
+	LastUpdated time.Time `db:"last_updated"`
+}
+
+// ManagedPosition tracks the management of a participants position after entry; moving the stop loss to break even
+// & trailing it once the first take profit fills.
+type ManagedPosition struct {
+	TradeStrategyID         string    `db:"trade_strategy_id"`
+	UserID                  string    `db:"user_id"`
+	Venue                   string    `db:"venue"`
+	Instrument              string    `db:"instrument"`
+	InstrumentType          string    `db:"instrument_type"`
+	TradeSide               string    `db:"trade_side"`
+	ReferenceEntryPrice     float64   `db:"reference_entry_price"`
+	MoveStopToBreakEven     bool      `db:"move_stop_to_break_even"`
+	TrailingStopPercentage  float64   `db:"trailing_stop_percentage"`
+	IsSyntheticTrailingStop bool      `db:"is_synthetic_trailing_stop"`
+	Status                  string    `db:"status"`
+	BreakEvenPrice          float64   `db:"break_even_price"`
+	BestPrice               float64   `db:"best_price"`
+	Created                 time.Time `db:"created"`
+	LastUpdated             time.Time `db:"last_updated"`
+}
+
+// CircuitBreaker halts trading either for a single user, or for every user if system scoped.
+type CircuitBreaker struct {
+	Scope       string    `db:"scope"`
+	UserID      string    `db:"user_id"`
+	IsTripped   bool      `db:"is_tripped"`
+	Reason      string    `db:"reason"`
+	ActorID     string    `db:"actor_id"`
+	Tripped     time.Time `db:"tripped"`
+	LastUpdated time.Time `db:"last_updated"`
+}
+
+// TradeOutcome tracks how a trade strategy played out against the market, regardless of whether anyone traded it; so
+// callers can be ranked on the merit of their calls.
+type TradeOutcome struct {
+	TradeStrategyID    string  `db:"trade_strategy_id"`
+	ActorID            string  `db:"actor_id"`
+	HumanizedActorName string  `db:"humanized_actor_name"`
+	ActorType          string  `db:"actor_type"`
+	Symbol             string  `db:"symbol"`
+	TradeSide          string  `db:"trade_side"`
+	EntryPrice         float64 `db:"entry_price"`
+	// ReferencePrice is the price when the trade strategy was created; it tells us which side the entry is hit from.
+	ReferencePrice         float64   `db:"reference_price"`
+	StopLoss               float64   `db:"stop_loss"`
+	TakeProfits            []float64 `db:"take_profits"`
+	TakeProfitAllocations  []float64 `db:"take_profit_allocations"`
+	Status                 string    `db:"status"`
+	NumberOfTakeProfitsHit int       `db:"number_of_take_profits_hit"`
+	RMultiple              float64   `db:"r_multiple"`
+	EvaluatedUntil         time.Time `db:"evaluated_until"`
+	// LastAttempted is when the outcome was last evaluated, successfully or not.
+	LastAttempted time.Time `db:"last_attempted"`
+	// Entered & Resolved are nil until the entry is hit & the outcome is known respectively.
+	Entered     *time.Time `db:"entered"`
+	Resolved    *time.Time `db:"resolved"`
+	Created     time.Time  `db:"created"`
+	LastUpdated time.Time  `db:"last_updated"`
+}
+
+// CallerPerformance aggregates the resolved trade outcomes of a single caller.
+type CallerPerformance struct {
+	HumanizedActorName      string  `db:"humanized_actor_name"`
+	ActorType               string  `db:"actor_type"`
+	NumberOfTrades          int     `db:"number_of_trades"`
+	NumberOfWins            int     `db:"number_of_wins"`
+	TotalRMultiple          float64 `db:"total_r_multiple"`
+	AverageMinutesToOutcome float64 `db:"average_minutes_to_outcome"`
+}
+
+const (
+	OrderStatusPendingNew      = "PENDING_NEW_ORDER"
+	OrderStatusNew             = "NEW_ORDER"
+	OrderStatusPartiallyFilled = "PARTIALLY_FILLED_ORDER"
+	OrderStatusFilled          = "FILLED_ORDER"
+	OrderStatusCancelled       = "CANCELLED_ORDER"
+	OrderStatusRejected        = "REJECTED"
+	OrderStatusExpired         = "EXPIRED"
+	// OrderStatusVenueUnavailable is an order the venue didn't accept because it was rate limited or unavailable, rather
+	// than rejecting it; so it doesn't count as a venue rejection.
+	OrderStatusVenueUnavailable = "VENUE_UNAVAILABLE"
+
+	ExecutionScheduleStatusActive    = "ACTIVE"
+	ExecutionScheduleStatusComplete  = "COMPLETE"
+	ExecutionScheduleStatusCancelled = "CANCELLED"
+	ExecutionScheduleStatusFailed    = "FAILED"
+
+	ChildOrderStatusPending   = "PENDING"
+	ChildOrderStatusExecuting = "EXECUTING"
+	ChildOrderStatusExecuted  = "EXECUTED"
+	ChildOrderStatusFailed    = "FAILED"
+	ChildOrderStatusCancelled = "CANCELLED"
+
+	ManagedPositionStatusAwaitingTakeProfit = "AWAITING_TAKE_PROFIT"
+	ManagedPositionStatusTrailing           = "TRAILING"
+	ManagedPositionStatusCompleted          = "COMPLETED"
+
+	TradeOutcomeStatusPendingEntry = "PENDING_ENTRY"
+	TradeOutcomeStatusEntered      = "ENTERED"
+	TradeOutcomeStatusStoppedOut   = "STOPPED_OUT"
+	TradeOutcomeStatusTakeProfit   = "TAKE_PROFIT"
+	TradeOutcomeStatusExpired      = "EXPIRED"
+)
@@ -0,0 +1,98 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// AmendStopLoss replaces every resting stop loss in the given orders with one at the new stop price. The replacement is
+// placed before the previous stop is cancelled, so a participant is never left without a stop; if the replacement
+// can't be placed the previous stop is left untouched.
+func AmendStopLoss(ctx context.Context, orders []*domain.Order, stopLoss float64) []*tradeengineproto.AmendedStopLoss {
+	return amendStopLoss(ctx, orders, stopLoss, venueCredentialsCache{})
+}
+
+func amendStopLoss(ctx context.Context, orders []*domain.Order, stopLoss float64, credentials venueCredentialsCache) []*tradeengineproto.AmendedStopLoss {
+	var amended []*tradeengineproto.AmendedStopLoss
+	for _, order := range orders {
+		if !isStopLoss(order) {
+			continue
+		}
+
+		amendedStopLoss := &tradeengineproto.AmendedStopLoss{
+			UserId:           order.UserID,
+			PreviousStopLoss: marshaling.OrderDomainToProto(order),
+		}
+		amended = append(amended, amendedStopLoss)
+
+		errParams := map[string]string{
+			"order_id": order.OrderID,
+			"user_id":  order.UserID,
+			"venue":    order.Venue,
+		}
+
+		venue := tradeengineproto.VENUE(tradeengineproto.VENUE_value[order.Venue])
+		venueCredentials, err := credentials.read(ctx, order.UserID, venue)
+		if err != nil {
+			amendedStopLoss.ErrorMessage = gerrors.Augment(err, "failed_to_amend_stop_loss.credentials", errParams).Error()
+			continue
+		}
+
+		// Place the replacement first.
+		newStopLoss, err := executeAndTrackOrder(
+			ctx, order.TradeStrategyID, order.UserID, replacementStopLoss(order, stopLoss), venue,
+			tradeengineproto.INSTRUMENT_TYPE(tradeengineproto.INSTRUMENT_TYPE_value[order.InstrumentType]), venueCredentials,
+		)
+		if err != nil {
+			slog.Error(ctx, "Failed to place replacement stop loss for order: %s, Error: %v", order.OrderID, err)
+			amendedStopLoss.ErrorMessage = gerrors.Augment(err, "failed_to_amend_stop_loss.place_replacement", errParams).Error()
+			continue
+		}
+		amendedStopLoss.NewStopLoss = newStopLoss
+
+		// Then cancel the previous stop.
+		if err := cancelOrder(ctx, order, venueCredentials); err != nil {
+			slog.Critical(ctx, "Failed to cancel previous stop loss after placing replacement: %s, Error: %v", order.OrderID, err)
+			amendedStopLoss.ErrorMessage = gerrors.Augment(err, "failed_to_amend_stop_loss.cancel_previous", errParams).Error()
+			continue
+		}
+		amendedStopLoss.PreviousStopLoss.Status = tradeengineproto.ORDER_STATUS(tradeengineproto.ORDER_STATUS_value[order.Status])
+	}
+
+	return amended
+}
+
+func isStopLoss(order *domain.Order) bool {
+	switch order.OrderType {
+	case tradeengineproto.ORDER_TYPE_STOP_MARKET.String(), tradeengineproto.ORDER_TYPE_STOP_LIMIT.String():
+		return order.ReduceOnly
+	default:
+		return false
+	}
+}
+
+// replacementStopLoss builds a new stop market order identical to the given stop, other than the stop price.
+func replacementStopLoss(order *domain.Order, stopLoss float64) *tradeengineproto.Order {
+	return &tradeengineproto.Order{
+		ActorId:          tradeengineproto.TradeEngineActorSatoshiSystem,
+		Instrument:       order.Instrument,
+		Asset:            order.Asset,
+		Pair:             tradeengineproto.TRADE_PAIR(tradeengineproto.TRADE_PAIR_value[order.Pair]),
+		InstrumentType:   tradeengineproto.INSTRUMENT_TYPE(tradeengineproto.INSTRUMENT_TYPE_value[order.InstrumentType]),
+		OrderType:        tradeengineproto.ORDER_TYPE_STOP_MARKET,
+		TradeSide:        tradeengineproto.TRADE_SIDE(tradeengineproto.TRADE_SIDE_value[order.TradeSide]),
+		StopPrice:        float32(stopLoss),
+		Quantity:         float32(order.Quantity - order.ExecutedQuantity),
+		ReduceOnly:       true,
+		WorkingType:      tradeengineproto.WORKING_TYPE_MARK_PRICE,
+		Venue:            tradeengineproto.VENUE(tradeengineproto.VENUE_value[order.Venue]),
+		CreatedTimestamp: time.Now().UTC().Unix(),
+	}
+}
@@ -0,0 +1,252 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/monzo/slog"
+	"google.golang.org/protobuf/proto"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	// maxExecutionAttempts bounds the number of times we send a single order to a venue.
+	maxExecutionAttempts = 3
+)
+
+// executionRetryBackoff is the base backoff between attempts; it grows linearly with each attempt. It's a package variable
+// so retries don't sleep in tests.
+var executionRetryBackoff = 500 * time.Millisecond
+
+// executeOrdersAtomically places the given orders as a single set; either every order is placed, or none are left on the venue.
+// Each order is retried a bounded number of times if the venue error is retryable. If the set can't be completed, every order
+// already placed is rolled back in reverse order: resting orders are cancelled & any filled entry is closed out with a reduce
+// only market order, so the participant is left flat. The outcome of the rollback is reported on the execution error.
+func executeOrdersAtomically(
+	ctx context.Context,
+	tradeStrategyID, userID string,
+	orders []*tradeengineproto.Order,
+	venue tradeengineproto.VENUE,
+	instrumentType tradeengineproto.INSTRUMENT_TYPE,
+	credentials *tradeengineproto.VenueCredentials,
+) ([]*tradeengineproto.Order, *tradeengineproto.ExecutionError) {
+	var placedOrders = make([]*tradeengineproto.Order, 0, len(orders))
+	for _, order := range orders {
+		var placedOrder *tradeengineproto.Order
+		attempts, err := executeWithRetry(ctx, func() error {
+			var err error
+			placedOrder, err = executeAndTrackOrder(ctx, tradeStrategyID, userID, order, venue, instrumentType, credentials)
+			return err
+		})
+		if err == nil {
+			slog.Info(ctx, "Order placed: %s [%s] %s", placedOrder.Venue, placedOrder.ExternalOrderId, placedOrder.Instrument)
+			placedOrders = append(placedOrders, placedOrder)
+			continue
+		}
+
+		slog.Error(ctx, "Failed to execute given order after %d attempts: %+v, Error: %v", attempts, order, err)
+
+		executionErr := &tradeengineproto.ExecutionError{
+			ErrorMessage: gerrors.Augment(err, "failed_to_execute_order", map[string]string{
+				"attempts": strconv.Itoa(attempts),
+			}).Error(),
+			FailedOrder: order,
+			ErrorClass:  classifyExecutionError(err),
+			Attempts:    int64(attempts),
+		}
+
+		rollbackExecution(ctx, tradeStrategyID, userID, placedOrders, venue, instrumentType, credentials, executionErr)
+
+		if executionErr.ErrorClass == tradeengineproto.EXECUTION_ERROR_CLASS_TERMINAL_EXECUTION_ERROR {
+			// Best effort; repeated rejections by the venue halt the participant until an admin intervenes.
+			if err := tripOnConsecutiveVenueRejections(ctx, userID); err != nil {
+				slog.Error(ctx, "Failed to check consecutive venue rejections: %s, Error: %v", userID, err)
+			}
+		}
+
+		return nil, executionErr
+	}
+
+	return placedOrders, nil
+}
+
+// rollbackExecution rolls back the given placed orders, recording the outcome on the execution error. If any order can't be
+// rolled back the participant is notified, since they must close out whatever we couldn't.
+func rollbackExecution(
+	ctx context.Context,
+	tradeStrategyID, userID string,
+	placedOrders []*tradeengineproto.Order,
+	venue tradeengineproto.VENUE,
+	instrumentType tradeengineproto.INSTRUMENT_TYPE,
+	credentials *tradeengineproto.VenueCredentials,
+	executionErr *tradeengineproto.ExecutionError,
+) {
+	rolledBack, failed := rollbackOrders(ctx, tradeStrategyID, userID, placedOrders, venue, instrumentType, credentials)
+	executionErr.RolledBackOrders = append(executionErr.RolledBackOrders, rolledBack...)
+	executionErr.FailedRollbackOrders = append(executionErr.FailedRollbackOrders, failed...)
+	executionErr.ParticipantFlat = len(executionErr.FailedRollbackOrders) == 0
+
+	if executionErr.ParticipantFlat {
+		return
+	}
+
+	slog.Critical(ctx, "Failed to rollback orders; participant left with live orders: %s %s, orders: %d", tradeStrategyID, userID, len(executionErr.FailedRollbackOrders))
+
+	// Best effort; the participant must manually close out whatever we couldn't.
+	msg := fmt.Sprintf("Failed to place trade strategy %s & failed to rollback %d order(s); please check your open orders & positions on %s", tradeStrategyID, len(executionErr.FailedRollbackOrders), venue)
+	if err := notifyUser(ctx, msg, userID); err != nil {
+		slog.Error(ctx, "Failed to notify user: %v", err)
+	}
+}
+
+// rollbackOrders rolls back the given placed orders in reverse order, returning those rolled back & those we failed to roll back.
+func rollbackOrders(
+	ctx context.Context,
+	tradeStrategyID, userID string,
+	placedOrders []*tradeengineproto.Order,
+	venue tradeengineproto.VENUE,
+	instrumentType tradeengineproto.INSTRUMENT_TYPE,
+	credentials *tradeengineproto.VenueCredentials,
+) (rolledBack, failed []*tradeengineproto.Order) {
+	for i := len(placedOrders) - 1; i >= 0; i-- {
+		order := placedOrders[i]
+		order.Venue, order.InstrumentType = venue, instrumentType
+
+		if err := rollbackOrder(ctx, tradeStrategyID, userID, order, credentials); err != nil {
+			slog.Error(ctx, "Failed to rollback order: %s [%s], Error: %v", order.OrderId, order.ExternalOrderId, err)
+
+			failedOrder := proto.Clone(order).(*tradeengineproto.Order)
+			failedOrder.FailureReason = err.Error()
+			failed = append(failed, failedOrder)
+			continue
+		}
+
+		rolledBack = append(rolledBack, order)
+	}
+
+	return rolledBack, failed
+}
+
+func rollbackOrder(ctx context.Context, tradeStrategyID, userID string, order *tradeengineproto.Order, credentials *tradeengineproto.VenueCredentials) error {
+	switch {
+	case order.OrderType == tradeengineproto.ORDER_TYPE_MARKET && order.ReduceOnly:
+		// A reduce only market order can only have reduced exposure; there's nothing to undo.
+		return nil
+	case order.OrderType == tradeengineproto.ORDER_TYPE_MARKET:
+		_, err := closeOutOrder(ctx, tradeStrategyID, userID, order, order.Quantity, credentials)
+		return err
+	}
+
+	trackedOrder := marshaling.OrderProtoToDomain(tradeStrategyID, userID, order)
+	if _, err := executeWithRetry(ctx, func() error {
+		return cancelOrder(ctx, trackedOrder, credentials)
+	}); err != nil {
+		return gerrors.Augment(err, "failed_to_rollback_order.cancel", nil)
+	}
+	order.Status = tradeengineproto.ORDER_STATUS(tradeengineproto.ORDER_STATUS_value[trackedOrder.Status])
+
+	if order.ReduceOnly {
+		return nil
+	}
+
+	// An entry may have been partially filled before it was cancelled; if so we close out whatever was filled.
+	cancelledOrder, err := routeAndReadOrderStatus(ctx, order, credentials)
+	switch {
+	case gerrors.IsCode(err, gerrors.ErrUnimplemented):
+		slog.Warn(ctx, "Unable to read executed quantity of cancelled entry on venue: %s [%s]", order.Venue, order.ExternalOrderId)
+		return nil
+	case err != nil:
+		return gerrors.Augment(err, "failed_to_rollback_order.read_executed_quantity", nil)
+	}
+	if cancelledOrder.ExecutedQuantity == 0 {
+		return nil
+	}
+
+	_, err = closeOutOrder(ctx, tradeStrategyID, userID, order, cancelledOrder.ExecutedQuantity, credentials)
+	return err
+}
+
+// closeOutOrder places a reduce only market order on the opposite side of the given order for the given quantity.
+func closeOutOrder(ctx context.Context, tradeStrategyID, userID string, order *tradeengineproto.Order, quantity float32, credentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+	closeOut := &tradeengineproto.Order{
+		ActorId:          tradeengineproto.TradeEngineActorSatoshiSystem,
+		Instrument:       order.Instrument,
+		Asset:            order.Asset,
+		Pair:             order.Pair,
+		InstrumentType:   order.InstrumentType,
+		OrderType:        tradeengineproto.ORDER_TYPE_MARKET,
+		TradeSide:        exitTradeSide(order.TradeSide),
+		Quantity:         quantity,
+		ReduceOnly:       order.InstrumentType != tradeengineproto.INSTRUMENT_TYPE_SPOT,
+		Venue:            order.Venue,
+		CreatedTimestamp: time.Now().UTC().Unix(),
+	}
+
+	var closedOut *tradeengineproto.Order
+	if _, err := executeWithRetry(ctx, func() error {
+		var err error
+		closedOut, err = executeAndTrackOrder(ctx, tradeStrategyID, userID, closeOut, order.Venue, order.InstrumentType, credentials)
+		return err
+	}); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_rollback_order.close_out", nil)
+	}
+
+	return closedOut, nil
+}
+
+// exitTradeSide returns the trade side that reduces a position entered on the given trade side.
+func exitTradeSide(tradeSide tradeengineproto.TRADE_SIDE) tradeengineproto.TRADE_SIDE {
+	switch tradeSide {
+	case tradeengineproto.TRADE_SIDE_BUY, tradeengineproto.TRADE_SIDE_LONG:
+		return tradeengineproto.TRADE_SIDE_SELL
+	default:
+		return tradeengineproto.TRADE_SIDE_BUY
+	}
+}
+
+// executeWithRetry executes the given venue operation, retrying with a linear backoff whilst the error is retryable. It
+// returns the number of attempts made.
+func executeWithRetry(ctx context.Context, f func() error) (int, error) {
+	var err error
+	for attempt := 1; attempt <= maxExecutionAttempts; attempt++ {
+		err = f()
+		switch {
+		case err == nil:
+			return attempt, nil
+		case classifyExecutionError(err) != tradeengineproto.EXECUTION_ERROR_CLASS_RETRYABLE_EXECUTION_ERROR:
+			return attempt, err
+		case attempt == maxExecutionAttempts:
+			return attempt, err
+		}
+
+		slog.Warn(ctx, "Retryable execution error on attempt %d/%d: %v", attempt, maxExecutionAttempts, err)
+
+		select {
+		case <-time.After(time.Duration(attempt) * executionRetryBackoff):
+		case <-ctx.Done():
+			return attempt, gerrors.Augment(ctx.Err(), "failed_to_execute_with_retry.context_done", nil)
+		}
+	}
+
+	return maxExecutionAttempts, err
+}
+
+// classifyExecutionError classifies a venue error as either retryable or terminal. We only retry where we know the venue
+// hasn't accepted the order: rate limits & the venue being unavailable. Anything ambiguous is terminal since retrying could
+// place a duplicate order; this includes a deadline being exceeded midflight & venue side server errors, since a 5xx from
+// Binance means the status of the order is unknown, not that it wasn't placed.
+func classifyExecutionError(err error) tradeengineproto.EXECUTION_ERROR_CLASS {
+	switch {
+	case err == nil:
+		return tradeengineproto.EXECUTION_ERROR_CLASS_UNCLASSIFIED_EXECUTION_ERROR
+	case gerrors.IsCode(err, gerrors.ErrRateLimited), gerrors.IsCode(err, gerrors.ErrUnavailable):
+		return tradeengineproto.EXECUTION_ERROR_CLASS_RETRYABLE_EXECUTION_ERROR
+	}
+
+	return tradeengineproto.EXECUTION_ERROR_CLASS_TERMINAL_EXECUTION_ERROR
+}
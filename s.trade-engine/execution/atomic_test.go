@@ -0,0 +1,200 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"swallowtail/libraries/gerrors"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func testBracket() []*tradeengineproto.Order {
+	return []*tradeengineproto.Order{
+		{
+			OrderType:  tradeengineproto.ORDER_TYPE_STOP_MARKET,
+			TradeSide:  tradeengineproto.TRADE_SIDE_SELL,
+			Quantity:   1,
+			ReduceOnly: true,
+		},
+		{
+			OrderType: tradeengineproto.ORDER_TYPE_MARKET,
+			TradeSide: tradeengineproto.TRADE_SIDE_BUY,
+			Quantity:  1,
+		},
+		{
+			OrderType:  tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET,
+			TradeSide:  tradeengineproto.TRADE_SIDE_SELL,
+			Quantity:   1,
+			ReduceOnly: true,
+		},
+	}
+}
+
+func TestExecuteOrdersAtomically(t *testing.T) {
+	tests := []struct {
+		name                  string
+		venueErrs             map[string][]error
+		expectedCalls         []string
+		expectedErrorClass    tradeengineproto.EXECUTION_ERROR_CLASS
+		expectedAttempts      int64
+		expectedNumRolledBack int
+	}{
+		{
+			name: "all_orders_placed",
+			expectedCalls: []string{
+				"place:STOP_MARKET:SELL", "place:MARKET:BUY", "place:TAKE_PROFIT_MARKET:SELL",
+			},
+		},
+		{
+			name: "retryable_error_retried",
+			venueErrs: map[string][]error{
+				"place:TAKE_PROFIT_MARKET:SELL": {gerrors.New(gerrors.ErrRateLimited, "too_many_requests", nil)},
+			},
+			expectedCalls: []string{
+				"place:STOP_MARKET:SELL", "place:MARKET:BUY", "place:TAKE_PROFIT_MARKET:SELL", "place:TAKE_PROFIT_MARKET:SELL",
+			},
+		},
+		{
+			name: "terminal_error_rolls_back_in_reverse",
+			venueErrs: map[string][]error{
+				"place:TAKE_PROFIT_MARKET:SELL": {gerrors.FailedPrecondition("bad_request", map[string]string{"status_code": "400"})},
+			},
+			expectedCalls: []string{
+				"place:STOP_MARKET:SELL", "place:MARKET:BUY", "place:TAKE_PROFIT_MARKET:SELL",
+				"place:MARKET:SELL", "cancel:STOP_MARKET",
+			},
+			expectedErrorClass:    tradeengineproto.EXECUTION_ERROR_CLASS_TERMINAL_EXECUTION_ERROR,
+			expectedAttempts:      1,
+			expectedNumRolledBack: 2,
+		},
+		{
+			name: "retries_exhausted_rolls_back",
+			venueErrs: map[string][]error{
+				"place:MARKET:BUY": {
+					gerrors.New(gerrors.ErrUnavailable, "unavailable", nil),
+					gerrors.New(gerrors.ErrUnavailable, "unavailable", nil),
+					gerrors.New(gerrors.ErrUnavailable, "unavailable", nil),
+				},
+			},
+			expectedCalls: []string{
+				"place:STOP_MARKET:SELL", "place:MARKET:BUY", "place:MARKET:BUY", "place:MARKET:BUY", "cancel:STOP_MARKET",
+			},
+			expectedErrorClass:    tradeengineproto.EXECUTION_ERROR_CLASS_RETRYABLE_EXECUTION_ERROR,
+			expectedAttempts:      maxExecutionAttempts,
+			expectedNumRolledBack: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			withFakeOrderStore(t)
+
+			originalBackoff := executionRetryBackoff
+			t.Cleanup(func() {
+				executionRetryBackoff = originalBackoff
+			})
+			executionRetryBackoff = 0
+
+			var calls []string
+			originalCancel := routeAndCancelOrder
+			t.Cleanup(func() {
+				routeAndCancelOrder = originalCancel
+			})
+			routeAndCancelOrder = func(ctx context.Context, order *tradeengineproto.Order, venueCredentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+				calls = append(calls, fmt.Sprintf("cancel:%s", order.OrderType))
+
+				order.Status = tradeengineproto.ORDER_STATUS_CANCELLED_ORDER
+				return order, nil
+			}
+
+			venueErrs := tt.venueErrs
+			routeAndExecuteNewOrder = func(
+				ctx context.Context,
+				order *tradeengineproto.Order,
+				venue tradeengineproto.VENUE,
+				instrumentType tradeengineproto.INSTRUMENT_TYPE,
+				venueCredentials *tradeengineproto.VenueCredentials,
+			) (*tradeengineproto.Order, error) {
+				call := fmt.Sprintf("place:%s:%s", order.OrderType, order.TradeSide)
+				calls = append(calls, call)
+
+				if errs := venueErrs[call]; len(errs) > 0 {
+					venueErrs[call] = errs[1:]
+					return nil, errs[0]
+				}
+
+				placed := proto.Clone(order).(*tradeengineproto.Order)
+				placed.ExternalOrderId = order.OrderType.String()
+				return placed, nil
+			}
+
+			placed, executionErr := executeOrdersAtomically(
+				context.Background(), "trade-strategy-id", "user-id", testBracket(),
+				tradeengineproto.VENUE_BINANCE, tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL, nil,
+			)
+			assert.Equal(t, tt.expectedCalls, calls)
+
+			if tt.expectedErrorClass == tradeengineproto.EXECUTION_ERROR_CLASS_UNCLASSIFIED_EXECUTION_ERROR {
+				require.Nil(t, executionErr)
+				assert.Len(t, placed, len(testBracket()))
+				return
+			}
+
+			require.NotNil(t, executionErr)
+			assert.Empty(t, placed)
+			assert.Equal(t, tt.expectedErrorClass, executionErr.ErrorClass)
+			assert.Equal(t, tt.expectedAttempts, executionErr.Attempts)
+			assert.Len(t, executionErr.RolledBackOrders, tt.expectedNumRolledBack)
+			assert.Empty(t, executionErr.FailedRollbackOrders)
+			assert.True(t, executionErr.ParticipantFlat)
+		})
+	}
+}
+
+func TestClassifyExecutionError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		expectedClass tradeengineproto.EXECUTION_ERROR_CLASS
+	}{
+		{
+			name:          "rate_limited",
+			err:           gerrors.New(gerrors.ErrRateLimited, "too_many_requests", nil),
+			expectedClass: tradeengineproto.EXECUTION_ERROR_CLASS_RETRYABLE_EXECUTION_ERROR,
+		},
+		{
+			name:          "venue_unavailable",
+			err:           gerrors.New(gerrors.ErrUnavailable, "connection_refused", nil),
+			expectedClass: tradeengineproto.EXECUTION_ERROR_CLASS_RETRYABLE_EXECUTION_ERROR,
+		},
+		{
+			name:          "venue_server_error_is_ambiguous",
+			err:           gerrors.Augment(gerrors.FailedPrecondition("bad_request", map[string]string{"status_code": "503"}), "failed_to_execute_order", nil),
+			expectedClass: tradeengineproto.EXECUTION_ERROR_CLASS_TERMINAL_EXECUTION_ERROR,
+		},
+		{
+			name:          "venue_rejected_order",
+			err:           gerrors.FailedPrecondition("bad_request", map[string]string{"status_code": "400"}),
+			expectedClass: tradeengineproto.EXECUTION_ERROR_CLASS_TERMINAL_EXECUTION_ERROR,
+		},
+		{
+			name:          "deadline_exceeded_is_ambiguous",
+			err:           gerrors.New(gerrors.ErrDeadlineExceeded, "deadline_exceeded", nil),
+			expectedClass: tradeengineproto.EXECUTION_ERROR_CLASS_TERMINAL_EXECUTION_ERROR,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expectedClass, classifyExecutionError(tt.err))
+		})
+	}
+}
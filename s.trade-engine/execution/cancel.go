@@ -0,0 +1,81 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+	"swallowtail/s.trade-engine/marshaling"
+	or "swallowtail/s.trade-engine/orderrouter"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// routeAndCancelOrder is a package variable so the order router can be faked in tests.
+var routeAndCancelOrder = or.RouteAndCancelOrder
+
+// CancelOrders cancels the given resting orders on their venues. Reduce only orders (stop losses & take profits) are skipped
+// unless explicitly included; these protect positions that may already have been entered. Each order is cancelled
+// independently, failures are returned with the failure reason set rather than aborting the remaining cancellations.
+func CancelOrders(ctx context.Context, orders []*domain.Order, includeReduceOnlyOrders bool) (cancelled, failed []*tradeengineproto.Order) {
+	return cancelOrders(ctx, orders, includeReduceOnlyOrders, venueCredentialsCache{})
+}
+
+func cancelOrders(
+	ctx context.Context,
+	orders []*domain.Order,
+	includeReduceOnlyOrders bool,
+	credentials venueCredentialsCache,
+) (cancelled, failed []*tradeengineproto.Order) {
+	for _, order := range orders {
+		if order.ReduceOnly && !includeReduceOnlyOrders {
+			continue
+		}
+
+		venueCredentials, err := credentials.read(ctx, order.UserID, tradeengineproto.VENUE(tradeengineproto.VENUE_value[order.Venue]))
+		if err != nil {
+			failedOrder := marshaling.OrderDomainToProto(order)
+			failedOrder.FailureReason = gerrors.Augment(err, "failed_to_cancel_order.credentials", nil).Error()
+			failed = append(failed, failedOrder)
+			continue
+		}
+
+		if err := cancelOrder(ctx, order, venueCredentials); err != nil {
+			slog.Error(ctx, "Failed to cancel order: %s [%s], Error: %v", order.OrderID, order.ExternalOrderID, err)
+
+			failedOrder := marshaling.OrderDomainToProto(order)
+			failedOrder.FailureReason = err.Error()
+			failed = append(failed, failedOrder)
+			continue
+		}
+
+		cancelled = append(cancelled, marshaling.OrderDomainToProto(order))
+	}
+
+	return cancelled, failed
+}
+
+// cancelOrder cancels a single order on its venue & persists the transition.
+func cancelOrder(ctx context.Context, order *domain.Order, credentials *tradeengineproto.VenueCredentials) error {
+	errParams := map[string]string{
+		"order_id":          order.OrderID,
+		"external_order_id": order.ExternalOrderID,
+		"venue":             order.Venue,
+	}
+
+	cancelledOrder, err := routeAndCancelOrder(ctx, marshaling.OrderDomainToProto(order), credentials)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_cancel_order", errParams)
+	}
+
+	order.Status = cancelledOrder.Status.String()
+	if err := updateOrder(ctx, order); err != nil {
+		// The order has been cancelled on the venue; so we only log here.
+		slog.Error(ctx, "Failed to update cancelled order: %s, Error: %v", order.OrderID, err)
+	}
+
+	slog.Info(ctx, "Order cancelled: %s [%s] %s", order.Venue, order.ExternalOrderID, order.Status)
+
+	return nil
+}
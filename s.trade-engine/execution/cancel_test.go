@@ -0,0 +1,160 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func withFakeCanceller(t *testing.T, calls *[]string) {
+	originalCancel := routeAndCancelOrder
+	t.Cleanup(func() {
+		routeAndCancelOrder = originalCancel
+	})
+
+	routeAndCancelOrder = func(ctx context.Context, order *tradeengineproto.Order, venueCredentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+		*calls = append(*calls, "cancel:"+order.ExternalOrderId)
+
+		order.Status = tradeengineproto.ORDER_STATUS_CANCELLED_ORDER
+		return order, nil
+	}
+}
+
+func testCredentialsCache(userID string) venueCredentialsCache {
+	return venueCredentialsCache{
+		userID + "-" + tradeengineproto.VENUE_BINANCE.String(): &tradeengineproto.VenueCredentials{},
+	}
+}
+
+func testOpenOrders(userID string) []*domain.Order {
+	return []*domain.Order{
+		{
+			OrderID:         "entry",
+			UserID:          userID,
+			ExternalOrderID: "1",
+			Venue:           tradeengineproto.VENUE_BINANCE.String(),
+			InstrumentType:  tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL.String(),
+			OrderType:       tradeengineproto.ORDER_TYPE_LIMIT.String(),
+			TradeSide:       tradeengineproto.TRADE_SIDE_BUY.String(),
+			Quantity:        2,
+			Status:          domain.OrderStatusNew,
+		},
+		{
+			OrderID:          "stop-loss",
+			UserID:           userID,
+			ExternalOrderID:  "2",
+			Venue:            tradeengineproto.VENUE_BINANCE.String(),
+			InstrumentType:   tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL.String(),
+			OrderType:        tradeengineproto.ORDER_TYPE_STOP_MARKET.String(),
+			TradeSide:        tradeengineproto.TRADE_SIDE_SELL.String(),
+			StopPrice:        100,
+			Quantity:         2,
+			ExecutedQuantity: 0.5,
+			ReduceOnly:       true,
+			Status:           domain.OrderStatusPartiallyFilled,
+		},
+	}
+}
+
+func TestCancelOrders(t *testing.T) {
+	tests := []struct {
+		name                    string
+		includeReduceOnlyOrders bool
+		expectedCalls           []string
+	}{
+		{
+			name:          "reduce_only_orders_skipped",
+			expectedCalls: []string{"cancel:1"},
+		},
+		{
+			name:                    "reduce_only_orders_included",
+			includeReduceOnlyOrders: true,
+			expectedCalls:           []string{"cancel:1", "cancel:2"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			store := withFakeOrderStore(t)
+
+			var calls []string
+			withFakeCanceller(t, &calls)
+
+			cancelled, failed := cancelOrders(context.Background(), testOpenOrders("user-id"), tt.includeReduceOnlyOrders, testCredentialsCache("user-id"))
+			assert.Empty(t, failed)
+			assert.Len(t, cancelled, len(tt.expectedCalls))
+			assert.Equal(t, tt.expectedCalls, calls)
+
+			for _, transition := range store.transitions {
+				assert.Equal(t, domain.OrderStatusCancelled, transition)
+			}
+		})
+	}
+}
+
+func TestAmendStopLoss(t *testing.T) {
+	tests := []struct {
+		name          string
+		placementErr  error
+		expectedCalls []string
+	}{
+		{
+			name:          "replacement_placed_before_previous_cancelled",
+			expectedCalls: []string{"place:90.00", "cancel:2"},
+		},
+		{
+			name:          "previous_kept_if_replacement_fails",
+			placementErr:  errors.New("insufficient margin"),
+			expectedCalls: []string{"place:90.00"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			withFakeOrderStore(t)
+
+			var calls []string
+			withFakeCanceller(t, &calls)
+
+			routeAndExecuteNewOrder = func(
+				ctx context.Context,
+				order *tradeengineproto.Order,
+				venue tradeengineproto.VENUE,
+				instrumentType tradeengineproto.INSTRUMENT_TYPE,
+				venueCredentials *tradeengineproto.VenueCredentials,
+			) (*tradeengineproto.Order, error) {
+				calls = append(calls, fmt.Sprintf("place:%.2f", order.StopPrice))
+				if tt.placementErr != nil {
+					return nil, tt.placementErr
+				}
+
+				assert.True(t, order.ReduceOnly)
+				assert.InDelta(t, 1.5, order.Quantity, 1e-6)
+				return &tradeengineproto.Order{ExternalOrderId: "3"}, nil
+			}
+
+			amended := amendStopLoss(context.Background(), testOpenOrders("user-id"), 90, testCredentialsCache("user-id"))
+			assert.Equal(t, tt.expectedCalls, calls)
+			require.Len(t, amended, 1)
+
+			if tt.placementErr != nil {
+				assert.NotEmpty(t, amended[0].ErrorMessage)
+				assert.Nil(t, amended[0].NewStopLoss)
+				return
+			}
+
+			assert.Empty(t, amended[0].ErrorMessage)
+			assert.Equal(t, "3", amended[0].NewStopLoss.ExternalOrderId)
+			assert.Equal(t, tradeengineproto.ORDER_STATUS_CANCELLED_ORDER, amended[0].PreviousStopLoss.Status)
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/domain"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	// consecutiveVenueRejectionsThreshold is the number of the users most recent orders that, if all rejected by the venue,
+	// trips the users circuit breaker.
+	consecutiveVenueRejectionsThreshold = 5
+	// maxDailyDrawdownPercentage is the percentage of the users venue balance that, if lost in a single day (UTC), trips the
+	// users circuit breaker.
+	maxDailyDrawdownPercentage = 20.0
+)
+
+const (
+	// Reasons for automatically tripping circuit breakers.
+	CircuitBreakerReasonConsecutiveVenueRejections = "consecutive_venue_rejections"
+	CircuitBreakerReasonDailyDrawdown              = "daily_drawdown"
+)
+
+// readCircuitBreaker, upsertCircuitBreaker, listRecentOrderStatuses & listOrdersOfOpenTradeStrategies are package variables
+// so circuit breakers can be faked in tests.
+var (
+	readCircuitBreaker              = dao.ReadCircuitBreaker
+	upsertCircuitBreaker            = dao.UpsertCircuitBreaker
+	listRecentOrderStatuses         = dao.ListRecentOrderStatusesByUserID
+	listOrdersOfOpenTradeStrategies = dao.ListOrdersOfOpenTradeStrategiesByUserID
+)
+
+// CheckCircuitBreakers returns a failed precondition if either the system circuit breaker, or that of the user, is tripped.
+func CheckCircuitBreakers(ctx context.Context, userID string) error {
+	for _, scope := range []tradeengineproto.CIRCUIT_BREAKER_SCOPE{
+		tradeengineproto.CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER,
+		tradeengineproto.CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER,
+	} {
+		circuitBreaker, err := readCircuitBreaker(ctx, scope.String(), circuitBreakerUserID(scope, userID))
+		switch {
+		case gerrors.Is(err, gerrors.ErrNotFound, "not_found.circuit_breaker"):
+			continue
+		case err != nil:
+			return gerrors.Augment(err, "failed_to_check_circuit_breakers", nil)
+		case !circuitBreaker.IsTripped:
+			continue
+		}
+
+		msg := "circuit_breaker_tripped.user"
+		if scope == tradeengineproto.CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER {
+			msg = "circuit_breaker_tripped.system"
+		}
+
+		return gerrors.FailedPrecondition(msg, map[string]string{
+			"reason":   circuitBreaker.Reason,
+			"actor_id": circuitBreaker.ActorID,
+			"tripped":  circuitBreaker.Tripped.String(),
+		})
+	}
+
+	return nil
+}
+
+// TripCircuitBreaker trips the circuit breaker of the given scope, halting any new trade strategies from being executed.
+func TripCircuitBreaker(ctx context.Context, scope tradeengineproto.CIRCUIT_BREAKER_SCOPE, userID, actorID, reason string) (*domain.CircuitBreaker, error) {
+	now := time.Now().UTC()
+	circuitBreaker := &domain.CircuitBreaker{
+		Scope:       scope.String(),
+		UserID:      circuitBreakerUserID(scope, userID),
+		IsTripped:   true,
+		Reason:      reason,
+		ActorID:     actorID,
+		Tripped:     now,
+		LastUpdated: now,
+	}
+
+	if err := upsertCircuitBreaker(ctx, circuitBreaker); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_trip_circuit_breaker", nil)
+	}
+
+	slog.Warn(ctx, "Circuit breaker tripped: %s %s by %s, reason: %s", circuitBreaker.Scope, circuitBreaker.UserID, actorID, reason)
+
+	return circuitBreaker, nil
+}
+
+// ResetCircuitBreaker resets the circuit breaker of the given scope; the time it was last tripped is retained.
+func ResetCircuitBreaker(ctx context.Context, scope tradeengineproto.CIRCUIT_BREAKER_SCOPE, userID, actorID string) (*domain.CircuitBreaker, error) {
+	circuitBreaker, err := readCircuitBreaker(ctx, scope.String(), circuitBreakerUserID(scope, userID))
+	switch {
+	case gerrors.Is(err, gerrors.ErrNotFound, "not_found.circuit_breaker"):
+		return nil, gerrors.FailedPrecondition("failed_to_reset_circuit_breaker.never_tripped", nil)
+	case err != nil:
+		return nil, gerrors.Augment(err, "failed_to_reset_circuit_breaker", nil)
+	}
+
+	circuitBreaker.IsTripped = false
+	circuitBreaker.ActorID = actorID
+	circuitBreaker.LastUpdated = time.Now().UTC()
+
+	if err := upsertCircuitBreaker(ctx, circuitBreaker); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_reset_circuit_breaker", nil)
+	}
+
+	slog.Warn(ctx, "Circuit breaker reset: %s %s by %s", circuitBreaker.Scope, circuitBreaker.UserID, actorID)
+
+	return circuitBreaker, nil
+}
+
+// HaltedTrading is the outcome of halting trading for a single user.
+type HaltedTrading struct {
+	CancelledOrders []*tradeengineproto.Order
+	ClosedOutOrders []*tradeengineproto.Order
+	FailedOrders    []*tradeengineproto.Order
+}
+
+// HaltTrading cancels the resting entries of every open trade strategy of the user. If flattening positions then every
+// resting order is cancelled, including protective orders, & any open position is closed out with a market order. Positions
+// are derived from the executed quantities of the tracked orders, as of the last time they were polled.
+func HaltTrading(ctx context.Context, userID string, flattenPositions bool) (*HaltedTrading, error) {
+	orders, err := listOrdersOfOpenTradeStrategies(ctx, userID)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_halt_trading.list_orders", map[string]string{
+			"user_id": userID,
+		})
+	}
+
+	return haltTrading(ctx, orders, flattenPositions, venueCredentialsCache{}), nil
+}
+
+func haltTrading(ctx context.Context, orders []*domain.Order, flattenPositions bool, credentials venueCredentialsCache) *HaltedTrading {
+	halted := &HaltedTrading{}
+	halted.CancelledOrders, halted.FailedOrders = cancelOrders(ctx, restingOrders(orders), flattenPositions, credentials)
+
+	if !flattenPositions {
+		return halted
+	}
+
+	for _, position := range netOpenPositions(orders) {
+		entry := marshaling.OrderDomainToProto(position.Entry)
+
+		venueCredentials, err := credentials.read(ctx, position.Entry.UserID, entry.Venue)
+		if err == nil {
+			var closedOut *tradeengineproto.Order
+			closedOut, err = closeOutOrder(ctx, position.Entry.TradeStrategyID, position.Entry.UserID, entry, float32(position.Quantity), venueCredentials)
+			if err == nil {
+				halted.ClosedOutOrders = append(halted.ClosedOutOrders, closedOut)
+				continue
+			}
+		}
+
+		slog.Error(ctx, "Failed to close out position: %s %s %s, Error: %v", position.Entry.TradeStrategyID, position.Entry.UserID, position.Entry.Instrument, err)
+
+		entry.Quantity = float32(position.Quantity)
+		entry.FailureReason = gerrors.Augment(err, "failed_to_close_out_position", nil).Error()
+		halted.FailedOrders = append(halted.FailedOrders, entry)
+	}
+
+	return halted
+}
+
+// openPosition is the net executed quantity of a trade strategy in a single instrument; in the direction of its first entry.
+type openPosition struct {
+	Entry    *domain.Order
+	Quantity float64
+}
+
+// netOpenPositions nets the executed quantity of the given orders by trade strategy & instrument. Fills on the side of the
+// first entry add to the position; fills on the opposite side, such as stop losses, take profits & close outs, reduce it.
+func netOpenPositions(orders []*domain.Order) []*openPosition {
+	var (
+		positions []*openPosition
+		index     = map[string]*openPosition{}
+	)
+
+	for _, order := range orders {
+		if order.ExecutedQuantity == 0 {
+			continue
+		}
+
+		key := fmt.Sprintf("%s-%s-%s", order.TradeStrategyID, order.Venue, order.Instrument)
+		position, ok := index[key]
+		switch {
+		case !ok && order.ReduceOnly:
+			// Nothing to reduce.
+			continue
+		case !ok:
+			position = &openPosition{Entry: order}
+			index[key] = position
+			positions = append(positions, position)
+		}
+
+		if isBuySide(order.TradeSide) == isBuySide(position.Entry.TradeSide) {
+			position.Quantity += order.ExecutedQuantity
+			continue
+		}
+
+		position.Quantity -= order.ExecutedQuantity
+	}
+
+	var open []*openPosition
+	for _, position := range positions {
+		if position.Quantity > 1e-9 {
+			open = append(open, position)
+		}
+	}
+
+	return open
+}
+
+func isBuySide(tradeSide string) bool {
+	switch tradeSide {
+	case tradeengineproto.TRADE_SIDE_BUY.String(), tradeengineproto.TRADE_SIDE_LONG.String():
+		return true
+	default:
+		return false
+	}
+}
+
+// tripOnConsecutiveVenueRejections trips the users circuit breaker if each of their most recent orders was rejected by the venue.
+func tripOnConsecutiveVenueRejections(ctx context.Context, userID string) error {
+	statuses, err := listRecentOrderStatuses(ctx, userID, consecutiveVenueRejectionsThreshold)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_check_consecutive_venue_rejections", nil)
+	}
+
+	if len(statuses) < consecutiveVenueRejectionsThreshold {
+		return nil
+	}
+
+	for _, status := range statuses {
+		if status != domain.OrderStatusRejected {
+			return nil
+		}
+	}
+
+	return tripCircuitBreakerAutomatically(ctx, userID, CircuitBreakerReasonConsecutiveVenueRejections)
+}
+
+// tripOnDrawdown trips the users circuit breaker if they have lost more than the max daily drawdown of their venue balance
+// today. Since the loss is already realized, the balance is taken to be that after the loss. If tripped, the circuit breaker
+// error is returned.
+func tripOnDrawdown(ctx context.Context, userID string, venueAccountBalance, realizedLossToday float64) error {
+	if realizedLossToday <= 0 {
+		return nil
+	}
+
+	drawdown := realizedLossToday / (venueAccountBalance + realizedLossToday) * 100
+	if drawdown < maxDailyDrawdownPercentage {
+		return nil
+	}
+
+	if err := tripCircuitBreakerAutomatically(ctx, userID, CircuitBreakerReasonDailyDrawdown); err != nil {
+		return gerrors.Augment(err, "failed_to_trip_on_drawdown", nil)
+	}
+
+	return gerrors.FailedPrecondition("circuit_breaker_tripped.user", map[string]string{
+		"reason":   CircuitBreakerReasonDailyDrawdown,
+		"drawdown": fmt.Sprintf("%.2f", math.Round(drawdown*100)/100),
+	})
+}
+
+func tripCircuitBreakerAutomatically(ctx context.Context, userID, reason string) error {
+	scope := tradeengineproto.CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER
+
+	existing, err := readCircuitBreaker(ctx, scope.String(), userID)
+	switch {
+	case gerrors.Is(err, gerrors.ErrNotFound, "not_found.circuit_breaker"):
+	case err != nil:
+		return gerrors.Augment(err, "failed_to_trip_circuit_breaker_automatically", nil)
+	case existing.IsTripped:
+		return nil
+	}
+
+	if _, err := TripCircuitBreaker(ctx, scope, userID, tradeengineproto.TradeEngineActorSatoshiSystem, reason); err != nil {
+		return gerrors.Augment(err, "failed_to_trip_circuit_breaker_automatically", nil)
+	}
+
+	// Best effort.
+	msg := fmt.Sprintf("Your trading has been halted (%s); no new trade strategies will be placed until an admin resets it.", reason)
+	if err := notifyUserOfCircuitBreaker(ctx, msg, userID); err != nil {
+		slog.Error(ctx, "Failed to notify user of tripped circuit breaker: %v", err)
+	}
+
+	return nil
+}
+
+// notifyUserOfCircuitBreaker is a package variable so notifications can be faked in tests.
+var notifyUserOfCircuitBreaker = notifyUser
+
+func circuitBreakerUserID(scope tradeengineproto.CIRCUIT_BREAKER_SCOPE, userID string) string {
+	if scope == tradeengineproto.CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER {
+		return ""
+	}
+
+	return userID
+}
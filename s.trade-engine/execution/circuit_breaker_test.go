@@ -0,0 +1,240 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// withFakeCircuitBreakers stubs the circuit breaker store with an in memory map keyed by scope & user id.
+func withFakeCircuitBreakers(t *testing.T) (map[string]*domain.CircuitBreaker, *[]string) {
+	circuitBreakers := map[string]*domain.CircuitBreaker{}
+	var notifications []string
+
+	originalRead, originalUpsert, originalNotify := readCircuitBreaker, upsertCircuitBreaker, notifyUserOfCircuitBreaker
+	t.Cleanup(func() {
+		readCircuitBreaker, upsertCircuitBreaker, notifyUserOfCircuitBreaker = originalRead, originalUpsert, originalNotify
+	})
+
+	readCircuitBreaker = func(ctx context.Context, scope, userID string) (*domain.CircuitBreaker, error) {
+		circuitBreaker, ok := circuitBreakers[scope+"-"+userID]
+		if !ok {
+			return nil, gerrors.NotFound("not_found.circuit_breaker", nil)
+		}
+		return circuitBreaker, nil
+	}
+	upsertCircuitBreaker = func(ctx context.Context, circuitBreaker *domain.CircuitBreaker) error {
+		circuitBreakers[circuitBreaker.Scope+"-"+circuitBreaker.UserID] = circuitBreaker
+		return nil
+	}
+	notifyUserOfCircuitBreaker = func(ctx context.Context, msg, userID string) error {
+		notifications = append(notifications, userID)
+		return nil
+	}
+
+	return circuitBreakers, &notifications
+}
+
+func TestCheckCircuitBreakers(t *testing.T) {
+	withFakeCircuitBreakers(t)
+	ctx := context.Background()
+
+	require.NoError(t, CheckCircuitBreakers(ctx, "user-id"))
+
+	_, err := TripCircuitBreaker(ctx, tradeengineproto.CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER, "user-id", "actor-id", "manual")
+	require.NoError(t, err)
+
+	gerrors.AssertIs(t, CheckCircuitBreakers(ctx, "user-id"), gerrors.ErrFailedPrecondition, "circuit_breaker_tripped.user")
+	require.NoError(t, CheckCircuitBreakers(ctx, "other-user-id"))
+
+	// The system circuit breaker halts everyone, regardless of the user id given when tripping it.
+	_, err = TripCircuitBreaker(ctx, tradeengineproto.CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER, "user-id", "actor-id", "venue outage")
+	require.NoError(t, err)
+	gerrors.AssertIs(t, CheckCircuitBreakers(ctx, "other-user-id"), gerrors.ErrFailedPrecondition, "circuit_breaker_tripped.system")
+
+	_, err = ResetCircuitBreaker(ctx, tradeengineproto.CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER, "", "actor-id")
+	require.NoError(t, err)
+	require.NoError(t, CheckCircuitBreakers(ctx, "other-user-id"))
+	gerrors.AssertIs(t, CheckCircuitBreakers(ctx, "user-id"), gerrors.ErrFailedPrecondition, "circuit_breaker_tripped.user")
+
+	_, err = ResetCircuitBreaker(ctx, tradeengineproto.CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER, "never-tripped", "actor-id")
+	gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, "failed_to_reset_circuit_breaker.never_tripped")
+}
+
+func TestTripOnConsecutiveVenueRejections(t *testing.T) {
+	tests := []struct {
+		name            string
+		statuses        []string
+		expectedTripped bool
+	}{
+		{
+			name:     "too_few_orders",
+			statuses: []string{domain.OrderStatusRejected, domain.OrderStatusRejected},
+		},
+		{
+			name: "accepted_order_within_window",
+			statuses: []string{
+				domain.OrderStatusRejected, domain.OrderStatusRejected, domain.OrderStatusNew,
+				domain.OrderStatusRejected, domain.OrderStatusRejected,
+			},
+		},
+		{
+			name: "every_order_rejected",
+			statuses: []string{
+				domain.OrderStatusRejected, domain.OrderStatusRejected, domain.OrderStatusRejected,
+				domain.OrderStatusRejected, domain.OrderStatusRejected,
+			},
+			expectedTripped: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			circuitBreakers, notifications := withFakeCircuitBreakers(t)
+
+			originalListRecentOrderStatuses := listRecentOrderStatuses
+			t.Cleanup(func() {
+				listRecentOrderStatuses = originalListRecentOrderStatuses
+			})
+			listRecentOrderStatuses = func(ctx context.Context, userID string, limit int) ([]string, error) {
+				assert.Equal(t, consecutiveVenueRejectionsThreshold, limit)
+				return tt.statuses, nil
+			}
+
+			require.NoError(t, tripOnConsecutiveVenueRejections(context.Background(), "user-id"))
+
+			circuitBreaker, ok := circuitBreakers[tradeengineproto.CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER.String()+"-user-id"]
+			if !tt.expectedTripped {
+				assert.False(t, ok)
+				assert.Empty(t, *notifications)
+				return
+			}
+
+			require.True(t, ok)
+			assert.True(t, circuitBreaker.IsTripped)
+			assert.Equal(t, CircuitBreakerReasonConsecutiveVenueRejections, circuitBreaker.Reason)
+			assert.Equal(t, tradeengineproto.TradeEngineActorSatoshiSystem, circuitBreaker.ActorID)
+			assert.Equal(t, []string{"user-id"}, *notifications)
+		})
+	}
+}
+
+func TestTripOnDrawdown(t *testing.T) {
+	_, notifications := withFakeCircuitBreakers(t)
+	ctx := context.Background()
+
+	// Lost 150 of an original 1000; 15% drawdown.
+	require.NoError(t, tripOnDrawdown(ctx, "user-id", 850, 150))
+	require.NoError(t, CheckCircuitBreakers(ctx, "user-id"))
+
+	// Lost 250 of an original 1000; 25% drawdown.
+	err := tripOnDrawdown(ctx, "user-id", 750, 250)
+	gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, "circuit_breaker_tripped.user")
+	gerrors.AssertIs(t, CheckCircuitBreakers(ctx, "user-id"), gerrors.ErrFailedPrecondition, "circuit_breaker_tripped.user")
+
+	// Already tripped; the user isn't notified twice.
+	err = tripOnDrawdown(ctx, "user-id", 700, 300)
+	gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, "circuit_breaker_tripped.user")
+	assert.Equal(t, []string{"user-id"}, *notifications)
+}
+
+func TestNetOpenPositions(t *testing.T) {
+	t.Parallel()
+
+	order := func(tradeStrategyID, tradeSide string, executedQuantity float64, reduceOnly bool) *domain.Order {
+		return &domain.Order{
+			TradeStrategyID:  tradeStrategyID,
+			Venue:            tradeengineproto.VENUE_BINANCE.String(),
+			Instrument:       "BTCUSDT",
+			TradeSide:        tradeSide,
+			ExecutedQuantity: executedQuantity,
+			ReduceOnly:       reduceOnly,
+		}
+	}
+
+	positions := netOpenPositions([]*domain.Order{
+		// Long 2, stopped out of 0.5.
+		order("strategy-1", tradeengineproto.TRADE_SIDE_BUY.String(), 2, false),
+		order("strategy-1", tradeengineproto.TRADE_SIDE_SELL.String(), 0.5, true),
+		// Short 1, fully taken profit.
+		order("strategy-2", tradeengineproto.TRADE_SIDE_SHORT.String(), 1, false),
+		order("strategy-2", tradeengineproto.TRADE_SIDE_BUY.String(), 1, true),
+		// Nothing filled.
+		order("strategy-3", tradeengineproto.TRADE_SIDE_BUY.String(), 0, false),
+		// Reduce only fill without an entry.
+		order("strategy-4", tradeengineproto.TRADE_SIDE_SELL.String(), 1, true),
+	})
+
+	require.Len(t, positions, 1)
+	assert.Equal(t, "strategy-1", positions[0].Entry.TradeStrategyID)
+	assert.InDelta(t, 1.5, positions[0].Quantity, 1e-9)
+}
+
+func TestHaltTrading(t *testing.T) {
+	var calls []string
+	store := withFakeOrderStore(t)
+	withFakeCanceller(t, &calls)
+
+	var closeOuts []*tradeengineproto.Order
+	routeAndExecuteNewOrder = func(
+		ctx context.Context,
+		order *tradeengineproto.Order,
+		venue tradeengineproto.VENUE,
+		instrumentType tradeengineproto.INSTRUMENT_TYPE,
+		venueCredentials *tradeengineproto.VenueCredentials,
+	) (*tradeengineproto.Order, error) {
+		closeOuts = append(closeOuts, order)
+		return order, nil
+	}
+
+	// Cancelling mutates the orders, so each run is given its own.
+	orders := func() []*domain.Order {
+		return append(testOpenOrders("user-id"), &domain.Order{
+			OrderID:          "filled-entry",
+			UserID:           "user-id",
+			ExternalOrderID:  "3",
+			Venue:            tradeengineproto.VENUE_BINANCE.String(),
+			InstrumentType:   tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL.String(),
+			Instrument:       "BTCUSDT",
+			OrderType:        tradeengineproto.ORDER_TYPE_MARKET.String(),
+			TradeSide:        tradeengineproto.TRADE_SIDE_BUY.String(),
+			Quantity:         1,
+			ExecutedQuantity: 1,
+			Status:           domain.OrderStatusFilled,
+		})
+	}
+
+	t.Run("cancel_resting_entries", func(t *testing.T) {
+		calls, closeOuts, store.transitions = nil, nil, nil
+
+		halted := haltTrading(context.Background(), orders(), false, testCredentialsCache("user-id"))
+		assert.Equal(t, []string{"cancel:1"}, calls)
+		assert.Len(t, halted.CancelledOrders, 1)
+		assert.Empty(t, halted.ClosedOutOrders)
+		assert.Empty(t, halted.FailedOrders)
+		assert.Empty(t, closeOuts)
+	})
+
+	t.Run("flatten_positions", func(t *testing.T) {
+		calls, closeOuts, store.transitions = nil, nil, nil
+
+		halted := haltTrading(context.Background(), orders(), true, testCredentialsCache("user-id"))
+		assert.Equal(t, []string{"cancel:1", "cancel:2"}, calls)
+		assert.Len(t, halted.CancelledOrders, 2)
+		assert.Empty(t, halted.FailedOrders)
+
+		require.Len(t, closeOuts, 1)
+		assert.Equal(t, tradeengineproto.TRADE_SIDE_SELL, closeOuts[0].TradeSide)
+		assert.Equal(t, tradeengineproto.ORDER_TYPE_MARKET, closeOuts[0].OrderType)
+		assert.True(t, closeOuts[0].ReduceOnly)
+		assert.Equal(t, float32(1), closeOuts[0].Quantity)
+		assert.Len(t, halted.ClosedOutOrders, 1)
+	})
+}
@@ -1,24 +1,131 @@
 package execution
 
-import "swallowtail/libraries/gerrors"
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
 
-const (
-	retailMaxRiskPerTradeStrategy = 10.5 // +0.5 to act as a buffer.
-	retailMinVenueMargainInUSDT   = 100
+	"swallowtail/libraries/gerrors"
+	accountproto "swallowtail/s.account/proto"
+	"swallowtail/s.trade-engine/dao"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
 )
 
-func isTradeStrategyParticipantOverRiskAppetite(accountBalance float64, totalOrderSize float64) error {
-	if totalOrderSize > accountBalance*retailMaxRiskPerTradeStrategy {
-		return gerrors.FailedPrecondition("trade_strategy_participant_over_risk_appetite", nil)
+// readRiskProfile & readRiskExposure are package variables so risk profiles can be faked in tests.
+var (
+	readRiskProfile  = readParticipantRiskProfile
+	readRiskExposure = readParticipantRiskExposure
+)
+
+// riskExposure is the participants current exposure; measured against their risk profile before placing a new trade strategy.
+type riskExposure struct {
+	OpenTradeStrategies int
+	AssetNotional       float64
+	RealizedLossToday   float64
+}
+
+func readParticipantRiskExposure(ctx context.Context, userID, asset string) (*riskExposure, error) {
+	openTradeStrategies, err := dao.CountOpenTradeStrategiesByUserID(ctx, userID)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_risk_exposure.open_trade_strategies", nil)
 	}
 
-	return nil
+	assetNotional, err := dao.ReadOpenNotionalByUserIDAndAsset(ctx, userID, asset)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_risk_exposure.asset_notional", nil)
+	}
+
+	startOfDay := time.Now().UTC().Truncate(24 * time.Hour)
+	realizedLoss, err := dao.ReadRealizedLossByUserIDSince(ctx, userID, startOfDay)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_risk_exposure.realized_loss", nil)
+	}
+
+	return &riskExposure{
+		OpenTradeStrategies: openTradeStrategies,
+		AssetNotional:       assetNotional,
+		RealizedLossToday:   realizedLoss,
+	}, nil
 }
 
-func isEnoughAvailableVenueMargin(accountBalanceInUSDT float64) error {
-	if accountBalanceInUSDT < retailMinVenueMargainInUSDT {
-		return gerrors.FailedPrecondition("venue_balance_too_small", nil)
+// enforceRiskProfile validates the trade strategy against the participants risk profile; it must be called before any
+// order is routed to a venue. The entry price is used to price the notional of the total quantity.
+func enforceRiskProfile(
+	ctx context.Context,
+	strategy *tradeengineproto.TradeStrategy,
+	participant *tradeengineproto.ExecuteTradeStrategyForParticipantRequest,
+	venueAccountBalance, totalQuantity, entryPrice float64,
+) error {
+	errParams := map[string]string{
+		"user_id": participant.UserId,
+	}
+
+	riskProfile, err := readRiskProfile(ctx, participant.UserId)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_enforce_risk_profile", errParams)
+	}
+
+	exposure, err := readRiskExposure(ctx, participant.UserId, strategy.Asset)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_enforce_risk_profile", errParams)
+	}
+
+	if err := tripOnDrawdown(ctx, participant.UserId, venueAccountBalance, exposure.RealizedLossToday); err != nil {
+		return gerrors.Augment(err, "failed_to_enforce_risk_profile", errParams)
+	}
+
+	return checkRiskProfile(riskProfile, exposure, strategy, float64(participant.Risk), venueAccountBalance, totalQuantity*entryPrice)
+}
+
+// checkRiskProfile checks each limit of the risk profile in turn; returning a failed precondition naming the first limit
+// breached, alongside both the limit & the value that breached it. Zero valued limits are unlimited.
+func checkRiskProfile(
+	riskProfile *accountproto.RiskProfile,
+	exposure *riskExposure,
+	strategy *tradeengineproto.TradeStrategy,
+	risk, venueAccountBalance, notional float64,
+) error {
+	violation := func(limit string, limitValue, value interface{}) error {
+		return gerrors.FailedPrecondition(fmt.Sprintf("risk_profile_violation.%s", limit), map[string]string{
+			"limit": fmt.Sprintf("%v", limitValue),
+			"value": fmt.Sprintf("%v", value),
+		})
+	}
+
+	switch {
+	case !isInstrumentAllowed(riskProfile.InstrumentAllowList, strategy):
+		return violation("instrument_not_allowed", strings.Join(riskProfile.InstrumentAllowList, ","), strategy.Instrument)
+	case riskProfile.MaxRiskPerTrade > 0 && risk > float64(riskProfile.MaxRiskPerTrade):
+		return violation("max_risk_per_trade_exceeded", riskProfile.MaxRiskPerTrade, risk)
+	case venueAccountBalance < float64(riskProfile.MinVenueBalance):
+		return violation("venue_balance_too_small", riskProfile.MinVenueBalance, fmt.Sprintf("%.2f", venueAccountBalance))
+	case riskProfile.MaxLeverage > 0 && notional > venueAccountBalance*float64(riskProfile.MaxLeverage):
+		return violation("max_leverage_exceeded", riskProfile.MaxLeverage, fmt.Sprintf("%.2f", notional/venueAccountBalance))
+	case riskProfile.MaxNotionalPerAsset > 0 && exposure.AssetNotional+notional > float64(riskProfile.MaxNotionalPerAsset):
+		return violation("max_notional_per_asset_exceeded", riskProfile.MaxNotionalPerAsset, fmt.Sprintf("%.2f", exposure.AssetNotional+notional))
+	case riskProfile.MaxConcurrentOpenStrategies > 0 && int64(exposure.OpenTradeStrategies) >= riskProfile.MaxConcurrentOpenStrategies:
+		return violation("max_concurrent_open_strategies_exceeded", riskProfile.MaxConcurrentOpenStrategies, exposure.OpenTradeStrategies+1)
+	case riskProfile.DailyLossLimit > 0 && exposure.RealizedLossToday >= float64(riskProfile.DailyLossLimit):
+		return violation("daily_loss_limit_exceeded", riskProfile.DailyLossLimit, fmt.Sprintf("%.2f", exposure.RealizedLossToday))
 	}
 
 	return nil
 }
+
+// isInstrumentAllowed checks the trade strategy against an allow list of either instruments or assets; an empty allow
+// list allows everything.
+func isInstrumentAllowed(allowList []string, strategy *tradeengineproto.TradeStrategy) bool {
+	if len(allowList) == 0 {
+		return true
+	}
+
+	for _, allowed := range allowList {
+		switch strings.ToUpper(allowed) {
+		case strings.ToUpper(strategy.Instrument), strings.ToUpper(strategy.Asset):
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,206 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	accountproto "swallowtail/s.account/proto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func TestCheckRiskProfile(t *testing.T) {
+	t.Parallel()
+
+	strategy := &tradeengineproto.TradeStrategy{
+		Asset:      "BTC",
+		Instrument: "BTCUSDT",
+	}
+
+	defaultRiskProfile := func() *accountproto.RiskProfile {
+		return &accountproto.RiskProfile{
+			MaxRiskPerTrade: accountproto.DefaultMaxRiskPerTrade,
+			MaxLeverage:     accountproto.DefaultMaxLeverage,
+			MinVenueBalance: accountproto.DefaultMinVenueBalance,
+		}
+	}
+
+	tests := []struct {
+		name                string
+		riskProfile         func() *accountproto.RiskProfile
+		exposure            *riskExposure
+		risk                float64
+		venueAccountBalance float64
+		notional            float64
+		expectedViolation   string
+		expectedLimit       string
+	}{
+		{
+			name:                "default_risk_profile_within_limits",
+			riskProfile:         defaultRiskProfile,
+			exposure:            &riskExposure{OpenTradeStrategies: 100, AssetNotional: 1e9, RealizedLossToday: 1e9},
+			risk:                2,
+			venueAccountBalance: 1000,
+			notional:            5000,
+		},
+		{
+			name: "instrument_allowed_by_asset",
+			riskProfile: func() *accountproto.RiskProfile {
+				rp := defaultRiskProfile()
+				rp.InstrumentAllowList = []string{"ETH", "btc"}
+				return rp
+			},
+			exposure:            &riskExposure{},
+			risk:                2,
+			venueAccountBalance: 1000,
+			notional:            5000,
+		},
+		{
+			name: "instrument_not_allowed",
+			riskProfile: func() *accountproto.RiskProfile {
+				rp := defaultRiskProfile()
+				rp.InstrumentAllowList = []string{"ETHUSDT", "SOL"}
+				return rp
+			},
+			exposure:            &riskExposure{},
+			risk:                2,
+			venueAccountBalance: 1000,
+			notional:            5000,
+			expectedViolation:   "risk_profile_violation.instrument_not_allowed",
+			expectedLimit:       "ETHUSDT,SOL",
+		},
+		{
+			name: "max_risk_per_trade_exceeded",
+			riskProfile: func() *accountproto.RiskProfile {
+				rp := defaultRiskProfile()
+				rp.MaxRiskPerTrade = 5
+				return rp
+			},
+			exposure:            &riskExposure{},
+			risk:                10,
+			venueAccountBalance: 1000,
+			notional:            5000,
+			expectedViolation:   "risk_profile_violation.max_risk_per_trade_exceeded",
+			expectedLimit:       "5",
+		},
+		{
+			name:                "venue_balance_too_small",
+			riskProfile:         defaultRiskProfile,
+			exposure:            &riskExposure{},
+			risk:                2,
+			venueAccountBalance: 99,
+			notional:            50,
+			expectedViolation:   "risk_profile_violation.venue_balance_too_small",
+			expectedLimit:       "100",
+		},
+		{
+			name:                "max_leverage_exceeded",
+			riskProfile:         defaultRiskProfile,
+			exposure:            &riskExposure{},
+			risk:                2,
+			venueAccountBalance: 1000,
+			notional:            11000,
+			expectedViolation:   "risk_profile_violation.max_leverage_exceeded",
+			expectedLimit:       "10.5",
+		},
+		{
+			name: "max_notional_per_asset_exceeded_including_open_exposure",
+			riskProfile: func() *accountproto.RiskProfile {
+				rp := defaultRiskProfile()
+				rp.MaxNotionalPerAsset = 10000
+				return rp
+			},
+			exposure:            &riskExposure{AssetNotional: 6000},
+			risk:                2,
+			venueAccountBalance: 1000,
+			notional:            5000,
+			expectedViolation:   "risk_profile_violation.max_notional_per_asset_exceeded",
+			expectedLimit:       "10000",
+		},
+		{
+			name: "max_concurrent_open_strategies_exceeded",
+			riskProfile: func() *accountproto.RiskProfile {
+				rp := defaultRiskProfile()
+				rp.MaxConcurrentOpenStrategies = 3
+				return rp
+			},
+			exposure:            &riskExposure{OpenTradeStrategies: 3},
+			risk:                2,
+			venueAccountBalance: 1000,
+			notional:            5000,
+			expectedViolation:   "risk_profile_violation.max_concurrent_open_strategies_exceeded",
+			expectedLimit:       "3",
+		},
+		{
+			name: "daily_loss_limit_exceeded",
+			riskProfile: func() *accountproto.RiskProfile {
+				rp := defaultRiskProfile()
+				rp.DailyLossLimit = 200
+				return rp
+			},
+			exposure:            &riskExposure{RealizedLossToday: 250},
+			risk:                2,
+			venueAccountBalance: 1000,
+			notional:            5000,
+			expectedViolation:   "risk_profile_violation.daily_loss_limit_exceeded",
+			expectedLimit:       "200",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkRiskProfile(tt.riskProfile(), tt.exposure, strategy, tt.risk, tt.venueAccountBalance, tt.notional)
+			if tt.expectedViolation == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, tt.expectedViolation)
+
+			limits, ok := gerrors.CollectDetailByKeyFromError(err, "limit")
+			require.True(t, ok)
+			assert.Equal(t, []string{tt.expectedLimit}, limits)
+		})
+	}
+}
+
+func TestEnforceRiskProfile(t *testing.T) {
+	originalReadRiskProfile, originalReadRiskExposure := readRiskProfile, readRiskExposure
+	t.Cleanup(func() {
+		readRiskProfile, readRiskExposure = originalReadRiskProfile, originalReadRiskExposure
+	})
+
+	readRiskProfile = func(ctx context.Context, userID string) (*accountproto.RiskProfile, error) {
+		return &accountproto.RiskProfile{
+			UserId:      userID,
+			MaxLeverage: 2,
+		}, nil
+	}
+
+	var exposureAsset string
+	readRiskExposure = func(ctx context.Context, userID, asset string) (*riskExposure, error) {
+		exposureAsset = asset
+		return &riskExposure{}, nil
+	}
+
+	strategy := &tradeengineproto.TradeStrategy{Asset: "ETH", Instrument: "ETHUSDT"}
+	participant := &tradeengineproto.ExecuteTradeStrategyForParticipantRequest{UserId: "user-id", Risk: 1}
+
+	// 1 ETH @ 1500 on a 1000 balance is within 2x leverage.
+	require.NoError(t, enforceRiskProfile(context.Background(), strategy, participant, 1000, 1, 1500))
+	assert.Equal(t, "ETH", exposureAsset)
+
+	// 2 ETH @ 1500 on a 1000 balance is 3x leverage.
+	err := enforceRiskProfile(context.Background(), strategy, participant, 1000, 2, 1500)
+	gerrors.AssertIs(t, err, gerrors.ErrFailedPrecondition, "risk_profile_violation.max_leverage_exceeded")
+
+	values, ok := gerrors.CollectDetailByKeyFromError(err, "value")
+	require.True(t, ok)
+	assert.Equal(t, []string{"3.00"}, values)
+}
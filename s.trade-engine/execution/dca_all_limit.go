@@ -46,7 +46,7 @@ func (d *DCAAllLimit) Execute(
 	}
 
 	// Read account balance.
-	venueAccountBalance, err := readVenueAccountBalance(ctx, participant.Venue, strategy, venueCredentials)
+	venueAccountBalance, err := readVenueAccountBalance(ctx, participant.UserId, participant.Venue, strategy, venueCredentials)
 	if err != nil {
 		return nil, gerrors.Augment(err, "failed_to_execute_dca_all_limit_strategy", nil)
 	}
@@ -69,19 +69,24 @@ func (d *DCAAllLimit) Execute(
 	// Calculate total quantity/size from positions.
 	totalQuantity := calculateTotalQuantityFromPositions(venueAccountBalance, float64(participant.Risk), positions)
 
-	// Validate order against risk appetite constraints.
-	if err := isTradeStrategyParticipantOverRiskAppetite(venueAccountBalance, totalQuantity); err != nil {
+	// Validate order against the participants risk profile.
+	if err := enforceRiskProfile(ctx, strategy, participant, venueAccountBalance, totalQuantity, averageEntryPrice(entries)); err != nil {
 		return nil, gerrors.Augment(err, "failed_to_execute_dca_all_limit_strategy", map[string]string{
 			"total_quantity": fmt.Sprintf("%f", totalQuantity),
 			"venue_balance":  fmt.Sprintf("%f", venueAccountBalance),
 		})
 	}
 
-	if err := isEnoughAvailableVenueMargin(venueAccountBalance); err != nil {
-		return nil, gerrors.Augment(err, "failed_to_execute_dca_all_limit_strategy", map[string]string{
-			"venue_balance":           fmt.Sprintf("%f", venueAccountBalance),
-			"venue_min_margain_limit": fmt.Sprintf("%d", retailMinVenueMargainInUSDT),
-		})
+	// Round each position onto the lot size of the instrument; so the take profits & stop close exactly what's opened.
+	filters, err := fetchInstrumentFilters(ctx, strategy, participant.Venue)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_dca_all_limit_strategy", nil)
+	}
+	totalQuantity = calculateRoundedTotalQuantity(float64(venueAccountBalance), float64(participant.Risk), positions, filters)
+
+	tps, err := calculateTakeProfits(totalQuantity, strategy.TakeProfits, resolveTakeProfitAllocations(ctx, strategy, participant.UserId), filters)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_dca_all_limit_strategy", nil)
 	}
 
 	var (
@@ -148,7 +153,7 @@ func (d *DCAAllLimit) Execute(
 			OrderType:        tradeengineproto.ORDER_TYPE_LIMIT,
 			TradeSide:        strategy.TradeSide,
 			LimitPrice:       float32(p.Price),
-			Quantity:         float32(venueAccountBalance) * float32(p.RiskCoefficient) * participant.Risk / 100.0,
+			Quantity:         float32(calculatePositionQuantity(float64(venueAccountBalance), float64(participant.Risk), p, filters)),
 			WorkingType:      tradeengineproto.WORKING_TYPE_MARK_PRICE,
 			Venue:            participant.Venue,
 			CreatedTimestamp: now.Unix(),
@@ -157,7 +162,6 @@ func (d *DCAAllLimit) Execute(
 	}
 
 	// Add take profits.
-	tps := calculateTakeProfits(totalQuantity, strategy.TakeProfits)
 	for _, tp := range tps {
 		orders = append(orders, &tradeengineproto.Order{
 			ActorId:          tradeengineproto.TradeEngineActorSatoshiSystem,
@@ -176,12 +180,12 @@ func (d *DCAAllLimit) Execute(
 		})
 	}
 
-	// Execute orders sequentially; gather successful orders, here we return early on the first failed order.
+	// Execute orders as a single set; if any order fails, every order already placed is rolled back.
 	// Here we manage risk, by placing the stop first - this is the most important.
-	successfulOrders, executionErr := executeOrdersSequentiallyWithoutRetry(ctx, orders, participant.Venue, strategy.InstrumentType, venueCredentials)
+	successfulOrders, executionErr := executeOrdersAtomically(ctx, strategy.TradeStrategyId, participant.UserId, orders, participant.Venue, strategy.InstrumentType, venueCredentials)
 	switch {
-	case err != nil:
-		slog.Error(ctx, "Failed to execute given order: %+v, Error: %v", executionErr.FailedOrder, executionErr.ErrorMessage, errParams)
+	case executionErr != nil:
+		slog.Error(ctx, "Failed to execute given order: %+v, Error: %v, participant flat: %v", executionErr.FailedOrder, executionErr.ErrorMessage, executionErr.ParticipantFlat, errParams)
 	default:
 		slog.Info(ctx, "Successfully placed trade strategy: %s for user: %s, risk: %v, total quantity: %v", strategy.TradeStrategyId, participant.UserId, participant.Risk, totalQuantity)
 	}
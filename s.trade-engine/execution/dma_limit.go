@@ -42,7 +42,7 @@ func (d *DMALimit) Execute(ctx context.Context, strategy *tradeengineproto.Trade
 	}
 
 	// Read account balance.
-	venueAccountBalance, err := readVenueAccountBalance(ctx, participant.Venue, strategy, venueCredentials)
+	venueAccountBalance, err := readVenueAccountBalance(ctx, participant.UserId, participant.Venue, strategy, venueCredentials)
 	if err != nil {
 		return nil, gerrors.Augment(err, "failed_to_execute_dma_limit_strategy", nil)
 	}
@@ -51,18 +51,23 @@ func (d *DMALimit) Execute(ctx context.Context, strategy *tradeengineproto.Trade
 	riskCoefficient := risk.CalculateRiskCoefficient(float64(strategy.Entries[0]), float64(strategy.StopLoss))
 	totalQuantity := riskCoefficient * float64(venueAccountBalance) * float64(participant.Risk)
 
-	// Validate order against risk appetite constraints.
-	if err := isTradeStrategyParticipantOverRiskAppetite(venueAccountBalance, totalQuantity); err != nil {
-		return nil, gerrors.Augment(err, "failed_to_execute_dma_limit_strategy", map[string]string{
-			"total_quantity": fmt.Sprintf("%f", totalQuantity),
-			"venue_balance":  fmt.Sprintf("%f", venueAccountBalance),
-		})
+	// Round the position onto the lot size of the instrument; so the take profits & stop close exactly what's opened.
+	filters, err := fetchInstrumentFilters(ctx, strategy, participant.Venue)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_dma_limit_strategy", nil)
 	}
+	totalQuantity = filters.roundQuantity(totalQuantity)
 
-	if err := isEnoughAvailableVenueMargin(venueAccountBalance); err != nil {
+	tps, err := calculateTakeProfits(totalQuantity, strategy.TakeProfits, resolveTakeProfitAllocations(ctx, strategy, participant.UserId), filters)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_dma_limit_strategy", nil)
+	}
+
+	// Validate order against the participants risk profile.
+	if err := enforceRiskProfile(ctx, strategy, participant, venueAccountBalance, totalQuantity, float64(strategy.Entries[0])); err != nil {
 		return nil, gerrors.Augment(err, "failed_to_execute_dma_limit_strategy", map[string]string{
-			"venue_balance":           fmt.Sprintf("%f", venueAccountBalance),
-			"venue_min_margain_limit": fmt.Sprintf("%d", retailMinVenueMargainInUSDT),
+			"total_quantity": fmt.Sprintf("%f", totalQuantity),
+			"venue_balance":  fmt.Sprintf("%f", venueAccountBalance),
 		})
 	}
 
@@ -138,7 +143,6 @@ func (d *DMALimit) Execute(ctx context.Context, strategy *tradeengineproto.Trade
 	})
 
 	// Add take profits.
-	tps := calculateTakeProfits(totalQuantity, strategy.TakeProfits)
 	for _, tp := range tps {
 
 		orders = append(orders, &tradeengineproto.Order{
@@ -158,12 +162,12 @@ func (d *DMALimit) Execute(ctx context.Context, strategy *tradeengineproto.Trade
 		})
 	}
 
-	// Execute orders sequentially; gather successful orders, here we return early on the first failed order.
+	// Execute orders as a single set; if any order fails, every order already placed is rolled back.
 	// Here we manage risk, by placing the stop first - this is the most important.
-	successfulOrders, executionErr := executeOrdersSequentiallyWithoutRetry(ctx, orders, participant.Venue, strategy.InstrumentType, venueCredentials)
+	successfulOrders, executionErr := executeOrdersAtomically(ctx, strategy.TradeStrategyId, participant.UserId, orders, participant.Venue, strategy.InstrumentType, venueCredentials)
 	switch {
-	case err != nil:
-		slog.Error(ctx, "Failed to execute given order: %+v, Error: %v", executionErr.FailedOrder, executionErr.ErrorMessage, errParams)
+	case executionErr != nil:
+		slog.Error(ctx, "Failed to execute given order: %+v, Error: %v, participant flat: %v", executionErr.FailedOrder, executionErr.ErrorMessage, executionErr.ParticipantFlat, errParams)
 	default:
 		slog.Info(ctx, "Successfully placed trade strategy: %s for user: %s, risk: %v, total quantity: %v", strategy.TradeStrategyId, participant.UserId, participant.Risk, totalQuantity)
 	}
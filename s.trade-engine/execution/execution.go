@@ -2,14 +2,27 @@ package execution
 
 import (
 	"context"
+	"fmt"
 
 	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/domain"
+	"swallowtail/s.trade-engine/marshaling"
 	or "swallowtail/s.trade-engine/orderrouter"
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 
 	"github.com/monzo/slog"
 )
 
+// routeAndExecuteNewOrder is a package variable so the order router can be faked in tests.
+var routeAndExecuteNewOrder = or.RouteAndExecuteNewOrder
+
+// createOrder & updateOrder are package variables so order tracking can be faked in tests.
+var (
+	createOrder = dao.CreateOrder
+	updateOrder = dao.UpdateOrder
+)
+
 // StrategyExecution defines the execution execution.
 type StrategyExecution interface {
 	Execute(
@@ -44,31 +57,75 @@ func ExecuteTradeStrategyForParticipant(
 		return nil, gerrors.Augment(err, "failed_to_execute_trading_strategy_execution_for_participant", errParams)
 	}
 
+	if rsp.GetError() == nil && isPositionManaged(participant) {
+		// Best effort; the trade strategy has already been placed.
+		if err := startManagingPosition(ctx, strategy, participant); err != nil {
+			slog.Error(ctx, "Failed to start managing position: %s %s, Error: %v", strategy.TradeStrategyId, participant.UserId, err)
+
+			msg := fmt.Sprintf("Trade strategy %s was placed, but I failed to start managing your position; your stop loss won't be moved automatically.", strategy.TradeStrategyId)
+			if err := notifyUser(ctx, msg, participant.UserId); err != nil {
+				slog.Error(ctx, "Failed to notify user: %v", err)
+			}
+		}
+	}
+
 	return rsp, nil
 }
 
-func executeOrdersSequentiallyWithoutRetry(
+// executeAndTrackOrder routes & executes the order, tracking its lifecycle in the persistence layer. The order is stored as
+// pending before it is sent to the venue, so that an order interrupted midflight is never lost. Failing to track an order
+// must never block execution; so those failures are only logged.
+func executeAndTrackOrder(
 	ctx context.Context,
-	orders []*tradeengineproto.Order,
+	tradeStrategyID, userID string,
+	order *tradeengineproto.Order,
 	venue tradeengineproto.VENUE,
 	instrumentType tradeengineproto.INSTRUMENT_TYPE,
 	credentials *tradeengineproto.VenueCredentials,
-) ([]*tradeengineproto.Order, *tradeengineproto.ExecutionError) {
-	var successfulOrders = make([]*tradeengineproto.Order, 0, len(orders))
-	for _, order := range orders {
-		successfulOrder, err := or.RouteAndExecuteNewOrder(ctx, order, venue, instrumentType, credentials)
-		if err != nil {
-			slog.Error(ctx, "Failed to execute given order: %+v, Error: %v", order, err)
-
-			return successfulOrders, &tradeengineproto.ExecutionError{
-				ErrorMessage: gerrors.Augment(err, "failed_to_execute_order", nil).Error(),
-				FailedOrder:  order,
-			}
-		}
+) (*tradeengineproto.Order, error) {
+	trackedOrder := marshaling.OrderProtoToDomain(tradeStrategyID, userID, order)
+	trackedOrder.Venue = venue.String()
+	trackedOrder.InstrumentType = instrumentType.String()
+	trackedOrder.Status = domain.OrderStatusPendingNew
 
-		slog.Info(ctx, "Order placed: %s [%s] %s", successfulOrder.Venue, successfulOrder.ExternalOrderId, successfulOrder.Instrument)
-		successfulOrders = append(successfulOrders, successfulOrder)
+	trackedOrder, err := createOrder(ctx, trackedOrder)
+	if err != nil {
+		slog.Error(ctx, "Failed to track order, executing untracked: %+v, Error: %v", order, err)
 	}
 
-	return successfulOrders, nil
+	// Venues don't use these; but simulated venues need to know who the order is placed on behalf of.
+	order.TradeStrategyId, order.UserId = tradeStrategyID, userID
+
+	successfulOrder, executionErr := routeAndExecuteNewOrder(ctx, order, venue, instrumentType, credentials)
+	if trackedOrder == nil {
+		return successfulOrder, executionErr
+	}
+
+	switch {
+	case executionErr != nil && classifyExecutionError(executionErr) == tradeengineproto.EXECUTION_ERROR_CLASS_RETRYABLE_EXECUTION_ERROR:
+		// The venue didn't reject the order; so it mustn't count towards the consecutive venue rejections of the user.
+		trackedOrder.Status = domain.OrderStatusVenueUnavailable
+		trackedOrder.FailureReason = executionErr.Error()
+	case executionErr != nil:
+		trackedOrder.Status = domain.OrderStatusRejected
+		trackedOrder.FailureReason = executionErr.Error()
+	default:
+		trackedOrder.Status = domain.OrderStatusNew
+		trackedOrder.ExternalOrderID = successfulOrder.ExternalOrderId
+	}
+
+	if err := updateOrder(ctx, trackedOrder); err != nil {
+		slog.Error(ctx, "Failed to update tracked order: %s, Error: %v", trackedOrder.OrderID, err)
+	}
+
+	if executionErr != nil {
+		return nil, executionErr
+	}
+
+	successfulOrder.OrderId = trackedOrder.OrderID
+	successfulOrder.TradeStrategyId = tradeStrategyID
+	successfulOrder.UserId = userID
+	successfulOrder.Status = tradeengineproto.ORDER_STATUS_NEW_ORDER
+
+	return successfulOrder, nil
 }
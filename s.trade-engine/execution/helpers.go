@@ -1,14 +1,24 @@
 package execution
 
 import (
+	"fmt"
 	"math"
 
+	"swallowtail/libraries/gerrors"
 	"swallowtail/libraries/risk"
 )
 
 const (
 	DCANumberOfBuysLowerBound = 5
 	DCANumberOfBuysUpperBound = 7
+
+	// minimumUnfilteredQuantity is the increment take profits are split by on venues without a lot size.
+	minimumUnfilteredQuantity = 1e-8
+	// lotTolerance is the fraction of a lot a quantity can fall short of it by, from floating point error, & still be
+	// rounded onto it.
+	lotTolerance = 1e-6
+	// allocationTolerance is the relative error of a take profit's share of the position from its float32 allocation.
+	allocationTolerance = 1e-6
 )
 
 type TakeProfitDetail struct {
@@ -37,24 +47,138 @@ func calculateTotalQuantityFromPositions(accountBalance, totalRisk float64, posi
 	return math.Ceil(f(positions)*accountBalance*totalRisk) / 100
 }
 
-func calculateTakeProfits(totalPositionQuantity float64, takeProfitStopPrices []float32) []*TakeProfitDetail {
+// calculatePositionQuantity sizes a single position of a DCA strategy, rounded down onto the lot size of the instrument.
+func calculatePositionQuantity(accountBalance, totalRisk float64, position *risk.PositionDetail, filters *instrumentFilters) float64 {
+	return filters.roundQuantity(accountBalance * position.RiskCoefficient * totalRisk / 100)
+}
+
+// calculateRoundedTotalQuantity is the total quantity of the positions once each is rounded onto the lot size; it's what
+// the stop & take profits must close.
+func calculateRoundedTotalQuantity(accountBalance, totalRisk float64, positions []*risk.PositionDetail, filters *instrumentFilters) float64 {
+	var total float64
+	for _, p := range positions {
+		total += calculatePositionQuantity(accountBalance, totalRisk, p, filters)
+	}
+
+	return total
+}
+
+// averageEntryPrice is used to price the notional of trade strategies with multiple entries.
+func averageEntryPrice(entries []float64) float64 {
+	if len(entries) == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, e := range entries {
+		total += e
+	}
+
+	return total / float64(len(entries))
+}
+
+// calculateTakeProfits splits the position across the take profits by the given allocations, or evenly if there are
+// none. Quantities are rounded down onto the lot size of the instrument; the last take profit closes whatever remains,
+// so together the take profits always close the whole position. A take profit below the minimum quantity of the
+// instrument is folded into the next, or the previous if it's the last.
+func calculateTakeProfits(totalPositionQuantity float64, takeProfitStopPrices, allocations []float32, filters *instrumentFilters) ([]*TakeProfitDetail, error) {
 	if len(takeProfitStopPrices) == 0 {
-		return nil
+		return nil, nil
+	}
+
+	errParams := map[string]string{
+		"total_quantity": fmt.Sprintf("%f", totalPositionQuantity),
+	}
+
+	numberOfTakeProfits := len(takeProfitStopPrices)
+	if len(allocations) != numberOfTakeProfits {
+		allocations = evenTakeProfitAllocations(numberOfTakeProfits)
+	}
+
+	// We work in whole lots to avoid any floating point drift; the lots of the take profits must sum to the position.
+	lotSize := filters.LotSize
+	if lotSize <= 0 {
+		lotSize = minimumUnfilteredQuantity
+	}
+
+	totalLots := math.Floor(totalPositionQuantity/lotSize + lotTolerance)
+	if totalLots <= 0 {
+		return nil, gerrors.FailedPrecondition("failed_to_calculate_take_profits.position_below_lot_size", errParams)
 	}
 
-	// Calculate position to consider; we leave some amount for continuation.
-	// This is `total quantity * (1 - 1/n + 1)` where `n` is the number of take profits.
-	var numberOfTakeProfits = len(takeProfitStopPrices)
-	positionSizeToConsider := totalPositionQuantity * (1 - (1 / float64(numberOfTakeProfits+1)))
+	lots := make([]float64, numberOfTakeProfits)
+	var allocatedLots float64
+	for i := 0; i < numberOfTakeProfits-1; i++ {
+		share := totalLots * float64(allocations[i]) / 100
+		lots[i] = math.Floor(share + math.Max(lotTolerance, share*allocationTolerance))
+		allocatedLots += lots[i]
+	}
+	lots[numberOfTakeProfits-1] = totalLots - allocatedLots
+
+	// Fold any take profit below the minimum quantity into its neighbour; its stop price is dropped.
+	var (
+		minLots = math.Ceil(filters.MinQuantity/lotSize - lotTolerance)
+		tpds    = make([]*TakeProfitDetail, 0, numberOfTakeProfits)
+		carried float64
+	)
+	for i, tp := range takeProfitStopPrices {
+		l := lots[i] + carried
+		if l < minLots || l <= 0 {
+			carried = l
+			continue
+		}
 
-	// Calculate quantity per take profit.
-	var tpds = make([]*TakeProfitDetail, 0, len(takeProfitStopPrices))
-	for _, tp := range takeProfitStopPrices {
+		carried = 0
 		tpds = append(tpds, &TakeProfitDetail{
-			StopPrice: float64(tp),
-			Quantity:  positionSizeToConsider * 1.0 / float64(numberOfTakeProfits),
+			StopPrice: filters.roundPrice(float64(tp)),
+			Quantity:  l * lotSize,
 		})
 	}
 
-	return tpds
+	if carried > 0 {
+		if len(tpds) == 0 {
+			return nil, gerrors.FailedPrecondition("failed_to_calculate_take_profits.position_below_minimum_quantity", errParams)
+		}
+
+		last := tpds[len(tpds)-1]
+		last.Quantity = (math.Round(last.Quantity/lotSize) + carried) * lotSize
+	}
+
+	return tpds, nil
+}
+
+// evenTakeProfitAllocations splits the position evenly across the take profits.
+func evenTakeProfitAllocations(numberOfTakeProfits int) []float32 {
+	allocations := make([]float32, 0, numberOfTakeProfits)
+	for i := 0; i < numberOfTakeProfits; i++ {
+		allocations = append(allocations, float32(100/float64(numberOfTakeProfits)))
+	}
+
+	return allocations
+}
+
+// instrumentFilters are the increments a venue accepts for the prices & quantities of orders of an instrument; zero
+// valued filters aren't applied.
+type instrumentFilters struct {
+	TickSize    float64
+	LotSize     float64
+	MinQuantity float64
+}
+
+// roundQuantity rounds the quantity down onto the lot size.
+func (f *instrumentFilters) roundQuantity(quantity float64) float64 {
+	if f.LotSize <= 0 {
+		return quantity
+	}
+
+	return math.Floor(quantity/f.LotSize+lotTolerance) * f.LotSize
+}
+
+// roundPrice rounds the price to the nearest tick.
+func (f *instrumentFilters) roundPrice(price float64) float64 {
+	if f.TickSize <= 0 {
+		return price
+	}
+
+	return math.Round(price/f.TickSize) * f.TickSize
 }
@@ -1,10 +1,15 @@
 package execution
 
 import (
+	"context"
+	"errors"
 	"swallowtail/libraries/risk"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tradeengineproto "swallowtail/s.trade-engine/proto"
 )
 
 func TestCalculateNumberOfDCABuys(t *testing.T) {
@@ -92,3 +97,199 @@ func TestCalculateTotalQuantityFromPositions(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateTakeProfits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		totalQuantity       float64
+		stopPrices          []float32
+		allocations         []float32
+		filters             *instrumentFilters
+		expectedTakeProfits []*TakeProfitDetail
+		expectedErr         bool
+	}{
+		{
+			name:          "even_split_without_allocations",
+			totalQuantity: 3,
+			stopPrices:    []float32{100, 110, 120},
+			filters:       &instrumentFilters{LotSize: 0.001},
+			expectedTakeProfits: []*TakeProfitDetail{
+				{StopPrice: 100, Quantity: 1},
+				{StopPrice: 110, Quantity: 1},
+				{StopPrice: 120, Quantity: 1},
+			},
+		},
+		{
+			name:          "allocations",
+			totalQuantity: 10,
+			stopPrices:    []float32{100, 110, 120},
+			allocations:   []float32{50, 30, 20},
+			filters:       &instrumentFilters{LotSize: 0.1},
+			expectedTakeProfits: []*TakeProfitDetail{
+				{StopPrice: 100, Quantity: 5},
+				{StopPrice: 110, Quantity: 3},
+				{StopPrice: 120, Quantity: 2},
+			},
+		},
+		{
+			name:          "mismatched_allocations_split_evenly",
+			totalQuantity: 2,
+			stopPrices:    []float32{100, 110},
+			allocations:   []float32{100},
+			filters:       &instrumentFilters{LotSize: 1},
+			expectedTakeProfits: []*TakeProfitDetail{
+				{StopPrice: 100, Quantity: 1},
+				{StopPrice: 110, Quantity: 1},
+			},
+		},
+		{
+			name:          "remainder_to_last_take_profit",
+			totalQuantity: 1,
+			stopPrices:    []float32{100, 110, 120},
+			filters:       &instrumentFilters{LotSize: 0.1},
+			expectedTakeProfits: []*TakeProfitDetail{
+				{StopPrice: 100, Quantity: 0.3},
+				{StopPrice: 110, Quantity: 0.3},
+				{StopPrice: 120, Quantity: 0.4},
+			},
+		},
+		{
+			name:          "stop_prices_rounded_to_tick",
+			totalQuantity: 1,
+			stopPrices:    []float32{100.04, 110.06},
+			allocations:   []float32{50, 50},
+			filters:       &instrumentFilters{TickSize: 0.1, LotSize: 0.5},
+			expectedTakeProfits: []*TakeProfitDetail{
+				{StopPrice: 100, Quantity: 0.5},
+				{StopPrice: 110.1, Quantity: 0.5},
+			},
+		},
+		{
+			name:          "below_minimum_quantity_folded_into_next",
+			totalQuantity: 10,
+			stopPrices:    []float32{100, 110, 120},
+			allocations:   []float32{10, 10, 80},
+			filters:       &instrumentFilters{LotSize: 1, MinQuantity: 2},
+			expectedTakeProfits: []*TakeProfitDetail{
+				{StopPrice: 110, Quantity: 2},
+				{StopPrice: 120, Quantity: 8},
+			},
+		},
+		{
+			name:          "last_below_minimum_quantity_folded_into_previous",
+			totalQuantity: 10,
+			stopPrices:    []float32{100, 110},
+			allocations:   []float32{90, 10},
+			filters:       &instrumentFilters{LotSize: 1, MinQuantity: 2},
+			expectedTakeProfits: []*TakeProfitDetail{
+				{StopPrice: 100, Quantity: 10},
+			},
+		},
+		{
+			name:          "position_below_lot_size",
+			totalQuantity: 0.05,
+			stopPrices:    []float32{100},
+			filters:       &instrumentFilters{LotSize: 0.1},
+			expectedErr:   true,
+		},
+		{
+			name:          "position_below_minimum_quantity",
+			totalQuantity: 1,
+			stopPrices:    []float32{100},
+			filters:       &instrumentFilters{LotSize: 1, MinQuantity: 2},
+			expectedErr:   true,
+		},
+		{
+			name:          "no_take_profits",
+			totalQuantity: 1,
+			filters:       &instrumentFilters{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tps, err := calculateTakeProfits(tt.totalQuantity, tt.stopPrices, tt.allocations, tt.filters)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, tps, len(tt.expectedTakeProfits))
+
+			var total float64
+			for i, tp := range tps {
+				assert.InDelta(t, tt.expectedTakeProfits[i].StopPrice, tp.StopPrice, 1e-6)
+				assert.InDelta(t, tt.expectedTakeProfits[i].Quantity, tp.Quantity, 1e-9)
+				total += tp.Quantity
+			}
+
+			if len(tps) > 0 {
+				assert.InDelta(t, tt.filters.roundQuantity(tt.totalQuantity), total, 1e-9)
+			}
+		})
+	}
+}
+
+func TestResolveTakeProfitAllocations(t *testing.T) {
+	originalFetch := fetchDefaultTakeProfitAllocations
+	t.Cleanup(func() {
+		fetchDefaultTakeProfitAllocations = originalFetch
+	})
+
+	tests := []struct {
+		name                string
+		strategyAllocations []float32
+		defaults            []float32
+		defaultsErr         error
+		expectedAllocations []float32
+	}{
+		{
+			name:                "strategy_allocations",
+			strategyAllocations: []float32{60, 30, 10},
+			defaults:            []float32{20, 30, 50},
+			expectedAllocations: []float32{60, 30, 10},
+		},
+		{
+			name:                "participant_defaults",
+			defaults:            []float32{20, 30, 50},
+			expectedAllocations: []float32{20, 30, 50},
+		},
+		{
+			name:                "participant_defaults_trimmed_&_rescaled",
+			defaults:            []float32{25, 25, 25, 25},
+			expectedAllocations: []float32{100.0 / 3, 100.0 / 3, 100.0 / 3},
+		},
+		{
+			name:     "too_few_participant_defaults",
+			defaults: []float32{50, 50},
+		},
+		{
+			name:        "failed_to_read_participant_defaults",
+			defaultsErr: errors.New("account unavailable"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			fetchDefaultTakeProfitAllocations = func(_ context.Context, _ string) ([]float32, error) {
+				return tt.defaults, tt.defaultsErr
+			}
+
+			allocations := resolveTakeProfitAllocations(context.Background(), &tradeengineproto.TradeStrategy{
+				TakeProfits:           []float32{100, 110, 120},
+				TakeProfitAllocations: tt.strategyAllocations,
+			}, "user")
+
+			require.Len(t, allocations, len(tt.expectedAllocations))
+			for i, a := range allocations {
+				assert.InDelta(t, tt.expectedAllocations[i], a, 1e-4)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/domain"
+	"swallowtail/s.trade-engine/marshaling"
+	or "swallowtail/s.trade-engine/orderrouter"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	orderStatusPollInterval  = 30 * time.Second
+	orderStatusPollBatchSize = 100
+
+	// orderStatusMaxPollBackoff caps how long we wait before polling an order whose status we've repeatedly failed to read.
+	orderStatusMaxPollBackoff = time.Hour
+)
+
+var (
+	// routeAndReadOrderStatus is a package variable so the order router can be faked in tests.
+	routeAndReadOrderStatus = or.RouteAndReadOrderStatus
+	recordOrderPoll         = dao.RecordOrderPoll
+)
+
+func runOrderStatusPoller(ctx context.Context) {
+	t := time.NewTicker(orderStatusPollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			if err := pollOpenOrders(ctx); err != nil {
+				slog.Error(ctx, "Failed to poll open orders: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// pollOpenOrders reads the status of all open orders from their venue & persists any transitions. Orders are polled least
+// recently polled first; every attempt is recorded, successful or not, so the whole book is cycled through even if larger
+// than a batch. Orders we fail to poll are backed off, so they don't crowd out the rest of the book.
+func pollOpenOrders(ctx context.Context) error {
+	orders, err := dao.ListOpenOrdersByVenueInstruments(ctx, pollableVenueInstruments(), orderStatusPollBatchSize)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_open_orders", nil)
+	}
+
+	credentials := venueCredentialsCache{}
+	for _, order := range orders {
+		venueCredentials, err := credentials.read(ctx, order.UserID, tradeengineproto.VENUE(tradeengineproto.VENUE_value[order.Venue]))
+		switch {
+		case err != nil:
+			slog.Error(ctx, "Failed to read venue credentials to poll order: %s, Error: %v", order.OrderID, err)
+		default:
+			err = pollOrderStatus(ctx, order, venueCredentials)
+			if err != nil {
+				slog.Error(ctx, "Failed to poll order status: %s, Error: %v", order.OrderID, err)
+			}
+		}
+
+		succeeded := err == nil
+		if err := recordOrderPoll(ctx, order.OrderID, succeeded, nextOrderPoll(order, succeeded, time.Now())); err != nil {
+			slog.Error(ctx, "Failed to record order poll: %s, Error: %v", order.OrderID, err)
+		}
+	}
+
+	return nil
+}
+
+// pollableVenueInstruments returns every venue & instrument type pair we're able to read order status from, of the form
+// `<venue>:<instrument_type>`; orders anywhere else are never polled, rather than failing every poll.
+func pollableVenueInstruments() []string {
+	var venueInstruments []string
+	for venue := range tradeengineproto.VENUE_name {
+		for instrumentType := range tradeengineproto.INSTRUMENT_TYPE_name {
+			if !or.IsOrderStatusReadable(tradeengineproto.VENUE(venue), tradeengineproto.INSTRUMENT_TYPE(instrumentType)) {
+				continue
+			}
+
+			venueInstruments = append(venueInstruments, fmt.Sprintf("%s:%s", tradeengineproto.VENUE(venue), tradeengineproto.INSTRUMENT_TYPE(instrumentType)))
+		}
+	}
+
+	sort.Strings(venueInstruments)
+	return venueInstruments
+}
+
+// nextOrderPoll returns when the order is next due to be polled; straight away on success, since the order is then
+// ordered behind every order not polled since, otherwise backing off exponentially with each consecutive failure.
+func nextOrderPoll(order *domain.Order, succeeded bool, now time.Time) time.Time {
+	if succeeded {
+		return now
+	}
+
+	backoff := orderStatusPollInterval
+	for i := 0; i < order.PollFailures && backoff < orderStatusMaxPollBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > orderStatusMaxPollBackoff {
+		backoff = orderStatusMaxPollBackoff
+	}
+
+	return now.Add(backoff)
+}
+
+func pollOrderStatus(ctx context.Context, order *domain.Order, credentials *tradeengineproto.VenueCredentials) error {
+	errParams := map[string]string{
+		"order_id":          order.OrderID,
+		"external_order_id": order.ExternalOrderID,
+		"venue":             order.Venue,
+	}
+
+	polledOrder, err := routeAndReadOrderStatus(ctx, marshaling.OrderDomainToProto(order), credentials)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_poll_order_status", errParams)
+	}
+
+	previousStatus, previousExecutedQuantity := order.Status, order.ExecutedQuantity
+	order.Status = polledOrder.Status.String()
+	order.ExecutedQuantity = float64(polledOrder.ExecutedQuantity)
+
+	if err := updateOrder(ctx, order); err != nil {
+		return gerrors.Augment(err, "failed_to_poll_order_status.update", errParams)
+	}
+
+	if previousStatus != order.Status {
+		slog.Info(ctx, "Order status transition: %s [%s] %s -> %s", order.OrderID, order.ExternalOrderID, previousStatus, order.Status)
+	}
+
+	if !order.ReduceOnly && order.ExecutedQuantity > previousExecutedQuantity {
+		// Best effort; the take profits are resized again on the next entry fill we observe.
+		if err := resizeParticipantTakeProfits(ctx, order.TradeStrategyID, order.UserID, credentials); err != nil {
+			slog.Error(ctx, "Failed to resize take profits after entry fill: %s, Error: %v", order.OrderID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// fakeOrderStore records every transition of the tracked orders.
+type fakeOrderStore struct {
+	transitions []string
+}
+
+func (f *fakeOrderStore) createOrder(ctx context.Context, order *domain.Order) (*domain.Order, error) {
+	order.OrderID = "order-id"
+	f.transitions = append(f.transitions, order.Status)
+	return order, nil
+}
+
+func (f *fakeOrderStore) updateOrder(ctx context.Context, order *domain.Order) error {
+	f.transitions = append(f.transitions, order.Status)
+	return nil
+}
+
+func withFakeOrderStore(t *testing.T) *fakeOrderStore {
+	store := &fakeOrderStore{}
+
+	originalCreate, originalUpdate, originalRoute := createOrder, updateOrder, routeAndExecuteNewOrder
+	originalListRecentOrderStatuses := listRecentOrderStatuses
+	t.Cleanup(func() {
+		createOrder, updateOrder, routeAndExecuteNewOrder = originalCreate, originalUpdate, originalRoute
+		listRecentOrderStatuses = originalListRecentOrderStatuses
+	})
+
+	createOrder, updateOrder = store.createOrder, store.updateOrder
+	listRecentOrderStatuses = func(ctx context.Context, userID string, limit int) ([]string, error) {
+		return nil, nil
+	}
+	return store
+}
+
+func TestExecuteAndTrackOrder(t *testing.T) {
+	tests := []struct {
+		name                string
+		routingErr          error
+		expectedTransitions []string
+	}{
+		{
+			name:                "order_accepted_by_venue",
+			expectedTransitions: []string{domain.OrderStatusPendingNew, domain.OrderStatusNew},
+		},
+		{
+			name:                "order_rejected_by_venue",
+			routingErr:          errors.New("insufficient margin"),
+			expectedTransitions: []string{domain.OrderStatusPendingNew, domain.OrderStatusRejected},
+		},
+		{
+			name:                "venue_unavailable",
+			routingErr:          gerrors.New(gerrors.ErrUnavailable, "unavailable", nil),
+			expectedTransitions: []string{domain.OrderStatusPendingNew, domain.OrderStatusVenueUnavailable},
+		},
+		{
+			name:                "rate_limited_by_venue",
+			routingErr:          gerrors.New(gerrors.ErrRateLimited, "too_many_requests", nil),
+			expectedTransitions: []string{domain.OrderStatusPendingNew, domain.OrderStatusVenueUnavailable},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			store := withFakeOrderStore(t)
+			routeAndExecuteNewOrder = func(
+				ctx context.Context,
+				order *tradeengineproto.Order,
+				venue tradeengineproto.VENUE,
+				instrumentType tradeengineproto.INSTRUMENT_TYPE,
+				venueCredentials *tradeengineproto.VenueCredentials,
+			) (*tradeengineproto.Order, error) {
+				if tt.routingErr != nil {
+					return nil, tt.routingErr
+				}
+
+				return &tradeengineproto.Order{ExternalOrderId: "123"}, nil
+			}
+
+			order, err := executeAndTrackOrder(
+				context.Background(), "trade-strategy-id", "user-id",
+				&tradeengineproto.Order{OrderType: tradeengineproto.ORDER_TYPE_STOP_MARKET},
+				tradeengineproto.VENUE_BINANCE, tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL, nil,
+			)
+			assert.Equal(t, tt.expectedTransitions, store.transitions)
+
+			if tt.routingErr != nil {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "order-id", order.OrderId)
+			assert.Equal(t, "123", order.ExternalOrderId)
+			assert.Equal(t, tradeengineproto.ORDER_STATUS_NEW_ORDER, order.Status)
+		})
+	}
+}
+
+func TestPollOrderStatus(t *testing.T) {
+	store := withFakeOrderStore(t)
+
+	originalRead, originalResize := routeAndReadOrderStatus, resizeParticipantTakeProfits
+	t.Cleanup(func() {
+		routeAndReadOrderStatus, resizeParticipantTakeProfits = originalRead, originalResize
+	})
+
+	var resized []string
+	resizeParticipantTakeProfits = func(ctx context.Context, tradeStrategyID, userID string, credentials *tradeengineproto.VenueCredentials) error {
+		resized = append(resized, tradeStrategyID)
+		return nil
+	}
+
+	var polledExternalOrderIDs []string
+	routeAndReadOrderStatus = func(ctx context.Context, order *tradeengineproto.Order, venueCredentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+		polledExternalOrderIDs = append(polledExternalOrderIDs, order.ExternalOrderId)
+
+		order.Status = tradeengineproto.ORDER_STATUS_FILLED_ORDER
+		order.ExecutedQuantity = order.Quantity
+		return order, nil
+	}
+
+	order := &domain.Order{
+		OrderID:         "order-id",
+		TradeStrategyID: "trade-strategy-id",
+		ExternalOrderID: "123",
+		Venue:           tradeengineproto.VENUE_BINANCE.String(),
+		InstrumentType:  tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL.String(),
+		OrderType:       tradeengineproto.ORDER_TYPE_STOP_MARKET.String(),
+		Quantity:        1.5,
+		Status:          domain.OrderStatusNew,
+	}
+
+	require.NoError(t, pollOrderStatus(context.Background(), order, nil))
+
+	assert.Equal(t, []string{"123"}, polledExternalOrderIDs)
+	assert.Equal(t, []string{domain.OrderStatusFilled}, store.transitions)
+	assert.Equal(t, domain.OrderStatusFilled, order.Status)
+	assert.InDelta(t, 1.5, order.ExecutedQuantity, 1e-9)
+
+	// The order isn't reduce only, so it's an entry; its take profits are resized to the fill.
+	assert.Equal(t, []string{"trade-strategy-id"}, resized)
+}
+
+func TestNextOrderPoll(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		pollFailures    int
+		succeeded       bool
+		expectedBackoff time.Duration
+	}{
+		{
+			name:            "succeeded",
+			pollFailures:    3,
+			succeeded:       true,
+			expectedBackoff: 0,
+		},
+		{
+			name:            "first_failure",
+			pollFailures:    0,
+			expectedBackoff: orderStatusPollInterval,
+		},
+		{
+			name:            "third_consecutive_failure",
+			pollFailures:    2,
+			expectedBackoff: 4 * orderStatusPollInterval,
+		},
+		{
+			name:            "backoff_capped",
+			pollFailures:    100,
+			expectedBackoff: orderStatusMaxPollBackoff,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			order := &domain.Order{
+				PollFailures: tt.pollFailures,
+			}
+
+			assert.Equal(t, now.Add(tt.expectedBackoff), nextOrderPoll(order, tt.succeeded, now))
+		})
+	}
+}
+
+func TestPollableVenueInstruments(t *testing.T) {
+	venueInstruments := pollableVenueInstruments()
+
+	assert.Contains(t, venueInstruments, "BINANCE:FUTURE_PERPETUAL")
+	assert.Contains(t, venueInstruments, "PAPER:SPOT")
+	assert.Contains(t, venueInstruments, "PAPER:FUTURE_PERPETUAL")
+
+	// Order status can't be read from these; so they'd fail every poll.
+	assert.NotContains(t, venueInstruments, "BINANCE:SPOT")
+	for _, venueInstrument := range venueInstruments {
+		assert.NotContains(t, venueInstrument, "FTX")
+		assert.NotContains(t, venueInstrument, "BITFINEX")
+		assert.NotContains(t, venueInstrument, "DERIBIT")
+	}
+}
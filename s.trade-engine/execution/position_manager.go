@@ -0,0 +1,494 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/domain"
+	"swallowtail/s.trade-engine/marshaling"
+	or "swallowtail/s.trade-engine/orderrouter"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	positionManagerPollInterval = 30 * time.Second
+	positionManagerBatchSize    = 100
+
+	// minTrailingStopStepPercentage is the minimum a synthetic trailing stop must move by, as a percentage of the price,
+	// before the resting stop loss is replaced; otherwise we'd churn orders on every tick.
+	minTrailingStopStepPercentage = 0.1
+)
+
+// trailingPercentageRange is the range of trailing percentages a venue accepts on its native trailing stop orders.
+type trailingPercentageRange struct {
+	Min, Max float64
+}
+
+var (
+	// nativeTrailingStopVenues are the venues & instrument types with native trailing stop orders; everywhere else the
+	// trailing stop is synthetic. Positions are only managed where we poll order status from, see IsPositionManagementSupported.
+	nativeTrailingStopVenues = map[tradeengineproto.VENUE]map[tradeengineproto.INSTRUMENT_TYPE]trailingPercentageRange{
+		tradeengineproto.VENUE_BINANCE: {
+			tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL: {Min: 0.1, Max: 5},
+		},
+	}
+)
+
+// createManagedPosition, updateManagedPosition, listActiveManagedPositions & listParticipantOrders are package variables so
+// position management can be faked in tests.
+var (
+	createManagedPosition      = dao.CreateManagedPosition
+	updateManagedPosition      = dao.UpdateManagedPosition
+	listActiveManagedPositions = dao.ListActiveManagedPositions
+	listParticipantOrders      = dao.ListOrdersByTradeStrategyIDAndUserID
+)
+
+// notifyUserOfManagedPosition is a package variable so notifications can be faked in tests.
+var notifyUserOfManagedPosition = notifyUser
+
+// IsPositionManagementSupported returns true if we're able to manage positions of the instrument type on the given venue;
+// we must be able to poll order status from the venue to know when the first take profit fills.
+func IsPositionManagementSupported(venue tradeengineproto.VENUE, instrumentType tradeengineproto.INSTRUMENT_TYPE) bool {
+	return or.IsOrderStatusReadable(venue, instrumentType)
+}
+
+// isPositionManaged returns true if the participant asked for their position to be managed after entry.
+func isPositionManaged(participant *tradeengineproto.ExecuteTradeStrategyForParticipantRequest) bool {
+	return participant.MoveStopToBreakEven || participant.TrailingStopPercentage > 0
+}
+
+// isNativeTrailingStopSupported returns true if the venue can trail the stop itself at the given percentage.
+func isNativeTrailingStopSupported(venue tradeengineproto.VENUE, instrumentType tradeengineproto.INSTRUMENT_TYPE, trailingPercentage float64) bool {
+	r, ok := nativeTrailingStopVenues[venue][instrumentType]
+	if !ok {
+		return false
+	}
+
+	return trailingPercentage >= r.Min && trailingPercentage <= r.Max
+}
+
+// startManagingPosition starts managing the position of the participant once the trade strategy has been placed.
+func startManagingPosition(
+	ctx context.Context,
+	strategy *tradeengineproto.TradeStrategy,
+	participant *tradeengineproto.ExecuteTradeStrategyForParticipantRequest,
+) error {
+	entries := make([]float64, 0, len(strategy.Entries))
+	for _, e := range strategy.Entries {
+		entries = append(entries, float64(e))
+	}
+
+	instrument := strategy.Instrument
+	if instrument == "" {
+		instrument = fmt.Sprintf("%s%s", strategy.Asset, strategy.Pair)
+	}
+
+	trailingStopPercentage := float64(participant.TrailingStopPercentage)
+	managedPosition := &domain.ManagedPosition{
+		TradeStrategyID:         strategy.TradeStrategyId,
+		UserID:                  participant.UserId,
+		Venue:                   participant.Venue.String(),
+		Instrument:              strings.ToUpper(instrument),
+		InstrumentType:          strategy.InstrumentType.String(),
+		TradeSide:               strategy.TradeSide.String(),
+		ReferenceEntryPrice:     averageEntryPrice(entries),
+		MoveStopToBreakEven:     participant.MoveStopToBreakEven,
+		TrailingStopPercentage:  trailingStopPercentage,
+		IsSyntheticTrailingStop: trailingStopPercentage > 0 && !isNativeTrailingStopSupported(participant.Venue, strategy.InstrumentType, trailingStopPercentage),
+		Status:                  domain.ManagedPositionStatusAwaitingTakeProfit,
+	}
+
+	if err := createManagedPosition(ctx, managedPosition); err != nil {
+		return gerrors.Augment(err, "failed_to_start_managing_position", map[string]string{
+			"trade_strategy_id": strategy.TradeStrategyId,
+			"user_id":           participant.UserId,
+		})
+	}
+
+	return nil
+}
+
+func runPositionManager(ctx context.Context) {
+	t := time.NewTicker(positionManagerPollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			if err := manageActivePositions(ctx); err != nil {
+				slog.Error(ctx, "Failed to manage active positions: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// manageActivePositions manages every active position, least recently updated first. Fills are observed from the order
+// status poller; so a position is managed at most one poll behind the venue.
+func manageActivePositions(ctx context.Context) error {
+	managedPositions, err := listActiveManagedPositions(ctx, positionManagerBatchSize)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_active_managed_positions", nil)
+	}
+
+	credentials := venueCredentialsCache{}
+	for _, managedPosition := range managedPositions {
+		if err := managePosition(ctx, managedPosition, credentials); err != nil {
+			slog.Error(ctx, "Failed to manage position: %s %s, Error: %v", managedPosition.TradeStrategyID, managedPosition.UserID, err)
+		}
+	}
+
+	return nil
+}
+
+func managePosition(ctx context.Context, managedPosition *domain.ManagedPosition, credentials venueCredentialsCache) error {
+	errParams := map[string]string{
+		"trade_strategy_id": managedPosition.TradeStrategyID,
+		"user_id":           managedPosition.UserID,
+		"status":            managedPosition.Status,
+	}
+
+	orders, err := listParticipantOrders(ctx, managedPosition.TradeStrategyID, managedPosition.UserID)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_manage_position.list_orders", errParams)
+	}
+
+	positions := netOpenPositions(orders)
+	if len(positions) == 0 {
+		if len(orders) == 0 || hasRestingEntries(orders) {
+			// Nothing has been entered yet.
+			return nil
+		}
+
+		// The position has been closed; either stopped out, or the final take profit filled.
+		return completeManagedPosition(ctx, managedPosition, orders, credentials)
+	}
+	position := positions[0]
+
+	switch managedPosition.Status {
+	case domain.ManagedPositionStatusAwaitingTakeProfit:
+		if !isTakeProfitFilled(orders) {
+			return nil
+		}
+
+		if err := onFirstTakeProfitFilled(ctx, managedPosition, orders, position, credentials); err != nil {
+			return gerrors.Augment(err, "failed_to_manage_position", errParams)
+		}
+	case domain.ManagedPositionStatusTrailing:
+		if !managedPosition.IsSyntheticTrailingStop {
+			// The venue trails the stop for us.
+			return nil
+		}
+
+		if err := trailStopLoss(ctx, managedPosition, orders, position, credentials); err != nil {
+			return gerrors.Augment(err, "failed_to_manage_position", errParams)
+		}
+	}
+
+	return nil
+}
+
+// onFirstTakeProfitFilled moves the stop loss to break even, if asked, & starts trailing the stop, if asked.
+func onFirstTakeProfitFilled(
+	ctx context.Context,
+	managedPosition *domain.ManagedPosition,
+	orders []*domain.Order,
+	position *openPosition,
+	credentials venueCredentialsCache,
+) error {
+	if managedPosition.MoveStopToBreakEven && managedPosition.BreakEvenPrice == 0 {
+		breakEven := breakEvenPrice(orders, position.Entry.TradeSide, managedPosition.ReferenceEntryPrice)
+		if err := moveStopLoss(ctx, orders, position, breakEven, credentials); err != nil {
+			return gerrors.Augment(err, "failed_to_move_stop_loss_to_break_even", nil)
+		}
+
+		// Persisted straight away, so we never move the stop to break even twice.
+		managedPosition.BreakEvenPrice = breakEven
+		if err := updateManagedPosition(ctx, managedPosition); err != nil {
+			return gerrors.Augment(err, "failed_to_update_managed_position", nil)
+		}
+
+		slog.Info(ctx, "Moved stop loss to break even: %s %s @ %f", managedPosition.TradeStrategyID, managedPosition.UserID, breakEven)
+		notifyManagedPosition(ctx, managedPosition, fmt.Sprintf("The first take profit filled; your stop loss has been moved to break even at %f.", breakEven))
+	}
+
+	if managedPosition.TrailingStopPercentage == 0 {
+		managedPosition.Status = domain.ManagedPositionStatusCompleted
+		return updateManagedPosition(ctx, managedPosition)
+	}
+
+	price, err := fetchLatestPrice(ctx, managedPosition.Instrument)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_start_trailing_stop", nil)
+	}
+	managedPosition.BestPrice = price
+
+	if !managedPosition.IsSyntheticTrailingStop {
+		if err := placeNativeTrailingStop(ctx, managedPosition, position, credentials); err != nil {
+			return gerrors.Augment(err, "failed_to_start_trailing_stop", nil)
+		}
+	}
+
+	managedPosition.Status = domain.ManagedPositionStatusTrailing
+	if err := updateManagedPosition(ctx, managedPosition); err != nil {
+		return gerrors.Augment(err, "failed_to_update_managed_position", nil)
+	}
+
+	slog.Info(ctx, "Started trailing stop: %s %s, synthetic: %v", managedPosition.TradeStrategyID, managedPosition.UserID, managedPosition.IsSyntheticTrailingStop)
+
+	return nil
+}
+
+// trailStopLoss ratchets the resting stop loss towards the best price seen since trailing began. If the price has already
+// retraced beyond the trailing stop between polls, the position is closed out at market.
+func trailStopLoss(
+	ctx context.Context,
+	managedPosition *domain.ManagedPosition,
+	orders []*domain.Order,
+	position *openPosition,
+	credentials venueCredentialsCache,
+) error {
+	price, err := fetchLatestPrice(ctx, managedPosition.Instrument)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_trail_stop_loss", nil)
+	}
+
+	isLong := isBuySide(position.Entry.TradeSide)
+	if (isLong && price > managedPosition.BestPrice) || (!isLong && price < managedPosition.BestPrice) {
+		managedPosition.BestPrice = price
+	}
+
+	stopPrice := trailingStopPrice(isLong, managedPosition.BestPrice, managedPosition.TrailingStopPercentage, managedPosition.BreakEvenPrice)
+
+	if (isLong && price <= stopPrice) || (!isLong && price >= stopPrice) {
+		venueCredentials, err := credentials.read(ctx, managedPosition.UserID, tradeengineproto.VENUE(tradeengineproto.VENUE_value[managedPosition.Venue]))
+		if err != nil {
+			return gerrors.Augment(err, "failed_to_trigger_trailing_stop", nil)
+		}
+
+		if _, err := closeOutOrder(
+			ctx, managedPosition.TradeStrategyID, managedPosition.UserID, marshaling.OrderDomainToProto(position.Entry), float32(position.Quantity), venueCredentials,
+		); err != nil {
+			return gerrors.Augment(err, "failed_to_trigger_trailing_stop", nil)
+		}
+
+		slog.Info(ctx, "Synthetic trailing stop triggered: %s %s @ %f", managedPosition.TradeStrategyID, managedPosition.UserID, price)
+		notifyManagedPosition(ctx, managedPosition, fmt.Sprintf("Your trailing stop triggered at %f; your position has been closed.", price))
+
+		return completeManagedPosition(ctx, managedPosition, orders, credentials)
+	}
+
+	currentStopPrice := restingStopPrice(orders)
+	minStep := price * minTrailingStopStepPercentage / 100
+	switch {
+	case currentStopPrice == 0:
+	case isLong && stopPrice-currentStopPrice < minStep, !isLong && currentStopPrice-stopPrice < minStep:
+		// Not worth replacing the stop; we still persist the best price.
+		return updateManagedPosition(ctx, managedPosition)
+	}
+
+	if err := moveStopLoss(ctx, orders, position, stopPrice, credentials); err != nil {
+		return gerrors.Augment(err, "failed_to_trail_stop_loss", nil)
+	}
+
+	return updateManagedPosition(ctx, managedPosition)
+}
+
+// trailingStopPrice returns the stop price trailing the best price by the given percentage; never worse than break even,
+// if the stop has already been moved there.
+func trailingStopPrice(isLong bool, bestPrice, trailingPercentage, breakEvenPrice float64) float64 {
+	if isLong {
+		stopPrice := bestPrice * (1 - trailingPercentage/100)
+		if breakEvenPrice > 0 && stopPrice < breakEvenPrice {
+			return breakEvenPrice
+		}
+		return stopPrice
+	}
+
+	stopPrice := bestPrice * (1 + trailingPercentage/100)
+	if breakEvenPrice > 0 && stopPrice > breakEvenPrice {
+		return breakEvenPrice
+	}
+	return stopPrice
+}
+
+// breakEvenPrice returns the average fill price of the entries, weighted by executed quantity. Market entries have no
+// limit price; so are priced at the reference entry price of the trade strategy.
+func breakEvenPrice(orders []*domain.Order, entryTradeSide string, referenceEntryPrice float64) float64 {
+	var notional, quantity float64
+	for _, order := range orders {
+		if order.ReduceOnly || order.ExecutedQuantity == 0 || isBuySide(order.TradeSide) != isBuySide(entryTradeSide) {
+			continue
+		}
+
+		price := order.LimitPrice
+		if price == 0 {
+			price = referenceEntryPrice
+		}
+
+		notional += price * order.ExecutedQuantity
+		quantity += order.ExecutedQuantity
+	}
+
+	if quantity == 0 {
+		return referenceEntryPrice
+	}
+
+	return notional / quantity
+}
+
+// moveStopLoss replaces every resting stop loss with one at the given stop price. If there's no resting stop loss, one is
+// placed for the remaining position.
+func moveStopLoss(ctx context.Context, orders []*domain.Order, position *openPosition, stopPrice float64, credentials venueCredentialsCache) error {
+	var stopLosses []*domain.Order
+	for _, order := range restingOrders(orders) {
+		if isStopLoss(order) {
+			stopLosses = append(stopLosses, order)
+		}
+	}
+
+	if len(stopLosses) > 0 {
+		for _, amended := range amendStopLoss(ctx, stopLosses, stopPrice, credentials) {
+			if amended.ErrorMessage != "" {
+				return gerrors.FailedPrecondition("failed_to_move_stop_loss", map[string]string{
+					"error": amended.ErrorMessage,
+				})
+			}
+		}
+
+		return nil
+	}
+
+	stopLoss := replacementStopLoss(position.Entry, stopPrice)
+	stopLoss.TradeSide = exitTradeSide(tradeengineproto.TRADE_SIDE(tradeengineproto.TRADE_SIDE_value[position.Entry.TradeSide]))
+	stopLoss.Quantity = float32(position.Quantity)
+
+	venueCredentials, err := credentials.read(ctx, position.Entry.UserID, stopLoss.Venue)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_place_stop_loss.credentials", nil)
+	}
+
+	if _, err := executeAndTrackOrder(
+		ctx, position.Entry.TradeStrategyID, position.Entry.UserID, stopLoss, stopLoss.Venue, stopLoss.InstrumentType, venueCredentials,
+	); err != nil {
+		return gerrors.Augment(err, "failed_to_place_stop_loss", nil)
+	}
+
+	return nil
+}
+
+// placeNativeTrailingStop places a reduce only trailing stop for the remaining position; the existing stop loss is left
+// resting, so the position is still protected at break even.
+func placeNativeTrailingStop(ctx context.Context, managedPosition *domain.ManagedPosition, position *openPosition, credentials venueCredentialsCache) error {
+	entry := marshaling.OrderDomainToProto(position.Entry)
+
+	venueCredentials, err := credentials.read(ctx, managedPosition.UserID, entry.Venue)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_place_trailing_stop.credentials", nil)
+	}
+
+	trailingStop := &tradeengineproto.Order{
+		ActorId:            tradeengineproto.TradeEngineActorSatoshiSystem,
+		Instrument:         entry.Instrument,
+		Asset:              entry.Asset,
+		Pair:               entry.Pair,
+		InstrumentType:     entry.InstrumentType,
+		OrderType:          tradeengineproto.ORDER_TYPE_TRAILING_STOP_MARKET,
+		TradeSide:          exitTradeSide(entry.TradeSide),
+		StopPrice:          float32(managedPosition.BestPrice),
+		TrailingPercentage: float32(managedPosition.TrailingStopPercentage),
+		Quantity:           float32(position.Quantity),
+		ReduceOnly:         true,
+		WorkingType:        tradeengineproto.WORKING_TYPE_MARK_PRICE,
+		Venue:              entry.Venue,
+		CreatedTimestamp:   time.Now().UTC().Unix(),
+	}
+
+	if _, err := executeAndTrackOrder(
+		ctx, managedPosition.TradeStrategyID, managedPosition.UserID, trailingStop, entry.Venue, entry.InstrumentType, venueCredentials,
+	); err != nil {
+		return gerrors.Augment(err, "failed_to_place_trailing_stop", nil)
+	}
+
+	return nil
+}
+
+// completeManagedPosition cancels any protective orders left resting once the position has been closed.
+func completeManagedPosition(ctx context.Context, managedPosition *domain.ManagedPosition, orders []*domain.Order, credentials venueCredentialsCache) error {
+	_, failed := cancelOrders(ctx, restingOrders(orders), true, credentials)
+	if len(failed) > 0 {
+		// These will be retried on the next poll.
+		return gerrors.FailedPrecondition("failed_to_complete_managed_position.cancel_orders", map[string]string{
+			"failed_orders": fmt.Sprintf("%d", len(failed)),
+		})
+	}
+
+	managedPosition.Status = domain.ManagedPositionStatusCompleted
+	if err := updateManagedPosition(ctx, managedPosition); err != nil {
+		return gerrors.Augment(err, "failed_to_complete_managed_position", nil)
+	}
+
+	return nil
+}
+
+func notifyManagedPosition(ctx context.Context, managedPosition *domain.ManagedPosition, msg string) {
+	// Best effort.
+	if err := notifyUserOfManagedPosition(ctx, fmt.Sprintf("[%s] %s", managedPosition.TradeStrategyID, msg), managedPosition.UserID); err != nil {
+		slog.Error(ctx, "Failed to notify user of managed position: %v", err)
+	}
+}
+
+func restingOrders(orders []*domain.Order) []*domain.Order {
+	var resting []*domain.Order
+	for _, order := range orders {
+		if isResting(order) {
+			resting = append(resting, order)
+		}
+	}
+
+	return resting
+}
+
+func hasRestingEntries(orders []*domain.Order) bool {
+	for _, order := range orders {
+		switch order.Status {
+		case domain.OrderStatusPendingNew, domain.OrderStatusNew, domain.OrderStatusPartiallyFilled:
+			if !order.ReduceOnly {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isTakeProfitFilled(orders []*domain.Order) bool {
+	for _, order := range orders {
+		switch order.OrderType {
+		case tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET.String(), tradeengineproto.ORDER_TYPE_TAKE_PROFIT_LIMIT.String():
+			if order.Status == domain.OrderStatusFilled {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// restingStopPrice returns the stop price of the resting stop loss, or zero if there isn't one.
+func restingStopPrice(orders []*domain.Order) float64 {
+	for _, order := range restingOrders(orders) {
+		if isStopLoss(order) {
+			return order.StopPrice
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,364 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func testManagedOrders(takeProfitStatus string) []*domain.Order {
+	order := func(id, orderType, tradeSide string, price, quantity, executedQuantity float64, reduceOnly bool, status string) *domain.Order {
+		o := &domain.Order{
+			OrderID:          id,
+			TradeStrategyID:  "trade-strategy-id",
+			UserID:           "user-id",
+			ExternalOrderID:  id,
+			Venue:            tradeengineproto.VENUE_BINANCE.String(),
+			InstrumentType:   tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL.String(),
+			Instrument:       "BTCUSDT",
+			Asset:            "BTC",
+			Pair:             tradeengineproto.TRADE_PAIR_USDT.String(),
+			OrderType:        orderType,
+			TradeSide:        tradeSide,
+			Quantity:         quantity,
+			ExecutedQuantity: executedQuantity,
+			ReduceOnly:       reduceOnly,
+			Status:           status,
+		}
+
+		switch orderType {
+		case tradeengineproto.ORDER_TYPE_LIMIT.String():
+			o.LimitPrice = price
+		default:
+			o.StopPrice = price
+		}
+
+		return o
+	}
+
+	takeProfitExecutedQuantity := 0.0
+	if takeProfitStatus == domain.OrderStatusFilled {
+		takeProfitExecutedQuantity = 1
+	}
+
+	buy, sell := tradeengineproto.TRADE_SIDE_BUY.String(), tradeengineproto.TRADE_SIDE_SELL.String()
+	return []*domain.Order{
+		order("entry-1", tradeengineproto.ORDER_TYPE_LIMIT.String(), buy, 100, 1, 1, false, domain.OrderStatusFilled),
+		order("entry-2", tradeengineproto.ORDER_TYPE_LIMIT.String(), buy, 96, 1, 1, false, domain.OrderStatusFilled),
+		order("stop-loss", tradeengineproto.ORDER_TYPE_STOP_MARKET.String(), sell, 90, 2, 0, true, domain.OrderStatusNew),
+		order("take-profit-1", tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET.String(), sell, 110, 1, takeProfitExecutedQuantity, true, takeProfitStatus),
+		order("take-profit-2", tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET.String(), sell, 120, 1, 0, true, domain.OrderStatusNew),
+	}
+}
+
+// withFakePositionManager fakes the persistence, pricing & routing of the position manager; returning every order routed
+// to the venue & every cancellation.
+func withFakePositionManager(t *testing.T, orders []*domain.Order, price float64) (*[]*tradeengineproto.Order, *[]string) {
+	var (
+		routed []*tradeengineproto.Order
+		calls  []string
+	)
+
+	withFakeOrderStore(t)
+	withFakeCanceller(t, &calls)
+
+	originalList, originalUpdate, originalFetch, originalNotify := listParticipantOrders, updateManagedPosition, fetchLatestPrice, notifyUserOfManagedPosition
+	t.Cleanup(func() {
+		listParticipantOrders, updateManagedPosition, fetchLatestPrice, notifyUserOfManagedPosition = originalList, originalUpdate, originalFetch, originalNotify
+	})
+
+	listParticipantOrders = func(ctx context.Context, tradeStrategyID, userID string) ([]*domain.Order, error) {
+		return orders, nil
+	}
+	updateManagedPosition = func(ctx context.Context, managedPosition *domain.ManagedPosition) error {
+		return nil
+	}
+	fetchLatestPrice = func(ctx context.Context, symbol string) (float64, error) {
+		return price, nil
+	}
+	notifyUserOfManagedPosition = func(ctx context.Context, msg, userID string) error {
+		return nil
+	}
+	routeAndExecuteNewOrder = func(
+		ctx context.Context,
+		order *tradeengineproto.Order,
+		venue tradeengineproto.VENUE,
+		instrumentType tradeengineproto.INSTRUMENT_TYPE,
+		venueCredentials *tradeengineproto.VenueCredentials,
+	) (*tradeengineproto.Order, error) {
+		routed = append(routed, order)
+		return order, nil
+	}
+
+	return &routed, &calls
+}
+
+func testManagedPosition(status string) *domain.ManagedPosition {
+	return &domain.ManagedPosition{
+		TradeStrategyID:     "trade-strategy-id",
+		UserID:              "user-id",
+		Venue:               tradeengineproto.VENUE_BINANCE.String(),
+		Instrument:          "BTCUSDT",
+		InstrumentType:      tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL.String(),
+		TradeSide:           tradeengineproto.TRADE_SIDE_BUY.String(),
+		ReferenceEntryPrice: 98,
+		Status:              status,
+	}
+}
+
+func TestManagePosition_AwaitingTakeProfit(t *testing.T) {
+	tests := []struct {
+		name                    string
+		takeProfitStatus        string
+		moveStopToBreakEven     bool
+		trailingStopPercentage  float64
+		isSyntheticTrailingStop bool
+		expectedStatus          string
+		expectedRouted          []tradeengineproto.ORDER_TYPE
+		expectedStopPrice       float32
+		expectedCalls           []string
+	}{
+		{
+			name:                "take_profit_not_yet_filled",
+			takeProfitStatus:    domain.OrderStatusNew,
+			moveStopToBreakEven: true,
+			expectedStatus:      domain.ManagedPositionStatusAwaitingTakeProfit,
+		},
+		{
+			name:                "move_stop_to_break_even",
+			takeProfitStatus:    domain.OrderStatusFilled,
+			moveStopToBreakEven: true,
+			expectedStatus:      domain.ManagedPositionStatusCompleted,
+			expectedRouted:      []tradeengineproto.ORDER_TYPE{tradeengineproto.ORDER_TYPE_STOP_MARKET},
+			expectedStopPrice:   98,
+			expectedCalls:       []string{"cancel:stop-loss"},
+		},
+		{
+			name:                   "move_stop_to_break_even_and_start_native_trailing_stop",
+			takeProfitStatus:       domain.OrderStatusFilled,
+			moveStopToBreakEven:    true,
+			trailingStopPercentage: 2,
+			expectedStatus:         domain.ManagedPositionStatusTrailing,
+			expectedRouted: []tradeengineproto.ORDER_TYPE{
+				tradeengineproto.ORDER_TYPE_STOP_MARKET, tradeengineproto.ORDER_TYPE_TRAILING_STOP_MARKET,
+			},
+			expectedStopPrice: 98,
+			expectedCalls:     []string{"cancel:stop-loss"},
+		},
+		{
+			name:                    "start_synthetic_trailing_stop",
+			takeProfitStatus:        domain.OrderStatusFilled,
+			trailingStopPercentage:  2,
+			isSyntheticTrailingStop: true,
+			expectedStatus:          domain.ManagedPositionStatusTrailing,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			routed, calls := withFakePositionManager(t, testManagedOrders(tt.takeProfitStatus), 112)
+
+			managedPosition := testManagedPosition(domain.ManagedPositionStatusAwaitingTakeProfit)
+			managedPosition.MoveStopToBreakEven = tt.moveStopToBreakEven
+			managedPosition.TrailingStopPercentage = tt.trailingStopPercentage
+			managedPosition.IsSyntheticTrailingStop = tt.isSyntheticTrailingStop
+
+			require.NoError(t, managePosition(context.Background(), managedPosition, testCredentialsCache("user-id")))
+			assert.Equal(t, tt.expectedStatus, managedPosition.Status)
+			assert.Equal(t, tt.expectedCalls, *calls)
+
+			var routedOrderTypes []tradeengineproto.ORDER_TYPE
+			for _, o := range *routed {
+				routedOrderTypes = append(routedOrderTypes, o.OrderType)
+				assert.True(t, o.ReduceOnly)
+				assert.Equal(t, tradeengineproto.TRADE_SIDE_SELL, o.TradeSide)
+
+				switch o.OrderType {
+				case tradeengineproto.ORDER_TYPE_STOP_MARKET:
+					assert.Equal(t, tt.expectedStopPrice, o.StopPrice)
+				case tradeengineproto.ORDER_TYPE_TRAILING_STOP_MARKET:
+					assert.Equal(t, float32(tt.trailingStopPercentage), o.TrailingPercentage)
+					// The remaining position after the first take profit.
+					assert.Equal(t, float32(1), o.Quantity)
+				}
+			}
+			assert.Equal(t, tt.expectedRouted, routedOrderTypes)
+
+			if tt.trailingStopPercentage > 0 {
+				assert.Equal(t, float64(112), managedPosition.BestPrice)
+			}
+		})
+	}
+}
+
+func TestManagePosition_SyntheticTrailingStop(t *testing.T) {
+	tests := []struct {
+		name              string
+		bestPrice         float64
+		price             float64
+		expectedBestPrice float64
+		expectedStatus    string
+		expectedRouted    []*tradeengineproto.Order
+		expectedCalls     []string
+	}{
+		{
+			name:              "stop_ratcheted_to_new_high",
+			bestPrice:         110,
+			price:             115,
+			expectedBestPrice: 115,
+			expectedStatus:    domain.ManagedPositionStatusTrailing,
+			expectedRouted: []*tradeengineproto.Order{
+				{OrderType: tradeengineproto.ORDER_TYPE_STOP_MARKET, StopPrice: 115 * 0.98},
+			},
+			expectedCalls: []string{"cancel:stop-loss"},
+		},
+		{
+			name:              "trailing_stop_never_below_break_even",
+			bestPrice:         99,
+			price:             99,
+			expectedBestPrice: 99,
+			expectedStatus:    domain.ManagedPositionStatusTrailing,
+		},
+		{
+			name:              "price_retraced_beyond_trailing_stop_between_polls",
+			bestPrice:         120,
+			price:             117,
+			expectedBestPrice: 120,
+			expectedStatus:    domain.ManagedPositionStatusCompleted,
+			expectedRouted: []*tradeengineproto.Order{
+				// The remaining position after the first take profit.
+				{OrderType: tradeengineproto.ORDER_TYPE_MARKET, Quantity: 1},
+			},
+			expectedCalls: []string{"cancel:stop-loss", "cancel:take-profit-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			orders := testManagedOrders(domain.OrderStatusFilled)
+			// The stop has already been moved to break even.
+			orders[2].StopPrice = 98
+			routed, calls := withFakePositionManager(t, orders, tt.price)
+
+			managedPosition := testManagedPosition(domain.ManagedPositionStatusTrailing)
+			managedPosition.MoveStopToBreakEven = true
+			managedPosition.BreakEvenPrice = 98
+			managedPosition.TrailingStopPercentage = 2
+			managedPosition.IsSyntheticTrailingStop = true
+			managedPosition.BestPrice = tt.bestPrice
+
+			require.NoError(t, managePosition(context.Background(), managedPosition, testCredentialsCache("user-id")))
+			assert.Equal(t, tt.expectedStatus, managedPosition.Status)
+			assert.Equal(t, tt.expectedBestPrice, managedPosition.BestPrice)
+			assert.Equal(t, tt.expectedCalls, *calls)
+
+			require.Len(t, *routed, len(tt.expectedRouted))
+			for i, expected := range tt.expectedRouted {
+				o := (*routed)[i]
+				assert.Equal(t, expected.OrderType, o.OrderType)
+				assert.InDelta(t, expected.StopPrice, o.StopPrice, 1e-3)
+				assert.Equal(t, tradeengineproto.TRADE_SIDE_SELL, o.TradeSide)
+				if expected.Quantity > 0 {
+					assert.Equal(t, expected.Quantity, o.Quantity)
+				}
+			}
+		})
+	}
+}
+
+func TestManagePosition_PositionClosed(t *testing.T) {
+	orders := testManagedOrders(domain.OrderStatusFilled)
+	// Stopped out of the remaining position.
+	orders[2].ExecutedQuantity, orders[2].Status = 1, domain.OrderStatusFilled
+	routed, calls := withFakePositionManager(t, orders, 95)
+
+	managedPosition := testManagedPosition(domain.ManagedPositionStatusTrailing)
+	managedPosition.TrailingStopPercentage = 2
+	managedPosition.IsSyntheticTrailingStop = true
+
+	require.NoError(t, managePosition(context.Background(), managedPosition, testCredentialsCache("user-id")))
+	assert.Equal(t, domain.ManagedPositionStatusCompleted, managedPosition.Status)
+	assert.Equal(t, []string{"cancel:take-profit-2"}, *calls)
+	assert.Empty(t, *routed)
+}
+
+func TestBreakEvenPrice(t *testing.T) {
+	t.Parallel()
+
+	orders := testManagedOrders(domain.OrderStatusFilled)
+	assert.InDelta(t, 98, breakEvenPrice(orders, tradeengineproto.TRADE_SIDE_BUY.String(), 50), 1e-9)
+
+	// Market entries are priced at the reference entry price.
+	orders[1].LimitPrice = 0
+	assert.InDelta(t, 75, breakEvenPrice(orders, tradeengineproto.TRADE_SIDE_BUY.String(), 50), 1e-9)
+
+	// Nothing filled.
+	assert.Equal(t, float64(50), breakEvenPrice(nil, tradeengineproto.TRADE_SIDE_BUY.String(), 50))
+}
+
+func TestTrailingStopPrice(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		isLong             bool
+		bestPrice          float64
+		breakEvenPrice     float64
+		expectedStopPrice  float64
+		trailingPercentage float64
+	}{
+		{
+			name:               "long",
+			isLong:             true,
+			bestPrice:          200,
+			trailingPercentage: 5,
+			expectedStopPrice:  190,
+		},
+		{
+			name:               "long_floored_at_break_even",
+			isLong:             true,
+			bestPrice:          200,
+			trailingPercentage: 5,
+			breakEvenPrice:     195,
+			expectedStopPrice:  195,
+		},
+		{
+			name:               "short",
+			bestPrice:          200,
+			trailingPercentage: 5,
+			expectedStopPrice:  210,
+		},
+		{
+			name:               "short_capped_at_break_even",
+			bestPrice:          200,
+			trailingPercentage: 5,
+			breakEvenPrice:     205,
+			expectedStopPrice:  205,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.InDelta(t, tt.expectedStopPrice, trailingStopPrice(tt.isLong, tt.bestPrice, tt.trailingPercentage, tt.breakEvenPrice), 1e-9)
+		})
+	}
+}
+
+func TestIsNativeTrailingStopSupported(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isNativeTrailingStopSupported(tradeengineproto.VENUE_BINANCE, tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL, 1))
+	assert.False(t, isNativeTrailingStopSupported(tradeengineproto.VENUE_BINANCE, tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL, 10))
+	assert.False(t, isNativeTrailingStopSupported(tradeengineproto.VENUE_BINANCE, tradeengineproto.INSTRUMENT_TYPE_SPOT, 1))
+	assert.False(t, isNativeTrailingStopSupported(tradeengineproto.VENUE_PAPER, tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL, 1))
+}
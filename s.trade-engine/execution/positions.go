@@ -0,0 +1,144 @@
+package execution
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/monzo/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/libraries/gerrors"
+	binanceproto "swallowtail/s.binance/proto"
+	ftxproto "swallowtail/s.ftx/proto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// listVenueCredentials & readVenuePositions are package variables so venues can be faked in tests.
+var (
+	listVenueCredentials = listActiveVenueCredentials
+	readVenuePositions   = readVenuePositionsFromVenue
+)
+
+// IsReadingPositionsSupported returns true if the open positions of the venue can be read.
+func IsReadingPositionsSupported(venue tradeengineproto.VENUE) bool {
+	switch venue {
+	case tradeengineproto.VENUE_BINANCE, tradeengineproto.VENUE_FTX:
+		return true
+	default:
+		return false
+	}
+}
+
+// ListVenuePositions lists the open positions of the user on each of their active venue accounts that support it. A
+// venue that can't be read is listed with its error, rather than failing the rest.
+func ListVenuePositions(ctx context.Context, userID string, realisedPnLSince time.Time) ([]*tradeengineproto.VenuePositions, error) {
+	venueCredentials, err := listVenueCredentials(ctx, userID)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_venue_positions", nil)
+	}
+
+	venuePositions := []*tradeengineproto.VenuePositions{}
+	for _, credentials := range venueCredentials {
+		if !IsReadingPositionsSupported(credentials.Venue) {
+			continue
+		}
+
+		positions, err := readVenuePositions(ctx, credentials, realisedPnLSince)
+		if err != nil {
+			slog.Warn(ctx, "Failed to read positions of user: %s on venue: %s, Error: %v", userID, credentials.Venue, err)
+			positions = &tradeengineproto.VenuePositions{
+				Error: err.Error(),
+			}
+		}
+
+		positions.Venue = credentials.Venue
+		venuePositions = append(venuePositions, positions)
+	}
+
+	sort.Slice(venuePositions, func(i, j int) bool {
+		return venuePositions[i].Venue < venuePositions[j].Venue
+	})
+
+	return venuePositions, nil
+}
+
+func readVenuePositionsFromVenue(ctx context.Context, credentials *tradeengineproto.VenueCredentials, realisedPnLSince time.Time) (*tradeengineproto.VenuePositions, error) {
+	errParams := map[string]string{
+		"venue": credentials.Venue.String(),
+	}
+
+	switch credentials.Venue {
+	case tradeengineproto.VENUE_BINANCE:
+		rsp, err := (&binanceproto.ListPerpetualFuturesPositionsRequest{
+			ActorId:          binanceproto.BinanceAccountActorTradeEngineSystem,
+			Credentials:      credentials,
+			RealisedPnlSince: timestamppb.New(realisedPnLSince),
+		}).Send(ctx).Response()
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_read_venue_positions", errParams)
+		}
+
+		positions := make([]*tradeengineproto.VenuePosition, 0, len(rsp.GetPositions()))
+		for _, p := range rsp.GetPositions() {
+			positions = append(positions, &tradeengineproto.VenuePosition{
+				Instrument:       p.Symbol,
+				Quantity:         p.Quantity,
+				EntryPrice:       p.EntryPrice,
+				MarkPrice:        p.MarkPrice,
+				LiquidationPrice: p.LiquidationPrice,
+				UnrealisedPnl:    p.UnrealisedPnl,
+			})
+		}
+
+		return &tradeengineproto.VenuePositions{
+			Positions:     positions,
+			MarginRatio:   rsp.MarginRatio,
+			UnrealisedPnl: rsp.UnrealisedPnl,
+			RealisedPnl:   rsp.RealisedPnl,
+		}, nil
+	case tradeengineproto.VENUE_FTX:
+		accountRsp, err := (&ftxproto.ReadAccountInformationRequest{
+			Credentials: credentials,
+		}).Send(ctx).Response()
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_read_venue_positions.account_information", errParams)
+		}
+
+		positionsRsp, err := (&ftxproto.ListAccountPositionsRequest{
+			Credentials: credentials,
+		}).Send(ctx).Response()
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_read_venue_positions", errParams)
+		}
+
+		// FTX only reports the realised PnL of each position over its lifetime; so we can't report it over the window.
+		venuePositions := &tradeengineproto.VenuePositions{
+			Positions:              make([]*tradeengineproto.VenuePosition, 0, len(positionsRsp.GetPositions())),
+			RealisedPnlUnavailable: true,
+		}
+
+		// FTX liquidates once the margin fraction falls to the maintenance margin requirement; without positions the
+		// margin fraction is zero.
+		if accountRsp.MarginFraction > 0 {
+			venuePositions.MarginRatio = accountRsp.MaintenanceMarginRequirement / accountRsp.MarginFraction
+		}
+
+		for _, p := range positionsRsp.GetPositions() {
+			venuePositions.Positions = append(venuePositions.Positions, &tradeengineproto.VenuePosition{
+				Instrument:       strings.ToUpper(p.Market),
+				Quantity:         p.NetSize,
+				EntryPrice:       p.EntryPrice,
+				LiquidationPrice: p.EstimatedLiquidationPrice,
+				UnrealisedPnl:    p.UnrealisedPnl,
+			})
+
+			venuePositions.UnrealisedPnl += p.UnrealisedPnl
+		}
+
+		return venuePositions, nil
+	default:
+		return nil, gerrors.Unimplemented("failed_to_read_venue_positions.unsupported_venue", errParams)
+	}
+}
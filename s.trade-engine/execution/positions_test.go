@@ -0,0 +1,60 @@
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func TestListVenuePositions(t *testing.T) {
+	originalList, originalRead := listVenueCredentials, readVenuePositions
+	t.Cleanup(func() {
+		listVenueCredentials, readVenuePositions = originalList, originalRead
+	})
+
+	listVenueCredentials = func(ctx context.Context, userID string) ([]*tradeengineproto.VenueCredentials, error) {
+		return []*tradeengineproto.VenueCredentials{
+			{Venue: tradeengineproto.VENUE_FTX},
+			{Venue: tradeengineproto.VENUE_DERIBIT},
+			{Venue: tradeengineproto.VENUE_BINANCE},
+		}, nil
+	}
+
+	var read []tradeengineproto.VENUE
+	readVenuePositions = func(ctx context.Context, credentials *tradeengineproto.VenueCredentials, realisedPnLSince time.Time) (*tradeengineproto.VenuePositions, error) {
+		read = append(read, credentials.Venue)
+
+		if credentials.Venue == tradeengineproto.VENUE_FTX {
+			return nil, gerrors.FailedPrecondition("ftx_unavailable", nil)
+		}
+
+		return &tradeengineproto.VenuePositions{
+			Positions: []*tradeengineproto.VenuePosition{
+				{Instrument: "BTCUSDT", Quantity: -0.1},
+			},
+			MarginRatio: 0.1,
+		}, nil
+	}
+
+	venuePositions, err := ListVenuePositions(context.Background(), "user-id", time.Now())
+	require.NoError(t, err)
+
+	// Venues we can't read positions from are skipped; those that fail are listed with their error.
+	assert.ElementsMatch(t, []tradeengineproto.VENUE{tradeengineproto.VENUE_BINANCE, tradeengineproto.VENUE_FTX}, read)
+	require.Len(t, venuePositions, 2)
+
+	assert.Equal(t, tradeengineproto.VENUE_BINANCE, venuePositions[0].Venue)
+	assert.Empty(t, venuePositions[0].Error)
+	require.Len(t, venuePositions[0].Positions, 1)
+	assert.Equal(t, "BTCUSDT", venuePositions[0].Positions[0].Instrument)
+
+	assert.Equal(t, tradeengineproto.VENUE_FTX, venuePositions[1].Venue)
+	assert.Contains(t, venuePositions[1].Error, "ftx_unavailable")
+	assert.Empty(t, venuePositions[1].Positions)
+}
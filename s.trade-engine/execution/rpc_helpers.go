@@ -6,13 +6,18 @@ import (
 	"strings"
 	"time"
 
+	"github.com/monzo/slog"
+
 	"swallowtail/libraries/gerrors"
 	"swallowtail/libraries/util"
 	accountproto "swallowtail/s.account/proto"
 	binanceproto "swallowtail/s.binance/proto"
+	bitfinexproto "swallowtail/s.bitfinex/proto"
+	deribitproto "swallowtail/s.deribit/proto"
 	discordproto "swallowtail/s.discord/proto"
 	ftxproto "swallowtail/s.ftx/proto"
 	"swallowtail/s.trade-engine/marshaling"
+	"swallowtail/s.trade-engine/paper"
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 )
 
@@ -32,7 +37,60 @@ func readVenueCredentials(ctx context.Context, userID string, venue tradeenginep
 	return marshaling.VenueAccountToVenueCredentials(rsp.GetVenueAccount()), nil
 }
 
-func readVenueAccountBalance(ctx context.Context, venue tradeengineproto.VENUE, tradeStrategy *tradeengineproto.TradeStrategy, credentials *tradeengineproto.VenueCredentials) (float64, error) {
+// listActiveVenueCredentials lists the credentials of each of the users active venue accounts.
+func listActiveVenueCredentials(ctx context.Context, userID string) ([]*tradeengineproto.VenueCredentials, error) {
+	rsp, err := (&accountproto.ListVenueAccountsRequest{
+		UserId:                  userID,
+		ActiveOnly:              true,
+		ActorId:                 accountproto.ActorSystemTradeEngine,
+		WithUnmaskedCredentials: true,
+	}).Send(ctx).Response()
+	switch {
+	case gerrors.Is(err, gerrors.ErrNotFound):
+		return []*tradeengineproto.VenueCredentials{}, nil
+	case err != nil:
+		return nil, gerrors.Augment(err, "failed_to_list_active_venue_credentials", nil)
+	}
+
+	credentials := make([]*tradeengineproto.VenueCredentials, 0, len(rsp.GetVenueAccounts()))
+	for _, venueAccount := range rsp.GetVenueAccounts() {
+		credentials = append(credentials, marshaling.VenueAccountToVenueCredentials(venueAccount))
+	}
+
+	return credentials, nil
+}
+
+func readParticipantRiskProfile(ctx context.Context, userID string) (*accountproto.RiskProfile, error) {
+	rsp, err := (&accountproto.ReadRiskProfileRequest{
+		UserId:  userID,
+		ActorId: accountproto.ActorSystemTradeEngine,
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_risk_profile", nil)
+	}
+
+	return rsp.GetRiskProfile(), nil
+}
+
+// venueCredentialsCache caches venue credentials by user & venue; it is only intended to live for a single batch operation.
+type venueCredentialsCache map[string]*tradeengineproto.VenueCredentials
+
+func (c venueCredentialsCache) read(ctx context.Context, userID string, venue tradeengineproto.VENUE) (*tradeengineproto.VenueCredentials, error) {
+	key := fmt.Sprintf("%s-%s", userID, venue)
+	if credentials, ok := c[key]; ok {
+		return credentials, nil
+	}
+
+	credentials, err := readVenueCredentials(ctx, userID, venue)
+	if err != nil {
+		return nil, err
+	}
+
+	c[key] = credentials
+	return credentials, nil
+}
+
+func readVenueAccountBalance(ctx context.Context, userID string, venue tradeengineproto.VENUE, tradeStrategy *tradeengineproto.TradeStrategy, credentials *tradeengineproto.VenueCredentials) (float64, error) {
 	errParams := map[string]string{
 		"venue": venue.String(),
 	}
@@ -68,6 +126,51 @@ func readVenueAccountBalance(ctx context.Context, venue tradeengineproto.VENUE,
 		}
 
 		return float64(balanceForPair.AvailableWithoutBorrow), nil
+	case tradeengineproto.VENUE_BITFINEX:
+		rsp, err := (&bitfinexproto.ListBitfinexWalletsRequest{
+			Credentials: credentials,
+		}).Send(ctx).Response()
+		if err != nil {
+			return 0, gerrors.Augment(err, "failed_to_read_venue_account_balance", errParams)
+		}
+
+		// Spot is traded from the exchange wallet; perpetuals from the margin wallet, collateralized in USDT.
+		walletType, currency := bitfinexproto.BitfinexWalletTypeMargin, bitfinexproto.BitfinexDerivativesCollateralCurrency
+		if tradeStrategy.InstrumentType == tradeengineproto.INSTRUMENT_TYPE_SPOT {
+			walletType, currency = bitfinexproto.BitfinexWalletTypeExchange, bitfinexproto.BitfinexCurrency(tradeStrategy.Pair)
+		}
+
+		for _, w := range rsp.GetWallets() {
+			if w.WalletType == walletType && strings.ToUpper(w.Currency) == currency {
+				return float64(w.AvailableBalance), nil
+			}
+		}
+
+		errParams["wallet_type"], errParams["currency"] = walletType, currency
+		return 0, gerrors.FailedPrecondition("failed_to_read_venue_account_balance.missing_wallet", errParams)
+	case tradeengineproto.VENUE_DERIBIT:
+		// Inverse perpetuals & options are collateralized in the asset itself; linear perpetuals in USDC.
+		currency := strings.ToUpper(tradeStrategy.Asset)
+		if tradeStrategy.Pair == tradeengineproto.TRADE_PAIR_USDC {
+			currency = tradeengineproto.TRADE_PAIR_USDC.String()
+		}
+
+		rsp, err := (&deribitproto.ReadDeribitAccountSummaryRequest{
+			Credentials: credentials,
+			Currency:    currency,
+		}).Send(ctx).Response()
+		if err != nil {
+			return 0, gerrors.Augment(err, "failed_to_read_venue_account_balance", errParams)
+		}
+
+		return float64(rsp.AvailableFundsUsd), nil
+	case tradeengineproto.VENUE_PAPER:
+		balance, err := paper.ReadBalance(ctx, userID, tradeStrategy.Pair.String())
+		if err != nil {
+			return 0, gerrors.Augment(err, "failed_to_read_venue_account_balance", errParams)
+		}
+
+		return balance, nil
 	default:
 		return 0, gerrors.Unimplemented("failed_to_read_venue_account_balance.unimplemented.venue", errParams)
 	}
@@ -91,3 +194,152 @@ func notifyUser(ctx context.Context, msg string, userID string) error {
 
 	return nil
 }
+
+// fetchLatestPrice is a package variable so prices can be faked in tests. Binance is used as the reference price, regardless
+// of the venue traded on.
+var fetchLatestPrice = func(ctx context.Context, symbol string) (float64, error) {
+	rsp, err := (&binanceproto.GetLatestPriceRequest{
+		Symbol: symbol,
+	}).Send(ctx).Response()
+	if err != nil {
+		return 0, gerrors.Augment(err, "failed_to_fetch_latest_price", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	return float64(rsp.Price), nil
+}
+
+// fetchHistoricalKlineVolumes is a package variable so the historical volume curve can be replayed in tests.
+var fetchHistoricalKlineVolumes = func(ctx context.Context, symbol string, from, to time.Time) ([]*KlineVolume, error) {
+	rsp, err := (&binanceproto.ListKlinesRequest{
+		Symbol:    symbol,
+		Interval:  "1m",
+		StartTime: from.UnixNano() / int64(time.Millisecond),
+		EndTime:   to.UnixNano() / int64(time.Millisecond),
+		Limit:     1500,
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_fetch_historical_klines", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	klineVolumes := make([]*KlineVolume, 0, len(rsp.GetKlines()))
+	for _, k := range rsp.GetKlines() {
+		klineVolumes = append(klineVolumes, &KlineVolume{
+			OpenTime: time.Unix(0, k.OpenTime*int64(time.Millisecond)).UTC(),
+			Volume:   float64(k.BaseAssetVolume),
+		})
+	}
+
+	return klineVolumes, nil
+}
+
+// fetchInstrumentFilters is a package variable so instrument filters can be faked in tests. Venues we don't have filters
+// for return empty filters; orders on them are placed unrounded.
+var fetchInstrumentFilters = func(ctx context.Context, strategy *tradeengineproto.TradeStrategy, venue tradeengineproto.VENUE) (*instrumentFilters, error) {
+	errParams := map[string]string{
+		"venue":      venue.String(),
+		"asset":      strategy.Asset,
+		"instrument": strategy.Instrument,
+	}
+
+	switch venue {
+	case tradeengineproto.VENUE_BINANCE:
+		symbol := strings.ToUpper(strategy.Instrument)
+		if symbol == "" {
+			symbol = fmt.Sprintf("%s%s", strings.ToUpper(strategy.Asset), strings.ToUpper(strategy.Pair.String()))
+		}
+
+		rsp, err := (&binanceproto.GetInstrumentFiltersRequest{
+			Symbol: symbol,
+		}).Send(ctx).Response()
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_fetch_instrument_filters", errParams)
+		}
+
+		// Take profits are placed as conditional orders; so are subject to the limit lot size rather than the market one.
+		return &instrumentFilters{
+			TickSize:    float64(rsp.TickSize),
+			LotSize:     float64(rsp.LotSize),
+			MinQuantity: float64(rsp.MinQuantity),
+		}, nil
+	case tradeengineproto.VENUE_FTX:
+		market := strings.ToUpper(strategy.Instrument)
+		if market == "" {
+			switch strategy.InstrumentType {
+			case tradeengineproto.INSTRUMENT_TYPE_SPOT:
+				market = fmt.Sprintf("%s/%s", strings.ToUpper(strategy.Asset), strings.ToUpper(strategy.Pair.String()))
+			default:
+				market = fmt.Sprintf("%s-PERP", strings.ToUpper(strategy.Asset))
+			}
+		}
+
+		rsp, err := (&ftxproto.GetFTXInstrumentFiltersRequest{
+			Market: market,
+		}).Send(ctx).Response()
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_fetch_instrument_filters", errParams)
+		}
+
+		return &instrumentFilters{
+			TickSize:    float64(rsp.TickSize),
+			LotSize:     float64(rsp.LotSize),
+			MinQuantity: float64(rsp.MinQuantity),
+		}, nil
+	default:
+		return &instrumentFilters{}, nil
+	}
+}
+
+// fetchDefaultTakeProfitAllocations is a package variable so account defaults can be faked in tests.
+var fetchDefaultTakeProfitAllocations = func(ctx context.Context, userID string) ([]float32, error) {
+	rsp, err := (&accountproto.ReadAccountRequest{
+		UserId: userID,
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_fetch_default_take_profit_allocations", map[string]string{
+			"user_id": userID,
+		})
+	}
+
+	return rsp.GetAccount().GetDefaultTakeProfitAllocations(), nil
+}
+
+// resolveTakeProfitAllocations returns the allocations of the trade strategy if it has them; otherwise the participant's
+// default allocations, trimmed to the number of take profits. If neither is set nil is returned & the take profits are
+// split evenly.
+func resolveTakeProfitAllocations(ctx context.Context, strategy *tradeengineproto.TradeStrategy, userID string) []float32 {
+	numberOfTakeProfits := len(strategy.TakeProfits)
+	if len(strategy.TakeProfitAllocations) == numberOfTakeProfits {
+		return strategy.TakeProfitAllocations
+	}
+
+	defaults, err := fetchDefaultTakeProfitAllocations(ctx, userID)
+	if err != nil {
+		// Best effort; we'd rather split evenly than not trade at all.
+		slog.Error(ctx, "Failed to read default take profit allocations of %s, splitting evenly: %v", userID, err)
+		return nil
+	}
+
+	if numberOfTakeProfits == 0 || len(defaults) < numberOfTakeProfits {
+		return nil
+	}
+
+	// Rescale the defaults we use so they still sum to 100.
+	var total float32
+	for _, a := range defaults[:numberOfTakeProfits] {
+		total += a
+	}
+	if total <= 0 {
+		return nil
+	}
+
+	allocations := make([]float32, 0, numberOfTakeProfits)
+	for _, a := range defaults[:numberOfTakeProfits] {
+		allocations = append(allocations, a*100/total)
+	}
+
+	return allocations
+}
@@ -0,0 +1,615 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/monzo/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/risk"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/domain"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	schedulerPollInterval        = 15 * time.Second
+	schedulerBatchSize           = 50
+	maxChildOrderAttempts        = 3
+	childOrderRetryBackoff       = 30 * time.Second
+	childOrderPausedBackoff      = time.Minute
+	defaultNumberOfChildOrders   = 12
+	defaultExecutionHorizon      = 60 * time.Minute
+	minNumberOfChildOrders       = 2
+	maxNumberOfChildOrders       = 100
+	maxExecutionHorizonInMinutes = 24 * 60
+)
+
+// The execution schedule store, the venue reads & the clock of scheduled executions are package variables so schedules
+// can be replayed in tests.
+var (
+	createExecutionSchedule          = dao.CreateExecutionSchedule
+	readExecutionSchedule            = dao.ReadExecutionScheduleByID
+	listChildOrders                  = dao.ListChildOrdersByExecutionScheduleID
+	listDueChildOrders               = dao.ListDueChildOrders
+	claimChildOrder                  = dao.ClaimChildOrder
+	deferChildOrder                  = dao.DeferChildOrder
+	updateChildOrder                 = dao.UpdateChildOrder
+	completeExecutionScheduleIfDone  = dao.CompleteExecutionScheduleIfDone
+	readScheduledVenueCredentials    = readVenueCredentials
+	readScheduledVenueAccountBalance = readVenueAccountBalance
+	schedulerNow                     = time.Now
+)
+
+// ChildOrderDetail describes a single slice of a scheduled execution.
+type ChildOrderDetail struct {
+	SequenceNumber int
+	Quantity       float64
+	ScheduledFor   time.Time
+}
+
+// Init starts the execution scheduler, the order status poller & the position manager; any schedules persisted before a
+// restart are resumed from where they left off.
+func Init(ctx context.Context) error {
+	// Anything left executing was interrupted by the last shutdown; we can't know if it reached the venue.
+	n, err := dao.FailInterruptedChildOrders(ctx)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_init_execution_scheduler", nil)
+	}
+	if n > 0 {
+		slog.Warn(ctx, "Marked %d interrupted child orders as failed on startup", n)
+	}
+
+	go runScheduler(ctx)
+	go runOrderStatusPoller(ctx)
+	go runPositionManager(ctx)
+
+	return nil
+}
+
+func runScheduler(ctx context.Context) {
+	t := time.NewTicker(schedulerPollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			if err := executeDueChildOrders(ctx); err != nil {
+				slog.Error(ctx, "Failed to execute due child orders: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func executeDueChildOrders(ctx context.Context) error {
+	childOrders, err := listDueChildOrders(ctx, schedulerNow().UTC(), schedulerBatchSize)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_due_child_orders", nil)
+	}
+
+	for _, co := range childOrders {
+		schedule, err := readExecutionSchedule(ctx, co.ExecutionScheduleID)
+		if err != nil {
+			slog.Error(ctx, "Failed to read execution schedule for child order: %s, Error: %v", co.ChildOrderID, err)
+			continue
+		}
+
+		if _, err := executeScheduledChildOrder(ctx, schedule, co, nil); err != nil {
+			slog.Error(ctx, "Failed to execute child order: %s, Error: %v", co.ChildOrderID, err)
+		}
+	}
+
+	return nil
+}
+
+// executeScheduledChildOrder claims & executes the given child order. If credentials are nil they are read from s.account.
+// A nil order is returned, without error, if the child order was claimed elsewhere. Whilst either the system or the users
+// circuit breaker is tripped the child order is deferred rather than claimed, pausing the schedule until it's reset.
+func executeScheduledChildOrder(
+	ctx context.Context,
+	schedule *domain.ExecutionSchedule,
+	childOrder *domain.ScheduledChildOrder,
+	credentials *tradeengineproto.VenueCredentials,
+) (*tradeengineproto.Order, error) {
+	errParams := map[string]string{
+		"execution_schedule_id": schedule.ExecutionScheduleID,
+		"child_order_id":        childOrder.ChildOrderID,
+		"sequence_number":       fmt.Sprintf("%d", childOrder.SequenceNumber),
+	}
+
+	// Not every trip cancels schedules, i.e those on drawdown or consecutive venue rejections; so we check before every
+	// child order, not only when the schedule is created.
+	if err := CheckCircuitBreakers(ctx, schedule.UserID); err != nil {
+		if _, derr := deferChildOrder(ctx, childOrder.ChildOrderID, schedulerNow().UTC().Add(childOrderPausedBackoff)); derr != nil {
+			slog.Error(ctx, "Failed to defer child order: %s, Error: %v", childOrder.ChildOrderID, derr)
+		}
+
+		return nil, gerrors.Augment(err, "failed_to_execute_child_order.paused", errParams)
+	}
+
+	claimed, err := claimChildOrder(ctx, childOrder.ChildOrderID)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_child_order.claim", errParams)
+	}
+	if !claimed {
+		return nil, nil
+	}
+	childOrder.Attempts++
+
+	venue := tradeengineproto.VENUE(tradeengineproto.VENUE_value[schedule.Venue])
+	instrumentType := tradeengineproto.INSTRUMENT_TYPE(tradeengineproto.INSTRUMENT_TYPE_value[schedule.InstrumentType])
+
+	if credentials == nil {
+		credentials, err = readScheduledVenueCredentials(ctx, schedule.UserID, venue)
+		if err != nil {
+			return nil, failChildOrder(ctx, schedule, childOrder, gerrors.Augment(err, "failed_to_execute_child_order", errParams))
+		}
+	}
+
+	order := childOrderToOrder(schedule, childOrder)
+
+	successfulOrder, err := executeAndTrackOrder(ctx, schedule.TradeStrategyID, schedule.UserID, order, venue, instrumentType, credentials)
+	if err != nil {
+		return nil, failChildOrder(ctx, schedule, childOrder, gerrors.Augment(err, "failed_to_execute_child_order", errParams))
+	}
+
+	childOrder.Status = domain.ChildOrderStatusExecuted
+	childOrder.ExternalOrderID = successfulOrder.ExternalOrderId
+	if err := updateChildOrder(ctx, childOrder); err != nil {
+		// The order has been placed; so we only log here, the schedule will be reconciled by the next tick.
+		slog.Critical(ctx, "Failed to mark child order as executed: %s, Error: %v", childOrder.ChildOrderID, err)
+	}
+
+	if err := completeExecutionScheduleIfDone(ctx, schedule.ExecutionScheduleID); err != nil {
+		slog.Error(ctx, "Failed to complete execution schedule: %s, Error: %v", schedule.ExecutionScheduleID, err)
+	}
+
+	slog.Info(ctx, "Child order placed: %s [%d/%d] %s", schedule.ExecutionScheduleID, childOrder.SequenceNumber+1, schedule.NumberOfChildOrders, successfulOrder.ExternalOrderId)
+
+	return successfulOrder, nil
+}
+
+// childOrderToOrder builds the market order to route for the given child order.
+func childOrderToOrder(schedule *domain.ExecutionSchedule, childOrder *domain.ScheduledChildOrder) *tradeengineproto.Order {
+	return &tradeengineproto.Order{
+		ActorId:          tradeengineproto.TradeEngineActorSatoshiSystem,
+		Instrument:       schedule.Instrument,
+		Asset:            schedule.Asset,
+		Pair:             tradeengineproto.TRADE_PAIR(tradeengineproto.TRADE_PAIR_value[schedule.Pair]),
+		InstrumentType:   tradeengineproto.INSTRUMENT_TYPE(tradeengineproto.INSTRUMENT_TYPE_value[schedule.InstrumentType]),
+		OrderType:        tradeengineproto.ORDER_TYPE_MARKET,
+		TradeSide:        tradeengineproto.TRADE_SIDE(tradeengineproto.TRADE_SIDE_value[schedule.TradeSide]),
+		Quantity:         float32(childOrder.Quantity),
+		WorkingType:      tradeengineproto.WORKING_TYPE_MARK_PRICE,
+		Venue:            tradeengineproto.VENUE(tradeengineproto.VENUE_value[schedule.Venue]),
+		CreatedTimestamp: schedulerNow().UTC().Unix(),
+	}
+}
+
+// failChildOrder either reschedules the child order or marks it as failed if we've exhausted all attempts.
+func failChildOrder(ctx context.Context, schedule *domain.ExecutionSchedule, childOrder *domain.ScheduledChildOrder, executionErr error) error {
+	switch {
+	case childOrder.Attempts < maxChildOrderAttempts:
+		childOrder.Status = domain.ChildOrderStatusPending
+		childOrder.ScheduledFor = schedulerNow().UTC().Add(childOrderRetryBackoff)
+	default:
+		childOrder.Status = domain.ChildOrderStatusFailed
+
+		// Best effort.
+		msg := fmt.Sprintf("[%s] child order %d/%d failed after %d attempts: %v", schedule.ExecutionStrategy, childOrder.SequenceNumber+1, schedule.NumberOfChildOrders, childOrder.Attempts, executionErr)
+		if err := notifyUser(ctx, msg, schedule.UserID); err != nil {
+			slog.Error(ctx, "Failed to notifiy user: %v", err)
+		}
+	}
+	childOrder.FailureReason = executionErr.Error()
+
+	if err := updateChildOrder(ctx, childOrder); err != nil {
+		slog.Error(ctx, "Failed to update failed child order: %s, Error: %v", childOrder.ChildOrderID, err)
+	}
+
+	if err := completeExecutionScheduleIfDone(ctx, schedule.ExecutionScheduleID); err != nil {
+		slog.Error(ctx, "Failed to complete execution schedule: %s, Error: %v", schedule.ExecutionScheduleID, err)
+	}
+
+	return executionErr
+}
+
+// failScheduleAndFlatten fails the schedule, cancelling its pending child orders so nothing more is placed, then rolls back the
+// given placed orders so the participant is left flat; the outcome of the rollback is recorded on the execution error.
+func failScheduleAndFlatten(
+	ctx context.Context,
+	schedule *domain.ExecutionSchedule,
+	placedOrders []*tradeengineproto.Order,
+	venue tradeengineproto.VENUE,
+	instrumentType tradeengineproto.INSTRUMENT_TYPE,
+	credentials *tradeengineproto.VenueCredentials,
+	executionErr *tradeengineproto.ExecutionError,
+) {
+	if _, err := dao.CancelActiveExecutionSchedules(ctx, schedule.TradeStrategyID, schedule.UserID); err != nil {
+		slog.Error(ctx, "Failed to cancel pending child orders of execution schedule: %s, Error: %v", schedule.ExecutionScheduleID, err)
+	}
+
+	if err := dao.UpdateExecutionScheduleStatus(ctx, schedule.ExecutionScheduleID, domain.ExecutionScheduleStatusFailed); err != nil {
+		slog.Error(ctx, "Failed to mark execution schedule as failed: %s, Error: %v", schedule.ExecutionScheduleID, err)
+	}
+	schedule.Status = domain.ExecutionScheduleStatusFailed
+
+	rollbackExecution(ctx, schedule.TradeStrategyID, schedule.UserID, placedOrders, venue, instrumentType, credentials, executionErr)
+}
+
+// calculateChildOrders slices the total quantity across the horizon, proportional to the given weights. The first child
+// order is scheduled at `start`, each subsequent order is evenly spaced. Quantities are rounded down onto the lot size of
+// the instrument; the last child order takes the remainder, so the sum of all child orders is always equal to the total
+// quantity. A child order below the minimum quantity of the instrument is folded into the next, or the previous if it's
+// the last; returns nil if no child order reaches the minimum quantity.
+func calculateChildOrders(totalQuantity float64, weights []float64, start time.Time, horizon time.Duration, filters *instrumentFilters) []*ChildOrderDetail {
+	if len(weights) == 0 {
+		return nil
+	}
+
+	var totalWeight float64
+	for _, w := range weights {
+		totalWeight += w
+	}
+	if totalWeight <= 0 {
+		return nil
+	}
+
+	// We work in whole lots to avoid any floating point drift; the lots of the child orders must sum to the total.
+	lotSize := filters.LotSize
+	if lotSize <= 0 {
+		lotSize = minimumUnfilteredQuantity
+	}
+
+	totalLots := math.Floor(totalQuantity/lotSize + lotTolerance)
+	if totalLots <= 0 {
+		return nil
+	}
+
+	lots := make([]float64, len(weights))
+	var allocatedLots float64
+	for i := 0; i < len(weights)-1; i++ {
+		lots[i] = math.Floor(totalLots*weights[i]/totalWeight + lotTolerance)
+		allocatedLots += lots[i]
+	}
+	lots[len(weights)-1] = totalLots - allocatedLots
+
+	// Fold any child order below the minimum quantity into its neighbour; it's executed in the neighbour's slot.
+	var (
+		minLots     = math.Ceil(filters.MinQuantity/lotSize - lotTolerance)
+		childOrders = make([]*ChildOrderDetail, 0, len(weights))
+		interval    = horizon / time.Duration(len(weights))
+		carried     float64
+	)
+	for i := range weights {
+		l := lots[i] + carried
+		if l < minLots || l <= 0 {
+			carried = l
+			continue
+		}
+
+		carried = 0
+		childOrders = append(childOrders, &ChildOrderDetail{
+			SequenceNumber: len(childOrders),
+			Quantity:       l * lotSize,
+			ScheduledFor:   start.Add(time.Duration(i) * interval),
+		})
+	}
+
+	if carried > 0 {
+		if len(childOrders) == 0 {
+			return nil
+		}
+
+		last := childOrders[len(childOrders)-1]
+		last.Quantity = (math.Round(last.Quantity/lotSize) + carried) * lotSize
+	}
+
+	return childOrders
+}
+
+// parseScheduleParameters returns the number of child orders & horizon requested by the participant, applying defaults.
+func parseScheduleParameters(participant *tradeengineproto.ExecuteTradeStrategyForParticipantRequest) (int, time.Duration, error) {
+	numberOfChildOrders := int(participant.NumberOfChildOrders)
+	if numberOfChildOrders == 0 {
+		numberOfChildOrders = defaultNumberOfChildOrders
+	}
+
+	horizon := time.Duration(participant.ExecutionHorizonInMinutes) * time.Minute
+	if horizon == 0 {
+		horizon = defaultExecutionHorizon
+	}
+
+	switch {
+	case numberOfChildOrders < minNumberOfChildOrders, numberOfChildOrders > maxNumberOfChildOrders:
+		return 0, 0, gerrors.BadParam("bad_param.number_of_child_orders_out_of_bounds", map[string]string{
+			"number_of_child_orders": fmt.Sprintf("%d", numberOfChildOrders),
+			"min":                    fmt.Sprintf("%d", minNumberOfChildOrders),
+			"max":                    fmt.Sprintf("%d", maxNumberOfChildOrders),
+		})
+	case horizon < 0, participant.ExecutionHorizonInMinutes > maxExecutionHorizonInMinutes:
+		return 0, 0, gerrors.BadParam("bad_param.execution_horizon_out_of_bounds", map[string]string{
+			"execution_horizon_in_minutes": fmt.Sprintf("%d", participant.ExecutionHorizonInMinutes),
+			"max":                          fmt.Sprintf("%d", maxExecutionHorizonInMinutes),
+		})
+	}
+
+	return numberOfChildOrders, horizon, nil
+}
+
+// weightsFunc returns the relative size of each child order for a scheduled execution strategy.
+type weightsFunc func(ctx context.Context, strategy *tradeengineproto.TradeStrategy, numberOfChildOrders int, start time.Time, horizon time.Duration) ([]float64, error)
+
+// executeScheduledStrategy places the stop loss & take profits immediately, then slices the entry into child orders
+// that are executed by the scheduler over the requested horizon. The schedule is persisted before anything is placed.
+func executeScheduledStrategy(
+	ctx context.Context,
+	strategy *tradeengineproto.TradeStrategy,
+	participant *tradeengineproto.ExecuteTradeStrategyForParticipantRequest,
+	weights weightsFunc,
+) (*tradeengineproto.ExecuteTradeStrategyForParticipantResponse, error) {
+	executionStrategy := strings.ToLower(strategy.ExecutionStrategy.String())
+
+	// Validation.
+	switch {
+	case len(strategy.Entries) == 0:
+		return nil, gerrors.FailedPrecondition(fmt.Sprintf("%s_trade_strategy_invalid.zero_entries", executionStrategy), nil)
+	case participant.Venue == tradeengineproto.VENUE_UNREQUIRED:
+		return nil, gerrors.FailedPrecondition(fmt.Sprintf("%s_trade_strategy_invalid.venue_required", executionStrategy), nil)
+	case participant.Risk == 0:
+		return nil, gerrors.FailedPrecondition(fmt.Sprintf("%s_trade_strategy_invalid.participant_nil_risk", executionStrategy), nil)
+	}
+
+	numberOfChildOrders, horizon, err := parseScheduleParameters(participant)
+	if err != nil {
+		return nil, gerrors.Augment(err, fmt.Sprintf("failed_to_execute_%s_strategy", executionStrategy), nil)
+	}
+
+	// Fetch venue specific credentials.
+	venueCredentials, err := readScheduledVenueCredentials(ctx, participant.UserId, participant.Venue)
+	if err != nil {
+		return nil, gerrors.Augment(err, fmt.Sprintf("failed_to_execute_%s_strategy", executionStrategy), nil)
+	}
+
+	// Read account balance.
+	venueAccountBalance, err := readScheduledVenueAccountBalance(ctx, participant.UserId, participant.Venue, strategy, venueCredentials)
+	if err != nil {
+		return nil, gerrors.Augment(err, fmt.Sprintf("failed_to_execute_%s_strategy", executionStrategy), nil)
+	}
+
+	// Calculate total quantity; we size against the average entry since the fills are spread over the horizon.
+	var averageEntry float64
+	for _, e := range strategy.Entries {
+		averageEntry += float64(e)
+	}
+	averageEntry = averageEntry / float64(len(strategy.Entries))
+
+	riskCoefficient := risk.CalculateRiskCoefficient(averageEntry, float64(strategy.StopLoss))
+	totalQuantity := riskCoefficient * float64(venueAccountBalance) * float64(participant.Risk)
+
+	// Round the position onto the lot size of the instrument; so the take profits & stop close exactly what's opened.
+	filters, err := fetchInstrumentFilters(ctx, strategy, participant.Venue)
+	if err != nil {
+		return nil, gerrors.Augment(err, fmt.Sprintf("failed_to_execute_%s_strategy", executionStrategy), nil)
+	}
+	totalQuantity = filters.roundQuantity(totalQuantity)
+
+	// Calculate take profits before anything is persisted; a position too small to take profit on shouldn't be scheduled.
+	tps, err := calculateTakeProfits(totalQuantity, strategy.TakeProfits, resolveTakeProfitAllocations(ctx, strategy, participant.UserId), filters)
+	if err != nil {
+		return nil, gerrors.Augment(err, fmt.Sprintf("failed_to_execute_%s_strategy", executionStrategy), nil)
+	}
+
+	// Validate order against the participants risk profile.
+	if err := enforceRiskProfile(ctx, strategy, participant, venueAccountBalance, totalQuantity, averageEntry); err != nil {
+		return nil, gerrors.Augment(err, fmt.Sprintf("failed_to_execute_%s_strategy", executionStrategy), map[string]string{
+			"total_quantity": fmt.Sprintf("%f", totalQuantity),
+			"venue_balance":  fmt.Sprintf("%f", venueAccountBalance),
+		})
+	}
+
+	now := schedulerNow().UTC()
+
+	errParams := map[string]string{
+		"created_timestamp":      now.String(),
+		"with_stop_loss":         strconv.FormatBool(strategy.StopLoss != 0),
+		"risk":                   fmt.Sprintf("%.02f", participant.Risk),
+		"user_id":                participant.UserId,
+		"asset":                  strategy.Asset,
+		"pair":                   strategy.Pair.String(),
+		"instrument":             strategy.Instrument,
+		"venue":                  participant.Venue.String(),
+		"total_size":             fmt.Sprintf("%f", totalQuantity),
+		"number_of_child_orders": strconv.Itoa(numberOfChildOrders),
+		"horizon":                horizon.String(),
+	}
+
+	// Calculate child orders.
+	ws, err := weights(ctx, strategy, numberOfChildOrders, now, horizon)
+	if err != nil {
+		return nil, gerrors.Augment(err, fmt.Sprintf("failed_to_execute_%s_strategy.weights", executionStrategy), errParams)
+	}
+
+	childOrderDetails := calculateChildOrders(totalQuantity, ws, now, horizon, filters)
+	if len(childOrderDetails) == 0 {
+		return nil, gerrors.FailedPrecondition(fmt.Sprintf("failed_to_execute_%s_strategy.no_child_orders", executionStrategy), errParams)
+	}
+
+	childOrders := make([]*domain.ScheduledChildOrder, 0, len(childOrderDetails))
+	for _, cod := range childOrderDetails {
+		childOrders = append(childOrders, &domain.ScheduledChildOrder{
+			SequenceNumber: cod.SequenceNumber,
+			Quantity:       cod.Quantity,
+			ScheduledFor:   cod.ScheduledFor,
+			Status:         domain.ChildOrderStatusPending,
+		})
+	}
+
+	// Persist the schedule first; this guarantees we can resume after a restart & that a participant can't
+	// run the same schedule twice.
+	schedule, err := createExecutionSchedule(ctx, &domain.ExecutionSchedule{
+		TradeStrategyID:     strategy.TradeStrategyId,
+		UserID:              participant.UserId,
+		ExecutionStrategy:   strategy.ExecutionStrategy.String(),
+		Venue:               participant.Venue.String(),
+		Instrument:          strategy.Instrument,
+		InstrumentType:      strategy.InstrumentType.String(),
+		Asset:               strategy.Asset,
+		Pair:                strategy.Pair.String(),
+		TradeSide:           strategy.TradeSide.String(),
+		TotalQuantity:       totalQuantity,
+		NumberOfChildOrders: len(childOrders),
+		Status:              domain.ExecutionScheduleStatusActive,
+		Start:               now,
+		End:                 now.Add(horizon),
+	}, childOrders)
+	if err != nil {
+		return nil, gerrors.Augment(err, fmt.Sprintf("failed_to_execute_%s_strategy.persist_schedule", executionStrategy), errParams)
+	}
+
+	// Determine position exit trade side.
+	var exitTradeSide tradeengineproto.TRADE_SIDE
+	switch strategy.TradeSide {
+	case tradeengineproto.TRADE_SIDE_BUY, tradeengineproto.TRADE_SIDE_LONG:
+		exitTradeSide = tradeengineproto.TRADE_SIDE_SELL
+	default:
+		exitTradeSide = tradeengineproto.TRADE_SIDE_BUY
+	}
+
+	var (
+		successfulOrders []*tradeengineproto.Order
+		executionErr     *tradeengineproto.ExecutionError
+	)
+
+	// Add stop loss order; this is placed first & for the full quantity since it's reduce only.
+	switch {
+	case strategy.StopLoss == 0 && strategy.InstrumentType == tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL:
+		slog.Warn(ctx, "Participant executing trade strategy without a stop loss: %s, %s", strategy.TradeStrategyId, participant.UserId)
+
+		// Warn user of **not** using a stop loss. Best effort.
+		if err := notifyUser(ctx, fmt.Sprintf("[%s] participant placing without a stop loss", strategy.ExecutionStrategy), participant.UserId); err != nil {
+			slog.Error(ctx, "Failed to notifiy user: %v", err)
+		}
+	default:
+		stopLoss := &tradeengineproto.Order{
+			ActorId:          tradeengineproto.TradeEngineActorSatoshiSystem,
+			Instrument:       strategy.Instrument,
+			Asset:            strategy.Asset,
+			Pair:             strategy.Pair,
+			InstrumentType:   strategy.InstrumentType,
+			OrderType:        tradeengineproto.ORDER_TYPE_STOP_MARKET,
+			TradeSide:        exitTradeSide,
+			StopPrice:        strategy.StopLoss,
+			Quantity:         float32(totalQuantity),
+			ReduceOnly:       true,
+			WorkingType:      tradeengineproto.WORKING_TYPE_MARK_PRICE,
+			Venue:            participant.Venue,
+			CreatedTimestamp: now.Unix(),
+		}
+
+		successfulOrders, executionErr = executeOrdersAtomically(ctx, strategy.TradeStrategyId, participant.UserId, []*tradeengineproto.Order{stopLoss}, participant.Venue, strategy.InstrumentType, venueCredentials)
+		if executionErr != nil {
+			// Without a stop we don't want to build a position; so we fail the schedule before any child order is placed.
+			slog.Error(ctx, "Failed to place stop loss for scheduled execution: %v", executionErr.ErrorMessage, errParams)
+
+			if err := dao.UpdateExecutionScheduleStatus(ctx, schedule.ExecutionScheduleID, domain.ExecutionScheduleStatusFailed); err != nil {
+				slog.Error(ctx, "Failed to mark execution schedule as failed: %s, Error: %v", schedule.ExecutionScheduleID, err)
+			}
+
+			schedule.Status = domain.ExecutionScheduleStatusFailed
+			return buildScheduledExecutionResponse(ctx, strategy, participant, schedule, totalQuantity, successfulOrders, executionErr), nil
+		}
+	}
+
+	// Execute the first child order immediately; the rest are left to the scheduler. We read the child orders
+	// back out since we need their persisted identifiers.
+	persistedChildOrders, err := listChildOrders(ctx, schedule.ExecutionScheduleID)
+	switch {
+	case err != nil:
+		slog.Error(ctx, "Failed to read persisted child orders; first child order will be executed by the scheduler: %v", err, errParams)
+	case len(persistedChildOrders) > 0:
+		childOrder, err := executeScheduledChildOrder(ctx, schedule, persistedChildOrders[0], venueCredentials)
+		switch {
+		case err != nil:
+			slog.Error(ctx, "Failed to execute first child order; it will be retried by the scheduler: %v", err, errParams)
+		case childOrder != nil:
+			successfulOrders = append(successfulOrders, childOrder)
+		}
+	}
+
+	// Add take profits.
+	var takeProfits []*tradeengineproto.Order
+	for _, tp := range tps {
+		takeProfits = append(takeProfits, &tradeengineproto.Order{
+			ActorId:          tradeengineproto.TradeEngineActorSatoshiSystem,
+			Instrument:       strategy.Instrument,
+			Asset:            strategy.Asset,
+			Pair:             strategy.Pair,
+			InstrumentType:   strategy.InstrumentType,
+			OrderType:        tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET,
+			TradeSide:        exitTradeSide,
+			StopPrice:        float32(tp.StopPrice),
+			Quantity:         float32(tp.Quantity),
+			WorkingType:      tradeengineproto.WORKING_TYPE_MARK_PRICE,
+			Venue:            participant.Venue,
+			ReduceOnly:       true,
+			CreatedTimestamp: now.Unix(),
+		})
+	}
+
+	successfulTakeProfits, executionErr := executeOrdersAtomically(ctx, strategy.TradeStrategyId, participant.UserId, takeProfits, participant.Venue, strategy.InstrumentType, venueCredentials)
+	if executionErr != nil {
+		slog.Error(ctx, "Failed to execute given order: %+v, Error: %v", executionErr.FailedOrder, executionErr.ErrorMessage, errParams)
+
+		// The take profits have already been rolled back; rather than leave a position with only part of its bracket, we
+		// fail the schedule & roll back the stop loss along with anything the first child order filled.
+		failScheduleAndFlatten(ctx, schedule, successfulOrders, participant.Venue, strategy.InstrumentType, venueCredentials, executionErr)
+		return buildScheduledExecutionResponse(ctx, strategy, participant, schedule, totalQuantity, nil, executionErr), nil
+	}
+	successfulOrders = append(successfulOrders, successfulTakeProfits...)
+
+	slog.Info(ctx, "Successfully scheduled trade strategy: %s for user: %s, risk: %v, total quantity: %v, child orders: %d", strategy.TradeStrategyId, participant.UserId, participant.Risk, totalQuantity, len(childOrders))
+
+	return buildScheduledExecutionResponse(ctx, strategy, participant, schedule, totalQuantity, successfulOrders, executionErr), nil
+}
+
+func buildScheduledExecutionResponse(
+	ctx context.Context,
+	strategy *tradeengineproto.TradeStrategy,
+	participant *tradeengineproto.ExecuteTradeStrategyForParticipantRequest,
+	schedule *domain.ExecutionSchedule,
+	totalQuantity float64,
+	successfulOrders []*tradeengineproto.Order,
+	executionErr *tradeengineproto.ExecutionError,
+) *tradeengineproto.ExecuteTradeStrategyForParticipantResponse {
+	// Read the child orders back out to report progress; best effort.
+	childOrders, err := listChildOrders(ctx, schedule.ExecutionScheduleID)
+	if err != nil {
+		slog.Error(ctx, "Failed to list child orders for execution schedule: %s, Error: %v", schedule.ExecutionScheduleID, err)
+	}
+
+	return &tradeengineproto.ExecuteTradeStrategyForParticipantResponse{
+		NotionalSizeIsUsd:      float32(totalQuantity),
+		NumberOfExecutedOrders: int64(len(successfulOrders)),
+		ExecutionStrategy:      strategy.ExecutionStrategy,
+		SuccessfulOrders:       successfulOrders,
+		Error:                  executionErr,
+		Timestamp:              timestamppb.Now(),
+		Venue:                  participant.Venue,
+		Asset:                  strategy.Asset,
+		Pair:                   strategy.Pair,
+		TradeParticipantId:     participant.UserId,
+		Instrument:             strategy.Instrument,
+		InstrumentType:         strategy.InstrumentType,
+		ExecutionSchedule:      marshaling.ExecutionScheduleDomainToProto(schedule, childOrders),
+	}
+}
@@ -0,0 +1,185 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func TestCalculateChildOrders(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                   string
+		totalQuantity          float64
+		weights                []float64
+		horizon                time.Duration
+		filters                instrumentFilters
+		expectedQuantities     []float64
+		expectedScheduledTimes []time.Time
+	}{
+		{
+			name:                   "equal_weights",
+			totalQuantity:          100,
+			weights:                []float64{1, 1, 1, 1},
+			horizon:                time.Hour,
+			expectedQuantities:     []float64{25, 25, 25, 25},
+			expectedScheduledTimes: []time.Time{start, start.Add(15 * time.Minute), start.Add(30 * time.Minute), start.Add(45 * time.Minute)},
+		},
+		{
+			name:                   "unequal_weights",
+			totalQuantity:          100,
+			weights:                []float64{1, 3},
+			horizon:                10 * time.Minute,
+			expectedQuantities:     []float64{25, 75},
+			expectedScheduledTimes: []time.Time{start, start.Add(5 * time.Minute)},
+		},
+		{
+			name:                   "rounded_onto_lot_size",
+			totalQuantity:          1,
+			weights:                []float64{1, 1, 1},
+			horizon:                time.Hour,
+			filters:                instrumentFilters{LotSize: 0.001},
+			expectedQuantities:     []float64{0.333, 0.333, 0.334},
+			expectedScheduledTimes: []time.Time{start, start.Add(20 * time.Minute), start.Add(40 * time.Minute)},
+		},
+		{
+			name:                   "below_minimum_quantity_folded_into_next",
+			totalQuantity:          1,
+			weights:                []float64{1, 4, 5},
+			horizon:                time.Hour,
+			filters:                instrumentFilters{LotSize: 0.01, MinQuantity: 0.2},
+			expectedQuantities:     []float64{0.5, 0.5},
+			expectedScheduledTimes: []time.Time{start.Add(20 * time.Minute), start.Add(40 * time.Minute)},
+		},
+		{
+			name:                   "last_below_minimum_quantity_folded_into_previous",
+			totalQuantity:          1,
+			weights:                []float64{5, 4, 1},
+			horizon:                time.Hour,
+			filters:                instrumentFilters{LotSize: 0.01, MinQuantity: 0.2},
+			expectedQuantities:     []float64{0.5, 0.5},
+			expectedScheduledTimes: []time.Time{start, start.Add(20 * time.Minute)},
+		},
+		{
+			name:                   "below_minimum_quantity",
+			totalQuantity:          0.1,
+			weights:                []float64{1, 1},
+			horizon:                time.Hour,
+			filters:                instrumentFilters{LotSize: 0.01, MinQuantity: 0.2},
+			expectedQuantities:     nil,
+			expectedScheduledTimes: nil,
+		},
+		{
+			name:                   "no_weights",
+			totalQuantity:          100,
+			weights:                nil,
+			horizon:                time.Hour,
+			expectedQuantities:     nil,
+			expectedScheduledTimes: nil,
+		},
+		{
+			name:                   "zero_weights",
+			totalQuantity:          100,
+			weights:                []float64{0, 0},
+			horizon:                time.Hour,
+			expectedQuantities:     nil,
+			expectedScheduledTimes: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			childOrders := calculateChildOrders(tt.totalQuantity, tt.weights, start, tt.horizon, &tt.filters)
+			require.Len(t, childOrders, len(tt.expectedQuantities))
+
+			for i, co := range childOrders {
+				assert.Equal(t, i, co.SequenceNumber)
+				assert.InDelta(t, tt.expectedQuantities[i], co.Quantity, 1e-9)
+				assert.Equal(t, tt.expectedScheduledTimes[i], co.ScheduledFor)
+			}
+		})
+	}
+}
+
+func TestCalculateChildOrders_SumsToTotalQuantity(t *testing.T) {
+	t.Parallel()
+
+	totalQuantity := 1.0
+	childOrders := calculateChildOrders(totalQuantity, []float64{1, 1, 1, 1, 1, 1, 1}, time.Now(), time.Hour, &instrumentFilters{LotSize: 0.001})
+
+	var sum float64
+	for _, co := range childOrders {
+		sum += co.Quantity
+	}
+
+	assert.InDelta(t, totalQuantity, sum, 1e-9)
+}
+
+func TestParseScheduleParameters(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                        string
+		participant                 *tradeengineproto.ExecuteTradeStrategyForParticipantRequest
+		expectedNumberOfChildOrders int
+		expectedHorizon             time.Duration
+		withErr                     bool
+	}{
+		{
+			name:                        "defaults",
+			participant:                 &tradeengineproto.ExecuteTradeStrategyForParticipantRequest{},
+			expectedNumberOfChildOrders: defaultNumberOfChildOrders,
+			expectedHorizon:             defaultExecutionHorizon,
+		},
+		{
+			name: "custom",
+			participant: &tradeengineproto.ExecuteTradeStrategyForParticipantRequest{
+				NumberOfChildOrders:       4,
+				ExecutionHorizonInMinutes: 20,
+			},
+			expectedNumberOfChildOrders: 4,
+			expectedHorizon:             20 * time.Minute,
+		},
+		{
+			name: "too_few_child_orders",
+			participant: &tradeengineproto.ExecuteTradeStrategyForParticipantRequest{
+				NumberOfChildOrders: 1,
+			},
+			withErr: true,
+		},
+		{
+			name: "horizon_too_long",
+			participant: &tradeengineproto.ExecuteTradeStrategyForParticipantRequest{
+				ExecutionHorizonInMinutes: maxExecutionHorizonInMinutes + 1,
+			},
+			withErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			numberOfChildOrders, horizon, err := parseScheduleParameters(tt.participant)
+			if tt.withErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNumberOfChildOrders, numberOfChildOrders)
+			assert.Equal(t, tt.expectedHorizon, horizon)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/domain"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+var (
+	// readTradeStrategy is a package variable so the trade strategy can be faked in tests.
+	readTradeStrategy = dao.ReadTradeStrategyByTradeStrategyID
+
+	// resizeParticipantTakeProfits is a package variable so take profit resizing can be faked in tests.
+	resizeParticipantTakeProfits = resizeTakeProfits
+)
+
+// resizeTakeProfits replaces the resting take profits of the participant with take profits sized from the quantity their
+// entries have actually executed. Take profits are placed alongside the entries, sized from the planned quantity; if the
+// entries execute less than planned the earlier take profits would close the whole position, so the later take profits are
+// never reached. Once any exit has executed the take profits are left as they are.
+//
+// The replacements are placed before the previous take profits are cancelled; if the replacements can't be placed the
+// previous take profits are left untouched.
+func resizeTakeProfits(ctx context.Context, tradeStrategyID, userID string, credentials *tradeengineproto.VenueCredentials) error {
+	errParams := map[string]string{
+		"trade_strategy_id": tradeStrategyID,
+		"user_id":           userID,
+	}
+
+	orders, err := listParticipantOrders(ctx, tradeStrategyID, userID)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_resize_take_profits.list_orders", errParams)
+	}
+
+	var (
+		executedQuantity float64
+		takeProfits      []*domain.Order
+	)
+	for _, order := range orders {
+		switch {
+		case !order.ReduceOnly:
+			executedQuantity += order.ExecutedQuantity
+		case order.ExecutedQuantity > 0:
+			// The position is already being exited.
+			return nil
+		case isTakeProfit(order) && isResting(order):
+			takeProfits = append(takeProfits, order)
+		}
+	}
+
+	if executedQuantity == 0 || len(takeProfits) == 0 {
+		return nil
+	}
+
+	tradeStrategy, err := readTradeStrategy(ctx, tradeStrategyID)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_resize_take_profits.read_trade_strategy", errParams)
+	}
+	strategy := marshaling.TradeStrategyDomainToProto(tradeStrategy)
+
+	var (
+		venue          = tradeengineproto.VENUE(tradeengineproto.VENUE_value[takeProfits[0].Venue])
+		instrumentType = tradeengineproto.INSTRUMENT_TYPE(tradeengineproto.INSTRUMENT_TYPE_value[takeProfits[0].InstrumentType])
+	)
+
+	filters, err := fetchInstrumentFilters(ctx, strategy, venue)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_resize_take_profits.instrument_filters", errParams)
+	}
+
+	tps, err := calculateTakeProfits(filters.roundQuantity(executedQuantity), strategy.TakeProfits, resolveTakeProfitAllocations(ctx, strategy, userID), filters)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_resize_take_profits", errParams)
+	}
+
+	if takeProfitsMatch(takeProfits, tps, filters) {
+		return nil
+	}
+
+	replacements := make([]*tradeengineproto.Order, 0, len(tps))
+	for _, tp := range tps {
+		replacements = append(replacements, replacementTakeProfit(takeProfits[0], tp))
+	}
+
+	// Place the replacements first.
+	if _, executionErr := executeOrdersAtomically(ctx, tradeStrategyID, userID, replacements, venue, instrumentType, credentials); executionErr != nil {
+		return gerrors.FailedPrecondition("failed_to_resize_take_profits.place_replacements", map[string]string{
+			"trade_strategy_id": tradeStrategyID,
+			"user_id":           userID,
+			"error":             executionErr.ErrorMessage,
+		})
+	}
+
+	// Then cancel the previous take profits.
+	var failed int
+	for _, order := range takeProfits {
+		if err := cancelOrder(ctx, order, credentials); err != nil {
+			slog.Critical(ctx, "Failed to cancel previous take profit after placing replacements: %s, Error: %v", order.OrderID, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		// Best effort; the participant must cancel whatever we couldn't.
+		msg := fmt.Sprintf("[%s] Your take profits were resized to your filled entries, but %d previous take profit(s) couldn't be cancelled; please check your open orders on %s", tradeStrategyID, failed, venue)
+		if err := notifyUser(ctx, msg, userID); err != nil {
+			slog.Error(ctx, "Failed to notify user: %v", err)
+		}
+
+		return gerrors.FailedPrecondition("failed_to_resize_take_profits.cancel_previous", map[string]string{
+			"trade_strategy_id": tradeStrategyID,
+			"user_id":           userID,
+			"failed_orders":     fmt.Sprintf("%d", failed),
+		})
+	}
+
+	slog.Info(ctx, "Resized take profits to executed quantity: %s %s, executed quantity: %f", tradeStrategyID, userID, executedQuantity)
+
+	return nil
+}
+
+// takeProfitsMatch returns true if the resting take profits are already those calculated; quantities are compared to
+// within half a lot.
+func takeProfitsMatch(resting []*domain.Order, tps []*TakeProfitDetail, filters *instrumentFilters) bool {
+	if len(resting) != len(tps) {
+		return false
+	}
+
+	lotSize := filters.LotSize
+	if lotSize <= 0 {
+		lotSize = minimumUnfilteredQuantity
+	}
+
+	matched := make([]bool, len(resting))
+	for _, tp := range tps {
+		var found bool
+		for i, order := range resting {
+			if matched[i] || float32(order.StopPrice) != float32(tp.StopPrice) || math.Abs(order.Quantity-tp.Quantity) > lotSize/2 {
+				continue
+			}
+
+			matched[i], found = true, true
+			break
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// replacementTakeProfit builds a new take profit market order identical to the given take profit, other than the stop
+// price & quantity.
+func replacementTakeProfit(order *domain.Order, tp *TakeProfitDetail) *tradeengineproto.Order {
+	return &tradeengineproto.Order{
+		ActorId:          tradeengineproto.TradeEngineActorSatoshiSystem,
+		Instrument:       order.Instrument,
+		Asset:            order.Asset,
+		Pair:             tradeengineproto.TRADE_PAIR(tradeengineproto.TRADE_PAIR_value[order.Pair]),
+		InstrumentType:   tradeengineproto.INSTRUMENT_TYPE(tradeengineproto.INSTRUMENT_TYPE_value[order.InstrumentType]),
+		OrderType:        tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET,
+		TradeSide:        tradeengineproto.TRADE_SIDE(tradeengineproto.TRADE_SIDE_value[order.TradeSide]),
+		StopPrice:        float32(tp.StopPrice),
+		Quantity:         float32(tp.Quantity),
+		ReduceOnly:       true,
+		WorkingType:      tradeengineproto.WORKING_TYPE_MARK_PRICE,
+		Venue:            tradeengineproto.VENUE(tradeengineproto.VENUE_value[order.Venue]),
+		CreatedTimestamp: time.Now().UTC().Unix(),
+	}
+}
+
+func isTakeProfit(order *domain.Order) bool {
+	switch order.OrderType {
+	case tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET.String(), tradeengineproto.ORDER_TYPE_TAKE_PROFIT_LIMIT.String():
+		return true
+	default:
+		return false
+	}
+}
+
+func isResting(order *domain.Order) bool {
+	switch order.Status {
+	case domain.OrderStatusNew, domain.OrderStatusPartiallyFilled:
+		return true
+	default:
+		return false
+	}
+}
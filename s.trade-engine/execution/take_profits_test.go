@@ -0,0 +1,114 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func withFakeTradeStrategy(t *testing.T, filters *instrumentFilters) {
+	originalRead, originalFetch := readTradeStrategy, fetchInstrumentFilters
+	t.Cleanup(func() {
+		readTradeStrategy, fetchInstrumentFilters = originalRead, originalFetch
+	})
+
+	readTradeStrategy = func(ctx context.Context, tradeStrategyID string) (*domain.TradeStrategy, error) {
+		return &domain.TradeStrategy{
+			TradeStrategyID:       tradeStrategyID,
+			InstrumentType:        tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL.String(),
+			Asset:                 "BTC",
+			Pair:                  tradeengineproto.TRADE_PAIR_USDT.String(),
+			TradeSide:             tradeengineproto.TRADE_SIDE_BUY.String(),
+			Entries:               []float64{100, 96},
+			StopLoss:              90,
+			TakeProfits:           []float64{110, 120},
+			TakeProfitAllocations: []float64{50, 50},
+		}, nil
+	}
+	fetchInstrumentFilters = func(ctx context.Context, strategy *tradeengineproto.TradeStrategy, venue tradeengineproto.VENUE) (*instrumentFilters, error) {
+		return filters, nil
+	}
+}
+
+func TestResizeTakeProfits(t *testing.T) {
+	tests := []struct {
+		name               string
+		orders             func() []*domain.Order
+		expectedQuantities []float32
+		expectedCalls      []string
+	}{
+		{
+			name: "entries_partially_filled",
+			orders: func() []*domain.Order {
+				orders := testManagedOrders(domain.OrderStatusNew)
+				orders[1].ExecutedQuantity, orders[1].Status = 0, domain.OrderStatusNew
+				return orders
+			},
+			expectedQuantities: []float32{0.5, 0.5},
+			expectedCalls:      []string{"cancel:take-profit-1", "cancel:take-profit-2"},
+		},
+		{
+			name: "entry_partially_executed",
+			orders: func() []*domain.Order {
+				orders := testManagedOrders(domain.OrderStatusNew)
+				orders[1].ExecutedQuantity, orders[1].Status = 0.4, domain.OrderStatusPartiallyFilled
+				return orders
+			},
+			expectedQuantities: []float32{0.7, 0.7},
+			expectedCalls:      []string{"cancel:take-profit-1", "cancel:take-profit-2"},
+		},
+		{
+			name: "entries_filled_as_planned",
+			orders: func() []*domain.Order {
+				return testManagedOrders(domain.OrderStatusNew)
+			},
+		},
+		{
+			name: "nothing_executed",
+			orders: func() []*domain.Order {
+				orders := testManagedOrders(domain.OrderStatusNew)
+				for _, order := range orders[:2] {
+					order.ExecutedQuantity, order.Status = 0, domain.OrderStatusNew
+				}
+				return orders
+			},
+		},
+		{
+			name: "take_profit_already_filled",
+			orders: func() []*domain.Order {
+				orders := testManagedOrders(domain.OrderStatusFilled)
+				orders[1].ExecutedQuantity, orders[1].Status = 0, domain.OrderStatusNew
+				return orders
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			routed, calls := withFakePositionManager(t, tt.orders(), 105)
+			withFakeTradeStrategy(t, &instrumentFilters{TickSize: 0.1, LotSize: 0.1, MinQuantity: 0.1})
+
+			require.NoError(t, resizeTakeProfits(context.Background(), "trade-strategy-id", "user-id", &tradeengineproto.VenueCredentials{}))
+
+			var quantities []float32
+			for _, order := range *routed {
+				assert.Equal(t, tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET, order.OrderType)
+				assert.Equal(t, tradeengineproto.TRADE_SIDE_SELL, order.TradeSide)
+				assert.True(t, order.ReduceOnly)
+				quantities = append(quantities, order.Quantity)
+			}
+
+			require.Len(t, quantities, len(tt.expectedQuantities))
+			for i, quantity := range tt.expectedQuantities {
+				assert.InDelta(t, quantity, quantities[i], 1e-6)
+			}
+			assert.Equal(t, tt.expectedCalls, *calls)
+		})
+	}
+}
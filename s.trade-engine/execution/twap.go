@@ -0,0 +1,33 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func init() {
+	register(tradeengineproto.EXECUTION_STRATEGY_TWAP, &TWAP{})
+}
+
+// TWAP executes the entry as equally sized market orders, evenly spaced over the execution horizon.
+type TWAP struct{}
+
+// Execute ...
+func (t *TWAP) Execute(
+	ctx context.Context,
+	strategy *tradeengineproto.TradeStrategy,
+	participant *tradeengineproto.ExecuteTradeStrategyForParticipantRequest,
+) (*tradeengineproto.ExecuteTradeStrategyForParticipantResponse, error) {
+	return executeScheduledStrategy(ctx, strategy, participant, twapWeights)
+}
+
+func twapWeights(_ context.Context, _ *tradeengineproto.TradeStrategy, numberOfChildOrders int, _ time.Time, _ time.Duration) ([]float64, error) {
+	weights := make([]float64, 0, numberOfChildOrders)
+	for i := 0; i < numberOfChildOrders; i++ {
+		weights = append(weights, 1)
+	}
+
+	return weights, nil
+}
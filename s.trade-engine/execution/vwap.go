@@ -0,0 +1,147 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/monzo/slog"
+
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	minutesPerDay = 24 * 60
+
+	// vwapLookbackDays is the number of previous days used to build the intraday volume profile.
+	vwapLookbackDays = 5
+
+	// vwapMinWeightCoefficient floors each bucket at a fraction of the mean bucket weight; this means quiet
+	// buckets still receive a child order rather than one of zero quantity.
+	vwapMinWeightCoefficient = 0.1
+)
+
+func init() {
+	register(tradeengineproto.EXECUTION_STRATEGY_VWAP, &VWAP{})
+}
+
+// VWAP executes the entry as market orders, evenly spaced over the execution horizon, where the size of
+// each child order is proportional to the volume we expect to trade in that bucket.
+type VWAP struct{}
+
+// Execute ...
+func (v *VWAP) Execute(
+	ctx context.Context,
+	strategy *tradeengineproto.TradeStrategy,
+	participant *tradeengineproto.ExecuteTradeStrategyForParticipantRequest,
+) (*tradeengineproto.ExecuteTradeStrategyForParticipantResponse, error) {
+	return executeScheduledStrategy(ctx, strategy, participant, vwapWeights)
+}
+
+// KlineVolume is the base asset volume traded within a single 1m kline.
+type KlineVolume struct {
+	OpenTime time.Time
+	Volume   float64
+}
+
+// volumeProfile is the average volume traded per minute of the day (UTC).
+type volumeProfile struct {
+	volumes [minutesPerDay]float64
+	empty   bool
+}
+
+// buildVolumeProfile averages the given 1m klines by minute of day. Minutes we have no observations
+// for are left at zero.
+func buildVolumeProfile(klines []*KlineVolume) *volumeProfile {
+	var (
+		sums   [minutesPerDay]float64
+		counts [minutesPerDay]int
+		vp     = &volumeProfile{empty: true}
+	)
+	for _, k := range klines {
+		m := minuteOfDay(k.OpenTime)
+		sums[m] += k.Volume
+		counts[m]++
+	}
+
+	for m := 0; m < minutesPerDay; m++ {
+		if counts[m] == 0 {
+			continue
+		}
+
+		vp.volumes[m] = sums[m] / float64(counts[m])
+		if vp.volumes[m] > 0 {
+			vp.empty = false
+		}
+	}
+
+	return vp
+}
+
+// expectedVolume returns the expected volume traded in [from, to); wrapping around midnight.
+func (v *volumeProfile) expectedVolume(from, to time.Time) float64 {
+	var total float64
+	for t := from.Truncate(time.Minute); t.Before(to); t = t.Add(time.Minute) {
+		total += v.volumes[minuteOfDay(t)]
+	}
+
+	return total
+}
+
+// weights returns the expected volume of each of the `n` equally sized buckets over the horizon.
+func (v *volumeProfile) weights(n int, start time.Time, horizon time.Duration) []float64 {
+	var (
+		interval = horizon / time.Duration(n)
+		weights  = make([]float64, 0, n)
+		total    float64
+	)
+	for i := 0; i < n; i++ {
+		from := start.Add(time.Duration(i) * interval)
+		w := v.expectedVolume(from, from.Add(interval))
+		weights = append(weights, w)
+		total += w
+	}
+
+	// Floor quiet buckets.
+	floor := vwapMinWeightCoefficient * total / float64(n)
+	for i, w := range weights {
+		if w < floor {
+			weights[i] = floor
+		}
+	}
+
+	return weights
+}
+
+func vwapWeights(ctx context.Context, strategy *tradeengineproto.TradeStrategy, numberOfChildOrders int, start time.Time, horizon time.Duration) ([]float64, error) {
+	// We use binance perpetuals as our proxy for market wide liquidity, regardless of the venue executed on.
+	symbol := fmt.Sprintf("%sUSDT", strings.ToUpper(strategy.Asset))
+
+	var klines []*KlineVolume
+	for d := 1; d <= vwapLookbackDays; d++ {
+		from := start.Add(-time.Duration(d) * 24 * time.Hour)
+
+		ks, err := fetchHistoricalKlineVolumes(ctx, symbol, from, from.Add(horizon))
+		if err != nil {
+			// Best effort; a partial profile is still better than none.
+			slog.Warn(ctx, "Failed to fetch historical klines for vwap volume profile: %s, lookback day: %d, Error: %v", symbol, d, err)
+			continue
+		}
+
+		klines = append(klines, ks...)
+	}
+
+	profile := buildVolumeProfile(klines)
+	if profile.empty {
+		slog.Warn(ctx, "Empty volume profile for %s; falling back to time weighted child orders", symbol)
+		return twapWeights(ctx, strategy, numberOfChildOrders, start, horizon)
+	}
+
+	return profile.weights(numberOfChildOrders, start, horizon), nil
+}
+
+func minuteOfDay(t time.Time) int {
+	t = t.UTC()
+	return t.Hour()*60 + t.Minute()
+}
@@ -0,0 +1,355 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	accountproto "swallowtail/s.account/proto"
+	binanceconsumerdomain "swallowtail/s.binance-consumer/domain"
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	recordedVolumeCurveFixture = "testdata/vwap_btcusdt_1m_klines.json"
+)
+
+// fakeOrderRouter records every order routed to it, in order, & fills it immediately.
+type fakeOrderRouter struct {
+	orders []*tradeengineproto.Order
+}
+
+func (f *fakeOrderRouter) routeAndExecuteNewOrder(
+	ctx context.Context,
+	order *tradeengineproto.Order,
+	venue tradeengineproto.VENUE,
+	instrumentType tradeengineproto.INSTRUMENT_TYPE,
+	venueCredentials *tradeengineproto.VenueCredentials,
+) (*tradeengineproto.Order, error) {
+	f.orders = append(f.orders, order)
+
+	filled := proto.Clone(order).(*tradeengineproto.Order)
+	filled.ExternalOrderId = fmt.Sprintf("fake-%d", len(f.orders))
+	return filled, nil
+}
+
+// loadRecordedVolumeCurve loads 1m klines recorded from the binance kline stream.
+func loadRecordedVolumeCurve(t *testing.T) []*KlineVolume {
+	b, err := ioutil.ReadFile(recordedVolumeCurveFixture)
+	require.NoError(t, err)
+
+	var events []*binanceconsumerdomain.BinanceKlineEvent
+	require.NoError(t, json.Unmarshal(b, &events))
+
+	klines := make([]*KlineVolume, 0, len(events))
+	for _, e := range events {
+		v, err := strconv.ParseFloat(e.Data.BaseAssetVolume, 64)
+		require.NoError(t, err)
+
+		klines = append(klines, &KlineVolume{
+			OpenTime: time.Unix(0, int64(e.Data.KlineStartTime)*int64(time.Millisecond)).UTC(),
+			Volume:   v,
+		})
+	}
+
+	return klines
+}
+
+// fakeExecutionScheduleStore holds execution schedules & their child orders in memory; child orders are claimed &
+// updated as they would be in the dao.
+type fakeExecutionScheduleStore struct {
+	schedule    *domain.ExecutionSchedule
+	childOrders []*domain.ScheduledChildOrder
+}
+
+func (f *fakeExecutionScheduleStore) create(ctx context.Context, schedule *domain.ExecutionSchedule, childOrders []*domain.ScheduledChildOrder) (*domain.ExecutionSchedule, error) {
+	schedule.ExecutionScheduleID = "execution-schedule-id"
+	f.schedule = schedule
+
+	for _, co := range childOrders {
+		c := *co
+		c.ChildOrderID = fmt.Sprintf("child-order-%d", co.SequenceNumber)
+		c.ExecutionScheduleID = schedule.ExecutionScheduleID
+		f.childOrders = append(f.childOrders, &c)
+	}
+
+	return schedule, nil
+}
+
+func (f *fakeExecutionScheduleStore) read(ctx context.Context, executionScheduleID string) (*domain.ExecutionSchedule, error) {
+	return f.schedule, nil
+}
+
+func (f *fakeExecutionScheduleStore) list(ctx context.Context, executionScheduleID string) ([]*domain.ScheduledChildOrder, error) {
+	childOrders := make([]*domain.ScheduledChildOrder, 0, len(f.childOrders))
+	for _, co := range f.childOrders {
+		c := *co
+		childOrders = append(childOrders, &c)
+	}
+
+	return childOrders, nil
+}
+
+func (f *fakeExecutionScheduleStore) listDue(ctx context.Context, now time.Time, limit int) ([]*domain.ScheduledChildOrder, error) {
+	var childOrders []*domain.ScheduledChildOrder
+	for _, co := range f.childOrders {
+		if co.Status != domain.ChildOrderStatusPending || co.ScheduledFor.After(now) || len(childOrders) == limit {
+			continue
+		}
+
+		c := *co
+		childOrders = append(childOrders, &c)
+	}
+
+	return childOrders, nil
+}
+
+func (f *fakeExecutionScheduleStore) find(childOrderID string) *domain.ScheduledChildOrder {
+	for _, co := range f.childOrders {
+		if co.ChildOrderID == childOrderID {
+			return co
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeExecutionScheduleStore) claim(ctx context.Context, childOrderID string) (bool, error) {
+	co := f.find(childOrderID)
+	if co == nil || co.Status != domain.ChildOrderStatusPending {
+		return false, nil
+	}
+
+	co.Status = domain.ChildOrderStatusExecuting
+	co.Attempts++
+	return true, nil
+}
+
+func (f *fakeExecutionScheduleStore) deferChildOrder(ctx context.Context, childOrderID string, scheduledFor time.Time) (bool, error) {
+	f.find(childOrderID).ScheduledFor = scheduledFor
+	return true, nil
+}
+
+func (f *fakeExecutionScheduleStore) update(ctx context.Context, childOrder *domain.ScheduledChildOrder) error {
+	*f.find(childOrder.ChildOrderID) = *childOrder
+	return nil
+}
+
+func (f *fakeExecutionScheduleStore) completeIfDone(ctx context.Context, executionScheduleID string) error {
+	for _, co := range f.childOrders {
+		switch co.Status {
+		case domain.ChildOrderStatusPending, domain.ChildOrderStatusExecuting:
+			return nil
+		}
+	}
+
+	f.schedule.Status = domain.ExecutionScheduleStatusComplete
+	return nil
+}
+
+// withFakeScheduler stubs the execution schedule store & the venue reads of the scheduler, starting the clock at `start`;
+// the returned func sets the clock.
+func withFakeScheduler(t *testing.T, start time.Time, venueAccountBalance float64) (*fakeExecutionScheduleStore, func(time.Time)) {
+	store := &fakeExecutionScheduleStore{}
+
+	var (
+		originalCreate, originalRead, originalList, originalListDue                       = createExecutionSchedule, readExecutionSchedule, listChildOrders, listDueChildOrders
+		originalClaim, originalDefer, originalUpdate, originalComplete                    = claimChildOrder, deferChildOrder, updateChildOrder, completeExecutionScheduleIfDone
+		originalCredentials, originalBalance, originalNow                                 = readScheduledVenueCredentials, readScheduledVenueAccountBalance, schedulerNow
+		originalFilters, originalReadRiskProfile, originalReadRiskExposure, originalFetch = fetchInstrumentFilters, readRiskProfile, readRiskExposure, fetchHistoricalKlineVolumes
+	)
+	t.Cleanup(func() {
+		createExecutionSchedule, readExecutionSchedule, listChildOrders, listDueChildOrders = originalCreate, originalRead, originalList, originalListDue
+		claimChildOrder, deferChildOrder, updateChildOrder, completeExecutionScheduleIfDone = originalClaim, originalDefer, originalUpdate, originalComplete
+		readScheduledVenueCredentials, readScheduledVenueAccountBalance, schedulerNow = originalCredentials, originalBalance, originalNow
+		fetchInstrumentFilters, readRiskProfile, readRiskExposure, fetchHistoricalKlineVolumes = originalFilters, originalReadRiskProfile, originalReadRiskExposure, originalFetch
+	})
+
+	createExecutionSchedule, readExecutionSchedule, listChildOrders, listDueChildOrders = store.create, store.read, store.list, store.listDue
+	claimChildOrder, deferChildOrder, updateChildOrder, completeExecutionScheduleIfDone = store.claim, store.deferChildOrder, store.update, store.completeIfDone
+
+	readScheduledVenueCredentials = func(ctx context.Context, userID string, venue tradeengineproto.VENUE) (*tradeengineproto.VenueCredentials, error) {
+		return &tradeengineproto.VenueCredentials{}, nil
+	}
+	readScheduledVenueAccountBalance = func(ctx context.Context, userID string, venue tradeengineproto.VENUE, tradeStrategy *tradeengineproto.TradeStrategy, credentials *tradeengineproto.VenueCredentials) (float64, error) {
+		return venueAccountBalance, nil
+	}
+	fetchInstrumentFilters = func(ctx context.Context, strategy *tradeengineproto.TradeStrategy, venue tradeengineproto.VENUE) (*instrumentFilters, error) {
+		return &instrumentFilters{LotSize: 0.001, MinQuantity: 0.001}, nil
+	}
+	readRiskProfile = func(ctx context.Context, userID string) (*accountproto.RiskProfile, error) {
+		return &accountproto.RiskProfile{UserId: userID}, nil
+	}
+	readRiskExposure = func(ctx context.Context, userID, asset string) (*riskExposure, error) {
+		return &riskExposure{}, nil
+	}
+
+	now := start
+	schedulerNow = func() time.Time { return now }
+
+	return store, func(t time.Time) { now = t }
+}
+
+// replayVWAP executes a vwap trade strategy from the recorded volume curve, then runs the scheduler every poll interval
+// over the horizon, so every child order is executed as it would be in production, against the fake order router.
+func replayVWAP(t *testing.T, klines []*KlineVolume, numberOfChildOrders int, start time.Time, horizon time.Duration) (*fakeExecutionScheduleStore, *fakeOrderRouter) {
+	// 0.01 / (40000 - 39990) * 60000 * 1% risk: a total quantity of 60.
+	store, setClock := withFakeScheduler(t, start, 60000)
+	withFakeCircuitBreakers(t)
+	withFakeOrderStore(t)
+
+	router := &fakeOrderRouter{}
+	routeAndExecuteNewOrder = router.routeAndExecuteNewOrder
+
+	fetchHistoricalKlineVolumes = func(ctx context.Context, symbol string, from, to time.Time) ([]*KlineVolume, error) {
+		var ks []*KlineVolume
+		for _, k := range klines {
+			if !k.OpenTime.Before(from) && k.OpenTime.Before(to) {
+				ks = append(ks, k)
+			}
+		}
+		return ks, nil
+	}
+
+	ctx := context.Background()
+	strategy := &tradeengineproto.TradeStrategy{
+		TradeStrategyId:   "trade-strategy-id",
+		ExecutionStrategy: tradeengineproto.EXECUTION_STRATEGY_VWAP,
+		Instrument:        "BTCUSDT",
+		Asset:             "BTC",
+		Pair:              tradeengineproto.TRADE_PAIR_USDT,
+		TradeSide:         tradeengineproto.TRADE_SIDE_LONG,
+		InstrumentType:    tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL,
+		Entries:           []float32{40000},
+		StopLoss:          39990,
+	}
+	participant := &tradeengineproto.ExecuteTradeStrategyForParticipantRequest{
+		UserId:                    "user-id",
+		Venue:                     tradeengineproto.VENUE_BINANCE,
+		Risk:                      1,
+		NumberOfChildOrders:       int64(numberOfChildOrders),
+		ExecutionHorizonInMinutes: int64(horizon / time.Minute),
+	}
+
+	rsp, err := (&VWAP{}).Execute(ctx, strategy, participant)
+	require.NoError(t, err)
+	require.Nil(t, rsp.GetError())
+
+	for now := start; !now.After(start.Add(horizon)); now = now.Add(schedulerPollInterval) {
+		setClock(now)
+		require.NoError(t, executeDueChildOrders(ctx))
+	}
+
+	return store, router
+}
+
+// childOrdersRouted returns the child orders routed, in order; the stop loss & take profits are placed alongside them.
+func childOrdersRouted(router *fakeOrderRouter) []*tradeengineproto.Order {
+	var orders []*tradeengineproto.Order
+	for _, o := range router.orders {
+		if o.OrderType == tradeengineproto.ORDER_TYPE_MARKET {
+			orders = append(orders, o)
+		}
+	}
+
+	return orders
+}
+
+func TestVWAP_ReplayRecordedVolumeCurve(t *testing.T) {
+	var (
+		klines              = loadRecordedVolumeCurve(t)
+		start               = time.Date(2022, 1, 5, 13, 0, 0, 0, time.UTC)
+		horizon             = time.Hour
+		numberOfChildOrders = 6
+	)
+
+	store, router := replayVWAP(t, klines, numberOfChildOrders, start, horizon)
+
+	require.NotNil(t, store.schedule)
+	assert.Equal(t, domain.ExecutionScheduleStatusComplete, store.schedule.Status)
+	totalQuantity := store.schedule.TotalQuantity
+	assert.InDelta(t, 60.0, totalQuantity, 1e-9)
+
+	require.Len(t, store.childOrders, numberOfChildOrders)
+	for i, co := range store.childOrders {
+		assert.Equal(t, domain.ChildOrderStatusExecuted, co.Status)
+		assert.Equal(t, start.Add(time.Duration(i)*horizon/time.Duration(numberOfChildOrders)), co.ScheduledFor)
+	}
+
+	orders := childOrdersRouted(router)
+	require.Len(t, orders, numberOfChildOrders)
+
+	// Independently derive the expected share of volume per bucket from the recorded curve.
+	var (
+		bucketVolumes = make([]float64, numberOfChildOrders)
+		totalVolume   float64
+		bucketWidth   = int(horizon.Minutes()) / numberOfChildOrders
+	)
+	for _, k := range klines {
+		bucketVolumes[k.OpenTime.Minute()/bucketWidth] += k.Volume
+		totalVolume += k.Volume
+	}
+
+	var routedQuantity float64
+	for i, o := range orders {
+		assert.Equal(t, tradeengineproto.TRADE_SIDE_LONG, o.TradeSide)
+		// Each child order is rounded down onto the lot size; the last takes the remainder.
+		assert.InDelta(t, totalQuantity*bucketVolumes[i]/totalVolume, float64(o.Quantity), float64(numberOfChildOrders)*0.001)
+
+		routedQuantity += float64(o.Quantity)
+	}
+
+	assert.InDelta(t, totalQuantity, routedQuantity, 1e-3)
+
+	// The recorded curve is U shaped; so the first & last buckets must be the largest.
+	assert.Greater(t, orders[0].Quantity, orders[2].Quantity)
+	assert.Greater(t, orders[numberOfChildOrders-1].Quantity, orders[3].Quantity)
+}
+
+func TestVWAP_FallsBackToTWAPWithoutHistory(t *testing.T) {
+	var (
+		start               = time.Date(2022, 1, 5, 13, 0, 0, 0, time.UTC)
+		numberOfChildOrders = 4
+	)
+
+	store, router := replayVWAP(t, nil, numberOfChildOrders, start, time.Hour)
+	assert.Equal(t, domain.ExecutionScheduleStatusComplete, store.schedule.Status)
+
+	orders := childOrdersRouted(router)
+	require.Len(t, orders, numberOfChildOrders)
+
+	for _, o := range orders {
+		assert.InDelta(t, store.schedule.TotalQuantity/float64(numberOfChildOrders), float64(o.Quantity), 1e-3)
+	}
+}
+
+func TestVolumeProfile_Weights(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2022, 1, 1, 23, 58, 0, 0, time.UTC)
+
+	// Four minutes either side of midnight; the profile must wrap around.
+	vp := buildVolumeProfile([]*KlineVolume{
+		{OpenTime: time.Date(2021, 12, 31, 23, 58, 0, 0, time.UTC), Volume: 10},
+		{OpenTime: time.Date(2021, 12, 31, 23, 59, 0, 0, time.UTC), Volume: 10},
+		{OpenTime: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), Volume: 0},
+		{OpenTime: time.Date(2022, 1, 1, 0, 1, 0, 0, time.UTC), Volume: 0},
+	})
+	require.False(t, vp.empty)
+
+	weights := vp.weights(2, start, 4*time.Minute)
+	require.Len(t, weights, 2)
+
+	assert.Equal(t, 20.0, weights[0])
+	// The quiet bucket is floored, rather than zero.
+	assert.Equal(t, vwapMinWeightCoefficient*20.0/2, weights[1])
+}
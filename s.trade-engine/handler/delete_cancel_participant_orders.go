@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/execution"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// CancelParticipantOrders stops any active execution schedule & cancels the resting orders of a single trade strategy participant.
+func (s *TradeEngineService) CancelParticipantOrders(
+	ctx context.Context, in *tradeengineproto.CancelParticipantOrdersRequest,
+) (*tradeengineproto.CancelParticipantOrdersResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_cancel_participant_orders.unauthorized", nil)
+	case in.TradeStrategyId == "":
+		return nil, gerrors.BadParam("missing_param.trade_strategy_id", nil)
+	case in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id":                   in.ActorId,
+		"trade_strategy_id":          in.TradeStrategyId,
+		"user_id":                    in.UserId,
+		"include_reduce_only_orders": strconv.FormatBool(in.IncludeReduceOnlyOrders),
+	}
+
+	numberOfCancelledSchedules, err := dao.CancelActiveExecutionSchedules(ctx, in.TradeStrategyId, in.UserId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_participant_orders.execution_schedules", errParams)
+	}
+
+	orders, err := dao.ListOpenOrdersByTradeStrategyIDAndUserID(ctx, in.TradeStrategyId, in.UserId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_participant_orders.list_open_orders", errParams)
+	}
+
+	cancelled, failed := execution.CancelOrders(ctx, orders, in.IncludeReduceOnlyOrders)
+
+	slog.Info(ctx, "Cancelled participant orders: %s %s, cancelled orders: %d, failed: %d", in.TradeStrategyId, in.UserId, len(cancelled), len(failed))
+
+	return &tradeengineproto.CancelParticipantOrdersResponse{
+		CancelledOrders:                     cancelled,
+		FailedOrders:                        failed,
+		NumberOfCancelledExecutionSchedules: numberOfCancelledSchedules,
+	}, nil
+}
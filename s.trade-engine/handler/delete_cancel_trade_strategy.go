@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/execution"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// CancelTradeStrategy cancels a trade strategy; all active execution schedules are stopped & resting orders of every
+// participant are cancelled on their venues.
+func (s *TradeEngineService) CancelTradeStrategy(
+	ctx context.Context, in *tradeengineproto.CancelTradeStrategyRequest,
+) (*tradeengineproto.CancelTradeStrategyResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_cancel_trade_strategy.unauthorized", nil)
+	case in.TradeStrategyId == "":
+		return nil, gerrors.BadParam("missing_param.trade_strategy_id", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id":                   in.ActorId,
+		"trade_strategy_id":          in.TradeStrategyId,
+		"include_reduce_only_orders": strconv.FormatBool(in.IncludeReduceOnlyOrders),
+	}
+
+	// Read trade strategy to see if it exists.
+	if _, err := dao.ReadTradeStrategyByTradeStrategyID(ctx, in.TradeStrategyId); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_trade_strategy", errParams)
+	}
+
+	// Stop any scheduled executions first; so no new orders are placed whilst we cancel.
+	numberOfCancelledSchedules, err := dao.CancelActiveExecutionSchedules(ctx, in.TradeStrategyId, "")
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_trade_strategy.execution_schedules", errParams)
+	}
+
+	orders, err := dao.ListOpenOrdersByTradeStrategyID(ctx, in.TradeStrategyId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_trade_strategy.list_open_orders", errParams)
+	}
+
+	cancelled, failed := execution.CancelOrders(ctx, orders, in.IncludeReduceOnlyOrders)
+
+	if err := dao.UpdateTradeStrategyStatus(ctx, in.TradeStrategyId, tradeengineproto.TRADE_STRATEGY_STATUS_CANCELLED.String()); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_trade_strategy.update_status", errParams)
+	}
+
+	slog.Info(ctx, "Cancelled trade strategy: %s, cancelled orders: %d, failed: %d", in.TradeStrategyId, len(cancelled), len(failed))
+
+	return &tradeengineproto.CancelTradeStrategyResponse{
+		CancelledOrders:                     cancelled,
+		FailedOrders:                        failed,
+		NumberOfCancelledExecutionSchedules: numberOfCancelledSchedules,
+	}, nil
+}
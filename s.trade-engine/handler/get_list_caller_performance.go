@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	defaultCallerPerformanceLimit = 10
+	maxCallerPerformanceLimit     = 50
+)
+
+// ListCallerPerformance ranks callers by how their trade strategies played out.
+func (s *TradeEngineService) ListCallerPerformance(
+	ctx context.Context, in *tradeengineproto.ListCallerPerformanceRequest,
+) (*tradeengineproto.ListCallerPerformanceResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_list_caller_performance.unauthorized", nil)
+	case in.WindowInDays < 0:
+		return nil, gerrors.BadParam("bad_param.window_in_days", nil)
+	case in.MinNumberOfTrades < 0:
+		return nil, gerrors.BadParam("bad_param.min_number_of_trades", nil)
+	case in.Limit < 0 || in.Limit > maxCallerPerformanceLimit:
+		return nil, gerrors.BadParam("bad_param.limit", map[string]string{
+			"max_limit": strconv.Itoa(maxCallerPerformanceLimit),
+		})
+	}
+
+	errParams := map[string]string{
+		"actor_id":       in.ActorId,
+		"window_in_days": strconv.FormatInt(in.WindowInDays, 10),
+		"sort":           in.Sort.String(),
+	}
+
+	var since time.Time
+	if in.WindowInDays > 0 {
+		since = time.Now().UTC().Add(-time.Duration(in.WindowInDays) * 24 * time.Hour)
+	}
+
+	limit := int(in.Limit)
+	if limit == 0 {
+		limit = defaultCallerPerformanceLimit
+	}
+
+	orderBy := dao.CallerPerformanceOrderByExpectancy
+	if in.Sort == tradeengineproto.CALLER_PERFORMANCE_SORT_WIN_RATE {
+		orderBy = dao.CallerPerformanceOrderByWinRate
+	}
+
+	performances, err := dao.ListCallerPerformance(ctx, since, int(in.MinNumberOfTrades), limit, orderBy)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_caller_performance", errParams)
+	}
+
+	return &tradeengineproto.ListCallerPerformanceResponse{
+		CallerPerformances: marshaling.CallerPerformancesDomainToProtos(performances),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// ListCircuitBreakers ...
+func (s *TradeEngineService) ListCircuitBreakers(
+	ctx context.Context, in *tradeengineproto.ListCircuitBreakersRequest,
+) (*tradeengineproto.ListCircuitBreakersResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_list_circuit_breakers.unauthorized", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id":     in.ActorId,
+		"only_tripped": strconv.FormatBool(in.OnlyTripped),
+	}
+
+	circuitBreakers, err := dao.ListCircuitBreakers(ctx, in.OnlyTripped)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_circuit_breakers", errParams)
+	}
+
+	return &tradeengineproto.ListCircuitBreakersResponse{
+		CircuitBreakers: marshaling.CircuitBreakersDomainToProtos(circuitBreakers),
+	}, nil
+}
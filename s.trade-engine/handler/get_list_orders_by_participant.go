@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// ListOrdersByParticipant lists all orders, along with their current status, placed on behalf of a trade strategy participant.
+func (s *TradeEngineService) ListOrdersByParticipant(
+	ctx context.Context, in *tradeengineproto.ListOrdersByParticipantRequest,
+) (*tradeengineproto.ListOrdersByParticipantResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_list_orders_by_participant.unauthorized", nil)
+	case in.TradeStrategyId == "":
+		return nil, gerrors.BadParam("missing_param.trade_strategy_id", nil)
+	case in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	}
+
+	errParams := map[string]string{
+		"trade_strategy_id": in.TradeStrategyId,
+		"user_id":           in.UserId,
+		"actor_id":          in.ActorId,
+	}
+
+	orders, err := dao.ListOrdersByTradeStrategyIDAndUserID(ctx, in.TradeStrategyId, in.UserId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_orders_by_participant", errParams)
+	}
+
+	return &tradeengineproto.ListOrdersByParticipantResponse{
+		Orders: marshaling.OrdersDomainToProtos(orders),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/execution"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const defaultRealisedPnLWindow = 24 * time.Hour
+
+// ListVenuePositions lists the open positions, margin & PnL of the user on each of their active venue accounts.
+func (s *TradeEngineService) ListVenuePositions(
+	ctx context.Context, in *tradeengineproto.ListVenuePositionsRequest,
+) (*tradeengineproto.ListVenuePositionsResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_list_venue_positions.unauthorized", nil)
+	}
+
+	realisedPnLSince := time.Now().UTC().Add(-defaultRealisedPnLWindow)
+	if in.RealisedPnlSince != nil {
+		realisedPnLSince = in.RealisedPnlSince.AsTime()
+	}
+
+	errParams := map[string]string{
+		"actor_id":           in.ActorId,
+		"user_id":            in.UserId,
+		"realised_pnl_since": realisedPnLSince.String(),
+	}
+
+	venuePositions, err := execution.ListVenuePositions(ctx, in.UserId, realisedPnLSince)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_venue_positions", errParams)
+	}
+
+	return &tradeengineproto.ListVenuePositionsResponse{
+		VenuePositions: venuePositions,
+	}, nil
+}
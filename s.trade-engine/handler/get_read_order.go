@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// ReadOrder ...
+func (s *TradeEngineService) ReadOrder(
+	ctx context.Context, in *tradeengineproto.ReadOrderRequest,
+) (*tradeengineproto.ReadOrderResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_read_order.unauthorized", nil)
+	case in.OrderId == "":
+		return nil, gerrors.BadParam("missing_param.order_id", nil)
+	}
+
+	errParams := map[string]string{
+		"order_id": in.OrderId,
+		"actor_id": in.ActorId,
+	}
+
+	order, err := dao.ReadOrderByOrderID(ctx, in.OrderId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_order", errParams)
+	}
+
+	return &tradeengineproto.ReadOrderResponse{
+		Order: marshaling.OrderDomainToProto(order),
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"swallowtail/libraries/gerrors"
 	"swallowtail/s.trade-engine/domain"
+	"swallowtail/s.trade-engine/execution"
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 )
 
@@ -19,9 +20,19 @@ func isActorValid(actorID string) bool {
 }
 
 func validateTradeStrategyParticipant(tradeStrategyParticipant *tradeengineproto.ExecuteTradeStrategyForParticipantRequest, tradeStrategy *domain.TradeStrategy) error {
+	// A cancelled or complete trade strategy has been invalidated; so no new orders can be placed for it.
+	switch tradeStrategy.Status {
+	case tradeengineproto.TRADE_STRATEGY_STATUS_CANCELLED.String(), tradeengineproto.TRADE_STRATEGY_STATUS_COMPLETE.String():
+		return gerrors.FailedPrecondition("invalid_trade_strategy_participant.trade_strategy_not_active", map[string]string{
+			"status": tradeStrategy.Status,
+		})
+	}
+
 	switch tradeStrategyParticipant.Venue {
 	case tradeengineproto.VENUE_UNREQUIRED:
 		// We don't need to validate anything at this point in time.
+	case tradeengineproto.VENUE_PAPER:
+		// Paper trading is simulated against latest prices; so is available for any trade strategy.
 	default:
 		if !isParticipantVenueAnAvailableVenue(tradeStrategyParticipant.Venue, tradeStrategy.TradeableVenues) {
 			return gerrors.FailedPrecondition("invalid_trade_strategy_participant.venue_not_available", map[string]string{
@@ -38,6 +49,21 @@ func validateTradeStrategyParticipant(tradeStrategyParticipant *tradeengineproto
 		return gerrors.BadParam("bad_param.risk_or_size_cannot_be_less_than_zero", nil)
 	case tradeStrategyParticipant.Size == 0 && tradeStrategyParticipant.Risk == 0:
 		return gerrors.BadParam("bad_params.risk_and_size_cannot_be_zero", nil)
+	case tradeStrategyParticipant.TrailingStopPercentage < 0, tradeStrategyParticipant.TrailingStopPercentage >= 100:
+		return gerrors.BadParam("bad_param.trailing_stop_percentage", nil)
+	}
+
+	// Position management is driven by the first take profit filling; which we must be able to observe on the venue.
+	if tradeStrategyParticipant.MoveStopToBreakEven || tradeStrategyParticipant.TrailingStopPercentage > 0 {
+		switch {
+		case len(tradeStrategy.TakeProfits) == 0:
+			return gerrors.FailedPrecondition("invalid_trade_strategy_participant.position_management_requires_take_profits", nil)
+		case !execution.IsPositionManagementSupported(tradeStrategyParticipant.Venue, tradeengineproto.INSTRUMENT_TYPE(tradeengineproto.INSTRUMENT_TYPE_value[tradeStrategy.InstrumentType])):
+			return gerrors.FailedPrecondition("invalid_trade_strategy_participant.position_management_unsupported_on_venue", map[string]string{
+				"participant_venue": tradeStrategyParticipant.Venue.String(),
+				"instrument_type":   tradeStrategy.InstrumentType,
+			})
+		}
 	}
 
 	return nil
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func TestValidateTradeStrategyParticipant_TradeStrategyStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      tradeengineproto.TRADE_STRATEGY_STATUS
+		expectedErr bool
+	}{
+		{
+			name:   "new",
+			status: tradeengineproto.TRADE_STRATEGY_STATUS_NEW,
+		},
+		{
+			name:   "active",
+			status: tradeengineproto.TRADE_STRATEGY_STATUS_ACTIVE,
+		},
+		{
+			name:        "cancelled",
+			status:      tradeengineproto.TRADE_STRATEGY_STATUS_CANCELLED,
+			expectedErr: true,
+		},
+		{
+			name:        "complete",
+			status:      tradeengineproto.TRADE_STRATEGY_STATUS_COMPLETE,
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTradeStrategyParticipant(&tradeengineproto.ExecuteTradeStrategyForParticipantRequest{
+				Venue: tradeengineproto.VENUE_PAPER,
+				Risk:  1,
+			}, &domain.TradeStrategy{
+				Status: tt.status.String(),
+			})
+
+			if !tt.expectedErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.True(t, gerrors.Is(err, gerrors.ErrFailedPrecondition, "invalid_trade_strategy_participant.trade_strategy_not_active"))
+		})
+	}
+}
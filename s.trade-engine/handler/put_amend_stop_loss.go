@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/execution"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// AmendStopLoss amends the stop loss of a trade strategy; the resting stop loss of every participant is replaced.
+func (s *TradeEngineService) AmendStopLoss(
+	ctx context.Context, in *tradeengineproto.AmendStopLossRequest,
+) (*tradeengineproto.AmendStopLossResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_amend_stop_loss.unauthorized", nil)
+	case in.TradeStrategyId == "":
+		return nil, gerrors.BadParam("missing_param.trade_strategy_id", nil)
+	case in.StopLoss <= 0:
+		return nil, gerrors.BadParam("bad_param.stop_loss", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id":          in.ActorId,
+		"trade_strategy_id": in.TradeStrategyId,
+		"stop_loss":         fmt.Sprintf("%f", in.StopLoss),
+	}
+
+	tradeStrategy, err := dao.ReadTradeStrategyByTradeStrategyID(ctx, in.TradeStrategyId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_amend_stop_loss", errParams)
+	}
+
+	switch tradeStrategy.Status {
+	case tradeengineproto.TRADE_STRATEGY_STATUS_CANCELLED.String(), tradeengineproto.TRADE_STRATEGY_STATUS_COMPLETE.String():
+		errParams["status"] = tradeStrategy.Status
+		return nil, gerrors.FailedPrecondition("failed_to_amend_stop_loss.trade_strategy_not_active", errParams)
+	}
+
+	// Update the strategy first; so participants executing from now on use the amended stop.
+	if err := dao.UpdateTradeStrategyStopLoss(ctx, in.TradeStrategyId, float64(in.StopLoss)); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_amend_stop_loss.update_trade_strategy", errParams)
+	}
+
+	orders, err := dao.ListOpenOrdersByTradeStrategyID(ctx, in.TradeStrategyId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_amend_stop_loss.list_open_orders", errParams)
+	}
+
+	amended := execution.AmendStopLoss(ctx, orders, float64(in.StopLoss))
+
+	slog.Info(ctx, "Amended stop loss of trade strategy: %s, %f -> %f, participants: %d", in.TradeStrategyId, tradeStrategy.StopLoss, in.StopLoss, len(amended))
+
+	return &tradeengineproto.AmendStopLossResponse{
+		PreviousStopLoss:  float32(tradeStrategy.StopLoss),
+		StopLoss:          in.StopLoss,
+		AmendedStopLosses: amended,
+	}, nil
+}
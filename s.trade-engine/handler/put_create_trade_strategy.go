@@ -2,13 +2,17 @@ package handler
 
 import (
 	"context"
+	"fmt"
 	"swallowtail/libraries/gerrors"
+	"swallowtail/libraries/risk"
 	"swallowtail/s.trade-engine/dao"
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 
+	"github.com/monzo/slog"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"swallowtail/s.trade-engine/marshaling"
+	"swallowtail/s.trade-engine/outcome"
 )
 
 // CreateTradeStrategy creates a trade strategy for participants to execute.
@@ -47,6 +51,11 @@ func (s *TradeEngineService) CreateTradeStrategy(
 		return nil, gerrors.Augment(err, "failed_to_create_trade.failed_to_read_created_trade_back", errParams)
 	}
 
+	// Best effort; failing to track the outcome mustn't stop anyone trading it.
+	if err := outcome.Track(ctx, embelishedTrade); err != nil {
+		slog.Error(ctx, "Failed to track outcome of trade strategy: %s, Error: %v", embelishedTrade.TradeStrategyID, err)
+	}
+
 	return &tradeengineproto.CreateTradeStrategyResponse{
 		TradeStrategyId: embelishedTrade.TradeStrategyID,
 		Created:         timestamppb.New(embelishedTrade.Created),
@@ -75,5 +84,16 @@ func validateTradeStrategy(tradeStrategy *tradeengineproto.TradeStrategy) error
 		}
 	}
 
+	if len(tradeStrategy.TakeProfitAllocations) > 0 && len(tradeStrategy.TakeProfitAllocations) != len(tradeStrategy.TakeProfits) {
+		return gerrors.BadParam("bad_param.take_profit_allocations_must_match_take_profits", map[string]string{
+			"take_profits":            fmt.Sprintf("%d", len(tradeStrategy.TakeProfits)),
+			"take_profit_allocations": fmt.Sprintf("%d", len(tradeStrategy.TakeProfitAllocations)),
+		})
+	}
+
+	if err := risk.ValidateTakeProfitAllocations(tradeStrategy.TakeProfitAllocations); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -39,12 +39,22 @@ func (s *TradeEngineService) ExecuteTradeStrategyForParticipant(
 		"venue":    in.Venue.String(),
 	}
 
+	// Check no circuit breaker is halting trading, either for the user or system wide.
+	if err := execution.CheckCircuitBreakers(ctx, in.UserId); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_add_participant_to_trade_strategy", errParams)
+	}
+
 	// Read trade strategy to see if it exists.
 	tradeStrategy, err := dao.ReadTradeStrategyByTradeStrategyID(ctx, in.TradeStrategyId)
 	if err != nil {
 		return nil, gerrors.Augment(err, "failed_to_add_participant_to_trade_strategy", errParams)
 	}
 
+	// Validate our trade strategy participant; including that the trade strategy is still active.
+	if err := validateTradeStrategyParticipant(in, tradeStrategy); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_add_participant_to_trade_strategy.invalid_trade_participant", errParams)
+	}
+
 	// Read trade participant to see if that already exists.
 	existingTradeParticipant, err := dao.ReadTradeStrategyParticipantByTradeStrategyID(ctx, tradeStrategy.TradeStrategyID, in.UserId)
 	switch {
@@ -55,11 +65,6 @@ func (s *TradeEngineService) ExecuteTradeStrategyForParticipant(
 		return nil, gerrors.AlreadyExists("failed_to_add_participant_to_trade_strategy.trade_already_exists", errParams)
 	}
 
-	// Validate our trade strategy participant.
-	if err := validateTradeStrategyParticipant(in, tradeStrategy); err != nil {
-		return nil, gerrors.Augment(err, "failed_to_add_participant_to_trade_strategy.invalid_trade_participant", errParams)
-	}
-
 	// Marshal domain trade strategy to proto; here we can leverage enums over order parameters.
 	tradeStrategyProto := marshaling.TradeStrategyDomainToProto(tradeStrategy)
 
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/execution"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// ResetCircuitBreaker resumes trading for a single user, or every user if system scoped. Orders cancelled & positions
+// closed out whilst tripped are not restored.
+func (s *TradeEngineService) ResetCircuitBreaker(
+	ctx context.Context, in *tradeengineproto.ResetCircuitBreakerRequest,
+) (*tradeengineproto.ResetCircuitBreakerResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_reset_circuit_breaker.unauthorized", nil)
+	case in.Scope == tradeengineproto.CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER && in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id": in.ActorId,
+		"scope":    in.Scope.String(),
+		"user_id":  in.UserId,
+	}
+
+	circuitBreaker, err := execution.ResetCircuitBreaker(ctx, in.Scope, in.UserId, in.ActorId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_reset_circuit_breaker", errParams)
+	}
+
+	return &tradeengineproto.ResetCircuitBreakerResponse{
+		CircuitBreaker: marshaling.CircuitBreakerDomainToProto(circuitBreaker),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/execution"
+	"swallowtail/s.trade-engine/marshaling"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// TripCircuitBreaker halts trading for a single user, or every user if system scoped. Optionally the resting orders of
+// every open trade strategy are cancelled & any open positions closed out.
+func (s *TradeEngineService) TripCircuitBreaker(
+	ctx context.Context, in *tradeengineproto.TripCircuitBreakerRequest,
+) (*tradeengineproto.TripCircuitBreakerResponse, error) {
+	switch {
+	case in.ActorId == "":
+		return nil, gerrors.BadParam("missing_param.actor_id", nil)
+	case !isActorValid(in.ActorId):
+		return nil, gerrors.Unauthenticated("failed_to_trip_circuit_breaker.unauthorized", nil)
+	case in.Reason == "":
+		return nil, gerrors.BadParam("missing_param.reason", nil)
+	case in.Scope == tradeengineproto.CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER && in.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", nil)
+	}
+
+	errParams := map[string]string{
+		"actor_id":              in.ActorId,
+		"scope":                 in.Scope.String(),
+		"user_id":               in.UserId,
+		"cancel_resting_orders": strconv.FormatBool(in.CancelRestingOrders),
+		"flatten_positions":     strconv.FormatBool(in.FlattenPositions),
+	}
+
+	circuitBreaker, err := execution.TripCircuitBreaker(ctx, in.Scope, in.UserId, in.ActorId, in.Reason)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_trip_circuit_breaker", errParams)
+	}
+
+	rsp := &tradeengineproto.TripCircuitBreakerResponse{
+		CircuitBreaker: marshaling.CircuitBreakerDomainToProto(circuitBreaker),
+	}
+
+	if !in.CancelRestingOrders && !in.FlattenPositions {
+		return rsp, nil
+	}
+
+	// The circuit breaker is tripped at this point; so no new trade strategies will be executed whilst we halt trading.
+	numberOfCancelledSchedules, err := dao.CancelActiveExecutionSchedulesByUserID(ctx, circuitBreaker.UserID)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_trip_circuit_breaker.execution_schedules", errParams)
+	}
+	rsp.NumberOfCancelledExecutionSchedules = numberOfCancelledSchedules
+
+	userIDs := []string{circuitBreaker.UserID}
+	if in.Scope == tradeengineproto.CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER {
+		userIDs, err = dao.ListUserIDsWithOpenOrders(ctx)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_trip_circuit_breaker.list_users", errParams)
+		}
+	}
+
+	for _, userID := range userIDs {
+		halted, err := execution.HaltTrading(ctx, userID, in.FlattenPositions)
+		if err != nil {
+			// Best effort; we continue halting the remaining users.
+			slog.Error(ctx, "Failed to halt trading for user: %s, Error: %v", userID, err)
+			continue
+		}
+
+		rsp.CancelledOrders = append(rsp.CancelledOrders, halted.CancelledOrders...)
+		rsp.ClosedOutOrders = append(rsp.ClosedOutOrders, halted.ClosedOutOrders...)
+		rsp.FailedOrders = append(rsp.FailedOrders, halted.FailedOrders...)
+	}
+
+	slog.Info(
+		ctx, "Halted trading: %s %s, cancelled orders: %d, closed out orders: %d, failed: %d",
+		in.Scope, circuitBreaker.UserID, len(rsp.CancelledOrders), len(rsp.ClosedOutOrders), len(rsp.FailedOrders),
+	)
+
+	return rsp, nil
+}
@@ -5,7 +5,9 @@ import (
 
 	"swallowtail/libraries/mariana"
 	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/execution"
 	"swallowtail/s.trade-engine/handler"
+	"swallowtail/s.trade-engine/outcome"
 	tradeengineproto "swallowtail/s.trade-engine/proto"
 )
 
@@ -23,6 +25,16 @@ func main() {
 		panic(err)
 	}
 
+	// Init execution scheduler, order status poller & position manager; resumes any persisted schedules.
+	if err := execution.Init(ctx); err != nil {
+		panic(err)
+	}
+
+	// Init trade outcome evaluator.
+	if err := outcome.Init(ctx); err != nil {
+		panic(err)
+	}
+
 	// Init Mariana Server
 	srv := mariana.Init(svcName)
 	tradeengineproto.RegisterTradeengineServer(srv.Grpc(), &handler.TradeEngineService{})
@@ -0,0 +1,36 @@
+package marshaling
+
+import (
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// CallerPerformanceDomainToProto ...
+func CallerPerformanceDomainToProto(performance *domain.CallerPerformance) *tradeengineproto.CallerPerformance {
+	var winRate, expectancy float64
+	if performance.NumberOfTrades > 0 {
+		winRate = float64(performance.NumberOfWins) / float64(performance.NumberOfTrades) * 100
+		expectancy = performance.TotalRMultiple / float64(performance.NumberOfTrades)
+	}
+
+	return &tradeengineproto.CallerPerformance{
+		HumanizedActorName:      performance.HumanizedActorName,
+		ActorType:               tradeengineproto.ACTOR_TYPE(tradeengineproto.ACTOR_TYPE_value[performance.ActorType]),
+		NumberOfTrades:          int64(performance.NumberOfTrades),
+		NumberOfWins:            int64(performance.NumberOfWins),
+		WinRate:                 float32(winRate),
+		Expectancy:              float32(expectancy),
+		TotalRMultiple:          float32(performance.TotalRMultiple),
+		AverageMinutesToOutcome: float32(performance.AverageMinutesToOutcome),
+	}
+}
+
+// CallerPerformancesDomainToProtos ...
+func CallerPerformancesDomainToProtos(performances []*domain.CallerPerformance) []*tradeengineproto.CallerPerformance {
+	protos := make([]*tradeengineproto.CallerPerformance, 0, len(performances))
+	for _, performance := range performances {
+		protos = append(protos, CallerPerformanceDomainToProto(performance))
+	}
+
+	return protos
+}
@@ -0,0 +1,31 @@
+package marshaling
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// CircuitBreakerDomainToProto ...
+func CircuitBreakerDomainToProto(circuitBreaker *domain.CircuitBreaker) *tradeengineproto.CircuitBreaker {
+	return &tradeengineproto.CircuitBreaker{
+		Scope:       tradeengineproto.CIRCUIT_BREAKER_SCOPE(tradeengineproto.CIRCUIT_BREAKER_SCOPE_value[circuitBreaker.Scope]),
+		UserId:      circuitBreaker.UserID,
+		IsTripped:   circuitBreaker.IsTripped,
+		Reason:      circuitBreaker.Reason,
+		ActorId:     circuitBreaker.ActorID,
+		Tripped:     timestamppb.New(circuitBreaker.Tripped),
+		LastUpdated: timestamppb.New(circuitBreaker.LastUpdated),
+	}
+}
+
+// CircuitBreakersDomainToProtos ...
+func CircuitBreakersDomainToProtos(circuitBreakers []*domain.CircuitBreaker) []*tradeengineproto.CircuitBreaker {
+	protos := make([]*tradeengineproto.CircuitBreaker, 0, len(circuitBreakers))
+	for _, circuitBreaker := range circuitBreakers {
+		protos = append(protos, CircuitBreakerDomainToProto(circuitBreaker))
+	}
+
+	return protos
+}
@@ -0,0 +1,44 @@
+package marshaling
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// ExecutionScheduleDomainToProto marshals an execution schedule & its child orders into a progress report.
+func ExecutionScheduleDomainToProto(schedule *domain.ExecutionSchedule, childOrders []*domain.ScheduledChildOrder) *tradeengineproto.ExecutionSchedule {
+	var (
+		numberOfExecuted int64
+		numberOfFailed   int64
+		executedQuantity float64
+		nextChildOrder   *timestamppb.Timestamp
+	)
+	for _, co := range childOrders {
+		switch co.Status {
+		case domain.ChildOrderStatusExecuted:
+			numberOfExecuted++
+			executedQuantity += co.Quantity
+		case domain.ChildOrderStatusFailed:
+			numberOfFailed++
+		case domain.ChildOrderStatusPending:
+			if nextChildOrder == nil || co.ScheduledFor.Before(nextChildOrder.AsTime()) {
+				nextChildOrder = timestamppb.New(co.ScheduledFor)
+			}
+		}
+	}
+
+	return &tradeengineproto.ExecutionSchedule{
+		ExecutionScheduleId:         schedule.ExecutionScheduleID,
+		ExecutionStrategy:           tradeengineproto.EXECUTION_STRATEGY((tradeengineproto.EXECUTION_STRATEGY_value[schedule.ExecutionStrategy])),
+		NumberOfChildOrders:         int64(schedule.NumberOfChildOrders),
+		NumberOfExecutedChildOrders: numberOfExecuted,
+		NumberOfFailedChildOrders:   numberOfFailed,
+		TotalQuantity:               float32(schedule.TotalQuantity),
+		ExecutedQuantity:            float32(executedQuantity),
+		Start:                       timestamppb.New(schedule.Start),
+		End:                         timestamppb.New(schedule.End),
+		NextChildOrder:              nextChildOrder,
+	}
+}
@@ -0,0 +1,70 @@
+package marshaling
+
+import (
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// OrderProtoToDomain marshals an order, placed on behalf of the given trade strategy participant, into its domain definition.
+func OrderProtoToDomain(tradeStrategyID, userID string, proto *tradeengineproto.Order) *domain.Order {
+	return &domain.Order{
+		OrderID:            proto.OrderId,
+		TradeStrategyID:    tradeStrategyID,
+		UserID:             userID,
+		ActorID:            proto.ActorId,
+		Venue:              proto.Venue.String(),
+		ExternalOrderID:    proto.ExternalOrderId,
+		Instrument:         proto.Instrument,
+		InstrumentType:     proto.InstrumentType.String(),
+		Asset:              proto.Asset,
+		Pair:               proto.Pair.String(),
+		OrderType:          proto.OrderType.String(),
+		TradeSide:          proto.TradeSide.String(),
+		LimitPrice:         float64(proto.LimitPrice),
+		StopPrice:          float64(proto.StopPrice),
+		Quantity:           float64(proto.Quantity),
+		ExecutedQuantity:   float64(proto.ExecutedQuantity),
+		ReduceOnly:         proto.ReduceOnly,
+		Status:             proto.Status.String(),
+		FailureReason:      proto.FailureReason,
+		TrailingPercentage: float64(proto.TrailingPercentage),
+	}
+}
+
+// OrderDomainToProto ...
+func OrderDomainToProto(order *domain.Order) *tradeengineproto.Order {
+	return &tradeengineproto.Order{
+		OrderId:              order.OrderID,
+		ActorId:              order.ActorID,
+		Instrument:           order.Instrument,
+		Asset:                order.Asset,
+		Pair:                 tradeengineproto.TRADE_PAIR((tradeengineproto.TRADE_PAIR_value[order.Pair])),
+		OrderType:            tradeengineproto.ORDER_TYPE((tradeengineproto.ORDER_TYPE_value[order.OrderType])),
+		TradeSide:            tradeengineproto.TRADE_SIDE((tradeengineproto.TRADE_SIDE_value[order.TradeSide])),
+		LimitPrice:           float32(order.LimitPrice),
+		StopPrice:            float32(order.StopPrice),
+		Quantity:             float32(order.Quantity),
+		ReduceOnly:           order.ReduceOnly,
+		Venue:                tradeengineproto.VENUE((tradeengineproto.VENUE_value[order.Venue])),
+		CreatedTimestamp:     order.Created.Unix(),
+		ExternalOrderId:      order.ExternalOrderID,
+		InstrumentType:       tradeengineproto.INSTRUMENT_TYPE((tradeengineproto.INSTRUMENT_TYPE_value[order.InstrumentType])),
+		Status:               tradeengineproto.ORDER_STATUS((tradeengineproto.ORDER_STATUS_value[order.Status])),
+		TradeStrategyId:      order.TradeStrategyID,
+		UserId:               order.UserID,
+		ExecutedQuantity:     float32(order.ExecutedQuantity),
+		FailureReason:        order.FailureReason,
+		LastUpdatedTimestamp: order.LastUpdated.Unix(),
+		TrailingPercentage:   float32(order.TrailingPercentage),
+	}
+}
+
+// OrdersDomainToProtos ...
+func OrdersDomainToProtos(orders []*domain.Order) []*tradeengineproto.Order {
+	protos := make([]*tradeengineproto.Order, 0, len(orders))
+	for _, o := range orders {
+		protos = append(protos, OrderDomainToProto(o))
+	}
+
+	return protos
+}
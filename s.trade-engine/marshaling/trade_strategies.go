@@ -20,30 +20,36 @@ func TradeStrategyProtoToDomain(proto *tradeengineproto.TradeStrategy) *domain.T
 		tps = append(tps, float64(tp))
 	}
 
+	allocations := make([]float64, 0, len(proto.TakeProfitAllocations))
+	for _, a := range proto.TakeProfitAllocations {
+		allocations = append(allocations, float64(a))
+	}
+
 	tradeableVenues := make([]string, 0, len(proto.TradeableVenues))
 	for _, tv := range proto.TradeableVenues {
 		tradeableVenues = append(tradeableVenues, tv.String())
 	}
 
 	return &domain.TradeStrategy{
-		TradeStrategyID:    proto.TradeStrategyId,
-		ActorID:            proto.ActorId,
-		ActorType:          proto.ActorType.String(),
-		HumanizedActorName: proto.HumanizedActorName,
-		IdempotencyKey:     proto.IdempotencyKey,
-		ExecutionStrategy:  proto.ExecutionStrategy.String(),
-		InstrumentType:     proto.InstrumentType.String(),
-		TradeSide:          proto.TradeSide.String(),
-		Asset:              proto.Asset,
-		Pair:               proto.Pair.String(),
-		Entries:            entries,
-		StopLoss:           float64(proto.StopLoss),
-		TakeProfits:        tps,
-		CurrentPrice:       float64(proto.CurrentPrice),
-		Status:             proto.Status.String(),
-		Created:            proto.Created.AsTime(),
-		LastUpdated:        proto.LastUpdated.AsTime(),
-		TradeableVenues:    tradeableVenues,
+		TradeStrategyID:       proto.TradeStrategyId,
+		ActorID:               proto.ActorId,
+		ActorType:             proto.ActorType.String(),
+		HumanizedActorName:    proto.HumanizedActorName,
+		IdempotencyKey:        proto.IdempotencyKey,
+		ExecutionStrategy:     proto.ExecutionStrategy.String(),
+		InstrumentType:        proto.InstrumentType.String(),
+		TradeSide:             proto.TradeSide.String(),
+		Asset:                 proto.Asset,
+		Pair:                  proto.Pair.String(),
+		Entries:               entries,
+		StopLoss:              float64(proto.StopLoss),
+		TakeProfits:           tps,
+		TakeProfitAllocations: allocations,
+		CurrentPrice:          float64(proto.CurrentPrice),
+		Status:                proto.Status.String(),
+		Created:               proto.Created.AsTime(),
+		LastUpdated:           proto.LastUpdated.AsTime(),
+		TradeableVenues:       tradeableVenues,
 	}
 }
 
@@ -59,23 +65,29 @@ func TradeStrategyDomainToProto(domain *domain.TradeStrategy) *tradeengineproto.
 		tps = append(tps, float32(tp))
 	}
 
+	var allocations []float32
+	for _, a := range domain.TakeProfitAllocations {
+		allocations = append(allocations, float32(a))
+	}
+
 	return &tradeengineproto.TradeStrategy{
-		TradeStrategyId:    domain.TradeStrategyID,
-		ActorId:            domain.ActorID,
-		ActorType:          tradeengineproto.ACTOR_TYPE((tradeengineproto.ACTOR_TYPE_value[domain.ActorType])),
-		HumanizedActorName: domain.HumanizedActorName,
-		ExecutionStrategy:  tradeengineproto.EXECUTION_STRATEGY((tradeengineproto.EXECUTION_STRATEGY_value[domain.ExecutionStrategy])),
-		InstrumentType:     tradeengineproto.INSTRUMENT_TYPE((tradeengineproto.INSTRUMENT_TYPE_value[domain.InstrumentType])),
-		TradeSide:          tradeengineproto.TRADE_SIDE((tradeengineproto.TRADE_SIDE_value[domain.TradeSide])),
-		Asset:              domain.Asset,
-		Pair:               tradeengineproto.TRADE_PAIR((tradeengineproto.TRADE_PAIR_value[domain.Pair])),
-		Entries:            entries,
-		StopLoss:           float32(domain.StopLoss),
-		TakeProfits:        tps,
-		Status:             tradeengineproto.TRADE_STRATEGY_STATUS((tradeengineproto.TRADE_STRATEGY_STATUS_value[domain.Status])),
-		CurrentPrice:       float32(domain.CurrentPrice),
-		Created:            timestamppb.New(domain.Created),
-		LastUpdated:        timestamppb.New(domain.LastUpdated),
+		TradeStrategyId:       domain.TradeStrategyID,
+		ActorId:               domain.ActorID,
+		ActorType:             tradeengineproto.ACTOR_TYPE((tradeengineproto.ACTOR_TYPE_value[domain.ActorType])),
+		HumanizedActorName:    domain.HumanizedActorName,
+		ExecutionStrategy:     tradeengineproto.EXECUTION_STRATEGY((tradeengineproto.EXECUTION_STRATEGY_value[domain.ExecutionStrategy])),
+		InstrumentType:        tradeengineproto.INSTRUMENT_TYPE((tradeengineproto.INSTRUMENT_TYPE_value[domain.InstrumentType])),
+		TradeSide:             tradeengineproto.TRADE_SIDE((tradeengineproto.TRADE_SIDE_value[domain.TradeSide])),
+		Asset:                 domain.Asset,
+		Pair:                  tradeengineproto.TRADE_PAIR((tradeengineproto.TRADE_PAIR_value[domain.Pair])),
+		Entries:               entries,
+		StopLoss:              float32(domain.StopLoss),
+		TakeProfits:           tps,
+		TakeProfitAllocations: allocations,
+		Status:                tradeengineproto.TRADE_STRATEGY_STATUS((tradeengineproto.TRADE_STRATEGY_STATUS_value[domain.Status])),
+		CurrentPrice:          float32(domain.CurrentPrice),
+		Created:               timestamppb.New(domain.Created),
+		LastUpdated:           timestamppb.New(domain.LastUpdated),
 	}
 }
 
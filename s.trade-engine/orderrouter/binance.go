@@ -2,6 +2,8 @@ package orderrouter
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -28,3 +30,46 @@ func executeBinanceNewPerpetualFuturesOrders(ctx context.Context, order *tradeen
 func executeBinanceNewSpotOrders(ctx context.Context, order *tradeengineproto.Order, credentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
 	return nil, gerrors.Unimplemented("unimplemented.binance_spot_orders", nil)
 }
+
+// readBinancePerpetualFuturesOrderStatus reads the current status of a binance perpetual futures order.
+func readBinancePerpetualFuturesOrderStatus(ctx context.Context, order *tradeengineproto.Order, credentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+	rsp, err := (&binanceproto.ReadPerpetualFuturesOrderRequest{
+		ActorId:         binanceproto.BinanceAccountActorTradeEngineSystem,
+		Credentials:     credentials,
+		Symbol:          binanceSymbol(order),
+		ExternalOrderId: order.ExternalOrderId,
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_read_order_status.binance_perpetual_futures", nil)
+	}
+
+	order.Status = rsp.Status
+	order.ExecutedQuantity = rsp.ExecutedQuantity
+
+	return order, nil
+}
+
+// cancelBinancePerpetualFuturesOrder cancels a resting binance perpetual futures order.
+func cancelBinancePerpetualFuturesOrder(ctx context.Context, order *tradeengineproto.Order, credentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+	rsp, err := (&binanceproto.CancelPerpetualFuturesOrderRequest{
+		ActorId:         binanceproto.BinanceAccountActorTradeEngineSystem,
+		Credentials:     credentials,
+		Symbol:          binanceSymbol(order),
+		ExternalOrderId: order.ExternalOrderId,
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_cancel_order.binance_perpetual_futures", nil)
+	}
+
+	order.Status = rsp.Status
+
+	return order, nil
+}
+
+func binanceSymbol(order *tradeengineproto.Order) string {
+	if order.Instrument != "" {
+		return strings.ToUpper(order.Instrument)
+	}
+
+	return fmt.Sprintf("%s%s", strings.ToUpper(order.Asset), order.Pair.String())
+}
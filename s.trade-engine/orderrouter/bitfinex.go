@@ -0,0 +1,36 @@
+package orderrouter
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	bitfinexproto "swallowtail/s.bitfinex/proto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// executeBitfinexNewOrder ...
+func executeBitfinexNewOrder(ctx context.Context, order *tradeengineproto.Order, credentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+	rsp, err := (&bitfinexproto.ExecuteNewBitfinexOrderRequest{
+		Order:       order,
+		Credentials: credentials,
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_execute_order.bitfinex", nil)
+	}
+
+	return rsp.Order, nil
+}
+
+// cancelBitfinexOrder cancels a resting order on Bitfinex; the cancellation is acknowledged synchronously.
+func cancelBitfinexOrder(ctx context.Context, order *tradeengineproto.Order, credentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+	if _, err := (&bitfinexproto.CancelBitfinexOrderRequest{
+		Credentials:     credentials,
+		ExternalOrderId: order.ExternalOrderId,
+	}).Send(ctx).Response(); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_cancel_order.bitfinex", nil)
+	}
+
+	order.Status = tradeengineproto.ORDER_STATUS_CANCELLED_ORDER
+
+	return order, nil
+}
@@ -0,0 +1,36 @@
+package orderrouter
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	deribitproto "swallowtail/s.deribit/proto"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// executeDeribitNewOrder ...
+func executeDeribitNewOrder(ctx context.Context, order *tradeengineproto.Order, credentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+	rsp, err := (&deribitproto.ExecuteNewDeribitOrderRequest{
+		Order:       order,
+		Credentials: credentials,
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_execute_order.deribit", nil)
+	}
+
+	return rsp.Order, nil
+}
+
+// cancelDeribitOrder cancels a resting order on Deribit.
+func cancelDeribitOrder(ctx context.Context, order *tradeengineproto.Order, credentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+	if _, err := (&deribitproto.CancelDeribitOrderRequest{
+		Credentials:     credentials,
+		ExternalOrderId: order.ExternalOrderId,
+	}).Send(ctx).Response(); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_cancel_order.deribit", nil)
+	}
+
+	order.Status = tradeengineproto.ORDER_STATUS_CANCELLED_ORDER
+
+	return order, nil
+}
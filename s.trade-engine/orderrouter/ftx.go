@@ -23,3 +23,25 @@ func executeFTXNewOrders(ctx context.Context, order *tradeengineproto.Order, cre
 
 	return rsp.Order, nil
 }
+
+// cancelFTXOrder cancels a resting order on FTX; FTX queues cancellations, so we treat the order as cancelled once accepted.
+func cancelFTXOrder(ctx context.Context, order *tradeengineproto.Order, credentials *tradeengineproto.VenueCredentials) (*tradeengineproto.Order, error) {
+	var isTriggerOrder bool
+	switch order.OrderType {
+	case tradeengineproto.ORDER_TYPE_LIMIT, tradeengineproto.ORDER_TYPE_MARKET:
+	default:
+		isTriggerOrder = true
+	}
+
+	if _, err := (&ftxproto.CancelOrderRequest{
+		Credentials:     credentials,
+		ExternalOrderId: order.ExternalOrderId,
+		IsTriggerOrder:  isTriggerOrder,
+	}).Send(ctx).Response(); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_cancel_order.ftx", nil)
+	}
+
+	order.Status = tradeengineproto.ORDER_STATUS_CANCELLED_ORDER
+
+	return order, nil
+}
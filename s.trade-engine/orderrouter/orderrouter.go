@@ -41,8 +41,94 @@ func RouteAndExecuteNewOrder(
 		default:
 			return executeFTXNewOrders(ctx, order, venueCredentials)
 		}
+	case tradeengineproto.VENUE_BITFINEX:
+		switch instrumentType {
+		case tradeengineproto.INSTRUMENT_TYPE_SPOT, tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL:
+			return executeBitfinexNewOrder(ctx, order, venueCredentials)
+		default:
+			return nil, gerrors.Unimplemented("failed_to_route_and_execute_order.instument_not_supported_on_venue", errParams)
+		}
+	case tradeengineproto.VENUE_DERIBIT:
+		switch instrumentType {
+		case tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL, tradeengineproto.INSTRUMENT_TYPE_OPTION:
+			return executeDeribitNewOrder(ctx, order, venueCredentials)
+		default:
+			return nil, gerrors.Unimplemented("failed_to_route_and_execute_order.instument_not_supported_on_venue", errParams)
+		}
+	case tradeengineproto.VENUE_PAPER:
+		return executePaperOrder(ctx, order)
 	default:
 		slog.Error(ctx, "Failed to route order: venue, instrument pair not implemented: %+v", errParams)
 		return nil, gerrors.Unimplemented("failed_to_route_and_execute_order.venue_unimplemented", errParams)
 	}
 }
+
+// RouteAndReadOrderStatus routes the order to the correct exchange & reads its current status; the order is returned
+// with the status & executed quantity updated.
+func RouteAndReadOrderStatus(
+	ctx context.Context,
+	order *tradeengineproto.Order,
+	venueCredentials *tradeengineproto.VenueCredentials,
+) (*tradeengineproto.Order, error) {
+	errParams := map[string]string{
+		"venue_id":          strings.ToLower(order.Venue.String()),
+		"instrument_type":   strings.ToLower(order.InstrumentType.String()),
+		"external_order_id": order.ExternalOrderId,
+	}
+
+	if !IsOrderStatusReadable(order.Venue, order.InstrumentType) {
+		return nil, gerrors.Unimplemented("failed_to_route_and_read_order_status.venue_unimplemented", errParams)
+	}
+
+	switch order.Venue {
+	case tradeengineproto.VENUE_BINANCE:
+		return readBinancePerpetualFuturesOrderStatus(ctx, order, venueCredentials)
+	default:
+		return readPaperOrderStatus(ctx, order)
+	}
+}
+
+// IsOrderStatusReadable returns true if we're able to read the status of orders of the instrument type from the venue.
+func IsOrderStatusReadable(venue tradeengineproto.VENUE, instrumentType tradeengineproto.INSTRUMENT_TYPE) bool {
+	switch venue {
+	case tradeengineproto.VENUE_BINANCE:
+		return instrumentType == tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL
+	case tradeengineproto.VENUE_PAPER:
+		return true
+	default:
+		return false
+	}
+}
+
+// RouteAndCancelOrder routes the order to the correct exchange & cancels it; the order is returned with the status updated.
+func RouteAndCancelOrder(
+	ctx context.Context,
+	order *tradeengineproto.Order,
+	venueCredentials *tradeengineproto.VenueCredentials,
+) (*tradeengineproto.Order, error) {
+	errParams := map[string]string{
+		"venue_id":          strings.ToLower(order.Venue.String()),
+		"instrument_type":   strings.ToLower(order.InstrumentType.String()),
+		"external_order_id": order.ExternalOrderId,
+	}
+
+	switch order.Venue {
+	case tradeengineproto.VENUE_BINANCE:
+		switch order.InstrumentType {
+		case tradeengineproto.INSTRUMENT_TYPE_FUTURE_PERPETUAL:
+			return cancelBinancePerpetualFuturesOrder(ctx, order, venueCredentials)
+		default:
+			return nil, gerrors.Unimplemented("failed_to_route_and_cancel_order.instument_not_supported_on_venue", errParams)
+		}
+	case tradeengineproto.VENUE_FTX:
+		return cancelFTXOrder(ctx, order, venueCredentials)
+	case tradeengineproto.VENUE_BITFINEX:
+		return cancelBitfinexOrder(ctx, order, venueCredentials)
+	case tradeengineproto.VENUE_DERIBIT:
+		return cancelDeribitOrder(ctx, order, venueCredentials)
+	case tradeengineproto.VENUE_PAPER:
+		return cancelPaperOrder(ctx, order)
+	default:
+		return nil, gerrors.Unimplemented("failed_to_route_and_cancel_order.venue_unimplemented", errParams)
+	}
+}
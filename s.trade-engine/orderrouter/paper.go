@@ -0,0 +1,39 @@
+package orderrouter
+
+import (
+	"context"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/paper"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+// executePaperOrder executes an order on the simulated paper trading venue.
+func executePaperOrder(ctx context.Context, order *tradeengineproto.Order) (*tradeengineproto.Order, error) {
+	executedOrder, err := paper.ExecuteOrder(ctx, order)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_execute_order.paper", nil)
+	}
+
+	return executedOrder, nil
+}
+
+// readPaperOrderStatus reads the current status of a paper order.
+func readPaperOrderStatus(ctx context.Context, order *tradeengineproto.Order) (*tradeengineproto.Order, error) {
+	polledOrder, err := paper.ReadOrderStatus(ctx, order)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_read_order_status.paper", nil)
+	}
+
+	return polledOrder, nil
+}
+
+// cancelPaperOrder cancels a resting paper order.
+func cancelPaperOrder(ctx context.Context, order *tradeengineproto.Order) (*tradeengineproto.Order, error) {
+	cancelledOrder, err := paper.CancelOrder(ctx, order)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_route_and_cancel_order.paper", nil)
+	}
+
+	return cancelledOrder, nil
+}
@@ -0,0 +1,272 @@
+package outcome
+
+import (
+	"context"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	binanceproto "swallowtail/s.binance/proto"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/domain"
+)
+
+const (
+	evaluatorPollInterval = time.Minute
+	evaluatorBatchSize    = 100
+
+	// candleInterval is the binance kline interval outcomes are evaluated on; binance returns at most 1500 per request, so
+	// an outcome catches up on at most 25 hours per poll.
+	candleInterval    = "1m"
+	maxCandlesPerPoll = 1500
+)
+
+// Candle is a single closed kline of the reference symbol.
+type Candle struct {
+	OpenTime  time.Time
+	CloseTime time.Time
+	High      float64
+	Low       float64
+	Close     float64
+}
+
+// listUnresolvedTradeOutcomes, updateTradeOutcome & recordTradeOutcomeAttempt are package variables so evaluation can be
+// faked in tests.
+var (
+	listUnresolvedTradeOutcomes = dao.ListUnresolvedTradeOutcomes
+	updateTradeOutcome          = dao.UpdateTradeOutcome
+	recordTradeOutcomeAttempt   = dao.RecordTradeOutcomeAttempt
+)
+
+// fetchCandles is a package variable so candles can be faked in tests.
+var fetchCandles = func(ctx context.Context, symbol string, from, to time.Time) ([]*Candle, error) {
+	rsp, err := (&binanceproto.ListKlinesRequest{
+		Symbol:    symbol,
+		Interval:  candleInterval,
+		StartTime: from.UnixNano() / int64(time.Millisecond),
+		EndTime:   to.UnixNano() / int64(time.Millisecond),
+		Limit:     maxCandlesPerPoll,
+	}).Send(ctx).Response()
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_fetch_candles", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	candles := make([]*Candle, 0, len(rsp.GetKlines()))
+	for _, k := range rsp.GetKlines() {
+		candles = append(candles, &Candle{
+			OpenTime:  time.Unix(0, k.OpenTime*int64(time.Millisecond)).UTC(),
+			CloseTime: time.Unix(0, k.CloseTime*int64(time.Millisecond)).UTC(),
+			High:      float64(k.HighPrice),
+			Low:       float64(k.LowPrice),
+			Close:     float64(k.ClosePrice),
+		})
+	}
+
+	return candles, nil
+}
+
+func runEvaluator(ctx context.Context) {
+	t := time.NewTicker(evaluatorPollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			if err := evaluateUnresolvedOutcomes(ctx, time.Now().UTC()); err != nil {
+				slog.Error(ctx, "Failed to evaluate unresolved trade outcomes: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// evaluateUnresolvedOutcomes evaluates every unresolved trade outcome, least recently attempted first. Every attempt is
+// recorded, successful or not; so outcomes we fail to evaluate, i.e their candles can't be fetched, are cycled to the back
+// rather than starving the rest.
+func evaluateUnresolvedOutcomes(ctx context.Context, now time.Time) error {
+	outcomes, err := listUnresolvedTradeOutcomes(ctx, evaluatorBatchSize)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_list_unresolved_trade_outcomes", nil)
+	}
+
+	for _, outcome := range outcomes {
+		if err := evaluateOutcome(ctx, outcome, now); err != nil {
+			slog.Error(ctx, "Failed to evaluate trade outcome: %s, Error: %v", outcome.TradeStrategyID, err)
+		}
+
+		if err := recordTradeOutcomeAttempt(ctx, outcome.TradeStrategyID, now); err != nil {
+			slog.Error(ctx, "Failed to record trade outcome attempt: %s, Error: %v", outcome.TradeStrategyID, err)
+		}
+	}
+
+	return nil
+}
+
+func evaluateOutcome(ctx context.Context, outcome *domain.TradeOutcome, now time.Time) error {
+	errParams := map[string]string{
+		"trade_strategy_id": outcome.TradeStrategyID,
+		"symbol":            outcome.Symbol,
+	}
+
+	candles, err := fetchCandles(ctx, outcome.Symbol, outcome.EvaluatedUntil, now)
+	if err != nil {
+		// Without candles, i.e the symbol isn't listed on binance, the outcome can never be known; so we give up on it
+		// once it would have expired regardless.
+		if now.Sub(outcome.Created) > pendingEntryExpiry+enteredExpiry {
+			expire(outcome, now, 0)
+			if err := updateTradeOutcome(ctx, outcome); err != nil {
+				return gerrors.Augment(err, "failed_to_expire_trade_outcome", errParams)
+			}
+		}
+
+		return gerrors.Augment(err, "failed_to_evaluate_trade_outcome", errParams)
+	}
+
+	if !applyCandles(outcome, candles, now) {
+		return nil
+	}
+
+	if err := updateTradeOutcome(ctx, outcome); err != nil {
+		return gerrors.Augment(err, "failed_to_update_trade_outcome", errParams)
+	}
+
+	if outcome.Resolved != nil {
+		slog.Info(ctx, "Trade outcome resolved: %s %s, R: %.2f", outcome.TradeStrategyID, outcome.Status, outcome.RMultiple)
+	}
+
+	return nil
+}
+
+// applyCandles replays the closed candles against the outcome, in order, until it resolves; returning true if the
+// outcome changed. Within a single candle we can't know the order prices traded in; so we assume the worst. A candle
+// that both enters & stops out is a loss, a candle that reaches both the stop & a take profit is stopped out, & take
+// profits aren't taken in the candle that enters.
+func applyCandles(outcome *domain.TradeOutcome, candles []*Candle, now time.Time) bool {
+	var changed bool
+	for _, c := range candles {
+		if outcome.Resolved != nil {
+			break
+		}
+
+		// The latest candle is still forming.
+		if !c.CloseTime.Before(now) {
+			break
+		}
+
+		applyCandle(outcome, c)
+		outcome.EvaluatedUntil = c.CloseTime.Add(time.Millisecond)
+		changed = true
+	}
+
+	return changed
+}
+
+func applyCandle(outcome *domain.TradeOutcome, c *Candle) {
+	isLong := isBuySide(outcome.TradeSide)
+
+	switch outcome.Status {
+	case domain.TradeOutcomeStatusPendingEntry:
+		if c.CloseTime.Sub(outcome.Created) > pendingEntryExpiry {
+			expire(outcome, c.CloseTime, 0)
+			return
+		}
+
+		if !isEntryHit(outcome, c) {
+			// The trade ran without ever filling; it's no longer a valid call.
+			if len(outcome.TakeProfits) > 0 && isPriceReached(isLong, outcome.TakeProfits[0], c) {
+				expire(outcome, c.CloseTime, 0)
+			}
+			return
+		}
+
+		entered := c.OpenTime
+		outcome.Entered = &entered
+		outcome.Status = domain.TradeOutcomeStatusEntered
+
+		if isStopHit(isLong, outcome.StopLoss, c) {
+			stopOut(outcome, c.CloseTime)
+		}
+	case domain.TradeOutcomeStatusEntered:
+		if outcome.Entered != nil && c.CloseTime.Sub(*outcome.Entered) > enteredExpiry {
+			expire(outcome, c.CloseTime, c.Close)
+			return
+		}
+
+		if isStopHit(isLong, outcome.StopLoss, c) {
+			stopOut(outcome, c.CloseTime)
+			return
+		}
+
+		for outcome.NumberOfTakeProfitsHit < len(outcome.TakeProfits) && isPriceReached(isLong, outcome.TakeProfits[outcome.NumberOfTakeProfitsHit], c) {
+			outcome.NumberOfTakeProfitsHit++
+		}
+
+		if len(outcome.TakeProfits) > 0 && outcome.NumberOfTakeProfitsHit == len(outcome.TakeProfits) {
+			realised, _ := realisedRMultiple(outcome)
+			resolve(outcome, domain.TradeOutcomeStatusTakeProfit, c.CloseTime, realised)
+		}
+	}
+}
+
+// isEntryHit returns true if the candle traded through the entry. Entries are hit from the side the price was on when the
+// trade strategy was created; if unknown, we assume a limit entry i.e below the price for longs.
+func isEntryHit(outcome *domain.TradeOutcome, c *Candle) bool {
+	hitFromBelow := !isBuySide(outcome.TradeSide)
+	if outcome.ReferencePrice > 0 {
+		hitFromBelow = outcome.ReferencePrice < outcome.EntryPrice
+	}
+
+	if hitFromBelow {
+		return c.High >= outcome.EntryPrice
+	}
+
+	return c.Low <= outcome.EntryPrice
+}
+
+// isPriceReached returns true if the candle reached the price in the direction of profit.
+func isPriceReached(isLong bool, price float64, c *Candle) bool {
+	if isLong {
+		return c.High >= price
+	}
+
+	return c.Low <= price
+}
+
+func isStopHit(isLong bool, stopLoss float64, c *Candle) bool {
+	if isLong {
+		return c.Low <= stopLoss
+	}
+
+	return c.High >= stopLoss
+}
+
+// stopOut closes whatever remains of the position at the stop loss.
+func stopOut(outcome *domain.TradeOutcome, at time.Time) {
+	realised, remaining := realisedRMultiple(outcome)
+	resolve(outcome, domain.TradeOutcomeStatusStoppedOut, at, realised+remaining*rMultiple(outcome, outcome.StopLoss))
+}
+
+// expire resolves the outcome as expired. If entered, whatever remains of the position is marked at the given price; or
+// left unmarked if there's no price.
+func expire(outcome *domain.TradeOutcome, at time.Time, price float64) {
+	var r float64
+	if outcome.Entered != nil {
+		realised, remaining := realisedRMultiple(outcome)
+		r = realised
+		if price > 0 {
+			r += remaining * rMultiple(outcome, price)
+		}
+	}
+
+	resolve(outcome, domain.TradeOutcomeStatusExpired, at, r)
+}
+
+func resolve(outcome *domain.TradeOutcome, status string, at time.Time, r float64) {
+	outcome.Status = status
+	outcome.Resolved = &at
+	outcome.RMultiple = r
+}
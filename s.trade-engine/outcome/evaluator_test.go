@@ -0,0 +1,299 @@
+package outcome
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/s.trade-engine/domain"
+)
+
+var testStart = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+// testCandles builds consecutive one minute candles from the start; each given as low, high & close.
+func testCandles(ohlc ...[3]float64) []*Candle {
+	candles := make([]*Candle, 0, len(ohlc))
+	for i, c := range ohlc {
+		open := testStart.Add(time.Duration(i) * time.Minute)
+		candles = append(candles, &Candle{
+			OpenTime:  open,
+			CloseTime: open.Add(time.Minute - time.Millisecond),
+			Low:       c[0],
+			High:      c[1],
+			Close:     c[2],
+		})
+	}
+
+	return candles
+}
+
+func testLongOutcome() *domain.TradeOutcome {
+	return &domain.TradeOutcome{
+		TradeStrategyID:       "trade-strategy-id",
+		Symbol:                "BTCUSDT",
+		TradeSide:             "LONG",
+		EntryPrice:            100,
+		ReferencePrice:        105,
+		StopLoss:              90,
+		TakeProfits:           []float64{110, 120},
+		TakeProfitAllocations: []float64{50, 50},
+		Status:                domain.TradeOutcomeStatusPendingEntry,
+		EvaluatedUntil:        testStart,
+		Created:               testStart,
+	}
+}
+
+func TestApplyCandles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                     string
+		outcome                  func() *domain.TradeOutcome
+		candles                  []*Candle
+		expectedStatus           string
+		expectedTakeProfitsHit   int
+		expectedRMultiple        float64
+		expectedEntered          bool
+		expectedResolved         bool
+		expectedEvaluatedCandles int
+	}{
+		{
+			name:    "entry_not_hit",
+			outcome: testLongOutcome,
+			candles: testCandles(
+				[3]float64{102, 106, 104},
+				[3]float64{101, 105, 103},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusPendingEntry,
+			expectedEvaluatedCandles: 2,
+		},
+		{
+			name:    "all_take_profits_hit",
+			outcome: testLongOutcome,
+			candles: testCandles(
+				[3]float64{99, 104, 101},
+				[3]float64{101, 111, 109},
+				[3]float64{108, 121, 120},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusTakeProfit,
+			expectedTakeProfitsHit:   2,
+			expectedRMultiple:        1.5, // half at 1R, half at 2R.
+			expectedEntered:          true,
+			expectedResolved:         true,
+			expectedEvaluatedCandles: 3,
+		},
+		{
+			name:    "stopped_out_after_first_take_profit",
+			outcome: testLongOutcome,
+			candles: testCandles(
+				[3]float64{99, 104, 101},
+				[3]float64{101, 111, 109},
+				[3]float64{89, 109, 89},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusStoppedOut,
+			expectedTakeProfitsHit:   1,
+			expectedRMultiple:        0, // half at 1R, half at -1R.
+			expectedEntered:          true,
+			expectedResolved:         true,
+			expectedEvaluatedCandles: 3,
+		},
+		{
+			name:    "stop_&_take_profit_in_the_same_candle_is_stopped_out",
+			outcome: testLongOutcome,
+			candles: testCandles(
+				[3]float64{99, 104, 101},
+				[3]float64{89, 111, 100},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusStoppedOut,
+			expectedRMultiple:        -1,
+			expectedEntered:          true,
+			expectedResolved:         true,
+			expectedEvaluatedCandles: 2,
+		},
+		{
+			name:    "entered_&_stopped_out_in_the_same_candle",
+			outcome: testLongOutcome,
+			candles: testCandles(
+				[3]float64{85, 104, 86},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusStoppedOut,
+			expectedRMultiple:        -1,
+			expectedEntered:          true,
+			expectedResolved:         true,
+			expectedEvaluatedCandles: 1,
+		},
+		{
+			name:    "take_profit_reached_before_entry_expires",
+			outcome: testLongOutcome,
+			candles: testCandles(
+				[3]float64{101, 111, 110},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusExpired,
+			expectedResolved:         true,
+			expectedEvaluatedCandles: 1,
+		},
+		{
+			name: "breakout_entry_hit_from_below",
+			outcome: func() *domain.TradeOutcome {
+				o := testLongOutcome()
+				o.ReferencePrice = 95
+				o.StopLoss = 92
+				return o
+			},
+			candles: testCandles(
+				[3]float64{94, 99, 98},
+				[3]float64{98, 101, 100},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusEntered,
+			expectedEntered:          true,
+			expectedEvaluatedCandles: 2,
+		},
+		{
+			name: "short_take_profit",
+			outcome: func() *domain.TradeOutcome {
+				o := testLongOutcome()
+				o.TradeSide = "SHORT"
+				o.ReferencePrice = 95
+				o.StopLoss = 110
+				o.TakeProfits = []float64{80}
+				o.TakeProfitAllocations = []float64{100}
+				return o
+			},
+			candles: testCandles(
+				[3]float64{96, 101, 99},
+				[3]float64{79, 99, 80},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusTakeProfit,
+			expectedTakeProfitsHit:   1,
+			expectedRMultiple:        2,
+			expectedEntered:          true,
+			expectedResolved:         true,
+			expectedEvaluatedCandles: 2,
+		},
+		{
+			name: "pending_entry_expires",
+			outcome: func() *domain.TradeOutcome {
+				o := testLongOutcome()
+				o.Created = testStart.Add(-pendingEntryExpiry)
+				return o
+			},
+			candles: testCandles(
+				[3]float64{101, 104, 103},
+				[3]float64{99, 104, 101},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusExpired,
+			expectedResolved:         true,
+			expectedEvaluatedCandles: 1,
+		},
+		{
+			name: "entered_expires_marked_to_market",
+			outcome: func() *domain.TradeOutcome {
+				o := testLongOutcome()
+				entered := testStart.Add(-enteredExpiry)
+				o.Status, o.Entered = domain.TradeOutcomeStatusEntered, &entered
+				return o
+			},
+			candles: testCandles(
+				[3]float64{101, 106, 105},
+			),
+			expectedStatus:           domain.TradeOutcomeStatusExpired,
+			expectedRMultiple:        0.5,
+			expectedEntered:          true,
+			expectedResolved:         true,
+			expectedEvaluatedCandles: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			outcome := tt.outcome()
+			now := testStart.Add(time.Hour)
+
+			changed := applyCandles(outcome, tt.candles, now)
+			assert.True(t, changed)
+
+			assert.Equal(t, tt.expectedStatus, outcome.Status)
+			assert.Equal(t, tt.expectedTakeProfitsHit, outcome.NumberOfTakeProfitsHit)
+			assert.InDelta(t, tt.expectedRMultiple, outcome.RMultiple, 1e-9)
+			assert.Equal(t, tt.expectedEntered, outcome.Entered != nil)
+			assert.Equal(t, tt.expectedResolved, outcome.Resolved != nil)
+			assert.Equal(t, tt.candles[tt.expectedEvaluatedCandles-1].CloseTime.Add(time.Millisecond), outcome.EvaluatedUntil)
+		})
+	}
+}
+
+func TestApplyCandles_SkipsFormingCandle(t *testing.T) {
+	t.Parallel()
+
+	outcome := testLongOutcome()
+	candles := testCandles(
+		[3]float64{102, 106, 104},
+		[3]float64{85, 104, 86},
+	)
+
+	changed := applyCandles(outcome, candles, candles[1].CloseTime)
+	assert.True(t, changed)
+	assert.Equal(t, domain.TradeOutcomeStatusPendingEntry, outcome.Status)
+	assert.Equal(t, candles[0].CloseTime.Add(time.Millisecond), outcome.EvaluatedUntil)
+}
+
+func TestEvaluateUnresolvedOutcomes(t *testing.T) {
+	originalList, originalUpdate, originalFetch, originalRecord := listUnresolvedTradeOutcomes, updateTradeOutcome, fetchCandles, recordTradeOutcomeAttempt
+	t.Cleanup(func() {
+		listUnresolvedTradeOutcomes, updateTradeOutcome, fetchCandles, recordTradeOutcomeAttempt = originalList, originalUpdate, originalFetch, originalRecord
+	})
+
+	resolving, unlisted := testLongOutcome(), testLongOutcome()
+	resolving.TradeStrategyID = "resolving"
+	unlisted.TradeStrategyID, unlisted.Symbol = "unlisted", "UNLISTEDUSDT"
+	unlisted.Created = testStart.Add(-pendingEntryExpiry - enteredExpiry)
+
+	listUnresolvedTradeOutcomes = func(_ context.Context, _ int) ([]*domain.TradeOutcome, error) {
+		return []*domain.TradeOutcome{resolving, unlisted}, nil
+	}
+
+	fetchCandles = func(_ context.Context, symbol string, _, _ time.Time) ([]*Candle, error) {
+		if symbol == "UNLISTEDUSDT" {
+			return nil, errors.New("invalid symbol")
+		}
+
+		return testCandles(
+			[3]float64{99, 104, 101},
+			[3]float64{85, 101, 86},
+		), nil
+	}
+
+	updated := map[string]*domain.TradeOutcome{}
+	updateTradeOutcome = func(_ context.Context, outcome *domain.TradeOutcome) error {
+		updated[outcome.TradeStrategyID] = outcome
+		return nil
+	}
+
+	attempted := map[string]time.Time{}
+	recordTradeOutcomeAttempt = func(_ context.Context, tradeStrategyID string, at time.Time) error {
+		attempted[tradeStrategyID] = at
+		return nil
+	}
+
+	now := testStart.Add(time.Hour)
+	require.NoError(t, evaluateUnresolvedOutcomes(context.Background(), now))
+
+	// Every attempt is recorded, even those that fail; so they're cycled to the back of the queue.
+	assert.Equal(t, map[string]time.Time{"resolving": now, "unlisted": now}, attempted)
+
+	require.Contains(t, updated, "resolving")
+	assert.Equal(t, domain.TradeOutcomeStatusStoppedOut, updated["resolving"].Status)
+	assert.InDelta(t, -1, updated["resolving"].RMultiple, 1e-9)
+
+	// Candles can never be fetched for the unlisted symbol; so it's given up on.
+	require.Contains(t, updated, "unlisted")
+	assert.Equal(t, domain.TradeOutcomeStatusExpired, updated["unlisted"].Status)
+	assert.Nil(t, updated["unlisted"].Entered)
+}
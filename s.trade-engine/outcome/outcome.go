@@ -0,0 +1,178 @@
+package outcome
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	binanceproto "swallowtail/s.binance/proto"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/domain"
+)
+
+const (
+	// pendingEntryExpiry is how long a trade strategy has for its entry to be hit before it expires untraded.
+	pendingEntryExpiry = 7 * 24 * time.Hour
+	// enteredExpiry is how long a trade strategy has after entry to either stop out or take its final profit; after which
+	// it's marked to market.
+	enteredExpiry = 30 * 24 * time.Hour
+)
+
+// createTradeOutcome is a package variable so tracking can be faked in tests.
+var createTradeOutcome = dao.CreateTradeOutcome
+
+// readInstrumentFilters is a package variable so the binance symbols we can price can be faked in tests; binance only
+// has filters for the symbols it lists.
+var readInstrumentFilters = func(ctx context.Context, symbol string) error {
+	if _, err := (&binanceproto.GetInstrumentFiltersRequest{
+		Symbol: symbol,
+	}).Send(ctx).Response(); err != nil {
+		return gerrors.Augment(err, "failed_to_read_instrument_filters", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	return nil
+}
+
+// Init starts the trade outcome evaluator.
+func Init(ctx context.Context) error {
+	go runEvaluator(ctx)
+
+	return nil
+}
+
+// Track starts tracking the outcome of the trade strategy. Trade strategies without a stop loss are never tracked; without
+// one there's no risk to measure an R-multiple by. Nor are trade strategies whose symbol binance doesn't list, since we
+// could never price them.
+func Track(ctx context.Context, strategy *domain.TradeStrategy) error {
+	outcome, ok := newTradeOutcome(strategy)
+	if !ok {
+		slog.Debug(ctx, "Not tracking the outcome of trade strategy without a valid stop loss: %s", strategy.TradeStrategyID)
+		return nil
+	}
+
+	errParams := map[string]string{
+		"trade_strategy_id": strategy.TradeStrategyID,
+		"symbol":            outcome.Symbol,
+	}
+
+	err := readInstrumentFilters(ctx, outcome.Symbol)
+	switch {
+	case gerrors.IsCode(err, gerrors.ErrNotFound):
+		slog.Info(ctx, "Not tracking the outcome of trade strategy with a symbol we can't price: %s %s", strategy.TradeStrategyID, outcome.Symbol)
+		return nil
+	case err != nil:
+		// Best effort; if the symbol turns out to be unlisted the evaluator gives up on it once it would have expired.
+		slog.Warn(ctx, "Failed to check we can price the outcome of trade strategy: %s %s, Error: %v", strategy.TradeStrategyID, outcome.Symbol, err)
+	}
+
+	if err := createTradeOutcome(ctx, outcome); err != nil {
+		return gerrors.Augment(err, "failed_to_track_trade_outcome", errParams)
+	}
+
+	return nil
+}
+
+// newTradeOutcome builds the outcome of a trade strategy that is yet to be entered. The entry price is the first entry
+// the price would reach; the highest for longs, the lowest for shorts.
+func newTradeOutcome(strategy *domain.TradeStrategy) (*domain.TradeOutcome, bool) {
+	if len(strategy.Entries) == 0 || strategy.StopLoss <= 0 {
+		return nil, false
+	}
+
+	isLong := isBuySide(strategy.TradeSide)
+
+	entryPrice := strategy.Entries[0]
+	for _, e := range strategy.Entries[1:] {
+		if (isLong && e > entryPrice) || (!isLong && e < entryPrice) {
+			entryPrice = e
+		}
+	}
+
+	// A stop on the wrong side of the entry has no risk to measure by.
+	if (isLong && strategy.StopLoss >= entryPrice) || (!isLong && strategy.StopLoss <= entryPrice) {
+		return nil, false
+	}
+
+	takeProfitAllocations := strategy.TakeProfitAllocations
+	if len(takeProfitAllocations) != len(strategy.TakeProfits) {
+		takeProfitAllocations = evenTakeProfitAllocations(len(strategy.TakeProfits))
+	}
+
+	return &domain.TradeOutcome{
+		TradeStrategyID:       strategy.TradeStrategyID,
+		ActorID:               strategy.ActorID,
+		HumanizedActorName:    strategy.HumanizedActorName,
+		ActorType:             strategy.ActorType,
+		Symbol:                referenceSymbol(strategy.Asset, strategy.Pair),
+		TradeSide:             strategy.TradeSide,
+		EntryPrice:            entryPrice,
+		ReferencePrice:        strategy.CurrentPrice,
+		StopLoss:              strategy.StopLoss,
+		TakeProfits:           strategy.TakeProfits,
+		TakeProfitAllocations: takeProfitAllocations,
+		Status:                domain.TradeOutcomeStatusPendingEntry,
+	}, true
+}
+
+// referenceSymbol is the binance symbol outcomes are evaluated against; binance has no USD margined perpetuals, so those
+// are evaluated against tether.
+func referenceSymbol(asset, pair string) string {
+	if strings.ToUpper(pair) == "USD" {
+		pair = "USDT"
+	}
+
+	return strings.ToUpper(fmt.Sprintf("%s%s", asset, pair))
+}
+
+// evenTakeProfitAllocations splits the position evenly across the take profits.
+func evenTakeProfitAllocations(numberOfTakeProfits int) []float64 {
+	allocations := make([]float64, 0, numberOfTakeProfits)
+	for i := 0; i < numberOfTakeProfits; i++ {
+		allocations = append(allocations, 100/float64(numberOfTakeProfits))
+	}
+
+	return allocations
+}
+
+// rMultiple returns the R-multiple of exiting at the given price; the profit or loss in units of the initial risk.
+func rMultiple(outcome *domain.TradeOutcome, exitPrice float64) float64 {
+	risk := math.Abs(outcome.EntryPrice - outcome.StopLoss)
+	if risk == 0 {
+		return 0
+	}
+
+	if isBuySide(outcome.TradeSide) {
+		return (exitPrice - outcome.EntryPrice) / risk
+	}
+
+	return (outcome.EntryPrice - exitPrice) / risk
+}
+
+// realisedRMultiple returns the R-multiple of the take profits hit so far, along with the fraction of the position left
+// open.
+func realisedRMultiple(outcome *domain.TradeOutcome) (float64, float64) {
+	var realised, closed float64
+	for i := 0; i < outcome.NumberOfTakeProfitsHit && i < len(outcome.TakeProfits); i++ {
+		allocation := outcome.TakeProfitAllocations[i] / 100
+		realised += allocation * rMultiple(outcome, outcome.TakeProfits[i])
+		closed += allocation
+	}
+
+	return realised, math.Max(0, 1-closed)
+}
+
+func isBuySide(tradeSide string) bool {
+	switch tradeSide {
+	case "BUY", "LONG":
+		return true
+	default:
+		return false
+	}
+}
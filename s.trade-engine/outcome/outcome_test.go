@@ -0,0 +1,151 @@
+package outcome
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.trade-engine/domain"
+)
+
+func TestNewTradeOutcome(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                          string
+		strategy                      *domain.TradeStrategy
+		expectedTracked               bool
+		expectedSymbol                string
+		expectedEntryPrice            float64
+		expectedTakeProfitAllocations []float64
+	}{
+		{
+			name: "dca_long_enters_at_highest_entry",
+			strategy: &domain.TradeStrategy{
+				Asset:                 "btc",
+				Pair:                  "USDT",
+				TradeSide:             "LONG",
+				Entries:               []float64{95, 100, 90},
+				StopLoss:              85,
+				TakeProfits:           []float64{110, 120},
+				TakeProfitAllocations: []float64{70, 30},
+			},
+			expectedTracked:               true,
+			expectedSymbol:                "BTCUSDT",
+			expectedEntryPrice:            100,
+			expectedTakeProfitAllocations: []float64{70, 30},
+		},
+		{
+			name: "dca_short_enters_at_lowest_entry_split_evenly",
+			strategy: &domain.TradeStrategy{
+				Asset:       "eth",
+				Pair:        "USD",
+				TradeSide:   "SHORT",
+				Entries:     []float64{105, 100, 110},
+				StopLoss:    115,
+				TakeProfits: []float64{90, 80, 70, 60},
+			},
+			expectedTracked:               true,
+			expectedSymbol:                "ETHUSDT",
+			expectedEntryPrice:            100,
+			expectedTakeProfitAllocations: []float64{25, 25, 25, 25},
+		},
+		{
+			name: "without_stop_loss",
+			strategy: &domain.TradeStrategy{
+				Asset:     "btc",
+				Pair:      "USDT",
+				TradeSide: "BUY",
+				Entries:   []float64{100},
+			},
+		},
+		{
+			name: "stop_loss_on_wrong_side_of_entry",
+			strategy: &domain.TradeStrategy{
+				Asset:     "btc",
+				Pair:      "USDT",
+				TradeSide: "LONG",
+				Entries:   []float64{100},
+				StopLoss:  110,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			outcome, ok := newTradeOutcome(tt.strategy)
+			require.Equal(t, tt.expectedTracked, ok)
+			if !ok {
+				return
+			}
+
+			assert.Equal(t, tt.expectedSymbol, outcome.Symbol)
+			assert.Equal(t, tt.expectedEntryPrice, outcome.EntryPrice)
+			assert.Equal(t, tt.expectedTakeProfitAllocations, outcome.TakeProfitAllocations)
+			assert.Equal(t, domain.TradeOutcomeStatusPendingEntry, outcome.Status)
+		})
+	}
+}
+
+func TestTrack(t *testing.T) {
+	tests := []struct {
+		name            string
+		filtersErr      error
+		expectedTracked bool
+	}{
+		{
+			name:            "listed_symbol",
+			expectedTracked: true,
+		},
+		{
+			name:       "unlisted_symbol",
+			filtersErr: gerrors.NotFound("failed_to_get_instrument_filters.symbol_not_found", nil),
+		},
+		{
+			name:            "unable_to_check_symbol",
+			filtersErr:      gerrors.New(gerrors.ErrUnknown, "binance_unavailable", nil),
+			expectedTracked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			originalRead, originalCreate := readInstrumentFilters, createTradeOutcome
+			t.Cleanup(func() {
+				readInstrumentFilters, createTradeOutcome = originalRead, originalCreate
+			})
+
+			readInstrumentFilters = func(_ context.Context, _ string) error {
+				return tt.filtersErr
+			}
+
+			var tracked []string
+			createTradeOutcome = func(_ context.Context, outcome *domain.TradeOutcome) error {
+				tracked = append(tracked, outcome.Symbol)
+				return nil
+			}
+
+			require.NoError(t, Track(context.Background(), &domain.TradeStrategy{
+				TradeStrategyID: "trade-strategy-id",
+				Asset:           "btc",
+				Pair:            "USDT",
+				TradeSide:       "LONG",
+				Entries:         []float64{100},
+				StopLoss:        90,
+			}))
+
+			if tt.expectedTracked {
+				assert.Equal(t, []string{"BTCUSDT"}, tracked)
+			} else {
+				assert.Empty(t, tracked)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package paper
+
+import (
+	"math"
+
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func isBuy(tradeSide string) bool {
+	switch tradeSide {
+	case tradeengineproto.TRADE_SIDE_BUY.String(), tradeengineproto.TRADE_SIDE_LONG.String():
+		return true
+	default:
+		return false
+	}
+}
+
+// isTriggered returns true if the conditional order has been triggered at the given price. Stops trigger as price moves
+// against the side of the order, take profits as it moves with it. Non conditional orders are always triggered.
+func isTriggered(order *domain.PaperOrder, price float64) bool {
+	switch order.OrderType {
+	case tradeengineproto.ORDER_TYPE_STOP_MARKET.String(), tradeengineproto.ORDER_TYPE_STOP_LIMIT.String():
+		if isBuy(order.TradeSide) {
+			return price >= order.StopPrice
+		}
+		return price <= order.StopPrice
+	case tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET.String(), tradeengineproto.ORDER_TYPE_TAKE_PROFIT_LIMIT.String():
+		if isBuy(order.TradeSide) {
+			return price <= order.StopPrice
+		}
+		return price >= order.StopPrice
+	default:
+		return true
+	}
+}
+
+// isConditional returns true if the order rests until its stop price is reached.
+func isConditional(order *domain.PaperOrder) bool {
+	switch order.OrderType {
+	case tradeengineproto.ORDER_TYPE_STOP_MARKET.String(),
+		tradeengineproto.ORDER_TYPE_STOP_LIMIT.String(),
+		tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET.String(),
+		tradeengineproto.ORDER_TYPE_TAKE_PROFIT_LIMIT.String():
+		return true
+	default:
+		return false
+	}
+}
+
+// isMarketable returns true if the order would fill at the given price. We assume infinite liquidity at the latest price;
+// marketable limit orders fill at the latest price since it is at least as good as the limit.
+func isMarketable(order *domain.PaperOrder, price float64) bool {
+	if !isTriggered(order, price) {
+		return false
+	}
+
+	switch order.OrderType {
+	case tradeengineproto.ORDER_TYPE_LIMIT.String(), tradeengineproto.ORDER_TYPE_STOP_LIMIT.String(), tradeengineproto.ORDER_TYPE_TAKE_PROFIT_LIMIT.String():
+		if isBuy(order.TradeSide) {
+			return price <= order.LimitPrice
+		}
+		return price >= order.LimitPrice
+	default:
+		return true
+	}
+}
+
+// reduceOnlyQuantity caps the quantity of a reduce only order to the size of the opposing position.
+func reduceOnlyQuantity(position *domain.PaperPosition, tradeSide string, quantity float64) float64 {
+	if isBuy(tradeSide) {
+		return math.Min(quantity, math.Max(0, -position.Quantity))
+	}
+
+	return math.Min(quantity, math.Max(0, position.Quantity))
+}
+
+// isReducing returns true if the fill only reduces, or closes, the position; without flipping it.
+func isReducing(position *domain.PaperPosition, tradeSide string, quantity float64) bool {
+	if isBuy(tradeSide) {
+		return position.Quantity < 0 && quantity <= -position.Quantity
+	}
+
+	return position.Quantity > 0 && quantity <= position.Quantity
+}
+
+// requiredInitialMargin returns the initial margin required to hold the positions at the given leverage; each position is
+// valued at its average entry price.
+func requiredInitialMargin(positions []*domain.PaperPosition, leverage float64) float64 {
+	var margin float64
+	for _, p := range positions {
+		margin += math.Abs(p.Quantity) * p.AverageEntryPrice / leverage
+	}
+
+	return margin
+}
+
+// applyFill applies a fill to the position, returning the realized pnl of any part of the position closed out. If the fill
+// flips the position, the remainder is opened at the fill price.
+func applyFill(position *domain.PaperPosition, tradeSide string, quantity, price float64) float64 {
+	signedQuantity := quantity
+	if !isBuy(tradeSide) {
+		signedQuantity = -quantity
+	}
+
+	// Increasing, or opening, the position.
+	if position.Quantity == 0 || (position.Quantity > 0) == (signedQuantity > 0) {
+		totalQuantity := math.Abs(position.Quantity) + quantity
+		position.AverageEntryPrice = (math.Abs(position.Quantity)*position.AverageEntryPrice + quantity*price) / totalQuantity
+		position.Quantity += signedQuantity
+		return 0
+	}
+
+	// Reducing, closing or flipping the position.
+	closedQuantity := math.Min(math.Abs(position.Quantity), quantity)
+
+	realizedPNL := closedQuantity * (price - position.AverageEntryPrice)
+	if position.Quantity < 0 {
+		realizedPNL = -realizedPNL
+	}
+
+	position.Quantity += signedQuantity
+	position.RealizedPNL += realizedPNL
+
+	switch {
+	case position.Quantity == 0:
+		position.AverageEntryPrice = 0
+	case quantity > closedQuantity:
+		position.AverageEntryPrice = price
+	}
+
+	return realizedPNL
+}
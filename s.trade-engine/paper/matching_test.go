@@ -0,0 +1,219 @@
+package paper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+func TestIsMarketable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		order      *domain.PaperOrder
+		price      float64
+		marketable bool
+	}{
+		{
+			name: "market_order",
+			order: &domain.PaperOrder{
+				OrderType: tradeengineproto.ORDER_TYPE_MARKET.String(),
+				TradeSide: tradeengineproto.TRADE_SIDE_BUY.String(),
+			},
+			price:      100,
+			marketable: true,
+		},
+		{
+			name: "buy_limit_above_price",
+			order: &domain.PaperOrder{
+				OrderType:  tradeengineproto.ORDER_TYPE_LIMIT.String(),
+				TradeSide:  tradeengineproto.TRADE_SIDE_BUY.String(),
+				LimitPrice: 101,
+			},
+			price:      100,
+			marketable: true,
+		},
+		{
+			name: "buy_limit_below_price_rests",
+			order: &domain.PaperOrder{
+				OrderType:  tradeengineproto.ORDER_TYPE_LIMIT.String(),
+				TradeSide:  tradeengineproto.TRADE_SIDE_BUY.String(),
+				LimitPrice: 99,
+			},
+			price:      100,
+			marketable: false,
+		},
+		{
+			name: "sell_stop_triggered",
+			order: &domain.PaperOrder{
+				OrderType: tradeengineproto.ORDER_TYPE_STOP_MARKET.String(),
+				TradeSide: tradeengineproto.TRADE_SIDE_SELL.String(),
+				StopPrice: 100,
+			},
+			price:      99,
+			marketable: true,
+		},
+		{
+			name: "sell_stop_not_triggered",
+			order: &domain.PaperOrder{
+				OrderType: tradeengineproto.ORDER_TYPE_STOP_MARKET.String(),
+				TradeSide: tradeengineproto.TRADE_SIDE_SELL.String(),
+				StopPrice: 100,
+			},
+			price:      101,
+			marketable: false,
+		},
+		{
+			name: "sell_take_profit_triggered",
+			order: &domain.PaperOrder{
+				OrderType: tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET.String(),
+				TradeSide: tradeengineproto.TRADE_SIDE_SELL.String(),
+				StopPrice: 110,
+			},
+			price:      111,
+			marketable: true,
+		},
+		{
+			name: "buy_take_profit_not_triggered",
+			order: &domain.PaperOrder{
+				OrderType: tradeengineproto.ORDER_TYPE_TAKE_PROFIT_MARKET.String(),
+				TradeSide: tradeengineproto.TRADE_SIDE_BUY.String(),
+				StopPrice: 90,
+			},
+			price:      95,
+			marketable: false,
+		},
+		{
+			name: "stop_limit_triggered_but_limit_not_marketable",
+			order: &domain.PaperOrder{
+				OrderType:  tradeengineproto.ORDER_TYPE_STOP_LIMIT.String(),
+				TradeSide:  tradeengineproto.TRADE_SIDE_SELL.String(),
+				StopPrice:  100,
+				LimitPrice: 99,
+			},
+			price:      98,
+			marketable: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.marketable, isMarketable(tt.order, tt.price))
+		})
+	}
+}
+
+func TestApplyFill(t *testing.T) {
+	t.Parallel()
+
+	type fill struct {
+		tradeSide tradeengineproto.TRADE_SIDE
+		quantity  float64
+		price     float64
+	}
+
+	tests := []struct {
+		name                      string
+		fills                     []fill
+		expectedQuantity          float64
+		expectedAverageEntryPrice float64
+		expectedRealizedPNL       float64
+	}{
+		{
+			name: "increase_long_averages_entry",
+			fills: []fill{
+				{tradeengineproto.TRADE_SIDE_BUY, 1, 100},
+				{tradeengineproto.TRADE_SIDE_BUY, 1, 200},
+			},
+			expectedQuantity:          2,
+			expectedAverageEntryPrice: 150,
+		},
+		{
+			name: "partially_close_long_realizes_pnl",
+			fills: []fill{
+				{tradeengineproto.TRADE_SIDE_BUY, 2, 100},
+				{tradeengineproto.TRADE_SIDE_SELL, 1, 120},
+			},
+			expectedQuantity:          1,
+			expectedAverageEntryPrice: 100,
+			expectedRealizedPNL:       20,
+		},
+		{
+			name: "close_short_at_a_loss",
+			fills: []fill{
+				{tradeengineproto.TRADE_SIDE_SELL, 1, 100},
+				{tradeengineproto.TRADE_SIDE_BUY, 1, 110},
+			},
+			expectedQuantity:          0,
+			expectedAverageEntryPrice: 0,
+			expectedRealizedPNL:       -10,
+		},
+		{
+			name: "flip_long_to_short",
+			fills: []fill{
+				{tradeengineproto.TRADE_SIDE_BUY, 1, 100},
+				{tradeengineproto.TRADE_SIDE_SELL, 3, 90},
+			},
+			expectedQuantity:          -2,
+			expectedAverageEntryPrice: 90,
+			expectedRealizedPNL:       -10,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			position := &domain.PaperPosition{}
+			for _, f := range tt.fills {
+				applyFill(position, f.tradeSide.String(), f.quantity, f.price)
+			}
+
+			assert.InDelta(t, tt.expectedQuantity, position.Quantity, 1e-9)
+			assert.InDelta(t, tt.expectedAverageEntryPrice, position.AverageEntryPrice, 1e-9)
+			assert.InDelta(t, tt.expectedRealizedPNL, position.RealizedPNL, 1e-9)
+		})
+	}
+}
+
+func TestReduceOnlyQuantity(t *testing.T) {
+	t.Parallel()
+
+	long := &domain.PaperPosition{Quantity: 2}
+
+	assert.InDelta(t, 1, reduceOnlyQuantity(long, tradeengineproto.TRADE_SIDE_SELL.String(), 1), 1e-9)
+	assert.InDelta(t, 2, reduceOnlyQuantity(long, tradeengineproto.TRADE_SIDE_SELL.String(), 5), 1e-9)
+	assert.InDelta(t, 0, reduceOnlyQuantity(long, tradeengineproto.TRADE_SIDE_BUY.String(), 1), 1e-9)
+}
+
+func TestIsReducing(t *testing.T) {
+	t.Parallel()
+
+	long := &domain.PaperPosition{Quantity: 2}
+
+	assert.True(t, isReducing(long, tradeengineproto.TRADE_SIDE_SELL.String(), 1))
+	assert.True(t, isReducing(long, tradeengineproto.TRADE_SIDE_SELL.String(), 2))
+	assert.False(t, isReducing(long, tradeengineproto.TRADE_SIDE_SELL.String(), 3))
+	assert.False(t, isReducing(long, tradeengineproto.TRADE_SIDE_BUY.String(), 1))
+	assert.False(t, isReducing(&domain.PaperPosition{}, tradeengineproto.TRADE_SIDE_SELL.String(), 1))
+}
+
+func TestRequiredInitialMargin(t *testing.T) {
+	t.Parallel()
+
+	positions := []*domain.PaperPosition{
+		{Quantity: 2, AverageEntryPrice: 1500},
+		{Quantity: -0.5, AverageEntryPrice: 40000},
+	}
+
+	// 3,000 + 20,000 notional at 20x.
+	assert.InDelta(t, 1150, requiredInitialMargin(positions, 20), 1e-9)
+	assert.InDelta(t, 0, requiredInitialMargin(nil, 20), 1e-9)
+}
@@ -0,0 +1,332 @@
+// Package paper is a simulated venue; orders are matched against the latest binance prices & positions & balances are
+// tracked per user in the persistence layer. Resting orders are matched each time their status is read.
+package paper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/monzo/slog"
+
+	"swallowtail/libraries/gerrors"
+	binanceproto "swallowtail/s.binance/proto"
+	"swallowtail/s.trade-engine/dao"
+	"swallowtail/s.trade-engine/domain"
+	tradeengineproto "swallowtail/s.trade-engine/proto"
+)
+
+const (
+	// defaultPaperBalance is the balance a user starts with for each quote asset.
+	defaultPaperBalance = 10_000
+
+	// paperLeverage is the fixed leverage futures positions are margined at; mirroring the default on binance futures.
+	paperLeverage = 20
+)
+
+// fetchLatestPrice is a package variable so prices can be faked in tests.
+var fetchLatestPrice = func(ctx context.Context, symbol string) (float64, error) {
+	rsp, err := (&binanceproto.GetLatestPriceRequest{
+		Symbol: symbol,
+	}).Send(ctx).Response()
+	if err != nil {
+		return 0, gerrors.Augment(err, "failed_to_fetch_latest_price", map[string]string{
+			"symbol": symbol,
+		})
+	}
+
+	return float64(rsp.Price), nil
+}
+
+// ExecuteOrder places the order on the paper venue; marketable orders are filled immediately, everything else rests.
+func ExecuteOrder(ctx context.Context, order *tradeengineproto.Order) (*tradeengineproto.Order, error) {
+	errParams := map[string]string{
+		"user_id":    order.UserId,
+		"order_type": order.OrderType.String(),
+	}
+
+	switch {
+	case order.UserId == "":
+		return nil, gerrors.BadParam("missing_param.user_id", errParams)
+	case order.Quantity <= 0:
+		return nil, gerrors.BadParam("bad_param.quantity", errParams)
+	case order.OrderType == tradeengineproto.ORDER_TYPE_TRAILING_STOP_MARKET:
+		return nil, gerrors.Unimplemented("paper_order_type_unimplemented", errParams)
+	}
+
+	paperOrder := &domain.PaperOrder{
+		UserID:         order.UserId,
+		Instrument:     symbol(order),
+		InstrumentType: order.InstrumentType.String(),
+		Asset:          strings.ToUpper(order.Asset),
+		Pair:           order.Pair.String(),
+		OrderType:      order.OrderType.String(),
+		TradeSide:      order.TradeSide.String(),
+		LimitPrice:     float64(order.LimitPrice),
+		StopPrice:      float64(order.StopPrice),
+		Quantity:       float64(order.Quantity),
+		ReduceOnly:     order.ReduceOnly,
+		Status:         domain.OrderStatusNew,
+	}
+
+	price, err := fetchLatestPrice(ctx, paperOrder.Instrument)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_execute_paper_order", errParams)
+	}
+
+	// Mirror real venues; a conditional order that would trigger immediately is rejected.
+	if isConditional(paperOrder) && isTriggered(paperOrder, price) {
+		errParams["price"] = fmt.Sprintf("%f", price)
+		return nil, gerrors.FailedPrecondition("paper_order_would_immediately_trigger", errParams)
+	}
+
+	paperOrder, err = dao.CreatePaperOrder(ctx, paperOrder)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_create_paper_order", errParams)
+	}
+
+	if isMarketable(paperOrder, price) {
+		if err := fill(ctx, paperOrder, price); err != nil {
+			if uerr := dao.UpdatePaperOrderStatus(ctx, paperOrder.ExternalOrderID, domain.OrderStatusRejected); uerr != nil {
+				slog.Error(ctx, "Failed to reject paper order: %s, Error: %v", paperOrder.ExternalOrderID, uerr)
+			}
+
+			return nil, gerrors.Augment(err, "failed_to_fill_paper_order", errParams)
+		}
+	}
+
+	order.ExternalOrderId = paperOrder.ExternalOrderID
+	order.Status = tradeengineproto.ORDER_STATUS(tradeengineproto.ORDER_STATUS_value[paperOrder.Status])
+	order.ExecutedQuantity = float32(paperOrder.ExecutedQuantity)
+
+	return order, nil
+}
+
+// ReadOrderStatus reads the current status of the paper order; if the order is resting, it's first matched against the
+// latest price.
+func ReadOrderStatus(ctx context.Context, order *tradeengineproto.Order) (*tradeengineproto.Order, error) {
+	paperOrder, err := dao.ReadPaperOrderByExternalOrderID(ctx, order.ExternalOrderId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_read_paper_order", nil)
+	}
+
+	if paperOrder.Status == domain.OrderStatusNew {
+		price, err := fetchLatestPrice(ctx, paperOrder.Instrument)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_match_paper_order", nil)
+		}
+
+		if isMarketable(paperOrder, price) {
+			err := fill(ctx, paperOrder, price)
+			switch {
+			case gerrors.IsCode(err, gerrors.ErrFailedPrecondition):
+				// The order can never be filled; i.e insufficient balance.
+				slog.Warn(ctx, "Rejecting resting paper order: %s, Error: %v", paperOrder.ExternalOrderID, err)
+
+				paperOrder.Status = domain.OrderStatusRejected
+				if err := dao.UpdatePaperOrderStatus(ctx, paperOrder.ExternalOrderID, paperOrder.Status); err != nil {
+					return nil, gerrors.Augment(err, "failed_to_reject_paper_order", nil)
+				}
+			case err != nil:
+				return nil, gerrors.Augment(err, "failed_to_match_paper_order", nil)
+			}
+		}
+	}
+
+	order.Status = tradeengineproto.ORDER_STATUS(tradeengineproto.ORDER_STATUS_value[paperOrder.Status])
+	order.ExecutedQuantity = float32(paperOrder.ExecutedQuantity)
+
+	return order, nil
+}
+
+// CancelOrder cancels a resting paper order.
+func CancelOrder(ctx context.Context, order *tradeengineproto.Order) (*tradeengineproto.Order, error) {
+	paperOrder, err := dao.ReadPaperOrderByExternalOrderID(ctx, order.ExternalOrderId)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_paper_order", nil)
+	}
+
+	if paperOrder.Status != domain.OrderStatusNew {
+		return nil, gerrors.FailedPrecondition("failed_to_cancel_paper_order.order_not_open", map[string]string{
+			"status": paperOrder.Status,
+		})
+	}
+
+	if err := dao.UpdatePaperOrderStatus(ctx, paperOrder.ExternalOrderID, domain.OrderStatusCancelled); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_cancel_paper_order", nil)
+	}
+
+	order.Status = tradeengineproto.ORDER_STATUS_CANCELLED_ORDER
+
+	return order, nil
+}
+
+// ReadBalance reads the users paper balance of the given asset; seeding the balance if this is the first time it's used.
+func ReadBalance(ctx context.Context, userID, asset string) (float64, error) {
+	if err := seedBalance(ctx, userID, asset); err != nil {
+		return 0, gerrors.Augment(err, "failed_to_read_paper_balance", nil)
+	}
+
+	balance, err := dao.ReadPaperBalance(ctx, userID, strings.ToUpper(asset))
+	if err != nil {
+		return 0, gerrors.Augment(err, "failed_to_read_paper_balance", nil)
+	}
+
+	return balance.Balance, nil
+}
+
+// fill fills the full quantity of the order at the given price; persisting the order, position & balances.
+func fill(ctx context.Context, order *domain.PaperOrder, price float64) error {
+	errParams := map[string]string{
+		"external_order_id": order.ExternalOrderID,
+		"instrument":        order.Instrument,
+	}
+
+	if err := seedBalance(ctx, order.UserID, order.Pair); err != nil {
+		return gerrors.Augment(err, "failed_to_fill_paper_order.seed_balance", errParams)
+	}
+
+	quoteBalance, err := dao.ReadPaperBalance(ctx, order.UserID, order.Pair)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_fill_paper_order.read_balance", errParams)
+	}
+
+	if order.InstrumentType == tradeengineproto.INSTRUMENT_TYPE_SPOT.String() {
+		return fillSpot(ctx, order, price, quoteBalance.Balance)
+	}
+
+	var previousLastUpdated time.Time
+	position, err := dao.ReadPaperPosition(ctx, order.UserID, order.Instrument, order.InstrumentType)
+	switch {
+	case gerrors.Is(err, gerrors.ErrNotFound, "not_found.paper_position"):
+		position = &domain.PaperPosition{
+			UserID:         order.UserID,
+			Instrument:     order.Instrument,
+			InstrumentType: order.InstrumentType,
+		}
+	case err != nil:
+		return gerrors.Augment(err, "failed_to_fill_paper_order.read_position", errParams)
+	default:
+		previousLastUpdated = position.LastUpdated
+	}
+
+	quantity := order.Quantity
+	if order.ReduceOnly {
+		quantity = reduceOnlyQuantity(position, order.TradeSide, quantity)
+	}
+
+	// As on real venues, a fill that opens exposure must be covered by the initial margin of every open position margined
+	// in the same quote asset; a fill that only reduces the position needs no margin.
+	if !order.ReduceOnly && !isReducing(position, order.TradeSide, quantity) {
+		openPositions, err := dao.ListOpenPaperPositionsByUserID(ctx, order.UserID, order.InstrumentType)
+		if err != nil {
+			return gerrors.Augment(err, "failed_to_fill_paper_order.list_positions", errParams)
+		}
+
+		projectedPosition := *position
+		applyFill(&projectedPosition, order.TradeSide, quantity, price)
+
+		marginedPositions := []*domain.PaperPosition{&projectedPosition}
+		for _, p := range openPositions {
+			if p.Instrument != position.Instrument && strings.HasSuffix(p.Instrument, order.Pair) {
+				marginedPositions = append(marginedPositions, p)
+			}
+		}
+
+		if requiredMargin := requiredInitialMargin(marginedPositions, paperLeverage); requiredMargin > quoteBalance.Balance {
+			errParams["required_margin"] = fmt.Sprintf("%f", requiredMargin)
+			errParams["balance"] = fmt.Sprintf("%f", quoteBalance.Balance)
+			return gerrors.FailedPrecondition("failed_to_fill_paper_order.insufficient_margin", errParams)
+		}
+	}
+
+	// There's nothing left for a reduce only order to reduce; as on real venues the order is expired.
+	if quantity == 0 {
+		order.Status = domain.OrderStatusExpired
+		if err := dao.UpdatePaperOrderStatus(ctx, order.ExternalOrderID, order.Status); err != nil {
+			return gerrors.Augment(err, "failed_to_fill_paper_order.expire", errParams)
+		}
+
+		return nil
+	}
+
+	realizedPNL := applyFill(position, order.TradeSide, quantity, price)
+
+	order.ExecutedQuantity = quantity
+	order.AverageFillPrice = price
+	order.Status = domain.OrderStatusFilled
+
+	if err := dao.FillPaperOrder(ctx, order, position, previousLastUpdated, map[string]float64{
+		order.Pair: realizedPNL,
+	}); err != nil {
+		return gerrors.Augment(err, "failed_to_fill_paper_order", errParams)
+	}
+
+	slog.Info(ctx, "Paper order filled: %s %s %s %f @ %f, realized pnl: %f", order.UserID, order.Instrument, order.TradeSide, quantity, price, realizedPNL)
+
+	return nil
+}
+
+// fillSpot fills a spot order; spot holdings are tracked purely as balances.
+func fillSpot(ctx context.Context, order *domain.PaperOrder, price, quoteBalance float64) error {
+	errParams := map[string]string{
+		"external_order_id": order.ExternalOrderID,
+		"instrument":        order.Instrument,
+	}
+
+	if err := seedBalance(ctx, order.UserID, order.Asset); err != nil {
+		return gerrors.Augment(err, "failed_to_fill_paper_order.seed_balance", errParams)
+	}
+
+	baseBalance, err := dao.ReadPaperBalance(ctx, order.UserID, order.Asset)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_fill_paper_order.read_balance", errParams)
+	}
+
+	notional := order.Quantity * price
+
+	var balanceDeltas map[string]float64
+	switch {
+	case isBuy(order.TradeSide) && quoteBalance < notional:
+		return gerrors.FailedPrecondition("failed_to_fill_paper_order.insufficient_balance", errParams)
+	case isBuy(order.TradeSide):
+		balanceDeltas = map[string]float64{order.Pair: -notional, order.Asset: order.Quantity}
+	case baseBalance.Balance < order.Quantity:
+		return gerrors.FailedPrecondition("failed_to_fill_paper_order.insufficient_balance", errParams)
+	default:
+		balanceDeltas = map[string]float64{order.Pair: notional, order.Asset: -order.Quantity}
+	}
+
+	order.ExecutedQuantity = order.Quantity
+	order.AverageFillPrice = price
+	order.Status = domain.OrderStatusFilled
+
+	if err := dao.FillPaperOrder(ctx, order, nil, time.Time{}, balanceDeltas); err != nil {
+		return gerrors.Augment(err, "failed_to_fill_paper_order", errParams)
+	}
+
+	slog.Info(ctx, "Paper spot order filled: %s %s %s %f @ %f", order.UserID, order.Instrument, order.TradeSide, order.Quantity, price)
+
+	return nil
+}
+
+// seedBalance seeds the users balance of the given asset; quote currencies start with the default balance, everything else
+// starts empty.
+func seedBalance(ctx context.Context, userID, asset string) error {
+	var balance float64
+	switch strings.ToUpper(asset) {
+	case tradeengineproto.TRADE_PAIR_USDT.String(), tradeengineproto.TRADE_PAIR_USD.String(), tradeengineproto.TRADE_PAIR_USDC.String():
+		balance = defaultPaperBalance
+	}
+
+	return dao.CreatePaperBalanceIfNotExists(ctx, userID, strings.ToUpper(asset), balance)
+}
+
+func symbol(order *tradeengineproto.Order) string {
+	if order.Instrument != "" {
+		return strings.ToUpper(order.Instrument)
+	}
+
+	return fmt.Sprintf("%s%s", strings.ToUpper(order.Asset), order.Pair.String())
+}
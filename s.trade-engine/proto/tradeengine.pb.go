@@ -29,6 +29,7 @@ const (
 	VENUE_FTX        VENUE = 2
 	VENUE_DERIBIT    VENUE = 3
 	VENUE_BITFINEX   VENUE = 4
+	VENUE_PAPER      VENUE = 5
 )
 
 // Enum value maps for VENUE.
@@ -39,6 +40,7 @@ var (
 		2: "FTX",
 		3: "DERIBIT",
 		4: "BITFINEX",
+		5: "PAPER",
 	}
 	VENUE_value = map[string]int32{
 		"UNREQUIRED": 0,
@@ -46,6 +48,7 @@ var (
 		"FTX":        2,
 		"DERIBIT":    3,
 		"BITFINEX":   4,
+		"PAPER":      5,
 	}
 )
 
@@ -244,6 +247,55 @@ func (ORDER_STATUS) EnumDescriptor() ([]byte, []int) {
 	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{3}
 }
 
+type EXECUTION_ERROR_CLASS int32
+
+const (
+	EXECUTION_ERROR_CLASS_UNCLASSIFIED_EXECUTION_ERROR EXECUTION_ERROR_CLASS = 0
+	EXECUTION_ERROR_CLASS_RETRYABLE_EXECUTION_ERROR    EXECUTION_ERROR_CLASS = 1
+	EXECUTION_ERROR_CLASS_TERMINAL_EXECUTION_ERROR     EXECUTION_ERROR_CLASS = 2
+)
+
+// Enum value maps for EXECUTION_ERROR_CLASS.
+var (
+	EXECUTION_ERROR_CLASS_name = map[int32]string{
+		0: "UNCLASSIFIED_EXECUTION_ERROR",
+		1: "RETRYABLE_EXECUTION_ERROR",
+		2: "TERMINAL_EXECUTION_ERROR",
+	}
+	EXECUTION_ERROR_CLASS_value = map[string]int32{
+		"UNCLASSIFIED_EXECUTION_ERROR": 0,
+		"RETRYABLE_EXECUTION_ERROR":    1,
+		"TERMINAL_EXECUTION_ERROR":     2,
+	}
+)
+
+func (x EXECUTION_ERROR_CLASS) Enum() *EXECUTION_ERROR_CLASS {
+	p := new(EXECUTION_ERROR_CLASS)
+	*p = x
+	return p
+}
+
+func (x EXECUTION_ERROR_CLASS) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EXECUTION_ERROR_CLASS) Descriptor() protoreflect.EnumDescriptor {
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[4].Descriptor()
+}
+
+func (EXECUTION_ERROR_CLASS) Type() protoreflect.EnumType {
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[4]
+}
+
+func (x EXECUTION_ERROR_CLASS) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EXECUTION_ERROR_CLASS.Descriptor instead.
+func (EXECUTION_ERROR_CLASS) EnumDescriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{4}
+}
+
 type TRADE_STRATEGY_STATUS int32
 
 const (
@@ -280,11 +332,11 @@ func (x TRADE_STRATEGY_STATUS) String() string {
 }
 
 func (TRADE_STRATEGY_STATUS) Descriptor() protoreflect.EnumDescriptor {
-	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[4].Descriptor()
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[5].Descriptor()
 }
 
 func (TRADE_STRATEGY_STATUS) Type() protoreflect.EnumType {
-	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[4]
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[5]
 }
 
 func (x TRADE_STRATEGY_STATUS) Number() protoreflect.EnumNumber {
@@ -293,7 +345,7 @@ func (x TRADE_STRATEGY_STATUS) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TRADE_STRATEGY_STATUS.Descriptor instead.
 func (TRADE_STRATEGY_STATUS) EnumDescriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{4}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{5}
 }
 
 type INSTRUMENT_TYPE int32
@@ -338,11 +390,11 @@ func (x INSTRUMENT_TYPE) String() string {
 }
 
 func (INSTRUMENT_TYPE) Descriptor() protoreflect.EnumDescriptor {
-	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[5].Descriptor()
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[6].Descriptor()
 }
 
 func (INSTRUMENT_TYPE) Type() protoreflect.EnumType {
-	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[5]
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[6]
 }
 
 func (x INSTRUMENT_TYPE) Number() protoreflect.EnumNumber {
@@ -351,7 +403,7 @@ func (x INSTRUMENT_TYPE) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use INSTRUMENT_TYPE.Descriptor instead.
 func (INSTRUMENT_TYPE) EnumDescriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{5}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{6}
 }
 
 type TRADE_PAIR int32
@@ -393,11 +445,11 @@ func (x TRADE_PAIR) String() string {
 }
 
 func (TRADE_PAIR) Descriptor() protoreflect.EnumDescriptor {
-	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[6].Descriptor()
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[7].Descriptor()
 }
 
 func (TRADE_PAIR) Type() protoreflect.EnumType {
-	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[6]
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[7]
 }
 
 func (x TRADE_PAIR) Number() protoreflect.EnumNumber {
@@ -406,7 +458,7 @@ func (x TRADE_PAIR) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TRADE_PAIR.Descriptor instead.
 func (TRADE_PAIR) EnumDescriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{6}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{7}
 }
 
 type ORDER_TYPE int32
@@ -454,11 +506,11 @@ func (x ORDER_TYPE) String() string {
 }
 
 func (ORDER_TYPE) Descriptor() protoreflect.EnumDescriptor {
-	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[7].Descriptor()
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[8].Descriptor()
 }
 
 func (ORDER_TYPE) Type() protoreflect.EnumType {
-	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[7]
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[8]
 }
 
 func (x ORDER_TYPE) Number() protoreflect.EnumNumber {
@@ -467,7 +519,7 @@ func (x ORDER_TYPE) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ORDER_TYPE.Descriptor instead.
 func (ORDER_TYPE) EnumDescriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{7}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{8}
 }
 
 type TIME_IN_FORCE int32
@@ -509,11 +561,11 @@ func (x TIME_IN_FORCE) String() string {
 }
 
 func (TIME_IN_FORCE) Descriptor() protoreflect.EnumDescriptor {
-	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[8].Descriptor()
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[9].Descriptor()
 }
 
 func (TIME_IN_FORCE) Type() protoreflect.EnumType {
-	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[8]
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[9]
 }
 
 func (x TIME_IN_FORCE) Number() protoreflect.EnumNumber {
@@ -522,7 +574,7 @@ func (x TIME_IN_FORCE) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TIME_IN_FORCE.Descriptor instead.
 func (TIME_IN_FORCE) EnumDescriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{8}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{9}
 }
 
 type WORKING_TYPE int32
@@ -558,11 +610,11 @@ func (x WORKING_TYPE) String() string {
 }
 
 func (WORKING_TYPE) Descriptor() protoreflect.EnumDescriptor {
-	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[9].Descriptor()
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[10].Descriptor()
 }
 
 func (WORKING_TYPE) Type() protoreflect.EnumType {
-	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[9]
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[10]
 }
 
 func (x WORKING_TYPE) Number() protoreflect.EnumNumber {
@@ -571,7 +623,7 @@ func (x WORKING_TYPE) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WORKING_TYPE.Descriptor instead.
 func (WORKING_TYPE) EnumDescriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{9}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{10}
 }
 
 type EXECUTION_STRATEGY int32
@@ -616,11 +668,11 @@ func (x EXECUTION_STRATEGY) String() string {
 }
 
 func (EXECUTION_STRATEGY) Descriptor() protoreflect.EnumDescriptor {
-	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[10].Descriptor()
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[11].Descriptor()
 }
 
 func (EXECUTION_STRATEGY) Type() protoreflect.EnumType {
-	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[10]
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[11]
 }
 
 func (x EXECUTION_STRATEGY) Number() protoreflect.EnumNumber {
@@ -629,7 +681,7 @@ func (x EXECUTION_STRATEGY) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EXECUTION_STRATEGY.Descriptor instead.
 func (EXECUTION_STRATEGY) EnumDescriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{10}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{11}
 }
 
 type DCA_EXECUTION_STRATEGY int32
@@ -665,11 +717,11 @@ func (x DCA_EXECUTION_STRATEGY) String() string {
 }
 
 func (DCA_EXECUTION_STRATEGY) Descriptor() protoreflect.EnumDescriptor {
-	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[11].Descriptor()
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[12].Descriptor()
 }
 
 func (DCA_EXECUTION_STRATEGY) Type() protoreflect.EnumType {
-	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[11]
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[12]
 }
 
 func (x DCA_EXECUTION_STRATEGY) Number() protoreflect.EnumNumber {
@@ -678,7 +730,103 @@ func (x DCA_EXECUTION_STRATEGY) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DCA_EXECUTION_STRATEGY.Descriptor instead.
 func (DCA_EXECUTION_STRATEGY) EnumDescriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{11}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{12}
+}
+
+type CALLER_PERFORMANCE_SORT int32
+
+const (
+	// Ranks callers by their average R-multiple per trade.
+	CALLER_PERFORMANCE_SORT_EXPECTANCY CALLER_PERFORMANCE_SORT = 0
+	// Ranks callers by the percentage of their trades that closed in profit.
+	CALLER_PERFORMANCE_SORT_WIN_RATE CALLER_PERFORMANCE_SORT = 1
+)
+
+// Enum value maps for CALLER_PERFORMANCE_SORT.
+var (
+	CALLER_PERFORMANCE_SORT_name = map[int32]string{
+		0: "EXPECTANCY",
+		1: "WIN_RATE",
+	}
+	CALLER_PERFORMANCE_SORT_value = map[string]int32{
+		"EXPECTANCY": 0,
+		"WIN_RATE":   1,
+	}
+)
+
+func (x CALLER_PERFORMANCE_SORT) Enum() *CALLER_PERFORMANCE_SORT {
+	p := new(CALLER_PERFORMANCE_SORT)
+	*p = x
+	return p
+}
+
+func (x CALLER_PERFORMANCE_SORT) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CALLER_PERFORMANCE_SORT) Descriptor() protoreflect.EnumDescriptor {
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[13].Descriptor()
+}
+
+func (CALLER_PERFORMANCE_SORT) Type() protoreflect.EnumType {
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[13]
+}
+
+func (x CALLER_PERFORMANCE_SORT) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CALLER_PERFORMANCE_SORT.Descriptor instead.
+func (CALLER_PERFORMANCE_SORT) EnumDescriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{13}
+}
+
+type CIRCUIT_BREAKER_SCOPE int32
+
+const (
+	// Halts trading for every user.
+	CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER CIRCUIT_BREAKER_SCOPE = 0
+	// Halts trading for a single user.
+	CIRCUIT_BREAKER_SCOPE_USER_CIRCUIT_BREAKER CIRCUIT_BREAKER_SCOPE = 1
+)
+
+// Enum value maps for CIRCUIT_BREAKER_SCOPE.
+var (
+	CIRCUIT_BREAKER_SCOPE_name = map[int32]string{
+		0: "SYSTEM_CIRCUIT_BREAKER",
+		1: "USER_CIRCUIT_BREAKER",
+	}
+	CIRCUIT_BREAKER_SCOPE_value = map[string]int32{
+		"SYSTEM_CIRCUIT_BREAKER": 0,
+		"USER_CIRCUIT_BREAKER":   1,
+	}
+)
+
+func (x CIRCUIT_BREAKER_SCOPE) Enum() *CIRCUIT_BREAKER_SCOPE {
+	p := new(CIRCUIT_BREAKER_SCOPE)
+	*p = x
+	return p
+}
+
+func (x CIRCUIT_BREAKER_SCOPE) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CIRCUIT_BREAKER_SCOPE) Descriptor() protoreflect.EnumDescriptor {
+	return file_s_trade_engine_proto_tradeengine_proto_enumTypes[14].Descriptor()
+}
+
+func (CIRCUIT_BREAKER_SCOPE) Type() protoreflect.EnumType {
+	return &file_s_trade_engine_proto_tradeengine_proto_enumTypes[14]
+}
+
+func (x CIRCUIT_BREAKER_SCOPE) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CIRCUIT_BREAKER_SCOPE.Descriptor instead.
+func (CIRCUIT_BREAKER_SCOPE) EnumDescriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{14}
 }
 
 type Order struct {
@@ -706,6 +854,16 @@ type Order struct {
 	ExternalOrderId    string          `protobuf:"bytes,17,opt,name=external_order_id,json=externalOrderId,proto3" json:"external_order_id,omitempty"`
 	ClosePosition      bool            `protobuf:"varint,18,opt,name=close_position,json=closePosition,proto3" json:"close_position,omitempty"`
 	InstrumentType     INSTRUMENT_TYPE `protobuf:"varint,19,opt,name=instrument_type,json=instrumentType,proto3,enum=INSTRUMENT_TYPE" json:"instrument_type,omitempty"`
+	// Lifecycle metadata; only set on orders read back from the trade engine.
+	Status               ORDER_STATUS `protobuf:"varint,22,opt,name=status,proto3,enum=ORDER_STATUS" json:"status,omitempty"`
+	TradeStrategyId      string       `protobuf:"bytes,23,opt,name=trade_strategy_id,json=tradeStrategyId,proto3" json:"trade_strategy_id,omitempty"`
+	UserId               string       `protobuf:"bytes,24,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExecutedQuantity     float32      `protobuf:"fixed32,25,opt,name=executed_quantity,json=executedQuantity,proto3" json:"executed_quantity,omitempty"`
+	FailureReason        string       `protobuf:"bytes,26,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	LastUpdatedTimestamp int64        `protobuf:"varint,27,opt,name=last_updated_timestamp,json=lastUpdatedTimestamp,proto3" json:"last_updated_timestamp,omitempty"`
+	// Only used by trailing stop orders; the distance the stop trails the best price by, as a percentage. Venues that trail
+	// by an absolute price apply the percentage to the stop price.
+	TrailingPercentage float32 `protobuf:"fixed32,28,opt,name=trailing_percentage,json=trailingPercentage,proto3" json:"trailing_percentage,omitempty"`
 }
 
 func (x *Order) Reset() {
@@ -880,6 +1038,55 @@ func (x *Order) GetInstrumentType() INSTRUMENT_TYPE {
 	return INSTRUMENT_TYPE_SPOT
 }
 
+func (x *Order) GetStatus() ORDER_STATUS {
+	if x != nil {
+		return x.Status
+	}
+	return ORDER_STATUS_PENDING_NEW_ORDER
+}
+
+func (x *Order) GetTradeStrategyId() string {
+	if x != nil {
+		return x.TradeStrategyId
+	}
+	return ""
+}
+
+func (x *Order) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Order) GetExecutedQuantity() float32 {
+	if x != nil {
+		return x.ExecutedQuantity
+	}
+	return 0
+}
+
+func (x *Order) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Order) GetLastUpdatedTimestamp() int64 {
+	if x != nil {
+		return x.LastUpdatedTimestamp
+	}
+	return 0
+}
+
+func (x *Order) GetTrailingPercentage() float32 {
+	if x != nil {
+		return x.TrailingPercentage
+	}
+	return 0
+}
+
 type TradeStrategy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -904,6 +1111,9 @@ type TradeStrategy struct {
 	TradeSide          TRADE_SIDE             `protobuf:"varint,17,opt,name=trade_side,json=tradeSide,proto3,enum=TRADE_SIDE" json:"trade_side,omitempty"`
 	CurrentPrice       float32                `protobuf:"fixed32,18,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
 	TradeableVenues    []VENUE                `protobuf:"varint,20,rep,packed,name=tradeable_venues,json=tradeableVenues,proto3,enum=VENUE" json:"tradeable_venues,omitempty"`
+	// The percentage of the position each take profit closes, in the same order as the take profits; they must sum to
+	// 100. If unset the participants default allocations are used, else the position is split evenly.
+	TakeProfitAllocations []float32 `protobuf:"fixed32,21,rep,packed,name=take_profit_allocations,json=takeProfitAllocations,proto3" json:"take_profit_allocations,omitempty"`
 }
 
 func (x *TradeStrategy) Reset() {
@@ -1071,6 +1281,13 @@ func (x *TradeStrategy) GetTradeableVenues() []VENUE {
 	return nil
 }
 
+func (x *TradeStrategy) GetTakeProfitAllocations() []float32 {
+	if x != nil {
+		return x.TakeProfitAllocations
+	}
+	return nil
+}
+
 type CreateTradeStrategyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1185,6 +1402,13 @@ type ExecuteTradeStrategyForParticipantRequest struct {
 	Venue           VENUE   `protobuf:"varint,6,opt,name=venue,proto3,enum=VENUE" json:"venue,omitempty"`
 	IsBot           bool    `protobuf:"varint,7,opt,name=is_bot,json=isBot,proto3" json:"is_bot,omitempty"`
 	Risk            float32 `protobuf:"fixed32,8,opt,name=risk,proto3" json:"risk,omitempty"`
+	// Only used by scheduled execution strategies i.e TWAP; defaults are applied if unset.
+	ExecutionHorizonInMinutes int64 `protobuf:"varint,9,opt,name=execution_horizon_in_minutes,json=executionHorizonInMinutes,proto3" json:"execution_horizon_in_minutes,omitempty"`
+	NumberOfChildOrders       int64 `protobuf:"varint,10,opt,name=number_of_child_orders,json=numberOfChildOrders,proto3" json:"number_of_child_orders,omitempty"`
+	// Position management; once the first take profit fills the stop loss is moved to the average entry price, and/or
+	// trails the best price by the given percentage. Both are optional.
+	MoveStopToBreakEven    bool    `protobuf:"varint,11,opt,name=move_stop_to_break_even,json=moveStopToBreakEven,proto3" json:"move_stop_to_break_even,omitempty"`
+	TrailingStopPercentage float32 `protobuf:"fixed32,12,opt,name=trailing_stop_percentage,json=trailingStopPercentage,proto3" json:"trailing_stop_percentage,omitempty"`
 }
 
 func (x *ExecuteTradeStrategyForParticipantRequest) Reset() {
@@ -1268,13 +1492,46 @@ func (x *ExecuteTradeStrategyForParticipantRequest) GetRisk() float32 {
 	return 0
 }
 
+func (x *ExecuteTradeStrategyForParticipantRequest) GetExecutionHorizonInMinutes() int64 {
+	if x != nil {
+		return x.ExecutionHorizonInMinutes
+	}
+	return 0
+}
+
+func (x *ExecuteTradeStrategyForParticipantRequest) GetNumberOfChildOrders() int64 {
+	if x != nil {
+		return x.NumberOfChildOrders
+	}
+	return 0
+}
+
+func (x *ExecuteTradeStrategyForParticipantRequest) GetMoveStopToBreakEven() bool {
+	if x != nil {
+		return x.MoveStopToBreakEven
+	}
+	return false
+}
+
+func (x *ExecuteTradeStrategyForParticipantRequest) GetTrailingStopPercentage() float32 {
+	if x != nil {
+		return x.TrailingStopPercentage
+	}
+	return 0
+}
+
 type ExecutionError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	FailedOrder  *Order `protobuf:"bytes,2,opt,name=failed_order,json=failedOrder,proto3" json:"failed_order,omitempty"`
+	ErrorMessage         string                `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	FailedOrder          *Order                `protobuf:"bytes,2,opt,name=failed_order,json=failedOrder,proto3" json:"failed_order,omitempty"`
+	ErrorClass           EXECUTION_ERROR_CLASS `protobuf:"varint,3,opt,name=error_class,json=errorClass,proto3,enum=EXECUTION_ERROR_CLASS" json:"error_class,omitempty"`
+	Attempts             int64                 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	RolledBackOrders     []*Order              `protobuf:"bytes,5,rep,name=rolled_back_orders,json=rolledBackOrders,proto3" json:"rolled_back_orders,omitempty"`
+	FailedRollbackOrders []*Order              `protobuf:"bytes,6,rep,name=failed_rollback_orders,json=failedRollbackOrders,proto3" json:"failed_rollback_orders,omitempty"`
+	ParticipantFlat      bool                  `protobuf:"varint,7,opt,name=participant_flat,json=participantFlat,proto3" json:"participant_flat,omitempty"`
 }
 
 func (x *ExecutionError) Reset() {
@@ -1323,6 +1580,41 @@ func (x *ExecutionError) GetFailedOrder() *Order {
 	return nil
 }
 
+func (x *ExecutionError) GetErrorClass() EXECUTION_ERROR_CLASS {
+	if x != nil {
+		return x.ErrorClass
+	}
+	return EXECUTION_ERROR_CLASS_UNCLASSIFIED_EXECUTION_ERROR
+}
+
+func (x *ExecutionError) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ExecutionError) GetRolledBackOrders() []*Order {
+	if x != nil {
+		return x.RolledBackOrders
+	}
+	return nil
+}
+
+func (x *ExecutionError) GetFailedRollbackOrders() []*Order {
+	if x != nil {
+		return x.FailedRollbackOrders
+	}
+	return nil
+}
+
+func (x *ExecutionError) GetParticipantFlat() bool {
+	if x != nil {
+		return x.ParticipantFlat
+	}
+	return false
+}
+
 type ExecuteTradeStrategyForParticipantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1340,6 +1632,7 @@ type ExecuteTradeStrategyForParticipantResponse struct {
 	Pair                   TRADE_PAIR             `protobuf:"varint,10,opt,name=pair,proto3,enum=TRADE_PAIR" json:"pair,omitempty"`
 	Instrument             string                 `protobuf:"bytes,11,opt,name=instrument,proto3" json:"instrument,omitempty"`
 	InstrumentType         INSTRUMENT_TYPE        `protobuf:"varint,12,opt,name=instrument_type,json=instrumentType,proto3,enum=INSTRUMENT_TYPE" json:"instrument_type,omitempty"`
+	ExecutionSchedule      *ExecutionSchedule     `protobuf:"bytes,13,opt,name=execution_schedule,json=executionSchedule,proto3" json:"execution_schedule,omitempty"`
 }
 
 func (x *ExecuteTradeStrategyForParticipantResponse) Reset() {
@@ -1458,16 +1751,32 @@ func (x *ExecuteTradeStrategyForParticipantResponse) GetInstrumentType() INSTRUM
 	return INSTRUMENT_TYPE_SPOT
 }
 
-type ReadTradeStrategyByTradeStrategyIDRequest struct {
+func (x *ExecuteTradeStrategyForParticipantResponse) GetExecutionSchedule() *ExecutionSchedule {
+	if x != nil {
+		return x.ExecutionSchedule
+	}
+	return nil
+}
+
+type ExecutionSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TradeStrategyId string `protobuf:"bytes,1,opt,name=trade_strategy_id,json=tradeStrategyId,proto3" json:"trade_strategy_id,omitempty"`
+	ExecutionScheduleId         string                 `protobuf:"bytes,1,opt,name=execution_schedule_id,json=executionScheduleId,proto3" json:"execution_schedule_id,omitempty"`
+	ExecutionStrategy           EXECUTION_STRATEGY     `protobuf:"varint,2,opt,name=execution_strategy,json=executionStrategy,proto3,enum=EXECUTION_STRATEGY" json:"execution_strategy,omitempty"`
+	NumberOfChildOrders         int64                  `protobuf:"varint,3,opt,name=number_of_child_orders,json=numberOfChildOrders,proto3" json:"number_of_child_orders,omitempty"`
+	NumberOfExecutedChildOrders int64                  `protobuf:"varint,4,opt,name=number_of_executed_child_orders,json=numberOfExecutedChildOrders,proto3" json:"number_of_executed_child_orders,omitempty"`
+	NumberOfFailedChildOrders   int64                  `protobuf:"varint,5,opt,name=number_of_failed_child_orders,json=numberOfFailedChildOrders,proto3" json:"number_of_failed_child_orders,omitempty"`
+	TotalQuantity               float32                `protobuf:"fixed32,6,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	ExecutedQuantity            float32                `protobuf:"fixed32,7,opt,name=executed_quantity,json=executedQuantity,proto3" json:"executed_quantity,omitempty"`
+	Start                       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
+	End                         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end,proto3" json:"end,omitempty"`
+	NextChildOrder              *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_child_order,json=nextChildOrder,proto3" json:"next_child_order,omitempty"`
 }
 
-func (x *ReadTradeStrategyByTradeStrategyIDRequest) Reset() {
-	*x = ReadTradeStrategyByTradeStrategyIDRequest{}
+func (x *ExecutionSchedule) Reset() {
+	*x = ExecutionSchedule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1475,13 +1784,13 @@ func (x *ReadTradeStrategyByTradeStrategyIDRequest) Reset() {
 	}
 }
 
-func (x *ReadTradeStrategyByTradeStrategyIDRequest) String() string {
+func (x *ExecutionSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadTradeStrategyByTradeStrategyIDRequest) ProtoMessage() {}
+func (*ExecutionSchedule) ProtoMessage() {}
 
-func (x *ReadTradeStrategyByTradeStrategyIDRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecutionSchedule) ProtoReflect() protoreflect.Message {
 	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1493,42 +1802,105 @@ func (x *ReadTradeStrategyByTradeStrategyIDRequest) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadTradeStrategyByTradeStrategyIDRequest.ProtoReflect.Descriptor instead.
-func (*ReadTradeStrategyByTradeStrategyIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecutionSchedule.ProtoReflect.Descriptor instead.
+func (*ExecutionSchedule) Descriptor() ([]byte, []int) {
 	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ReadTradeStrategyByTradeStrategyIDRequest) GetTradeStrategyId() string {
+func (x *ExecutionSchedule) GetExecutionScheduleId() string {
 	if x != nil {
-		return x.TradeStrategyId
+		return x.ExecutionScheduleId
 	}
 	return ""
 }
 
-type ReadTradeStrategyByTradeStrategyIDResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ExecutionSchedule) GetExecutionStrategy() EXECUTION_STRATEGY {
+	if x != nil {
+		return x.ExecutionStrategy
+	}
+	return EXECUTION_STRATEGY_DMA_LIMIT
+}
 
-	TradeStrategy *TradeStrategy `protobuf:"bytes,1,opt,name=trade_strategy,json=tradeStrategy,proto3" json:"trade_strategy,omitempty"`
+func (x *ExecutionSchedule) GetNumberOfChildOrders() int64 {
+	if x != nil {
+		return x.NumberOfChildOrders
+	}
+	return 0
 }
 
-func (x *ReadTradeStrategyByTradeStrategyIDResponse) Reset() {
-	*x = ReadTradeStrategyByTradeStrategyIDResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ExecutionSchedule) GetNumberOfExecutedChildOrders() int64 {
+	if x != nil {
+		return x.NumberOfExecutedChildOrders
 	}
+	return 0
 }
 
-func (x *ReadTradeStrategyByTradeStrategyIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ExecutionSchedule) GetNumberOfFailedChildOrders() int64 {
+	if x != nil {
+		return x.NumberOfFailedChildOrders
+	}
+	return 0
 }
 
-func (*ReadTradeStrategyByTradeStrategyIDResponse) ProtoMessage() {}
+func (x *ExecutionSchedule) GetTotalQuantity() float32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
 
-func (x *ReadTradeStrategyByTradeStrategyIDResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecutionSchedule) GetExecutedQuantity() float32 {
+	if x != nil {
+		return x.ExecutedQuantity
+	}
+	return 0
+}
+
+func (x *ExecutionSchedule) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ExecutionSchedule) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ExecutionSchedule) GetNextChildOrder() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextChildOrder
+	}
+	return nil
+}
+
+type ReadTradeStrategyByTradeStrategyIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeStrategyId string `protobuf:"bytes,1,opt,name=trade_strategy_id,json=tradeStrategyId,proto3" json:"trade_strategy_id,omitempty"`
+}
+
+func (x *ReadTradeStrategyByTradeStrategyIDRequest) Reset() {
+	*x = ReadTradeStrategyByTradeStrategyIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadTradeStrategyByTradeStrategyIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTradeStrategyByTradeStrategyIDRequest) ProtoMessage() {}
+
+func (x *ReadTradeStrategyByTradeStrategyIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1540,9 +1912,56 @@ func (x *ReadTradeStrategyByTradeStrategyIDResponse) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Use ReadTradeStrategyByTradeStrategyIDRequest.ProtoReflect.Descriptor instead.
+func (*ReadTradeStrategyByTradeStrategyIDRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReadTradeStrategyByTradeStrategyIDRequest) GetTradeStrategyId() string {
+	if x != nil {
+		return x.TradeStrategyId
+	}
+	return ""
+}
+
+type ReadTradeStrategyByTradeStrategyIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeStrategy *TradeStrategy `protobuf:"bytes,1,opt,name=trade_strategy,json=tradeStrategy,proto3" json:"trade_strategy,omitempty"`
+}
+
+func (x *ReadTradeStrategyByTradeStrategyIDResponse) Reset() {
+	*x = ReadTradeStrategyByTradeStrategyIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadTradeStrategyByTradeStrategyIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTradeStrategyByTradeStrategyIDResponse) ProtoMessage() {}
+
+func (x *ReadTradeStrategyByTradeStrategyIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
 // Deprecated: Use ReadTradeStrategyByTradeStrategyIDResponse.ProtoReflect.Descriptor instead.
 func (*ReadTradeStrategyByTradeStrategyIDResponse) Descriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{8}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ReadTradeStrategyByTradeStrategyIDResponse) GetTradeStrategy() *TradeStrategy {
@@ -1569,7 +1988,7 @@ type VenueCredentials struct {
 func (x *VenueCredentials) Reset() {
 	*x = VenueCredentials{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[9]
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1582,7 +2001,7 @@ func (x *VenueCredentials) String() string {
 func (*VenueCredentials) ProtoMessage() {}
 
 func (x *VenueCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[9]
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1595,7 +2014,7 @@ func (x *VenueCredentials) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VenueCredentials.ProtoReflect.Descriptor instead.
 func (*VenueCredentials) Descriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{9}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *VenueCredentials) GetVenue() VENUE {
@@ -1656,7 +2075,7 @@ type ListAvailableVenuesRequest struct {
 func (x *ListAvailableVenuesRequest) Reset() {
 	*x = ListAvailableVenuesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[10]
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1669,7 +2088,7 @@ func (x *ListAvailableVenuesRequest) String() string {
 func (*ListAvailableVenuesRequest) ProtoMessage() {}
 
 func (x *ListAvailableVenuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[10]
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,7 +2101,7 @@ func (x *ListAvailableVenuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAvailableVenuesRequest.ProtoReflect.Descriptor instead.
 func (*ListAvailableVenuesRequest) Descriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{10}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{11}
 }
 
 type ListAvailableVenuesResponse struct {
@@ -1696,7 +2115,7 @@ type ListAvailableVenuesResponse struct {
 func (x *ListAvailableVenuesResponse) Reset() {
 	*x = ListAvailableVenuesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[11]
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1709,7 +2128,7 @@ func (x *ListAvailableVenuesResponse) String() string {
 func (*ListAvailableVenuesResponse) ProtoMessage() {}
 
 func (x *ListAvailableVenuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[11]
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1722,7 +2141,7 @@ func (x *ListAvailableVenuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAvailableVenuesResponse.ProtoReflect.Descriptor instead.
 func (*ListAvailableVenuesResponse) Descriptor() ([]byte, []int) {
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{11}
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListAvailableVenuesResponse) GetVenues() []VENUE {
@@ -1732,465 +2151,2709 @@ func (x *ListAvailableVenuesResponse) GetVenues() []VENUE {
 	return nil
 }
 
-var File_s_trade_engine_proto_tradeengine_proto protoreflect.FileDescriptor
+type ListOrdersByParticipantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_s_trade_engine_proto_tradeengine_proto_rawDesc = []byte{
-	0x0a, 0x26, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x05, 0x0a, 0x05, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12,
-	0x1f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
-	0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
-	0x12, 0x2a, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x0a,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0b, 0x2e, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x52, 0x09, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f,
-	0x70, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x73,
-	0x74, 0x6f, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x54, 0x49,
-	0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x52, 0x0b, 0x74, 0x69, 0x6d,
-	0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x73,
-	0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x75, 0x63, 0x65, 0x5f,
-	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x75,
-	0x63, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x57,
-	0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0b, 0x77, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75,
-	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52,
-	0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x10, 0x2e, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0xb6, 0x06, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64,
-	0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x68,
-	0x75, 0x6d, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x75, 0x6d, 0x61, 0x6e,
-	0x69, 0x7a, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
-	0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0b, 0x2e, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x09,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65,
-	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
-	0x65, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54,
-	0x45, 0x47, 0x59, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x10, 0x2e, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x50, 0x41,
-	0x49, 0x52, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x73, 0x18,
-	0x0c, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54,
-	0x45, 0x47, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x5f, 0x73, 0x69, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x54, 0x52,
-	0x41, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53,
-	0x69, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x1a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0e, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x22, 0x7f, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x22, 0xe8, 0x01, 0x0a, 0x29, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75,
-	0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x69, 0x73, 0x42, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x69, 0x73, 0x6b,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x69, 0x73, 0x6b, 0x22, 0x60, 0x0a, 0x0e,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23,
-	0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xd4,
-	0x04, 0x0a, 0x2a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
-	0x14, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x69,
-	0x73, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x6e, 0x6f, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x49, 0x73, 0x55, 0x73, 0x64, 0x12, 0x1c,
-	0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e,
-	0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x19,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x16, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x33, 0x0a, 0x11, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x10,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x74, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x69,
-	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f,
-	0x50, 0x41, 0x49, 0x52, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0f, 0x69, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x57, 0x0a, 0x29, 0x52, 0x65, 0x61, 0x64, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x79, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x22, 0x63,
-	0x0a, 0x2a, 0x52, 0x65, 0x61, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x42, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0e,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52,
-	0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
-	0x12, 0x15, 0x0a, 0x06, 0x77, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x77, 0x73, 0x55, 0x72, 0x6c, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x06, 0x76, 0x65,
-	0x6e, 0x75, 0x65, 0x73, 0x2a, 0x48, 0x0a, 0x05, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x12, 0x0e, 0x0a,
-	0x0a, 0x55, 0x4e, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x42, 0x49, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x54,
-	0x58, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x52, 0x49, 0x42, 0x49, 0x54, 0x10, 0x03,
-	0x12, 0x0c, 0x0a, 0x08, 0x42, 0x49, 0x54, 0x46, 0x49, 0x4e, 0x45, 0x58, 0x10, 0x04, 0x2a, 0x43,
-	0x0a, 0x0a, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x0d, 0x0a, 0x09,
-	0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
-	0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52,
-	0x4e, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41,
-	0x4c, 0x10, 0x03, 0x2a, 0x34, 0x0a, 0x0a, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x44,
-	0x45, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x59, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45,
-	0x4c, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09,
-	0x0a, 0x05, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x2a, 0xac, 0x01, 0x0a, 0x0c, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10,
-	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x45, 0x57, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x01,
-	0x12, 0x18, 0x0a, 0x14, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43,
-	0x45, 0x4c, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x41,
-	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x03, 0x12,
-	0x1a, 0x0a, 0x16, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x46, 0x49, 0x4c,
-	0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x46,
-	0x49, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0c, 0x0a,
-	0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x45,
-	0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x07, 0x2a, 0x49, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x44,
-	0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43,
-	0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
-	0x54, 0x45, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
-	0x44, 0x10, 0x03, 0x2a, 0x60, 0x0a, 0x0f, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x50, 0x4f, 0x54, 0x10, 0x00,
-	0x12, 0x14, 0x0a, 0x10, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x50, 0x45,
-	0x54, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45,
-	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0b,
-	0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4d,
-	0x4f, 0x56, 0x45, 0x10, 0x05, 0x2a, 0x3b, 0x0a, 0x0a, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x50,
-	0x41, 0x49, 0x52, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x44, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a,
-	0x03, 0x55, 0x53, 0x44, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x54, 0x43, 0x10, 0x02, 0x12,
-	0x08, 0x0a, 0x04, 0x55, 0x53, 0x44, 0x43, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x54, 0x48,
-	0x10, 0x04, 0x2a, 0x8d, 0x01, 0x0a, 0x0a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x4f, 0x50,
-	0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x4f,
-	0x50, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x41, 0x4b,
-	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10,
-	0x04, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x54,
-	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x52, 0x41, 0x49,
-	0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54,
-	0x10, 0x06, 0x2a, 0x89, 0x01, 0x0a, 0x0d, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46,
-	0x4f, 0x52, 0x43, 0x45, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f,
-	0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x4f, 0x4f, 0x44, 0x5f, 0x54, 0x49, 0x4c, 0x4c, 0x5f,
-	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49,
-	0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43,
-	0x45, 0x4c, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x49, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x5f,
-	0x4b, 0x49, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x4f, 0x4f, 0x44, 0x5f, 0x54,
-	0x49, 0x4c, 0x4c, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x2a, 0x4f,
-	0x0a, 0x0c, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x1b,
-	0x0a, 0x17, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d,
-	0x41, 0x52, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43,
-	0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x02, 0x2a,
-	0x7b, 0x0a, 0x12, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52,
-	0x41, 0x54, 0x45, 0x47, 0x59, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x4d, 0x41, 0x5f, 0x4c, 0x49, 0x4d,
-	0x49, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x4d, 0x41, 0x5f, 0x4d, 0x41, 0x52, 0x4b,
-	0x45, 0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x43, 0x41, 0x5f, 0x46, 0x49, 0x52, 0x53,
-	0x54, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x5f, 0x4c, 0x49,
-	0x4d, 0x49, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x43, 0x41, 0x5f, 0x41, 0x4c, 0x4c,
-	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x57, 0x41, 0x50,
-	0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x57, 0x41, 0x50, 0x10, 0x05, 0x2a, 0x43, 0x0a, 0x16,
-	0x44, 0x43, 0x41, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
-	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x41,
-	0x4e, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x01,
-	0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x58, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10,
-	0x02, 0x32, 0xb7, 0x03, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x12, 0x52, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72,
-	0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x22, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x6f, 0x72,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x6f,
-	0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x22, 0x52, 0x65, 0x61, 0x64, 0x54, 0x72,
-	0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x79, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x42, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x79, 0x54, 0x72,
-	0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x1b,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65,
-	0x6e, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x33, 0x5a, 0x31, 0x73,
-	0x77, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x73, 0x2e, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	TradeStrategyId string `protobuf:"bytes,1,opt,name=trade_strategy_id,json=tradeStrategyId,proto3" json:"trade_strategy_id,omitempty"`
+	UserId          string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId         string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 }
 
-var (
-	file_s_trade_engine_proto_tradeengine_proto_rawDescOnce sync.Once
-	file_s_trade_engine_proto_tradeengine_proto_rawDescData = file_s_trade_engine_proto_tradeengine_proto_rawDesc
-)
+func (x *ListOrdersByParticipantRequest) Reset() {
+	*x = ListOrdersByParticipantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP() []byte {
-	file_s_trade_engine_proto_tradeengine_proto_rawDescOnce.Do(func() {
-		file_s_trade_engine_proto_tradeengine_proto_rawDescData = protoimpl.X.CompressGZIP(file_s_trade_engine_proto_tradeengine_proto_rawDescData)
-	})
-	return file_s_trade_engine_proto_tradeengine_proto_rawDescData
+func (x *ListOrdersByParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_s_trade_engine_proto_tradeengine_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_s_trade_engine_proto_tradeengine_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_s_trade_engine_proto_tradeengine_proto_goTypes = []interface{}{
-	(VENUE)(0),                          // 0: VENUE
-	(ACTOR_TYPE)(0),                     // 1: ACTOR_TYPE
-	(TRADE_SIDE)(0),                     // 2: TRADE_SIDE
-	(ORDER_STATUS)(0),                   // 3: ORDER_STATUS
-	(TRADE_STRATEGY_STATUS)(0),          // 4: TRADE_STRATEGY_STATUS
-	(INSTRUMENT_TYPE)(0),                // 5: INSTRUMENT_TYPE
-	(TRADE_PAIR)(0),                     // 6: TRADE_PAIR
-	(ORDER_TYPE)(0),                     // 7: ORDER_TYPE
-	(TIME_IN_FORCE)(0),                  // 8: TIME_IN_FORCE
-	(WORKING_TYPE)(0),                   // 9: WORKING_TYPE
-	(EXECUTION_STRATEGY)(0),             // 10: EXECUTION_STRATEGY
-	(DCA_EXECUTION_STRATEGY)(0),         // 11: DCA_EXECUTION_STRATEGY
-	(*Order)(nil),                       // 12: Order
-	(*TradeStrategy)(nil),               // 13: TradeStrategy
-	(*CreateTradeStrategyRequest)(nil),  // 14: CreateTradeStrategyRequest
-	(*CreateTradeStrategyResponse)(nil), // 15: CreateTradeStrategyResponse
-	(*ExecuteTradeStrategyForParticipantRequest)(nil),  // 16: ExecuteTradeStrategyForParticipantRequest
-	(*ExecutionError)(nil),                             // 17: ExecutionError
-	(*ExecuteTradeStrategyForParticipantResponse)(nil), // 18: ExecuteTradeStrategyForParticipantResponse
-	(*ReadTradeStrategyByTradeStrategyIDRequest)(nil),  // 19: ReadTradeStrategyByTradeStrategyIDRequest
-	(*ReadTradeStrategyByTradeStrategyIDResponse)(nil), // 20: ReadTradeStrategyByTradeStrategyIDResponse
-	(*VenueCredentials)(nil),                           // 21: VenueCredentials
-	(*ListAvailableVenuesRequest)(nil),                 // 22: ListAvailableVenuesRequest
-	(*ListAvailableVenuesResponse)(nil),                // 23: ListAvailableVenuesResponse
-	(*timestamppb.Timestamp)(nil),                      // 24: google.protobuf.Timestamp
+func (*ListOrdersByParticipantRequest) ProtoMessage() {}
+
+func (x *ListOrdersByParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_s_trade_engine_proto_tradeengine_proto_depIdxs = []int32{
-	6,  // 0: Order.pair:type_name -> TRADE_PAIR
-	7,  // 1: Order.order_type:type_name -> ORDER_TYPE
-	2,  // 2: Order.trade_side:type_name -> TRADE_SIDE
-	8,  // 3: Order.time_in_force:type_name -> TIME_IN_FORCE
-	9,  // 4: Order.working_type:type_name -> WORKING_TYPE
-	0,  // 5: Order.venue:type_name -> VENUE
-	5,  // 6: Order.instrument_type:type_name -> INSTRUMENT_TYPE
-	1,  // 7: TradeStrategy.actor_type:type_name -> ACTOR_TYPE
-	10, // 8: TradeStrategy.execution_strategy:type_name -> EXECUTION_STRATEGY
-	5,  // 9: TradeStrategy.instrument_type:type_name -> INSTRUMENT_TYPE
-	6,  // 10: TradeStrategy.pair:type_name -> TRADE_PAIR
-	4,  // 11: TradeStrategy.status:type_name -> TRADE_STRATEGY_STATUS
-	24, // 12: TradeStrategy.created:type_name -> google.protobuf.Timestamp
-	24, // 13: TradeStrategy.last_updated:type_name -> google.protobuf.Timestamp
-	2,  // 14: TradeStrategy.trade_side:type_name -> TRADE_SIDE
-	0,  // 15: TradeStrategy.tradeable_venues:type_name -> VENUE
-	13, // 16: CreateTradeStrategyRequest.trade_strategy:type_name -> TradeStrategy
-	24, // 17: CreateTradeStrategyResponse.created:type_name -> google.protobuf.Timestamp
-	0,  // 18: ExecuteTradeStrategyForParticipantRequest.venue:type_name -> VENUE
-	12, // 19: ExecutionError.failed_order:type_name -> Order
-	0,  // 20: ExecuteTradeStrategyForParticipantResponse.venue:type_name -> VENUE
-	10, // 21: ExecuteTradeStrategyForParticipantResponse.execution_strategy:type_name -> EXECUTION_STRATEGY
-	12, // 22: ExecuteTradeStrategyForParticipantResponse.successful_orders:type_name -> Order
-	24, // 23: ExecuteTradeStrategyForParticipantResponse.timestamp:type_name -> google.protobuf.Timestamp
-	17, // 24: ExecuteTradeStrategyForParticipantResponse.error:type_name -> ExecutionError
-	6,  // 25: ExecuteTradeStrategyForParticipantResponse.pair:type_name -> TRADE_PAIR
-	5,  // 26: ExecuteTradeStrategyForParticipantResponse.instrument_type:type_name -> INSTRUMENT_TYPE
-	13, // 27: ReadTradeStrategyByTradeStrategyIDResponse.trade_strategy:type_name -> TradeStrategy
-	0,  // 28: VenueCredentials.venue:type_name -> VENUE
-	0,  // 29: ListAvailableVenuesResponse.venues:type_name -> VENUE
-	14, // 30: tradeengine.CreateTradeStrategy:input_type -> CreateTradeStrategyRequest
-	16, // 31: tradeengine.ExecuteTradeStrategyForParticipant:input_type -> ExecuteTradeStrategyForParticipantRequest
-	19, // 32: tradeengine.ReadTradeStrategyByTradeStrategyID:input_type -> ReadTradeStrategyByTradeStrategyIDRequest
-	22, // 33: tradeengine.ListAvailableVenues:input_type -> ListAvailableVenuesRequest
-	15, // 34: tradeengine.CreateTradeStrategy:output_type -> CreateTradeStrategyResponse
-	18, // 35: tradeengine.ExecuteTradeStrategyForParticipant:output_type -> ExecuteTradeStrategyForParticipantResponse
-	20, // 36: tradeengine.ReadTradeStrategyByTradeStrategyID:output_type -> ReadTradeStrategyByTradeStrategyIDResponse
-	23, // 37: tradeengine.ListAvailableVenues:output_type -> ListAvailableVenuesResponse
-	34, // [34:38] is the sub-list for method output_type
-	30, // [30:34] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+
+// Deprecated: Use ListOrdersByParticipantRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersByParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{13}
 }
 
-func init() { file_s_trade_engine_proto_tradeengine_proto_init() }
-func file_s_trade_engine_proto_tradeengine_proto_init() {
-	if File_s_trade_engine_proto_tradeengine_proto != nil {
-		return
+func (x *ListOrdersByParticipantRequest) GetTradeStrategyId() string {
+	if x != nil {
+		return x.TradeStrategyId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Order); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradeStrategy); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTradeStrategyRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTradeStrategyResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
+	return ""
+}
+
+func (x *ListOrdersByParticipantRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListOrdersByParticipantRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ListOrdersByParticipantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *ListOrdersByParticipantResponse) Reset() {
+	*x = ListOrdersByParticipantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrdersByParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersByParticipantResponse) ProtoMessage() {}
+
+func (x *ListOrdersByParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersByParticipantResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersByParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListOrdersByParticipantResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type ReadOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+}
+
+func (x *ReadOrderRequest) Reset() {
+	*x = ReadOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadOrderRequest) ProtoMessage() {}
+
+func (x *ReadOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReadOrderRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReadOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReadOrderRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ReadOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *ReadOrderResponse) Reset() {
+	*x = ReadOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadOrderResponse) ProtoMessage() {}
+
+func (x *ReadOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReadOrderResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReadOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type CancelTradeStrategyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId         string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TradeStrategyId string `protobuf:"bytes,2,opt,name=trade_strategy_id,json=tradeStrategyId,proto3" json:"trade_strategy_id,omitempty"`
+	// By default only entries are cancelled; reduce only orders (stop losses & take profits) are left to protect
+	// any position that has already been entered.
+	IncludeReduceOnlyOrders bool `protobuf:"varint,3,opt,name=include_reduce_only_orders,json=includeReduceOnlyOrders,proto3" json:"include_reduce_only_orders,omitempty"`
+}
+
+func (x *CancelTradeStrategyRequest) Reset() {
+	*x = CancelTradeStrategyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTradeStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTradeStrategyRequest) ProtoMessage() {}
+
+func (x *CancelTradeStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTradeStrategyRequest.ProtoReflect.Descriptor instead.
+func (*CancelTradeStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CancelTradeStrategyRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CancelTradeStrategyRequest) GetTradeStrategyId() string {
+	if x != nil {
+		return x.TradeStrategyId
+	}
+	return ""
+}
+
+func (x *CancelTradeStrategyRequest) GetIncludeReduceOnlyOrders() bool {
+	if x != nil {
+		return x.IncludeReduceOnlyOrders
+	}
+	return false
+}
+
+type CancelTradeStrategyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CancelledOrders []*Order `protobuf:"bytes,1,rep,name=cancelled_orders,json=cancelledOrders,proto3" json:"cancelled_orders,omitempty"`
+	// Each failed order has the failure reason set.
+	FailedOrders                        []*Order `protobuf:"bytes,2,rep,name=failed_orders,json=failedOrders,proto3" json:"failed_orders,omitempty"`
+	NumberOfCancelledExecutionSchedules int64    `protobuf:"varint,3,opt,name=number_of_cancelled_execution_schedules,json=numberOfCancelledExecutionSchedules,proto3" json:"number_of_cancelled_execution_schedules,omitempty"`
+}
+
+func (x *CancelTradeStrategyResponse) Reset() {
+	*x = CancelTradeStrategyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTradeStrategyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTradeStrategyResponse) ProtoMessage() {}
+
+func (x *CancelTradeStrategyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTradeStrategyResponse.ProtoReflect.Descriptor instead.
+func (*CancelTradeStrategyResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelTradeStrategyResponse) GetCancelledOrders() []*Order {
+	if x != nil {
+		return x.CancelledOrders
+	}
+	return nil
+}
+
+func (x *CancelTradeStrategyResponse) GetFailedOrders() []*Order {
+	if x != nil {
+		return x.FailedOrders
+	}
+	return nil
+}
+
+func (x *CancelTradeStrategyResponse) GetNumberOfCancelledExecutionSchedules() int64 {
+	if x != nil {
+		return x.NumberOfCancelledExecutionSchedules
+	}
+	return 0
+}
+
+type CancelParticipantOrdersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId                 string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TradeStrategyId         string `protobuf:"bytes,2,opt,name=trade_strategy_id,json=tradeStrategyId,proto3" json:"trade_strategy_id,omitempty"`
+	UserId                  string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeReduceOnlyOrders bool   `protobuf:"varint,4,opt,name=include_reduce_only_orders,json=includeReduceOnlyOrders,proto3" json:"include_reduce_only_orders,omitempty"`
+}
+
+func (x *CancelParticipantOrdersRequest) Reset() {
+	*x = CancelParticipantOrdersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelParticipantOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelParticipantOrdersRequest) ProtoMessage() {}
+
+func (x *CancelParticipantOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelParticipantOrdersRequest.ProtoReflect.Descriptor instead.
+func (*CancelParticipantOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelParticipantOrdersRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CancelParticipantOrdersRequest) GetTradeStrategyId() string {
+	if x != nil {
+		return x.TradeStrategyId
+	}
+	return ""
+}
+
+func (x *CancelParticipantOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelParticipantOrdersRequest) GetIncludeReduceOnlyOrders() bool {
+	if x != nil {
+		return x.IncludeReduceOnlyOrders
+	}
+	return false
+}
+
+type CancelParticipantOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CancelledOrders []*Order `protobuf:"bytes,1,rep,name=cancelled_orders,json=cancelledOrders,proto3" json:"cancelled_orders,omitempty"`
+	// Each failed order has the failure reason set.
+	FailedOrders                        []*Order `protobuf:"bytes,2,rep,name=failed_orders,json=failedOrders,proto3" json:"failed_orders,omitempty"`
+	NumberOfCancelledExecutionSchedules int64    `protobuf:"varint,3,opt,name=number_of_cancelled_execution_schedules,json=numberOfCancelledExecutionSchedules,proto3" json:"number_of_cancelled_execution_schedules,omitempty"`
+}
+
+func (x *CancelParticipantOrdersResponse) Reset() {
+	*x = CancelParticipantOrdersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelParticipantOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelParticipantOrdersResponse) ProtoMessage() {}
+
+func (x *CancelParticipantOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelParticipantOrdersResponse.ProtoReflect.Descriptor instead.
+func (*CancelParticipantOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelParticipantOrdersResponse) GetCancelledOrders() []*Order {
+	if x != nil {
+		return x.CancelledOrders
+	}
+	return nil
+}
+
+func (x *CancelParticipantOrdersResponse) GetFailedOrders() []*Order {
+	if x != nil {
+		return x.FailedOrders
+	}
+	return nil
+}
+
+func (x *CancelParticipantOrdersResponse) GetNumberOfCancelledExecutionSchedules() int64 {
+	if x != nil {
+		return x.NumberOfCancelledExecutionSchedules
+	}
+	return 0
+}
+
+type AmendStopLossRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId         string  `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	TradeStrategyId string  `protobuf:"bytes,2,opt,name=trade_strategy_id,json=tradeStrategyId,proto3" json:"trade_strategy_id,omitempty"`
+	StopLoss        float32 `protobuf:"fixed32,3,opt,name=stop_loss,json=stopLoss,proto3" json:"stop_loss,omitempty"`
+}
+
+func (x *AmendStopLossRequest) Reset() {
+	*x = AmendStopLossRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendStopLossRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendStopLossRequest) ProtoMessage() {}
+
+func (x *AmendStopLossRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendStopLossRequest.ProtoReflect.Descriptor instead.
+func (*AmendStopLossRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AmendStopLossRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AmendStopLossRequest) GetTradeStrategyId() string {
+	if x != nil {
+		return x.TradeStrategyId
+	}
+	return ""
+}
+
+func (x *AmendStopLossRequest) GetStopLoss() float32 {
+	if x != nil {
+		return x.StopLoss
+	}
+	return 0
+}
+
+type AmendedStopLoss struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PreviousStopLoss *Order `protobuf:"bytes,2,opt,name=previous_stop_loss,json=previousStopLoss,proto3" json:"previous_stop_loss,omitempty"`
+	NewStopLoss      *Order `protobuf:"bytes,3,opt,name=new_stop_loss,json=newStopLoss,proto3" json:"new_stop_loss,omitempty"`
+	ErrorMessage     string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *AmendedStopLoss) Reset() {
+	*x = AmendedStopLoss{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendedStopLoss) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendedStopLoss) ProtoMessage() {}
+
+func (x *AmendedStopLoss) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendedStopLoss.ProtoReflect.Descriptor instead.
+func (*AmendedStopLoss) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AmendedStopLoss) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AmendedStopLoss) GetPreviousStopLoss() *Order {
+	if x != nil {
+		return x.PreviousStopLoss
+	}
+	return nil
+}
+
+func (x *AmendedStopLoss) GetNewStopLoss() *Order {
+	if x != nil {
+		return x.NewStopLoss
+	}
+	return nil
+}
+
+func (x *AmendedStopLoss) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type AmendStopLossResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousStopLoss  float32            `protobuf:"fixed32,1,opt,name=previous_stop_loss,json=previousStopLoss,proto3" json:"previous_stop_loss,omitempty"`
+	StopLoss          float32            `protobuf:"fixed32,2,opt,name=stop_loss,json=stopLoss,proto3" json:"stop_loss,omitempty"`
+	AmendedStopLosses []*AmendedStopLoss `protobuf:"bytes,3,rep,name=amended_stop_losses,json=amendedStopLosses,proto3" json:"amended_stop_losses,omitempty"`
+}
+
+func (x *AmendStopLossResponse) Reset() {
+	*x = AmendStopLossResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendStopLossResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendStopLossResponse) ProtoMessage() {}
+
+func (x *AmendStopLossResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendStopLossResponse.ProtoReflect.Descriptor instead.
+func (*AmendStopLossResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AmendStopLossResponse) GetPreviousStopLoss() float32 {
+	if x != nil {
+		return x.PreviousStopLoss
+	}
+	return 0
+}
+
+func (x *AmendStopLossResponse) GetStopLoss() float32 {
+	if x != nil {
+		return x.StopLoss
+	}
+	return 0
+}
+
+func (x *AmendStopLossResponse) GetAmendedStopLosses() []*AmendedStopLoss {
+	if x != nil {
+		return x.AmendedStopLosses
+	}
+	return nil
+}
+
+type CircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope CIRCUIT_BREAKER_SCOPE `protobuf:"varint,1,opt,name=scope,proto3,enum=CIRCUIT_BREAKER_SCOPE" json:"scope,omitempty"`
+	// Empty for the system circuit breaker.
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsTripped   bool                   `protobuf:"varint,3,opt,name=is_tripped,json=isTripped,proto3" json:"is_tripped,omitempty"`
+	Reason      string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActorId     string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Tripped     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CircuitBreaker) GetScope() CIRCUIT_BREAKER_SCOPE {
+	if x != nil {
+		return x.Scope
+	}
+	return CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER
+}
+
+func (x *CircuitBreaker) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetIsTripped() bool {
+	if x != nil {
+		return x.IsTripped
+	}
+	return false
+}
+
+func (x *CircuitBreaker) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CircuitBreaker) GetTripped() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Tripped
+	}
+	return nil
+}
+
+func (x *CircuitBreaker) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+type TripCircuitBreakerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId string                `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Scope   CIRCUIT_BREAKER_SCOPE `protobuf:"varint,2,opt,name=scope,proto3,enum=CIRCUIT_BREAKER_SCOPE" json:"scope,omitempty"`
+	UserId  string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason  string                `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Cancels resting entries; protective orders (stop losses & take profits) are left in place.
+	CancelRestingOrders bool `protobuf:"varint,5,opt,name=cancel_resting_orders,json=cancelRestingOrders,proto3" json:"cancel_resting_orders,omitempty"`
+	// Cancels every resting order, including protective orders, & closes out any open position with a market order.
+	FlattenPositions bool `protobuf:"varint,6,opt,name=flatten_positions,json=flattenPositions,proto3" json:"flatten_positions,omitempty"`
+}
+
+func (x *TripCircuitBreakerRequest) Reset() {
+	*x = TripCircuitBreakerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripCircuitBreakerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripCircuitBreakerRequest) ProtoMessage() {}
+
+func (x *TripCircuitBreakerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripCircuitBreakerRequest.ProtoReflect.Descriptor instead.
+func (*TripCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TripCircuitBreakerRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *TripCircuitBreakerRequest) GetScope() CIRCUIT_BREAKER_SCOPE {
+	if x != nil {
+		return x.Scope
+	}
+	return CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER
+}
+
+func (x *TripCircuitBreakerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TripCircuitBreakerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TripCircuitBreakerRequest) GetCancelRestingOrders() bool {
+	if x != nil {
+		return x.CancelRestingOrders
+	}
+	return false
+}
+
+func (x *TripCircuitBreakerRequest) GetFlattenPositions() bool {
+	if x != nil {
+		return x.FlattenPositions
+	}
+	return false
+}
+
+type TripCircuitBreakerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CircuitBreaker  *CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	CancelledOrders []*Order        `protobuf:"bytes,2,rep,name=cancelled_orders,json=cancelledOrders,proto3" json:"cancelled_orders,omitempty"`
+	ClosedOutOrders []*Order        `protobuf:"bytes,3,rep,name=closed_out_orders,json=closedOutOrders,proto3" json:"closed_out_orders,omitempty"`
+	// Each failed order has the failure reason set.
+	FailedOrders                        []*Order `protobuf:"bytes,4,rep,name=failed_orders,json=failedOrders,proto3" json:"failed_orders,omitempty"`
+	NumberOfCancelledExecutionSchedules int64    `protobuf:"varint,5,opt,name=number_of_cancelled_execution_schedules,json=numberOfCancelledExecutionSchedules,proto3" json:"number_of_cancelled_execution_schedules,omitempty"`
+}
+
+func (x *TripCircuitBreakerResponse) Reset() {
+	*x = TripCircuitBreakerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripCircuitBreakerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripCircuitBreakerResponse) ProtoMessage() {}
+
+func (x *TripCircuitBreakerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripCircuitBreakerResponse.ProtoReflect.Descriptor instead.
+func (*TripCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TripCircuitBreakerResponse) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+func (x *TripCircuitBreakerResponse) GetCancelledOrders() []*Order {
+	if x != nil {
+		return x.CancelledOrders
+	}
+	return nil
+}
+
+func (x *TripCircuitBreakerResponse) GetClosedOutOrders() []*Order {
+	if x != nil {
+		return x.ClosedOutOrders
+	}
+	return nil
+}
+
+func (x *TripCircuitBreakerResponse) GetFailedOrders() []*Order {
+	if x != nil {
+		return x.FailedOrders
+	}
+	return nil
+}
+
+func (x *TripCircuitBreakerResponse) GetNumberOfCancelledExecutionSchedules() int64 {
+	if x != nil {
+		return x.NumberOfCancelledExecutionSchedules
+	}
+	return 0
+}
+
+type ResetCircuitBreakerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId string                `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Scope   CIRCUIT_BREAKER_SCOPE `protobuf:"varint,2,opt,name=scope,proto3,enum=CIRCUIT_BREAKER_SCOPE" json:"scope,omitempty"`
+	UserId  string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ResetCircuitBreakerRequest) Reset() {
+	*x = ResetCircuitBreakerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetCircuitBreakerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCircuitBreakerRequest) ProtoMessage() {}
+
+func (x *ResetCircuitBreakerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCircuitBreakerRequest.ProtoReflect.Descriptor instead.
+func (*ResetCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ResetCircuitBreakerRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ResetCircuitBreakerRequest) GetScope() CIRCUIT_BREAKER_SCOPE {
+	if x != nil {
+		return x.Scope
+	}
+	return CIRCUIT_BREAKER_SCOPE_SYSTEM_CIRCUIT_BREAKER
+}
+
+func (x *ResetCircuitBreakerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResetCircuitBreakerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CircuitBreaker *CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+}
+
+func (x *ResetCircuitBreakerResponse) Reset() {
+	*x = ResetCircuitBreakerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetCircuitBreakerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetCircuitBreakerResponse) ProtoMessage() {}
+
+func (x *ResetCircuitBreakerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetCircuitBreakerResponse.ProtoReflect.Descriptor instead.
+func (*ResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ResetCircuitBreakerResponse) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+type ListCircuitBreakersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId     string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	OnlyTripped bool   `protobuf:"varint,2,opt,name=only_tripped,json=onlyTripped,proto3" json:"only_tripped,omitempty"`
+}
+
+func (x *ListCircuitBreakersRequest) Reset() {
+	*x = ListCircuitBreakersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCircuitBreakersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCircuitBreakersRequest) ProtoMessage() {}
+
+func (x *ListCircuitBreakersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCircuitBreakersRequest.ProtoReflect.Descriptor instead.
+func (*ListCircuitBreakersRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCircuitBreakersRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListCircuitBreakersRequest) GetOnlyTripped() bool {
+	if x != nil {
+		return x.OnlyTripped
+	}
+	return false
+}
+
+type ListCircuitBreakersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CircuitBreakers []*CircuitBreaker `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty"`
+}
+
+func (x *ListCircuitBreakersResponse) Reset() {
+	*x = ListCircuitBreakersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCircuitBreakersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCircuitBreakersResponse) ProtoMessage() {}
+
+func (x *ListCircuitBreakersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCircuitBreakersResponse.ProtoReflect.Descriptor instead.
+func (*ListCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListCircuitBreakersResponse) GetCircuitBreakers() []*CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreakers
+	}
+	return nil
+}
+
+// CallerPerformance is how the trade strategies of a single caller played out; only trade strategies whose entry was hit
+// & have since resolved, either stopped out, fully taken profit or expired, are counted.
+type CallerPerformance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HumanizedActorName string     `protobuf:"bytes,1,opt,name=humanized_actor_name,json=humanizedActorName,proto3" json:"humanized_actor_name,omitempty"`
+	ActorType          ACTOR_TYPE `protobuf:"varint,2,opt,name=actor_type,json=actorType,proto3,enum=ACTOR_TYPE" json:"actor_type,omitempty"`
+	NumberOfTrades     int64      `protobuf:"varint,3,opt,name=number_of_trades,json=numberOfTrades,proto3" json:"number_of_trades,omitempty"`
+	NumberOfWins       int64      `protobuf:"varint,4,opt,name=number_of_wins,json=numberOfWins,proto3" json:"number_of_wins,omitempty"`
+	// A percentage; a win is a trade that closed with a positive R-multiple.
+	WinRate float32 `protobuf:"fixed32,5,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	// The average R-multiple per trade.
+	Expectancy     float32 `protobuf:"fixed32,6,opt,name=expectancy,proto3" json:"expectancy,omitempty"`
+	TotalRMultiple float32 `protobuf:"fixed32,7,opt,name=total_r_multiple,json=totalRMultiple,proto3" json:"total_r_multiple,omitempty"`
+	// From the trade strategy being created to its outcome.
+	AverageMinutesToOutcome float32 `protobuf:"fixed32,8,opt,name=average_minutes_to_outcome,json=averageMinutesToOutcome,proto3" json:"average_minutes_to_outcome,omitempty"`
+}
+
+func (x *CallerPerformance) Reset() {
+	*x = CallerPerformance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallerPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallerPerformance) ProtoMessage() {}
+
+func (x *CallerPerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallerPerformance.ProtoReflect.Descriptor instead.
+func (*CallerPerformance) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CallerPerformance) GetHumanizedActorName() string {
+	if x != nil {
+		return x.HumanizedActorName
+	}
+	return ""
+}
+
+func (x *CallerPerformance) GetActorType() ACTOR_TYPE {
+	if x != nil {
+		return x.ActorType
+	}
+	return ACTOR_TYPE_AUTOMATED
+}
+
+func (x *CallerPerformance) GetNumberOfTrades() int64 {
+	if x != nil {
+		return x.NumberOfTrades
+	}
+	return 0
+}
+
+func (x *CallerPerformance) GetNumberOfWins() int64 {
+	if x != nil {
+		return x.NumberOfWins
+	}
+	return 0
+}
+
+func (x *CallerPerformance) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *CallerPerformance) GetExpectancy() float32 {
+	if x != nil {
+		return x.Expectancy
+	}
+	return 0
+}
+
+func (x *CallerPerformance) GetTotalRMultiple() float32 {
+	if x != nil {
+		return x.TotalRMultiple
+	}
+	return 0
+}
+
+func (x *CallerPerformance) GetAverageMinutesToOutcome() float32 {
+	if x != nil {
+		return x.AverageMinutesToOutcome
+	}
+	return 0
+}
+
+type ListCallerPerformanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Only trades resolved within the window are counted; zero counts every trade.
+	WindowInDays int64                   `protobuf:"varint,2,opt,name=window_in_days,json=windowInDays,proto3" json:"window_in_days,omitempty"`
+	Sort         CALLER_PERFORMANCE_SORT `protobuf:"varint,3,opt,name=sort,proto3,enum=CALLER_PERFORMANCE_SORT" json:"sort,omitempty"`
+	// Callers with fewer trades in the window aren't ranked.
+	MinNumberOfTrades int64 `protobuf:"varint,4,opt,name=min_number_of_trades,json=minNumberOfTrades,proto3" json:"min_number_of_trades,omitempty"`
+	Limit             int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListCallerPerformanceRequest) Reset() {
+	*x = ListCallerPerformanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCallerPerformanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallerPerformanceRequest) ProtoMessage() {}
+
+func (x *ListCallerPerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallerPerformanceRequest.ProtoReflect.Descriptor instead.
+func (*ListCallerPerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListCallerPerformanceRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListCallerPerformanceRequest) GetWindowInDays() int64 {
+	if x != nil {
+		return x.WindowInDays
+	}
+	return 0
+}
+
+func (x *ListCallerPerformanceRequest) GetSort() CALLER_PERFORMANCE_SORT {
+	if x != nil {
+		return x.Sort
+	}
+	return CALLER_PERFORMANCE_SORT_EXPECTANCY
+}
+
+func (x *ListCallerPerformanceRequest) GetMinNumberOfTrades() int64 {
+	if x != nil {
+		return x.MinNumberOfTrades
+	}
+	return 0
+}
+
+func (x *ListCallerPerformanceRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCallerPerformanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallerPerformances []*CallerPerformance `protobuf:"bytes,1,rep,name=caller_performances,json=callerPerformances,proto3" json:"caller_performances,omitempty"`
+}
+
+func (x *ListCallerPerformanceResponse) Reset() {
+	*x = ListCallerPerformanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCallerPerformanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallerPerformanceResponse) ProtoMessage() {}
+
+func (x *ListCallerPerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallerPerformanceResponse.ProtoReflect.Descriptor instead.
+func (*ListCallerPerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListCallerPerformanceResponse) GetCallerPerformances() []*CallerPerformance {
+	if x != nil {
+		return x.CallerPerformances
+	}
+	return nil
+}
+
+type ListVenuePositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Defaults to the last 24 hours; FTX only reports the realised PnL of open positions, so ignores it.
+	RealisedPnlSince *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=realised_pnl_since,json=realisedPnlSince,proto3" json:"realised_pnl_since,omitempty"`
+}
+
+func (x *ListVenuePositionsRequest) Reset() {
+	*x = ListVenuePositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVenuePositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuePositionsRequest) ProtoMessage() {}
+
+func (x *ListVenuePositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuePositionsRequest.ProtoReflect.Descriptor instead.
+func (*ListVenuePositionsRequest) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListVenuePositionsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListVenuePositionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListVenuePositionsRequest) GetRealisedPnlSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RealisedPnlSince
+	}
+	return nil
+}
+
+type VenuePosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instrument string `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	// Negative if short.
+	Quantity   float32 `protobuf:"fixed32,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	EntryPrice float32 `protobuf:"fixed32,3,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	// Zero if the venue doesn't report it.
+	MarkPrice        float32 `protobuf:"fixed32,4,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	LiquidationPrice float32 `protobuf:"fixed32,5,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	UnrealisedPnl    float32 `protobuf:"fixed32,6,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	// Zero if the venue doesn't report the realised PnL per position.
+	RealisedPnl float32 `protobuf:"fixed32,7,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+}
+
+func (x *VenuePosition) Reset() {
+	*x = VenuePosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VenuePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenuePosition) ProtoMessage() {}
+
+func (x *VenuePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenuePosition.ProtoReflect.Descriptor instead.
+func (*VenuePosition) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VenuePosition) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *VenuePosition) GetQuantity() float32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *VenuePosition) GetEntryPrice() float32 {
+	if x != nil {
+		return x.EntryPrice
+	}
+	return 0
+}
+
+func (x *VenuePosition) GetMarkPrice() float32 {
+	if x != nil {
+		return x.MarkPrice
+	}
+	return 0
+}
+
+func (x *VenuePosition) GetLiquidationPrice() float32 {
+	if x != nil {
+		return x.LiquidationPrice
+	}
+	return 0
+}
+
+func (x *VenuePosition) GetUnrealisedPnl() float32 {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return 0
+}
+
+func (x *VenuePosition) GetRealisedPnl() float32 {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return 0
+}
+
+type VenuePositions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Venue     VENUE            `protobuf:"varint,1,opt,name=venue,proto3,enum=VENUE" json:"venue,omitempty"`
+	Positions []*VenuePosition `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"`
+	// The maintenance margin as a ratio of the margin balance; the account is liquidated at one.
+	MarginRatio   float32 `protobuf:"fixed32,3,opt,name=margin_ratio,json=marginRatio,proto3" json:"margin_ratio,omitempty"`
+	UnrealisedPnl float32 `protobuf:"fixed32,4,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	// The realised PnL since realised_pnl_since; zero if unavailable.
+	RealisedPnl float32 `protobuf:"fixed32,5,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	// Set if the positions of the venue couldn't be read; the rest of the venues are still listed.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// Set if the venue can't report the realised PnL since realised_pnl_since.
+	RealisedPnlUnavailable bool `protobuf:"varint,7,opt,name=realised_pnl_unavailable,json=realisedPnlUnavailable,proto3" json:"realised_pnl_unavailable,omitempty"`
+}
+
+func (x *VenuePositions) Reset() {
+	*x = VenuePositions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VenuePositions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenuePositions) ProtoMessage() {}
+
+func (x *VenuePositions) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenuePositions.ProtoReflect.Descriptor instead.
+func (*VenuePositions) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VenuePositions) GetVenue() VENUE {
+	if x != nil {
+		return x.Venue
+	}
+	return VENUE_UNREQUIRED
+}
+
+func (x *VenuePositions) GetPositions() []*VenuePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *VenuePositions) GetMarginRatio() float32 {
+	if x != nil {
+		return x.MarginRatio
+	}
+	return 0
+}
+
+func (x *VenuePositions) GetUnrealisedPnl() float32 {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return 0
+}
+
+func (x *VenuePositions) GetRealisedPnl() float32 {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return 0
+}
+
+func (x *VenuePositions) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *VenuePositions) GetRealisedPnlUnavailable() bool {
+	if x != nil {
+		return x.RealisedPnlUnavailable
+	}
+	return false
+}
+
+type ListVenuePositionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VenuePositions []*VenuePositions `protobuf:"bytes,1,rep,name=venue_positions,json=venuePositions,proto3" json:"venue_positions,omitempty"`
+}
+
+func (x *ListVenuePositionsResponse) Reset() {
+	*x = ListVenuePositionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVenuePositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuePositionsResponse) ProtoMessage() {}
+
+func (x *ListVenuePositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_s_trade_engine_proto_tradeengine_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuePositionsResponse.ProtoReflect.Descriptor instead.
+func (*ListVenuePositionsResponse) Descriptor() ([]byte, []int) {
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListVenuePositionsResponse) GetVenuePositions() []*VenuePositions {
+	if x != nil {
+		return x.VenuePositions
+	}
+	return nil
+}
+
+var File_s_trade_engine_proto_tradeengine_proto protoreflect.FileDescriptor
+
+var file_s_trade_engine_proto_tradeengine_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x08, 0x0a, 0x05, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x1f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
+	0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x12, 0x2a, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x0a,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0b, 0x2e, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f,
+	0x70, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x73,
+	0x74, 0x6f, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x52, 0x0b, 0x74, 0x69, 0x6d,
+	0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x75, 0x63, 0x65, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x75,
+	0x63, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x57,
+	0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52,
+	0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xee, 0x06, 0x0a, 0x0d, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x41, 0x43, 0x54, 0x4f, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f,
+	0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x54,
+	0x52, 0x41, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x02,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f,
+	0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x73, 0x74,
+	0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x61,
+	0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x54, 0x52, 0x41, 0x44,
+	0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a,
+	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x52,
+	0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x69, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x31, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x64, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55,
+	0x45, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75,
+	0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x15, 0x20,
+	0x03, 0x28, 0x02, 0x52, 0x15, 0x74, 0x61, 0x6b, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x53, 0x0a, 0x1a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22,
+	0x7f, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x22, 0xce, 0x03, 0x0a, 0x29, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x69, 0x73, 0x42, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x69, 0x73, 0x6b, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x69, 0x73, 0x6b, 0x12, 0x3f, 0x0a, 0x1c, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x19, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x72, 0x69,
+	0x7a, 0x6f, 0x6e, 0x49, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x34, 0x0a, 0x17, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74,
+	0x6f, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x6f, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x22, 0xd4, 0x02, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x0c, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x45, 0x58, 0x45, 0x43,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53,
+	0x53, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x12, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x10, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x3c, 0x0a, 0x16, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x14, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x66, 0x6c, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x74, 0x22, 0x97, 0x05, 0x0a, 0x2a, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x6e, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x69, 0x73, 0x5f, 0x75, 0x73, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53,
+	0x69, 0x7a, 0x65, 0x49, 0x73, 0x55, 0x73, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52,
+	0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x4f, 0x66, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45,
+	0x47, 0x59, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x33, 0x0a, 0x11, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0b, 0x2e, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x52, 0x04,
+	0x70, 0x61, 0x69, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52,
+	0x0e, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x41, 0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x22, 0xc2, 0x04, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x12,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x52, 0x11, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x33, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x1f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x6f, 0x66, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x1d, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x19, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64,
+	0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x12, 0x44, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x29, 0x52, 0x65, 0x61, 0x64, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x79, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64,
+	0x22, 0x63, 0x0a, 0x2a, 0x52, 0x65, 0x61, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55,
+	0x45, 0x52, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x77, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x77, 0x73, 0x55, 0x72, 0x6c, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x06,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x1f, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x06,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x10,
+	0x52, 0x65, 0x61, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xa0, 0x01, 0x0a, 0x1a, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x12,
+	0x3b, 0x0a, 0x1a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x64, 0x75, 0x63,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x64, 0x75,
+	0x63, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0xd3, 0x01, 0x0a,
+	0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x10,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0f,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x2b, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0c,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x27,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x23, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x52, 0x65, 0x64, 0x75, 0x63, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x1f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x10, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x0d, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x27, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x23, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f,
+	0x66, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x14,
+	0x41, 0x6d, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
+	0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x41, 0x6d, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x0d, 0x6e,
+	0x65, 0x77, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x53,
+	0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa4, 0x01, 0x0a,
+	0x15, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x6f, 0x70,
+	0x4c, 0x6f, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73,
+	0x73, 0x12, 0x40, 0x0a, 0x13, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x6f,
+	0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73,
+	0x52, 0x11, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73,
+	0x73, 0x65, 0x73, 0x22, 0x9e, 0x02, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f,
+	0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x54, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x34, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x74, 0x72,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x69, 0x70, 0x43, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x43,
+	0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x53,
+	0x43, 0x4f, 0x50, 0x45, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x6c, 0x61,
+	0x74, 0x74, 0x65, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc0, 0x02,
+	0x0a, 0x1a, 0x54, 0x72, 0x69, 0x70, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0f,
+	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x10, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x11, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a,
+	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x27, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x23, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0x7e, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x43, 0x49, 0x52, 0x43, 0x55,
+	0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x57, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x22, 0x5a, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x79, 0x54, 0x72,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x59, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x10, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52,
+	0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73,
+	0x22, 0xe3, 0x02, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x41,
+	0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f,
+	0x66, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x69, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
+	0x57, 0x69, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x61, 0x6e, 0x63, 0x79, 0x12,
+	0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x52, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
+	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x49, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x43, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x5f,
+	0x50, 0x45, 0x52, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54,
+	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f,
+	0x66, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x64, 0x0a,
+	0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x13, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x43, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x12, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75,
+	0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65,
+	0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x72,
+	0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22,
+	0x82, 0x02, 0x0a, 0x0d, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0d, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x50, 0x6e,
+	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65,
+	0x64, 0x50, 0x6e, 0x6c, 0x22, 0x99, 0x02, 0x0a, 0x0e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x56, 0x45, 0x4e, 0x55, 0x45, 0x52, 0x05,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c,
+	0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
+	0x75, 0x6e, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x50, 0x6e, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73,
+	0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x5f, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73,
+	0x65, 0x64, 0x50, 0x6e, 0x6c, 0x55, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x22, 0x56, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x0f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x53, 0x0a, 0x05, 0x56, 0x45, 0x4e, 0x55,
+	0x45, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x4e, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x49, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x07,
+	0x0a, 0x03, 0x46, 0x54, 0x58, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x52, 0x49, 0x42,
+	0x49, 0x54, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x49, 0x54, 0x46, 0x49, 0x4e, 0x45, 0x58,
+	0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x50, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x43, 0x0a,
+	0x0a, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x0d, 0x0a, 0x09, 0x41,
+	0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41,
+	0x4e, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x10, 0x03, 0x2a, 0x34, 0x0a, 0x0a, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45,
+	0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x59, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4c,
+	0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x09, 0x0a,
+	0x05, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x2a, 0xac, 0x01, 0x0a, 0x0c, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x45, 0x57, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x03, 0x12, 0x1a,
+	0x0a, 0x16, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x4c,
+	0x45, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x49,
+	0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x07, 0x2a, 0x76, 0x0a, 0x15, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x12, 0x20, 0x0a, 0x1c, 0x55, 0x4e, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x54, 0x52, 0x59, 0x41, 0x42, 0x4c, 0x45, 0x5f,
+	0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x58,
+	0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x2a,
+	0x49, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
+	0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x60, 0x0a, 0x0f, 0x49, 0x4e,
+	0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x08, 0x0a,
+	0x04, 0x53, 0x50, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x55, 0x54, 0x55, 0x52,
+	0x45, 0x5f, 0x50, 0x45, 0x52, 0x50, 0x45, 0x54, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44,
+	0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x05, 0x2a, 0x3b, 0x0a, 0x0a,
+	0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53,
+	0x44, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x53, 0x44, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x42, 0x54, 0x43, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x44, 0x43, 0x10, 0x03,
+	0x12, 0x07, 0x0a, 0x03, 0x45, 0x54, 0x48, 0x10, 0x04, 0x2a, 0x8d, 0x01, 0x0a, 0x0a, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4d, 0x49,
+	0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x03,
+	0x12, 0x16, 0x0a, 0x12, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x54, 0x5f,
+	0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x4b, 0x45,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x05, 0x12,
+	0x18, 0x0a, 0x14, 0x54, 0x52, 0x41, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x4f, 0x50,
+	0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x06, 0x2a, 0x89, 0x01, 0x0a, 0x0d, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x12, 0x1c, 0x0a, 0x18, 0x54,
+	0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x52,
+	0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x4f, 0x4f,
+	0x44, 0x5f, 0x54, 0x49, 0x4c, 0x4c, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x5f,
+	0x4f, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46,
+	0x49, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x16, 0x0a,
+	0x12, 0x47, 0x4f, 0x4f, 0x44, 0x5f, 0x54, 0x49, 0x4c, 0x4c, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53,
+	0x49, 0x4e, 0x47, 0x10, 0x04, 0x2a, 0x4f, 0x0a, 0x0c, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x5f, 0x50,
+	0x52, 0x49, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x7b, 0x0a, 0x12, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x12, 0x0d, 0x0a, 0x09,
+	0x44, 0x4d, 0x41, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44,
+	0x4d, 0x41, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44,
+	0x43, 0x41, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f,
+	0x52, 0x45, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x44, 0x43, 0x41, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x12,
+	0x08, 0x0a, 0x04, 0x54, 0x57, 0x41, 0x50, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x57, 0x41,
+	0x50, 0x10, 0x05, 0x2a, 0x43, 0x0a, 0x16, 0x44, 0x43, 0x41, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x12, 0x0c, 0x0a,
+	0x08, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c,
+	0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x58, 0x50, 0x4f, 0x4e,
+	0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x02, 0x2a, 0x37, 0x0a, 0x17, 0x43, 0x41, 0x4c, 0x4c,
+	0x45, 0x52, 0x5f, 0x50, 0x45, 0x52, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53,
+	0x4f, 0x52, 0x54, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x41, 0x4e, 0x43,
+	0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x57, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10,
+	0x01, 0x2a, 0x4d, 0x0a, 0x15, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45,
+	0x41, 0x4b, 0x45, 0x52, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x59,
+	0x53, 0x54, 0x45, 0x4d, 0x5f, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45,
+	0x41, 0x4b, 0x45, 0x52, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43,
+	0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x45, 0x52, 0x10, 0x01,
+	0x32, 0xe7, 0x09, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x12, 0x52, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x22, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x6f, 0x72, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x6f, 0x72,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x22, 0x52, 0x65, 0x61, 0x64, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x79, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42,
+	0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x79, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x1b, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x42, 0x79, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x52, 0x65,
+	0x61, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1b, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x1f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f,
+	0x70, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f,
+	0x70, 0x4c, 0x6f, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x41,
+	0x6d, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x54, 0x72, 0x69, 0x70, 0x43, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x54,
+	0x72, 0x69, 0x70, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x43,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1b,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x73, 0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x65, 0x6e, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x33, 0x5a, 0x31, 0x73, 0x77,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x73, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_s_trade_engine_proto_tradeengine_proto_rawDescOnce sync.Once
+	file_s_trade_engine_proto_tradeengine_proto_rawDescData = file_s_trade_engine_proto_tradeengine_proto_rawDesc
+)
+
+func file_s_trade_engine_proto_tradeengine_proto_rawDescGZIP() []byte {
+	file_s_trade_engine_proto_tradeengine_proto_rawDescOnce.Do(func() {
+		file_s_trade_engine_proto_tradeengine_proto_rawDescData = protoimpl.X.CompressGZIP(file_s_trade_engine_proto_tradeengine_proto_rawDescData)
+	})
+	return file_s_trade_engine_proto_tradeengine_proto_rawDescData
+}
+
+var file_s_trade_engine_proto_tradeengine_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_s_trade_engine_proto_tradeengine_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_s_trade_engine_proto_tradeengine_proto_goTypes = []interface{}{
+	(VENUE)(0),                                         // 0: VENUE
+	(ACTOR_TYPE)(0),                                    // 1: ACTOR_TYPE
+	(TRADE_SIDE)(0),                                    // 2: TRADE_SIDE
+	(ORDER_STATUS)(0),                                  // 3: ORDER_STATUS
+	(EXECUTION_ERROR_CLASS)(0),                         // 4: EXECUTION_ERROR_CLASS
+	(TRADE_STRATEGY_STATUS)(0),                         // 5: TRADE_STRATEGY_STATUS
+	(INSTRUMENT_TYPE)(0),                               // 6: INSTRUMENT_TYPE
+	(TRADE_PAIR)(0),                                    // 7: TRADE_PAIR
+	(ORDER_TYPE)(0),                                    // 8: ORDER_TYPE
+	(TIME_IN_FORCE)(0),                                 // 9: TIME_IN_FORCE
+	(WORKING_TYPE)(0),                                  // 10: WORKING_TYPE
+	(EXECUTION_STRATEGY)(0),                            // 11: EXECUTION_STRATEGY
+	(DCA_EXECUTION_STRATEGY)(0),                        // 12: DCA_EXECUTION_STRATEGY
+	(CALLER_PERFORMANCE_SORT)(0),                       // 13: CALLER_PERFORMANCE_SORT
+	(CIRCUIT_BREAKER_SCOPE)(0),                         // 14: CIRCUIT_BREAKER_SCOPE
+	(*Order)(nil),                                      // 15: Order
+	(*TradeStrategy)(nil),                              // 16: TradeStrategy
+	(*CreateTradeStrategyRequest)(nil),                 // 17: CreateTradeStrategyRequest
+	(*CreateTradeStrategyResponse)(nil),                // 18: CreateTradeStrategyResponse
+	(*ExecuteTradeStrategyForParticipantRequest)(nil),  // 19: ExecuteTradeStrategyForParticipantRequest
+	(*ExecutionError)(nil),                             // 20: ExecutionError
+	(*ExecuteTradeStrategyForParticipantResponse)(nil), // 21: ExecuteTradeStrategyForParticipantResponse
+	(*ExecutionSchedule)(nil),                          // 22: ExecutionSchedule
+	(*ReadTradeStrategyByTradeStrategyIDRequest)(nil),  // 23: ReadTradeStrategyByTradeStrategyIDRequest
+	(*ReadTradeStrategyByTradeStrategyIDResponse)(nil), // 24: ReadTradeStrategyByTradeStrategyIDResponse
+	(*VenueCredentials)(nil),                           // 25: VenueCredentials
+	(*ListAvailableVenuesRequest)(nil),                 // 26: ListAvailableVenuesRequest
+	(*ListAvailableVenuesResponse)(nil),                // 27: ListAvailableVenuesResponse
+	(*ListOrdersByParticipantRequest)(nil),             // 28: ListOrdersByParticipantRequest
+	(*ListOrdersByParticipantResponse)(nil),            // 29: ListOrdersByParticipantResponse
+	(*ReadOrderRequest)(nil),                           // 30: ReadOrderRequest
+	(*ReadOrderResponse)(nil),                          // 31: ReadOrderResponse
+	(*CancelTradeStrategyRequest)(nil),                 // 32: CancelTradeStrategyRequest
+	(*CancelTradeStrategyResponse)(nil),                // 33: CancelTradeStrategyResponse
+	(*CancelParticipantOrdersRequest)(nil),             // 34: CancelParticipantOrdersRequest
+	(*CancelParticipantOrdersResponse)(nil),            // 35: CancelParticipantOrdersResponse
+	(*AmendStopLossRequest)(nil),                       // 36: AmendStopLossRequest
+	(*AmendedStopLoss)(nil),                            // 37: AmendedStopLoss
+	(*AmendStopLossResponse)(nil),                      // 38: AmendStopLossResponse
+	(*CircuitBreaker)(nil),                             // 39: CircuitBreaker
+	(*TripCircuitBreakerRequest)(nil),                  // 40: TripCircuitBreakerRequest
+	(*TripCircuitBreakerResponse)(nil),                 // 41: TripCircuitBreakerResponse
+	(*ResetCircuitBreakerRequest)(nil),                 // 42: ResetCircuitBreakerRequest
+	(*ResetCircuitBreakerResponse)(nil),                // 43: ResetCircuitBreakerResponse
+	(*ListCircuitBreakersRequest)(nil),                 // 44: ListCircuitBreakersRequest
+	(*ListCircuitBreakersResponse)(nil),                // 45: ListCircuitBreakersResponse
+	(*CallerPerformance)(nil),                          // 46: CallerPerformance
+	(*ListCallerPerformanceRequest)(nil),               // 47: ListCallerPerformanceRequest
+	(*ListCallerPerformanceResponse)(nil),              // 48: ListCallerPerformanceResponse
+	(*ListVenuePositionsRequest)(nil),                  // 49: ListVenuePositionsRequest
+	(*VenuePosition)(nil),                              // 50: VenuePosition
+	(*VenuePositions)(nil),                             // 51: VenuePositions
+	(*ListVenuePositionsResponse)(nil),                 // 52: ListVenuePositionsResponse
+	(*timestamppb.Timestamp)(nil),                      // 53: google.protobuf.Timestamp
+}
+var file_s_trade_engine_proto_tradeengine_proto_depIdxs = []int32{
+	7,  // 0: Order.pair:type_name -> TRADE_PAIR
+	8,  // 1: Order.order_type:type_name -> ORDER_TYPE
+	2,  // 2: Order.trade_side:type_name -> TRADE_SIDE
+	9,  // 3: Order.time_in_force:type_name -> TIME_IN_FORCE
+	10, // 4: Order.working_type:type_name -> WORKING_TYPE
+	0,  // 5: Order.venue:type_name -> VENUE
+	6,  // 6: Order.instrument_type:type_name -> INSTRUMENT_TYPE
+	3,  // 7: Order.status:type_name -> ORDER_STATUS
+	1,  // 8: TradeStrategy.actor_type:type_name -> ACTOR_TYPE
+	11, // 9: TradeStrategy.execution_strategy:type_name -> EXECUTION_STRATEGY
+	6,  // 10: TradeStrategy.instrument_type:type_name -> INSTRUMENT_TYPE
+	7,  // 11: TradeStrategy.pair:type_name -> TRADE_PAIR
+	5,  // 12: TradeStrategy.status:type_name -> TRADE_STRATEGY_STATUS
+	53, // 13: TradeStrategy.created:type_name -> google.protobuf.Timestamp
+	53, // 14: TradeStrategy.last_updated:type_name -> google.protobuf.Timestamp
+	2,  // 15: TradeStrategy.trade_side:type_name -> TRADE_SIDE
+	0,  // 16: TradeStrategy.tradeable_venues:type_name -> VENUE
+	16, // 17: CreateTradeStrategyRequest.trade_strategy:type_name -> TradeStrategy
+	53, // 18: CreateTradeStrategyResponse.created:type_name -> google.protobuf.Timestamp
+	0,  // 19: ExecuteTradeStrategyForParticipantRequest.venue:type_name -> VENUE
+	15, // 20: ExecutionError.failed_order:type_name -> Order
+	4,  // 21: ExecutionError.error_class:type_name -> EXECUTION_ERROR_CLASS
+	15, // 22: ExecutionError.rolled_back_orders:type_name -> Order
+	15, // 23: ExecutionError.failed_rollback_orders:type_name -> Order
+	0,  // 24: ExecuteTradeStrategyForParticipantResponse.venue:type_name -> VENUE
+	11, // 25: ExecuteTradeStrategyForParticipantResponse.execution_strategy:type_name -> EXECUTION_STRATEGY
+	15, // 26: ExecuteTradeStrategyForParticipantResponse.successful_orders:type_name -> Order
+	53, // 27: ExecuteTradeStrategyForParticipantResponse.timestamp:type_name -> google.protobuf.Timestamp
+	20, // 28: ExecuteTradeStrategyForParticipantResponse.error:type_name -> ExecutionError
+	7,  // 29: ExecuteTradeStrategyForParticipantResponse.pair:type_name -> TRADE_PAIR
+	6,  // 30: ExecuteTradeStrategyForParticipantResponse.instrument_type:type_name -> INSTRUMENT_TYPE
+	22, // 31: ExecuteTradeStrategyForParticipantResponse.execution_schedule:type_name -> ExecutionSchedule
+	11, // 32: ExecutionSchedule.execution_strategy:type_name -> EXECUTION_STRATEGY
+	53, // 33: ExecutionSchedule.start:type_name -> google.protobuf.Timestamp
+	53, // 34: ExecutionSchedule.end:type_name -> google.protobuf.Timestamp
+	53, // 35: ExecutionSchedule.next_child_order:type_name -> google.protobuf.Timestamp
+	16, // 36: ReadTradeStrategyByTradeStrategyIDResponse.trade_strategy:type_name -> TradeStrategy
+	0,  // 37: VenueCredentials.venue:type_name -> VENUE
+	0,  // 38: ListAvailableVenuesResponse.venues:type_name -> VENUE
+	15, // 39: ListOrdersByParticipantResponse.orders:type_name -> Order
+	15, // 40: ReadOrderResponse.order:type_name -> Order
+	15, // 41: CancelTradeStrategyResponse.cancelled_orders:type_name -> Order
+	15, // 42: CancelTradeStrategyResponse.failed_orders:type_name -> Order
+	15, // 43: CancelParticipantOrdersResponse.cancelled_orders:type_name -> Order
+	15, // 44: CancelParticipantOrdersResponse.failed_orders:type_name -> Order
+	15, // 45: AmendedStopLoss.previous_stop_loss:type_name -> Order
+	15, // 46: AmendedStopLoss.new_stop_loss:type_name -> Order
+	37, // 47: AmendStopLossResponse.amended_stop_losses:type_name -> AmendedStopLoss
+	14, // 48: CircuitBreaker.scope:type_name -> CIRCUIT_BREAKER_SCOPE
+	53, // 49: CircuitBreaker.tripped:type_name -> google.protobuf.Timestamp
+	53, // 50: CircuitBreaker.last_updated:type_name -> google.protobuf.Timestamp
+	14, // 51: TripCircuitBreakerRequest.scope:type_name -> CIRCUIT_BREAKER_SCOPE
+	39, // 52: TripCircuitBreakerResponse.circuit_breaker:type_name -> CircuitBreaker
+	15, // 53: TripCircuitBreakerResponse.cancelled_orders:type_name -> Order
+	15, // 54: TripCircuitBreakerResponse.closed_out_orders:type_name -> Order
+	15, // 55: TripCircuitBreakerResponse.failed_orders:type_name -> Order
+	14, // 56: ResetCircuitBreakerRequest.scope:type_name -> CIRCUIT_BREAKER_SCOPE
+	39, // 57: ResetCircuitBreakerResponse.circuit_breaker:type_name -> CircuitBreaker
+	39, // 58: ListCircuitBreakersResponse.circuit_breakers:type_name -> CircuitBreaker
+	1,  // 59: CallerPerformance.actor_type:type_name -> ACTOR_TYPE
+	13, // 60: ListCallerPerformanceRequest.sort:type_name -> CALLER_PERFORMANCE_SORT
+	46, // 61: ListCallerPerformanceResponse.caller_performances:type_name -> CallerPerformance
+	53, // 62: ListVenuePositionsRequest.realised_pnl_since:type_name -> google.protobuf.Timestamp
+	0,  // 63: VenuePositions.venue:type_name -> VENUE
+	50, // 64: VenuePositions.positions:type_name -> VenuePosition
+	51, // 65: ListVenuePositionsResponse.venue_positions:type_name -> VenuePositions
+	17, // 66: tradeengine.CreateTradeStrategy:input_type -> CreateTradeStrategyRequest
+	19, // 67: tradeengine.ExecuteTradeStrategyForParticipant:input_type -> ExecuteTradeStrategyForParticipantRequest
+	23, // 68: tradeengine.ReadTradeStrategyByTradeStrategyID:input_type -> ReadTradeStrategyByTradeStrategyIDRequest
+	26, // 69: tradeengine.ListAvailableVenues:input_type -> ListAvailableVenuesRequest
+	28, // 70: tradeengine.ListOrdersByParticipant:input_type -> ListOrdersByParticipantRequest
+	30, // 71: tradeengine.ReadOrder:input_type -> ReadOrderRequest
+	32, // 72: tradeengine.CancelTradeStrategy:input_type -> CancelTradeStrategyRequest
+	34, // 73: tradeengine.CancelParticipantOrders:input_type -> CancelParticipantOrdersRequest
+	36, // 74: tradeengine.AmendStopLoss:input_type -> AmendStopLossRequest
+	40, // 75: tradeengine.TripCircuitBreaker:input_type -> TripCircuitBreakerRequest
+	42, // 76: tradeengine.ResetCircuitBreaker:input_type -> ResetCircuitBreakerRequest
+	44, // 77: tradeengine.ListCircuitBreakers:input_type -> ListCircuitBreakersRequest
+	47, // 78: tradeengine.ListCallerPerformance:input_type -> ListCallerPerformanceRequest
+	49, // 79: tradeengine.ListVenuePositions:input_type -> ListVenuePositionsRequest
+	18, // 80: tradeengine.CreateTradeStrategy:output_type -> CreateTradeStrategyResponse
+	21, // 81: tradeengine.ExecuteTradeStrategyForParticipant:output_type -> ExecuteTradeStrategyForParticipantResponse
+	24, // 82: tradeengine.ReadTradeStrategyByTradeStrategyID:output_type -> ReadTradeStrategyByTradeStrategyIDResponse
+	27, // 83: tradeengine.ListAvailableVenues:output_type -> ListAvailableVenuesResponse
+	29, // 84: tradeengine.ListOrdersByParticipant:output_type -> ListOrdersByParticipantResponse
+	31, // 85: tradeengine.ReadOrder:output_type -> ReadOrderResponse
+	33, // 86: tradeengine.CancelTradeStrategy:output_type -> CancelTradeStrategyResponse
+	35, // 87: tradeengine.CancelParticipantOrders:output_type -> CancelParticipantOrdersResponse
+	38, // 88: tradeengine.AmendStopLoss:output_type -> AmendStopLossResponse
+	41, // 89: tradeengine.TripCircuitBreaker:output_type -> TripCircuitBreakerResponse
+	43, // 90: tradeengine.ResetCircuitBreaker:output_type -> ResetCircuitBreakerResponse
+	45, // 91: tradeengine.ListCircuitBreakers:output_type -> ListCircuitBreakersResponse
+	48, // 92: tradeengine.ListCallerPerformance:output_type -> ListCallerPerformanceResponse
+	52, // 93: tradeengine.ListVenuePositions:output_type -> ListVenuePositionsResponse
+	80, // [80:94] is the sub-list for method output_type
+	66, // [66:80] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
+}
+
+func init() { file_s_trade_engine_proto_tradeengine_proto_init() }
+func file_s_trade_engine_proto_tradeengine_proto_init() {
+	if File_s_trade_engine_proto_tradeengine_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTradeStrategyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTradeStrategyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteTradeStrategyForParticipantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteTradeStrategyForParticipantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteTradeStrategyForParticipantRequest); i {
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2201,8 +4864,8 @@ func file_s_trade_engine_proto_tradeengine_proto_init() {
 				return nil
 			}
 		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutionError); i {
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTradeStrategyByTradeStrategyIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2213,8 +4876,8 @@ func file_s_trade_engine_proto_tradeengine_proto_init() {
 				return nil
 			}
 		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteTradeStrategyForParticipantResponse); i {
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTradeStrategyByTradeStrategyIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2225,8 +4888,8 @@ func file_s_trade_engine_proto_tradeengine_proto_init() {
 				return nil
 			}
 		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadTradeStrategyByTradeStrategyIDRequest); i {
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VenueCredentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2237,8 +4900,8 @@ func file_s_trade_engine_proto_tradeengine_proto_init() {
 				return nil
 			}
 		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadTradeStrategyByTradeStrategyIDResponse); i {
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAvailableVenuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2249,8 +4912,8 @@ func file_s_trade_engine_proto_tradeengine_proto_init() {
 				return nil
 			}
 		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VenueCredentials); i {
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAvailableVenuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2261,8 +4924,8 @@ func file_s_trade_engine_proto_tradeengine_proto_init() {
 				return nil
 			}
 		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAvailableVenuesRequest); i {
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersByParticipantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2273,8 +4936,284 @@ func file_s_trade_engine_proto_tradeengine_proto_init() {
 				return nil
 			}
 		}
-		file_s_trade_engine_proto_tradeengine_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAvailableVenuesResponse); i {
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersByParticipantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTradeStrategyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTradeStrategyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelParticipantOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelParticipantOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendStopLossRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendedStopLoss); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendStopLossResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripCircuitBreakerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripCircuitBreakerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetCircuitBreakerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetCircuitBreakerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCircuitBreakersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCircuitBreakersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallerPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCallerPerformanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCallerPerformanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVenuePositionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VenuePosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VenuePositions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_s_trade_engine_proto_tradeengine_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVenuePositionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2291,8 +5230,8 @@ func file_s_trade_engine_proto_tradeengine_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_s_trade_engine_proto_tradeengine_proto_rawDesc,
-			NumEnums:      12,
-			NumMessages:   12,
+			NumEnums:      15,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
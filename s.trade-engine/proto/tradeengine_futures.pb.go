@@ -291,3 +291,733 @@ func (r *ListAvailableVenuesRequest) SendWithTimeout(ctx context.Context, timeou
 		resultc: resultc,
 	}
 }
+
+// --- List Orders By Participant --- //
+
+type ListOrdersByParticipantFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListOrdersByParticipantResponse
+	ctx     context.Context
+}
+
+func (a *ListOrdersByParticipantFuture) Response() (*ListOrdersByParticipantResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_orders_by_participant", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListOrdersByParticipantRequest) Send(ctx context.Context) *ListOrdersByParticipantFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListOrdersByParticipantRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListOrdersByParticipantFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListOrdersByParticipantResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &ListOrdersByParticipantFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListOrdersByParticipant(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_orders_by_participant", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListOrdersByParticipantFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Read Order --- //
+
+type ReadOrderFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ReadOrderResponse
+	ctx     context.Context
+}
+
+func (a *ReadOrderFuture) Response() (*ReadOrderResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "read_order", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ReadOrderRequest) Send(ctx context.Context) *ReadOrderFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ReadOrderRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ReadOrderFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ReadOrderResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &ReadOrderFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ReadOrder(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_read_order", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ReadOrderFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Cancel Trade Strategy --- //
+
+type CancelTradeStrategyFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *CancelTradeStrategyResponse
+	ctx     context.Context
+}
+
+func (a *CancelTradeStrategyFuture) Response() (*CancelTradeStrategyResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "cancel_trade_strategy", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *CancelTradeStrategyRequest) Send(ctx context.Context) *CancelTradeStrategyFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *CancelTradeStrategyRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *CancelTradeStrategyFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *CancelTradeStrategyResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &CancelTradeStrategyFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.CancelTradeStrategy(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_cancel_trade_strategy", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &CancelTradeStrategyFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Cancel Participant Orders --- //
+
+type CancelParticipantOrdersFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *CancelParticipantOrdersResponse
+	ctx     context.Context
+}
+
+func (a *CancelParticipantOrdersFuture) Response() (*CancelParticipantOrdersResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "cancel_participant_orders", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *CancelParticipantOrdersRequest) Send(ctx context.Context) *CancelParticipantOrdersFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *CancelParticipantOrdersRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *CancelParticipantOrdersFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *CancelParticipantOrdersResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &CancelParticipantOrdersFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.CancelParticipantOrders(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_cancel_participant_orders", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &CancelParticipantOrdersFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Amend Stop Loss --- //
+
+type AmendStopLossFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *AmendStopLossResponse
+	ctx     context.Context
+}
+
+func (a *AmendStopLossFuture) Response() (*AmendStopLossResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "amend_stop_loss", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *AmendStopLossRequest) Send(ctx context.Context) *AmendStopLossFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *AmendStopLossRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *AmendStopLossFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *AmendStopLossResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &AmendStopLossFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.AmendStopLoss(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_amend_stop_loss", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &AmendStopLossFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Trip Circuit Breaker --- //
+
+type TripCircuitBreakerFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *TripCircuitBreakerResponse
+	ctx     context.Context
+}
+
+func (a *TripCircuitBreakerFuture) Response() (*TripCircuitBreakerResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "trip_circuit_breaker", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *TripCircuitBreakerRequest) Send(ctx context.Context) *TripCircuitBreakerFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *TripCircuitBreakerRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *TripCircuitBreakerFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *TripCircuitBreakerResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &TripCircuitBreakerFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.TripCircuitBreaker(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_trip_circuit_breaker", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &TripCircuitBreakerFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- Reset Circuit Breaker --- //
+
+type ResetCircuitBreakerFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ResetCircuitBreakerResponse
+	ctx     context.Context
+}
+
+func (a *ResetCircuitBreakerFuture) Response() (*ResetCircuitBreakerResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "reset_circuit_breaker", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ResetCircuitBreakerRequest) Send(ctx context.Context) *ResetCircuitBreakerFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ResetCircuitBreakerRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ResetCircuitBreakerFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ResetCircuitBreakerResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &ResetCircuitBreakerFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ResetCircuitBreaker(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_reset_circuit_breaker", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ResetCircuitBreakerFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- List Circuit Breakers --- //
+
+type ListCircuitBreakersFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListCircuitBreakersResponse
+	ctx     context.Context
+}
+
+func (a *ListCircuitBreakersFuture) Response() (*ListCircuitBreakersResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_circuit_breakers", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListCircuitBreakersRequest) Send(ctx context.Context) *ListCircuitBreakersFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListCircuitBreakersRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListCircuitBreakersFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListCircuitBreakersResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &ListCircuitBreakersFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListCircuitBreakers(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_circuit_breakers", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListCircuitBreakersFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- List Caller Performance --- //
+
+type ListCallerPerformanceFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListCallerPerformanceResponse
+	ctx     context.Context
+}
+
+func (a *ListCallerPerformanceFuture) Response() (*ListCallerPerformanceResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_caller_performance", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListCallerPerformanceRequest) Send(ctx context.Context) *ListCallerPerformanceFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListCallerPerformanceRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListCallerPerformanceFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListCallerPerformanceResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &ListCallerPerformanceFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListCallerPerformance(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_caller_performance", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListCallerPerformanceFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
+
+// --- List Venue Positions --- //
+
+type ListVenuePositionsFuture struct {
+	closer  func() error
+	errc    chan error
+	resultc chan *ListVenuePositionsResponse
+	ctx     context.Context
+}
+
+func (a *ListVenuePositionsFuture) Response() (*ListVenuePositionsResponse, error) {
+	defer func() {
+		if err := a.closer(); err != nil {
+			slog.Critical(context.Background(), "Failed to close %s grpc connection: %v", "list_venue_positions", err)
+		}
+	}()
+
+	select {
+	case r := <-a.resultc:
+		return r, nil
+	case <-a.ctx.Done():
+		return nil, a.ctx.Err()
+	case err := <-a.errc:
+		return nil, err
+	}
+}
+
+func (r *ListVenuePositionsRequest) Send(ctx context.Context) *ListVenuePositionsFuture {
+	return r.SendWithTimeout(ctx, 10*time.Second)
+}
+
+func (r *ListVenuePositionsRequest) SendWithTimeout(ctx context.Context, timeout time.Duration) *ListVenuePositionsFuture {
+	errc := make(chan error, 1)
+	resultc := make(chan *ListVenuePositionsResponse, 1)
+
+	conn, err := grpc.DialContext(ctx, "swallowtail-s-tradeengine:8000", grpc.WithInsecure())
+	if err != nil {
+		errc <- err
+		return &ListVenuePositionsFuture{
+			ctx:  ctx,
+			errc: errc,
+			closer: func() error {
+				if conn != nil {
+					return conn.Close()
+				}
+				return nil
+			},
+			resultc: resultc,
+		}
+	}
+	c := NewTradeengineClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	go func() {
+		rsp, err := c.ListVenuePositions(ctx, r)
+		if err != nil {
+			errc <- gerrors.Augment(err, "failed_to_list_venue_positions", nil)
+			return
+		}
+		resultc <- rsp
+	}()
+
+	return &ListVenuePositionsFuture{
+		ctx: ctx,
+		closer: func() error {
+			cancel()
+			return conn.Close()
+		},
+		errc:    errc,
+		resultc: resultc,
+	}
+}
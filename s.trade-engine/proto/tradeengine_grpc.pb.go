@@ -22,6 +22,16 @@ type TradeengineClient interface {
 	ExecuteTradeStrategyForParticipant(ctx context.Context, in *ExecuteTradeStrategyForParticipantRequest, opts ...grpc.CallOption) (*ExecuteTradeStrategyForParticipantResponse, error)
 	ReadTradeStrategyByTradeStrategyID(ctx context.Context, in *ReadTradeStrategyByTradeStrategyIDRequest, opts ...grpc.CallOption) (*ReadTradeStrategyByTradeStrategyIDResponse, error)
 	ListAvailableVenues(ctx context.Context, in *ListAvailableVenuesRequest, opts ...grpc.CallOption) (*ListAvailableVenuesResponse, error)
+	ListOrdersByParticipant(ctx context.Context, in *ListOrdersByParticipantRequest, opts ...grpc.CallOption) (*ListOrdersByParticipantResponse, error)
+	ReadOrder(ctx context.Context, in *ReadOrderRequest, opts ...grpc.CallOption) (*ReadOrderResponse, error)
+	CancelTradeStrategy(ctx context.Context, in *CancelTradeStrategyRequest, opts ...grpc.CallOption) (*CancelTradeStrategyResponse, error)
+	CancelParticipantOrders(ctx context.Context, in *CancelParticipantOrdersRequest, opts ...grpc.CallOption) (*CancelParticipantOrdersResponse, error)
+	AmendStopLoss(ctx context.Context, in *AmendStopLossRequest, opts ...grpc.CallOption) (*AmendStopLossResponse, error)
+	TripCircuitBreaker(ctx context.Context, in *TripCircuitBreakerRequest, opts ...grpc.CallOption) (*TripCircuitBreakerResponse, error)
+	ResetCircuitBreaker(ctx context.Context, in *ResetCircuitBreakerRequest, opts ...grpc.CallOption) (*ResetCircuitBreakerResponse, error)
+	ListCircuitBreakers(ctx context.Context, in *ListCircuitBreakersRequest, opts ...grpc.CallOption) (*ListCircuitBreakersResponse, error)
+	ListCallerPerformance(ctx context.Context, in *ListCallerPerformanceRequest, opts ...grpc.CallOption) (*ListCallerPerformanceResponse, error)
+	ListVenuePositions(ctx context.Context, in *ListVenuePositionsRequest, opts ...grpc.CallOption) (*ListVenuePositionsResponse, error)
 }
 
 type tradeengineClient struct {
@@ -68,6 +78,96 @@ func (c *tradeengineClient) ListAvailableVenues(ctx context.Context, in *ListAva
 	return out, nil
 }
 
+func (c *tradeengineClient) ListOrdersByParticipant(ctx context.Context, in *ListOrdersByParticipantRequest, opts ...grpc.CallOption) (*ListOrdersByParticipantResponse, error) {
+	out := new(ListOrdersByParticipantResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/ListOrdersByParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeengineClient) ReadOrder(ctx context.Context, in *ReadOrderRequest, opts ...grpc.CallOption) (*ReadOrderResponse, error) {
+	out := new(ReadOrderResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/ReadOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeengineClient) CancelTradeStrategy(ctx context.Context, in *CancelTradeStrategyRequest, opts ...grpc.CallOption) (*CancelTradeStrategyResponse, error) {
+	out := new(CancelTradeStrategyResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/CancelTradeStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeengineClient) CancelParticipantOrders(ctx context.Context, in *CancelParticipantOrdersRequest, opts ...grpc.CallOption) (*CancelParticipantOrdersResponse, error) {
+	out := new(CancelParticipantOrdersResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/CancelParticipantOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeengineClient) AmendStopLoss(ctx context.Context, in *AmendStopLossRequest, opts ...grpc.CallOption) (*AmendStopLossResponse, error) {
+	out := new(AmendStopLossResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/AmendStopLoss", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeengineClient) TripCircuitBreaker(ctx context.Context, in *TripCircuitBreakerRequest, opts ...grpc.CallOption) (*TripCircuitBreakerResponse, error) {
+	out := new(TripCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/TripCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeengineClient) ResetCircuitBreaker(ctx context.Context, in *ResetCircuitBreakerRequest, opts ...grpc.CallOption) (*ResetCircuitBreakerResponse, error) {
+	out := new(ResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/ResetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeengineClient) ListCircuitBreakers(ctx context.Context, in *ListCircuitBreakersRequest, opts ...grpc.CallOption) (*ListCircuitBreakersResponse, error) {
+	out := new(ListCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/ListCircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeengineClient) ListCallerPerformance(ctx context.Context, in *ListCallerPerformanceRequest, opts ...grpc.CallOption) (*ListCallerPerformanceResponse, error) {
+	out := new(ListCallerPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/ListCallerPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradeengineClient) ListVenuePositions(ctx context.Context, in *ListVenuePositionsRequest, opts ...grpc.CallOption) (*ListVenuePositionsResponse, error) {
+	out := new(ListVenuePositionsResponse)
+	err := c.cc.Invoke(ctx, "/tradeengine/ListVenuePositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TradeengineServer is the server API for Tradeengine service.
 // All implementations must embed UnimplementedTradeengineServer
 // for forward compatibility
@@ -76,6 +176,16 @@ type TradeengineServer interface {
 	ExecuteTradeStrategyForParticipant(context.Context, *ExecuteTradeStrategyForParticipantRequest) (*ExecuteTradeStrategyForParticipantResponse, error)
 	ReadTradeStrategyByTradeStrategyID(context.Context, *ReadTradeStrategyByTradeStrategyIDRequest) (*ReadTradeStrategyByTradeStrategyIDResponse, error)
 	ListAvailableVenues(context.Context, *ListAvailableVenuesRequest) (*ListAvailableVenuesResponse, error)
+	ListOrdersByParticipant(context.Context, *ListOrdersByParticipantRequest) (*ListOrdersByParticipantResponse, error)
+	ReadOrder(context.Context, *ReadOrderRequest) (*ReadOrderResponse, error)
+	CancelTradeStrategy(context.Context, *CancelTradeStrategyRequest) (*CancelTradeStrategyResponse, error)
+	CancelParticipantOrders(context.Context, *CancelParticipantOrdersRequest) (*CancelParticipantOrdersResponse, error)
+	AmendStopLoss(context.Context, *AmendStopLossRequest) (*AmendStopLossResponse, error)
+	TripCircuitBreaker(context.Context, *TripCircuitBreakerRequest) (*TripCircuitBreakerResponse, error)
+	ResetCircuitBreaker(context.Context, *ResetCircuitBreakerRequest) (*ResetCircuitBreakerResponse, error)
+	ListCircuitBreakers(context.Context, *ListCircuitBreakersRequest) (*ListCircuitBreakersResponse, error)
+	ListCallerPerformance(context.Context, *ListCallerPerformanceRequest) (*ListCallerPerformanceResponse, error)
+	ListVenuePositions(context.Context, *ListVenuePositionsRequest) (*ListVenuePositionsResponse, error)
 	mustEmbedUnimplementedTradeengineServer()
 }
 
@@ -95,6 +205,36 @@ func (UnimplementedTradeengineServer) ReadTradeStrategyByTradeStrategyID(context
 func (UnimplementedTradeengineServer) ListAvailableVenues(context.Context, *ListAvailableVenuesRequest) (*ListAvailableVenuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAvailableVenues not implemented")
 }
+func (UnimplementedTradeengineServer) ListOrdersByParticipant(context.Context, *ListOrdersByParticipantRequest) (*ListOrdersByParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrdersByParticipant not implemented")
+}
+func (UnimplementedTradeengineServer) ReadOrder(context.Context, *ReadOrderRequest) (*ReadOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadOrder not implemented")
+}
+func (UnimplementedTradeengineServer) CancelTradeStrategy(context.Context, *CancelTradeStrategyRequest) (*CancelTradeStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTradeStrategy not implemented")
+}
+func (UnimplementedTradeengineServer) CancelParticipantOrders(context.Context, *CancelParticipantOrdersRequest) (*CancelParticipantOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelParticipantOrders not implemented")
+}
+func (UnimplementedTradeengineServer) AmendStopLoss(context.Context, *AmendStopLossRequest) (*AmendStopLossResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendStopLoss not implemented")
+}
+func (UnimplementedTradeengineServer) TripCircuitBreaker(context.Context, *TripCircuitBreakerRequest) (*TripCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TripCircuitBreaker not implemented")
+}
+func (UnimplementedTradeengineServer) ResetCircuitBreaker(context.Context, *ResetCircuitBreakerRequest) (*ResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (UnimplementedTradeengineServer) ListCircuitBreakers(context.Context, *ListCircuitBreakersRequest) (*ListCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCircuitBreakers not implemented")
+}
+func (UnimplementedTradeengineServer) ListCallerPerformance(context.Context, *ListCallerPerformanceRequest) (*ListCallerPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCallerPerformance not implemented")
+}
+func (UnimplementedTradeengineServer) ListVenuePositions(context.Context, *ListVenuePositionsRequest) (*ListVenuePositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVenuePositions not implemented")
+}
 func (UnimplementedTradeengineServer) mustEmbedUnimplementedTradeengineServer() {}
 
 // UnsafeTradeengineServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +320,186 @@ func _Tradeengine_ListAvailableVenues_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tradeengine_ListOrdersByParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersByParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).ListOrdersByParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/ListOrdersByParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).ListOrdersByParticipant(ctx, req.(*ListOrdersByParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tradeengine_ReadOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).ReadOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/ReadOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).ReadOrder(ctx, req.(*ReadOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tradeengine_CancelTradeStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTradeStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).CancelTradeStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/CancelTradeStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).CancelTradeStrategy(ctx, req.(*CancelTradeStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tradeengine_CancelParticipantOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelParticipantOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).CancelParticipantOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/CancelParticipantOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).CancelParticipantOrders(ctx, req.(*CancelParticipantOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tradeengine_AmendStopLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendStopLossRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).AmendStopLoss(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/AmendStopLoss",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).AmendStopLoss(ctx, req.(*AmendStopLossRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tradeengine_TripCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TripCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).TripCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/TripCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).TripCircuitBreaker(ctx, req.(*TripCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tradeengine_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).ResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/ResetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).ResetCircuitBreaker(ctx, req.(*ResetCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tradeengine_ListCircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCircuitBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).ListCircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/ListCircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).ListCircuitBreakers(ctx, req.(*ListCircuitBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tradeengine_ListCallerPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCallerPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).ListCallerPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/ListCallerPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).ListCallerPerformance(ctx, req.(*ListCallerPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tradeengine_ListVenuePositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVenuePositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeengineServer).ListVenuePositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tradeengine/ListVenuePositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeengineServer).ListVenuePositions(ctx, req.(*ListVenuePositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Tradeengine_ServiceDesc is the grpc.ServiceDesc for Tradeengine service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +523,46 @@ var Tradeengine_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAvailableVenues",
 			Handler:    _Tradeengine_ListAvailableVenues_Handler,
 		},
+		{
+			MethodName: "ListOrdersByParticipant",
+			Handler:    _Tradeengine_ListOrdersByParticipant_Handler,
+		},
+		{
+			MethodName: "ReadOrder",
+			Handler:    _Tradeengine_ReadOrder_Handler,
+		},
+		{
+			MethodName: "CancelTradeStrategy",
+			Handler:    _Tradeengine_CancelTradeStrategy_Handler,
+		},
+		{
+			MethodName: "CancelParticipantOrders",
+			Handler:    _Tradeengine_CancelParticipantOrders_Handler,
+		},
+		{
+			MethodName: "AmendStopLoss",
+			Handler:    _Tradeengine_AmendStopLoss_Handler,
+		},
+		{
+			MethodName: "TripCircuitBreaker",
+			Handler:    _Tradeengine_TripCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Tradeengine_ResetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "ListCircuitBreakers",
+			Handler:    _Tradeengine_ListCircuitBreakers_Handler,
+		},
+		{
+			MethodName: "ListCallerPerformance",
+			Handler:    _Tradeengine_ListCallerPerformance_Handler,
+		},
+		{
+			MethodName: "ListVenuePositions",
+			Handler:    _Tradeengine_ListVenuePositions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "s.trade-engine/proto/tradeengine.proto",
@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.satoshi/parser"
+)
+
+const (
+	binanceKlinesURL       = "https://fapi.binance.com/fapi/v1/klines"
+	binanceExchangeInfoURL = "https://fapi.binance.com/fapi/v1/exchangeInfo"
+	ftxMarketsURL          = "https://ftx.com/api/markets"
+)
+
+// getHistoricalPrice returns the open price of the USDT perpetual of the asset in the minute of the given time.
+func getHistoricalPrice(ctx context.Context, asset string, at time.Time) (float64, error) {
+	params := url.Values{}
+	params.Set("symbol", fmt.Sprintf("%sUSDT", strings.ToUpper(asset)))
+	params.Set("interval", "1m")
+	params.Set("startTime", strconv.FormatInt(at.Truncate(time.Minute).UnixMilli(), 10))
+	params.Set("limit", "1")
+
+	errParams := map[string]string{
+		"asset": asset,
+		"at":    at.String(),
+	}
+
+	var klines [][]interface{}
+	if err := getJSON(ctx, binanceKlinesURL+"?"+params.Encode(), &klines); err != nil {
+		return 0, gerrors.Augment(err, "failed_to_get_historical_price", errParams)
+	}
+
+	// Each kline is [open time, open, high, low, close, ...].
+	if len(klines) == 0 || len(klines[0]) < 2 {
+		return 0, gerrors.NotFound("failed_to_get_historical_price.no_klines", errParams)
+	}
+
+	open, ok := klines[0][1].(string)
+	if !ok {
+		return 0, gerrors.FailedPrecondition("failed_to_get_historical_price.bad_kline", errParams)
+	}
+
+	price, err := strconv.ParseFloat(open, 64)
+	if err != nil {
+		return 0, gerrors.Augment(err, "failed_to_get_historical_price.bad_kline", errParams)
+	}
+
+	return price, nil
+}
+
+// readOrFetchInstruments reads the instruments of the corpus; if the corpus has none yet we snapshot the instruments
+// currently tradeable. We never refresh an existing snapshot, since delisted instruments would change the outcome of
+// existing cases.
+func readOrFetchInstruments(ctx context.Context, path string) (*parser.CorpusInstruments, error) {
+	if _, err := os.Stat(path); err == nil {
+		return parser.ReadCorpusInstruments(path)
+	}
+
+	var exchangeInfo struct {
+		Symbols []struct {
+			BaseAsset  string `json:"baseAsset"`
+			QuoteAsset string `json:"quoteAsset"`
+		} `json:"symbols"`
+	}
+	if err := getJSON(ctx, binanceExchangeInfoURL, &exchangeInfo); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_fetch_instruments.binance", nil)
+	}
+
+	var markets struct {
+		Result []struct {
+			Name string `json:"name"`
+		} `json:"result"`
+	}
+	if err := getJSON(ctx, ftxMarketsURL, &markets); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_fetch_instruments.ftx", nil)
+	}
+
+	instruments := &parser.CorpusInstruments{}
+	seen := map[string]bool{}
+	for _, symbol := range exchangeInfo.Symbols {
+		asset := strings.ToLower(symbol.BaseAsset)
+		if symbol.QuoteAsset != "USDT" || seen[asset] {
+			continue
+		}
+
+		seen[asset] = true
+		instruments.Binance = append(instruments.Binance, asset)
+	}
+	for _, market := range markets.Result {
+		instruments.FTX = append(instruments.FTX, strings.ToLower(market.Name))
+	}
+
+	b, err := json.MarshalIndent(instruments, "", "  ")
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_fetch_instruments.marshal", nil)
+	}
+
+	if err := os.WriteFile(path, append(b, '\n'), 0644); err != nil {
+		return nil, gerrors.Augment(err, "failed_to_fetch_instruments.write", map[string]string{
+			"path": path,
+		})
+	}
+
+	return instruments, nil
+}
+
+func getJSON(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_create_request", nil)
+	}
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_execute_request", nil)
+	}
+	defer rsp.Body.Close()
+
+	body, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_read_response", nil)
+	}
+
+	if rsp.StatusCode != http.StatusOK {
+		return gerrors.FailedPrecondition("bad_response", map[string]string{
+			"status_code": strconv.Itoa(rsp.StatusCode),
+			"body":        string(body),
+		})
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return gerrors.Augment(err, "failed_to_unmarshal_response", nil)
+	}
+
+	return nil
+}
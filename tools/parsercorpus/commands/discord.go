@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"swallowtail/libraries/gerrors"
+)
+
+const (
+	discordChannelMessagesURL = "https://discord.com/api/v9/channels/%s/messages"
+	// Discord caps the number of messages returned per request.
+	discordMaxMessagesPerPage = 100
+)
+
+type channelMessage struct {
+	ID        string                `json:"id"`
+	ChannelID string                `json:"channel_id"`
+	Author    *channelMessageAuthor `json:"author"`
+	Content   string                `json:"content"`
+	Timestamp string                `json:"timestamp"`
+}
+
+type channelMessageAuthor struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+// listChannelMessages pages back through the history of the channel; returning up to limit messages, latest first.
+func listChannelMessages(ctx context.Context, token, channelID, before string, limit int) ([]*channelMessage, error) {
+	var msgs []*channelMessage
+	for len(msgs) < limit {
+		pageSize := limit - len(msgs)
+		if pageSize > discordMaxMessagesPerPage {
+			pageSize = discordMaxMessagesPerPage
+		}
+
+		page, err := listChannelMessagesPage(ctx, token, channelID, before, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		msgs = append(msgs, page...)
+		before = page[len(page)-1].ID
+	}
+
+	return msgs, nil
+}
+
+func listChannelMessagesPage(ctx context.Context, token, channelID, before string, limit int) ([]*channelMessage, error) {
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(limit))
+	if before != "" {
+		params.Set("before", before)
+	}
+
+	u := fmt.Sprintf(discordChannelMessagesURL, channelID) + "?" + params.Encode()
+	errParams := map[string]string{
+		"channel_id": channelID,
+		"before":     before,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, gerrors.Augment(err, "failed_to_list_channel_messages.create_request", errParams)
+	}
+
+	req.Header.Set("authorization", token)
+
+	for {
+		rsp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_list_channel_messages", errParams)
+		}
+
+		body, err := ioutil.ReadAll(rsp.Body)
+		rsp.Body.Close()
+		if err != nil {
+			return nil, gerrors.Augment(err, "failed_to_list_channel_messages.read_body", errParams)
+		}
+
+		switch rsp.StatusCode {
+		case http.StatusOK:
+		case http.StatusTooManyRequests:
+			// Back off for as long as discord asks us to.
+			var rateLimit struct {
+				RetryAfter float64 `json:"retry_after"`
+			}
+			json.Unmarshal(body, &rateLimit)
+
+			select {
+			case <-time.After(time.Duration(rateLimit.RetryAfter*float64(time.Second)) + time.Second):
+				continue
+			case <-ctx.Done():
+				return nil, gerrors.Augment(ctx.Err(), "failed_to_list_channel_messages.rate_limited", errParams)
+			}
+		default:
+			errParams["status_code"] = strconv.Itoa(rsp.StatusCode)
+			errParams["body"] = string(body)
+			return nil, gerrors.FailedPrecondition("failed_to_list_channel_messages.bad_response", errParams)
+		}
+
+		var msgs []*channelMessage
+		if err := json.Unmarshal(body, &msgs); err != nil {
+			return nil, gerrors.Augment(err, "failed_to_list_channel_messages.unmarshal", errParams)
+		}
+
+		return msgs, nil
+	}
+}
@@ -0,0 +1,183 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"swallowtail/libraries/gerrors"
+	"swallowtail/s.satoshi/parser"
+)
+
+const (
+	defaultCorpusDir     = "s.satoshi/parser/testdata/corpus"
+	defaultExportLimit   = 100
+	discordTokenEnvVar   = "SATOSHI_DISCORD_CONSUMER_1_API_TOKEN"
+	instrumentsFileName  = "instruments.json"
+	exportRequestTimeout = 10 * time.Minute
+)
+
+var (
+	exportChannelID string
+	exportBefore    string
+	exportLimit     int
+	exportCorpusDir string
+	exportCorpus    string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Exports historical messages of a registered discord channel into the parser corpus.",
+	Long: `Exports historical messages of a registered discord channel into the parser corpus.
+
+Each message is replayed through the parser with the price of its asset at the time it was posted; the parse is recorded
+as the expected outcome of the case. Check the recorded trade strategies are correct before committing them; a case
+that parses wrongly today should be corrected by hand so the test fails until the parser is fixed.
+
+Messages already in the corpus are skipped, so the export can be run repeatedly.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(cmd.Context(), exportRequestTimeout)
+		defer cancel()
+
+		return export(ctx)
+	},
+}
+
+func init() {
+	exportCmd.Flags().StringVar(&exportChannelID, "channel", "", "The ID of the registered discord channel to export.")
+	exportCmd.Flags().StringVar(&exportBefore, "before", "", "Only export messages before this message ID; defaults to the latest message.")
+	exportCmd.Flags().IntVar(&exportLimit, "limit", defaultExportLimit, "The maximum number of messages to export.")
+	exportCmd.Flags().StringVar(&exportCorpusDir, "corpus-dir", defaultCorpusDir, "The directory of the corpus.")
+	exportCmd.Flags().StringVar(&exportCorpus, "corpus", "", "The corpus file to append to; defaults to <channel>.jsonl in the corpus directory.")
+	exportCmd.MarkFlagRequired("channel")
+
+	rootCmd.AddCommand(exportCmd)
+}
+
+func export(ctx context.Context) error {
+	token := os.Getenv(discordTokenEnvVar)
+	if token == "" {
+		return gerrors.FailedPrecondition("failed_to_export_corpus.missing_discord_token", map[string]string{
+			"env_var": discordTokenEnvVar,
+		})
+	}
+
+	if !parser.IsRegistered(exportChannelID) {
+		return gerrors.BadParam("failed_to_export_corpus.channel_not_registered", map[string]string{
+			"channel_id": exportChannelID,
+		})
+	}
+
+	if exportLimit <= 0 {
+		return gerrors.BadParam("failed_to_export_corpus.invalid_limit", nil)
+	}
+
+	corpusPath := exportCorpus
+	if corpusPath == "" {
+		corpusPath = filepath.Join(exportCorpusDir, fmt.Sprintf("%s.jsonl", exportChannelID))
+	}
+
+	errParams := map[string]string{
+		"channel_id": exportChannelID,
+		"corpus":     corpusPath,
+	}
+
+	instruments, err := readOrFetchInstruments(ctx, filepath.Join(exportCorpusDir, instrumentsFileName))
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_export_corpus", errParams)
+	}
+
+	existing := map[string]bool{}
+	if _, err := os.Stat(corpusPath); err == nil {
+		cases, err := parser.ReadCorpus(corpusPath)
+		if err != nil {
+			return gerrors.Augment(err, "failed_to_export_corpus", errParams)
+		}
+
+		for _, c := range cases {
+			existing[c.Name] = true
+		}
+	}
+
+	msgs, err := listChannelMessages(ctx, token, exportChannelID, exportBefore, exportLimit)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_export_corpus", errParams)
+	}
+
+	f, err := os.OpenFile(corpusPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return gerrors.Augment(err, "failed_to_export_corpus.open_corpus", errParams)
+	}
+	defer f.Close()
+
+	var exported, parsed, skipped int
+	// Discord returns the latest messages first; we record the oldest first so the corpus reads chronologically.
+	for i := len(msgs) - 1; i >= 0; i-- {
+		msg := msgs[i]
+		if existing[msg.ID] || msg.Content == "" || msg.Author == nil {
+			continue
+		}
+
+		timestamp, err := time.Parse(time.RFC3339, msg.Timestamp)
+		if err != nil {
+			return gerrors.Augment(err, "failed_to_export_corpus.bad_message_timestamp", map[string]string{
+				"message_id": msg.ID,
+			})
+		}
+
+		c := &parser.CorpusCase{
+			Name:      msg.ID,
+			ChannelID: exportChannelID,
+			AuthorID:  msg.Author.ID,
+			Username:  msg.Author.Username,
+			Content:   msg.Content,
+			Timestamp: timestamp.UTC(),
+		}
+
+		// The price is recorded as the parser asks for it; messages that aren't signals never ask.
+		var priceErr error
+		parse, err := parser.Replay(ctx, c, instruments, func(ctx context.Context, ticker string) (float64, error) {
+			price, err := getHistoricalPrice(ctx, ticker, c.Timestamp)
+			if err != nil {
+				priceErr = err
+				return 0, err
+			}
+
+			c.Price = price
+			return price, nil
+		})
+		switch {
+		case priceErr != nil:
+			// Recording the case would expect a signal not to parse at all; so we skip it instead.
+			skipped++
+			fmt.Printf("Skipping message %s; failed to fetch historical price: %v\n", msg.ID, priceErr)
+			continue
+		case err != nil:
+			parse = nil
+		}
+
+		if err := c.SetExpected(parse); err != nil {
+			return gerrors.Augment(err, "failed_to_export_corpus", errParams)
+		}
+
+		if err := parser.WriteCorpusCase(f, c); err != nil {
+			return gerrors.Augment(err, "failed_to_export_corpus", errParams)
+		}
+
+		exported++
+		if parse != nil {
+			parsed++
+		}
+	}
+
+	fmt.Printf(
+		"Exported %d messages to %s; %d parsed to trade strategies & %d skipped. Check the expected trade strategies before committing.\n",
+		exported, corpusPath, parsed, skipped,
+	)
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "parsercorpus",
+	Short: "Maintains the satoshi parser regression corpus; exports historical discord messages as corpus cases.",
+}
+
+func Execute() error {
+	return rootCmd.Execute()
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"os"
+
+	"swallowtail/tools/parsercorpus/commands"
+)
+
+func main() {
+	if err := commands.Execute(); err != nil {
+		os.Exit(1)
+	}
+}